@@ -26,6 +26,22 @@ func RegisterBuiltinTypes(reg Registry) error {
 		&LoginResponse{},
 		&LoginCheckpoint{},
 		&PinRequest{},
+		&PinUpdate{},
+		&CellLease{},
+		&Presence{},
+		&Notification{},
+		&NotificationAck{},
+		&Badge{},
+		&UndoRequest{},
+		&UndoState{},
+		&UploadRequest{},
+		&UploadChunk{},
+		&UploadStatus{},
+		&PrefetchHint{},
+		&AssetPush{},
+		&Reauth{},
+		&Device{},
+		&UserKey{},
 	}
 
 	for _, pi := range prototypes {
@@ -40,7 +56,7 @@ func MarshalPbToStore(src tag.ValuePb, dst []byte) ([]byte, error) {
 	newLen := oldLen + src.Size()
 	if cap(dst) < newLen {
 		old := dst
-		dst = make([]byte, (newLen+0x400)&^0x3FF)
+		dst = make([]byte, (max(newLen, 2*cap(old))+0x3FF)&^0x3FF) // grow geometrically so a large tx is not copied per op
 		copy(dst, old)
 	}
 	dst = dst[:newLen]
@@ -48,16 +64,12 @@ func MarshalPbToStore(src tag.ValuePb, dst []byte) ([]byte, error) {
 	return dst, err
 }
 
+// ErrorToValue returns the *Err sent to a client for the given error, classified via AsErr() so its category and retryability survive the wire.
 func ErrorToValue(v error) tag.Value {
 	if v == nil {
 		return nil
 	}
-	artErr, _ := v.(*Err)
-	if artErr == nil {
-		wrapped := ErrCode_UnnamedErr.Wrap(v)
-		artErr = wrapped.(*Err)
-	}
-	return artErr
+	return AsErr(v)
 }
 
 func (v *Tag) MarshalToStore(in []byte) (out []byte, err error) {
@@ -241,3 +253,195 @@ func (v *Request) AttrsToPin() map[tag.ID]struct{} {
 	return pinAttrs
 }
 */
+
+func (v *PinUpdate) MarshalToStore(in []byte) (out []byte, err error) {
+	return MarshalPbToStore(v, in)
+}
+
+func (v *PinUpdate) TagSpec() tag.Spec {
+	return AttrSpec.With("PinUpdate")
+}
+
+func (v *PinUpdate) New() tag.Value {
+	return &PinUpdate{}
+}
+
+func (v *CellLease) MarshalToStore(in []byte) (out []byte, err error) {
+	return MarshalPbToStore(v, in)
+}
+
+func (v *CellLease) TagSpec() tag.Spec {
+	return AttrSpec.With("CellLease")
+}
+
+func (v *CellLease) New() tag.Value {
+	return &CellLease{}
+}
+
+func (v *Presence) MarshalToStore(in []byte) (out []byte, err error) {
+	return MarshalPbToStore(v, in)
+}
+
+func (v *Presence) TagSpec() tag.Spec {
+	return AttrSpec.With("Presence")
+}
+
+func (v *Presence) New() tag.Value {
+	return &Presence{}
+}
+
+func (v *Notification) MarshalToStore(in []byte) (out []byte, err error) {
+	return MarshalPbToStore(v, in)
+}
+
+func (v *Notification) TagSpec() tag.Spec {
+	return AttrSpec.With("Notification")
+}
+
+func (v *Notification) New() tag.Value {
+	return &Notification{}
+}
+
+func (v *NotificationAck) MarshalToStore(in []byte) (out []byte, err error) {
+	return MarshalPbToStore(v, in)
+}
+
+func (v *NotificationAck) TagSpec() tag.Spec {
+	return AttrSpec.With("NotificationAck")
+}
+
+func (v *NotificationAck) New() tag.Value {
+	return &NotificationAck{}
+}
+
+func (v *Badge) MarshalToStore(in []byte) (out []byte, err error) {
+	return MarshalPbToStore(v, in)
+}
+
+func (v *Badge) TagSpec() tag.Spec {
+	return AttrSpec.With("Badge")
+}
+
+func (v *Badge) New() tag.Value {
+	return &Badge{}
+}
+
+func (v *UndoRequest) MarshalToStore(in []byte) (out []byte, err error) {
+	return MarshalPbToStore(v, in)
+}
+
+func (v *UndoRequest) TagSpec() tag.Spec {
+	return AttrSpec.With("UndoRequest")
+}
+
+func (v *UndoRequest) New() tag.Value {
+	return &UndoRequest{}
+}
+
+func (v *UndoState) MarshalToStore(in []byte) (out []byte, err error) {
+	return MarshalPbToStore(v, in)
+}
+
+func (v *UndoState) TagSpec() tag.Spec {
+	return AttrSpec.With("UndoState")
+}
+
+func (v *UndoState) New() tag.Value {
+	return &UndoState{}
+}
+
+func (v *UploadRequest) MarshalToStore(in []byte) (out []byte, err error) {
+	return MarshalPbToStore(v, in)
+}
+
+func (v *UploadRequest) TagSpec() tag.Spec {
+	return AttrSpec.With("UploadRequest")
+}
+
+func (v *UploadRequest) New() tag.Value {
+	return &UploadRequest{}
+}
+
+func (v *UploadChunk) MarshalToStore(in []byte) (out []byte, err error) {
+	return MarshalPbToStore(v, in)
+}
+
+func (v *UploadChunk) TagSpec() tag.Spec {
+	return AttrSpec.With("UploadChunk")
+}
+
+func (v *UploadChunk) New() tag.Value {
+	return &UploadChunk{}
+}
+
+func (v *UploadStatus) MarshalToStore(in []byte) (out []byte, err error) {
+	return MarshalPbToStore(v, in)
+}
+
+func (v *UploadStatus) TagSpec() tag.Spec {
+	return AttrSpec.With("UploadStatus")
+}
+
+func (v *UploadStatus) New() tag.Value {
+	return &UploadStatus{}
+}
+
+func (v *PrefetchHint) MarshalToStore(in []byte) (out []byte, err error) {
+	return MarshalPbToStore(v, in)
+}
+
+func (v *PrefetchHint) TagSpec() tag.Spec {
+	return AttrSpec.With("PrefetchHint")
+}
+
+func (v *PrefetchHint) New() tag.Value {
+	return &PrefetchHint{}
+}
+
+func (v *AssetPush) MarshalToStore(in []byte) (out []byte, err error) {
+	return MarshalPbToStore(v, in)
+}
+
+func (v *AssetPush) TagSpec() tag.Spec {
+	return AttrSpec.With("AssetPush")
+}
+
+func (v *AssetPush) New() tag.Value {
+	return &AssetPush{}
+}
+
+func (v *Reauth) MarshalToStore(in []byte) (out []byte, err error) {
+	return MarshalPbToStore(v, in)
+}
+
+func (v *Reauth) TagSpec() tag.Spec {
+	return AttrSpec.With("Reauth")
+}
+
+func (v *Reauth) New() tag.Value {
+	return &Reauth{}
+}
+
+func (v *Device) MarshalToStore(in []byte) (out []byte, err error) {
+	return MarshalPbToStore(v, in)
+}
+
+func (v *Device) TagSpec() tag.Spec {
+	return AttrSpec.With("Device")
+}
+
+func (v *Device) New() tag.Value {
+	return &Device{}
+}
+
+func (v *UserKey) MarshalToStore(in []byte) (out []byte, err error) {
+	return MarshalPbToStore(v, in)
+}
+
+func (v *UserKey) TagSpec() tag.Spec {
+	return AttrSpec.With("UserKey")
+}
+
+func (v *UserKey) New() tag.Value {
+	return &UserKey{}
+}
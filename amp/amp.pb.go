@@ -83,21 +83,27 @@ func (Const) EnumDescriptor() ([]byte, []int) {
 type TxOpCode int32
 
 const (
-	TxOpCode_Nil           TxOpCode = 0
-	TxOpCode_UpsertElement TxOpCode = 2
-	TxOpCode_DeleteElement TxOpCode = 4
+	TxOpCode_Nil              TxOpCode = 0
+	TxOpCode_UpsertElement    TxOpCode = 2
+	TxOpCode_DeleteElement    TxOpCode = 4
+	TxOpCode_DeferElement     TxOpCode = 6
+	TxOpCode_EphemeralElement TxOpCode = 8
 )
 
 var TxOpCode_name = map[int32]string{
 	0: "TxOpCode_Nil",
 	2: "TxOpCode_UpsertElement",
 	4: "TxOpCode_DeleteElement",
+	6: "TxOpCode_DeferElement",
+	8: "TxOpCode_EphemeralElement",
 }
 
 var TxOpCode_value = map[string]int32{
-	"TxOpCode_Nil":           0,
-	"TxOpCode_UpsertElement": 2,
-	"TxOpCode_DeleteElement": 4,
+	"TxOpCode_Nil":              0,
+	"TxOpCode_UpsertElement":    2,
+	"TxOpCode_DeleteElement":    4,
+	"TxOpCode_DeferElement":     6,
+	"TxOpCode_EphemeralElement": 8,
 }
 
 func (TxOpCode) EnumDescriptor() ([]byte, []int) {
@@ -202,6 +208,9 @@ const (
 	// Sent by the host to signal that the op up to date and the client state is stable / synchronized.
 	// This typically drives UI updates or other dependencies requiring a stable state.
 	OpStatus_Synced OpStatus = 3
+	// Sent by the host to signal that the request is open but its target does not yet exist.
+	// State is pushed as soon as the target is created -- see PinRequest.WaitForTarget.
+	OpStatus_Pending OpStatus = 4
 	// From the client to host, this signals to close / cancel the op associated with ReqID.
 	// From the host to client, this signals that the given request ID has been closed / discarded.
 	OpStatus_Closed OpStatus = 7
@@ -212,6 +221,7 @@ var OpStatus_name = map[int32]string{
 	1: "OpStatus_Syncing",
 	2: "OpStatus_Busy",
 	3: "OpStatus_Synced",
+	4: "OpStatus_Pending",
 	7: "OpStatus_Closed",
 }
 
@@ -220,6 +230,7 @@ var OpStatus_value = map[string]int32{
 	"OpStatus_Syncing":    1,
 	"OpStatus_Busy":       2,
 	"OpStatus_Synced":     3,
+	"OpStatus_Pending":    4,
 	"OpStatus_Closed":     7,
 }
 
@@ -424,6 +435,7 @@ const (
 	ErrCode_ProviderErr             ErrCode = 5059
 	ErrCode_ViolatesAppendOnly      ErrCode = 5100
 	ErrCode_InsufficientPermissions ErrCode = 5101
+	ErrCode_QuotaExceeded           ErrCode = 5102
 )
 
 var ErrCode_name = map[int32]string{
@@ -460,6 +472,7 @@ var ErrCode_name = map[int32]string{
 	5059: "ErrCode_ProviderErr",
 	5100: "ErrCode_ViolatesAppendOnly",
 	5101: "ErrCode_InsufficientPermissions",
+	5102: "ErrCode_QuotaExceeded",
 }
 
 var ErrCode_value = map[string]int32{
@@ -496,12 +509,62 @@ var ErrCode_value = map[string]int32{
 	"ErrCode_ProviderErr":             5059,
 	"ErrCode_ViolatesAppendOnly":      5100,
 	"ErrCode_InsufficientPermissions": 5101,
+	"ErrCode_QuotaExceeded":           5102,
 }
 
 func (ErrCode) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_7e479d288f92766f, []int{10}
 }
 
+// ErrCategory classifies an ErrCode by how a client should react to it -- see ErrCode.Category().
+type ErrCategory int32
+
+const (
+	ErrCategory_Unknown     ErrCategory = 0
+	ErrCategory_Internal    ErrCategory = 1
+	ErrCategory_Invalid     ErrCategory = 2
+	ErrCategory_Auth        ErrCategory = 3
+	ErrCategory_Permission  ErrCategory = 4
+	ErrCategory_NotFound    ErrCategory = 5
+	ErrCategory_Unavailable ErrCategory = 6
+	ErrCategory_Quota       ErrCategory = 7
+	ErrCategory_Unsupported ErrCategory = 8
+	ErrCategory_Conflict    ErrCategory = 9
+	ErrCategory_Canceled    ErrCategory = 10
+)
+
+var ErrCategory_name = map[int32]string{
+	0:  "ErrCategory_Unknown",
+	1:  "ErrCategory_Internal",
+	2:  "ErrCategory_Invalid",
+	3:  "ErrCategory_Auth",
+	4:  "ErrCategory_Permission",
+	5:  "ErrCategory_NotFound",
+	6:  "ErrCategory_Unavailable",
+	7:  "ErrCategory_Quota",
+	8:  "ErrCategory_Unsupported",
+	9:  "ErrCategory_Conflict",
+	10: "ErrCategory_Canceled",
+}
+
+var ErrCategory_value = map[string]int32{
+	"ErrCategory_Unknown":     0,
+	"ErrCategory_Internal":    1,
+	"ErrCategory_Invalid":     2,
+	"ErrCategory_Auth":        3,
+	"ErrCategory_Permission":  4,
+	"ErrCategory_NotFound":    5,
+	"ErrCategory_Unavailable": 6,
+	"ErrCategory_Quota":       7,
+	"ErrCategory_Unsupported": 8,
+	"ErrCategory_Conflict":    9,
+	"ErrCategory_Canceled":    10,
+}
+
+func (ErrCategory) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7e479d288f92766f, []int{11}
+}
+
 type LogLevel int32
 
 const (
@@ -523,7 +586,7 @@ var LogLevel_value = map[string]int32{
 }
 
 func (LogLevel) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_7e479d288f92766f, []int{11}
+	return fileDescriptor_7e479d288f92766f, []int{12}
 }
 
 // TxEnvelope contains information for a TxMsg
@@ -679,6 +742,12 @@ type Login struct {
 	Tags string `protobuf:"bytes,9,opt,name=Tags,proto3" json:"Tags,omitempty"`
 	// Checkpoint allows the client to resume an auth session.
 	Checkpoint *LoginCheckpoint `protobuf:"bytes,12,opt,name=Checkpoint,proto3" json:"Checkpoint,omitempty"`
+	// If set, this session was opened by the given admin user acting as UserID -- see amp.ImpersonateLogin().
+	ImpersonatorID *Tag `protobuf:"bytes,14,opt,name=ImpersonatorID,proto3" json:"ImpersonatorID,omitempty"`
+	// Set by the client at a device's first login to enroll the device's keypair, after which the device proves possession via LoginResponse -- see amp.DeviceRegistry.
+	Enroll *Device `protobuf:"bytes,15,opt,name=Enroll,proto3" json:"Enroll,omitempty"`
+	// If set, a headless client (e.g. a bot, importer, or CI job) signs in with this long-lived API key instead of an interactive login -- see amp.APIKeys.
+	APIKey string `protobuf:"bytes,16,opt,name=APIKey,proto3" json:"APIKey,omitempty"`
 }
 
 func (m *Login) Reset()      { *m = Login{} }
@@ -748,15 +817,208 @@ func (m *Login) GetCheckpoint() *LoginCheckpoint {
 	return nil
 }
 
+func (m *Login) GetImpersonatorID() *Tag {
+	if m != nil {
+		return m.ImpersonatorID
+	}
+	return nil
+}
+
+func (m *Login) GetEnroll() *Device {
+	if m != nil {
+		return m.Enroll
+	}
+	return nil
+}
+
+func (m *Login) GetAPIKey() string {
+	if m != nil {
+		return m.APIKey
+	}
+	return ""
+}
+
+// Device is a client device enrolled by a user -- see amp.DeviceRegistry.
+type Device struct {
+	DeviceID   *Tag   `protobuf:"bytes,1,opt,name=DeviceID,proto3" json:"DeviceID,omitempty"`
+	UserID     *Tag   `protobuf:"bytes,2,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	PublicKey  []byte `protobuf:"bytes,3,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`
+	Label      string `protobuf:"bytes,4,opt,name=Label,proto3" json:"Label,omitempty"`
+	EnrolledAt int64  `protobuf:"varint,5,opt,name=EnrolledAt,proto3" json:"EnrolledAt,omitempty"`
+	LastSeenAt int64  `protobuf:"varint,6,opt,name=LastSeenAt,proto3" json:"LastSeenAt,omitempty"`
+	RevokedAt  int64  `protobuf:"varint,7,opt,name=RevokedAt,proto3" json:"RevokedAt,omitempty"`
+}
+
+func (m *Device) Reset()      { *m = Device{} }
+func (*Device) ProtoMessage() {}
+func (*Device) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e479d288f92766f, []int{2}
+}
+func (m *Device) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Device) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Device.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Device) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Device.Merge(m, src)
+}
+func (m *Device) XXX_Size() int {
+	return m.Size()
+}
+func (m *Device) XXX_DiscardUnknown() {
+	xxx_messageInfo_Device.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Device proto.InternalMessageInfo
+
+func (m *Device) GetDeviceID() *Tag {
+	if m != nil {
+		return m.DeviceID
+	}
+	return nil
+}
+
+func (m *Device) GetUserID() *Tag {
+	if m != nil {
+		return m.UserID
+	}
+	return nil
+}
+
+func (m *Device) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *Device) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *Device) GetEnrolledAt() int64 {
+	if m != nil {
+		return m.EnrolledAt
+	}
+	return 0
+}
+
+func (m *Device) GetLastSeenAt() int64 {
+	if m != nil {
+		return m.LastSeenAt
+	}
+	return 0
+}
+
+func (m *Device) GetRevokedAt() int64 {
+	if m != nil {
+		return m.RevokedAt
+	}
+	return 0
+}
+
+// UserKey is the public identity of a user, allowing others to verify what the user signs and to seal content only the user can open -- see amp.KeyService.
+type UserKey struct {
+	UserID     *Tag   `protobuf:"bytes,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	SigningKey []byte `protobuf:"bytes,2,opt,name=SigningKey,proto3" json:"SigningKey,omitempty"`
+	SealingKey []byte `protobuf:"bytes,3,opt,name=SealingKey,proto3" json:"SealingKey,omitempty"`
+	ClientHeld bool   `protobuf:"varint,4,opt,name=ClientHeld,proto3" json:"ClientHeld,omitempty"`
+	CreatedAt  int64  `protobuf:"varint,5,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+}
+
+func (m *UserKey) Reset()      { *m = UserKey{} }
+func (*UserKey) ProtoMessage() {}
+func (*UserKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e479d288f92766f, []int{3}
+}
+func (m *UserKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UserKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserKey.Merge(m, src)
+}
+func (m *UserKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserKey proto.InternalMessageInfo
+
+func (m *UserKey) GetUserID() *Tag {
+	if m != nil {
+		return m.UserID
+	}
+	return nil
+}
+
+func (m *UserKey) GetSigningKey() []byte {
+	if m != nil {
+		return m.SigningKey
+	}
+	return nil
+}
+
+func (m *UserKey) GetSealingKey() []byte {
+	if m != nil {
+		return m.SealingKey
+	}
+	return nil
+}
+
+func (m *UserKey) GetClientHeld() bool {
+	if m != nil {
+		return m.ClientHeld
+	}
+	return false
+}
+
+func (m *UserKey) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
 // LoginChallenge -- STEP 2: host -> client
 type LoginChallenge struct {
 	Hash []byte `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	// If set, Hash is a WebAuthn challenge for a passkey of this relying party (e.g. "example.com") -- see amp.PasskeyAuth.
+	RelyingPartyID string `protobuf:"bytes,2,opt,name=RelyingPartyID,proto3" json:"RelyingPartyID,omitempty"`
+	// Passkeys the client may sign with (WebAuthn allowCredentials); if empty, any discoverable passkey of the relying party.
+	CredentialIDs [][]byte `protobuf:"bytes,3,rep,name=CredentialIDs,proto3" json:"CredentialIDs,omitempty"`
 }
 
 func (m *LoginChallenge) Reset()      { *m = LoginChallenge{} }
 func (*LoginChallenge) ProtoMessage() {}
 func (*LoginChallenge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7e479d288f92766f, []int{2}
+	return fileDescriptor_7e479d288f92766f, []int{4}
 }
 func (m *LoginChallenge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -792,15 +1054,33 @@ func (m *LoginChallenge) GetHash() []byte {
 	return nil
 }
 
+func (m *LoginChallenge) GetRelyingPartyID() string {
+	if m != nil {
+		return m.RelyingPartyID
+	}
+	return ""
+}
+
+func (m *LoginChallenge) GetCredentialIDs() [][]byte {
+	if m != nil {
+		return m.CredentialIDs
+	}
+	return nil
+}
+
 // LoginResponse -- STEP 3: client -> host
 type LoginResponse struct {
 	HashResponse []byte `protobuf:"bytes,1,opt,name=HashResponse,proto3" json:"HashResponse,omitempty"`
+	// Set in response to a passkey challenge (WebAuthn navigator.credentials.get)
+	Passkey *PasskeyAssertion `protobuf:"bytes,2,opt,name=Passkey,proto3" json:"Passkey,omitempty"`
+	// Set in response to a passkey registration challenge (WebAuthn navigator.credentials.create)
+	NewPasskey *PasskeyCredential `protobuf:"bytes,3,opt,name=NewPasskey,proto3" json:"NewPasskey,omitempty"`
 }
 
 func (m *LoginResponse) Reset()      { *m = LoginResponse{} }
 func (*LoginResponse) ProtoMessage() {}
 func (*LoginResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7e479d288f92766f, []int{3}
+	return fileDescriptor_7e479d288f92766f, []int{5}
 }
 func (m *LoginResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -836,6 +1116,172 @@ func (m *LoginResponse) GetHashResponse() []byte {
 	return nil
 }
 
+func (m *LoginResponse) GetPasskey() *PasskeyAssertion {
+	if m != nil {
+		return m.Passkey
+	}
+	return nil
+}
+
+func (m *LoginResponse) GetNewPasskey() *PasskeyCredential {
+	if m != nil {
+		return m.NewPasskey
+	}
+	return nil
+}
+
+// PasskeyAssertion is a WebAuthn AuthenticatorAssertionResponse, signing a LoginChallenge.
+type PasskeyAssertion struct {
+	CredentialID      []byte `protobuf:"bytes,1,opt,name=CredentialID,proto3" json:"CredentialID,omitempty"`
+	AuthenticatorData []byte `protobuf:"bytes,2,opt,name=AuthenticatorData,proto3" json:"AuthenticatorData,omitempty"`
+	ClientDataJSON    []byte `protobuf:"bytes,3,opt,name=ClientDataJSON,proto3" json:"ClientDataJSON,omitempty"`
+	Signature         []byte `protobuf:"bytes,4,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	UserHandle        []byte `protobuf:"bytes,5,opt,name=UserHandle,proto3" json:"UserHandle,omitempty"`
+}
+
+func (m *PasskeyAssertion) Reset()      { *m = PasskeyAssertion{} }
+func (*PasskeyAssertion) ProtoMessage() {}
+func (*PasskeyAssertion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e479d288f92766f, []int{6}
+}
+func (m *PasskeyAssertion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PasskeyAssertion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PasskeyAssertion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PasskeyAssertion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PasskeyAssertion.Merge(m, src)
+}
+func (m *PasskeyAssertion) XXX_Size() int {
+	return m.Size()
+}
+func (m *PasskeyAssertion) XXX_DiscardUnknown() {
+	xxx_messageInfo_PasskeyAssertion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PasskeyAssertion proto.InternalMessageInfo
+
+func (m *PasskeyAssertion) GetCredentialID() []byte {
+	if m != nil {
+		return m.CredentialID
+	}
+	return nil
+}
+
+func (m *PasskeyAssertion) GetAuthenticatorData() []byte {
+	if m != nil {
+		return m.AuthenticatorData
+	}
+	return nil
+}
+
+func (m *PasskeyAssertion) GetClientDataJSON() []byte {
+	if m != nil {
+		return m.ClientDataJSON
+	}
+	return nil
+}
+
+func (m *PasskeyAssertion) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *PasskeyAssertion) GetUserHandle() []byte {
+	if m != nil {
+		return m.UserHandle
+	}
+	return nil
+}
+
+// PasskeyCredential is a WebAuthn AuthenticatorAttestationResponse, registering a new passkey.
+type PasskeyCredential struct {
+	CredentialID      []byte `protobuf:"bytes,1,opt,name=CredentialID,proto3" json:"CredentialID,omitempty"`
+	PublicKey         []byte `protobuf:"bytes,2,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`
+	AuthenticatorData []byte `protobuf:"bytes,3,opt,name=AuthenticatorData,proto3" json:"AuthenticatorData,omitempty"`
+	ClientDataJSON    []byte `protobuf:"bytes,4,opt,name=ClientDataJSON,proto3" json:"ClientDataJSON,omitempty"`
+	Label             string `protobuf:"bytes,5,opt,name=Label,proto3" json:"Label,omitempty"`
+}
+
+func (m *PasskeyCredential) Reset()      { *m = PasskeyCredential{} }
+func (*PasskeyCredential) ProtoMessage() {}
+func (*PasskeyCredential) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e479d288f92766f, []int{7}
+}
+func (m *PasskeyCredential) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PasskeyCredential) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PasskeyCredential.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PasskeyCredential) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PasskeyCredential.Merge(m, src)
+}
+func (m *PasskeyCredential) XXX_Size() int {
+	return m.Size()
+}
+func (m *PasskeyCredential) XXX_DiscardUnknown() {
+	xxx_messageInfo_PasskeyCredential.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PasskeyCredential proto.InternalMessageInfo
+
+func (m *PasskeyCredential) GetCredentialID() []byte {
+	if m != nil {
+		return m.CredentialID
+	}
+	return nil
+}
+
+func (m *PasskeyCredential) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *PasskeyCredential) GetAuthenticatorData() []byte {
+	if m != nil {
+		return m.AuthenticatorData
+	}
+	return nil
+}
+
+func (m *PasskeyCredential) GetClientDataJSON() []byte {
+	if m != nil {
+		return m.ClientDataJSON
+	}
+	return nil
+}
+
+func (m *PasskeyCredential) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
 // LoginCheckpoint wraps oauth2 -- see oauth2.Token
 type LoginCheckpoint struct {
 	TokenType    string `protobuf:"bytes,1,opt,name=TokenType,proto3" json:"TokenType,omitempty"`
@@ -849,7 +1295,7 @@ type LoginCheckpoint struct {
 func (m *LoginCheckpoint) Reset()      { *m = LoginCheckpoint{} }
 func (*LoginCheckpoint) ProtoMessage() {}
 func (*LoginCheckpoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7e479d288f92766f, []int{4}
+	return fileDescriptor_7e479d288f92766f, []int{8}
 }
 func (m *LoginCheckpoint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -928,6 +1374,8 @@ type PinRequest struct {
 	PinAttrs []*Tag `protobuf:"bytes,4,rep,name=PinAttrs,proto3" json:"PinAttrs,omitempty"`
 	// Options for this request.
 	StateSync StateSync `protobuf:"varint,6,opt,name=StateSync,proto3,enum=amp.StateSync" json:"StateSync,omitempty"`
+	// If set and the target cell does not yet exist, this request stays open (OpStatus_Pending) until the cell is created.
+	WaitForTarget bool `protobuf:"varint,7,opt,name=WaitForTarget,proto3" json:"WaitForTarget,omitempty"`
 	// future proofing
 	Tags *Tag `protobuf:"bytes,17,opt,name=Tags,proto3" json:"Tags,omitempty"`
 }
@@ -935,7 +1383,7 @@ type PinRequest struct {
 func (m *PinRequest) Reset()      { *m = PinRequest{} }
 func (*PinRequest) ProtoMessage() {}
 func (*PinRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7e479d288f92766f, []int{5}
+	return fileDescriptor_7e479d288f92766f, []int{9}
 }
 func (m *PinRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -985,6 +1433,13 @@ func (m *PinRequest) GetStateSync() StateSync {
 	return StateSync_None
 }
 
+func (m *PinRequest) GetWaitForTarget() bool {
+	if m != nil {
+		return m.WaitForTarget
+	}
+	return false
+}
+
 func (m *PinRequest) GetTags() *Tag {
 	if m != nil {
 		return m.Tags
@@ -992,22 +1447,24 @@ func (m *PinRequest) GetTags() *Tag {
 	return nil
 }
 
-// LaunchURL is used as a meta attribute handle a URL, such as an oauth request (host to client) or an oauth response (client to host).
-type LaunchURL struct {
-	URL string `protobuf:"bytes,1,opt,name=URL,proto3" json:"URL,omitempty"`
+// PinUpdate is sent by a client to revise a live pin request, where the tx ContextID identifies the pin request being revised.
+// This allows a list view to pin only the attrs it presents and then widen the selection when an item is opened.
+type PinUpdate struct {
+	// Replaces the attrs selected by the pin request -- if empty, all attrs are pinned.
+	PinAttrs []*Tag `protobuf:"bytes,4,rep,name=PinAttrs,proto3" json:"PinAttrs,omitempty"`
 }
 
-func (m *LaunchURL) Reset()      { *m = LaunchURL{} }
-func (*LaunchURL) ProtoMessage() {}
-func (*LaunchURL) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7e479d288f92766f, []int{6}
+func (m *PinUpdate) Reset()      { *m = PinUpdate{} }
+func (*PinUpdate) ProtoMessage() {}
+func (*PinUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e479d288f92766f, []int{10}
 }
-func (m *LaunchURL) XXX_Unmarshal(b []byte) error {
+func (m *PinUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LaunchURL) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PinUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LaunchURL.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PinUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1017,55 +1474,45 @@ func (m *LaunchURL) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *LaunchURL) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LaunchURL.Merge(m, src)
+func (m *PinUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PinUpdate.Merge(m, src)
 }
-func (m *LaunchURL) XXX_Size() int {
+func (m *PinUpdate) XXX_Size() int {
 	return m.Size()
 }
-func (m *LaunchURL) XXX_DiscardUnknown() {
-	xxx_messageInfo_LaunchURL.DiscardUnknown(m)
+func (m *PinUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PinUpdate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LaunchURL proto.InternalMessageInfo
+var xxx_messageInfo_PinUpdate proto.InternalMessageInfo
 
-func (m *LaunchURL) GetURL() string {
+func (m *PinUpdate) GetPinAttrs() []*Tag {
 	if m != nil {
-		return m.URL
+		return m.PinAttrs
 	}
-	return ""
+	return nil
 }
 
-// Tag is a multi-purpose, human-readable, intuitive, phonetically / hands-free, friendly cryptographically anonymous tagging and system actor "tag" or "badge" identifier.
-// Typically, a "Tags" string is parsed and hashed for safe and high performance data access.
-// SHA1 or any compatible 24 byte UTF8 literal hash DFA.
-type Tag struct {
-	ID_0        int64  `protobuf:"varint,2,opt,name=ID_0,json=ID0,proto3" json:"ID_0,omitempty"`
-	ID_1        uint64 `protobuf:"fixed64,3,opt,name=ID_1,json=ID1,proto3" json:"ID_1,omitempty"`
-	ID_2        uint64 `protobuf:"fixed64,4,opt,name=ID_2,json=ID2,proto3" json:"ID_2,omitempty"`
-	ContentType string `protobuf:"bytes,10,opt,name=ContentType,proto3" json:"ContentType,omitempty"`
-	UID         string `protobuf:"bytes,12,opt,name=UID,proto3" json:"UID,omitempty"`
-	Text        string `protobuf:"bytes,13,opt,name=Text,proto3" json:"Text,omitempty"`
-	URL         string `protobuf:"bytes,15,opt,name=URL,proto3" json:"URL,omitempty"`
-	// Metric specifies the metric system used for the size metric values
-	Metric Metric `protobuf:"varint,20,opt,name=Metric,proto3,enum=amp.Metric" json:"Metric,omitempty"`
-	// SizeX, SizeY, SizeZ specify the size of the object in a given metric system
-	SizeX int64 `protobuf:"varint,21,opt,name=SizeX,proto3" json:"SizeX,omitempty"`
-	SizeY int64 `protobuf:"varint,22,opt,name=SizeY,proto3" json:"SizeY,omitempty"`
-	SizeZ int64 `protobuf:"varint,23,opt,name=SizeZ,proto3" json:"SizeZ,omitempty"`
+// CellLease is an advisory write lock on a cell (e.g. "now editing"), visible to other pins of the cell.
+// A lease is released when its holder releases it, its holder's session closes, or it expires.
+type CellLease struct {
+	Holder     *Tag   `protobuf:"bytes,1,opt,name=Holder,proto3" json:"Holder,omitempty"`
+	Purpose    string `protobuf:"bytes,2,opt,name=Purpose,proto3" json:"Purpose,omitempty"`
+	AcquiredAt int64  `protobuf:"varint,3,opt,name=AcquiredAt,proto3" json:"AcquiredAt,omitempty"`
+	ExpiresAt  int64  `protobuf:"varint,4,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
 }
 
-func (m *Tag) Reset()      { *m = Tag{} }
-func (*Tag) ProtoMessage() {}
-func (*Tag) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7e479d288f92766f, []int{7}
+func (m *CellLease) Reset()      { *m = CellLease{} }
+func (*CellLease) ProtoMessage() {}
+func (*CellLease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e479d288f92766f, []int{11}
 }
-func (m *Tag) XXX_Unmarshal(b []byte) error {
+func (m *CellLease) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Tag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CellLease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Tag.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CellLease.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1075,114 +1522,224 @@ func (m *Tag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Tag) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Tag.Merge(m, src)
+func (m *CellLease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CellLease.Merge(m, src)
 }
-func (m *Tag) XXX_Size() int {
+func (m *CellLease) XXX_Size() int {
 	return m.Size()
 }
-func (m *Tag) XXX_DiscardUnknown() {
-	xxx_messageInfo_Tag.DiscardUnknown(m)
+func (m *CellLease) XXX_DiscardUnknown() {
+	xxx_messageInfo_CellLease.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Tag proto.InternalMessageInfo
+var xxx_messageInfo_CellLease proto.InternalMessageInfo
 
-func (m *Tag) GetID_0() int64 {
+func (m *CellLease) GetHolder() *Tag {
 	if m != nil {
-		return m.ID_0
+		return m.Holder
+	}
+	return nil
+}
+
+func (m *CellLease) GetPurpose() string {
+	if m != nil {
+		return m.Purpose
+	}
+	return ""
+}
+
+func (m *CellLease) GetAcquiredAt() int64 {
+	if m != nil {
+		return m.AcquiredAt
 	}
 	return 0
 }
 
-func (m *Tag) GetID_1() uint64 {
+func (m *CellLease) GetExpiresAt() int64 {
 	if m != nil {
-		return m.ID_1
+		return m.ExpiresAt
 	}
 	return 0
 }
 
-func (m *Tag) GetID_2() uint64 {
+// Presence describes a user currently pinning a cell -- see PresenceTable.
+type Presence struct {
+	UserID   *Tag  `protobuf:"bytes,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	PinCount int32 `protobuf:"varint,2,opt,name=PinCount,proto3" json:"PinCount,omitempty"`
+	Since    int64 `protobuf:"varint,3,opt,name=Since,proto3" json:"Since,omitempty"`
+}
+
+func (m *Presence) Reset()      { *m = Presence{} }
+func (*Presence) ProtoMessage() {}
+func (*Presence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e479d288f92766f, []int{12}
+}
+func (m *Presence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Presence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Presence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Presence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Presence.Merge(m, src)
+}
+func (m *Presence) XXX_Size() int {
+	return m.Size()
+}
+func (m *Presence) XXX_DiscardUnknown() {
+	xxx_messageInfo_Presence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Presence proto.InternalMessageInfo
+
+func (m *Presence) GetUserID() *Tag {
 	if m != nil {
-		return m.ID_2
+		return m.UserID
+	}
+	return nil
+}
+
+func (m *Presence) GetPinCount() int32 {
+	if m != nil {
+		return m.PinCount
 	}
 	return 0
 }
 
-func (m *Tag) GetContentType() string {
+func (m *Presence) GetSince() int64 {
 	if m != nil {
-		return m.ContentType
+		return m.Since
 	}
-	return ""
+	return 0
 }
 
-func (m *Tag) GetUID() string {
+// Notification is a user-targeted message emitted by an app and delivered to the client's session controller (context ID 0).
+// Notifications are queued while the user has no live session and remain pending until acked -- see NotificationService.
+type Notification struct {
+	ID        *Tag   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	AppID     *Tag   `protobuf:"bytes,2,opt,name=AppID,proto3" json:"AppID,omitempty"`
+	UserID    *Tag   `protobuf:"bytes,3,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Title     string `protobuf:"bytes,4,opt,name=Title,proto3" json:"Title,omitempty"`
+	Body      string `protobuf:"bytes,5,opt,name=Body,proto3" json:"Body,omitempty"`
+	Link      *Tag   `protobuf:"bytes,6,opt,name=Link,proto3" json:"Link,omitempty"`
+	CreatedAt int64  `protobuf:"varint,7,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	Silent    bool   `protobuf:"varint,8,opt,name=Silent,proto3" json:"Silent,omitempty"`
+}
+
+func (m *Notification) Reset()      { *m = Notification{} }
+func (*Notification) ProtoMessage() {}
+func (*Notification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e479d288f92766f, []int{13}
+}
+func (m *Notification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Notification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Notification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Notification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Notification.Merge(m, src)
+}
+func (m *Notification) XXX_Size() int {
+	return m.Size()
+}
+func (m *Notification) XXX_DiscardUnknown() {
+	xxx_messageInfo_Notification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Notification proto.InternalMessageInfo
+
+func (m *Notification) GetID() *Tag {
 	if m != nil {
-		return m.UID
+		return m.ID
 	}
-	return ""
+	return nil
 }
 
-func (m *Tag) GetText() string {
+func (m *Notification) GetAppID() *Tag {
 	if m != nil {
-		return m.Text
+		return m.AppID
 	}
-	return ""
+	return nil
 }
 
-func (m *Tag) GetURL() string {
+func (m *Notification) GetUserID() *Tag {
 	if m != nil {
-		return m.URL
+		return m.UserID
+	}
+	return nil
+}
+
+func (m *Notification) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
 	return ""
 }
 
-func (m *Tag) GetMetric() Metric {
+func (m *Notification) GetBody() string {
 	if m != nil {
-		return m.Metric
+		return m.Body
 	}
-	return Metric_Nil
+	return ""
 }
 
-func (m *Tag) GetSizeX() int64 {
+func (m *Notification) GetLink() *Tag {
 	if m != nil {
-		return m.SizeX
+		return m.Link
 	}
-	return 0
+	return nil
 }
 
-func (m *Tag) GetSizeY() int64 {
+func (m *Notification) GetCreatedAt() int64 {
 	if m != nil {
-		return m.SizeY
+		return m.CreatedAt
 	}
 	return 0
 }
 
-func (m *Tag) GetSizeZ() int64 {
+func (m *Notification) GetSilent() bool {
 	if m != nil {
-		return m.SizeZ
+		return m.Silent
 	}
-	return 0
+	return false
 }
 
-// Tags is a multi-purpose, automatically serializable and customable tree.
-//
-// All fields are optional and whose meaning is contextual.
-type Tags struct {
-	ID      *Tag    `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	SubTags []*Tags `protobuf:"bytes,8,rep,name=SubTags,proto3" json:"SubTags,omitempty"`
+// NotificationAck is sent by the client to its session controller to clear pending notifications.
+type NotificationAck struct {
+	IDs   []*Tag `protobuf:"bytes,1,rep,name=IDs,proto3" json:"IDs,omitempty"`
+	AppID *Tag   `protobuf:"bytes,2,opt,name=AppID,proto3" json:"AppID,omitempty"`
 }
 
-func (m *Tags) Reset()      { *m = Tags{} }
-func (*Tags) ProtoMessage() {}
-func (*Tags) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7e479d288f92766f, []int{8}
+func (m *NotificationAck) Reset()      { *m = NotificationAck{} }
+func (*NotificationAck) ProtoMessage() {}
+func (*NotificationAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e479d288f92766f, []int{14}
 }
-func (m *Tags) XXX_Unmarshal(b []byte) error {
+func (m *NotificationAck) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Tags) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NotificationAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Tags.Marshal(b, m, deterministic)
+		return xxx_messageInfo_NotificationAck.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1192,48 +1749,49 @@ func (m *Tags) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Tags) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Tags.Merge(m, src)
+func (m *NotificationAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotificationAck.Merge(m, src)
 }
-func (m *Tags) XXX_Size() int {
+func (m *NotificationAck) XXX_Size() int {
 	return m.Size()
 }
-func (m *Tags) XXX_DiscardUnknown() {
-	xxx_messageInfo_Tags.DiscardUnknown(m)
+func (m *NotificationAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotificationAck.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Tags proto.InternalMessageInfo
+var xxx_messageInfo_NotificationAck proto.InternalMessageInfo
 
-func (m *Tags) GetID() *Tag {
+func (m *NotificationAck) GetIDs() []*Tag {
 	if m != nil {
-		return m.ID
+		return m.IDs
 	}
 	return nil
 }
 
-func (m *Tags) GetSubTags() []*Tags {
+func (m *NotificationAck) GetAppID() *Tag {
 	if m != nil {
-		return m.SubTags
+		return m.AppID
 	}
 	return nil
 }
 
-type CryptoKey struct {
-	CryptoKitID CryptoKitID `protobuf:"varint,1,opt,name=CryptoKitID,proto3,enum=amp.CryptoKitID" json:"CryptoKitID,omitempty"`
-	KeyBytes    []byte      `protobuf:"bytes,4,opt,name=KeyBytes,proto3" json:"KeyBytes,omitempty"`
+// Badge is the number of pending notifications from an app.
+type Badge struct {
+	AppID *Tag  `protobuf:"bytes,1,opt,name=AppID,proto3" json:"AppID,omitempty"`
+	Count int32 `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
 }
 
-func (m *CryptoKey) Reset()      { *m = CryptoKey{} }
-func (*CryptoKey) ProtoMessage() {}
-func (*CryptoKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7e479d288f92766f, []int{9}
+func (m *Badge) Reset()      { *m = Badge{} }
+func (*Badge) ProtoMessage() {}
+func (*Badge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e479d288f92766f, []int{15}
 }
-func (m *CryptoKey) XXX_Unmarshal(b []byte) error {
+func (m *Badge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CryptoKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Badge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CryptoKey.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Badge.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1243,53 +1801,53 @@ func (m *CryptoKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *CryptoKey) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CryptoKey.Merge(m, src)
+func (m *Badge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Badge.Merge(m, src)
 }
-func (m *CryptoKey) XXX_Size() int {
+func (m *Badge) XXX_Size() int {
 	return m.Size()
 }
-func (m *CryptoKey) XXX_DiscardUnknown() {
-	xxx_messageInfo_CryptoKey.DiscardUnknown(m)
+func (m *Badge) XXX_DiscardUnknown() {
+	xxx_messageInfo_Badge.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CryptoKey proto.InternalMessageInfo
+var xxx_messageInfo_Badge proto.InternalMessageInfo
 
-func (m *CryptoKey) GetCryptoKitID() CryptoKitID {
+func (m *Badge) GetAppID() *Tag {
 	if m != nil {
-		return m.CryptoKitID
+		return m.AppID
 	}
-	return CryptoKit_Nil
+	return nil
 }
 
-func (m *CryptoKey) GetKeyBytes() []byte {
+func (m *Badge) GetCount() int32 {
 	if m != nil {
-		return m.KeyBytes
+		return m.Count
 	}
-	return nil
+	return 0
 }
 
-// Err is a general purpose error / warning / log message.
-type Err struct {
-	// Identifies the type of error.
-	Code ErrCode `protobuf:"varint,1,opt,name=Code,proto3,enum=amp.ErrCode" json:"Code,omitempty"`
-	// Severity level
-	Level LogLevel `protobuf:"varint,2,opt,name=Level,proto3,enum=amp.LogLevel" json:"Level,omitempty"`
-	// human-readable info
-	Msg string `protobuf:"bytes,4,opt,name=Msg,proto3" json:"Msg,omitempty"`
+// ScheduledTx is a tx that an app has scheduled to be applied at a future time (e.g. publish-at or auto-expire) -- see Scheduler.
+type ScheduledTx struct {
+	ID       *Tag   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	AppID    *Tag   `protobuf:"bytes,2,opt,name=AppID,proto3" json:"AppID,omitempty"`
+	FireAt   int64  `protobuf:"varint,3,opt,name=FireAt,proto3" json:"FireAt,omitempty"`
+	Label    string `protobuf:"bytes,4,opt,name=Label,proto3" json:"Label,omitempty"`
+	Tx       []byte `protobuf:"bytes,5,opt,name=Tx,proto3" json:"Tx,omitempty"`
+	Attempts int32  `protobuf:"varint,6,opt,name=Attempts,proto3" json:"Attempts,omitempty"`
 }
 
-func (m *Err) Reset()      { *m = Err{} }
-func (*Err) ProtoMessage() {}
-func (*Err) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7e479d288f92766f, []int{10}
+func (m *ScheduledTx) Reset()      { *m = ScheduledTx{} }
+func (*ScheduledTx) ProtoMessage() {}
+func (*ScheduledTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e479d288f92766f, []int{16}
 }
-func (m *Err) XXX_Unmarshal(b []byte) error {
+func (m *ScheduledTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Err) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ScheduledTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Err.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ScheduledTx.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1299,1023 +1857,1491 @@ func (m *Err) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Err) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Err.Merge(m, src)
+func (m *ScheduledTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledTx.Merge(m, src)
 }
-func (m *Err) XXX_Size() int {
+func (m *ScheduledTx) XXX_Size() int {
 	return m.Size()
 }
-func (m *Err) XXX_DiscardUnknown() {
-	xxx_messageInfo_Err.DiscardUnknown(m)
+func (m *ScheduledTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledTx.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Err proto.InternalMessageInfo
+var xxx_messageInfo_ScheduledTx proto.InternalMessageInfo
 
-func (m *Err) GetCode() ErrCode {
+func (m *ScheduledTx) GetID() *Tag {
 	if m != nil {
-		return m.Code
+		return m.ID
 	}
-	return ErrCode_NoErr
+	return nil
 }
 
-func (m *Err) GetLevel() LogLevel {
+func (m *ScheduledTx) GetAppID() *Tag {
 	if m != nil {
-		return m.Level
+		return m.AppID
 	}
-	return LogLevel_Error
+	return nil
 }
 
-func (m *Err) GetMsg() string {
+func (m *ScheduledTx) GetFireAt() int64 {
 	if m != nil {
-		return m.Msg
+		return m.FireAt
+	}
+	return 0
+}
+
+func (m *ScheduledTx) GetLabel() string {
+	if m != nil {
+		return m.Label
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("amp.Const", Const_name, Const_value)
-	proto.RegisterEnum("amp.TxOpCode", TxOpCode_name, TxOpCode_value)
-	proto.RegisterEnum("amp.TxField", TxField_name, TxField_value)
-	proto.RegisterEnum("amp.SelectOp", SelectOp_name, SelectOp_value)
-	proto.RegisterEnum("amp.OpStatus", OpStatus_name, OpStatus_value)
-	proto.RegisterEnum("amp.StateSync", StateSync_name, StateSync_value)
-	proto.RegisterEnum("amp.Enable", Enable_name, Enable_value)
-	proto.RegisterEnum("amp.UrlScheme", UrlScheme_name, UrlScheme_value)
-	proto.RegisterEnum("amp.Metric", Metric_name, Metric_value)
-	proto.RegisterEnum("amp.CryptoKitID", CryptoKitID_name, CryptoKitID_value)
-	proto.RegisterEnum("amp.ErrCode", ErrCode_name, ErrCode_value)
-	proto.RegisterEnum("amp.LogLevel", LogLevel_name, LogLevel_value)
-	proto.RegisterType((*TxEnvelope)(nil), "amp.TxEnvelope")
-	proto.RegisterType((*Login)(nil), "amp.Login")
-	proto.RegisterType((*LoginChallenge)(nil), "amp.LoginChallenge")
-	proto.RegisterType((*LoginResponse)(nil), "amp.LoginResponse")
-	proto.RegisterType((*LoginCheckpoint)(nil), "amp.LoginCheckpoint")
-	proto.RegisterType((*PinRequest)(nil), "amp.PinRequest")
-	proto.RegisterType((*LaunchURL)(nil), "amp.LaunchURL")
-	proto.RegisterType((*Tag)(nil), "amp.Tag")
-	proto.RegisterType((*Tags)(nil), "amp.Tags")
-	proto.RegisterType((*CryptoKey)(nil), "amp.CryptoKey")
-	proto.RegisterType((*Err)(nil), "amp.Err")
+func (m *ScheduledTx) GetTx() []byte {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("amp/amp.proto", fileDescriptor_7e479d288f92766f) }
+func (m *ScheduledTx) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
 
-var fileDescriptor_7e479d288f92766f = []byte{
-	// 1966 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x98, 0x4f, 0x70, 0x1b, 0x49,
-	0xf5, 0xc7, 0x3d, 0x92, 0x2c, 0x5b, 0xed, 0x7f, 0xed, 0x5e, 0xdb, 0x99, 0xe4, 0xe7, 0x28, 0x2e,
-	0x25, 0x3f, 0xe4, 0x52, 0x6d, 0xfe, 0x48, 0x61, 0x0f, 0x1c, 0x6d, 0x49, 0x49, 0x54, 0xeb, 0x7f,
-	0x35, 0x92, 0x03, 0x1b, 0xaa, 0x56, 0xd5, 0xd1, 0x3c, 0x49, 0x53, 0x19, 0x75, 0x0f, 0x3d, 0x2d,
-	0x23, 0xe5, 0xc4, 0x85, 0x2a, 0x58, 0xfe, 0x2d, 0x1c, 0x38, 0x2d, 0xb0, 0x1c, 0x58, 0x96, 0x3d,
-	0x71, 0xe3, 0x00, 0x0b, 0x05, 0x5c, 0xb6, 0x38, 0xe5, 0xb8, 0xc5, 0x89, 0x38, 0x17, 0x0e, 0x50,
-	0x95, 0x0b, 0x67, 0xa8, 0xee, 0xf9, 0xa3, 0x19, 0xaf, 0x6f, 0xaf, 0x3f, 0xdf, 0xd7, 0xaf, 0x5f,
-	0xbf, 0xee, 0x7e, 0x23, 0x1b, 0xad, 0xd0, 0x91, 0x77, 0x97, 0x8e, 0xbc, 0x3b, 0x9e, 0xe0, 0x92,
-	0x93, 0x2c, 0x1d, 0x79, 0xa5, 0xf7, 0xb2, 0x08, 0x75, 0x26, 0x4d, 0x76, 0x06, 0x2e, 0xf7, 0x80,
-	0xfc, 0x3f, 0xca, 0xb7, 0x25, 0x95, 0x63, 0xdf, 0xcc, 0xec, 0x18, 0xbb, 0xab, 0xb5, 0x95, 0x3b,
-	0xca, 0xff, 0xd8, 0x0b, 0xa0, 0x15, 0x8a, 0xc4, 0x44, 0x0b, 0xc7, 0x5e, 0x9d, 0x8f, 0x99, 0x34,
-	0x73, 0x3b, 0xc6, 0x6e, 0xce, 0x8a, 0x86, 0xe4, 0x06, 0x5a, 0x7a, 0x08, 0x0c, 0x7c, 0xc7, 0x6f,
-	0x35, 0xba, 0xf7, 0xcc, 0xf9, 0x1d, 0x63, 0x37, 0x6b, 0xa1, 0x18, 0xdd, 0x4b, 0x3b, 0x54, 0xcd,
-	0xfc, 0x8e, 0xb1, 0x9b, 0x4f, 0x38, 0x54, 0xd3, 0x0e, 0x35, 0x73, 0xe1, 0x82, 0x43, 0x4d, 0x39,
-	0xd4, 0x39, 0x93, 0x30, 0x91, 0x7a, 0x09, 0x14, 0x2c, 0x11, 0xa3, 0x7b, 0x69, 0x87, 0xaa, 0xb9,
-	0x14, 0x44, 0x88, 0x51, 0x35, 0xed, 0x50, 0x33, 0x97, 0x2f, 0x38, 0xd4, 0xc8, 0x36, 0xca, 0x3d,
-	0x10, 0x7c, 0x64, 0xae, 0xee, 0x18, 0xbb, 0x4b, 0xb5, 0x45, 0x5d, 0x84, 0x0e, 0x1d, 0x58, 0x9a,
-	0x12, 0x13, 0x65, 0x3a, 0xdc, 0x5c, 0xbb, 0xa0, 0x65, 0x3a, 0x9c, 0x14, 0xd1, 0x7c, 0xd3, 0xe3,
-	0xbd, 0xa1, 0x89, 0x2f, 0x88, 0x01, 0x26, 0xd7, 0x51, 0xae, 0x43, 0x07, 0xbe, 0xb9, 0xae, 0xe5,
-	0x42, 0x24, 0xfb, 0x96, 0xc6, 0xa5, 0xdf, 0x1b, 0x68, 0xfe, 0x80, 0x0f, 0x1c, 0x46, 0x76, 0x50,
-	0xfe, 0xd4, 0x07, 0xd1, 0x6a, 0x98, 0xc6, 0x85, 0x48, 0x21, 0x27, 0xb7, 0xd0, 0x62, 0x03, 0xce,
-	0x9c, 0x1e, 0xb4, 0x1a, 0xba, 0xca, 0x49, 0x9f, 0x58, 0x21, 0x3b, 0x68, 0xe9, 0x11, 0xf7, 0xe5,
-	0x9e, 0x6d, 0x0b, 0xf0, 0x7d, 0x73, 0x71, 0xc7, 0xd8, 0x2d, 0x58, 0x49, 0x44, 0x48, 0x98, 0x52,
-	0x41, 0x4b, 0xda, 0x26, 0x5f, 0x46, 0xa8, 0x3e, 0x84, 0xde, 0x33, 0x8f, 0x3b, 0x4c, 0xea, 0xf2,
-	0x2c, 0xd5, 0x36, 0x74, 0x74, 0x9d, 0xdd, 0x4c, 0xb3, 0x12, 0x7e, 0xa5, 0x5b, 0x68, 0x35, 0x94,
-	0xa9, 0xeb, 0x02, 0x1b, 0x80, 0x8a, 0xfd, 0x88, 0xfa, 0x43, 0xbd, 0x87, 0x65, 0x4b, 0xdb, 0xa5,
-	0xfb, 0x68, 0x45, 0x7b, 0x59, 0xe0, 0x7b, 0x9c, 0xf9, 0x40, 0x4a, 0x68, 0x59, 0x09, 0xd1, 0x38,
-	0x74, 0x4e, 0xb1, 0xd2, 0xef, 0x0c, 0xb4, 0x76, 0x61, 0x69, 0xb2, 0x8d, 0x0a, 0x1d, 0xfe, 0x0c,
-	0x58, 0x67, 0xea, 0x05, 0x93, 0x0a, 0xd6, 0x0c, 0xa8, 0x8d, 0xef, 0xf5, 0x7a, 0xe0, 0xfb, 0x1a,
-	0xe9, 0xdb, 0x5c, 0xb0, 0x92, 0x48, 0xad, 0x6b, 0x41, 0x5f, 0x80, 0x3f, 0x0c, 0x5c, 0xb2, 0xda,
-	0x25, 0xc5, 0xc8, 0x16, 0xca, 0x37, 0x27, 0x9e, 0x23, 0xa6, 0xfa, 0x9a, 0x67, 0xad, 0x70, 0xa4,
-	0x78, 0x78, 0x3c, 0x4b, 0x7a, 0x56, 0x74, 0x28, 0x18, 0x65, 0x4f, 0xad, 0x96, 0xae, 0x58, 0xc1,
-	0x52, 0x66, 0xe9, 0x23, 0x03, 0xa1, 0x13, 0xb5, 0xdb, 0x6f, 0x8c, 0xc1, 0x97, 0xe4, 0x4b, 0xa8,
-	0x70, 0xe2, 0xb0, 0x0e, 0x15, 0x03, 0x90, 0x3a, 0xa9, 0xe4, 0xb1, 0xcd, 0x24, 0x75, 0xba, 0x27,
-	0x0e, 0xdb, 0x93, 0x52, 0xf8, 0x66, 0x6e, 0x27, 0x9b, 0x3e, 0xdd, 0x48, 0x21, 0x6f, 0xa2, 0x82,
-	0x7a, 0x90, 0xd0, 0x9e, 0xb2, 0x9e, 0x7e, 0x49, 0xab, 0xb5, 0x55, 0xed, 0x16, 0x53, 0x6b, 0xe6,
-	0xa0, 0x2e, 0x75, 0xe2, 0xf2, 0x25, 0x2e, 0xb5, 0xbe, 0x7b, 0xd7, 0x51, 0xe1, 0x80, 0x8e, 0x59,
-	0x6f, 0x78, 0x6a, 0x1d, 0x04, 0xfb, 0x38, 0x08, 0xab, 0xaa, 0xcc, 0xd2, 0x7f, 0x0d, 0x94, 0xed,
-	0xd0, 0x01, 0x59, 0x47, 0x39, 0xfd, 0xea, 0x32, 0xba, 0x1e, 0x59, 0xf5, 0xdc, 0x02, 0x54, 0xd5,
-	0x05, 0xcc, 0x2b, 0x54, 0x0d, 0x51, 0x4d, 0x57, 0x4d, 0xa3, 0x9a, 0x3a, 0x10, 0xfd, 0xc0, 0x98,
-	0xd4, 0x07, 0x86, 0x82, 0x03, 0x49, 0x20, 0xbd, 0x68, 0xab, 0x11, 0x17, 0xaf, 0xd5, 0xd0, 0x77,
-	0x13, 0x26, 0xd2, 0x5c, 0x09, 0xef, 0x26, 0x4c, 0x64, 0x94, 0xda, 0x5a, 0x9c, 0x1a, 0xb9, 0x89,
-	0xf2, 0x87, 0x20, 0x85, 0xd3, 0x33, 0x37, 0x74, 0x09, 0x96, 0xf4, 0xce, 0x02, 0x64, 0x85, 0x12,
-	0xd9, 0x40, 0xf3, 0x6d, 0xe7, 0x39, 0x7c, 0xcd, 0xdc, 0xd4, 0x89, 0x07, 0x83, 0x88, 0xbe, 0x63,
-	0x6e, 0xcd, 0xe8, 0x3b, 0x11, 0x7d, 0x62, 0x5e, 0x99, 0xd1, 0x27, 0xa5, 0x66, 0x50, 0x3e, 0xf5,
-	0xfa, 0x2f, 0x79, 0x96, 0x99, 0x56, 0x83, 0xdc, 0x44, 0x0b, 0xed, 0xf1, 0x53, 0x5d, 0xe3, 0x45,
-	0x7d, 0x66, 0x89, 0x07, 0x1e, 0x29, 0xa5, 0xaf, 0xa3, 0x42, 0x5d, 0x4c, 0x3d, 0xc9, 0xdf, 0x86,
-	0x29, 0xa9, 0xa1, 0xa5, 0x70, 0xe0, 0xc8, 0x30, 0xe8, 0x6a, 0x0d, 0xeb, 0x59, 0x09, 0x6e, 0x25,
-	0x9d, 0xc8, 0x35, 0xb4, 0xf8, 0x36, 0x4c, 0xf7, 0xa7, 0x12, 0x7c, 0x5d, 0xdf, 0x65, 0x2b, 0x1e,
-	0x97, 0xde, 0x45, 0xd9, 0xa6, 0x10, 0x64, 0x07, 0xe5, 0xea, 0xdc, 0x86, 0x30, 0xde, 0xb2, 0x8e,
-	0xd7, 0x14, 0x42, 0x31, 0x4b, 0x2b, 0xe4, 0x26, 0x9a, 0x3f, 0x80, 0x33, 0x70, 0x53, 0x6d, 0xfe,
-	0x80, 0x0f, 0x34, 0xb4, 0x02, 0x4d, 0x95, 0xfa, 0xd0, 0x1f, 0xe8, 0x45, 0x0a, 0x96, 0x32, 0x2b,
-	0x1f, 0x1a, 0x68, 0xbe, 0xce, 0x99, 0x2f, 0xc9, 0x2a, 0x42, 0xda, 0xe8, 0x36, 0xa0, 0xef, 0xe3,
-	0x39, 0x72, 0x1d, 0x99, 0xf1, 0x98, 0x8e, 0x5d, 0xd9, 0x06, 0xa1, 0x5a, 0xd0, 0x09, 0x17, 0x12,
-	0x7f, 0xb6, 0x4b, 0xae, 0xa0, 0x37, 0x02, 0xb9, 0x33, 0x79, 0x04, 0xd4, 0x06, 0xd1, 0x55, 0x45,
-	0xc5, 0x98, 0x5c, 0x43, 0x5b, 0x17, 0x84, 0xc7, 0x20, 0x7c, 0x87, 0x33, 0x7c, 0x9f, 0x6c, 0xa3,
-	0xcd, 0x0b, 0xda, 0x21, 0x15, 0xcf, 0x40, 0xe0, 0xd7, 0x7f, 0xff, 0x76, 0x96, 0x6c, 0x22, 0x1c,
-	0xa8, 0x2d, 0x76, 0xc6, 0x7b, 0x54, 0xaa, 0x39, 0x9f, 0x5e, 0xaf, 0x74, 0xd0, 0x62, 0x67, 0xa2,
-	0xbe, 0x46, 0xb6, 0xba, 0x51, 0xcb, 0x91, 0xdd, 0x3d, 0x72, 0x5c, 0x3c, 0xa7, 0x96, 0x8b, 0xc9,
-	0xa9, 0xe7, 0x83, 0x90, 0x4d, 0x17, 0x46, 0xc0, 0x24, 0xce, 0xa4, 0xb4, 0x06, 0xb8, 0x20, 0x21,
-	0xd2, 0x72, 0x95, 0x17, 0x19, 0xb4, 0xd0, 0x99, 0x3c, 0x70, 0xc0, 0xb5, 0xc9, 0x1a, 0x5a, 0x0a,
-	0xcd, 0x30, 0xe8, 0x06, 0xc2, 0x11, 0xa8, 0x83, 0xeb, 0xaa, 0xf7, 0x81, 0x8d, 0x4b, 0x68, 0x15,
-	0x67, 0x2e, 0xa1, 0x35, 0x9c, 0x4d, 0x52, 0xf5, 0xb2, 0x75, 0x84, 0xdc, 0x25, 0xb4, 0x8a, 0xe7,
-	0x2f, 0xa1, 0x35, 0x9c, 0x4f, 0xd2, 0x96, 0x84, 0x91, 0x8e, 0xb0, 0x70, 0x09, 0xad, 0xe2, 0xc5,
-	0x4b, 0x68, 0x0d, 0x17, 0x92, 0xb4, 0x69, 0x3b, 0xfa, 0xdb, 0x8a, 0xd1, 0x25, 0xb4, 0x8a, 0x97,
-	0x2e, 0xa1, 0x35, 0xbc, 0x4c, 0x36, 0xd1, 0x7a, 0x5c, 0x98, 0xf1, 0x48, 0x1b, 0x3e, 0x5e, 0x49,
-	0xe2, 0x43, 0x3a, 0x09, 0xb1, 0x59, 0x39, 0x40, 0x8b, 0x6d, 0x70, 0xa1, 0x27, 0x8f, 0x3d, 0x15,
-	0x2f, 0xb2, 0xbb, 0x47, 0x30, 0x96, 0x82, 0x86, 0x75, 0x8d, 0x69, 0x8b, 0xf5, 0xdc, 0xb1, 0x0d,
-	0x41, 0x5d, 0x63, 0xda, 0x9c, 0x04, 0x34, 0x53, 0x39, 0x43, 0x8b, 0xd1, 0xaf, 0x14, 0x75, 0xd9,
-	0x22, 0xbb, 0x7b, 0xc4, 0x65, 0x5b, 0x52, 0x21, 0xc1, 0x0e, 0x02, 0xc6, 0x82, 0x6a, 0x89, 0x0e,
-	0x1b, 0x60, 0x83, 0xac, 0xa3, 0x95, 0x98, 0xee, 0x8f, 0xfd, 0x29, 0xce, 0x90, 0x37, 0xd0, 0x5a,
-	0xca, 0x11, 0x6c, 0x9c, 0x4d, 0xc1, 0xba, 0xcb, 0x7d, 0xb0, 0xf1, 0x42, 0xc5, 0x4a, 0xb4, 0x60,
-	0x42, 0xd0, 0x6a, 0x3c, 0xe8, 0x1e, 0x71, 0x06, 0x78, 0x8e, 0x5c, 0x45, 0x9b, 0x33, 0xa6, 0xa7,
-	0x1d, 0x33, 0x65, 0x63, 0x83, 0x6c, 0x21, 0x32, 0x93, 0x0e, 0xa9, 0xc3, 0x24, 0x75, 0x18, 0xce,
-	0x54, 0xde, 0x45, 0xf9, 0x26, 0xa3, 0x4f, 0x5d, 0x50, 0x09, 0x07, 0x56, 0xf7, 0x80, 0xaa, 0x3e,
-	0x79, 0xdc, 0xef, 0xe3, 0x39, 0x95, 0x48, 0x9a, 0x32, 0x6c, 0x24, 0xe0, 0x5e, 0x4f, 0x3a, 0x67,
-	0x70, 0xcc, 0x82, 0xdb, 0x96, 0x86, 0xfd, 0x3e, 0xce, 0x56, 0x3e, 0x30, 0x50, 0xe1, 0x54, 0xb8,
-	0xed, 0xde, 0x10, 0x46, 0xa0, 0xb6, 0x1f, 0x0f, 0x66, 0xaf, 0x64, 0x86, 0x4e, 0x99, 0x80, 0x1e,
-	0x1f, 0x30, 0xe7, 0x39, 0xd8, 0xd8, 0x50, 0x7b, 0x9c, 0x69, 0x8f, 0xa4, 0xf4, 0x70, 0x26, 0xcd,
-	0x1a, 0x54, 0x52, 0x9c, 0x4d, 0xb3, 0x07, 0x8e, 0x0b, 0x38, 0x97, 0x5e, 0x6a, 0x6f, 0xe4, 0xe1,
-	0x85, 0x34, 0x7a, 0xe8, 0x48, 0x8c, 0x2b, 0x7f, 0x36, 0xa2, 0x86, 0xae, 0xba, 0x4c, 0x60, 0x85,
-	0x89, 0x6d, 0xa2, 0xf5, 0x70, 0x7c, 0x2c, 0xe4, 0x90, 0x9f, 0x38, 0x13, 0x70, 0xb1, 0x71, 0x11,
-	0x1f, 0x82, 0x04, 0x11, 0x3c, 0xe8, 0x14, 0x76, 0x5c, 0xd7, 0x19, 0x69, 0x2d, 0xfb, 0x85, 0x48,
-	0x2e, 0x65, 0xcf, 0x70, 0x8e, 0x6c, 0x23, 0x33, 0xc4, 0x8f, 0x60, 0xf2, 0x50, 0x38, 0x76, 0x62,
-	0xd2, 0x3c, 0xd9, 0x45, 0xb7, 0x42, 0xb5, 0x23, 0xa8, 0x07, 0xcf, 0x79, 0x83, 0xdb, 0xd0, 0xa3,
-	0x43, 0xb0, 0x05, 0x67, 0x09, 0xcf, 0x7c, 0xe5, 0xa7, 0x46, 0xaa, 0xb3, 0xab, 0x6d, 0xc6, 0xc3,
-	0x70, 0x2f, 0xdb, 0xc8, 0x9c, 0xa1, 0x36, 0xf4, 0x04, 0xc8, 0x7d, 0x3e, 0xe9, 0x1e, 0xd1, 0xba,
-	0x8b, 0x6d, 0xdd, 0x17, 0x63, 0x75, 0xcf, 0x9f, 0x8e, 0x0e, 0xfd, 0x41, 0xa0, 0x41, 0x5a, 0x6b,
-	0x3b, 0x03, 0xe6, 0xb0, 0x50, 0xeb, 0x93, 0x22, 0xba, 0xfa, 0x45, 0xad, 0xd9, 0xa8, 0xbd, 0xf5,
-	0x56, 0xf5, 0x2b, 0xf8, 0x6f, 0x46, 0xe5, 0x3f, 0x79, 0xb4, 0x10, 0x7e, 0x0a, 0x54, 0x52, 0xa1,
-	0xd9, 0x3d, 0xe2, 0x4d, 0x21, 0xf0, 0x1c, 0xb9, 0x82, 0x48, 0x84, 0x4e, 0x19, 0xa3, 0x23, 0xb0,
-	0x15, 0xff, 0x4e, 0x99, 0x98, 0xe8, 0x8d, 0x48, 0x68, 0x31, 0x09, 0x82, 0x51, 0x57, 0x29, 0xdf,
-	0x2d, 0x93, 0x6b, 0x68, 0x73, 0x36, 0xc5, 0x1f, 0x7b, 0x1e, 0x57, 0xaf, 0xed, 0xd8, 0xc3, 0xef,
-	0x5d, 0xd0, 0x9c, 0x91, 0x17, 0xf4, 0x53, 0xb0, 0xf1, 0xf7, 0xca, 0x64, 0x03, 0xad, 0x45, 0x5a,
-	0xc7, 0x19, 0x01, 0x1f, 0x4b, 0xfc, 0xfd, 0x32, 0xb9, 0x8a, 0x36, 0x22, 0xda, 0x1e, 0x8e, 0xa5,
-	0x74, 0xd8, 0xa0, 0xc1, 0xbf, 0xc9, 0xf0, 0x0f, 0x52, 0xd2, 0x11, 0x97, 0x75, 0xce, 0x18, 0xf4,
-	0x54, 0xac, 0x1f, 0x96, 0x93, 0x69, 0xef, 0x8d, 0xe5, 0xf0, 0x01, 0x75, 0x5c, 0xb0, 0xf1, 0x8f,
-	0x52, 0x69, 0xeb, 0xdf, 0x8f, 0xa1, 0xf2, 0x7e, 0x99, 0xfc, 0x1f, 0xda, 0x8a, 0x17, 0x02, 0x5f,
-	0x7d, 0x71, 0xf4, 0x6f, 0x3b, 0xb0, 0xf1, 0x8f, 0xcb, 0xea, 0xdb, 0x92, 0x58, 0xca, 0x02, 0x6a,
-	0x4f, 0xf1, 0x4f, 0xca, 0x64, 0x1b, 0x5d, 0x89, 0x70, 0xf8, 0x83, 0xee, 0x88, 0xcb, 0x07, 0x7c,
-	0xcc, 0x6c, 0xfc, 0x41, 0x6a, 0xb3, 0xa1, 0x1a, 0x76, 0x89, 0x9f, 0xa5, 0x12, 0xdc, 0xa7, 0x76,
-	0x28, 0xe3, 0x9f, 0xa7, 0x84, 0x16, 0x3b, 0xa3, 0xae, 0x63, 0x9f, 0x5a, 0x2d, 0xfc, 0x8b, 0x54,
-	0x0a, 0xfb, 0xd4, 0x7e, 0x4c, 0xdd, 0x31, 0xe0, 0x0f, 0x2f, 0xf3, 0xef, 0xd0, 0x01, 0xfe, 0x65,
-	0xaa, 0x3a, 0xea, 0xb3, 0x10, 0x27, 0xf6, 0xab, 0x54, 0xda, 0x47, 0x5c, 0x0e, 0x1d, 0x36, 0xe8,
-	0xf0, 0x3a, 0x1f, 0x8d, 0x1c, 0x89, 0x3f, 0x4a, 0x4d, 0x0c, 0x60, 0x58, 0xa3, 0x5f, 0xa7, 0x76,
-	0xd4, 0xf6, 0x68, 0x0f, 0xe2, 0xa0, 0x1f, 0xa7, 0xeb, 0x27, 0xb9, 0xa0, 0x03, 0x50, 0xf3, 0xc6,
-	0x02, 0xf0, 0x6f, 0x52, 0x65, 0xdf, 0xf3, 0xbc, 0x78, 0xda, 0x27, 0x29, 0xe5, 0x90, 0xba, 0x7d,
-	0x2e, 0x46, 0x60, 0x77, 0x26, 0xf8, 0xb7, 0x65, 0xb2, 0x85, 0xd6, 0x13, 0x1b, 0xd6, 0x1d, 0x81,
-	0xe2, 0x3f, 0xa4, 0x66, 0xa8, 0xd6, 0x12, 0xad, 0xf2, 0x69, 0x6a, 0x46, 0x73, 0xa2, 0xae, 0x9d,
-	0xba, 0x91, 0x7f, 0x4c, 0xf1, 0x93, 0xf8, 0xc8, 0xff, 0x94, 0xde, 0x29, 0xb8, 0x6e, 0x9c, 0xd6,
-	0x5f, 0x52, 0x8b, 0x9c, 0x08, 0x7e, 0xe6, 0xd8, 0x20, 0x54, 0xb0, 0xbf, 0x96, 0xc9, 0x0d, 0x74,
-	0x2d, 0x52, 0x1e, 0x3b, 0xdc, 0xa5, 0x12, 0xfc, 0x3d, 0xcf, 0x03, 0x66, 0x1f, 0x33, 0x77, 0x8a,
-	0xff, 0x55, 0x26, 0xb7, 0xd0, 0x8d, 0xd9, 0x89, 0xf8, 0xe3, 0x7e, 0xdf, 0xe9, 0x39, 0xc0, 0xe4,
-	0x09, 0x88, 0x91, 0xa3, 0xef, 0x95, 0x8f, 0xff, 0x5d, 0xae, 0x34, 0xd0, 0x62, 0xf4, 0xf3, 0x4a,
-	0xb5, 0xc6, 0xc8, 0xee, 0x36, 0x85, 0xe0, 0xea, 0xe1, 0xad, 0xeb, 0x3f, 0x8b, 0x02, 0xf6, 0x55,
-	0x2a, 0x54, 0xf3, 0x4e, 0xa2, 0x16, 0xeb, 0x73, 0x9c, 0xdb, 0x1f, 0xbe, 0x78, 0x59, 0x9c, 0xfb,
-	0xfc, 0x65, 0x71, 0xee, 0xf5, 0xcb, 0xa2, 0xf1, 0xad, 0xf3, 0xa2, 0xf1, 0xf1, 0x79, 0xd1, 0xf8,
-	0xec, 0xbc, 0x68, 0xbc, 0x38, 0x2f, 0x1a, 0xff, 0x38, 0x2f, 0x1a, 0xff, 0x3c, 0x2f, 0xce, 0xbd,
-	0x3e, 0x2f, 0x1a, 0xef, 0xbf, 0x2a, 0xce, 0xbd, 0x78, 0x55, 0x9c, 0xfb, 0xfc, 0x55, 0x71, 0xee,
-	0xc9, 0x9b, 0x03, 0x47, 0x0e, 0xc7, 0x4f, 0xef, 0xf4, 0xf8, 0xe8, 0x2e, 0x15, 0xf2, 0xf6, 0x08,
-	0x6c, 0x87, 0xde, 0xf6, 0x5c, 0x2a, 0x55, 0xfd, 0xef, 0xd2, 0x91, 0x77, 0xdb, 0xb7, 0x9f, 0xdd,
-	0x1e, 0x70, 0x65, 0x7e, 0x92, 0xc9, 0xee, 0x1d, 0x9e, 0x3c, 0xcd, 0xeb, 0xff, 0x11, 0xdc, 0xff,
-	0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf4, 0x89, 0xe1, 0x91, 0x34, 0x10, 0x00, 0x00,
+// UndoRequest is sent by the client to its session controller to undo or redo its most recent mutations -- see UndoManager.
+type UndoRequest struct {
+	Redo  bool  `protobuf:"varint,1,opt,name=Redo,proto3" json:"Redo,omitempty"`
+	Steps int32 `protobuf:"varint,2,opt,name=Steps,proto3" json:"Steps,omitempty"`
 }
 
-func (x Const) String() string {
-	s, ok := Const_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+func (m *UndoRequest) Reset()      { *m = UndoRequest{} }
+func (*UndoRequest) ProtoMessage() {}
+func (*UndoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e479d288f92766f, []int{17}
 }
-func (x TxOpCode) String() string {
-	s, ok := TxOpCode_name[int32(x)]
-	if ok {
-		return s
+func (m *UndoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UndoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UndoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return strconv.Itoa(int(x))
 }
-func (x TxField) String() string {
-	s, ok := TxField_name[int32(x)]
-	if ok {
-		return s
+func (m *UndoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UndoRequest.Merge(m, src)
+}
+func (m *UndoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UndoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UndoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UndoRequest proto.InternalMessageInfo
+
+func (m *UndoRequest) GetRedo() bool {
+	if m != nil {
+		return m.Redo
 	}
-	return strconv.Itoa(int(x))
+	return false
 }
-func (x SelectOp) String() string {
-	s, ok := SelectOp_name[int32(x)]
-	if ok {
-		return s
+
+func (m *UndoRequest) GetSteps() int32 {
+	if m != nil {
+		return m.Steps
 	}
-	return strconv.Itoa(int(x))
+	return 0
 }
-func (x OpStatus) String() string {
-	s, ok := OpStatus_name[int32(x)]
-	if ok {
-		return s
+
+// UndoState is sent by the host to the client's session controller whenever the session's undo or redo stack changes.
+type UndoState struct {
+	UndoDepth int32  `protobuf:"varint,1,opt,name=UndoDepth,proto3" json:"UndoDepth,omitempty"`
+	RedoDepth int32  `protobuf:"varint,2,opt,name=RedoDepth,proto3" json:"RedoDepth,omitempty"`
+	UndoLabel string `protobuf:"bytes,3,opt,name=UndoLabel,proto3" json:"UndoLabel,omitempty"`
+	RedoLabel string `protobuf:"bytes,4,opt,name=RedoLabel,proto3" json:"RedoLabel,omitempty"`
+}
+
+func (m *UndoState) Reset()      { *m = UndoState{} }
+func (*UndoState) ProtoMessage() {}
+func (*UndoState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e479d288f92766f, []int{18}
+}
+func (m *UndoState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UndoState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UndoState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return strconv.Itoa(int(x))
 }
-func (x StateSync) String() string {
-	s, ok := StateSync_name[int32(x)]
-	if ok {
-		return s
+func (m *UndoState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UndoState.Merge(m, src)
+}
+func (m *UndoState) XXX_Size() int {
+	return m.Size()
+}
+func (m *UndoState) XXX_DiscardUnknown() {
+	xxx_messageInfo_UndoState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UndoState proto.InternalMessageInfo
+
+func (m *UndoState) GetUndoDepth() int32 {
+	if m != nil {
+		return m.UndoDepth
 	}
-	return strconv.Itoa(int(x))
+	return 0
 }
-func (x Enable) String() string {
-	s, ok := Enable_name[int32(x)]
-	if ok {
-		return s
+
+func (m *UndoState) GetRedoDepth() int32 {
+	if m != nil {
+		return m.RedoDepth
 	}
-	return strconv.Itoa(int(x))
+	return 0
 }
-func (x UrlScheme) String() string {
-	s, ok := UrlScheme_name[int32(x)]
-	if ok {
-		return s
+
+func (m *UndoState) GetUndoLabel() string {
+	if m != nil {
+		return m.UndoLabel
 	}
-	return strconv.Itoa(int(x))
+	return ""
 }
-func (x Metric) String() string {
-	s, ok := Metric_name[int32(x)]
-	if ok {
-		return s
+
+func (m *UndoState) GetRedoLabel() string {
+	if m != nil {
+		return m.RedoLabel
 	}
-	return strconv.Itoa(int(x))
+	return ""
 }
-func (x CryptoKitID) String() string {
-	s, ok := CryptoKitID_name[int32(x)]
-	if ok {
-		return s
+
+// UploadRequest is sent by the client to its session controller to begin or resume an asset upload -- see UploadManager.
+// Re-sending an UploadRequest with the same UploadID (e.g. after a reconnect) replies with the offset to resume from.
+type UploadRequest struct {
+	UploadID    string `protobuf:"bytes,1,opt,name=UploadID,proto3" json:"UploadID,omitempty"`
+	Label       string `protobuf:"bytes,2,opt,name=Label,proto3" json:"Label,omitempty"`
+	ContentType string `protobuf:"bytes,3,opt,name=ContentType,proto3" json:"ContentType,omitempty"`
+	TotalSize   int64  `protobuf:"varint,4,opt,name=TotalSize,proto3" json:"TotalSize,omitempty"`
+	AppID       *Tag   `protobuf:"bytes,5,opt,name=AppID,proto3" json:"AppID,omitempty"`
+	CellID      *Tag   `protobuf:"bytes,6,opt,name=CellID,proto3" json:"CellID,omitempty"`
+}
+
+func (m *UploadRequest) Reset()      { *m = UploadRequest{} }
+func (*UploadRequest) ProtoMessage() {}
+func (*UploadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e479d288f92766f, []int{19}
+}
+func (m *UploadRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UploadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UploadRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return strconv.Itoa(int(x))
 }
-func (x ErrCode) String() string {
-	s, ok := ErrCode_name[int32(x)]
-	if ok {
-		return s
+func (m *UploadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadRequest.Merge(m, src)
+}
+func (m *UploadRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UploadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadRequest proto.InternalMessageInfo
+
+func (m *UploadRequest) GetUploadID() string {
+	if m != nil {
+		return m.UploadID
 	}
-	return strconv.Itoa(int(x))
+	return ""
 }
-func (x LogLevel) String() string {
-	s, ok := LogLevel_name[int32(x)]
-	if ok {
-		return s
+
+func (m *UploadRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
 	}
-	return strconv.Itoa(int(x))
+	return ""
 }
-func (m *TxEnvelope) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *UploadRequest) GetContentType() string {
+	if m != nil {
+		return m.ContentType
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *TxEnvelope) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *UploadRequest) GetTotalSize() int64 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
 }
 
-func (m *TxEnvelope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Tags != nil {
-		{
-			size, err := m.Tags.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAmp(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
+func (m *UploadRequest) GetAppID() *Tag {
+	if m != nil {
+		return m.AppID
 	}
-	if m.Epoch != nil {
-		{
-			size, err := m.Epoch.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAmp(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+	return nil
+}
+
+func (m *UploadRequest) GetCellID() *Tag {
+	if m != nil {
+		return m.CellID
 	}
-	if m.To != nil {
-		{
-			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAmp(dAtA, i, uint64(size))
+	return nil
+}
+
+// UploadChunk carries a contiguous range of an upload's content from the client.
+type UploadChunk struct {
+	UploadID string `protobuf:"bytes,1,opt,name=UploadID,proto3" json:"UploadID,omitempty"`
+	Offset   int64  `protobuf:"varint,2,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	Data     []byte `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+	Final    bool   `protobuf:"varint,4,opt,name=Final,proto3" json:"Final,omitempty"`
+}
+
+func (m *UploadChunk) Reset()      { *m = UploadChunk{} }
+func (*UploadChunk) ProtoMessage() {}
+func (*UploadChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e479d288f92766f, []int{20}
+}
+func (m *UploadChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UploadChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UploadChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x7a
+		return b[:n], nil
 	}
-	if m.From != nil {
-		{
-			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAmp(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x72
+}
+func (m *UploadChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadChunk.Merge(m, src)
+}
+func (m *UploadChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *UploadChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadChunk proto.InternalMessageInfo
+
+func (m *UploadChunk) GetUploadID() string {
+	if m != nil {
+		return m.UploadID
 	}
-	if m.ContextID_2 != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.ContextID_2))
-		i--
-		dAtA[i] = 0x61
+	return ""
+}
+
+func (m *UploadChunk) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
 	}
-	if m.ContextID_1 != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.ContextID_1))
-		i--
-		dAtA[i] = 0x59
+	return 0
+}
+
+func (m *UploadChunk) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	if m.ContextID_0 != 0 {
-		i = encodeVarintAmp(dAtA, i, uint64(m.ContextID_0))
-		i--
-		dAtA[i] = 0x50
+	return nil
+}
+
+func (m *UploadChunk) GetFinal() bool {
+	if m != nil {
+		return m.Final
 	}
-	if m.GenesisID_2 != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.GenesisID_2))
-		i--
-		dAtA[i] = 0x39
+	return false
+}
+
+// UploadStatus is sent by the host in reply to an UploadRequest or UploadChunk.
+type UploadStatus struct {
+	UploadID string `protobuf:"bytes,1,opt,name=UploadID,proto3" json:"UploadID,omitempty"`
+	Offset   int64  `protobuf:"varint,2,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	Complete bool   `protobuf:"varint,3,opt,name=Complete,proto3" json:"Complete,omitempty"`
+	CID      string `protobuf:"bytes,4,opt,name=CID,proto3" json:"CID,omitempty"`
+	Err      *Err   `protobuf:"bytes,5,opt,name=Err,proto3" json:"Err,omitempty"`
+}
+
+func (m *UploadStatus) Reset()      { *m = UploadStatus{} }
+func (*UploadStatus) ProtoMessage() {}
+func (*UploadStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e479d288f92766f, []int{21}
+}
+func (m *UploadStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UploadStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UploadStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.GenesisID_1 != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.GenesisID_1))
-		i--
-		dAtA[i] = 0x31
+}
+func (m *UploadStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadStatus.Merge(m, src)
+}
+func (m *UploadStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *UploadStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadStatus proto.InternalMessageInfo
+
+func (m *UploadStatus) GetUploadID() string {
+	if m != nil {
+		return m.UploadID
 	}
-	if m.GenesisID_0 != 0 {
-		i = encodeVarintAmp(dAtA, i, uint64(m.GenesisID_0))
-		i--
-		dAtA[i] = 0x28
+	return ""
+}
+
+func (m *UploadStatus) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
 	}
-	if m.OpCount != 0 {
-		i = encodeVarintAmp(dAtA, i, uint64(m.OpCount))
-		i--
-		dAtA[i] = 0x20
+	return 0
+}
+
+func (m *UploadStatus) GetComplete() bool {
+	if m != nil {
+		return m.Complete
 	}
-	if m.Status != 0 {
-		i = encodeVarintAmp(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x10
+	return false
+}
+
+func (m *UploadStatus) GetCID() string {
+	if m != nil {
+		return m.CID
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Login) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *UploadStatus) GetErr() *Err {
+	if m != nil {
+		return m.Err
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Login) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PrefetchHint marks an asset referenced by a pinned cell as likely to be requested soon (e.g. as the user scrolls) -- see Prefetcher.
+// A hint the host cannot act on (e.g. having no BlobKey) is forwarded to the client so it may fetch the URL itself.
+type PrefetchHint struct {
+	CellID   *Tag   `protobuf:"bytes,1,opt,name=CellID,proto3" json:"CellID,omitempty"`
+	URL      string `protobuf:"bytes,2,opt,name=URL,proto3" json:"URL,omitempty"`
+	BlobKey  string `protobuf:"bytes,3,opt,name=BlobKey,proto3" json:"BlobKey,omitempty"`
+	Priority int32  `protobuf:"varint,4,opt,name=Priority,proto3" json:"Priority,omitempty"`
 }
 
-func (m *Login) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Checkpoint != nil {
-		{
-			size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAmp(dAtA, i, uint64(size))
+func (m *PrefetchHint) Reset()      { *m = PrefetchHint{} }
+func (*PrefetchHint) ProtoMessage() {}
+func (*PrefetchHint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e479d288f92766f, []int{22}
+}
+func (m *PrefetchHint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrefetchHint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrefetchHint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x62
-	}
-	if len(m.Tags) > 0 {
-		i -= len(m.Tags)
-		copy(dAtA[i:], m.Tags)
-		i = encodeVarintAmp(dAtA, i, uint64(len(m.Tags)))
-		i--
-		dAtA[i] = 0x4a
+		return b[:n], nil
 	}
-	if len(m.HostAddress) > 0 {
-		i -= len(m.HostAddress)
-		copy(dAtA[i:], m.HostAddress)
-		i = encodeVarintAmp(dAtA, i, uint64(len(m.HostAddress)))
-		i--
-		dAtA[i] = 0x42
+}
+func (m *PrefetchHint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrefetchHint.Merge(m, src)
+}
+func (m *PrefetchHint) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrefetchHint) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrefetchHint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrefetchHint proto.InternalMessageInfo
+
+func (m *PrefetchHint) GetCellID() *Tag {
+	if m != nil {
+		return m.CellID
 	}
-	if m.DeviceID != nil {
-		{
-			size, err := m.DeviceID.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAmp(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+	return nil
+}
+
+func (m *PrefetchHint) GetURL() string {
+	if m != nil {
+		return m.URL
 	}
-	if m.UserID != nil {
-		{
-			size, err := m.UserID.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAmp(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *PrefetchHint) GetBlobKey() string {
+	if m != nil {
+		return m.BlobKey
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *LoginChallenge) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PrefetchHint) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *LoginChallenge) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// AssetPush delivers a small asset's content ahead of a request, allowing the client to satisfy the asset's URL from its cache.
+type AssetPush struct {
+	URL         string `protobuf:"bytes,1,opt,name=URL,proto3" json:"URL,omitempty"`
+	BlobKey     string `protobuf:"bytes,2,opt,name=BlobKey,proto3" json:"BlobKey,omitempty"`
+	ContentType string `protobuf:"bytes,3,opt,name=ContentType,proto3" json:"ContentType,omitempty"`
+	ETag        string `protobuf:"bytes,4,opt,name=ETag,proto3" json:"ETag,omitempty"`
+	Content     []byte `protobuf:"bytes,5,opt,name=Content,proto3" json:"Content,omitempty"`
 }
 
-func (m *LoginChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintAmp(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+func (m *AssetPush) Reset()      { *m = AssetPush{} }
+func (*AssetPush) ProtoMessage() {}
+func (*AssetPush) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e479d288f92766f, []int{23}
+}
+func (m *AssetPush) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetPush) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetPush.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *AssetPush) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetPush.Merge(m, src)
+}
+func (m *AssetPush) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetPush) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetPush.DiscardUnknown(m)
 }
 
-func (m *LoginResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AssetPush proto.InternalMessageInfo
+
+func (m *AssetPush) GetURL() string {
+	if m != nil {
+		return m.URL
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *LoginResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AssetPush) GetBlobKey() string {
+	if m != nil {
+		return m.BlobKey
+	}
+	return ""
 }
 
-func (m *LoginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.HashResponse) > 0 {
-		i -= len(m.HashResponse)
-		copy(dAtA[i:], m.HashResponse)
-		i = encodeVarintAmp(dAtA, i, uint64(len(m.HashResponse)))
-		i--
-		dAtA[i] = 0xa
+func (m *AssetPush) GetContentType() string {
+	if m != nil {
+		return m.ContentType
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *LoginCheckpoint) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AssetPush) GetETag() string {
+	if m != nil {
+		return m.ETag
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *LoginCheckpoint) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AssetPush) GetContent() []byte {
+	if m != nil {
+		return m.Content
+	}
+	return nil
 }
 
-func (m *LoginCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.URI) > 0 {
-		i -= len(m.URI)
-		copy(dAtA[i:], m.URI)
-		i = encodeVarintAmp(dAtA, i, uint64(len(m.URI)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if len(m.UserID) > 0 {
-		i -= len(m.UserID)
-		copy(dAtA[i:], m.UserID)
-		i = encodeVarintAmp(dAtA, i, uint64(len(m.UserID)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if m.Expiry != 0 {
-		i = encodeVarintAmp(dAtA, i, uint64(m.Expiry))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.RefreshToken) > 0 {
-		i -= len(m.RefreshToken)
-		copy(dAtA[i:], m.RefreshToken)
-		i = encodeVarintAmp(dAtA, i, uint64(len(m.RefreshToken)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.AccessToken) > 0 {
-		i -= len(m.AccessToken)
-		copy(dAtA[i:], m.AccessToken)
-		i = encodeVarintAmp(dAtA, i, uint64(len(m.AccessToken)))
-		i--
-		dAtA[i] = 0x12
+// Reauth is sent by the client to its session controller to refresh the session's credentials (e.g. an expiring OAuth token) without restarting the session and its pins.
+// The host replies with a Reauth bearing the checkpoint now in effect (which the host may have reissued) or Err if refused, in which case the previous credentials remain in effect.
+type Reauth struct {
+	Checkpoint *LoginCheckpoint `protobuf:"bytes,1,opt,name=Checkpoint,proto3" json:"Checkpoint,omitempty"`
+	Err        *Err             `protobuf:"bytes,2,opt,name=Err,proto3" json:"Err,omitempty"`
+}
+
+func (m *Reauth) Reset()      { *m = Reauth{} }
+func (*Reauth) ProtoMessage() {}
+func (*Reauth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e479d288f92766f, []int{24}
+}
+func (m *Reauth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Reauth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Reauth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.TokenType) > 0 {
-		i -= len(m.TokenType)
-		copy(dAtA[i:], m.TokenType)
-		i = encodeVarintAmp(dAtA, i, uint64(len(m.TokenType)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *Reauth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Reauth.Merge(m, src)
+}
+func (m *Reauth) XXX_Size() int {
+	return m.Size()
+}
+func (m *Reauth) XXX_DiscardUnknown() {
+	xxx_messageInfo_Reauth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Reauth proto.InternalMessageInfo
+
+func (m *Reauth) GetCheckpoint() *LoginCheckpoint {
+	if m != nil {
+		return m.Checkpoint
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *PinRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Reauth) GetErr() *Err {
+	if m != nil {
+		return m.Err
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PinRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// LaunchURL is used as a meta attribute handle a URL, such as an oauth request (host to client) or an oauth response (client to host).
+type LaunchURL struct {
+	URL string `protobuf:"bytes,1,opt,name=URL,proto3" json:"URL,omitempty"`
 }
 
-func (m *PinRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Tags != nil {
-		{
-			size, err := m.Tags.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAmp(dAtA, i, uint64(size))
+func (m *LaunchURL) Reset()      { *m = LaunchURL{} }
+func (*LaunchURL) ProtoMessage() {}
+func (*LaunchURL) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e479d288f92766f, []int{25}
+}
+func (m *LaunchURL) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LaunchURL) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LaunchURL.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
+		return b[:n], nil
 	}
-	if m.StateSync != 0 {
-		i = encodeVarintAmp(dAtA, i, uint64(m.StateSync))
-		i--
-		dAtA[i] = 0x30
+}
+func (m *LaunchURL) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LaunchURL.Merge(m, src)
+}
+func (m *LaunchURL) XXX_Size() int {
+	return m.Size()
+}
+func (m *LaunchURL) XXX_DiscardUnknown() {
+	xxx_messageInfo_LaunchURL.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LaunchURL proto.InternalMessageInfo
+
+func (m *LaunchURL) GetURL() string {
+	if m != nil {
+		return m.URL
 	}
-	if len(m.PinAttrs) > 0 {
-		for iNdEx := len(m.PinAttrs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PinAttrs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAmp(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+	return ""
+}
+
+// Tag is a multi-purpose, human-readable, intuitive, phonetically / hands-free, friendly cryptographically anonymous tagging and system actor "tag" or "badge" identifier.
+// Typically, a "Tags" string is parsed and hashed for safe and high performance data access.
+// SHA1 or any compatible 24 byte UTF8 literal hash DFA.
+type Tag struct {
+	ID_0        int64  `protobuf:"varint,2,opt,name=ID_0,json=ID0,proto3" json:"ID_0,omitempty"`
+	ID_1        uint64 `protobuf:"fixed64,3,opt,name=ID_1,json=ID1,proto3" json:"ID_1,omitempty"`
+	ID_2        uint64 `protobuf:"fixed64,4,opt,name=ID_2,json=ID2,proto3" json:"ID_2,omitempty"`
+	ContentType string `protobuf:"bytes,10,opt,name=ContentType,proto3" json:"ContentType,omitempty"`
+	UID         string `protobuf:"bytes,12,opt,name=UID,proto3" json:"UID,omitempty"`
+	Text        string `protobuf:"bytes,13,opt,name=Text,proto3" json:"Text,omitempty"`
+	URL         string `protobuf:"bytes,15,opt,name=URL,proto3" json:"URL,omitempty"`
+	// Metric specifies the metric system used for the size metric values
+	Metric Metric `protobuf:"varint,20,opt,name=Metric,proto3,enum=amp.Metric" json:"Metric,omitempty"`
+	// SizeX, SizeY, SizeZ specify the size of the object in a given metric system
+	SizeX int64 `protobuf:"varint,21,opt,name=SizeX,proto3" json:"SizeX,omitempty"`
+	SizeY int64 `protobuf:"varint,22,opt,name=SizeY,proto3" json:"SizeY,omitempty"`
+	SizeZ int64 `protobuf:"varint,23,opt,name=SizeZ,proto3" json:"SizeZ,omitempty"`
+}
+
+func (m *Tag) Reset()      { *m = Tag{} }
+func (*Tag) ProtoMessage() {}
+func (*Tag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e479d288f92766f, []int{26}
+}
+func (m *Tag) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Tag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Tag.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.PinTarget != nil {
-		{
-			size, err := m.PinTarget.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAmp(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+}
+func (m *Tag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tag.Merge(m, src)
+}
+func (m *Tag) XXX_Size() int {
+	return m.Size()
+}
+func (m *Tag) XXX_DiscardUnknown() {
+	xxx_messageInfo_Tag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Tag proto.InternalMessageInfo
+
+func (m *Tag) GetID_0() int64 {
+	if m != nil {
+		return m.ID_0
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *LaunchURL) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Tag) GetID_1() uint64 {
+	if m != nil {
+		return m.ID_1
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *LaunchURL) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Tag) GetID_2() uint64 {
+	if m != nil {
+		return m.ID_2
+	}
+	return 0
 }
 
-func (m *LaunchURL) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.URL) > 0 {
-		i -= len(m.URL)
-		copy(dAtA[i:], m.URL)
-		i = encodeVarintAmp(dAtA, i, uint64(len(m.URL)))
-		i--
-		dAtA[i] = 0xa
+func (m *Tag) GetContentType() string {
+	if m != nil {
+		return m.ContentType
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Tag) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Tag) GetUID() string {
+	if m != nil {
+		return m.UID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Tag) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Tag) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
 }
 
-func (m *Tag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SizeZ != 0 {
-		i = encodeVarintAmp(dAtA, i, uint64(m.SizeZ))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb8
-	}
-	if m.SizeY != 0 {
-		i = encodeVarintAmp(dAtA, i, uint64(m.SizeY))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb0
-	}
-	if m.SizeX != 0 {
-		i = encodeVarintAmp(dAtA, i, uint64(m.SizeX))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa8
-	}
-	if m.Metric != 0 {
-		i = encodeVarintAmp(dAtA, i, uint64(m.Metric))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa0
-	}
-	if len(m.URL) > 0 {
-		i -= len(m.URL)
-		copy(dAtA[i:], m.URL)
-		i = encodeVarintAmp(dAtA, i, uint64(len(m.URL)))
-		i--
-		dAtA[i] = 0x7a
-	}
-	if len(m.Text) > 0 {
-		i -= len(m.Text)
-		copy(dAtA[i:], m.Text)
-		i = encodeVarintAmp(dAtA, i, uint64(len(m.Text)))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if len(m.UID) > 0 {
-		i -= len(m.UID)
-		copy(dAtA[i:], m.UID)
-		i = encodeVarintAmp(dAtA, i, uint64(len(m.UID)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if len(m.ContentType) > 0 {
-		i -= len(m.ContentType)
-		copy(dAtA[i:], m.ContentType)
-		i = encodeVarintAmp(dAtA, i, uint64(len(m.ContentType)))
-		i--
-		dAtA[i] = 0x52
+func (m *Tag) GetURL() string {
+	if m != nil {
+		return m.URL
 	}
-	if m.ID_2 != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.ID_2))
-		i--
-		dAtA[i] = 0x21
+	return ""
+}
+
+func (m *Tag) GetMetric() Metric {
+	if m != nil {
+		return m.Metric
 	}
-	if m.ID_1 != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.ID_1))
-		i--
-		dAtA[i] = 0x19
+	return Metric_Nil
+}
+
+func (m *Tag) GetSizeX() int64 {
+	if m != nil {
+		return m.SizeX
 	}
-	if m.ID_0 != 0 {
-		i = encodeVarintAmp(dAtA, i, uint64(m.ID_0))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *Tag) GetSizeY() int64 {
+	if m != nil {
+		return m.SizeY
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Tags) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Tag) GetSizeZ() int64 {
+	if m != nil {
+		return m.SizeZ
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Tags) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Tags is a multi-purpose, automatically serializable and customable tree.
+//
+// All fields are optional and whose meaning is contextual.
+type Tags struct {
+	ID      *Tag    `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	SubTags []*Tags `protobuf:"bytes,8,rep,name=SubTags,proto3" json:"SubTags,omitempty"`
 }
 
-func (m *Tags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SubTags) > 0 {
-		for iNdEx := len(m.SubTags) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SubTags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAmp(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
+func (m *Tags) Reset()      { *m = Tags{} }
+func (*Tags) ProtoMessage() {}
+func (*Tags) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e479d288f92766f, []int{27}
+}
+func (m *Tags) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Tags) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Tags.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.ID != nil {
-		{
-			size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAmp(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *Tags) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tags.Merge(m, src)
+}
+func (m *Tags) XXX_Size() int {
+	return m.Size()
+}
+func (m *Tags) XXX_DiscardUnknown() {
+	xxx_messageInfo_Tags.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Tags proto.InternalMessageInfo
+
+func (m *Tags) GetID() *Tag {
+	if m != nil {
+		return m.ID
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *CryptoKey) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Tags) GetSubTags() []*Tags {
+	if m != nil {
+		return m.SubTags
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CryptoKey) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ArchiveManifest describes a cell archive: a portable export of a cell subtree, its tx history, and the assets it references.
+type ArchiveManifest struct {
+	Version   int32           `protobuf:"varint,1,opt,name=Version,proto3" json:"Version,omitempty"`
+	Root      *Tag            `protobuf:"bytes,2,opt,name=Root,proto3" json:"Root,omitempty"`
+	CreatedAt int64           `protobuf:"varint,3,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	Exporter  string          `protobuf:"bytes,4,opt,name=Exporter,proto3" json:"Exporter,omitempty"`
+	TxCount   int64           `protobuf:"varint,5,opt,name=TxCount,proto3" json:"TxCount,omitempty"`
+	Assets    []*ArchiveAsset `protobuf:"bytes,8,rep,name=Assets,proto3" json:"Assets,omitempty"`
 }
 
-func (m *CryptoKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.KeyBytes) > 0 {
-		i -= len(m.KeyBytes)
-		copy(dAtA[i:], m.KeyBytes)
-		i = encodeVarintAmp(dAtA, i, uint64(len(m.KeyBytes)))
-		i--
-		dAtA[i] = 0x22
+func (m *ArchiveManifest) Reset()      { *m = ArchiveManifest{} }
+func (*ArchiveManifest) ProtoMessage() {}
+func (*ArchiveManifest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e479d288f92766f, []int{28}
+}
+func (m *ArchiveManifest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchiveManifest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchiveManifest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.CryptoKitID != 0 {
-		i = encodeVarintAmp(dAtA, i, uint64(m.CryptoKitID))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *ArchiveManifest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveManifest.Merge(m, src)
+}
+func (m *ArchiveManifest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchiveManifest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveManifest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveManifest proto.InternalMessageInfo
+
+func (m *ArchiveManifest) GetVersion() int32 {
+	if m != nil {
+		return m.Version
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Err) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ArchiveManifest) GetRoot() *Tag {
+	if m != nil {
+		return m.Root
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Err) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ArchiveManifest) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
 }
 
-func (m *Err) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintAmp(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Level != 0 {
-		i = encodeVarintAmp(dAtA, i, uint64(m.Level))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Code != 0 {
-		i = encodeVarintAmp(dAtA, i, uint64(m.Code))
-		i--
-		dAtA[i] = 0x8
+func (m *ArchiveManifest) GetExporter() string {
+	if m != nil {
+		return m.Exporter
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintAmp(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAmp(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ArchiveManifest) GetTxCount() int64 {
+	if m != nil {
+		return m.TxCount
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (this *TxEnvelope) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ArchiveManifest) GetAssets() []*ArchiveAsset {
+	if m != nil {
+		return m.Assets
 	}
+	return nil
+}
 
-	that1, ok := that.(*TxEnvelope)
-	if !ok {
-		that2, ok := that.(TxEnvelope)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// ArchiveAsset describes an asset contained in a cell archive.
+type ArchiveAsset struct {
+	URI         string `protobuf:"bytes,1,opt,name=URI,proto3" json:"URI,omitempty"`
+	Path        string `protobuf:"bytes,2,opt,name=Path,proto3" json:"Path,omitempty"`
+	ContentType string `protobuf:"bytes,3,opt,name=ContentType,proto3" json:"ContentType,omitempty"`
+	ByteSize    int64  `protobuf:"varint,4,opt,name=ByteSize,proto3" json:"ByteSize,omitempty"`
+}
+
+func (m *ArchiveAsset) Reset()      { *m = ArchiveAsset{} }
+func (*ArchiveAsset) ProtoMessage() {}
+func (*ArchiveAsset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e479d288f92766f, []int{29}
+}
+func (m *ArchiveAsset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchiveAsset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchiveAsset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Status != that1.Status {
-		return false
-	}
-	if this.OpCount != that1.OpCount {
-		return false
-	}
-	if this.GenesisID_0 != that1.GenesisID_0 {
-		return false
-	}
-	if this.GenesisID_1 != that1.GenesisID_1 {
-		return false
-	}
-	if this.GenesisID_2 != that1.GenesisID_2 {
-		return false
-	}
-	if this.ContextID_0 != that1.ContextID_0 {
-		return false
-	}
-	if this.ContextID_1 != that1.ContextID_1 {
-		return false
+}
+func (m *ArchiveAsset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveAsset.Merge(m, src)
+}
+func (m *ArchiveAsset) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchiveAsset) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveAsset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveAsset proto.InternalMessageInfo
+
+func (m *ArchiveAsset) GetURI() string {
+	if m != nil {
+		return m.URI
 	}
-	if this.ContextID_2 != that1.ContextID_2 {
-		return false
+	return ""
+}
+
+func (m *ArchiveAsset) GetPath() string {
+	if m != nil {
+		return m.Path
 	}
-	if !this.From.Equal(that1.From) {
-		return false
+	return ""
+}
+
+func (m *ArchiveAsset) GetContentType() string {
+	if m != nil {
+		return m.ContentType
 	}
-	if !this.To.Equal(that1.To) {
-		return false
+	return ""
+}
+
+func (m *ArchiveAsset) GetByteSize() int64 {
+	if m != nil {
+		return m.ByteSize
 	}
-	if !this.Epoch.Equal(that1.Epoch) {
-		return false
+	return 0
+}
+
+type CryptoKey struct {
+	CryptoKitID CryptoKitID `protobuf:"varint,1,opt,name=CryptoKitID,proto3,enum=amp.CryptoKitID" json:"CryptoKitID,omitempty"`
+	KeyBytes    []byte      `protobuf:"bytes,4,opt,name=KeyBytes,proto3" json:"KeyBytes,omitempty"`
+}
+
+func (m *CryptoKey) Reset()      { *m = CryptoKey{} }
+func (*CryptoKey) ProtoMessage() {}
+func (*CryptoKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e479d288f92766f, []int{30}
+}
+func (m *CryptoKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CryptoKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CryptoKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if !this.Tags.Equal(that1.Tags) {
-		return false
+}
+func (m *CryptoKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CryptoKey.Merge(m, src)
+}
+func (m *CryptoKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *CryptoKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_CryptoKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CryptoKey proto.InternalMessageInfo
+
+func (m *CryptoKey) GetCryptoKitID() CryptoKitID {
+	if m != nil {
+		return m.CryptoKitID
 	}
-	return true
+	return CryptoKit_Nil
 }
-func (this *Login) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *CryptoKey) GetKeyBytes() []byte {
+	if m != nil {
+		return m.KeyBytes
 	}
+	return nil
+}
 
-	that1, ok := that.(*Login)
-	if !ok {
-		that2, ok := that.(Login)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// Err is a general purpose error / warning / log message.
+type Err struct {
+	// Identifies the type of error.
+	Code ErrCode `protobuf:"varint,1,opt,name=Code,proto3,enum=amp.ErrCode" json:"Code,omitempty"`
+	// Severity level
+	Level LogLevel `protobuf:"varint,2,opt,name=Level,proto3,enum=amp.LogLevel" json:"Level,omitempty"`
+	// human-readable info
+	Msg string `protobuf:"bytes,4,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	// How a client should react to this error, as set from Code when the error is made -- see ErrCode.Category().
+	Category ErrCategory `protobuf:"varint,5,opt,name=Category,proto3,enum=amp.ErrCategory" json:"Category,omitempty"`
+	// If set, the request may succeed if retried unchanged.
+	Retryable bool `protobuf:"varint,6,opt,name=Retryable,proto3" json:"Retryable,omitempty"`
+	// If non-zero, the suggested delay before a retry.
+	RetryAfterMs int64 `protobuf:"varint,7,opt,name=RetryAfterMs,proto3" json:"RetryAfterMs,omitempty"`
+	// If set, a message suitable for showing to the user (whereas Msg is for developers and logs) -- see UserMessage().
+	UserMsg string `protobuf:"bytes,8,opt,name=UserMsg,proto3" json:"UserMsg,omitempty"`
+}
+
+func (m *Err) Reset()      { *m = Err{} }
+func (*Err) ProtoMessage() {}
+func (*Err) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e479d288f92766f, []int{31}
+}
+func (m *Err) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Err) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Err.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.UserID.Equal(that1.UserID) {
-		return false
+}
+func (m *Err) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Err.Merge(m, src)
+}
+func (m *Err) XXX_Size() int {
+	return m.Size()
+}
+func (m *Err) XXX_DiscardUnknown() {
+	xxx_messageInfo_Err.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Err proto.InternalMessageInfo
+
+func (m *Err) GetCode() ErrCode {
+	if m != nil {
+		return m.Code
 	}
-	if !this.DeviceID.Equal(that1.DeviceID) {
-		return false
+	return ErrCode_NoErr
+}
+
+func (m *Err) GetLevel() LogLevel {
+	if m != nil {
+		return m.Level
 	}
-	if this.HostAddress != that1.HostAddress {
-		return false
+	return LogLevel_Error
+}
+
+func (m *Err) GetMsg() string {
+	if m != nil {
+		return m.Msg
 	}
-	if this.Tags != that1.Tags {
-		return false
+	return ""
+}
+
+func (m *Err) GetCategory() ErrCategory {
+	if m != nil {
+		return m.Category
 	}
-	if !this.Checkpoint.Equal(that1.Checkpoint) {
-		return false
+	return ErrCategory_Unknown
+}
+
+func (m *Err) GetRetryable() bool {
+	if m != nil {
+		return m.Retryable
 	}
-	return true
+	return false
 }
-func (this *LoginChallenge) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *Err) GetRetryAfterMs() int64 {
+	if m != nil {
+		return m.RetryAfterMs
 	}
+	return 0
+}
 
-	that1, ok := that.(*LoginChallenge)
+func (m *Err) GetUserMsg() string {
+	if m != nil {
+		return m.UserMsg
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("amp.Const", Const_name, Const_value)
+	proto.RegisterEnum("amp.TxOpCode", TxOpCode_name, TxOpCode_value)
+	proto.RegisterEnum("amp.TxField", TxField_name, TxField_value)
+	proto.RegisterEnum("amp.SelectOp", SelectOp_name, SelectOp_value)
+	proto.RegisterEnum("amp.OpStatus", OpStatus_name, OpStatus_value)
+	proto.RegisterEnum("amp.StateSync", StateSync_name, StateSync_value)
+	proto.RegisterEnum("amp.Enable", Enable_name, Enable_value)
+	proto.RegisterEnum("amp.UrlScheme", UrlScheme_name, UrlScheme_value)
+	proto.RegisterEnum("amp.Metric", Metric_name, Metric_value)
+	proto.RegisterEnum("amp.CryptoKitID", CryptoKitID_name, CryptoKitID_value)
+	proto.RegisterEnum("amp.ErrCode", ErrCode_name, ErrCode_value)
+	proto.RegisterEnum("amp.ErrCategory", ErrCategory_name, ErrCategory_value)
+	proto.RegisterEnum("amp.LogLevel", LogLevel_name, LogLevel_value)
+	proto.RegisterType((*TxEnvelope)(nil), "amp.TxEnvelope")
+	proto.RegisterType((*Login)(nil), "amp.Login")
+	proto.RegisterType((*Device)(nil), "amp.Device")
+	proto.RegisterType((*UserKey)(nil), "amp.UserKey")
+	proto.RegisterType((*LoginChallenge)(nil), "amp.LoginChallenge")
+	proto.RegisterType((*LoginResponse)(nil), "amp.LoginResponse")
+	proto.RegisterType((*PasskeyAssertion)(nil), "amp.PasskeyAssertion")
+	proto.RegisterType((*PasskeyCredential)(nil), "amp.PasskeyCredential")
+	proto.RegisterType((*LoginCheckpoint)(nil), "amp.LoginCheckpoint")
+	proto.RegisterType((*PinRequest)(nil), "amp.PinRequest")
+	proto.RegisterType((*PinUpdate)(nil), "amp.PinUpdate")
+	proto.RegisterType((*CellLease)(nil), "amp.CellLease")
+	proto.RegisterType((*Presence)(nil), "amp.Presence")
+	proto.RegisterType((*Notification)(nil), "amp.Notification")
+	proto.RegisterType((*NotificationAck)(nil), "amp.NotificationAck")
+	proto.RegisterType((*Badge)(nil), "amp.Badge")
+	proto.RegisterType((*ScheduledTx)(nil), "amp.ScheduledTx")
+	proto.RegisterType((*UndoRequest)(nil), "amp.UndoRequest")
+	proto.RegisterType((*UndoState)(nil), "amp.UndoState")
+	proto.RegisterType((*UploadRequest)(nil), "amp.UploadRequest")
+	proto.RegisterType((*UploadChunk)(nil), "amp.UploadChunk")
+	proto.RegisterType((*UploadStatus)(nil), "amp.UploadStatus")
+	proto.RegisterType((*PrefetchHint)(nil), "amp.PrefetchHint")
+	proto.RegisterType((*AssetPush)(nil), "amp.AssetPush")
+	proto.RegisterType((*Reauth)(nil), "amp.Reauth")
+	proto.RegisterType((*LaunchURL)(nil), "amp.LaunchURL")
+	proto.RegisterType((*Tag)(nil), "amp.Tag")
+	proto.RegisterType((*Tags)(nil), "amp.Tags")
+	proto.RegisterType((*ArchiveManifest)(nil), "amp.ArchiveManifest")
+	proto.RegisterType((*ArchiveAsset)(nil), "amp.ArchiveAsset")
+	proto.RegisterType((*CryptoKey)(nil), "amp.CryptoKey")
+	proto.RegisterType((*Err)(nil), "amp.Err")
+}
+
+func init() { proto.RegisterFile("amp/amp.proto", fileDescriptor_7e479d288f92766f) }
+
+var fileDescriptor_7e479d288f92766f = []byte{
+	// 3243 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x59, 0xcd, 0x73, 0x24, 0xc9,
+	0x55, 0x57, 0xf5, 0x97, 0xba, 0x53, 0x1f, 0x93, 0x2a, 0x6b, 0x34, 0xb5, 0xf2, 0x8c, 0x56, 0xd1,
+	0xbb, 0x58, 0x42, 0xb1, 0x1f, 0xa3, 0x36, 0x86, 0xe0, 0xc0, 0xa1, 0x25, 0xf5, 0xac, 0x1a, 0xeb,
+	0xa3, 0x29, 0xb5, 0xd6, 0xf6, 0x12, 0x61, 0x45, 0x4e, 0xd7, 0xeb, 0xee, 0x0a, 0x55, 0x67, 0x96,
+	0xb3, 0xb2, 0xe5, 0xd6, 0x9c, 0x88, 0x20, 0x1c, 0x80, 0x59, 0x8c, 0xcd, 0x81, 0xd3, 0x02, 0xf6,
+	0x01, 0xb0, 0xf7, 0xc4, 0x8d, 0x0b, 0x86, 0x30, 0xbe, 0x6c, 0x38, 0x38, 0xec, 0xd1, 0xc1, 0x89,
+	0x9d, 0xbd, 0xf8, 0x00, 0xc4, 0xfe, 0x07, 0x10, 0x2f, 0x2b, 0xab, 0xba, 0xaa, 0xd5, 0xbb, 0x3b,
+	0x01, 0xb7, 0x7c, 0xbf, 0x5f, 0x7e, 0xbc, 0xf7, 0xf2, 0xe5, 0x7b, 0x99, 0x55, 0x64, 0x85, 0x8d,
+	0xc2, 0x37, 0xd9, 0x28, 0x7c, 0x23, 0x94, 0x42, 0x09, 0xbb, 0xc8, 0x46, 0x61, 0xfd, 0xbb, 0x45,
+	0x42, 0xba, 0x93, 0x16, 0xbf, 0x81, 0x40, 0x84, 0x60, 0xff, 0x1a, 0xa9, 0x5c, 0x28, 0xa6, 0xc6,
+	0x91, 0x53, 0xd8, 0xb6, 0x76, 0x57, 0x1b, 0x2b, 0x6f, 0x60, 0xff, 0xf3, 0x30, 0x06, 0x5d, 0x43,
+	0xda, 0x0e, 0x59, 0x3c, 0x0f, 0x0f, 0xc5, 0x98, 0x2b, 0xa7, 0xb4, 0x6d, 0xed, 0x96, 0xdc, 0x44,
+	0xb4, 0x5f, 0x26, 0x4b, 0x6f, 0x01, 0x87, 0xc8, 0x8f, 0xda, 0x47, 0x57, 0x8f, 0x9d, 0xf2, 0xb6,
+	0xb5, 0x5b, 0x74, 0x49, 0x0a, 0x3d, 0xce, 0x77, 0xd8, 0x77, 0x2a, 0xdb, 0xd6, 0x6e, 0x25, 0xd3,
+	0x61, 0x3f, 0xdf, 0xa1, 0xe1, 0x2c, 0xce, 0x74, 0x68, 0x60, 0x87, 0x43, 0xc1, 0x15, 0x4c, 0x94,
+	0x5e, 0x82, 0xc4, 0x4b, 0xa4, 0xd0, 0xe3, 0x7c, 0x87, 0x7d, 0x67, 0x29, 0x9e, 0x21, 0x85, 0xf6,
+	0xf3, 0x1d, 0x1a, 0xce, 0xf2, 0x4c, 0x87, 0x86, 0xfd, 0x90, 0x94, 0x9e, 0x48, 0x31, 0x72, 0x56,
+	0xb7, 0xad, 0xdd, 0xa5, 0x46, 0x55, 0x3b, 0xa1, 0xcb, 0x06, 0xae, 0x46, 0x6d, 0x87, 0x14, 0xba,
+	0xc2, 0xb9, 0x37, 0xc3, 0x15, 0xba, 0xc2, 0xde, 0x22, 0xe5, 0x56, 0x28, 0x7a, 0x43, 0x87, 0xce,
+	0x90, 0x31, 0x6c, 0x3f, 0x22, 0xa5, 0x2e, 0x1b, 0x44, 0xce, 0x9a, 0xa6, 0x6b, 0x09, 0x1d, 0xb9,
+	0x1a, 0xae, 0xbf, 0x5f, 0x20, 0xe5, 0x13, 0x31, 0xf0, 0xb9, 0xbd, 0x4d, 0x2a, 0x97, 0x11, 0xc8,
+	0xf6, 0x91, 0x63, 0xcd, 0xcc, 0x64, 0x70, 0xfb, 0x55, 0x52, 0x3d, 0x82, 0x1b, 0xbf, 0x07, 0xed,
+	0x23, 0xa7, 0x3c, 0xd3, 0x27, 0x65, 0xec, 0x6d, 0xb2, 0x74, 0x2c, 0x22, 0xd5, 0xf4, 0x3c, 0x09,
+	0x51, 0xe4, 0x54, 0xb7, 0xad, 0xdd, 0x9a, 0x9b, 0x85, 0x6c, 0xdb, 0xa8, 0x54, 0xd3, 0x94, 0x6e,
+	0xdb, 0xbf, 0x41, 0xc8, 0xe1, 0x10, 0x7a, 0xd7, 0xa1, 0xf0, 0xb9, 0xd2, 0xee, 0x59, 0x6a, 0xac,
+	0xeb, 0xd9, 0xb5, 0x76, 0x53, 0xce, 0xcd, 0xf4, 0xb3, 0x1f, 0x93, 0xd5, 0xf6, 0x28, 0x04, 0x19,
+	0x09, 0xce, 0x94, 0x40, 0xdd, 0x67, 0xdd, 0x37, 0xc3, 0xdb, 0xaf, 0x90, 0x4a, 0x8b, 0x4b, 0x11,
+	0x04, 0xc6, 0x99, 0x4b, 0xba, 0x67, 0xac, 0xbc, 0x6b, 0x28, 0x7b, 0x83, 0x54, 0x9a, 0x9d, 0xf6,
+	0x57, 0xe1, 0x56, 0x3b, 0xb5, 0xe6, 0x1a, 0xa9, 0xfe, 0xdc, 0x22, 0x95, 0xb8, 0x6b, 0xce, 0x17,
+	0xd6, 0x67, 0xf8, 0x22, 0xf1, 0x69, 0xe1, 0x53, 0x7c, 0xfa, 0x90, 0xd4, 0x3a, 0xe3, 0xa7, 0x81,
+	0xdf, 0xc3, 0xd5, 0x8a, 0xdb, 0xd6, 0xee, 0xb2, 0x3b, 0x05, 0xec, 0x75, 0x52, 0x3e, 0x61, 0x4f,
+	0x21, 0xd0, 0x21, 0x5f, 0x73, 0x63, 0xc1, 0xde, 0x22, 0x24, 0x56, 0x14, 0xbc, 0xa6, 0x4a, 0xe2,
+	0x7d, 0x8a, 0x20, 0x7f, 0xc2, 0x22, 0x75, 0x01, 0xc0, 0x9b, 0x4a, 0x87, 0x7b, 0xd1, 0xcd, 0x20,
+	0xb8, 0xa6, 0x0b, 0x37, 0xe2, 0x5a, 0x0f, 0x5f, 0xd4, 0xf4, 0x14, 0xa8, 0xff, 0xc4, 0x22, 0x8b,
+	0xa8, 0x1c, 0xae, 0xff, 0xf9, 0x31, 0xb1, 0x45, 0xc8, 0x85, 0x3f, 0xe0, 0x3e, 0x1f, 0xa0, 0x01,
+	0x05, 0x6d, 0x40, 0x06, 0xd1, 0x3c, 0xb0, 0xc0, 0xf0, 0x45, 0xc3, 0xa7, 0x08, 0xf2, 0x87, 0x81,
+	0x0f, 0x5c, 0x1d, 0x43, 0xe0, 0x69, 0x33, 0xab, 0x6e, 0x06, 0x41, 0x5d, 0x0f, 0x25, 0x30, 0x95,
+	0x31, 0x75, 0x0a, 0xd4, 0x25, 0x59, 0x35, 0xe1, 0xc1, 0x82, 0x00, 0xf8, 0x00, 0x30, 0xb6, 0x8e,
+	0x59, 0x34, 0xd4, 0xfa, 0x2e, 0xbb, 0xba, 0x6d, 0x7f, 0x89, 0xac, 0xba, 0x10, 0xdc, 0xfa, 0x7c,
+	0xd0, 0x61, 0x52, 0xdd, 0x9a, 0xdd, 0xa8, 0xb9, 0x33, 0xa8, 0xfd, 0x2a, 0x59, 0x39, 0x94, 0xe0,
+	0x01, 0x57, 0x3e, 0x0b, 0xda, 0x47, 0x91, 0x53, 0xdc, 0x2e, 0xee, 0x2e, 0xbb, 0x79, 0xb0, 0xfe,
+	0x9e, 0x45, 0x56, 0xf4, 0xa2, 0x2e, 0x44, 0xa1, 0xe0, 0x11, 0xd8, 0x75, 0xb2, 0x8c, 0xeb, 0x24,
+	0xb2, 0x59, 0x3b, 0x87, 0xd9, 0x6f, 0x92, 0xc5, 0x0e, 0x8b, 0xa2, 0x6b, 0xe3, 0xa4, 0xa5, 0xc6,
+	0x7d, 0xed, 0x4a, 0x83, 0x35, 0xa3, 0x08, 0xa4, 0xf2, 0x05, 0x77, 0x93, 0x5e, 0xf6, 0x6f, 0x12,
+	0x72, 0x06, 0xdf, 0x4e, 0xc6, 0x14, 0xf5, 0x98, 0x8d, 0xec, 0x98, 0xa9, 0x56, 0x6e, 0xa6, 0x67,
+	0xfd, 0x17, 0x16, 0xa1, 0xb3, 0xb3, 0xa2, 0x86, 0x59, 0x23, 0x12, 0x0d, 0xb3, 0x98, 0xfd, 0x1a,
+	0x59, 0x6b, 0x8e, 0xd5, 0x10, 0xe5, 0x1e, 0x1e, 0x96, 0x23, 0xa6, 0x98, 0xd9, 0xd0, 0xbb, 0x04,
+	0xfa, 0x34, 0xde, 0x25, 0x94, 0x7e, 0xf7, 0xe2, 0xfc, 0xcc, 0xec, 0xed, 0x0c, 0x8a, 0xfb, 0x87,
+	0xd1, 0xc0, 0xd4, 0x58, 0x82, 0xde, 0xde, 0x65, 0x77, 0x0a, 0xe0, 0xee, 0x63, 0x1c, 0x1d, 0x33,
+	0xee, 0x05, 0xa0, 0xb7, 0x77, 0xd9, 0xcd, 0x20, 0xf5, 0x9f, 0x59, 0x64, 0xed, 0x8e, 0xb9, 0x2f,
+	0x64, 0x4d, 0xee, 0x5c, 0x15, 0x66, 0xcf, 0xd5, 0x5c, 0x5b, 0x8b, 0x2f, 0x6e, 0x6b, 0x69, 0xae,
+	0xad, 0xe9, 0x69, 0x2d, 0x67, 0x4e, 0x6b, 0xfd, 0x1f, 0x2d, 0x72, 0x6f, 0x26, 0x87, 0xa1, 0x76,
+	0x5d, 0x71, 0x0d, 0xbc, 0x7b, 0x1b, 0xc6, 0xe1, 0x52, 0x73, 0xa7, 0x00, 0x66, 0xd0, 0x66, 0xaf,
+	0x07, 0x51, 0xa4, 0x21, 0x13, 0xac, 0x59, 0x08, 0x3d, 0xe0, 0x42, 0x5f, 0x42, 0x34, 0x8c, 0xbb,
+	0x14, 0x75, 0x97, 0x1c, 0x86, 0x49, 0xac, 0x35, 0x09, 0x7d, 0x79, 0xab, 0xb5, 0x2d, 0xba, 0x46,
+	0x42, 0xdc, 0x9c, 0xe9, 0xa5, 0x38, 0xb9, 0xc5, 0x92, 0x4d, 0x49, 0xf1, 0xd2, 0x6d, 0xeb, 0xd4,
+	0x5b, 0x73, 0xb1, 0x59, 0xff, 0x37, 0x8b, 0x90, 0x0e, 0xc6, 0xf9, 0xb7, 0xc6, 0x10, 0x29, 0xfb,
+	0x4b, 0xa4, 0xd6, 0xf1, 0x79, 0x97, 0xc9, 0x01, 0xa8, 0x3b, 0xf9, 0x6c, 0x4a, 0x61, 0x6a, 0xec,
+	0xf8, 0xbc, 0xa9, 0x94, 0x8c, 0x9c, 0xd2, 0x76, 0x31, 0x9f, 0x1a, 0x13, 0xc6, 0x7e, 0x8d, 0xd4,
+	0xb0, 0xb2, 0xc3, 0xc5, 0x2d, 0xef, 0xe9, 0x1c, 0xb5, 0xda, 0x58, 0xd5, 0xdd, 0x52, 0xd4, 0x9d,
+	0x76, 0xc0, 0xa3, 0xf9, 0x35, 0xe6, 0xab, 0x27, 0x42, 0x9a, 0xf5, 0x17, 0x75, 0xa6, 0xc8, 0x83,
+	0x58, 0x43, 0x33, 0xb5, 0x2e, 0x53, 0x43, 0x75, 0xa9, 0xdb, 0xd7, 0xfa, 0x5f, 0x86, 0x1e, 0x53,
+	0xf0, 0x62, 0x4a, 0xd6, 0xbf, 0x63, 0x91, 0xda, 0x21, 0x04, 0xc1, 0x09, 0xb0, 0x08, 0xf7, 0xa5,
+	0x72, 0x2c, 0x02, 0x0f, 0xe4, 0xdd, 0x6c, 0x18, 0xe3, 0x78, 0x49, 0xe9, 0x8c, 0x65, 0x28, 0x22,
+	0x30, 0xbb, 0x96, 0x88, 0x18, 0xe9, 0xcd, 0xde, 0xb7, 0xc6, 0xbe, 0xd4, 0x89, 0xac, 0x18, 0xe7,
+	0xe4, 0x29, 0x82, 0x11, 0xa1, 0xf7, 0x07, 0xa2, 0xa6, 0x32, 0x1b, 0x36, 0x05, 0xea, 0xdf, 0x24,
+	0xd5, 0x8e, 0x84, 0x08, 0x78, 0x0f, 0x5e, 0x20, 0x27, 0x6f, 0x6a, 0xdb, 0xe2, 0xbb, 0x12, 0xaa,
+	0x51, 0x76, 0x53, 0x19, 0x63, 0xf4, 0xc2, 0xe7, 0x3d, 0x30, 0x2a, 0xc4, 0x42, 0xfd, 0x57, 0x16,
+	0x59, 0x3e, 0x13, 0xca, 0xef, 0x63, 0xd4, 0x63, 0xc2, 0x70, 0x48, 0x61, 0xce, 0x02, 0x05, 0x9d,
+	0xf0, 0xcb, 0xcd, 0x30, 0x9c, 0x53, 0xd1, 0x62, 0x38, 0xa3, 0x5e, 0xf1, 0x53, 0xd4, 0x5b, 0x27,
+	0xe5, 0xae, 0xaf, 0x02, 0x48, 0x8a, 0x9a, 0x16, 0x30, 0x71, 0x1f, 0x08, 0xef, 0xd6, 0x9c, 0x1d,
+	0xdd, 0xc6, 0xfd, 0x3c, 0xf1, 0xf9, 0xb5, 0x53, 0x99, 0x99, 0x49, 0xa3, 0xf9, 0xd2, 0xb0, 0x38,
+	0x53, 0x1a, 0x30, 0xcc, 0x2f, 0xfc, 0x00, 0xb8, 0xd2, 0x37, 0x90, 0xaa, 0x6b, 0xa4, 0xfa, 0x29,
+	0xb9, 0x97, 0xb5, 0xb4, 0xd9, 0xbb, 0xb6, 0x37, 0x49, 0x11, 0xb3, 0xbd, 0x35, 0x13, 0x06, 0x08,
+	0x7e, 0x9e, 0xb9, 0xf5, 0xdf, 0x21, 0xe5, 0x03, 0xe6, 0x0d, 0x60, 0xda, 0xd1, 0x9a, 0xef, 0x97,
+	0x75, 0x52, 0xce, 0xee, 0x48, 0x2c, 0xd4, 0x7f, 0x64, 0x91, 0xa5, 0x8b, 0xde, 0x10, 0xbc, 0x71,
+	0x00, 0x5e, 0x77, 0xf2, 0xff, 0xf0, 0xfb, 0x06, 0xa9, 0x3c, 0xf1, 0x25, 0xa4, 0xc1, 0x65, 0xa4,
+	0x4f, 0xb9, 0x42, 0xac, 0x92, 0x42, 0x77, 0x62, 0x12, 0x6e, 0xa1, 0x3b, 0xc1, 0x90, 0x69, 0x2a,
+	0x05, 0xa3, 0x50, 0x45, 0xda, 0xdb, 0x65, 0x37, 0x95, 0xeb, 0xbf, 0x45, 0x96, 0x2e, 0xb9, 0x27,
+	0x92, 0x34, 0x60, 0x93, 0x92, 0x0b, 0x9e, 0xd0, 0x4a, 0x56, 0x5d, 0xdd, 0xd6, 0x51, 0xa5, 0x20,
+	0x8c, 0x12, 0xe3, 0xb4, 0x50, 0xff, 0x43, 0x8b, 0xd4, 0x70, 0xa4, 0x3e, 0xc6, 0xf6, 0xc3, 0x58,
+	0x38, 0x82, 0x50, 0xc5, 0xe5, 0xb9, 0xec, 0x4e, 0x81, 0xf8, 0x4e, 0x92, 0xb0, 0xf1, 0x2c, 0x53,
+	0x20, 0x19, 0x1b, 0x1b, 0x12, 0x27, 0xbb, 0x29, 0x90, 0x8c, 0xcd, 0x9a, 0x39, 0x05, 0xea, 0x3f,
+	0xb7, 0xc8, 0xca, 0x65, 0x18, 0x08, 0xe6, 0x25, 0x16, 0x6c, 0x92, 0x6a, 0x0c, 0x18, 0x57, 0xd7,
+	0xdc, 0x54, 0x9e, 0xba, 0xab, 0x90, 0x75, 0xd7, 0xb6, 0xb9, 0xbd, 0x73, 0xa5, 0x33, 0x76, 0xac,
+	0x41, 0x16, 0x8a, 0x33, 0xba, 0x62, 0xc1, 0x85, 0xff, 0x0c, 0x92, 0xf3, 0x9b, 0x02, 0xd3, 0xcd,
+	0x2b, 0x7f, 0xea, 0xa1, 0xc1, 0x34, 0xd3, 0x3e, 0xba, 0x13, 0xea, 0x06, 0xaf, 0x5f, 0x93, 0xa5,
+	0x58, 0xc7, 0xc3, 0xe1, 0x98, 0x5f, 0x7f, 0xa6, 0x09, 0x1b, 0xa4, 0x72, 0xde, 0xef, 0x47, 0x26,
+	0x49, 0x17, 0x5d, 0x23, 0xe1, 0xc6, 0x65, 0xea, 0x9c, 0x6e, 0xa3, 0xb9, 0x4f, 0x7c, 0xce, 0x02,
+	0x73, 0xf3, 0x8a, 0x85, 0xfa, 0xbb, 0x16, 0x59, 0x8e, 0xa7, 0x33, 0x8f, 0xaf, 0xff, 0xcb, 0x72,
+	0x9b, 0xa4, 0x7a, 0x28, 0x46, 0x61, 0x00, 0x2a, 0x76, 0x58, 0xd5, 0x4d, 0x65, 0xac, 0x35, 0x87,
+	0xed, 0x23, 0xb3, 0x57, 0xd8, 0xc4, 0x33, 0xd8, 0x92, 0x32, 0xe7, 0x9f, 0x96, 0x94, 0x2e, 0x82,
+	0xf5, 0x09, 0x59, 0xee, 0x48, 0xe8, 0x83, 0xea, 0x0d, 0x8f, 0xb1, 0x7a, 0x4e, 0xbd, 0x65, 0xcd,
+	0xf7, 0x56, 0x5c, 0xcb, 0x4e, 0xcc, 0x1e, 0x62, 0x13, 0x33, 0xf3, 0x41, 0x20, 0x9e, 0x26, 0x97,
+	0xd0, 0x9a, 0x9b, 0x88, 0x3a, 0x5b, 0x4a, 0x5f, 0x48, 0x5f, 0xc5, 0x95, 0xb2, 0xec, 0xa6, 0x72,
+	0xfd, 0x8f, 0x2c, 0x52, 0xc3, 0x5b, 0x94, 0xea, 0x8c, 0xa3, 0x61, 0x32, 0xab, 0x35, 0x77, 0xd6,
+	0x42, 0x7e, 0xd6, 0xcf, 0x8f, 0x18, 0x9b, 0x94, 0x5a, 0x5d, 0x36, 0x30, 0x4e, 0xd0, 0x6d, 0x9c,
+	0xcf, 0x74, 0x31, 0x67, 0x33, 0x11, 0xeb, 0xef, 0x90, 0x8a, 0x0b, 0x6c, 0xac, 0x86, 0x33, 0x2f,
+	0x25, 0xeb, 0x05, 0x5f, 0x4a, 0xc6, 0xbf, 0x85, 0x79, 0xfe, 0x7d, 0x44, 0x6a, 0x27, 0x6c, 0xcc,
+	0x7b, 0x43, 0x34, 0xe9, 0x8e, 0x91, 0xf5, 0xff, 0xb1, 0x48, 0x11, 0x95, 0x5b, 0x23, 0x25, 0xfd,
+	0xfa, 0x8d, 0xb7, 0xb9, 0x88, 0xcf, 0xde, 0x18, 0xda, 0xd7, 0xe6, 0x55, 0x10, 0xda, 0x37, 0x50,
+	0xc3, 0x29, 0x25, 0x50, 0x63, 0xd6, 0x17, 0xe4, 0xae, 0x2f, 0x70, 0xd1, 0xf6, 0x51, 0x7a, 0xf7,
+	0x68, 0x1f, 0xe9, 0x37, 0x22, 0x4c, 0x94, 0xb3, 0x62, 0xde, 0x88, 0x30, 0x51, 0x89, 0x6a, 0xf7,
+	0xa6, 0xfe, 0x7f, 0x85, 0x54, 0x4e, 0x41, 0x49, 0xbf, 0xe7, 0xac, 0xeb, 0x1b, 0x44, 0xfc, 0x9a,
+	0x8b, 0x21, 0xd7, 0x50, 0x71, 0xc9, 0x7b, 0x06, 0x5f, 0x77, 0xee, 0x27, 0x25, 0xef, 0x19, 0x7c,
+	0x3d, 0x41, 0xbf, 0xe1, 0x6c, 0x4c, 0xd1, 0x6f, 0x24, 0xe8, 0x3b, 0xce, 0x83, 0x29, 0xfa, 0x4e,
+	0xbd, 0x15, 0xdf, 0x2b, 0x3e, 0x23, 0x3b, 0xbf, 0x42, 0x16, 0x2f, 0xc6, 0x4f, 0xb1, 0x93, 0x53,
+	0xdd, 0x2e, 0xe6, 0x1f, 0xda, 0x09, 0x53, 0xff, 0xc0, 0x22, 0xf7, 0x9a, 0xb2, 0x37, 0xf4, 0x6f,
+	0xe0, 0x94, 0x71, 0xbf, 0x8f, 0xb9, 0xc8, 0x21, 0x8b, 0x6f, 0x83, 0x8c, 0x7c, 0xc1, 0x4d, 0x4e,
+	0x4c, 0x44, 0x2c, 0x7e, 0xae, 0x10, 0x77, 0x6f, 0x5a, 0x1a, 0xcd, 0x17, 0xbf, 0xe2, 0x6c, 0xf1,
+	0xdb, 0x24, 0xd5, 0xd6, 0x24, 0x14, 0x52, 0x81, 0x34, 0xf1, 0x95, 0xca, 0xb8, 0x62, 0x77, 0x12,
+	0x97, 0xa2, 0xf8, 0x3d, 0x95, 0x88, 0xf6, 0xaf, 0x93, 0x8a, 0x0e, 0xf6, 0xc4, 0x86, 0x35, 0xbd,
+	0xa6, 0xd1, 0x58, 0x33, 0xae, 0xe9, 0x50, 0x97, 0x64, 0x39, 0x8b, 0x27, 0x97, 0xc7, 0x34, 0x6a,
+	0xda, 0xb8, 0x81, 0x1d, 0x66, 0x72, 0x79, 0xcd, 0xd5, 0xed, 0x17, 0x38, 0x14, 0x9b, 0xa4, 0x7a,
+	0x70, 0xab, 0x20, 0x93, 0x45, 0x53, 0xb9, 0xfe, 0xfb, 0x68, 0xf2, 0x6d, 0xa8, 0x04, 0x9e, 0xaf,
+	0x06, 0x59, 0x32, 0x82, 0xaf, 0xcc, 0x9e, 0xac, 0x36, 0xa8, 0x56, 0x38, 0x83, 0xbb, 0xd9, 0x4e,
+	0x38, 0xf9, 0x57, 0xe1, 0x16, 0xe7, 0x8b, 0xcc, 0x0d, 0x3e, 0x95, 0xf1, 0x06, 0x84, 0x67, 0xc1,
+	0xde, 0x26, 0xa5, 0x43, 0xe1, 0x81, 0x99, 0x70, 0x39, 0x39, 0x28, 0x88, 0xb9, 0x9a, 0xb1, 0x5f,
+	0x21, 0xe5, 0x13, 0xb8, 0x81, 0x20, 0xf7, 0xb9, 0xea, 0x44, 0x0c, 0x34, 0xe8, 0xc6, 0x1c, 0xfa,
+	0xe3, 0x34, 0x4a, 0xce, 0x36, 0x36, 0xed, 0xd7, 0x48, 0xf5, 0x90, 0x29, 0x18, 0x08, 0x19, 0xdf,
+	0x71, 0x12, 0x6d, 0x71, 0x72, 0x83, 0xbb, 0x69, 0x8f, 0xb8, 0xa4, 0x29, 0x79, 0xcb, 0x9e, 0x06,
+	0xa0, 0x6b, 0x42, 0xd5, 0x9d, 0x02, 0xf1, 0xf5, 0x5f, 0xc9, 0xdb, 0x66, 0x5f, 0x81, 0x3c, 0x8d,
+	0xcc, 0xe5, 0x27, 0x87, 0xe1, 0x36, 0xe3, 0x7d, 0x0b, 0xb5, 0x88, 0x3f, 0xc1, 0x24, 0xe2, 0xde,
+	0x0f, 0x2d, 0xbc, 0x8a, 0xf0, 0x48, 0xd9, 0xab, 0x84, 0xe8, 0xc6, 0xd5, 0x11, 0xf4, 0x23, 0xba,
+	0x60, 0x3f, 0x22, 0x4e, 0x2a, 0xb3, 0x71, 0xa0, 0x2e, 0x40, 0xe2, 0x87, 0x8c, 0x8e, 0x90, 0x8a,
+	0x7e, 0xb0, 0x6b, 0x3f, 0x20, 0x5f, 0x88, 0xe9, 0xee, 0xe4, 0x18, 0x98, 0x07, 0xf2, 0x0a, 0xf7,
+	0x85, 0x52, 0x7b, 0x93, 0x6c, 0xcc, 0x10, 0x26, 0x88, 0xe9, 0x97, 0xed, 0x87, 0xe4, 0xfe, 0x0c,
+	0x77, 0xca, 0xe4, 0x35, 0x48, 0xfa, 0xc9, 0xbf, 0x7f, 0xa7, 0x68, 0xdf, 0x27, 0x34, 0x66, 0xdb,
+	0xfc, 0x46, 0xc4, 0x37, 0x32, 0xfa, 0xd3, 0x47, 0x7b, 0xdf, 0xb3, 0x48, 0xb5, 0x3b, 0xc1, 0x0f,
+	0x7c, 0x1e, 0x26, 0x87, 0xe5, 0xa4, 0x7d, 0x75, 0xe6, 0x07, 0x74, 0x01, 0xd7, 0x4b, 0x91, 0xcb,
+	0x10, 0x1f, 0xb9, 0xad, 0x00, 0x46, 0xc0, 0x15, 0x2d, 0xe4, 0xb8, 0x23, 0xc0, 0x6a, 0x93, 0x70,
+	0x25, 0xfb, 0x25, 0x72, 0x3f, 0xc3, 0xf5, 0x41, 0x26, 0x54, 0xc5, 0x7e, 0x44, 0x5e, 0x4a, 0xa9,
+	0x56, 0x38, 0x84, 0x11, 0x48, 0x16, 0x24, 0x74, 0x75, 0xef, 0xc3, 0x02, 0x9e, 0x9a, 0x27, 0x3e,
+	0x7e, 0x92, 0xb8, 0x47, 0x96, 0x4c, 0xd3, 0xa8, 0xb3, 0x4e, 0x68, 0x02, 0xc4, 0xf5, 0xe7, 0xea,
+	0x31, 0xb5, 0xe6, 0xa0, 0xfb, 0xb4, 0x30, 0x07, 0x6d, 0xd0, 0x62, 0x16, 0xc5, 0x87, 0x87, 0x9e,
+	0xa1, 0x34, 0x07, 0xdd, 0xa7, 0xe5, 0x39, 0x68, 0x83, 0x56, 0xb2, 0x68, 0x5b, 0xc1, 0x48, 0xcf,
+	0xb0, 0x38, 0x07, 0xdd, 0xa7, 0xd5, 0x39, 0x68, 0x83, 0xd6, 0xb2, 0x68, 0xcb, 0xf3, 0xf5, 0x87,
+	0x4e, 0x4a, 0xe6, 0xa0, 0xfb, 0x74, 0x69, 0x0e, 0xda, 0xa0, 0xcb, 0xf6, 0x7d, 0xb2, 0x96, 0x3a,
+	0x66, 0x3c, 0xd2, 0x8d, 0x88, 0xae, 0x64, 0xe1, 0x53, 0x36, 0x31, 0xb0, 0xb3, 0x77, 0x42, 0xaa,
+	0x17, 0x10, 0x40, 0x4f, 0x9d, 0x87, 0x38, 0x5f, 0xd2, 0xbe, 0x3a, 0x83, 0xb1, 0x92, 0xcc, 0xf8,
+	0x35, 0x45, 0xdb, 0xbc, 0x17, 0x8c, 0x3d, 0xa0, 0x56, 0x0e, 0x6d, 0x4d, 0x62, 0xb4, 0xb0, 0xf7,
+	0xae, 0x45, 0xaa, 0xc9, 0x37, 0x63, 0x0c, 0xd4, 0xa4, 0x7d, 0x75, 0x26, 0xd4, 0x85, 0x62, 0x52,
+	0x81, 0x17, 0xcf, 0x98, 0x12, 0xf8, 0xae, 0xf4, 0xf9, 0x80, 0x5a, 0xf6, 0x1a, 0x59, 0x49, 0xd1,
+	0x83, 0x71, 0x74, 0x4b, 0x0b, 0xf6, 0x17, 0xc8, 0xbd, 0x5c, 0x47, 0xf0, 0xe2, 0x5d, 0x4a, 0xc1,
+	0x0e, 0x70, 0x0f, 0x47, 0x97, 0x72, 0x5d, 0x0f, 0x03, 0x11, 0x81, 0x47, 0x17, 0xf7, 0xdc, 0xcc,
+	0xeb, 0xd6, 0xb6, 0xc9, 0x6a, 0x2a, 0x5c, 0x9d, 0x09, 0x0e, 0x74, 0x01, 0x43, 0x71, 0x8a, 0xe9,
+	0x61, 0xe7, 0x1c, 0xdb, 0xd4, 0xb2, 0x37, 0x88, 0x3d, 0xa5, 0x4e, 0x99, 0xcf, 0x15, 0xf3, 0x39,
+	0x2d, 0xec, 0x7d, 0x13, 0x3f, 0x5d, 0xea, 0xf3, 0xbf, 0x4e, 0x68, 0xdc, 0xba, 0x3a, 0x61, 0x98,
+	0x3a, 0xcf, 0xfb, 0x7d, 0xba, 0x80, 0x8a, 0xe4, 0x51, 0x4e, 0xad, 0x0c, 0xd8, 0xec, 0x29, 0xff,
+	0x06, 0xce, 0x79, 0x1c, 0x84, 0x79, 0xb0, 0xdf, 0xa7, 0xc5, 0xbd, 0xf7, 0xf0, 0xba, 0x2e, 0x03,
+	0x7c, 0x8e, 0x8c, 0x00, 0x9d, 0x92, 0x0a, 0xd3, 0x63, 0x37, 0x85, 0x2e, 0xb9, 0x84, 0x9e, 0x18,
+	0x70, 0xff, 0x19, 0x78, 0xd4, 0x42, 0x1b, 0xa7, 0xdc, 0xb1, 0x52, 0x21, 0x2d, 0xe4, 0x31, 0xbc,
+	0x6e, 0xd2, 0x62, 0x1e, 0x7b, 0xe2, 0x07, 0x40, 0x4b, 0xf9, 0xa5, 0x9a, 0xa3, 0x90, 0x2e, 0xe6,
+	0xa1, 0xb7, 0x7c, 0x45, 0xe9, 0xde, 0xcf, 0xac, 0xa4, 0xd8, 0x63, 0xde, 0x8a, 0x5b, 0x46, 0xb1,
+	0xfb, 0x64, 0xcd, 0xc8, 0xe7, 0x52, 0x0d, 0x45, 0xc7, 0x9f, 0x40, 0x40, 0xad, 0x59, 0xf8, 0x14,
+	0x14, 0xc8, 0x38, 0x43, 0xe4, 0x60, 0x3f, 0x08, 0xfc, 0x91, 0xe6, 0x8a, 0x77, 0x66, 0x0a, 0x18,
+	0xbf, 0xa6, 0x25, 0xfb, 0x21, 0x71, 0x0c, 0x7c, 0x0c, 0x93, 0xb7, 0xa4, 0xef, 0x65, 0x06, 0x95,
+	0xed, 0x5d, 0xf2, 0xaa, 0x61, 0xbb, 0x92, 0x85, 0xf0, 0x4c, 0x1c, 0x09, 0x0f, 0x7a, 0x6c, 0x08,
+	0x9e, 0x14, 0x3c, 0xd3, 0xb3, 0xb2, 0xf7, 0x97, 0x56, 0xae, 0x6c, 0xa1, 0x99, 0xa9, 0x68, 0x6c,
+	0x79, 0x48, 0x9c, 0x29, 0x74, 0x01, 0x3d, 0x09, 0xea, 0x40, 0x4c, 0xae, 0xce, 0xd8, 0x61, 0x40,
+	0x3d, 0x9d, 0x69, 0x53, 0xb6, 0x19, 0xdd, 0x8e, 0x4e, 0xa3, 0x41, 0xcc, 0x41, 0x9e, 0x33, 0x9f,
+	0x60, 0x63, 0xae, 0x6f, 0x6f, 0x91, 0x97, 0xee, 0x72, 0xad, 0xa3, 0xc6, 0x57, 0xbe, 0xb2, 0xff,
+	0xdb, 0xf4, 0x17, 0xd6, 0xde, 0x0f, 0x16, 0xc9, 0xa2, 0x29, 0x73, 0xa8, 0x94, 0x69, 0x5e, 0x9d,
+	0x89, 0x96, 0x94, 0x74, 0xc1, 0x7e, 0x40, 0xec, 0x04, 0xba, 0xe4, 0x9c, 0x8d, 0xc0, 0x43, 0xfc,
+	0x8f, 0x77, 0x6c, 0x87, 0x7c, 0x21, 0x21, 0xda, 0x5c, 0x81, 0xe4, 0x2c, 0x40, 0xe6, 0x4f, 0x76,
+	0xec, 0x4d, 0x72, 0x7f, 0x3a, 0x24, 0x1a, 0x87, 0xfa, 0xf6, 0xe1, 0x9d, 0x87, 0xf4, 0xbb, 0x33,
+	0x9c, 0x3f, 0x0a, 0xe3, 0x34, 0x0b, 0x1e, 0xfd, 0xd3, 0x1d, 0x7b, 0x9d, 0xdc, 0x4b, 0xb8, 0xae,
+	0x3f, 0x02, 0x31, 0x56, 0xf4, 0xdd, 0x1d, 0xfb, 0x25, 0xb2, 0x9e, 0xa0, 0x17, 0xc3, 0xb1, 0x52,
+	0x3e, 0x1f, 0x1c, 0x89, 0x6f, 0x73, 0xfa, 0x67, 0x39, 0xea, 0x4c, 0xa8, 0x43, 0xc1, 0x39, 0xf4,
+	0x70, 0xae, 0xef, 0xed, 0x64, 0xd5, 0xc6, 0xaf, 0x7a, 0x4f, 0x98, 0x1f, 0x80, 0x47, 0xff, 0x3c,
+	0xa7, 0xb6, 0xbe, 0x34, 0x1b, 0xe6, 0xfb, 0x3b, 0xf6, 0x17, 0xc9, 0x46, 0xba, 0x10, 0x44, 0x58,
+	0xc3, 0xe2, 0xaf, 0x30, 0x1e, 0xfd, 0xc1, 0x0e, 0x56, 0xab, 0xcc, 0x52, 0x2e, 0x30, 0xef, 0x96,
+	0xfe, 0xc5, 0x8e, 0xfd, 0x90, 0x3c, 0x48, 0x60, 0xf3, 0xc4, 0x3c, 0x13, 0xea, 0x89, 0x18, 0x73,
+	0x8f, 0xbe, 0x97, 0x33, 0xd6, 0xb0, 0x26, 0x4b, 0xfc, 0x55, 0x4e, 0xc1, 0x83, 0xf4, 0x7d, 0x4a,
+	0xff, 0x3a, 0x47, 0xb4, 0xf9, 0x0d, 0x0b, 0x7c, 0xef, 0xd2, 0x6d, 0xd3, 0xbf, 0xc9, 0xa9, 0x70,
+	0xc0, 0xbc, 0xb7, 0x59, 0x30, 0x06, 0xfa, 0xc3, 0x79, 0xfd, 0xbb, 0x6c, 0x40, 0x7f, 0x94, 0xf3,
+	0x0e, 0x56, 0x8b, 0x54, 0xb1, 0xbf, 0xcd, 0xa9, 0x7d, 0x26, 0xd4, 0xd0, 0xe7, 0x83, 0xae, 0x38,
+	0x14, 0xa3, 0x91, 0xaf, 0xe8, 0xdf, 0xe5, 0x06, 0xc6, 0xa0, 0xf1, 0xd1, 0xdf, 0xe7, 0x2c, 0xba,
+	0x08, 0x59, 0x0f, 0xd2, 0x49, 0x7f, 0x9c, 0xf7, 0x9f, 0x12, 0x92, 0x0d, 0x00, 0xc7, 0x8d, 0x25,
+	0xd0, 0x9f, 0xe4, 0xdc, 0xde, 0x0c, 0xc3, 0x74, 0xd8, 0xfb, 0x39, 0xe6, 0x94, 0x05, 0x7d, 0x21,
+	0x47, 0xf8, 0x39, 0x84, 0xfe, 0xc3, 0x8e, 0xbd, 0x41, 0xd6, 0x32, 0x06, 0xeb, 0x8c, 0xc0, 0xe8,
+	0x3f, 0xe5, 0x46, 0x60, 0x6a, 0x49, 0x56, 0xf9, 0x69, 0x6e, 0x44, 0x7c, 0xe9, 0xc5, 0x88, 0xfc,
+	0xe7, 0x1c, 0xde, 0x49, 0xb7, 0xfc, 0x5f, 0xf2, 0x96, 0x42, 0x10, 0xa4, 0x6a, 0xfd, 0x6b, 0x6e,
+	0x91, 0x8e, 0x14, 0x37, 0xbe, 0x07, 0x12, 0x27, 0xfb, 0xf9, 0x8e, 0xfd, 0x32, 0xd9, 0x4c, 0x98,
+	0xb7, 0x7d, 0x11, 0x30, 0x05, 0x51, 0x33, 0x0c, 0x81, 0x7b, 0xe7, 0x3c, 0xb8, 0xa5, 0xff, 0xb9,
+	0x63, 0xbf, 0x4a, 0x5e, 0x9e, 0xee, 0x48, 0x34, 0xee, 0xf7, 0xfd, 0x9e, 0x0f, 0x5c, 0x75, 0x40,
+	0x8e, 0x7c, 0x1d, 0x57, 0x11, 0xfd, 0xaf, 0x9c, 0x2b, 0x7f, 0x6f, 0x2c, 0x14, 0x6b, 0x4d, 0x7a,
+	0x00, 0x1e, 0x78, 0xf4, 0xbf, 0x77, 0xf6, 0xde, 0x2f, 0x90, 0xa5, 0xcc, 0xed, 0x10, 0xab, 0x5a,
+	0x46, 0xbc, 0xba, 0xe4, 0xd7, 0x1c, 0x8f, 0xc0, 0x82, 0xed, 0x90, 0xf5, 0x2c, 0x91, 0x1c, 0x44,
+	0x6a, 0xcd, 0x0e, 0x31, 0xa1, 0x61, 0x2a, 0x40, 0x86, 0xc0, 0xd3, 0x41, 0x8b, 0x98, 0x41, 0xb2,
+	0xe8, 0x54, 0x57, 0x5a, 0x9a, 0x5d, 0x24, 0xf5, 0x52, 0xd9, 0xfe, 0x22, 0x79, 0x90, 0x65, 0x2e,
+	0x39, 0xbb, 0x61, 0x7e, 0x80, 0xe5, 0x85, 0x56, 0x30, 0xa1, 0x66, 0x49, 0x6d, 0x24, 0x5d, 0xbc,
+	0x3b, 0x26, 0xcd, 0x10, 0xb4, 0x3a, 0xbb, 0xd4, 0xa1, 0xe0, 0xfd, 0xc0, 0xef, 0x29, 0x5a, 0xbb,
+	0xc3, 0x30, 0xde, 0x03, 0xdc, 0x45, 0xb2, 0x77, 0x44, 0xaa, 0xc9, 0x1d, 0x1c, 0x6b, 0x4c, 0xd2,
+	0xbe, 0x6a, 0x49, 0x29, 0x30, 0x83, 0xad, 0x91, 0x95, 0x14, 0xfb, 0x1a, 0x93, 0x58, 0x05, 0xb3,
+	0x50, 0x9b, 0xf7, 0x05, 0x2d, 0x1d, 0x0c, 0x3f, 0xfc, 0x68, 0x6b, 0xe1, 0x97, 0x1f, 0x6d, 0x2d,
+	0x7c, 0xf2, 0xd1, 0x96, 0xf5, 0x07, 0xcf, 0xb7, 0xac, 0x1f, 0x3f, 0xdf, 0xb2, 0x3e, 0x78, 0xbe,
+	0x65, 0x7d, 0xf8, 0x7c, 0xcb, 0xfa, 0x8f, 0xe7, 0x5b, 0xd6, 0xaf, 0x9e, 0x6f, 0x2d, 0x7c, 0xf2,
+	0x7c, 0xcb, 0xfa, 0xfe, 0xc7, 0x5b, 0x0b, 0x1f, 0x7e, 0xbc, 0xb5, 0xf0, 0xcb, 0x8f, 0xb7, 0x16,
+	0xde, 0x79, 0x6d, 0xe0, 0xab, 0xe1, 0xf8, 0xe9, 0x1b, 0x3d, 0x31, 0x7a, 0x93, 0x49, 0xf5, 0xfa,
+	0x08, 0x3c, 0x9f, 0xbd, 0x1e, 0x06, 0x4c, 0x61, 0x20, 0xe3, 0xef, 0xef, 0xd7, 0x23, 0xef, 0xfa,
+	0xf5, 0x81, 0xc0, 0xe6, 0xfb, 0x85, 0x62, 0xf3, 0xb4, 0xf3, 0xb4, 0xa2, 0x7f, 0x88, 0x7f, 0xf9,
+	0x7f, 0x07, 0x00, 0xef, 0x4e, 0x22, 0xe3, 0x21, 0x1f, 0x00, 0x00,
+}
+
+func (x Const) String() string {
+	s, ok := Const_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x TxOpCode) String() string {
+	s, ok := TxOpCode_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x TxField) String() string {
+	s, ok := TxField_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x SelectOp) String() string {
+	s, ok := SelectOp_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x OpStatus) String() string {
+	s, ok := OpStatus_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x StateSync) String() string {
+	s, ok := StateSync_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x Enable) String() string {
+	s, ok := Enable_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x UrlScheme) String() string {
+	s, ok := UrlScheme_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x Metric) String() string {
+	s, ok := Metric_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x CryptoKitID) String() string {
+	s, ok := CryptoKitID_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x ErrCode) String() string {
+	s, ok := ErrCode_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x ErrCategory) String() string {
+	s, ok := ErrCategory_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x LogLevel) String() string {
+	s, ok := LogLevel_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *TxEnvelope) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TxEnvelope)
 	if !ok {
-		that2, ok := that.(LoginChallenge)
+		that2, ok := that.(TxEnvelope)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2327,19 +3353,52 @@ func (this *LoginChallenge) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.Hash, that1.Hash) {
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.OpCount != that1.OpCount {
+		return false
+	}
+	if this.GenesisID_0 != that1.GenesisID_0 {
+		return false
+	}
+	if this.GenesisID_1 != that1.GenesisID_1 {
+		return false
+	}
+	if this.GenesisID_2 != that1.GenesisID_2 {
+		return false
+	}
+	if this.ContextID_0 != that1.ContextID_0 {
+		return false
+	}
+	if this.ContextID_1 != that1.ContextID_1 {
+		return false
+	}
+	if this.ContextID_2 != that1.ContextID_2 {
+		return false
+	}
+	if !this.From.Equal(that1.From) {
+		return false
+	}
+	if !this.To.Equal(that1.To) {
+		return false
+	}
+	if !this.Epoch.Equal(that1.Epoch) {
+		return false
+	}
+	if !this.Tags.Equal(that1.Tags) {
 		return false
 	}
 	return true
 }
-func (this *LoginResponse) Equal(that interface{}) bool {
+func (this *Login) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*LoginResponse)
+	that1, ok := that.(*Login)
 	if !ok {
-		that2, ok := that.(LoginResponse)
+		that2, ok := that.(Login)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2351,19 +3410,40 @@ func (this *LoginResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.HashResponse, that1.HashResponse) {
+	if !this.UserID.Equal(that1.UserID) {
+		return false
+	}
+	if !this.DeviceID.Equal(that1.DeviceID) {
+		return false
+	}
+	if this.HostAddress != that1.HostAddress {
+		return false
+	}
+	if this.Tags != that1.Tags {
+		return false
+	}
+	if !this.Checkpoint.Equal(that1.Checkpoint) {
+		return false
+	}
+	if !this.ImpersonatorID.Equal(that1.ImpersonatorID) {
+		return false
+	}
+	if !this.Enroll.Equal(that1.Enroll) {
+		return false
+	}
+	if this.APIKey != that1.APIKey {
 		return false
 	}
 	return true
 }
-func (this *LoginCheckpoint) Equal(that interface{}) bool {
+func (this *Device) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*LoginCheckpoint)
+	that1, ok := that.(*Device)
 	if !ok {
-		that2, ok := that.(LoginCheckpoint)
+		that2, ok := that.(Device)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2375,34 +3455,37 @@ func (this *LoginCheckpoint) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.TokenType != that1.TokenType {
+	if !this.DeviceID.Equal(that1.DeviceID) {
 		return false
 	}
-	if this.AccessToken != that1.AccessToken {
+	if !this.UserID.Equal(that1.UserID) {
 		return false
 	}
-	if this.RefreshToken != that1.RefreshToken {
+	if !bytes.Equal(this.PublicKey, that1.PublicKey) {
 		return false
 	}
-	if this.Expiry != that1.Expiry {
+	if this.Label != that1.Label {
 		return false
 	}
-	if this.UserID != that1.UserID {
+	if this.EnrolledAt != that1.EnrolledAt {
 		return false
 	}
-	if this.URI != that1.URI {
+	if this.LastSeenAt != that1.LastSeenAt {
+		return false
+	}
+	if this.RevokedAt != that1.RevokedAt {
 		return false
 	}
 	return true
 }
-func (this *PinRequest) Equal(that interface{}) bool {
+func (this *UserKey) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PinRequest)
+	that1, ok := that.(*UserKey)
 	if !ok {
-		that2, ok := that.(PinRequest)
+		that2, ok := that.(UserKey)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2414,33 +3497,31 @@ func (this *PinRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.PinTarget.Equal(that1.PinTarget) {
+	if !this.UserID.Equal(that1.UserID) {
 		return false
 	}
-	if len(this.PinAttrs) != len(that1.PinAttrs) {
+	if !bytes.Equal(this.SigningKey, that1.SigningKey) {
 		return false
 	}
-	for i := range this.PinAttrs {
-		if !this.PinAttrs[i].Equal(that1.PinAttrs[i]) {
-			return false
-		}
+	if !bytes.Equal(this.SealingKey, that1.SealingKey) {
+		return false
 	}
-	if this.StateSync != that1.StateSync {
+	if this.ClientHeld != that1.ClientHeld {
 		return false
 	}
-	if !this.Tags.Equal(that1.Tags) {
+	if this.CreatedAt != that1.CreatedAt {
 		return false
 	}
 	return true
 }
-func (this *LaunchURL) Equal(that interface{}) bool {
+func (this *LoginChallenge) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*LaunchURL)
+	that1, ok := that.(*LoginChallenge)
 	if !ok {
-		that2, ok := that.(LaunchURL)
+		that2, ok := that.(LoginChallenge)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2452,19 +3533,30 @@ func (this *LaunchURL) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.URL != that1.URL {
+	if !bytes.Equal(this.Hash, that1.Hash) {
+		return false
+	}
+	if this.RelyingPartyID != that1.RelyingPartyID {
+		return false
+	}
+	if len(this.CredentialIDs) != len(that1.CredentialIDs) {
 		return false
 	}
+	for i := range this.CredentialIDs {
+		if !bytes.Equal(this.CredentialIDs[i], that1.CredentialIDs[i]) {
+			return false
+		}
+	}
 	return true
 }
-func (this *Tag) Equal(that interface{}) bool {
+func (this *LoginResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Tag)
+	that1, ok := that.(*LoginResponse)
 	if !ok {
-		that2, ok := that.(Tag)
+		that2, ok := that.(LoginResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2476,49 +3568,61 @@ func (this *Tag) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ID_0 != that1.ID_0 {
-		return false
-	}
-	if this.ID_1 != that1.ID_1 {
+	if !bytes.Equal(this.HashResponse, that1.HashResponse) {
 		return false
 	}
-	if this.ID_2 != that1.ID_2 {
+	if !this.Passkey.Equal(that1.Passkey) {
 		return false
 	}
-	if this.ContentType != that1.ContentType {
+	if !this.NewPasskey.Equal(that1.NewPasskey) {
 		return false
 	}
-	if this.UID != that1.UID {
-		return false
+	return true
+}
+func (this *PasskeyAssertion) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if this.Text != that1.Text {
+
+	that1, ok := that.(*PasskeyAssertion)
+	if !ok {
+		that2, ok := that.(PasskeyAssertion)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.URL != that1.URL {
+	if !bytes.Equal(this.CredentialID, that1.CredentialID) {
 		return false
 	}
-	if this.Metric != that1.Metric {
+	if !bytes.Equal(this.AuthenticatorData, that1.AuthenticatorData) {
 		return false
 	}
-	if this.SizeX != that1.SizeX {
+	if !bytes.Equal(this.ClientDataJSON, that1.ClientDataJSON) {
 		return false
 	}
-	if this.SizeY != that1.SizeY {
+	if !bytes.Equal(this.Signature, that1.Signature) {
 		return false
 	}
-	if this.SizeZ != that1.SizeZ {
+	if !bytes.Equal(this.UserHandle, that1.UserHandle) {
 		return false
 	}
 	return true
 }
-func (this *Tags) Equal(that interface{}) bool {
+func (this *PasskeyCredential) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Tags)
+	that1, ok := that.(*PasskeyCredential)
 	if !ok {
-		that2, ok := that.(Tags)
+		that2, ok := that.(PasskeyCredential)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2530,27 +3634,31 @@ func (this *Tags) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.ID.Equal(that1.ID) {
+	if !bytes.Equal(this.CredentialID, that1.CredentialID) {
 		return false
 	}
-	if len(this.SubTags) != len(that1.SubTags) {
+	if !bytes.Equal(this.PublicKey, that1.PublicKey) {
 		return false
 	}
-	for i := range this.SubTags {
-		if !this.SubTags[i].Equal(that1.SubTags[i]) {
-			return false
-		}
+	if !bytes.Equal(this.AuthenticatorData, that1.AuthenticatorData) {
+		return false
+	}
+	if !bytes.Equal(this.ClientDataJSON, that1.ClientDataJSON) {
+		return false
+	}
+	if this.Label != that1.Label {
+		return false
 	}
 	return true
 }
-func (this *CryptoKey) Equal(that interface{}) bool {
+func (this *LoginCheckpoint) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*CryptoKey)
+	that1, ok := that.(*LoginCheckpoint)
 	if !ok {
-		that2, ok := that.(CryptoKey)
+		that2, ok := that.(LoginCheckpoint)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2562,22 +3670,34 @@ func (this *CryptoKey) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.CryptoKitID != that1.CryptoKitID {
+	if this.TokenType != that1.TokenType {
 		return false
 	}
-	if !bytes.Equal(this.KeyBytes, that1.KeyBytes) {
+	if this.AccessToken != that1.AccessToken {
+		return false
+	}
+	if this.RefreshToken != that1.RefreshToken {
+		return false
+	}
+	if this.Expiry != that1.Expiry {
+		return false
+	}
+	if this.UserID != that1.UserID {
+		return false
+	}
+	if this.URI != that1.URI {
 		return false
 	}
 	return true
 }
-func (this *Err) Equal(that interface{}) bool {
+func (this *PinRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Err)
+	that1, ok := that.(*PinRequest)
 	if !ok {
-		that2, ok := that.(Err)
+		that2, ok := that.(PinRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2589,643 +3709,8575 @@ func (this *Err) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Code != that1.Code {
+	if !this.PinTarget.Equal(that1.PinTarget) {
 		return false
 	}
-	if this.Level != that1.Level {
+	if len(this.PinAttrs) != len(that1.PinAttrs) {
 		return false
 	}
-	if this.Msg != that1.Msg {
+	for i := range this.PinAttrs {
+		if !this.PinAttrs[i].Equal(that1.PinAttrs[i]) {
+			return false
+		}
+	}
+	if this.StateSync != that1.StateSync {
+		return false
+	}
+	if this.WaitForTarget != that1.WaitForTarget {
+		return false
+	}
+	if !this.Tags.Equal(that1.Tags) {
 		return false
 	}
 	return true
 }
-func (this *TxEnvelope) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *PinUpdate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 16)
-	s = append(s, "&amp.TxEnvelope{")
-	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
-	s = append(s, "OpCount: "+fmt.Sprintf("%#v", this.OpCount)+",\n")
-	s = append(s, "GenesisID_0: "+fmt.Sprintf("%#v", this.GenesisID_0)+",\n")
-	s = append(s, "GenesisID_1: "+fmt.Sprintf("%#v", this.GenesisID_1)+",\n")
-	s = append(s, "GenesisID_2: "+fmt.Sprintf("%#v", this.GenesisID_2)+",\n")
-	s = append(s, "ContextID_0: "+fmt.Sprintf("%#v", this.ContextID_0)+",\n")
-	s = append(s, "ContextID_1: "+fmt.Sprintf("%#v", this.ContextID_1)+",\n")
-	s = append(s, "ContextID_2: "+fmt.Sprintf("%#v", this.ContextID_2)+",\n")
-	if this.From != nil {
-		s = append(s, "From: "+fmt.Sprintf("%#v", this.From)+",\n")
+
+	that1, ok := that.(*PinUpdate)
+	if !ok {
+		that2, ok := that.(PinUpdate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.To != nil {
-		s = append(s, "To: "+fmt.Sprintf("%#v", this.To)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if this.Epoch != nil {
-		s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	if len(this.PinAttrs) != len(that1.PinAttrs) {
+		return false
 	}
-	if this.Tags != nil {
-		s = append(s, "Tags: "+fmt.Sprintf("%#v", this.Tags)+",\n")
+	for i := range this.PinAttrs {
+		if !this.PinAttrs[i].Equal(that1.PinAttrs[i]) {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *Login) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *CellLease) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&amp.Login{")
-	if this.UserID != nil {
-		s = append(s, "UserID: "+fmt.Sprintf("%#v", this.UserID)+",\n")
+
+	that1, ok := that.(*CellLease)
+	if !ok {
+		that2, ok := that.(CellLease)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.DeviceID != nil {
-		s = append(s, "DeviceID: "+fmt.Sprintf("%#v", this.DeviceID)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "HostAddress: "+fmt.Sprintf("%#v", this.HostAddress)+",\n")
-	s = append(s, "Tags: "+fmt.Sprintf("%#v", this.Tags)+",\n")
-	if this.Checkpoint != nil {
-		s = append(s, "Checkpoint: "+fmt.Sprintf("%#v", this.Checkpoint)+",\n")
+	if !this.Holder.Equal(that1.Holder) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *LoginChallenge) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Purpose != that1.Purpose {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&amp.LoginChallenge{")
-	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *LoginResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.AcquiredAt != that1.AcquiredAt {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&amp.LoginResponse{")
-	s = append(s, "HashResponse: "+fmt.Sprintf("%#v", this.HashResponse)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *LoginCheckpoint) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.ExpiresAt != that1.ExpiresAt {
+		return false
 	}
-	s := make([]string, 0, 10)
-	s = append(s, "&amp.LoginCheckpoint{")
-	s = append(s, "TokenType: "+fmt.Sprintf("%#v", this.TokenType)+",\n")
-	s = append(s, "AccessToken: "+fmt.Sprintf("%#v", this.AccessToken)+",\n")
-	s = append(s, "RefreshToken: "+fmt.Sprintf("%#v", this.RefreshToken)+",\n")
-	s = append(s, "Expiry: "+fmt.Sprintf("%#v", this.Expiry)+",\n")
-	s = append(s, "UserID: "+fmt.Sprintf("%#v", this.UserID)+",\n")
-	s = append(s, "URI: "+fmt.Sprintf("%#v", this.URI)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *PinRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *Presence) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&amp.PinRequest{")
-	if this.PinTarget != nil {
-		s = append(s, "PinTarget: "+fmt.Sprintf("%#v", this.PinTarget)+",\n")
+
+	that1, ok := that.(*Presence)
+	if !ok {
+		that2, ok := that.(Presence)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.PinAttrs != nil {
-		s = append(s, "PinAttrs: "+fmt.Sprintf("%#v", this.PinAttrs)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "StateSync: "+fmt.Sprintf("%#v", this.StateSync)+",\n")
-	if this.Tags != nil {
-		s = append(s, "Tags: "+fmt.Sprintf("%#v", this.Tags)+",\n")
+	if !this.UserID.Equal(that1.UserID) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *LaunchURL) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.PinCount != that1.PinCount {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&amp.LaunchURL{")
-	s = append(s, "URL: "+fmt.Sprintf("%#v", this.URL)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Tag) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Since != that1.Since {
+		return false
 	}
-	s := make([]string, 0, 15)
-	s = append(s, "&amp.Tag{")
-	s = append(s, "ID_0: "+fmt.Sprintf("%#v", this.ID_0)+",\n")
-	s = append(s, "ID_1: "+fmt.Sprintf("%#v", this.ID_1)+",\n")
-	s = append(s, "ID_2: "+fmt.Sprintf("%#v", this.ID_2)+",\n")
-	s = append(s, "ContentType: "+fmt.Sprintf("%#v", this.ContentType)+",\n")
-	s = append(s, "UID: "+fmt.Sprintf("%#v", this.UID)+",\n")
-	s = append(s, "Text: "+fmt.Sprintf("%#v", this.Text)+",\n")
-	s = append(s, "URL: "+fmt.Sprintf("%#v", this.URL)+",\n")
-	s = append(s, "Metric: "+fmt.Sprintf("%#v", this.Metric)+",\n")
-	s = append(s, "SizeX: "+fmt.Sprintf("%#v", this.SizeX)+",\n")
-	s = append(s, "SizeY: "+fmt.Sprintf("%#v", this.SizeY)+",\n")
-	s = append(s, "SizeZ: "+fmt.Sprintf("%#v", this.SizeZ)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *Tags) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 6)
-	s = append(s, "&amp.Tags{")
-	if this.ID != nil {
-		s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
-	}
-	if this.SubTags != nil {
-		s = append(s, "SubTags: "+fmt.Sprintf("%#v", this.SubTags)+",\n")
+func (this *Notification) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *CryptoKey) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*Notification)
+	if !ok {
+		that2, ok := that.(Notification)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&amp.CryptoKey{")
-	s = append(s, "CryptoKitID: "+fmt.Sprintf("%#v", this.CryptoKitID)+",\n")
-	s = append(s, "KeyBytes: "+fmt.Sprintf("%#v", this.KeyBytes)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Err) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&amp.Err{")
-	s = append(s, "Code: "+fmt.Sprintf("%#v", this.Code)+",\n")
-	s = append(s, "Level: "+fmt.Sprintf("%#v", this.Level)+",\n")
-	s = append(s, "Msg: "+fmt.Sprintf("%#v", this.Msg)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func valueToGoStringAmp(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if !this.ID.Equal(that1.ID) {
+		return false
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
-}
-func (m *TxEnvelope) Size() (n int) {
-	if m == nil {
-		return 0
+	if !this.AppID.Equal(that1.AppID) {
+		return false
 	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovAmp(uint64(m.Status))
+	if !this.UserID.Equal(that1.UserID) {
+		return false
 	}
-	if m.OpCount != 0 {
-		n += 1 + sovAmp(uint64(m.OpCount))
+	if this.Title != that1.Title {
+		return false
 	}
-	if m.GenesisID_0 != 0 {
-		n += 1 + sovAmp(uint64(m.GenesisID_0))
+	if this.Body != that1.Body {
+		return false
 	}
-	if m.GenesisID_1 != 0 {
-		n += 9
+	if !this.Link.Equal(that1.Link) {
+		return false
 	}
-	if m.GenesisID_2 != 0 {
-		n += 9
+	if this.CreatedAt != that1.CreatedAt {
+		return false
 	}
-	if m.ContextID_0 != 0 {
-		n += 1 + sovAmp(uint64(m.ContextID_0))
+	if this.Silent != that1.Silent {
+		return false
 	}
-	if m.ContextID_1 != 0 {
-		n += 9
+	return true
+}
+func (this *NotificationAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.ContextID_2 != 0 {
-		n += 9
+
+	that1, ok := that.(*NotificationAck)
+	if !ok {
+		that2, ok := that.(NotificationAck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.From != nil {
-		l = m.From.Size()
-		n += 1 + l + sovAmp(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.To != nil {
-		l = m.To.Size()
-		n += 1 + l + sovAmp(uint64(l))
+	if len(this.IDs) != len(that1.IDs) {
+		return false
 	}
-	if m.Epoch != nil {
-		l = m.Epoch.Size()
-		n += 2 + l + sovAmp(uint64(l))
+	for i := range this.IDs {
+		if !this.IDs[i].Equal(that1.IDs[i]) {
+			return false
+		}
 	}
-	if m.Tags != nil {
-		l = m.Tags.Size()
-		n += 2 + l + sovAmp(uint64(l))
+	if !this.AppID.Equal(that1.AppID) {
+		return false
 	}
-	return n
+	return true
 }
-
-func (m *Login) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.UserID != nil {
-		l = m.UserID.Size()
-		n += 1 + l + sovAmp(uint64(l))
+func (this *Badge) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.DeviceID != nil {
-		l = m.DeviceID.Size()
-		n += 1 + l + sovAmp(uint64(l))
+
+	that1, ok := that.(*Badge)
+	if !ok {
+		that2, ok := that.(Badge)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.HostAddress)
-	if l > 0 {
-		n += 1 + l + sovAmp(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Tags)
-	if l > 0 {
-		n += 1 + l + sovAmp(uint64(l))
+	if !this.AppID.Equal(that1.AppID) {
+		return false
 	}
-	if m.Checkpoint != nil {
-		l = m.Checkpoint.Size()
-		n += 1 + l + sovAmp(uint64(l))
+	if this.Count != that1.Count {
+		return false
 	}
-	return n
+	return true
 }
-
-func (m *LoginChallenge) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovAmp(uint64(l))
+func (this *ScheduledTx) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return n
-}
 
-func (m *LoginResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.HashResponse)
-	if l > 0 {
-		n += 1 + l + sovAmp(uint64(l))
+	that1, ok := that.(*ScheduledTx)
+	if !ok {
+		that2, ok := that.(ScheduledTx)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return n
-}
-
-func (m *LoginCheckpoint) Size() (n int) {
-	if m == nil {
-		return 0
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.TokenType)
-	if l > 0 {
-		n += 1 + l + sovAmp(uint64(l))
+	if !this.ID.Equal(that1.ID) {
+		return false
 	}
-	l = len(m.AccessToken)
-	if l > 0 {
-		n += 1 + l + sovAmp(uint64(l))
+	if !this.AppID.Equal(that1.AppID) {
+		return false
 	}
-	l = len(m.RefreshToken)
-	if l > 0 {
-		n += 1 + l + sovAmp(uint64(l))
+	if this.FireAt != that1.FireAt {
+		return false
 	}
-	if m.Expiry != 0 {
-		n += 1 + sovAmp(uint64(m.Expiry))
+	if this.Label != that1.Label {
+		return false
 	}
-	l = len(m.UserID)
-	if l > 0 {
-		n += 1 + l + sovAmp(uint64(l))
+	if !bytes.Equal(this.Tx, that1.Tx) {
+		return false
 	}
-	l = len(m.URI)
-	if l > 0 {
-		n += 1 + l + sovAmp(uint64(l))
+	if this.Attempts != that1.Attempts {
+		return false
 	}
-	return n
+	return true
 }
-
-func (m *PinRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PinTarget != nil {
-		l = m.PinTarget.Size()
-		n += 1 + l + sovAmp(uint64(l))
+func (this *UndoRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.PinAttrs) > 0 {
-		for _, e := range m.PinAttrs {
-			l = e.Size()
-			n += 1 + l + sovAmp(uint64(l))
+
+	that1, ok := that.(*UndoRequest)
+	if !ok {
+		that2, ok := that.(UndoRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.StateSync != 0 {
-		n += 1 + sovAmp(uint64(m.StateSync))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Tags != nil {
-		l = m.Tags.Size()
-		n += 2 + l + sovAmp(uint64(l))
+	if this.Redo != that1.Redo {
+		return false
 	}
-	return n
+	if this.Steps != that1.Steps {
+		return false
+	}
+	return true
 }
-
-func (m *LaunchURL) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *UndoState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	l = len(m.URL)
-	if l > 0 {
-		n += 1 + l + sovAmp(uint64(l))
-	}
-	return n
-}
 
-func (m *Tag) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*UndoState)
+	if !ok {
+		that2, ok := that.(UndoState)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.ID_0 != 0 {
-		n += 1 + sovAmp(uint64(m.ID_0))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.ID_1 != 0 {
-		n += 9
+	if this.UndoDepth != that1.UndoDepth {
+		return false
 	}
-	if m.ID_2 != 0 {
-		n += 9
+	if this.RedoDepth != that1.RedoDepth {
+		return false
 	}
-	l = len(m.ContentType)
-	if l > 0 {
-		n += 1 + l + sovAmp(uint64(l))
+	if this.UndoLabel != that1.UndoLabel {
+		return false
 	}
-	l = len(m.UID)
-	if l > 0 {
-		n += 1 + l + sovAmp(uint64(l))
+	if this.RedoLabel != that1.RedoLabel {
+		return false
 	}
-	l = len(m.Text)
-	if l > 0 {
-		n += 1 + l + sovAmp(uint64(l))
+	return true
+}
+func (this *UploadRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	l = len(m.URL)
-	if l > 0 {
-		n += 1 + l + sovAmp(uint64(l))
+
+	that1, ok := that.(*UploadRequest)
+	if !ok {
+		that2, ok := that.(UploadRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Metric != 0 {
-		n += 2 + sovAmp(uint64(m.Metric))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.SizeX != 0 {
-		n += 2 + sovAmp(uint64(m.SizeX))
+	if this.UploadID != that1.UploadID {
+		return false
 	}
-	if m.SizeY != 0 {
-		n += 2 + sovAmp(uint64(m.SizeY))
+	if this.Label != that1.Label {
+		return false
 	}
-	if m.SizeZ != 0 {
-		n += 2 + sovAmp(uint64(m.SizeZ))
+	if this.ContentType != that1.ContentType {
+		return false
 	}
-	return n
-}
-
-func (m *Tags) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.TotalSize != that1.TotalSize {
+		return false
 	}
-	var l int
-	_ = l
-	if m.ID != nil {
-		l = m.ID.Size()
-		n += 1 + l + sovAmp(uint64(l))
+	if !this.AppID.Equal(that1.AppID) {
+		return false
 	}
-	if len(m.SubTags) > 0 {
-		for _, e := range m.SubTags {
-			l = e.Size()
-			n += 1 + l + sovAmp(uint64(l))
-		}
+	if !this.CellID.Equal(that1.CellID) {
+		return false
 	}
-	return n
+	return true
 }
+func (this *UploadChunk) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CryptoKey) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*UploadChunk)
+	if !ok {
+		that2, ok := that.(UploadChunk)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.CryptoKitID != 0 {
-		n += 1 + sovAmp(uint64(m.CryptoKitID))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.KeyBytes)
-	if l > 0 {
-		n += 1 + l + sovAmp(uint64(l))
+	if this.UploadID != that1.UploadID {
+		return false
 	}
-	return n
+	if this.Offset != that1.Offset {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	if this.Final != that1.Final {
+		return false
+	}
+	return true
 }
+func (this *UploadStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Err) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*UploadStatus)
+	if !ok {
+		that2, ok := that.(UploadStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovAmp(uint64(m.Code))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Level != 0 {
-		n += 1 + sovAmp(uint64(m.Level))
+	if this.UploadID != that1.UploadID {
+		return false
 	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovAmp(uint64(l))
+	if this.Offset != that1.Offset {
+		return false
 	}
-	return n
+	if this.Complete != that1.Complete {
+		return false
+	}
+	if this.CID != that1.CID {
+		return false
+	}
+	if !this.Err.Equal(that1.Err) {
+		return false
+	}
+	return true
 }
+func (this *PrefetchHint) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func sovAmp(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozAmp(x uint64) (n int) {
-	return sovAmp(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *TxEnvelope) String() string {
-	if this == nil {
-		return "nil"
+	that1, ok := that.(*PrefetchHint)
+	if !ok {
+		that2, ok := that.(PrefetchHint)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := strings.Join([]string{`&TxEnvelope{`,
-		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
-		`OpCount:` + fmt.Sprintf("%v", this.OpCount) + `,`,
-		`GenesisID_0:` + fmt.Sprintf("%v", this.GenesisID_0) + `,`,
-		`GenesisID_1:` + fmt.Sprintf("%v", this.GenesisID_1) + `,`,
-		`GenesisID_2:` + fmt.Sprintf("%v", this.GenesisID_2) + `,`,
-		`ContextID_0:` + fmt.Sprintf("%v", this.ContextID_0) + `,`,
-		`ContextID_1:` + fmt.Sprintf("%v", this.ContextID_1) + `,`,
-		`ContextID_2:` + fmt.Sprintf("%v", this.ContextID_2) + `,`,
-		`From:` + strings.Replace(this.From.String(), "Tag", "Tag", 1) + `,`,
-		`To:` + strings.Replace(this.To.String(), "Tag", "Tag", 1) + `,`,
-		`Epoch:` + strings.Replace(this.Epoch.String(), "Tag", "Tag", 1) + `,`,
-		`Tags:` + strings.Replace(this.Tags.String(), "Tags", "Tags", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Login) String() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := strings.Join([]string{`&Login{`,
-		`UserID:` + strings.Replace(this.UserID.String(), "Tag", "Tag", 1) + `,`,
-		`DeviceID:` + strings.Replace(this.DeviceID.String(), "Tag", "Tag", 1) + `,`,
-		`HostAddress:` + fmt.Sprintf("%v", this.HostAddress) + `,`,
-		`Tags:` + fmt.Sprintf("%v", this.Tags) + `,`,
-		`Checkpoint:` + strings.Replace(this.Checkpoint.String(), "LoginCheckpoint", "LoginCheckpoint", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *LoginChallenge) String() string {
-	if this == nil {
-		return "nil"
+	if !this.CellID.Equal(that1.CellID) {
+		return false
 	}
-	s := strings.Join([]string{`&LoginChallenge{`,
-		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *LoginResponse) String() string {
-	if this == nil {
-		return "nil"
+	if this.URL != that1.URL {
+		return false
 	}
-	s := strings.Join([]string{`&LoginResponse{`,
-		`HashResponse:` + fmt.Sprintf("%v", this.HashResponse) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *LoginCheckpoint) String() string {
-	if this == nil {
-		return "nil"
+	if this.BlobKey != that1.BlobKey {
+		return false
 	}
-	s := strings.Join([]string{`&LoginCheckpoint{`,
-		`TokenType:` + fmt.Sprintf("%v", this.TokenType) + `,`,
-		`AccessToken:` + fmt.Sprintf("%v", this.AccessToken) + `,`,
-		`RefreshToken:` + fmt.Sprintf("%v", this.RefreshToken) + `,`,
-		`Expiry:` + fmt.Sprintf("%v", this.Expiry) + `,`,
-		`UserID:` + fmt.Sprintf("%v", this.UserID) + `,`,
-		`URI:` + fmt.Sprintf("%v", this.URI) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PinRequest) String() string {
-	if this == nil {
-		return "nil"
+	if this.Priority != that1.Priority {
+		return false
 	}
-	repeatedStringForPinAttrs := "[]*Tag{"
-	for _, f := range this.PinAttrs {
-		repeatedStringForPinAttrs += strings.Replace(f.String(), "Tag", "Tag", 1) + ","
+	return true
+}
+func (this *AssetPush) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	repeatedStringForPinAttrs += "}"
-	s := strings.Join([]string{`&PinRequest{`,
-		`PinTarget:` + strings.Replace(this.PinTarget.String(), "Tag", "Tag", 1) + `,`,
-		`PinAttrs:` + repeatedStringForPinAttrs + `,`,
-		`StateSync:` + fmt.Sprintf("%v", this.StateSync) + `,`,
-		`Tags:` + strings.Replace(this.Tags.String(), "Tag", "Tag", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+	that1, ok := that.(*AssetPush)
+	if !ok {
+		that2, ok := that.(AssetPush)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.URL != that1.URL {
+		return false
+	}
+	if this.BlobKey != that1.BlobKey {
+		return false
+	}
+	if this.ContentType != that1.ContentType {
+		return false
+	}
+	if this.ETag != that1.ETag {
+		return false
+	}
+	if !bytes.Equal(this.Content, that1.Content) {
+		return false
+	}
+	return true
 }
-func (this *LaunchURL) String() string {
-	if this == nil {
-		return "nil"
+func (this *Reauth) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&LaunchURL{`,
-		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
-		`}`,
-	}, "")
-	return s
+
+	that1, ok := that.(*Reauth)
+	if !ok {
+		that2, ok := that.(Reauth)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Checkpoint.Equal(that1.Checkpoint) {
+		return false
+	}
+	if !this.Err.Equal(that1.Err) {
+		return false
+	}
+	return true
 }
-func (this *Tag) String() string {
-	if this == nil {
-		return "nil"
+func (this *LaunchURL) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&Tag{`,
-		`ID_0:` + fmt.Sprintf("%v", this.ID_0) + `,`,
-		`ID_1:` + fmt.Sprintf("%v", this.ID_1) + `,`,
-		`ID_2:` + fmt.Sprintf("%v", this.ID_2) + `,`,
-		`ContentType:` + fmt.Sprintf("%v", this.ContentType) + `,`,
-		`UID:` + fmt.Sprintf("%v", this.UID) + `,`,
-		`Text:` + fmt.Sprintf("%v", this.Text) + `,`,
-		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
-		`Metric:` + fmt.Sprintf("%v", this.Metric) + `,`,
-		`SizeX:` + fmt.Sprintf("%v", this.SizeX) + `,`,
-		`SizeY:` + fmt.Sprintf("%v", this.SizeY) + `,`,
-		`SizeZ:` + fmt.Sprintf("%v", this.SizeZ) + `,`,
-		`}`,
-	}, "")
-	return s
+
+	that1, ok := that.(*LaunchURL)
+	if !ok {
+		that2, ok := that.(LaunchURL)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.URL != that1.URL {
+		return false
+	}
+	return true
 }
-func (this *Tags) String() string {
-	if this == nil {
-		return "nil"
+func (this *Tag) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	repeatedStringForSubTags := "[]*Tags{"
-	for _, f := range this.SubTags {
-		repeatedStringForSubTags += strings.Replace(f.String(), "Tags", "Tags", 1) + ","
+
+	that1, ok := that.(*Tag)
+	if !ok {
+		that2, ok := that.(Tag)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	repeatedStringForSubTags += "}"
-	s := strings.Join([]string{`&Tags{`,
-		`ID:` + strings.Replace(this.ID.String(), "Tag", "Tag", 1) + `,`,
-		`SubTags:` + repeatedStringForSubTags + `,`,
-		`}`,
-	}, "")
-	return s
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID_0 != that1.ID_0 {
+		return false
+	}
+	if this.ID_1 != that1.ID_1 {
+		return false
+	}
+	if this.ID_2 != that1.ID_2 {
+		return false
+	}
+	if this.ContentType != that1.ContentType {
+		return false
+	}
+	if this.UID != that1.UID {
+		return false
+	}
+	if this.Text != that1.Text {
+		return false
+	}
+	if this.URL != that1.URL {
+		return false
+	}
+	if this.Metric != that1.Metric {
+		return false
+	}
+	if this.SizeX != that1.SizeX {
+		return false
+	}
+	if this.SizeY != that1.SizeY {
+		return false
+	}
+	if this.SizeZ != that1.SizeZ {
+		return false
+	}
+	return true
 }
-func (this *CryptoKey) String() string {
-	if this == nil {
-		return "nil"
+func (this *Tags) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&CryptoKey{`,
-		`CryptoKitID:` + fmt.Sprintf("%v", this.CryptoKitID) + `,`,
-		`KeyBytes:` + fmt.Sprintf("%v", this.KeyBytes) + `,`,
-		`}`,
-	}, "")
-	return s
+
+	that1, ok := that.(*Tags)
+	if !ok {
+		that2, ok := that.(Tags)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ID.Equal(that1.ID) {
+		return false
+	}
+	if len(this.SubTags) != len(that1.SubTags) {
+		return false
+	}
+	for i := range this.SubTags {
+		if !this.SubTags[i].Equal(that1.SubTags[i]) {
+			return false
+		}
+	}
+	return true
 }
-func (this *Err) String() string {
-	if this == nil {
-		return "nil"
+func (this *ArchiveManifest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&Err{`,
-		`Code:` + fmt.Sprintf("%v", this.Code) + `,`,
-		`Level:` + fmt.Sprintf("%v", this.Level) + `,`,
-		`Msg:` + fmt.Sprintf("%v", this.Msg) + `,`,
-		`}`,
-	}, "")
-	return s
+
+	that1, ok := that.(*ArchiveManifest)
+	if !ok {
+		that2, ok := that.(ArchiveManifest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if !this.Root.Equal(that1.Root) {
+		return false
+	}
+	if this.CreatedAt != that1.CreatedAt {
+		return false
+	}
+	if this.Exporter != that1.Exporter {
+		return false
+	}
+	if this.TxCount != that1.TxCount {
+		return false
+	}
+	if len(this.Assets) != len(that1.Assets) {
+		return false
+	}
+	for i := range this.Assets {
+		if !this.Assets[i].Equal(that1.Assets[i]) {
+			return false
+		}
+	}
+	return true
 }
-func valueToStringAmp(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+func (this *ArchiveAsset) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+
+	that1, ok := that.(*ArchiveAsset)
+	if !ok {
+		that2, ok := that.(ArchiveAsset)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.URI != that1.URI {
+		return false
+	}
+	if this.Path != that1.Path {
+		return false
+	}
+	if this.ContentType != that1.ContentType {
+		return false
+	}
+	if this.ByteSize != that1.ByteSize {
+		return false
+	}
+	return true
+}
+func (this *CryptoKey) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CryptoKey)
+	if !ok {
+		that2, ok := that.(CryptoKey)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CryptoKitID != that1.CryptoKitID {
+		return false
+	}
+	if !bytes.Equal(this.KeyBytes, that1.KeyBytes) {
+		return false
+	}
+	return true
+}
+func (this *Err) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Err)
+	if !ok {
+		that2, ok := that.(Err)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Code != that1.Code {
+		return false
+	}
+	if this.Level != that1.Level {
+		return false
+	}
+	if this.Msg != that1.Msg {
+		return false
+	}
+	if this.Category != that1.Category {
+		return false
+	}
+	if this.Retryable != that1.Retryable {
+		return false
+	}
+	if this.RetryAfterMs != that1.RetryAfterMs {
+		return false
+	}
+	if this.UserMsg != that1.UserMsg {
+		return false
+	}
+	return true
+}
+func (this *TxEnvelope) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 16)
+	s = append(s, "&amp.TxEnvelope{")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "OpCount: "+fmt.Sprintf("%#v", this.OpCount)+",\n")
+	s = append(s, "GenesisID_0: "+fmt.Sprintf("%#v", this.GenesisID_0)+",\n")
+	s = append(s, "GenesisID_1: "+fmt.Sprintf("%#v", this.GenesisID_1)+",\n")
+	s = append(s, "GenesisID_2: "+fmt.Sprintf("%#v", this.GenesisID_2)+",\n")
+	s = append(s, "ContextID_0: "+fmt.Sprintf("%#v", this.ContextID_0)+",\n")
+	s = append(s, "ContextID_1: "+fmt.Sprintf("%#v", this.ContextID_1)+",\n")
+	s = append(s, "ContextID_2: "+fmt.Sprintf("%#v", this.ContextID_2)+",\n")
+	if this.From != nil {
+		s = append(s, "From: "+fmt.Sprintf("%#v", this.From)+",\n")
+	}
+	if this.To != nil {
+		s = append(s, "To: "+fmt.Sprintf("%#v", this.To)+",\n")
+	}
+	if this.Epoch != nil {
+		s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	}
+	if this.Tags != nil {
+		s = append(s, "Tags: "+fmt.Sprintf("%#v", this.Tags)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Login) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&amp.Login{")
+	if this.UserID != nil {
+		s = append(s, "UserID: "+fmt.Sprintf("%#v", this.UserID)+",\n")
+	}
+	if this.DeviceID != nil {
+		s = append(s, "DeviceID: "+fmt.Sprintf("%#v", this.DeviceID)+",\n")
+	}
+	s = append(s, "HostAddress: "+fmt.Sprintf("%#v", this.HostAddress)+",\n")
+	s = append(s, "Tags: "+fmt.Sprintf("%#v", this.Tags)+",\n")
+	if this.Checkpoint != nil {
+		s = append(s, "Checkpoint: "+fmt.Sprintf("%#v", this.Checkpoint)+",\n")
+	}
+	if this.ImpersonatorID != nil {
+		s = append(s, "ImpersonatorID: "+fmt.Sprintf("%#v", this.ImpersonatorID)+",\n")
+	}
+	if this.Enroll != nil {
+		s = append(s, "Enroll: "+fmt.Sprintf("%#v", this.Enroll)+",\n")
+	}
+	s = append(s, "APIKey: "+fmt.Sprintf("%#v", this.APIKey)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Device) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&amp.Device{")
+	if this.DeviceID != nil {
+		s = append(s, "DeviceID: "+fmt.Sprintf("%#v", this.DeviceID)+",\n")
+	}
+	if this.UserID != nil {
+		s = append(s, "UserID: "+fmt.Sprintf("%#v", this.UserID)+",\n")
+	}
+	s = append(s, "PublicKey: "+fmt.Sprintf("%#v", this.PublicKey)+",\n")
+	s = append(s, "Label: "+fmt.Sprintf("%#v", this.Label)+",\n")
+	s = append(s, "EnrolledAt: "+fmt.Sprintf("%#v", this.EnrolledAt)+",\n")
+	s = append(s, "LastSeenAt: "+fmt.Sprintf("%#v", this.LastSeenAt)+",\n")
+	s = append(s, "RevokedAt: "+fmt.Sprintf("%#v", this.RevokedAt)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UserKey) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&amp.UserKey{")
+	if this.UserID != nil {
+		s = append(s, "UserID: "+fmt.Sprintf("%#v", this.UserID)+",\n")
+	}
+	s = append(s, "SigningKey: "+fmt.Sprintf("%#v", this.SigningKey)+",\n")
+	s = append(s, "SealingKey: "+fmt.Sprintf("%#v", this.SealingKey)+",\n")
+	s = append(s, "ClientHeld: "+fmt.Sprintf("%#v", this.ClientHeld)+",\n")
+	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *LoginChallenge) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&amp.LoginChallenge{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "RelyingPartyID: "+fmt.Sprintf("%#v", this.RelyingPartyID)+",\n")
+	s = append(s, "CredentialIDs: "+fmt.Sprintf("%#v", this.CredentialIDs)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *LoginResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&amp.LoginResponse{")
+	s = append(s, "HashResponse: "+fmt.Sprintf("%#v", this.HashResponse)+",\n")
+	if this.Passkey != nil {
+		s = append(s, "Passkey: "+fmt.Sprintf("%#v", this.Passkey)+",\n")
+	}
+	if this.NewPasskey != nil {
+		s = append(s, "NewPasskey: "+fmt.Sprintf("%#v", this.NewPasskey)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PasskeyAssertion) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&amp.PasskeyAssertion{")
+	s = append(s, "CredentialID: "+fmt.Sprintf("%#v", this.CredentialID)+",\n")
+	s = append(s, "AuthenticatorData: "+fmt.Sprintf("%#v", this.AuthenticatorData)+",\n")
+	s = append(s, "ClientDataJSON: "+fmt.Sprintf("%#v", this.ClientDataJSON)+",\n")
+	s = append(s, "Signature: "+fmt.Sprintf("%#v", this.Signature)+",\n")
+	s = append(s, "UserHandle: "+fmt.Sprintf("%#v", this.UserHandle)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PasskeyCredential) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&amp.PasskeyCredential{")
+	s = append(s, "CredentialID: "+fmt.Sprintf("%#v", this.CredentialID)+",\n")
+	s = append(s, "PublicKey: "+fmt.Sprintf("%#v", this.PublicKey)+",\n")
+	s = append(s, "AuthenticatorData: "+fmt.Sprintf("%#v", this.AuthenticatorData)+",\n")
+	s = append(s, "ClientDataJSON: "+fmt.Sprintf("%#v", this.ClientDataJSON)+",\n")
+	s = append(s, "Label: "+fmt.Sprintf("%#v", this.Label)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *LoginCheckpoint) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&amp.LoginCheckpoint{")
+	s = append(s, "TokenType: "+fmt.Sprintf("%#v", this.TokenType)+",\n")
+	s = append(s, "AccessToken: "+fmt.Sprintf("%#v", this.AccessToken)+",\n")
+	s = append(s, "RefreshToken: "+fmt.Sprintf("%#v", this.RefreshToken)+",\n")
+	s = append(s, "Expiry: "+fmt.Sprintf("%#v", this.Expiry)+",\n")
+	s = append(s, "UserID: "+fmt.Sprintf("%#v", this.UserID)+",\n")
+	s = append(s, "URI: "+fmt.Sprintf("%#v", this.URI)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PinRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&amp.PinRequest{")
+	if this.PinTarget != nil {
+		s = append(s, "PinTarget: "+fmt.Sprintf("%#v", this.PinTarget)+",\n")
+	}
+	if this.PinAttrs != nil {
+		s = append(s, "PinAttrs: "+fmt.Sprintf("%#v", this.PinAttrs)+",\n")
+	}
+	s = append(s, "StateSync: "+fmt.Sprintf("%#v", this.StateSync)+",\n")
+	s = append(s, "WaitForTarget: "+fmt.Sprintf("%#v", this.WaitForTarget)+",\n")
+	if this.Tags != nil {
+		s = append(s, "Tags: "+fmt.Sprintf("%#v", this.Tags)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PinUpdate) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&amp.PinUpdate{")
+	if this.PinAttrs != nil {
+		s = append(s, "PinAttrs: "+fmt.Sprintf("%#v", this.PinAttrs)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CellLease) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&amp.CellLease{")
+	if this.Holder != nil {
+		s = append(s, "Holder: "+fmt.Sprintf("%#v", this.Holder)+",\n")
+	}
+	s = append(s, "Purpose: "+fmt.Sprintf("%#v", this.Purpose)+",\n")
+	s = append(s, "AcquiredAt: "+fmt.Sprintf("%#v", this.AcquiredAt)+",\n")
+	s = append(s, "ExpiresAt: "+fmt.Sprintf("%#v", this.ExpiresAt)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Presence) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&amp.Presence{")
+	if this.UserID != nil {
+		s = append(s, "UserID: "+fmt.Sprintf("%#v", this.UserID)+",\n")
+	}
+	s = append(s, "PinCount: "+fmt.Sprintf("%#v", this.PinCount)+",\n")
+	s = append(s, "Since: "+fmt.Sprintf("%#v", this.Since)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Notification) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&amp.Notification{")
+	if this.ID != nil {
+		s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	}
+	if this.AppID != nil {
+		s = append(s, "AppID: "+fmt.Sprintf("%#v", this.AppID)+",\n")
+	}
+	if this.UserID != nil {
+		s = append(s, "UserID: "+fmt.Sprintf("%#v", this.UserID)+",\n")
+	}
+	s = append(s, "Title: "+fmt.Sprintf("%#v", this.Title)+",\n")
+	s = append(s, "Body: "+fmt.Sprintf("%#v", this.Body)+",\n")
+	if this.Link != nil {
+		s = append(s, "Link: "+fmt.Sprintf("%#v", this.Link)+",\n")
+	}
+	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
+	s = append(s, "Silent: "+fmt.Sprintf("%#v", this.Silent)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NotificationAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&amp.NotificationAck{")
+	if this.IDs != nil {
+		s = append(s, "IDs: "+fmt.Sprintf("%#v", this.IDs)+",\n")
+	}
+	if this.AppID != nil {
+		s = append(s, "AppID: "+fmt.Sprintf("%#v", this.AppID)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Badge) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&amp.Badge{")
+	if this.AppID != nil {
+		s = append(s, "AppID: "+fmt.Sprintf("%#v", this.AppID)+",\n")
+	}
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ScheduledTx) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&amp.ScheduledTx{")
+	if this.ID != nil {
+		s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	}
+	if this.AppID != nil {
+		s = append(s, "AppID: "+fmt.Sprintf("%#v", this.AppID)+",\n")
+	}
+	s = append(s, "FireAt: "+fmt.Sprintf("%#v", this.FireAt)+",\n")
+	s = append(s, "Label: "+fmt.Sprintf("%#v", this.Label)+",\n")
+	s = append(s, "Tx: "+fmt.Sprintf("%#v", this.Tx)+",\n")
+	s = append(s, "Attempts: "+fmt.Sprintf("%#v", this.Attempts)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UndoRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&amp.UndoRequest{")
+	s = append(s, "Redo: "+fmt.Sprintf("%#v", this.Redo)+",\n")
+	s = append(s, "Steps: "+fmt.Sprintf("%#v", this.Steps)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UndoState) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&amp.UndoState{")
+	s = append(s, "UndoDepth: "+fmt.Sprintf("%#v", this.UndoDepth)+",\n")
+	s = append(s, "RedoDepth: "+fmt.Sprintf("%#v", this.RedoDepth)+",\n")
+	s = append(s, "UndoLabel: "+fmt.Sprintf("%#v", this.UndoLabel)+",\n")
+	s = append(s, "RedoLabel: "+fmt.Sprintf("%#v", this.RedoLabel)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UploadRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&amp.UploadRequest{")
+	s = append(s, "UploadID: "+fmt.Sprintf("%#v", this.UploadID)+",\n")
+	s = append(s, "Label: "+fmt.Sprintf("%#v", this.Label)+",\n")
+	s = append(s, "ContentType: "+fmt.Sprintf("%#v", this.ContentType)+",\n")
+	s = append(s, "TotalSize: "+fmt.Sprintf("%#v", this.TotalSize)+",\n")
+	if this.AppID != nil {
+		s = append(s, "AppID: "+fmt.Sprintf("%#v", this.AppID)+",\n")
+	}
+	if this.CellID != nil {
+		s = append(s, "CellID: "+fmt.Sprintf("%#v", this.CellID)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UploadChunk) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&amp.UploadChunk{")
+	s = append(s, "UploadID: "+fmt.Sprintf("%#v", this.UploadID)+",\n")
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "Final: "+fmt.Sprintf("%#v", this.Final)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UploadStatus) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&amp.UploadStatus{")
+	s = append(s, "UploadID: "+fmt.Sprintf("%#v", this.UploadID)+",\n")
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "Complete: "+fmt.Sprintf("%#v", this.Complete)+",\n")
+	s = append(s, "CID: "+fmt.Sprintf("%#v", this.CID)+",\n")
+	if this.Err != nil {
+		s = append(s, "Err: "+fmt.Sprintf("%#v", this.Err)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PrefetchHint) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&amp.PrefetchHint{")
+	if this.CellID != nil {
+		s = append(s, "CellID: "+fmt.Sprintf("%#v", this.CellID)+",\n")
+	}
+	s = append(s, "URL: "+fmt.Sprintf("%#v", this.URL)+",\n")
+	s = append(s, "BlobKey: "+fmt.Sprintf("%#v", this.BlobKey)+",\n")
+	s = append(s, "Priority: "+fmt.Sprintf("%#v", this.Priority)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AssetPush) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&amp.AssetPush{")
+	s = append(s, "URL: "+fmt.Sprintf("%#v", this.URL)+",\n")
+	s = append(s, "BlobKey: "+fmt.Sprintf("%#v", this.BlobKey)+",\n")
+	s = append(s, "ContentType: "+fmt.Sprintf("%#v", this.ContentType)+",\n")
+	s = append(s, "ETag: "+fmt.Sprintf("%#v", this.ETag)+",\n")
+	s = append(s, "Content: "+fmt.Sprintf("%#v", this.Content)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Reauth) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&amp.Reauth{")
+	if this.Checkpoint != nil {
+		s = append(s, "Checkpoint: "+fmt.Sprintf("%#v", this.Checkpoint)+",\n")
+	}
+	if this.Err != nil {
+		s = append(s, "Err: "+fmt.Sprintf("%#v", this.Err)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *LaunchURL) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&amp.LaunchURL{")
+	s = append(s, "URL: "+fmt.Sprintf("%#v", this.URL)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Tag) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 15)
+	s = append(s, "&amp.Tag{")
+	s = append(s, "ID_0: "+fmt.Sprintf("%#v", this.ID_0)+",\n")
+	s = append(s, "ID_1: "+fmt.Sprintf("%#v", this.ID_1)+",\n")
+	s = append(s, "ID_2: "+fmt.Sprintf("%#v", this.ID_2)+",\n")
+	s = append(s, "ContentType: "+fmt.Sprintf("%#v", this.ContentType)+",\n")
+	s = append(s, "UID: "+fmt.Sprintf("%#v", this.UID)+",\n")
+	s = append(s, "Text: "+fmt.Sprintf("%#v", this.Text)+",\n")
+	s = append(s, "URL: "+fmt.Sprintf("%#v", this.URL)+",\n")
+	s = append(s, "Metric: "+fmt.Sprintf("%#v", this.Metric)+",\n")
+	s = append(s, "SizeX: "+fmt.Sprintf("%#v", this.SizeX)+",\n")
+	s = append(s, "SizeY: "+fmt.Sprintf("%#v", this.SizeY)+",\n")
+	s = append(s, "SizeZ: "+fmt.Sprintf("%#v", this.SizeZ)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Tags) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&amp.Tags{")
+	if this.ID != nil {
+		s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	}
+	if this.SubTags != nil {
+		s = append(s, "SubTags: "+fmt.Sprintf("%#v", this.SubTags)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ArchiveManifest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&amp.ArchiveManifest{")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	if this.Root != nil {
+		s = append(s, "Root: "+fmt.Sprintf("%#v", this.Root)+",\n")
+	}
+	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
+	s = append(s, "Exporter: "+fmt.Sprintf("%#v", this.Exporter)+",\n")
+	s = append(s, "TxCount: "+fmt.Sprintf("%#v", this.TxCount)+",\n")
+	if this.Assets != nil {
+		s = append(s, "Assets: "+fmt.Sprintf("%#v", this.Assets)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ArchiveAsset) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&amp.ArchiveAsset{")
+	s = append(s, "URI: "+fmt.Sprintf("%#v", this.URI)+",\n")
+	s = append(s, "Path: "+fmt.Sprintf("%#v", this.Path)+",\n")
+	s = append(s, "ContentType: "+fmt.Sprintf("%#v", this.ContentType)+",\n")
+	s = append(s, "ByteSize: "+fmt.Sprintf("%#v", this.ByteSize)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CryptoKey) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&amp.CryptoKey{")
+	s = append(s, "CryptoKitID: "+fmt.Sprintf("%#v", this.CryptoKitID)+",\n")
+	s = append(s, "KeyBytes: "+fmt.Sprintf("%#v", this.KeyBytes)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Err) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&amp.Err{")
+	s = append(s, "Code: "+fmt.Sprintf("%#v", this.Code)+",\n")
+	s = append(s, "Level: "+fmt.Sprintf("%#v", this.Level)+",\n")
+	s = append(s, "Msg: "+fmt.Sprintf("%#v", this.Msg)+",\n")
+	s = append(s, "Category: "+fmt.Sprintf("%#v", this.Category)+",\n")
+	s = append(s, "Retryable: "+fmt.Sprintf("%#v", this.Retryable)+",\n")
+	s = append(s, "RetryAfterMs: "+fmt.Sprintf("%#v", this.RetryAfterMs)+",\n")
+	s = append(s, "UserMsg: "+fmt.Sprintf("%#v", this.UserMsg)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringAmp(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *TxEnvelope) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxEnvelope) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxEnvelope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tags != nil {
+		{
+			size, err := m.Tags.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAmp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.Epoch != nil {
+		{
+			size, err := m.Epoch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAmp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.To != nil {
+		{
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAmp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.From != nil {
+		{
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAmp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.ContextID_2 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.ContextID_2))
+		i--
+		dAtA[i] = 0x61
+	}
+	if m.ContextID_1 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.ContextID_1))
+		i--
+		dAtA[i] = 0x59
+	}
+	if m.ContextID_0 != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.ContextID_0))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.GenesisID_2 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.GenesisID_2))
+		i--
+		dAtA[i] = 0x39
+	}
+	if m.GenesisID_1 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.GenesisID_1))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.GenesisID_0 != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.GenesisID_0))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OpCount != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.OpCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Status != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Login) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Login) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Login) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.APIKey) > 0 {
+		i -= len(m.APIKey)
+		copy(dAtA[i:], m.APIKey)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.APIKey)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.Enroll != nil {
+		{
+			size, err := m.Enroll.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAmp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.ImpersonatorID != nil {
+		{
+			size, err := m.ImpersonatorID.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAmp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Checkpoint != nil {
+		{
+			size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAmp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Tags) > 0 {
+		i -= len(m.Tags)
+		copy(dAtA[i:], m.Tags)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.Tags)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.HostAddress) > 0 {
+		i -= len(m.HostAddress)
+		copy(dAtA[i:], m.HostAddress)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.HostAddress)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.DeviceID != nil {
+		{
+			size, err := m.DeviceID.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAmp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.UserID != nil {
+		{
+			size, err := m.UserID.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAmp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Device) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Device) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Device) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RevokedAt != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.RevokedAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LastSeenAt != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.LastSeenAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EnrolledAt != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.EnrolledAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.UserID != nil {
+		{
+			size, err := m.UserID.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAmp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DeviceID != nil {
+		{
+			size, err := m.DeviceID.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAmp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedAt != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ClientHeld {
+		i--
+		if m.ClientHeld {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SealingKey) > 0 {
+		i -= len(m.SealingKey)
+		copy(dAtA[i:], m.SealingKey)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.SealingKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SigningKey) > 0 {
+		i -= len(m.SigningKey)
+		copy(dAtA[i:], m.SigningKey)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.SigningKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.UserID != nil {
+		{
+			size, err := m.UserID.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAmp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LoginChallenge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LoginChallenge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LoginChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CredentialIDs) > 0 {
+		for iNdEx := len(m.CredentialIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CredentialIDs[iNdEx])
+			copy(dAtA[i:], m.CredentialIDs[iNdEx])
+			i = encodeVarintAmp(dAtA, i, uint64(len(m.CredentialIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RelyingPartyID) > 0 {
+		i -= len(m.RelyingPartyID)
+		copy(dAtA[i:], m.RelyingPartyID)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.RelyingPartyID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LoginResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LoginResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LoginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewPasskey != nil {
+		{
+			size, err := m.NewPasskey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAmp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Passkey != nil {
+		{
+			size, err := m.Passkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAmp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HashResponse) > 0 {
+		i -= len(m.HashResponse)
+		copy(dAtA[i:], m.HashResponse)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.HashResponse)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PasskeyAssertion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PasskeyAssertion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PasskeyAssertion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UserHandle) > 0 {
+		i -= len(m.UserHandle)
+		copy(dAtA[i:], m.UserHandle)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.UserHandle)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ClientDataJSON) > 0 {
+		i -= len(m.ClientDataJSON)
+		copy(dAtA[i:], m.ClientDataJSON)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.ClientDataJSON)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AuthenticatorData) > 0 {
+		i -= len(m.AuthenticatorData)
+		copy(dAtA[i:], m.AuthenticatorData)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.AuthenticatorData)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CredentialID) > 0 {
+		i -= len(m.CredentialID)
+		copy(dAtA[i:], m.CredentialID)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.CredentialID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PasskeyCredential) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PasskeyCredential) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PasskeyCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ClientDataJSON) > 0 {
+		i -= len(m.ClientDataJSON)
+		copy(dAtA[i:], m.ClientDataJSON)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.ClientDataJSON)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AuthenticatorData) > 0 {
+		i -= len(m.AuthenticatorData)
+		copy(dAtA[i:], m.AuthenticatorData)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.AuthenticatorData)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CredentialID) > 0 {
+		i -= len(m.CredentialID)
+		copy(dAtA[i:], m.CredentialID)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.CredentialID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LoginCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LoginCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LoginCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.URI) > 0 {
+		i -= len(m.URI)
+		copy(dAtA[i:], m.URI)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.URI)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.UserID) > 0 {
+		i -= len(m.UserID)
+		copy(dAtA[i:], m.UserID)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.UserID)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Expiry != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.Expiry))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RefreshToken) > 0 {
+		i -= len(m.RefreshToken)
+		copy(dAtA[i:], m.RefreshToken)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.RefreshToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AccessToken) > 0 {
+		i -= len(m.AccessToken)
+		copy(dAtA[i:], m.AccessToken)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.AccessToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenType) > 0 {
+		i -= len(m.TokenType)
+		copy(dAtA[i:], m.TokenType)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.TokenType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PinRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PinRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PinRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tags != nil {
+		{
+			size, err := m.Tags.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAmp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.WaitForTarget {
+		i--
+		if m.WaitForTarget {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StateSync != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.StateSync))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.PinAttrs) > 0 {
+		for iNdEx := len(m.PinAttrs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PinAttrs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAmp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.PinTarget != nil {
+		{
+			size, err := m.PinTarget.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAmp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PinUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PinUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PinUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PinAttrs) > 0 {
+		for iNdEx := len(m.PinAttrs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PinAttrs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAmp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CellLease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CellLease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CellLease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AcquiredAt != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.AcquiredAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Purpose) > 0 {
+		i -= len(m.Purpose)
+		copy(dAtA[i:], m.Purpose)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.Purpose)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Holder != nil {
+		{
+			size, err := m.Holder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAmp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Presence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Presence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Presence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Since != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.Since))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PinCount != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.PinCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.UserID != nil {
+		{
+			size, err := m.UserID.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAmp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Notification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Notification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Notification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Silent {
+		i--
+		if m.Silent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Link != nil {
+		{
+			size, err := m.Link.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAmp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UserID != nil {
+		{
+			size, err := m.UserID.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAmp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AppID != nil {
+		{
+			size, err := m.AppID.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAmp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != nil {
+		{
+			size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAmp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NotificationAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NotificationAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NotificationAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AppID != nil {
+		{
+			size, err := m.AppID.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAmp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IDs) > 0 {
+		for iNdEx := len(m.IDs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IDs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAmp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Badge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Badge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Badge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AppID != nil {
+		{
+			size, err := m.AppID.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAmp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Attempts != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Tx) > 0 {
+		i -= len(m.Tx)
+		copy(dAtA[i:], m.Tx)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.Tx)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.FireAt != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.FireAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AppID != nil {
+		{
+			size, err := m.AppID.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAmp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != nil {
+		{
+			size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAmp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UndoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UndoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UndoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Steps != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.Steps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Redo {
+		i--
+		if m.Redo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UndoState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UndoState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UndoState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RedoLabel) > 0 {
+		i -= len(m.RedoLabel)
+		copy(dAtA[i:], m.RedoLabel)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.RedoLabel)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.UndoLabel) > 0 {
+		i -= len(m.UndoLabel)
+		copy(dAtA[i:], m.UndoLabel)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.UndoLabel)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RedoDepth != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.RedoDepth))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.UndoDepth != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.UndoDepth))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UploadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UploadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UploadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CellID != nil {
+		{
+			size, err := m.CellID.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAmp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.AppID != nil {
+		{
+			size, err := m.AppID.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAmp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TotalSize != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.TotalSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UploadID) > 0 {
+		i -= len(m.UploadID)
+		copy(dAtA[i:], m.UploadID)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.UploadID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UploadChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UploadChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UploadChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Final {
+		i--
+		if m.Final {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Offset != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.UploadID) > 0 {
+		i -= len(m.UploadID)
+		copy(dAtA[i:], m.UploadID)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.UploadID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UploadStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UploadStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UploadStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Err != nil {
+		{
+			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAmp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CID) > 0 {
+		i -= len(m.CID)
+		copy(dAtA[i:], m.CID)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.CID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Complete {
+		i--
+		if m.Complete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Offset != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.UploadID) > 0 {
+		i -= len(m.UploadID)
+		copy(dAtA[i:], m.UploadID)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.UploadID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrefetchHint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrefetchHint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrefetchHint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BlobKey) > 0 {
+		i -= len(m.BlobKey)
+		copy(dAtA[i:], m.BlobKey)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.BlobKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.URL)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CellID != nil {
+		{
+			size, err := m.CellID.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAmp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetPush) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetPush) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetPush) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ETag) > 0 {
+		i -= len(m.ETag)
+		copy(dAtA[i:], m.ETag)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.ETag)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BlobKey) > 0 {
+		i -= len(m.BlobKey)
+		copy(dAtA[i:], m.BlobKey)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.BlobKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.URL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Reauth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Reauth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Reauth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Err != nil {
+		{
+			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAmp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Checkpoint != nil {
+		{
+			size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAmp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LaunchURL) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LaunchURL) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LaunchURL) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.URL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Tag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Tag) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SizeZ != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.SizeZ))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.SizeY != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.SizeY))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.SizeX != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.SizeX))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.Metric != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.Metric))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.URL)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.Text) > 0 {
+		i -= len(m.Text)
+		copy(dAtA[i:], m.Text)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.Text)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.UID) > 0 {
+		i -= len(m.UID)
+		copy(dAtA[i:], m.UID)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.UID)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ID_2 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.ID_2))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.ID_1 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.ID_1))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.ID_0 != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.ID_0))
+		i--
+		dAtA[i] = 0x10
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Tags) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Tags) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubTags) > 0 {
+		for iNdEx := len(m.SubTags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubTags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAmp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.ID != nil {
+		{
+			size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAmp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchiveManifest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchiveManifest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchiveManifest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Assets) > 0 {
+		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAmp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.TxCount != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Exporter) > 0 {
+		i -= len(m.Exporter)
+		copy(dAtA[i:], m.Exporter)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.Exporter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Root != nil {
+		{
+			size, err := m.Root.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAmp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchiveAsset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchiveAsset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchiveAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ByteSize != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.ByteSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.URI) > 0 {
+		i -= len(m.URI)
+		copy(dAtA[i:], m.URI)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.URI)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CryptoKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CryptoKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CryptoKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyBytes) > 0 {
+		i -= len(m.KeyBytes)
+		copy(dAtA[i:], m.KeyBytes)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.KeyBytes)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CryptoKitID != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.CryptoKitID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Err) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Err) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Err) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UserMsg) > 0 {
+		i -= len(m.UserMsg)
+		copy(dAtA[i:], m.UserMsg)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.UserMsg)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.RetryAfterMs != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.RetryAfterMs))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Retryable {
+		i--
+		if m.Retryable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Category != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.Category))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintAmp(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Level != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.Level))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Code != 0 {
+		i = encodeVarintAmp(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAmp(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAmp(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TxEnvelope) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovAmp(uint64(m.Status))
+	}
+	if m.OpCount != 0 {
+		n += 1 + sovAmp(uint64(m.OpCount))
+	}
+	if m.GenesisID_0 != 0 {
+		n += 1 + sovAmp(uint64(m.GenesisID_0))
+	}
+	if m.GenesisID_1 != 0 {
+		n += 9
+	}
+	if m.GenesisID_2 != 0 {
+		n += 9
+	}
+	if m.ContextID_0 != 0 {
+		n += 1 + sovAmp(uint64(m.ContextID_0))
+	}
+	if m.ContextID_1 != 0 {
+		n += 9
+	}
+	if m.ContextID_2 != 0 {
+		n += 9
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if m.Epoch != nil {
+		l = m.Epoch.Size()
+		n += 2 + l + sovAmp(uint64(l))
+	}
+	if m.Tags != nil {
+		l = m.Tags.Size()
+		n += 2 + l + sovAmp(uint64(l))
+	}
+	return n
+}
+
+func (m *Login) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UserID != nil {
+		l = m.UserID.Size()
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if m.DeviceID != nil {
+		l = m.DeviceID.Size()
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.HostAddress)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.Tags)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if m.Checkpoint != nil {
+		l = m.Checkpoint.Size()
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if m.ImpersonatorID != nil {
+		l = m.ImpersonatorID.Size()
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if m.Enroll != nil {
+		l = m.Enroll.Size()
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.APIKey)
+	if l > 0 {
+		n += 2 + l + sovAmp(uint64(l))
+	}
+	return n
+}
+
+func (m *Device) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DeviceID != nil {
+		l = m.DeviceID.Size()
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if m.UserID != nil {
+		l = m.UserID.Size()
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if m.EnrolledAt != 0 {
+		n += 1 + sovAmp(uint64(m.EnrolledAt))
+	}
+	if m.LastSeenAt != 0 {
+		n += 1 + sovAmp(uint64(m.LastSeenAt))
+	}
+	if m.RevokedAt != 0 {
+		n += 1 + sovAmp(uint64(m.RevokedAt))
+	}
+	return n
+}
+
+func (m *UserKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UserID != nil {
+		l = m.UserID.Size()
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.SigningKey)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.SealingKey)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if m.ClientHeld {
+		n += 2
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovAmp(uint64(m.CreatedAt))
+	}
+	return n
+}
+
+func (m *LoginChallenge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.RelyingPartyID)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if len(m.CredentialIDs) > 0 {
+		for _, b := range m.CredentialIDs {
+			l = len(b)
+			n += 1 + l + sovAmp(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LoginResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HashResponse)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if m.Passkey != nil {
+		l = m.Passkey.Size()
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if m.NewPasskey != nil {
+		l = m.NewPasskey.Size()
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	return n
+}
+
+func (m *PasskeyAssertion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CredentialID)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.AuthenticatorData)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.ClientDataJSON)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.UserHandle)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	return n
+}
+
+func (m *PasskeyCredential) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CredentialID)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.AuthenticatorData)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.ClientDataJSON)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	return n
+}
+
+func (m *LoginCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenType)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.AccessToken)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.RefreshToken)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if m.Expiry != 0 {
+		n += 1 + sovAmp(uint64(m.Expiry))
+	}
+	l = len(m.UserID)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.URI)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	return n
+}
+
+func (m *PinRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PinTarget != nil {
+		l = m.PinTarget.Size()
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if len(m.PinAttrs) > 0 {
+		for _, e := range m.PinAttrs {
+			l = e.Size()
+			n += 1 + l + sovAmp(uint64(l))
+		}
+	}
+	if m.StateSync != 0 {
+		n += 1 + sovAmp(uint64(m.StateSync))
+	}
+	if m.WaitForTarget {
+		n += 2
+	}
+	if m.Tags != nil {
+		l = m.Tags.Size()
+		n += 2 + l + sovAmp(uint64(l))
+	}
+	return n
+}
+
+func (m *PinUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PinAttrs) > 0 {
+		for _, e := range m.PinAttrs {
+			l = e.Size()
+			n += 1 + l + sovAmp(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CellLease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Holder != nil {
+		l = m.Holder.Size()
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.Purpose)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if m.AcquiredAt != 0 {
+		n += 1 + sovAmp(uint64(m.AcquiredAt))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovAmp(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func (m *Presence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UserID != nil {
+		l = m.UserID.Size()
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if m.PinCount != 0 {
+		n += 1 + sovAmp(uint64(m.PinCount))
+	}
+	if m.Since != 0 {
+		n += 1 + sovAmp(uint64(m.Since))
+	}
+	return n
+}
+
+func (m *Notification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != nil {
+		l = m.ID.Size()
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if m.AppID != nil {
+		l = m.AppID.Size()
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if m.UserID != nil {
+		l = m.UserID.Size()
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if m.Link != nil {
+		l = m.Link.Size()
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovAmp(uint64(m.CreatedAt))
+	}
+	if m.Silent {
+		n += 2
+	}
+	return n
+}
+
+func (m *NotificationAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IDs) > 0 {
+		for _, e := range m.IDs {
+			l = e.Size()
+			n += 1 + l + sovAmp(uint64(l))
+		}
+	}
+	if m.AppID != nil {
+		l = m.AppID.Size()
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	return n
+}
+
+func (m *Badge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppID != nil {
+		l = m.AppID.Size()
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovAmp(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *ScheduledTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != nil {
+		l = m.ID.Size()
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if m.AppID != nil {
+		l = m.AppID.Size()
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if m.FireAt != 0 {
+		n += 1 + sovAmp(uint64(m.FireAt))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.Tx)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovAmp(uint64(m.Attempts))
+	}
+	return n
+}
+
+func (m *UndoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Redo {
+		n += 2
+	}
+	if m.Steps != 0 {
+		n += 1 + sovAmp(uint64(m.Steps))
+	}
+	return n
+}
+
+func (m *UndoState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UndoDepth != 0 {
+		n += 1 + sovAmp(uint64(m.UndoDepth))
+	}
+	if m.RedoDepth != 0 {
+		n += 1 + sovAmp(uint64(m.RedoDepth))
+	}
+	l = len(m.UndoLabel)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.RedoLabel)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	return n
+}
+
+func (m *UploadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UploadID)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovAmp(uint64(m.TotalSize))
+	}
+	if m.AppID != nil {
+		l = m.AppID.Size()
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if m.CellID != nil {
+		l = m.CellID.Size()
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	return n
+}
+
+func (m *UploadChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UploadID)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovAmp(uint64(m.Offset))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if m.Final {
+		n += 2
+	}
+	return n
+}
+
+func (m *UploadStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UploadID)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovAmp(uint64(m.Offset))
+	}
+	if m.Complete {
+		n += 2
+	}
+	l = len(m.CID)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if m.Err != nil {
+		l = m.Err.Size()
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	return n
+}
+
+func (m *PrefetchHint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CellID != nil {
+		l = m.CellID.Size()
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.BlobKey)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovAmp(uint64(m.Priority))
+	}
+	return n
+}
+
+func (m *AssetPush) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.BlobKey)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.ETag)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	return n
+}
+
+func (m *Reauth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Checkpoint != nil {
+		l = m.Checkpoint.Size()
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if m.Err != nil {
+		l = m.Err.Size()
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	return n
+}
+
+func (m *LaunchURL) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	return n
+}
+
+func (m *Tag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID_0 != 0 {
+		n += 1 + sovAmp(uint64(m.ID_0))
+	}
+	if m.ID_1 != 0 {
+		n += 9
+	}
+	if m.ID_2 != 0 {
+		n += 9
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.UID)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if m.Metric != 0 {
+		n += 2 + sovAmp(uint64(m.Metric))
+	}
+	if m.SizeX != 0 {
+		n += 2 + sovAmp(uint64(m.SizeX))
+	}
+	if m.SizeY != 0 {
+		n += 2 + sovAmp(uint64(m.SizeY))
+	}
+	if m.SizeZ != 0 {
+		n += 2 + sovAmp(uint64(m.SizeZ))
+	}
+	return n
+}
+
+func (m *Tags) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != nil {
+		l = m.ID.Size()
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if len(m.SubTags) > 0 {
+		for _, e := range m.SubTags {
+			l = e.Size()
+			n += 1 + l + sovAmp(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ArchiveManifest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovAmp(uint64(m.Version))
+	}
+	if m.Root != nil {
+		l = m.Root.Size()
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovAmp(uint64(m.CreatedAt))
+	}
+	l = len(m.Exporter)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if m.TxCount != 0 {
+		n += 1 + sovAmp(uint64(m.TxCount))
+	}
+	if len(m.Assets) > 0 {
+		for _, e := range m.Assets {
+			l = e.Size()
+			n += 1 + l + sovAmp(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ArchiveAsset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URI)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if m.ByteSize != 0 {
+		n += 1 + sovAmp(uint64(m.ByteSize))
+	}
+	return n
+}
+
+func (m *CryptoKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CryptoKitID != 0 {
+		n += 1 + sovAmp(uint64(m.CryptoKitID))
+	}
+	l = len(m.KeyBytes)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	return n
+}
+
+func (m *Err) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovAmp(uint64(m.Code))
+	}
+	if m.Level != 0 {
+		n += 1 + sovAmp(uint64(m.Level))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	if m.Category != 0 {
+		n += 1 + sovAmp(uint64(m.Category))
+	}
+	if m.Retryable {
+		n += 2
+	}
+	if m.RetryAfterMs != 0 {
+		n += 1 + sovAmp(uint64(m.RetryAfterMs))
+	}
+	l = len(m.UserMsg)
+	if l > 0 {
+		n += 1 + l + sovAmp(uint64(l))
+	}
+	return n
+}
+
+func sovAmp(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAmp(x uint64) (n int) {
+	return sovAmp(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *TxEnvelope) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TxEnvelope{`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`OpCount:` + fmt.Sprintf("%v", this.OpCount) + `,`,
+		`GenesisID_0:` + fmt.Sprintf("%v", this.GenesisID_0) + `,`,
+		`GenesisID_1:` + fmt.Sprintf("%v", this.GenesisID_1) + `,`,
+		`GenesisID_2:` + fmt.Sprintf("%v", this.GenesisID_2) + `,`,
+		`ContextID_0:` + fmt.Sprintf("%v", this.ContextID_0) + `,`,
+		`ContextID_1:` + fmt.Sprintf("%v", this.ContextID_1) + `,`,
+		`ContextID_2:` + fmt.Sprintf("%v", this.ContextID_2) + `,`,
+		`From:` + strings.Replace(this.From.String(), "Tag", "Tag", 1) + `,`,
+		`To:` + strings.Replace(this.To.String(), "Tag", "Tag", 1) + `,`,
+		`Epoch:` + strings.Replace(this.Epoch.String(), "Tag", "Tag", 1) + `,`,
+		`Tags:` + strings.Replace(this.Tags.String(), "Tags", "Tags", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Login) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Login{`,
+		`UserID:` + strings.Replace(this.UserID.String(), "Tag", "Tag", 1) + `,`,
+		`DeviceID:` + strings.Replace(this.DeviceID.String(), "Tag", "Tag", 1) + `,`,
+		`HostAddress:` + fmt.Sprintf("%v", this.HostAddress) + `,`,
+		`Tags:` + fmt.Sprintf("%v", this.Tags) + `,`,
+		`Checkpoint:` + strings.Replace(this.Checkpoint.String(), "LoginCheckpoint", "LoginCheckpoint", 1) + `,`,
+		`ImpersonatorID:` + strings.Replace(this.ImpersonatorID.String(), "Tag", "Tag", 1) + `,`,
+		`Enroll:` + strings.Replace(this.Enroll.String(), "Device", "Device", 1) + `,`,
+		`APIKey:` + fmt.Sprintf("%v", this.APIKey) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Device) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Device{`,
+		`DeviceID:` + strings.Replace(this.DeviceID.String(), "Tag", "Tag", 1) + `,`,
+		`UserID:` + strings.Replace(this.UserID.String(), "Tag", "Tag", 1) + `,`,
+		`PublicKey:` + fmt.Sprintf("%v", this.PublicKey) + `,`,
+		`Label:` + fmt.Sprintf("%v", this.Label) + `,`,
+		`EnrolledAt:` + fmt.Sprintf("%v", this.EnrolledAt) + `,`,
+		`LastSeenAt:` + fmt.Sprintf("%v", this.LastSeenAt) + `,`,
+		`RevokedAt:` + fmt.Sprintf("%v", this.RevokedAt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UserKey) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UserKey{`,
+		`UserID:` + strings.Replace(this.UserID.String(), "Tag", "Tag", 1) + `,`,
+		`SigningKey:` + fmt.Sprintf("%v", this.SigningKey) + `,`,
+		`SealingKey:` + fmt.Sprintf("%v", this.SealingKey) + `,`,
+		`ClientHeld:` + fmt.Sprintf("%v", this.ClientHeld) + `,`,
+		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LoginChallenge) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LoginChallenge{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`RelyingPartyID:` + fmt.Sprintf("%v", this.RelyingPartyID) + `,`,
+		`CredentialIDs:` + fmt.Sprintf("%v", this.CredentialIDs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LoginResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LoginResponse{`,
+		`HashResponse:` + fmt.Sprintf("%v", this.HashResponse) + `,`,
+		`Passkey:` + strings.Replace(this.Passkey.String(), "PasskeyAssertion", "PasskeyAssertion", 1) + `,`,
+		`NewPasskey:` + strings.Replace(this.NewPasskey.String(), "PasskeyCredential", "PasskeyCredential", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PasskeyAssertion) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PasskeyAssertion{`,
+		`CredentialID:` + fmt.Sprintf("%v", this.CredentialID) + `,`,
+		`AuthenticatorData:` + fmt.Sprintf("%v", this.AuthenticatorData) + `,`,
+		`ClientDataJSON:` + fmt.Sprintf("%v", this.ClientDataJSON) + `,`,
+		`Signature:` + fmt.Sprintf("%v", this.Signature) + `,`,
+		`UserHandle:` + fmt.Sprintf("%v", this.UserHandle) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PasskeyCredential) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PasskeyCredential{`,
+		`CredentialID:` + fmt.Sprintf("%v", this.CredentialID) + `,`,
+		`PublicKey:` + fmt.Sprintf("%v", this.PublicKey) + `,`,
+		`AuthenticatorData:` + fmt.Sprintf("%v", this.AuthenticatorData) + `,`,
+		`ClientDataJSON:` + fmt.Sprintf("%v", this.ClientDataJSON) + `,`,
+		`Label:` + fmt.Sprintf("%v", this.Label) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LoginCheckpoint) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LoginCheckpoint{`,
+		`TokenType:` + fmt.Sprintf("%v", this.TokenType) + `,`,
+		`AccessToken:` + fmt.Sprintf("%v", this.AccessToken) + `,`,
+		`RefreshToken:` + fmt.Sprintf("%v", this.RefreshToken) + `,`,
+		`Expiry:` + fmt.Sprintf("%v", this.Expiry) + `,`,
+		`UserID:` + fmt.Sprintf("%v", this.UserID) + `,`,
+		`URI:` + fmt.Sprintf("%v", this.URI) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PinRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPinAttrs := "[]*Tag{"
+	for _, f := range this.PinAttrs {
+		repeatedStringForPinAttrs += strings.Replace(f.String(), "Tag", "Tag", 1) + ","
+	}
+	repeatedStringForPinAttrs += "}"
+	s := strings.Join([]string{`&PinRequest{`,
+		`PinTarget:` + strings.Replace(this.PinTarget.String(), "Tag", "Tag", 1) + `,`,
+		`PinAttrs:` + repeatedStringForPinAttrs + `,`,
+		`StateSync:` + fmt.Sprintf("%v", this.StateSync) + `,`,
+		`WaitForTarget:` + fmt.Sprintf("%v", this.WaitForTarget) + `,`,
+		`Tags:` + strings.Replace(this.Tags.String(), "Tag", "Tag", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PinUpdate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPinAttrs := "[]*Tag{"
+	for _, f := range this.PinAttrs {
+		repeatedStringForPinAttrs += strings.Replace(f.String(), "Tag", "Tag", 1) + ","
+	}
+	repeatedStringForPinAttrs += "}"
+	s := strings.Join([]string{`&PinUpdate{`,
+		`PinAttrs:` + repeatedStringForPinAttrs + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CellLease) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CellLease{`,
+		`Holder:` + strings.Replace(this.Holder.String(), "Tag", "Tag", 1) + `,`,
+		`Purpose:` + fmt.Sprintf("%v", this.Purpose) + `,`,
+		`AcquiredAt:` + fmt.Sprintf("%v", this.AcquiredAt) + `,`,
+		`ExpiresAt:` + fmt.Sprintf("%v", this.ExpiresAt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Presence) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Presence{`,
+		`UserID:` + strings.Replace(this.UserID.String(), "Tag", "Tag", 1) + `,`,
+		`PinCount:` + fmt.Sprintf("%v", this.PinCount) + `,`,
+		`Since:` + fmt.Sprintf("%v", this.Since) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Notification) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Notification{`,
+		`ID:` + strings.Replace(this.ID.String(), "Tag", "Tag", 1) + `,`,
+		`AppID:` + strings.Replace(this.AppID.String(), "Tag", "Tag", 1) + `,`,
+		`UserID:` + strings.Replace(this.UserID.String(), "Tag", "Tag", 1) + `,`,
+		`Title:` + fmt.Sprintf("%v", this.Title) + `,`,
+		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
+		`Link:` + strings.Replace(this.Link.String(), "Tag", "Tag", 1) + `,`,
+		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
+		`Silent:` + fmt.Sprintf("%v", this.Silent) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NotificationAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForIDs := "[]*Tag{"
+	for _, f := range this.IDs {
+		repeatedStringForIDs += strings.Replace(f.String(), "Tag", "Tag", 1) + ","
+	}
+	repeatedStringForIDs += "}"
+	s := strings.Join([]string{`&NotificationAck{`,
+		`IDs:` + repeatedStringForIDs + `,`,
+		`AppID:` + strings.Replace(this.AppID.String(), "Tag", "Tag", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Badge) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Badge{`,
+		`AppID:` + strings.Replace(this.AppID.String(), "Tag", "Tag", 1) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduledTx) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduledTx{`,
+		`ID:` + strings.Replace(this.ID.String(), "Tag", "Tag", 1) + `,`,
+		`AppID:` + strings.Replace(this.AppID.String(), "Tag", "Tag", 1) + `,`,
+		`FireAt:` + fmt.Sprintf("%v", this.FireAt) + `,`,
+		`Label:` + fmt.Sprintf("%v", this.Label) + `,`,
+		`Tx:` + fmt.Sprintf("%v", this.Tx) + `,`,
+		`Attempts:` + fmt.Sprintf("%v", this.Attempts) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UndoRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UndoRequest{`,
+		`Redo:` + fmt.Sprintf("%v", this.Redo) + `,`,
+		`Steps:` + fmt.Sprintf("%v", this.Steps) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UndoState) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UndoState{`,
+		`UndoDepth:` + fmt.Sprintf("%v", this.UndoDepth) + `,`,
+		`RedoDepth:` + fmt.Sprintf("%v", this.RedoDepth) + `,`,
+		`UndoLabel:` + fmt.Sprintf("%v", this.UndoLabel) + `,`,
+		`RedoLabel:` + fmt.Sprintf("%v", this.RedoLabel) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UploadRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UploadRequest{`,
+		`UploadID:` + fmt.Sprintf("%v", this.UploadID) + `,`,
+		`Label:` + fmt.Sprintf("%v", this.Label) + `,`,
+		`ContentType:` + fmt.Sprintf("%v", this.ContentType) + `,`,
+		`TotalSize:` + fmt.Sprintf("%v", this.TotalSize) + `,`,
+		`AppID:` + strings.Replace(this.AppID.String(), "Tag", "Tag", 1) + `,`,
+		`CellID:` + strings.Replace(this.CellID.String(), "Tag", "Tag", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UploadChunk) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UploadChunk{`,
+		`UploadID:` + fmt.Sprintf("%v", this.UploadID) + `,`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`Final:` + fmt.Sprintf("%v", this.Final) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UploadStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UploadStatus{`,
+		`UploadID:` + fmt.Sprintf("%v", this.UploadID) + `,`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`Complete:` + fmt.Sprintf("%v", this.Complete) + `,`,
+		`CID:` + fmt.Sprintf("%v", this.CID) + `,`,
+		`Err:` + strings.Replace(this.Err.String(), "Err", "Err", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PrefetchHint) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PrefetchHint{`,
+		`CellID:` + strings.Replace(this.CellID.String(), "Tag", "Tag", 1) + `,`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`BlobKey:` + fmt.Sprintf("%v", this.BlobKey) + `,`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AssetPush) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AssetPush{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`BlobKey:` + fmt.Sprintf("%v", this.BlobKey) + `,`,
+		`ContentType:` + fmt.Sprintf("%v", this.ContentType) + `,`,
+		`ETag:` + fmt.Sprintf("%v", this.ETag) + `,`,
+		`Content:` + fmt.Sprintf("%v", this.Content) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Reauth) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Reauth{`,
+		`Checkpoint:` + strings.Replace(this.Checkpoint.String(), "LoginCheckpoint", "LoginCheckpoint", 1) + `,`,
+		`Err:` + strings.Replace(this.Err.String(), "Err", "Err", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LaunchURL) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LaunchURL{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Tag) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Tag{`,
+		`ID_0:` + fmt.Sprintf("%v", this.ID_0) + `,`,
+		`ID_1:` + fmt.Sprintf("%v", this.ID_1) + `,`,
+		`ID_2:` + fmt.Sprintf("%v", this.ID_2) + `,`,
+		`ContentType:` + fmt.Sprintf("%v", this.ContentType) + `,`,
+		`UID:` + fmt.Sprintf("%v", this.UID) + `,`,
+		`Text:` + fmt.Sprintf("%v", this.Text) + `,`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Metric:` + fmt.Sprintf("%v", this.Metric) + `,`,
+		`SizeX:` + fmt.Sprintf("%v", this.SizeX) + `,`,
+		`SizeY:` + fmt.Sprintf("%v", this.SizeY) + `,`,
+		`SizeZ:` + fmt.Sprintf("%v", this.SizeZ) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Tags) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSubTags := "[]*Tags{"
+	for _, f := range this.SubTags {
+		repeatedStringForSubTags += strings.Replace(f.String(), "Tags", "Tags", 1) + ","
+	}
+	repeatedStringForSubTags += "}"
+	s := strings.Join([]string{`&Tags{`,
+		`ID:` + strings.Replace(this.ID.String(), "Tag", "Tag", 1) + `,`,
+		`SubTags:` + repeatedStringForSubTags + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArchiveManifest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForAssets := "[]*ArchiveAsset{"
+	for _, f := range this.Assets {
+		repeatedStringForAssets += strings.Replace(f.String(), "ArchiveAsset", "ArchiveAsset", 1) + ","
+	}
+	repeatedStringForAssets += "}"
+	s := strings.Join([]string{`&ArchiveManifest{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Root:` + strings.Replace(this.Root.String(), "Tag", "Tag", 1) + `,`,
+		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
+		`Exporter:` + fmt.Sprintf("%v", this.Exporter) + `,`,
+		`TxCount:` + fmt.Sprintf("%v", this.TxCount) + `,`,
+		`Assets:` + repeatedStringForAssets + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArchiveAsset) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArchiveAsset{`,
+		`URI:` + fmt.Sprintf("%v", this.URI) + `,`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`ContentType:` + fmt.Sprintf("%v", this.ContentType) + `,`,
+		`ByteSize:` + fmt.Sprintf("%v", this.ByteSize) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CryptoKey) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CryptoKey{`,
+		`CryptoKitID:` + fmt.Sprintf("%v", this.CryptoKitID) + `,`,
+		`KeyBytes:` + fmt.Sprintf("%v", this.KeyBytes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Err) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Err{`,
+		`Code:` + fmt.Sprintf("%v", this.Code) + `,`,
+		`Level:` + fmt.Sprintf("%v", this.Level) + `,`,
+		`Msg:` + fmt.Sprintf("%v", this.Msg) + `,`,
+		`Category:` + fmt.Sprintf("%v", this.Category) + `,`,
+		`Retryable:` + fmt.Sprintf("%v", this.Retryable) + `,`,
+		`RetryAfterMs:` + fmt.Sprintf("%v", this.RetryAfterMs) + `,`,
+		`UserMsg:` + fmt.Sprintf("%v", this.UserMsg) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringAmp(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *TxEnvelope) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAmp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxEnvelope: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxEnvelope: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= OpStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpCount", wireType)
+			}
+			m.OpCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisID_0", wireType)
+			}
+			m.GenesisID_0 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GenesisID_0 |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisID_1", wireType)
+			}
+			m.GenesisID_1 = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisID_1 = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisID_2", wireType)
+			}
+			m.GenesisID_2 = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisID_2 = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContextID_0", wireType)
+			}
+			m.ContextID_0 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContextID_0 |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContextID_1", wireType)
+			}
+			m.ContextID_1 = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContextID_1 = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 12:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContextID_2", wireType)
+			}
+			m.ContextID_2 = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContextID_2 = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.From == nil {
+				m.From = &Tag{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.To == nil {
+				m.To = &Tag{}
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Epoch == nil {
+				m.Epoch = &Tag{}
+			}
+			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tags == nil {
+				m.Tags = &Tags{}
+			}
+			if err := m.Tags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAmp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Login) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAmp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Login: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Login: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UserID == nil {
+				m.UserID = &Tag{}
+			}
+			if err := m.UserID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeviceID == nil {
+				m.DeviceID = &Tag{}
+			}
+			if err := m.DeviceID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Checkpoint == nil {
+				m.Checkpoint = &LoginCheckpoint{}
+			}
+			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImpersonatorID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ImpersonatorID == nil {
+				m.ImpersonatorID = &Tag{}
+			}
+			if err := m.ImpersonatorID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enroll", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Enroll == nil {
+				m.Enroll = &Device{}
+			}
+			if err := m.Enroll.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAmp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Device) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAmp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Device: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Device: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeviceID == nil {
+				m.DeviceID = &Tag{}
+			}
+			if err := m.DeviceID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UserID == nil {
+				m.UserID = &Tag{}
+			}
+			if err := m.UserID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnrolledAt", wireType)
+			}
+			m.EnrolledAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EnrolledAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSeenAt", wireType)
+			}
+			m.LastSeenAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSeenAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedAt", wireType)
+			}
+			m.RevokedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevokedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAmp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAmp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UserID == nil {
+				m.UserID = &Tag{}
+			}
+			if err := m.UserID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningKey = append(m.SigningKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.SigningKey == nil {
+				m.SigningKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealingKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SealingKey = append(m.SealingKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.SealingKey == nil {
+				m.SealingKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientHeld", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClientHeld = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAmp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LoginChallenge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAmp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LoginChallenge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LoginChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelyingPartyID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelyingPartyID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialIDs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredentialIDs = append(m.CredentialIDs, make([]byte, postIndex-iNdEx))
+			copy(m.CredentialIDs[len(m.CredentialIDs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAmp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LoginResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAmp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LoginResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LoginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashResponse", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HashResponse = append(m.HashResponse[:0], dAtA[iNdEx:postIndex]...)
+			if m.HashResponse == nil {
+				m.HashResponse = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Passkey == nil {
+				m.Passkey = &PasskeyAssertion{}
+			}
+			if err := m.Passkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPasskey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewPasskey == nil {
+				m.NewPasskey = &PasskeyCredential{}
+			}
+			if err := m.NewPasskey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAmp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PasskeyAssertion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAmp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PasskeyAssertion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PasskeyAssertion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredentialID = append(m.CredentialID[:0], dAtA[iNdEx:postIndex]...)
+			if m.CredentialID == nil {
+				m.CredentialID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthenticatorData = append(m.AuthenticatorData[:0], dAtA[iNdEx:postIndex]...)
+			if m.AuthenticatorData == nil {
+				m.AuthenticatorData = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientDataJSON", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientDataJSON = append(m.ClientDataJSON[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientDataJSON == nil {
+				m.ClientDataJSON = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserHandle", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserHandle = append(m.UserHandle[:0], dAtA[iNdEx:postIndex]...)
+			if m.UserHandle == nil {
+				m.UserHandle = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAmp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PasskeyCredential) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAmp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PasskeyCredential: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PasskeyCredential: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredentialID = append(m.CredentialID[:0], dAtA[iNdEx:postIndex]...)
+			if m.CredentialID == nil {
+				m.CredentialID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthenticatorData = append(m.AuthenticatorData[:0], dAtA[iNdEx:postIndex]...)
+			if m.AuthenticatorData == nil {
+				m.AuthenticatorData = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientDataJSON", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientDataJSON = append(m.ClientDataJSON[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientDataJSON == nil {
+				m.ClientDataJSON = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAmp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LoginCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAmp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LoginCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LoginCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefreshToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			m.Expiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAmp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PinRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAmp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PinRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PinRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PinTarget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PinTarget == nil {
+				m.PinTarget = &Tag{}
+			}
+			if err := m.PinTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PinAttrs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PinAttrs = append(m.PinAttrs, &Tag{})
+			if err := m.PinAttrs[len(m.PinAttrs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateSync", wireType)
+			}
+			m.StateSync = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StateSync |= StateSync(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitForTarget", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WaitForTarget = bool(v != 0)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tags == nil {
+				m.Tags = &Tag{}
+			}
+			if err := m.Tags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAmp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PinUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAmp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PinUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PinUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PinAttrs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PinAttrs = append(m.PinAttrs, &Tag{})
+			if err := m.PinAttrs[len(m.PinAttrs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAmp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CellLease) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAmp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CellLease: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CellLease: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Holder == nil {
+				m.Holder = &Tag{}
+			}
+			if err := m.Holder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Purpose = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcquiredAt", wireType)
+			}
+			m.AcquiredAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AcquiredAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAmp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Presence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAmp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Presence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Presence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UserID == nil {
+				m.UserID = &Tag{}
+			}
+			if err := m.UserID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PinCount", wireType)
+			}
+			m.PinCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PinCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			m.Since = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Since |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAmp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Notification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAmp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Notification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Notification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ID == nil {
+				m.ID = &Tag{}
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AppID == nil {
+				m.AppID = &Tag{}
+			}
+			if err := m.AppID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UserID == nil {
+				m.UserID = &Tag{}
+			}
+			if err := m.UserID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Link", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Link == nil {
+				m.Link = &Tag{}
+			}
+			if err := m.Link.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Silent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Silent = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAmp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NotificationAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAmp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NotificationAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NotificationAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IDs = append(m.IDs, &Tag{})
+			if err := m.IDs[len(m.IDs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AppID == nil {
+				m.AppID = &Tag{}
+			}
+			if err := m.AppID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAmp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Badge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAmp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Badge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Badge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AppID == nil {
+				m.AppID = &Tag{}
+			}
+			if err := m.AppID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAmp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAmp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ID == nil {
+				m.ID = &Tag{}
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AppID == nil {
+				m.AppID = &Tag{}
+			}
+			if err := m.AppID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FireAt", wireType)
+			}
+			m.FireAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FireAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tx = append(m.Tx[:0], dAtA[iNdEx:postIndex]...)
+			if m.Tx == nil {
+				m.Tx = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAmp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UndoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAmp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UndoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UndoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redo", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Redo = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+			}
+			m.Steps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Steps |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAmp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UndoState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAmp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UndoState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UndoState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UndoDepth", wireType)
+			}
+			m.UndoDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UndoDepth |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedoDepth", wireType)
+			}
+			m.RedoDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedoDepth |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UndoLabel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UndoLabel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedoLabel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedoLabel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAmp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UploadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAmp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UploadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UploadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UploadID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AppID == nil {
+				m.AppID = &Tag{}
+			}
+			if err := m.AppID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CellID == nil {
+				m.CellID = &Tag{}
+			}
+			if err := m.CellID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAmp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UploadChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAmp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UploadChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UploadChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UploadID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Final", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Final = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAmp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *TxEnvelope) Unmarshal(dAtA []byte) error {
+func (m *UploadStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3248,17 +12300,17 @@ func (m *TxEnvelope) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxEnvelope: wiretype end group for non-group")
+			return fmt.Errorf("proto: UploadStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxEnvelope: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UploadStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadID", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAmp
@@ -3268,16 +12320,29 @@ func (m *TxEnvelope) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= OpStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UploadID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			m.OpCount = 0
+			m.Offset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAmp
@@ -3287,16 +12352,16 @@ func (m *TxEnvelope) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OpCount |= uint64(b&0x7F) << shift
+				m.Offset |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisID_0", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Complete", wireType)
 			}
-			m.GenesisID_0 = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAmp
@@ -3306,36 +12371,17 @@ func (m *TxEnvelope) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GenesisID_0 |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisID_1", wireType)
-			}
-			m.GenesisID_1 = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GenesisID_1 = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-		case 7:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisID_2", wireType)
-			}
-			m.GenesisID_2 = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GenesisID_2 = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContextID_0", wireType)
+			m.Complete = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CID", wireType)
 			}
-			m.ContextID_0 = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAmp
@@ -3345,34 +12391,27 @@ func (m *TxEnvelope) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ContextID_0 |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContextID_1", wireType)
-			}
-			m.ContextID_1 = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmp
 			}
-			m.ContextID_1 = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-		case 12:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContextID_2", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
 			}
-			m.ContextID_2 = 0
-			if (iNdEx + 8) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContextID_2 = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-		case 14:
+			m.CID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3399,16 +12438,66 @@ func (m *TxEnvelope) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.From == nil {
-				m.From = &Tag{}
+			if m.Err == nil {
+				m.Err = &Err{}
 			}
-			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAmp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrefetchHint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAmp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrefetchHint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrefetchHint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CellID", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3435,18 +12524,18 @@ func (m *TxEnvelope) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.To == nil {
-				m.To = &Tag{}
+			if m.CellID == nil {
+				m.CellID = &Tag{}
 			}
-			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CellID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAmp
@@ -3456,33 +12545,29 @@ func (m *TxEnvelope) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAmp
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAmp
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Epoch == nil {
-				m.Epoch = &Tag{}
-			}
-			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAmp
@@ -3492,28 +12577,43 @@ func (m *TxEnvelope) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAmp
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAmp
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tags == nil {
-				m.Tags = &Tags{}
+			m.BlobKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
-			if err := m.Tags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAmp(dAtA[iNdEx:])
@@ -3535,7 +12635,7 @@ func (m *TxEnvelope) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Login) Unmarshal(dAtA []byte) error {
+func (m *AssetPush) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3558,17 +12658,17 @@ func (m *Login) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Login: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssetPush: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Login: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssetPush: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAmp
@@ -3578,33 +12678,29 @@ func (m *Login) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAmp
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAmp
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UserID == nil {
-				m.UserID = &Tag{}
-			}
-			if err := m.UserID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeviceID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAmp
@@ -3614,31 +12710,27 @@ func (m *Login) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAmp
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAmp
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DeviceID == nil {
-				m.DeviceID = &Tag{}
-			}
-			if err := m.DeviceID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BlobKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3666,11 +12758,11 @@ func (m *Login) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostAddress = string(dAtA[iNdEx:postIndex])
+			m.ContentType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ETag", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3698,13 +12790,13 @@ func (m *Login) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tags = string(dAtA[iNdEx:postIndex])
+			m.ETag = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAmp
@@ -3714,26 +12806,24 @@ func (m *Login) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAmp
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAmp
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Checkpoint == nil {
-				m.Checkpoint = &LoginCheckpoint{}
-			}
-			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Content = append(m.Content[:0], dAtA[iNdEx:postIndex]...)
+			if m.Content == nil {
+				m.Content = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -3757,7 +12847,7 @@ func (m *Login) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LoginChallenge) Unmarshal(dAtA []byte) error {
+func (m *Reauth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3780,17 +12870,17 @@ func (m *LoginChallenge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LoginChallenge: wiretype end group for non-group")
+			return fmt.Errorf("proto: Reauth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LoginChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Reauth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAmp
@@ -3800,24 +12890,62 @@ func (m *LoginChallenge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAmp
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAmp
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
+			if m.Checkpoint == nil {
+				m.Checkpoint = &LoginCheckpoint{}
+			}
+			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Err == nil {
+				m.Err = &Err{}
+			}
+			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3841,7 +12969,7 @@ func (m *LoginChallenge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LoginResponse) Unmarshal(dAtA []byte) error {
+func (m *LaunchURL) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3864,17 +12992,17 @@ func (m *LoginResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LoginResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LaunchURL: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LoginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LaunchURL: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HashResponse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAmp
@@ -3884,25 +13012,23 @@ func (m *LoginResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAmp
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAmp
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HashResponse = append(m.HashResponse[:0], dAtA[iNdEx:postIndex]...)
-			if m.HashResponse == nil {
-				m.HashResponse = []byte{}
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3925,7 +13051,7 @@ func (m *LoginResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LoginCheckpoint) Unmarshal(dAtA []byte) error {
+func (m *Tag) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3948,49 +13074,17 @@ func (m *LoginCheckpoint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LoginCheckpoint: wiretype end group for non-group")
+			return fmt.Errorf("proto: Tag: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LoginCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Tag: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAmp
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAmp
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAmp
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessToken", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID_0", wireType)
 			}
-			var stringLen uint64
+			m.ID_0 = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAmp
@@ -4000,78 +13094,34 @@ func (m *LoginCheckpoint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ID_0 |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAmp
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAmp
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AccessToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefreshToken", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAmp
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAmp
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAmp
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID_1", wireType)
 			}
-			if postIndex > l {
+			m.ID_1 = 0
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RefreshToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.ID_1 = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID_2", wireType)
 			}
-			m.Expiry = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAmp
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Expiry |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.ID_2 = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 11:
+			m.ID_2 = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4099,11 +13149,11 @@ func (m *LoginCheckpoint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserID = string(dAtA[iNdEx:postIndex])
+			m.ContentType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4114,80 +13164,30 @@ func (m *LoginCheckpoint) Unmarshal(dAtA []byte) error {
 					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAmp
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAmp
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.URI = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAmp(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAmp
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PinRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAmp
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmp
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PinRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PinRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 2:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PinTarget", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAmp
@@ -4197,33 +13197,29 @@ func (m *PinRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAmp
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAmp
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PinTarget == nil {
-				m.PinTarget = &Tag{}
-			}
-			if err := m.PinTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Text = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PinAttrs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAmp
@@ -4233,31 +13229,29 @@ func (m *PinRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAmp
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAmp
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PinAttrs = append(m.PinAttrs, &Tag{})
-			if err := m.PinAttrs[len(m.PinAttrs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 20:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StateSync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metric", wireType)
 			}
-			m.StateSync = 0
+			m.Metric = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAmp
@@ -4267,16 +13261,16 @@ func (m *PinRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StateSync |= StateSync(b&0x7F) << shift
+				m.Metric |= Metric(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeX", wireType)
 			}
-			var msglen int
+			m.SizeX = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAmp
@@ -4286,28 +13280,49 @@ func (m *PinRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SizeX |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAmp
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAmp
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeY", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.SizeY = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeY |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.Tags == nil {
-				m.Tags = &Tag{}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeZ", wireType)
 			}
-			if err := m.Tags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SizeZ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeZ |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAmp(dAtA[iNdEx:])
@@ -4329,7 +13344,7 @@ func (m *PinRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LaunchURL) Unmarshal(dAtA []byte) error {
+func (m *Tags) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4352,17 +13367,17 @@ func (m *LaunchURL) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LaunchURL: wiretype end group for non-group")
+			return fmt.Errorf("proto: Tags: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LaunchURL: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Tags: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAmp
@@ -4372,23 +13387,61 @@ func (m *LaunchURL) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAmp
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAmp
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			if m.ID == nil {
+				m.ID = &Tag{}
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubTags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubTags = append(m.SubTags, &Tags{})
+			if err := m.SubTags[len(m.SubTags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4411,7 +13464,7 @@ func (m *LaunchURL) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Tag) Unmarshal(dAtA []byte) error {
+func (m *ArchiveManifest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4434,56 +13487,17 @@ func (m *Tag) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Tag: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArchiveManifest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tag: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArchiveManifest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID_0", wireType)
-			}
-			m.ID_0 = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAmp
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ID_0 |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID_1", wireType)
-			}
-			m.ID_1 = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ID_1 = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-		case 4:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID_2", wireType)
-			}
-			m.ID_2 = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ID_2 = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var stringLen uint64
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAmp
@@ -4493,29 +13507,16 @@ func (m *Tag) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Version |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAmp
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAmp
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContentType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAmp
@@ -4525,29 +13526,33 @@ func (m *Tag) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAmp
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAmp
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UID = string(dAtA[iNdEx:postIndex])
+			if m.Root == nil {
+				m.Root = &Tag{}
+			}
+			if err := m.Root.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var stringLen uint64
+			m.CreatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAmp
@@ -4557,27 +13562,14 @@ func (m *Tag) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CreatedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAmp
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAmp
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Text = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 15:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exporter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4605,13 +13597,13 @@ func (m *Tag) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Exporter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 20:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metric", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
 			}
-			m.Metric = 0
+			m.TxCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAmp
@@ -4621,16 +13613,16 @@ func (m *Tag) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Metric |= Metric(b&0x7F) << shift
+				m.TxCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 21:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeX", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
 			}
-			m.SizeX = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAmp
@@ -4640,49 +13632,26 @@ func (m *Tag) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SizeX |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 22:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeY", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthAmp
 			}
-			m.SizeY = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAmp
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SizeY |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
 			}
-		case 23:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeZ", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.SizeZ = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAmp
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SizeZ |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Assets = append(m.Assets, &ArchiveAsset{})
+			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAmp(dAtA[iNdEx:])
@@ -4704,7 +13673,7 @@ func (m *Tag) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Tags) Unmarshal(dAtA []byte) error {
+func (m *ArchiveAsset) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4727,17 +13696,17 @@ func (m *Tags) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Tags: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArchiveAsset: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tags: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArchiveAsset: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAmp
@@ -4747,33 +13716,61 @@ func (m *Tags) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAmp
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAmp
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ID == nil {
-				m.ID = &Tag{}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubTags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAmp
@@ -4783,26 +13780,43 @@ func (m *Tags) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAmp
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAmp
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubTags = append(m.SubTags, &Tags{})
-			if err := m.SubTags[len(m.SubTags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByteSize", wireType)
+			}
+			m.ByteSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ByteSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAmp(dAtA[iNdEx:])
@@ -5026,6 +14040,96 @@ func (m *Err) Unmarshal(dAtA []byte) error {
 			}
 			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			m.Category = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Category |= ErrCategory(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retryable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Retryable = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryAfterMs", wireType)
+			}
+			m.RetryAfterMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryAfterMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserMsg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserMsg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAmp(dAtA[iNdEx:])
@@ -2,6 +2,8 @@
 package amp
 
 import (
+	"time"
+
 	"github.com/art-media-platform/amp-sdk-go/stdlib/media"
 	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
 	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
@@ -16,6 +18,7 @@ type App struct {
 	Version      string   // "v{MajorVers}.{MinorID}.{RevID}"
 	Dependencies []tag.ID // module Tags this app may access
 	Invocations  []string // additional aliases that invoke this app
+	SearchAttrs  []tag.ID // attrs this app offers to federated search -- see Searcher
 
 	// NewAppInstance is the instantiation entry point for an App called when an App is first invoked on a User session and is not yet running.
 	//
@@ -40,6 +43,24 @@ type AppContext interface {
 
 	// Write analog for GetAppAttr()
 	PutAppAttr(attrSpec tag.ID, src tag.Value) error
+
+	// Returns the host-managed persistent cell state store for this app, scoped by App.AppSpec and the current user.
+	// Stored values are encrypted at rest using the tenant's key.
+	CellStore() CellStore
+}
+
+// CellStore persists cell attr elements on behalf of an app that prefers host-managed storage over LocalDataPath().
+type CellStore interface {
+
+	// Reads the given element into dst, returning ErrCellNotFound if not present.
+	GetElement(elemID ElementID, dst tag.Value) error
+
+	// Writes the given element, replacing any previous value (nil deletes the element).
+	PutElement(elemID ElementID, src tag.Value) error
+
+	// Calls fn for each element stored under the given cell, in attr and item order.
+	// The data passed to fn is only valid for the duration of the call.
+	ForEachElement(cellID tag.ID, fn func(elemID ElementID, data []byte) error) error
 }
 
 // Pinner is characterized by the ability to emit Pins.
@@ -63,6 +84,71 @@ type AppInstance interface {
 	OnClosing()
 }
 
+// UploadReceiver is optionally implemented by an AppInstance to receive client uploads addressed to it via UploadRequest.AppID.
+type UploadReceiver interface {
+
+	// Called once an upload addressed to this app is complete, where status.CID identifies the content in Services.BlobStore.
+	OnUploadComplete(req *UploadRequest, status *UploadStatus) error
+}
+
+// LoginObserver is optionally implemented by an AppInstance wishing to be notified when its session's credentials are refreshed (see Reauther).
+// This allows an app holding a token derived from the session's credentials (e.g. for a third-party API) to refresh it.
+type LoginObserver interface {
+	OnLoginRefreshed(login Login)
+}
+
+// PinObserver is optionally implemented by an AppInstance wishing to be notified as its cells are pinned and unpinned.
+// This allows an app to run expensive watchers (e.g. file system notify, API polling) only while someone is viewing a cell.
+type PinObserver interface {
+	OnCellPinned(cellID tag.ID)   // called each time a cell is pinned
+	OnCellUnpinned(cellID tag.ID) // called each time a pin of a cell closes
+	OnFirstPin(cellID tag.ID)     // called when a cell goes from no pins to one pin
+	OnLastUnpin(cellID tag.ID)    // called when a cell's last remaining pin closes
+}
+
+// ScheduledTxHandler is implemented by an AppInstance that schedules txs via Scheduler.
+type ScheduledTxHandler interface {
+
+	// Applies a scheduled tx that has come due.  If an error is returned, delivery is retried later.
+	OnScheduledTx(scheduleID tag.ID, tx *TxMsg) error
+}
+
+// Undoable is implemented by an AppInstance whose client mutations can be undone -- see UndoManager.
+type Undoable interface {
+
+	// Returns a tx that reverts the given client tx, called before the tx is committed.
+	// Returns nil if the tx cannot be undone (in which case it is not recorded).
+	InverseTx(tx *TxMsg) (*TxMsg, error)
+
+	// Applies an inverse tx (undo) or re-applies an original tx (redo).
+	ApplyTx(tx *TxMsg) error
+}
+
+// Searcher is implemented by an AppInstance that offers results to federated search.
+// A "search:" pin fans out to each running app registered with App.SearchAttrs and merges their ranked hits.
+type Searcher interface {
+
+	// Returns hits for the given query, ranked by descending score.
+	// Implementations should return promptly when ctx is closing.
+	Search(ctx task.Context, query SearchQuery) ([]SearchHit, error)
+}
+
+// SearchQuery is a federated search request sent to each participating Searcher.
+type SearchQuery struct {
+	Text  string   // search text entered by the user
+	Attrs []tag.ID // if non-empty, only these attrs should be searched
+	Limit int      // max number of hits wanted; if <= 0, the searcher chooses
+}
+
+// SearchHit references a cell matching a SearchQuery.
+type SearchHit struct {
+	CellID tag.ID  // matching cell
+	AppID  tag.ID  // app serving the matching cell
+	Score  float64 // relevance, where higher is better
+	Label  string  // human-readable description of the matching cell
+	URL    string  // optional URL that pins the matching cell
+}
+
 // Pin is a attribute state connection to an amp.App.
 // The handling App is responsible for updating the Requester with state changes as requested.
 type Pin interface {
@@ -75,6 +161,32 @@ type Pin interface {
 	Context() task.Context
 }
 
+// PinUpdater is optionally implemented by a Pin that allows its originating request to be revised while live.
+// amp.Host routes a PinUpdate to the Pin whose request ID matches the tx ContextID.
+type PinUpdater interface {
+
+	// Replaces the attrs selected by this Pin and pushes state for any newly selected attrs.
+	UpdatePin(update *PinUpdate) error
+}
+
+// PinMetrics is a snapshot of a Pin's push activity.
+type PinMetrics struct {
+	TxsPushed   int64         // number of txs pushed to the Requester
+	BytesPushed int64         // total data store bytes pushed
+	QueueDepth  int           // number of updates held awaiting push
+	TimeBlocked time.Duration // cumulative time blocked in Requester.PushTx()
+	SlowPushes  int           // number of consecutive pushes exceeding the slow push threshold
+}
+
+// SlowConsumerObserver is optionally implemented by a Requester wishing to be notified when its Pin's consumer is persistently slow.
+// This allows a host to degrade gracefully, such as widening push intervals or closing the request.
+type SlowConsumerObserver interface {
+	OnSlowConsumer(metrics PinMetrics)
+}
+
+// AttrMask is the set of attr IDs selected by a pin request -- an empty mask selects all attrs.
+type AttrMask map[tag.ID]struct{}
+
 // TxMsg is workhorse generic transport serialization sent between client and host.
 type TxMsg struct {
 	TxEnvelope        // public fields and routing tags
@@ -1,13 +1,36 @@
 package amp
 
 import (
+	"context"
 	"net/url"
+	"time"
 
+	"github.com/art-media-platform/amp-sdk-go/stdlib/blob"
 	"github.com/art-media-platform/amp-sdk-go/stdlib/media"
 	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
 	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
 )
 
+// Log field keys a Host sets (via task.Info.LogFields) on the contexts it starts, so that logging within them (and their children) is correlated.
+const (
+	LogFieldSession = "session" // on a Session's context: the session ID
+	LogFieldUser    = "user"    // on a Session's context: the session's user ID
+	LogFieldApp     = "app"     // on an AppContext: the app's UID (App.AppSpec)
+	LogFieldCell    = "cell"    // on a pin's context: the pinned cell ID
+)
+
+// Subsystems a Host tags its service contexts with (via log.FieldSubsystem), so that log levels can be set per subsystem -- see log.Levels.
+const (
+	SubsystemTransport = "transport" // services through which clients connect (e.g. rpc, gateway)
+	SubsystemRegistry  = "registry"  // app and attr registration and lookup
+)
+
+// Span attribute keys set on a pin's span (see task.SpanOf), in addition to its log fields.
+const (
+	SpanAttrPinSync  = "pin.sync"  // the request's StateSync
+	SpanAttrPinAttrs = "pin.attrs" // the number of attrs requested, or 0 if all
+)
+
 // Host allows app and transport services to be attached.
 // Child processes attach as it responds to client requests to "pin" cells via URLs.
 type Host interface {
@@ -21,6 +44,420 @@ type Host interface {
 	StartNewSession(parent HostService, via Transport) (Session, error)
 }
 
+// Services are the optional services a Host offers its sessions and apps, where a nil field means the service is not offered.
+// Services are fields here rather than Host or Session methods so that existing Host implementations keep building as services are added.
+type Services struct {
+	HostContext   task.Context        // the host's root task.Context so its task tree can be inspected (see task.Inspect and the "tasks:" sys app)
+	BlobStore     blob.Store          // stores asset content (e.g. local disk, S3, or GCS) -- see media.NewBlobAsset() to publish a stored blob
+	Aliases       AliasTable          // maps human-stable URLs to cells
+	Leases        LeaseTable          // tracks advisory cell locks across sessions (e.g. "now editing")
+	Presence      PresenceTable       // tracks which users have which cells pinned
+	Notifications NotificationService // queues notifications and badge counts for users across sessions
+	Scheduler     Scheduler           // persists scheduled txs so they fire across host restarts
+	AccessControl AccessControl       // decides which users may read which cells
+	AssetRefs     AssetRefs           // tracks which stored assets cells reference, so that unreferenced assets are collected
+	Prefetcher    Prefetcher          // acts on hints for assets likely to be requested soon
+	Capabilities  CapabilityTokens    // tokens a PinRequest may present (see CapabilityParam) in place of the session's own access
+	Devices       DeviceRegistry      // enrolled devices, whose revocation closes the sessions of that device
+	APIKeys       APIKeys             // keys a headless client presents via Login.APIKey in place of an interactive login -- see APIKeyLogin()
+	AuthProviders []AuthProvider      // providers offered to sign in a new Session, in order of preference -- see SelectAuthProvider()
+	Keys          KeyService          // holds each user's identity keys
+	Audit         SecurityAudit       // records sign ins, credential changes, and access denials
+	OAuth         OAuthService        // holds each user's grants to third-party APIs
+
+	// Set only in the Services of a Session
+	Undo    UndoManager   // nil if the client has not opted in to undo
+	Uploads UploadManager // receives assets pushed by the client
+}
+
+// ServiceProvider is optionally implemented by a Host or Session offering Services -- see ServicesOf().
+type ServiceProvider interface {
+	Services() *Services
+}
+
+// Impersonator is optionally implemented by a Host allowing an admin to reproduce a user-specific issue.
+type Impersonator interface {
+
+	// Creates a new Session signed in as the given user on behalf of an admin session.
+	// The admin's user must hold RoleImpersonate, a reason is required, and the session is recorded in the host's audit trail -- see ImpersonateLogin().
+	// The new session's Login().ImpersonatorID is the admin's user ID, allowing apps to disable actions an admin should not take as a user.
+	StartImpersonation(admin Session, userID tag.ID, reason string, via Transport) (Session, error)
+}
+
+// AliasTable maps human-stable URLs ("aliases") to CellIDs that may change across app restarts -- concurrency safe.
+// This allows a client to bookmark a URL that survives an app re-indexing its cells.
+type AliasTable interface {
+
+	// Claims the given alias for a cell on behalf of an app, replacing any previous cell for the alias.
+	// Returns ErrAliasClaimed if the alias is already claimed by a different app.
+	ClaimAlias(appID tag.ID, alias string, cellID tag.ID) error
+
+	// Releases the given alias if claimed by the given app.
+	ReleaseAlias(appID tag.ID, alias string) error
+
+	// Returns the cell and app that the given alias currently resolves to.
+	// Returns ErrAliasNotFound if the alias is unclaimed.
+	ResolveAlias(alias string) (cellID, appID tag.ID, err error)
+}
+
+// LeaseTable tracks advisory cell leases -- concurrency safe.
+// A lease is held on behalf of a task.Context (typically a Session) and is automatically released when that context closes.
+type LeaseTable interface {
+
+	// Acquires or renews a lease on the given cell for holder, setting lease.AcquiredAt if unset.
+	// Returns ErrLeaseHeld if a different holder holds an unexpired lease on the cell.
+	AcquireLease(holder task.Context, cellID tag.ID, lease *CellLease) error
+
+	// Releases the lease on the given cell held by holder.
+	// Returns ErrLeaseNotHeld if holder does not hold a lease on the cell.
+	ReleaseLease(holder task.Context, cellID tag.ID) error
+
+	// Returns the unexpired lease on the given cell (or nil if none).
+	GetLease(cellID tag.ID) *CellLease
+
+	// Calls fn each time a lease is acquired, renewed, or released (lease == nil) until ctx closes.
+	// fn is called from a goroutine dedicated to this watcher, and changes to a cell arriving while fn is busy are coalesced (latest wins).
+	WatchLeases(ctx task.Context, fn func(cellID tag.ID, lease *CellLease))
+}
+
+// AuthProvider signs in a new Session via the login handshake -- concurrency safe.
+// A client sends a Login (STEP 1), the host replies with the provider's LoginChallenge (STEP 2), and the client's LoginResponse (STEP 3) is verified.
+type AuthProvider interface {
+
+	// Returns true if this provider handles the given login (typically per Login.Tags).
+	Accepts(login *Login) bool
+
+	// Returns the challenge the host sends for the given login, which the host retains until the client responds.
+	Challenge(login *Login) (*LoginChallenge, error)
+
+	// Verifies the client's response to the given challenge, returning the user signed in.
+	Verify(login *Login, challenge *LoginChallenge, response *LoginResponse) (userID tag.ID, err error)
+}
+
+// AccessControl decides which users may read which cells -- concurrency safe.
+// Resources a cell references (e.g. assets published via PublishCellAsset) inherit the cell's ACL.
+type AccessControl interface {
+
+	// Returns nil if the given user may read the given cell, otherwise ErrAccessDenied.
+	CanReadCell(userID, cellID tag.ID) error
+
+	// Returns the user signed in with the given access token (see LoginCheckpoint.AccessToken), or ErrNoAuthToken.
+	UserForToken(accessToken string) (tag.ID, error)
+
+	// Returns true if the given user holds the given role (e.g. RoleImpersonate).
+	HasRole(userID tag.ID, role string) bool
+}
+
+// Roles a host grants to users via its AccessControl.
+const (
+	RoleImpersonate = "amp.role.impersonate" // may open sessions as other users -- see Impersonator
+	RoleInspect     = "amp.role.inspect"     // may pin the host's sessions, pins, and tasks -- see amp/sys/inspector
+)
+
+// Capability allows the bearer of a token to pin a single cell, and optionally only some of its attrs, until it expires.
+// This enables "share this item" links without sharing an account.
+type Capability struct {
+	CellID    tag.ID   // cell the bearer may pin
+	Attrs     []tag.ID // attrs the bearer may pin; if empty, all
+	Issuer    tag.ID   // user who minted the capability
+	ExpiresAt int64    // unix seconds -- set by Mint()
+}
+
+// CapabilityTokens mints and redeems short-lived capability tokens -- concurrency safe.
+// A host serves a PinRequest presenting a token (see RequestCapability) even if the session is not signed in or can't otherwise read the cell.
+type CapabilityTokens interface {
+
+	// Mints a token for the given capability, valid for ttl or the implementation's maximum ttl, whichever is shorter.
+	Mint(capability Capability, ttl time.Duration) (token string, err error)
+
+	// Returns the capability of the given token, or ErrBadCapability or ErrCapabilityExpired.
+	Redeem(token string) (*Capability, error)
+
+	// Invalidates all tokens minted so far by the given issuer (e.g. when a user withdraws their shared links).
+	RevokeIssuer(issuer tag.ID)
+}
+
+// DeviceRegistry tracks each user's enrolled devices, so that a lost device can be revoked -- concurrency safe.
+// A device enrolls its public key at first login (Login.Enroll) and thereafter proves possession of its private key by signing the host's LoginChallenge.
+type DeviceRegistry interface {
+
+	// Enrolls the given device, setting EnrolledAt.
+	// Re-enrolling a device with the same user and key has no effect, otherwise ErrDeviceConflict is returned if the DeviceID is already enrolled.
+	Enroll(device *Device) error
+
+	// Verifies that response is the device's signature of challenge, updating LastSeenAt.
+	// Returns ErrDeviceNotFound, ErrDeviceRevoked, or ErrCode_AuthFailed if the signature is invalid.
+	Authenticate(deviceID tag.ID, challenge *LoginChallenge, response *LoginResponse) (*Device, error)
+
+	// Returns the devices enrolled by the given user (including revoked devices), most recently seen first.
+	ListDevices(userID tag.ID) []*Device
+
+	// Revokes the given device of the given user so it can no longer sign in, returning ErrDeviceNotFound if the user has no such device.
+	RevokeDevice(userID, deviceID tag.ID) error
+
+	// Calls fn each time a device of the given user (or of any user if userID is nil) is enrolled or revoked, until ctx closes.
+	// A Host watches all users so it can close the sessions of a device as it is revoked.
+	WatchDevices(ctx task.Context, userID tag.ID, fn func(device *Device))
+}
+
+// APIKey is a long-lived credential allowing a headless client (e.g. a bot, importer, or CI job) to sign in as the user who issued it.
+// Unlike an interactive login, a key is scoped: it may be limited to some apps and to read-only access.
+type APIKey struct {
+	KeyID      tag.ID   // identifies the key (but does not grant access)
+	UserID     tag.ID   // user the key signs in as
+	Label      string   // e.g. "nightly importer"
+	Apps       []tag.ID // apps (AppSpec IDs) the key may use; if empty, all
+	Write      bool     // if set, the key may commit txs, otherwise it may only pin
+	CreatedAt  int64    // unix seconds -- set by Issue()
+	ExpiresAt  int64    // unix seconds; if zero, the key does not expire
+	LastUsedAt int64    // unix seconds of the key's last successful sign in
+}
+
+// APIKeys issues and verifies API keys -- concurrency safe.
+// Only a hash of each key's secret is retained, so a secret is only available when issued.
+type APIKeys interface {
+
+	// Issues a key for the given user and scope, returning the secret the client presents as Login.APIKey.
+	Issue(key APIKey) (secret string, err error)
+
+	// Returns the key of the given secret, updating LastUsedAt, or ErrBadAPIKey or ErrAPIKeyExpired.
+	Authenticate(secret string) (*APIKey, error)
+
+	// Returns the keys issued for the given user, most recently created first.
+	ListKeys(userID tag.ID) []*APIKey
+
+	// Revokes the given key of the given user, returning ErrBadAPIKey if the user has no such key.
+	RevokeKey(userID, keyID tag.ID) error
+}
+
+// KeyService provisions and holds each user's identity keys (see UserKey) -- concurrency safe.
+// A user's private keys are either held by the host, allowing apps to sign and open on the user's behalf, or held only by the user's client (UserKey.ClientHeld).
+// Content sealed to a user with client-held keys is opened by the client -- see OpenSealed().
+type KeyService interface {
+
+	// Returns the given user's key, provisioning host-held keys if the user has none.
+	Provision(userID tag.ID) (*UserKey, error)
+
+	// Registers the public keys of a user whose private keys are held by their client, replacing any previous key.
+	RegisterClientKey(key *UserKey) error
+
+	// Returns the given user's key, or ErrUserKeyNotFound.
+	UserKey(userID tag.ID) (*UserKey, error)
+
+	// Signs msg as the given user, returning ErrClientHeldKey if the user's private key is not held by the host.
+	Sign(userID tag.ID, msg []byte) ([]byte, error)
+
+	// Returns nil if sig is the given user's signature of msg, otherwise ErrCode_AuthFailed.
+	Verify(userID tag.ID, msg, sig []byte) error
+
+	// Seals plaintext so that only the given users can open it -- see SealTo().
+	Seal(recipients []tag.ID, plaintext []byte) ([]byte, error)
+
+	// Opens content sealed to the given user, returning ErrClientHeldKey if the user's private key is not held by the host.
+	Open(userID tag.ID, sealed []byte) ([]byte, error)
+}
+
+// SecurityEvent is a structured entry of a host's security audit trail.
+type SecurityEvent struct {
+	At       time.Time
+	Kind     string // e.g. EventLoginFailed
+	UserID   tag.ID // user the event concerns, if known
+	ActorID  tag.ID // if set, the user acting on behalf of UserID (e.g. an admin impersonating)
+	DeviceID tag.ID // device the event originated from, if known
+	Remote   string // client network address, if known
+	Target   tag.ID // resource the event concerns (e.g. the cell or app access was denied to)
+	Detail   string // reason or error
+}
+
+// Security event kinds
+const (
+	EventLoginSucceeded      = "login.succeeded"
+	EventLoginFailed         = "login.failed"
+	EventTokenRefreshed      = "token.refreshed"
+	EventTokenRefreshFailed  = "token.refresh-failed"
+	EventAccessDenied        = "access.denied"
+	EventImpersonationOpened = "impersonation.opened"
+)
+
+// SecurityQuery selects events from a SecurityAudit, where zero fields match all events.
+type SecurityQuery struct {
+	Kinds  []string  // if set, only these kinds
+	UserID tag.ID    // if set, only events concerning this user
+	Since  time.Time // if set, only events at or after this time
+	Until  time.Time // if set, only events before this time
+	Limit  int       // if > 0, at most this many events (the most recent)
+}
+
+// SecurityAudit records security events for later query and export (e.g. to a SIEM) -- concurrency safe.
+// See SecurityLog and WriteSecurityJSONL.
+type SecurityAudit interface {
+
+	// Records the given event, setting At if unset.
+	RecordEvent(ev SecurityEvent) error
+
+	// Returns the recorded events matching the given query, oldest first.
+	QueryEvents(q SecurityQuery) []SecurityEvent
+
+	// Calls fn for each event as it is recorded, until ctx closes.
+	WatchEvents(ctx task.Context, fn func(ev SecurityEvent))
+}
+
+// OAuthProvider specifies a third-party OAuth2 authorization server (e.g. a music or photo service) that apps obtain user grants from.
+type OAuthProvider struct {
+	Name         string   // identifies the provider to apps, e.g. "spotify"
+	ClientID     string   // issued to the host by the provider
+	ClientSecret string   // issued to the host by the provider
+	AuthURL      string   // authorization endpoint the user is sent to
+	TokenURL     string   // token endpoint
+	RedirectURL  string   // where the provider returns the user, which the client reports back to the host
+	Scopes       []string // requested access
+}
+
+// TokenSource returns a valid access token, refreshing it as needed -- concurrency safe.
+type TokenSource interface {
+	Token(ctx context.Context) (*LoginCheckpoint, error)
+}
+
+// OAuthService obtains, stores, and refreshes user grants to third-party APIs on behalf of apps -- concurrency safe.
+//
+// Since the host has no browser, the authorization redirect round-trips via the client:
+// the host sends the client a LaunchURL of AuthCodeURL(), and the client returns the URL the provider redirected to (see BeginOAuth and HandleOAuthRedirect).
+// Refresh tokens are stored per user (encrypted at rest) so a grant survives sessions and host restarts.
+type OAuthService interface {
+
+	// Registers (or replaces) a provider apps may request grants from.
+	RegisterProvider(provider OAuthProvider) error
+
+	// Returns the URL to send the given user to in order to grant access via the given provider.
+	AuthCodeURL(userID tag.ID, provider string) (string, error)
+
+	// Completes a grant from the URL the provider redirected the user to, storing the resulting tokens and returning the provider's name.
+	Exchange(ctx context.Context, userID tag.ID, redirected string) (provider string, err error)
+
+	// Returns a TokenSource of the given user's grant via the given provider, or ErrOAuthNotGranted.
+	TokenSource(userID tag.ID, provider string) (TokenSource, error)
+
+	// Forgets the given user's grant via the given provider.
+	Revoke(userID tag.ID, provider string) error
+}
+
+// AssetRefs tracks which cells reference which stored assets (blob keys), so that assets no longer referenced by any cell can be deleted -- concurrency safe.
+// An asset becomes collectable once unreferenced for a grace period, allowing a cell to be rewritten without losing the assets it keeps.
+type AssetRefs interface {
+
+	// Sets the blob keys referenced by the given cell, replacing its previous references (nil clears them, e.g. when the cell is deleted).
+	SetRefs(cellID tag.ID, keys []string) error
+
+	// Tracks a blob not yet referenced by any cell (e.g. a completed upload) so it is collected unless a cell references it within the grace period.
+	Track(key string) error
+
+	// Returns the cells referencing the given blob.
+	Referrers(key string) []tag.ID
+
+	// Deletes each tracked blob that has been unreferenced for at least the grace period, returning the deleted keys.
+	Collect(ctx context.Context) ([]string, error)
+}
+
+// Prefetcher acts on PrefetchHints to improve perceived latency -- concurrency safe.
+// Small assets are pushed to the client ahead of a request, larger ones are loaded into the host's blob cache (see blob.Warmer),
+// and hints the host cannot act on are forwarded to the client.
+type Prefetcher interface {
+
+	// Queues hints on behalf of a pinned request, where op receives any pushed assets and forwarded hints.
+	// Hints still queued when pin closes are dropped.
+	Prefetch(pin task.Context, op Requester, hints ...*PrefetchHint)
+}
+
+// PresenceTable tracks which users currently pin which cells, across all sessions -- concurrency safe.
+type PresenceTable interface {
+
+	// Records that the given user opened (delta > 0) or closed (delta < 0) a pin of the given cell.
+	UpdatePresence(cellID tag.ID, userID *Tag, delta int)
+
+	// Returns the users currently pinning the given cell, ordered by Since.
+	GetPresence(cellID tag.ID) []*Presence
+
+	// Calls fn with the cell's current presence each time it changes until ctx closes.
+	WatchPresence(ctx task.Context, cellID tag.ID, fn func(present []*Presence))
+}
+
+// NotificationService queues user-targeted notifications and delivers them to each of the user's live sessions -- concurrency safe.
+// A notification remains pending (and counts toward its app's badge) until the client acks it.
+type NotificationService interface {
+
+	// Queues a notification for n.UserID, delivering it to each of the user's subscribed sessions.
+	// If unset, n.ID and n.CreatedAt are assigned.
+	Notify(n *Notification) error
+
+	// Clears the given user's notifications as specified by a client ack.
+	Ack(userID tag.ID, ack *NotificationAck)
+
+	// Returns the given user's pending notifications, oldest first.
+	Pending(userID tag.ID) []*Notification
+
+	// Returns the number of pending notifications from each app for the given user.
+	Badges(userID tag.ID) []*Badge
+
+	// Delivers the given user's pending and subsequent notifications via send until ctx closes.
+	// A host typically calls SubscribeSession() for each new session.
+	Subscribe(ctx task.Context, userID tag.ID, send func(n *Notification) error)
+
+	// Calls fn each time the given user's badge count for an app changes until ctx closes.
+	WatchBadges(ctx task.Context, userID tag.ID, fn func(badge *Badge))
+}
+
+// Scheduler applies txs at a future time on behalf of apps -- concurrency safe.
+// Scheduled txs are persisted so they fire even if the host restarts before they are due.
+// When a scheduled tx fires, the host delivers it to its app's ScheduledTxHandler.
+type Scheduler interface {
+
+	// Schedules the given tx to be delivered to the given app at fireAt, returning the scheduled tx ID.
+	// A fireAt in the past fires as soon as possible.
+	Schedule(appID tag.ID, fireAt time.Time, label string, tx *TxMsg) (tag.ID, error)
+
+	// Cancels a scheduled tx that has not yet fired.
+	// Returns ErrNotScheduled if the given ID is not scheduled.
+	Cancel(scheduleID tag.ID) error
+
+	// Returns the txs scheduled by the given app, soonest first.
+	ListScheduled(appID tag.ID) []*ScheduledTx
+}
+
+// UndoManager records a session's client-originated mutations so they can be undone and redone -- concurrency safe.
+// The host calls Record() as it commits a client tx to an Undoable app and calls HandleUndoRequest() when the client sends an UndoRequest.
+type UndoManager interface {
+
+	// Records a committed client tx and its inverse, clearing the redo stack.
+	Record(app Undoable, label string, tx, inverse *TxMsg)
+
+	// Reverts the most recently recorded (or redone) mutation.
+	Undo() error
+
+	// Re-applies the most recently undone mutation.
+	Redo() error
+
+	// Returns the current depth and labels of the undo and redo stacks.
+	State() *UndoState
+}
+
+// UploadManager receives client asset uploads in chunks, staging content durably so an interrupted upload resumes where it left off -- concurrency safe.
+// The host calls HandleUploadMsg() when the client sends an UploadRequest or UploadChunk.
+type UploadManager interface {
+
+	// Begins a new upload or resumes an existing upload having the same UploadID, returning the offset to continue from.
+	Begin(req *UploadRequest) (*UploadStatus, error)
+
+	// Appends a chunk to an upload begun via Begin().
+	// A chunk not starting at the upload's current offset is ignored and the current offset is returned so the client can resync.
+	WriteChunk(chunk *UploadChunk) (*UploadStatus, error)
+
+	// Returns the status of the given upload, or ErrNoUpload.
+	Status(uploadID string) (*UploadStatus, error)
+
+	// Cancels the given upload and discards any staged content.
+	Cancel(uploadID string) error
+}
+
 // Transport wraps a Msg transport abstraction, allowing a Host to connect over any data transport layer.
 // For example, a tcp-based transport as well as a dll-based transport are both implemented..
 type Transport interface {
@@ -40,6 +477,14 @@ type Transport interface {
 	RecvTx() (*TxMsg, error)
 }
 
+// BatchSender is optionally implemented by a Transport able to send several txs at once, such as via a single vectored write (see WriteTxBatch),
+// cutting per-tx syscall overhead for high-frequency updates.  A host hands a BatchSender each batch of txs ready to send -- see TxSendQueue.
+type BatchSender interface {
+
+	// SendTxs sends the given txs in order, as if by successive calls to SendTx.
+	SendTxs(txs []*TxMsg) error
+}
+
 // HostService attaches to a amp.Host as a child, extending host functionality.
 type HostService interface {
 	task.Context
@@ -65,7 +510,7 @@ type Session interface {
 	// Returns the active media.Publisher instance for this session.
 	AssetPublisher() media.Publisher
 
-	// Returns info about this user and session
+	// Returns info about this user and session, reflecting the latest credentials accepted by Reauth() if a Reauther.
 	Login() Login
 
 	// Sends a readied Msg to the client for handling.
@@ -77,6 +522,14 @@ type Session interface {
 	GetAppInstance(appID tag.ID, autoCreate bool) (AppInstance, error)
 }
 
+// Reauther is optionally implemented by a Session that can refresh its credentials -- see HandleReauth().
+type Reauther interface {
+
+	// Replaces this session's credentials with the given checkpoint (for the same user) without disturbing its pins.
+	// Once accepted, each running app instance implementing LoginObserver is notified -- see RefreshLogin().
+	Reauth(checkpoint *LoginCheckpoint) error
+}
+
 // Registry is where apps and types are registered -- concurrency safe.
 type Registry interface {
 
@@ -95,11 +548,21 @@ type Registry interface {
 	// Looks-up an app by tag ID -- READ ONLY ACCESS
 	GetAppByTag(appTag tag.ID) (*App, error)
 
+	// Returns all registered apps -- READ ONLY ACCESS
+	ListApps() []*App
+
+	// Returns all registered attr definitions (see RegisterPrototype) -- READ ONLY ACCESS
+	ListAttrs() []AttrDef
+
 	// Selects the app that best matches an invocation string.
 	GetAppForInvocation(invocation string) (*App, error)
 
 	// Instantiates an attr element value for a given attr spec -- typically followed by tag.Value.Unmarshal()
 	MakeValue(attrSpec tag.ID) (tag.Value, error)
+
+	// Resolves a tag.Spec expression (e.g. "amp.attr.Tag") to its registered attr or element definition.
+	// Results are cached until the next registration, so resolving a recurring expression is a single map lookup.
+	ResolveAttrSpec(expr string) (AttrDef, error)
 }
 
 // Requester wraps a client request to receive a cell's state / updates.
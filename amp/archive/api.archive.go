@@ -0,0 +1,59 @@
+// Package archive exports and imports cell archives: a portable tar of a cell subtree's tx history and the assets it references,
+// enabling backup, migration, and sharing of content between hosts.
+//
+// An archive is a tar containing (in order):
+//
+//	manifest.pb    -- amp.ArchiveManifest
+//	txs.log        -- consecutive serialized amp.TxMsg(s) in commit order
+//	assets/{n}     -- asset content, as listed in the manifest
+package archive
+
+import (
+	"io"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+// FormatVersion is the archive format version written by Export.
+const FormatVersion = 1
+
+// Source is implemented by a host store to export a cell subtree.
+type Source interface {
+
+	// Calls fn with each tx affecting the subtree rooted at the given cell, in commit order.
+	// Export reads the history twice (to size then stream the tx log), so both passes must yield the same txs.
+	ReadHistory(rootID tag.ID, fn func(tx *amp.TxMsg) error) error
+
+	// Returns the URIs of assets referenced by the subtree rooted at the given cell.
+	AssetRefs(rootID tag.ID) ([]string, error)
+
+	// Opens the given asset for reading, describing its content type and byte size.
+	OpenAsset(uri string) (io.ReadCloser, *amp.ArchiveAsset, error)
+}
+
+// Sink is implemented by a host store to import a cell subtree.
+type Sink interface {
+
+	// Commits the given tx as if it were originally committed to this store.
+	// The tx is released after CommitTx returns, so an implementation retaining it should call tx.AddRef().
+	CommitTx(tx *amp.TxMsg) error
+
+	// Stores the given asset so it is available under asset.URI.
+	PutAsset(asset *amp.ArchiveAsset, r io.Reader) error
+}
+
+// MaxManifestSize is the largest manifest Import accepts, so a malformed archive can't force a huge allocation.
+const MaxManifestSize = 16 << 20
+
+// ExportOpts specifies what is exported by Export.
+type ExportOpts struct {
+	Root     tag.ID // root cell of the subtree to export
+	Exporter string // describes the exporting host (informational)
+	NoAssets bool   // if set, referenced assets are not included
+}
+
+var (
+	ErrBadArchive         = amp.ErrCode_DataFailure.Error("malformed archive")
+	ErrUnsupportedVersion = amp.ErrCode_UnsupportedOp.Error("unsupported archive version")
+)
@@ -0,0 +1,215 @@
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+)
+
+const (
+	manifestPath = "manifest.pb"
+	txLogPath    = "txs.log"
+	assetsDir    = "assets/"
+)
+
+// Export writes an archive of the given cell subtree to w, returning the archive's manifest.
+func Export(w io.Writer, src Source, opts ExportOpts) (*amp.ArchiveManifest, error) {
+	manifest := &amp.ArchiveManifest{
+		Version:   FormatVersion,
+		Root:      &amp.Tag{},
+		CreatedAt: time.Now().Unix(),
+		Exporter:  opts.Exporter,
+	}
+	manifest.Root.SetID(opts.Root)
+
+	// The manifest leads the archive, so a first pass over the history sizes the tx log before a second pass streams it
+	var txLog byteCounter
+	var scrap []byte
+	err := src.ReadHistory(opts.Root, func(tx *amp.TxMsg) error {
+		manifest.TxCount++
+		return tx.MarshalToWriter(&scrap, &txLog)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var assetURIs []string
+	if !opts.NoAssets {
+		if assetURIs, err = src.AssetRefs(opts.Root); err != nil {
+			return nil, err
+		}
+	}
+
+	// Assets are opened up front so the manifest describes exactly what follows
+	readers := make([]io.ReadCloser, 0, len(assetURIs))
+	defer func() {
+		for _, r := range readers {
+			r.Close()
+		}
+	}()
+	for i, uri := range assetURIs {
+		r, info, err := src.OpenAsset(uri)
+		if err != nil {
+			return nil, err
+		}
+		readers = append(readers, r)
+		manifest.Assets = append(manifest.Assets, &amp.ArchiveAsset{
+			URI:         uri,
+			Path:        fmt.Sprintf("%s%d", assetsDir, i),
+			ContentType: info.ContentType,
+			ByteSize:    info.ByteSize,
+		})
+	}
+
+	manifestBuf, err := manifest.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	tw := tar.NewWriter(w)
+	modTime := time.Unix(manifest.CreatedAt, 0)
+	writeEntry := func(path string, size int64, r io.Reader) error {
+		err := tw.WriteHeader(&tar.Header{
+			Name:    path,
+			Mode:    0644,
+			Size:    size,
+			ModTime: modTime,
+		})
+		if err == nil {
+			_, err = io.CopyN(tw, r, size)
+		}
+		return err
+	}
+
+	if err = writeEntry(manifestPath, int64(len(manifestBuf)), bytes.NewReader(manifestBuf)); err != nil {
+		return nil, err
+	}
+	err = tw.WriteHeader(&tar.Header{
+		Name:    txLogPath,
+		Mode:    0644,
+		Size:    int64(txLog),
+		ModTime: modTime,
+	})
+	if err == nil {
+		err = src.ReadHistory(opts.Root, func(tx *amp.TxMsg) error {
+			return tx.MarshalToWriter(&scrap, tw)
+		})
+	}
+	if err == nil {
+		err = tw.Flush() // fails if the history changed between passes
+	}
+	if err != nil {
+		return nil, fmt.Errorf("archive: tx log: %w", err)
+	}
+	for i, asset := range manifest.Assets {
+		if err = writeEntry(asset.Path, asset.ByteSize, readers[i]); err != nil {
+			return nil, fmt.Errorf("archive: asset %q: %w", asset.URI, err)
+		}
+	}
+	if err = tw.Close(); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// Import reads an archive from r, committing its txs and storing its assets to the given Sink.
+// Txs are committed as they are read, so a failed import may leave a partial subtree in dst.
+func Import(r io.Reader, dst Sink) (*amp.ArchiveManifest, error) {
+	tr := tar.NewReader(r)
+
+	next := func(expectPath string) (*tar.Header, error) {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil, ErrBadArchive
+		}
+		if err != nil {
+			return nil, err
+		}
+		if hdr.Name != expectPath {
+			return nil, fmt.Errorf("%w: expected %q, got %q", ErrBadArchive, expectPath, hdr.Name)
+		}
+		return hdr, nil
+	}
+
+	hdr, err := next(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+	if hdr.Size > MaxManifestSize {
+		return nil, fmt.Errorf("%w: manifest exceeds %d bytes", ErrBadArchive, MaxManifestSize)
+	}
+	manifestBuf, err := io.ReadAll(io.LimitReader(tr, MaxManifestSize))
+	if err != nil {
+		return nil, err
+	}
+	manifest := &amp.ArchiveManifest{}
+	if err = manifest.Unmarshal(manifestBuf); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBadArchive, err)
+	}
+	if manifest.Version > FormatVersion {
+		return nil, ErrUnsupportedVersion
+	}
+
+	hdr, err = next(txLogPath)
+	if err != nil {
+		return nil, err
+	}
+	txCount := int64(0)
+	remain := hdr.Size
+	for {
+		// each tx's declared length is checked against what remains of the tx log before anything is allocated for it
+		var txHeader amp.TxHeader
+		if _, err = io.ReadFull(tr, txHeader[:]); err == io.EOF {
+			break
+		} else if err == io.ErrUnexpectedEOF {
+			return nil, fmt.Errorf("%w: truncated tx %d", ErrBadArchive, txCount)
+		} else if err != nil {
+			return nil, err
+		}
+		txLen, err := txHeader.TxLen()
+		if err != nil || txLen > remain {
+			return nil, fmt.Errorf("%w: malformed tx %d", ErrBadArchive, txCount)
+		}
+		remain -= txLen
+
+		tx, err := amp.ReadTxMsg(io.MultiReader(bytes.NewReader(txHeader[:]), tr))
+		if err != nil {
+			return nil, fmt.Errorf("%w: tx %d: %v", ErrBadArchive, txCount, err)
+		}
+		err = dst.CommitTx(tx)
+		tx.ReleaseRef()
+		if err != nil {
+			return nil, err
+		}
+		txCount++
+	}
+	if txCount != manifest.TxCount {
+		return nil, fmt.Errorf("%w: expected %d txs, got %d", ErrBadArchive, manifest.TxCount, txCount)
+	}
+
+	for _, asset := range manifest.Assets {
+		hdr, err := next(asset.Path)
+		if err != nil {
+			return nil, err
+		}
+		if hdr.Size != asset.ByteSize {
+			return nil, fmt.Errorf("%w: asset %q size mismatch", ErrBadArchive, asset.URI)
+		}
+		if err = dst.PutAsset(asset, tr); err != nil {
+			return nil, err
+		}
+	}
+	return manifest, nil
+}
+
+// byteCounter is an io.Writer counting the bytes written to it.
+type byteCounter int64
+
+func (n *byteCounter) Write(p []byte) (int, error) {
+	*n += byteCounter(len(p))
+	return len(p), nil
+}
@@ -0,0 +1,144 @@
+package archive_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/amp/archive"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+type store struct {
+	txs    []*amp.TxMsg
+	assets map[string][]byte
+}
+
+func (st *store) ReadHistory(rootID tag.ID, fn func(tx *amp.TxMsg) error) error {
+	for _, tx := range st.txs {
+		if err := fn(tx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (st *store) AssetRefs(rootID tag.ID) ([]string, error) {
+	return []string{"asset://cover.png"}, nil
+}
+
+func (st *store) OpenAsset(uri string) (io.ReadCloser, *amp.ArchiveAsset, error) {
+	buf := st.assets[uri]
+	return io.NopCloser(bytes.NewReader(buf)), &amp.ArchiveAsset{
+		ContentType: "image/png",
+		ByteSize:    int64(len(buf)),
+	}, nil
+}
+
+func (st *store) CommitTx(tx *amp.TxMsg) error {
+	tx.AddRef()
+	st.txs = append(st.txs, tx)
+	return nil
+}
+
+func (st *store) PutAsset(asset *amp.ArchiveAsset, r io.Reader) error {
+	buf, err := io.ReadAll(r)
+	st.assets[asset.URI] = buf
+	return err
+}
+
+func TestArchive(t *testing.T) {
+	rootID := tag.Now()
+	src := &store{
+		assets: map[string][]byte{
+			"asset://cover.png": []byte("not really a png"),
+		},
+	}
+	for i := 0; i < 3; i++ {
+		tx, err := amp.MarshalAttr(rootID, amp.AttrSpec.With("Tag").ID, &amp.Tag{Text: "rev " + string(rune('a'+i))})
+		if err != nil {
+			t.Fatal(err)
+		}
+		src.txs = append(src.txs, tx)
+	}
+
+	var buf bytes.Buffer
+	exported, err := archive.Export(&buf, src, archive.ExportOpts{Root: rootID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exported.TxCount != 3 || len(exported.Assets) != 1 {
+		t.Fatalf("unexpected manifest: %v", exported)
+	}
+
+	dst := &store{
+		assets: map[string][]byte{},
+	}
+	imported, err := archive.Import(&buf, dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if imported.Root.AsID() != rootID || len(dst.txs) != 3 {
+		t.Fatalf("unexpected import: %v", imported)
+	}
+	if string(dst.assets["asset://cover.png"]) != "not really a png" {
+		t.Fatal("asset not imported")
+	}
+
+	for i, tx := range dst.txs {
+		val := &amp.Tag{}
+		if err := tx.LoadItem(amp.AttrSpec.With("Tag").ID, tag.ID{}, val); err != nil {
+			t.Fatal(err)
+		}
+		if val.Text != "rev "+string(rune('a'+i)) {
+			t.Fatalf("tx %d: unexpected value %q", i, val.Text)
+		}
+	}
+
+	// truncated archive
+	buf.Reset()
+	archive.Export(&buf, src, archive.ExportOpts{Root: rootID, NoAssets: true})
+	if _, err = archive.Import(bytes.NewReader(buf.Bytes()[:1024]), dst); err == nil {
+		t.Fatal("expected truncated archive to fail")
+	}
+
+	// a manifest claiming to be huge is rejected before it is read
+	buf.Reset()
+	tw := tar.NewWriter(&buf)
+	tw.WriteHeader(&tar.Header{Name: "manifest.pb", Mode: 0644, Size: 8 << 30})
+	if _, err = archive.Import(&buf, dst); !errors.Is(err, archive.ErrBadArchive) {
+		t.Fatalf("expected ErrBadArchive for an oversized manifest, got %v", err)
+	}
+
+	// a tx header declaring a body shorter than the header, or more bytes than the tx log holds, is rejected before it is read
+	malformed := func(bodyLen, dataLen uint32) []byte {
+		var header amp.TxHeader
+		header[0] = byte(amp.Const_TxHeader_Marker >> 16)
+		header[1] = byte(amp.Const_TxHeader_Marker >> 8 & 0xFF)
+		header[2] = byte(amp.Const_TxHeader_Marker & 0xFF)
+		header[3] = byte(amp.Const_TxHeader_Version)
+		binary.LittleEndian.PutUint32(header[4:8], bodyLen)
+		binary.LittleEndian.PutUint32(header[8:12], dataLen)
+		return append(header[:], make([]byte, 64)...)
+	}
+	manifestBuf, _ := (&amp.ArchiveManifest{TxCount: 1}).Marshal()
+	for _, txLog := range [][]byte{
+		malformed(0, 0),
+		malformed(uint32(amp.Const_TxHeader_Size), 0xFFFFFFFF),
+	} {
+		buf.Reset()
+		tw = tar.NewWriter(&buf)
+		tw.WriteHeader(&tar.Header{Name: "manifest.pb", Mode: 0644, Size: int64(len(manifestBuf))})
+		tw.Write(manifestBuf)
+		tw.WriteHeader(&tar.Header{Name: "txs.log", Mode: 0644, Size: int64(len(txLog))})
+		tw.Write(txLog)
+		tw.Close()
+		if _, err = archive.Import(&buf, dst); !errors.Is(err, archive.ErrBadArchive) {
+			t.Fatalf("expected ErrBadArchive for a malformed tx, got %v", err)
+		}
+	}
+}
@@ -0,0 +1,346 @@
+// Package cabi implements the handle-based API that cmd/amp.sdk.lib.cgo exports to C, so engines (e.g. Unity, Unreal) and native apps can embed an amp host or client without a Go toolchain.
+//
+// Txs cross the boundary as flat buffers: a TxMsg serialized via TxMsg.MarshalToBuffer (and parsed via amp.ReadTxMsg).
+// Errors are reported to C as the negated amp.ErrCode -- see Code().
+package cabi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/amp/rpc"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// Handle identifies a host or session opened via this package; handles are never reused and 0 is never valid.
+type Handle int64
+
+// HostStarter starts an embedded amp.Host from an implementation-defined config string (e.g. JSON).
+type HostStarter func(config string) (amp.Host, error)
+
+var (
+	ErrBadHandle   = amp.ErrCode_BadRequest.Error("invalid handle")
+	ErrNoHost      = amp.ErrCode_Unimplemented.Error("no embedded host is linked -- see cabi.SetHostStarter")
+	ErrShortBuffer = amp.ErrCode_BadRequest.Error("buffer too small for tx")
+)
+
+var (
+	gMu          sync.Mutex
+	gHostStarter HostStarter
+	gHandles     = make(map[Handle]any) // *embeddedHost or *session
+	gNextHandle  Handle
+	gLastErr     error
+)
+
+// SetHostStarter sets how HostStart() starts a host -- typically called from an init() in a host implementation linked into the library.
+func SetHostStarter(fn HostStarter) {
+	gMu.Lock()
+	gHostStarter = fn
+	gMu.Unlock()
+}
+
+func newHandle(obj any) Handle {
+	gMu.Lock()
+	defer gMu.Unlock()
+	gNextHandle++
+	gHandles[gNextHandle] = obj
+	return gNextHandle
+}
+
+func lookup(h Handle) any {
+	gMu.Lock()
+	defer gMu.Unlock()
+	return gHandles[h]
+}
+
+// Code returns 0 if err is nil, otherwise the negated amp.ErrCode of err (recording err for LastError).
+func Code(err error) int32 {
+	if err == nil {
+		return 0
+	}
+	gMu.Lock()
+	gLastErr = err
+	gMu.Unlock()
+
+	var ampErr *amp.Err
+	if errors.As(err, &ampErr) {
+		return -int32(ampErr.Code)
+	}
+	return -int32(amp.ErrCode_UnnamedErr)
+}
+
+// Recovered returns the error reported for a panic recovered at the C boundary, where unwinding would kill the embedding process.
+func Recovered(r any) error {
+	return amp.ErrCode_InternalErr.Errorf("panic: %v", r)
+}
+
+// LastError returns the message of the most recent error passed to Code().
+func LastError() string {
+	gMu.Lock()
+	defer gMu.Unlock()
+	if gLastErr == nil {
+		return ""
+	}
+	return gLastErr.Error()
+}
+
+// HostStart starts an embedded host via the HostStarter.
+func HostStart(config string) (Handle, error) {
+	gMu.Lock()
+	starter := gHostStarter
+	gMu.Unlock()
+	if starter == nil {
+		return 0, ErrNoHost
+	}
+	host, err := starter(config)
+	if err != nil {
+		return 0, err
+	}
+	embed := &embeddedHost{
+		host: host,
+	}
+	if err = embed.StartService(host); err != nil {
+		host.Close()
+		return 0, err
+	}
+	return newHandle(embed), nil
+}
+
+// embeddedHost is the amp.HostService through which sessions are opened with an embedded host.
+type embeddedHost struct {
+	task.Context
+	host amp.Host
+}
+
+func (embed *embeddedHost) StartService(on amp.Host) error {
+	ctx, err := on.StartChild(&task.Task{
+		Info: task.Info{
+			Label: "cabi",
+		},
+	})
+	if err != nil {
+		return err
+	}
+	embed.Context = ctx
+	return nil
+}
+
+func (embed *embeddedHost) GracefulStop() {
+}
+
+// SessionOpen opens an in-process session with an embedded host.
+func SessionOpen(hostHandle Handle) (Handle, error) {
+	embed, ok := lookup(hostHandle).(*embeddedHost)
+	if !ok {
+		return 0, ErrBadHandle
+	}
+	sess := newSession()
+	via := &localTransport{
+		sess:   sess,
+		toHost: make(chan *amp.TxMsg, 16),
+	}
+
+	hostSess, err := embed.host.StartNewSession(embed, via)
+	if err != nil {
+		return 0, err
+	}
+	sess.send = func(tx *amp.TxMsg) error {
+		select {
+		case via.toHost <- tx:
+			return nil
+		case <-sess.closing:
+			tx.ReleaseRef()
+			return amp.ErrStreamClosed
+		}
+	}
+	sess.onClose = func() {
+		hostSess.Close()
+	}
+	return newHandle(sess), nil
+}
+
+// SessionConnect opens a client session with a remote host serving rpc.Service at the given URL (e.g. "https://host:5192").
+func SessionConnect(url string) (Handle, error) {
+	via, err := rpc.Connect(context.Background(), http.DefaultClient, url)
+	if err != nil {
+		return 0, err
+	}
+	sess := newSession()
+	sess.send = func(tx *amp.TxMsg) error {
+		defer tx.ReleaseRef()
+		return via.SendTx(tx)
+	}
+	sess.onClose = func() {
+		via.Close()
+	}
+	go func() {
+		for {
+			tx, err := via.RecvTx()
+			if err != nil {
+				sess.Close()
+				return
+			}
+			err = sess.deliver(tx)
+			tx.ReleaseRef()
+			if err != nil {
+				return
+			}
+		}
+	}()
+	return newHandle(sess), nil
+}
+
+// TxSend sends a serialized TxMsg to the session's host.
+func TxSend(sessHandle Handle, buf []byte) error {
+	sess, ok := lookup(sessHandle).(*session)
+	if !ok {
+		return ErrBadHandle
+	}
+
+	// buf comes from the embedding app, so its header is checked against buf before anything is allocated for it
+	var header amp.TxHeader
+	if len(buf) < len(header) {
+		return amp.ErrMalformedTx
+	}
+	copy(header[:], buf)
+	if txLen, err := header.TxLen(); err != nil || txLen > int64(len(buf)) {
+		return amp.ErrMalformedTx
+	}
+	tx, err := amp.ReadTxMsg(bytes.NewReader(buf))
+	if err != nil {
+		return err
+	}
+	return sess.send(tx)
+}
+
+// TxRecv copies the next serialized TxMsg from the session's host into dst, waiting up to the given timeout (or indefinitely if < 0).
+// If the tx does not fit in dst, ErrShortBuffer is returned with its size and the tx remains next.
+// ErrCode_Timeout is returned if no tx arrives in time.
+func TxRecv(sessHandle Handle, dst []byte, timeout time.Duration) (int, error) {
+	sess, ok := lookup(sessHandle).(*session)
+	if !ok {
+		return 0, ErrBadHandle
+	}
+	return sess.recv(dst, timeout)
+}
+
+// Close closes a host or session handle.
+func Close(h Handle) error {
+	gMu.Lock()
+	obj := gHandles[h]
+	delete(gHandles, h)
+	gMu.Unlock()
+
+	switch obj := obj.(type) {
+	case *embeddedHost:
+		obj.Context.Close()
+		return obj.host.Close()
+	case *session:
+		return obj.Close()
+	default:
+		return ErrBadHandle
+	}
+}
+
+// session buffers serialized txs from the host until the embedding app receives them.
+type session struct {
+	send      func(tx *amp.TxMsg) error // takes ownership of tx
+	onClose   func()
+	fromHost  chan []byte
+	closing   chan struct{}
+	closeOnce sync.Once
+
+	recvMu  sync.Mutex
+	pending []byte // next tx, if it did not fit the caller's buffer
+}
+
+func newSession() *session {
+	return &session{
+		fromHost: make(chan []byte, 64),
+		closing:  make(chan struct{}),
+	}
+}
+
+func (sess *session) Close() error {
+	first := false
+	sess.closeOnce.Do(func() {
+		close(sess.closing)
+		first = true
+	})
+	if first && sess.onClose != nil {
+		sess.onClose()
+	}
+	return nil
+}
+
+// deliver serializes a tx from the host, blocking until buffered.
+func (sess *session) deliver(tx *amp.TxMsg) error {
+	var buf []byte
+	tx.MarshalToBuffer(&buf)
+	select {
+	case sess.fromHost <- buf:
+		return nil
+	case <-sess.closing:
+		return amp.ErrStreamClosed
+	}
+}
+
+func (sess *session) recv(dst []byte, timeout time.Duration) (int, error) {
+	sess.recvMu.Lock()
+	defer sess.recvMu.Unlock()
+
+	buf := sess.pending
+	if buf == nil {
+		var expired <-chan time.Time
+		if timeout >= 0 {
+			timer := time.NewTimer(timeout)
+			defer timer.Stop()
+			expired = timer.C
+		}
+		select {
+		case buf = <-sess.fromHost:
+		case <-sess.closing:
+			return 0, amp.ErrStreamClosed
+		case <-expired:
+			return 0, amp.ErrCode_Timeout.Error("no tx received")
+		}
+	}
+	if len(buf) > len(dst) {
+		sess.pending = buf
+		return len(buf), ErrShortBuffer
+	}
+	sess.pending = nil
+	return copy(dst, buf), nil
+}
+
+// localTransport is the host side of an in-process session.
+type localTransport struct {
+	sess   *session
+	toHost chan *amp.TxMsg
+}
+
+func (t *localTransport) Label() string {
+	return "cabi"
+}
+
+func (t *localTransport) Close() error {
+	return t.sess.Close()
+}
+
+func (t *localTransport) SendTx(tx *amp.TxMsg) error {
+	return t.sess.deliver(tx)
+}
+
+func (t *localTransport) RecvTx() (*amp.TxMsg, error) {
+	select {
+	case tx := <-t.toHost:
+		return tx, nil
+	case <-t.sess.closing:
+		return nil, amp.ErrStreamClosed
+	}
+}
@@ -0,0 +1,135 @@
+package cabi
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// echoHost starts sessions that send back each tx received, marked as synced.
+type echoHost struct {
+	amp.Host
+	ctx task.Context
+}
+
+type echoSession struct {
+	amp.Session
+	ctx task.Context
+}
+
+func (host *echoHost) StartChild(task *task.Task) (task.Context, error) {
+	return host.ctx.StartChild(task)
+}
+
+func (host *echoHost) StartNewSession(parent amp.HostService, via amp.Transport) (amp.Session, error) {
+	ctx, err := host.ctx.StartChild(&task.Task{
+		Info: task.Info{Label: via.Label()},
+		OnRun: func(ctx task.Context) {
+			for {
+				tx, err := via.RecvTx()
+				if err != nil {
+					return
+				}
+				tx.Status = amp.OpStatus_Synced
+				err = via.SendTx(tx)
+				tx.ReleaseRef()
+				if err != nil {
+					return
+				}
+			}
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &echoSession{ctx: ctx}, nil
+}
+
+func (sess *echoSession) Close() error {
+	return sess.ctx.Close()
+}
+
+func (host *echoHost) Close() error {
+	return host.ctx.Close()
+}
+
+func TestTxRoundTrip(t *testing.T) {
+	if _, err := HostStart(""); err != ErrNoHost {
+		t.Fatalf("expected ErrNoHost, got %v", err)
+	}
+	SetHostStarter(func(config string) (amp.Host, error) {
+		root, err := task.Start(&task.Task{})
+		return &echoHost{ctx: root}, err
+	})
+	defer SetHostStarter(nil)
+
+	hostH, err := HostStart("{}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sessH, err := SessionOpen(hostH)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cellID := tag.ID{0, 1, 2}
+	tx, _ := amp.MarshalAttr(cellID, (&amp.Tag{}).TagSpec().ID, &amp.Tag{Text: "hello"})
+	var buf []byte
+	tx.MarshalToBuffer(&buf)
+	tx.ReleaseRef()
+	if err = TxSend(sessH, buf); err != nil {
+		t.Fatal(err)
+	}
+
+	small := make([]byte, 4)
+	needed, err := TxRecv(sessH, small, time.Second)
+	if err != ErrShortBuffer || needed <= len(small) {
+		t.Fatalf("expected ErrShortBuffer, got %d, %v", needed, err)
+	}
+	if code := Code(err); code != -int32(amp.ErrCode_BadRequest) || LastError() == "" {
+		t.Fatalf("unexpected code %d", code)
+	}
+
+	dst := make([]byte, needed)
+	n, err := TxRecv(sessH, dst, time.Second)
+	if err != nil || n != needed {
+		t.Fatalf("TxRecv: %d, %v", n, err)
+	}
+	echo, err := amp.ReadTxMsg(bytes.NewReader(dst[:n]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := &amp.Tag{}
+	if echo.Status != amp.OpStatus_Synced || len(echo.Ops) != 1 || echo.UnmarshalOpValue(0, got) != nil || got.Text != "hello" {
+		t.Fatalf("unexpected echo: %+v", echo.TxEnvelope)
+	}
+
+	// a short buffer, or a header declaring a short body or more bytes than given, is rejected without being read
+	short := append([]byte(nil), buf...)
+	binary.LittleEndian.PutUint32(short[4:8], 0)
+	huge := append([]byte(nil), buf...)
+	binary.LittleEndian.PutUint32(huge[8:12], 0xFFFFFFFF)
+	for _, malformed := range [][]byte{nil, buf[:5], buf[:len(buf)-1], short, huge} {
+		if err = TxSend(sessH, malformed); err != amp.ErrMalformedTx {
+			t.Fatalf("expected ErrMalformedTx, got %v", err)
+		}
+	}
+
+	if _, err = TxRecv(sessH, dst, 10*time.Millisecond); Code(err) != -int32(amp.ErrCode_Timeout) {
+		t.Fatalf("expected timeout, got %v", err)
+	}
+	if err = Close(sessH); err != nil {
+		t.Fatal(err)
+	}
+	if err = TxSend(sessH, buf); err != ErrBadHandle {
+		t.Fatalf("expected ErrBadHandle, got %v", err)
+	}
+	if err = Close(hostH); err != nil {
+		t.Fatal(err)
+	}
+}
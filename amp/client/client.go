@@ -0,0 +1,222 @@
+// Package client implements the client side of an amp session, so Go programs can sign in, pin cells, and commit changes over any amp.Transport (e.g. rpc.Connect).
+package client
+
+import (
+	"context"
+	"sync"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/symbol"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// Opts specifies a Session.
+type Opts struct {
+	Registry    amp.Registry // resolves attr value types; if nil, amp.RegisterBuiltinTypes()
+	UpdateQueue int          // number of updates a Pin buffers before the session blocks on its reader; if <= 0, 64
+	Symbols     symbol.Table // if set, strings decoded from the host's txs are interned through it (see amp.StringInterner)
+
+	// Answers the host's LoginChallenge (e.g. via amp.SignChallenge), or nil if the host is not expected to issue one.
+	OnChallenge func(challenge *amp.LoginChallenge) (*amp.LoginResponse, error)
+}
+
+// Session is a client's session with an amp.Host over a Transport.
+type Session struct {
+	task.Context
+	opts     Opts
+	via      amp.Transport
+	interner *amp.StringInterner // nil if Opts.Symbols is nil
+
+	mu       sync.Mutex
+	requests map[tag.ID]*request // by context ID
+	recvErr  error               // set once the transport closes
+}
+
+// request is an open request awaiting txs from the host with its context ID.
+type request struct {
+	txs    chan *amp.TxMsg
+	closed chan struct{}
+	once   sync.Once
+}
+
+func (req *request) close() {
+	req.once.Do(func() {
+		close(req.closed)
+	})
+}
+
+// Start starts a Session as a child of the given context, receiving txs from the given transport until either closes.
+func Start(parent task.Context, via amp.Transport, opts Opts) (*Session, error) {
+	if opts.Registry == nil {
+		opts.Registry = amp.NewRegistry()
+		amp.RegisterBuiltinTypes(opts.Registry)
+	}
+	if opts.UpdateQueue <= 0 {
+		opts.UpdateQueue = 64
+	}
+	sess := &Session{
+		opts:     opts,
+		via:      via,
+		requests: make(map[tag.ID]*request),
+	}
+	if opts.Symbols != nil {
+		sess.interner = amp.NewStringInterner(opts.Symbols, 0, 0)
+	}
+
+	var err error
+	sess.Context, err = parent.StartChild(&task.Task{
+		Info: task.Info{
+			Label: "client " + via.Label(),
+		},
+		OnRun: func(ctx task.Context) {
+			sess.recvLoop()
+		},
+		OnClosing: func() {
+			via.Close()
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sess, nil
+}
+
+func (sess *Session) recvLoop() {
+	for {
+		tx, err := sess.via.RecvTx()
+		if err != nil {
+			sess.mu.Lock()
+			sess.recvErr = err
+			for _, req := range sess.requests {
+				req.close()
+			}
+			sess.mu.Unlock()
+			return
+		}
+
+		sess.mu.Lock()
+		req := sess.requests[tx.ContextID()]
+		sess.mu.Unlock()
+		if req == nil {
+			tx.ReleaseRef() // e.g. a reply to a closed request
+			continue
+		}
+		select {
+		case req.txs <- tx:
+		case <-req.closed:
+			tx.ReleaseRef()
+		case <-sess.Closing():
+			tx.ReleaseRef()
+			return
+		}
+	}
+}
+
+// openRequest registers a request to receive txs from the host with the given context ID.
+func (sess *Session) openRequest(contextID tag.ID) (*request, error) {
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+
+	if sess.recvErr != nil {
+		return nil, sess.recvErr
+	}
+	req := &request{
+		txs:    make(chan *amp.TxMsg, sess.opts.UpdateQueue),
+		closed: make(chan struct{}),
+	}
+	sess.requests[contextID] = req
+	return req, nil
+}
+
+func (sess *Session) closeRequest(contextID tag.ID) {
+	sess.mu.Lock()
+	req := sess.requests[contextID]
+	delete(sess.requests, contextID)
+	sess.mu.Unlock()
+
+	if req != nil {
+		req.close()
+	}
+}
+
+// next returns the next tx for the given request, or the error reported by the host.
+func (sess *Session) next(ctx context.Context, req *request) (*amp.TxMsg, error) {
+	select {
+	case tx := <-req.txs:
+		if len(tx.Ops) == 1 && tx.Ops[0].CellID == amp.MetaNodeID && tx.Ops[0].AttrID == (&amp.Err{}).TagSpec().ID {
+			reported := &amp.Err{}
+			err := tx.UnmarshalOpValue(0, reported)
+			tx.ReleaseRef()
+			if err != nil {
+				return nil, err
+			}
+			return nil, reported
+		}
+		return tx, nil
+	case <-req.closed:
+		return nil, amp.ErrStreamClosed
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// send sends a meta attr value to the host under the given context ID.
+func (sess *Session) send(contextID tag.ID, status amp.OpStatus, val tag.Value) error {
+	tx, err := amp.MarshalAttr(amp.MetaNodeID, val.TagSpec().ID, val)
+	if err != nil {
+		return err
+	}
+	tx.SetContextID(contextID)
+	tx.Status = status
+	err = sess.via.SendTx(tx)
+	tx.ReleaseRef()
+	return err
+}
+
+// Login signs in, answering a LoginChallenge with Opts.OnChallenge if the host issues one, and returns the host's LoginCheckpoint.
+func (sess *Session) Login(ctx context.Context, login *amp.Login) (*amp.LoginCheckpoint, error) {
+	loginID := tag.Now()
+	req, err := sess.openRequest(loginID)
+	if err != nil {
+		return nil, err
+	}
+	defer sess.closeRequest(loginID)
+
+	if err = sess.send(loginID, amp.OpStatus_Syncing, login); err != nil {
+		return nil, err
+	}
+
+	for {
+		tx, err := sess.next(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		val, err := tx.CheckMetaAttr(sess.opts.Registry)
+		tx.ReleaseRef()
+		if err != nil {
+			return nil, err
+		}
+
+		switch msg := val.(type) {
+		case *amp.LoginChallenge:
+			if sess.opts.OnChallenge == nil {
+				return nil, amp.ErrCode_LoginFailed.Error("host issued a LoginChallenge but Opts.OnChallenge is not set")
+			}
+			response, err := sess.opts.OnChallenge(msg)
+			if err != nil {
+				return nil, err
+			}
+			if err = sess.send(loginID, amp.OpStatus_Syncing, response); err != nil {
+				return nil, err
+			}
+		case *amp.LoginCheckpoint:
+			return msg, nil
+		}
+	}
+}
+
+// Registry returns the registry used to resolve attr value types.
+func (sess *Session) Registry() amp.Registry {
+	return sess.opts.Registry
+}
@@ -0,0 +1,182 @@
+package client
+
+import (
+	"context"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+// Element is a single element of a cell update, where Value is decoded via the session's Registry.
+type Element struct {
+	OpCode amp.TxOpCode
+	CellID tag.ID
+	AttrID tag.ID
+	ItemID tag.ID
+	Value  tag.Value // nil for a delete or if the attr's type is not registered
+}
+
+// Update is a tx sent by the host for a Pin.
+type Update struct {
+	Status   amp.OpStatus
+	Elements []Element
+}
+
+// Synced returns true if this update completes the pin's state (see amp.OpStatus_Synced).
+func (u *Update) Synced() bool {
+	return u.Status == amp.OpStatus_Synced
+}
+
+// Values returns the values of the given update that are of type V, in order.
+func Values[V tag.Value](u *Update) []V {
+	var vals []V
+	for _, elem := range u.Elements {
+		if val, ok := elem.Value.(V); ok {
+			vals = append(vals, val)
+		}
+	}
+	return vals
+}
+
+// PinOpts specifies a pin request.
+type PinOpts struct {
+	Attrs         []tag.ID // attrs to pin; if empty, all attrs
+	Maintain      bool     // if set, updates are sent until the pin is closed (vs closing once synced)
+	WaitForTarget bool     // see amp.PinRequest.WaitForTarget
+}
+
+// Pin is an open pin request, whose updates are received via Next().
+type Pin struct {
+	sess  *Session
+	reqID tag.ID
+	req   *request
+}
+
+// Pin pins the given URL.
+func (sess *Session) Pin(url string, opts PinOpts) (*Pin, error) {
+	pinReq := &amp.PinRequest{
+		PinTarget:     &amp.Tag{URL: url},
+		StateSync:     amp.StateSync_CloseOnSync,
+		WaitForTarget: opts.WaitForTarget,
+	}
+	if opts.Maintain {
+		pinReq.StateSync = amp.StateSync_Maintain
+	}
+	for _, attrID := range opts.Attrs {
+		attr := &amp.Tag{}
+		attr.SetID(attrID)
+		pinReq.PinAttrs = append(pinReq.PinAttrs, attr)
+	}
+
+	pin := &Pin{
+		sess:  sess,
+		reqID: tag.Now(),
+	}
+	var err error
+	if pin.req, err = sess.openRequest(pin.reqID); err != nil {
+		return nil, err
+	}
+	if err = sess.send(pin.reqID, amp.OpStatus_Syncing, pinReq); err != nil {
+		sess.closeRequest(pin.reqID)
+		return nil, err
+	}
+	return pin, nil
+}
+
+// Next blocks until the next update arrives, returning amp.ErrRequestClosed once the host closes the pin.
+func (pin *Pin) Next(ctx context.Context) (*Update, error) {
+	tx, err := pin.sess.next(ctx, pin.req)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.ReleaseRef()
+
+	if tx.Status == amp.OpStatus_Closed {
+		pin.sess.closeRequest(pin.reqID)
+		return nil, amp.ErrRequestClosed
+	}
+
+	update := &Update{
+		Status:   tx.Status,
+		Elements: make([]Element, len(tx.Ops)),
+	}
+	for i, op := range tx.Ops {
+		elem := Element{
+			OpCode: op.OpCode,
+			CellID: op.CellID,
+			AttrID: op.AttrID,
+			ItemID: op.ItemID,
+		}
+		if op.DataLen > 0 {
+			if val, err := pin.sess.opts.Registry.MakeValue(op.AttrID); err == nil {
+				if err = tx.UnmarshalOpValueInterned(i, val, pin.sess.interner); err == nil {
+					elem.Value = val
+				}
+			}
+		}
+		update.Elements[i] = elem
+	}
+	return update, nil
+}
+
+// AwaitSynced returns the pin's state, merging updates until the host signals OpStatus_Synced.
+func (pin *Pin) AwaitSynced(ctx context.Context) (*Update, error) {
+	state := &Update{}
+	for {
+		update, err := pin.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		state.Elements = append(state.Elements, update.Elements...)
+		if update.Synced() {
+			state.Status = update.Status
+			return state, nil
+		}
+	}
+}
+
+// Close closes this pin, signaling the host to close the request.
+func (pin *Pin) Close() error {
+	pin.sess.closeRequest(pin.reqID)
+
+	tx := amp.NewTxMsg(true)
+	tx.SetContextID(pin.reqID)
+	tx.Status = amp.OpStatus_Closed
+	err := pin.sess.via.SendTx(tx)
+	tx.ReleaseRef()
+	return err
+}
+
+// Commit sends the given tx to be merged into the pinned cell -- see Upsert() and Delete().
+// The caller retains ownership of tx.
+func (pin *Pin) Commit(tx *amp.TxMsg) error {
+	tx.SetContextID(pin.reqID)
+	return pin.sess.via.SendTx(tx)
+}
+
+// Upsert commits a single element value, where the attr is the value's TagSpec.
+func (pin *Pin) Upsert(cellID, itemID tag.ID, val tag.Value) error {
+	return pin.commitOp(amp.TxOpCode_UpsertElement, cellID, val.TagSpec().ID, itemID, val)
+}
+
+// Delete commits the deletion of a single element.
+func (pin *Pin) Delete(cellID, attrID, itemID tag.ID) error {
+	return pin.commitOp(amp.TxOpCode_DeleteElement, cellID, attrID, itemID, nil)
+}
+
+func (pin *Pin) commitOp(opCode amp.TxOpCode, cellID, attrID, itemID tag.ID, val tag.Value) error {
+	tx := amp.NewTxMsg(true)
+	defer tx.ReleaseRef()
+
+	op := amp.TxOp{
+		OpCode: opCode,
+	}
+	op.CellID = cellID
+	op.AttrID = attrID
+	op.ItemID = itemID
+	op.EditID = tag.Genesis(tx.GenesisID())
+	if err := tx.MarshalOp(&op, val); err != nil {
+		return err
+	}
+	return pin.Commit(tx)
+}
@@ -0,0 +1,155 @@
+package client
+
+import (
+	"context"
+	"crypto/ed25519"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// pipe is one end of an in-process transport pair.
+type pipe struct {
+	in, out  chan *amp.TxMsg
+	closing  chan struct{}
+	closeErr sync.Once
+}
+
+func newPipe() (client, host *pipe) {
+	a, b := make(chan *amp.TxMsg, 8), make(chan *amp.TxMsg, 8)
+	closing := make(chan struct{})
+	return &pipe{in: a, out: b, closing: closing}, &pipe{in: b, out: a, closing: closing}
+}
+
+func (p *pipe) Label() string { return "pipe" }
+
+func (p *pipe) Close() error {
+	p.closeErr.Do(func() { close(p.closing) })
+	return nil
+}
+
+func (p *pipe) SendTx(tx *amp.TxMsg) error {
+	tx.AddRef()
+	select {
+	case p.out <- tx:
+		return nil
+	case <-p.closing:
+		return amp.ErrStreamClosed
+	}
+}
+
+func (p *pipe) RecvTx() (*amp.TxMsg, error) {
+	select {
+	case tx := <-p.in:
+		return tx, nil
+	case <-p.closing:
+		return nil, amp.ErrStreamClosed
+	}
+}
+
+// serveFake challenges the login, then serves each pin with a label element and echoes back commits.
+func serveFake(via *pipe, reg amp.Registry, devicePub ed25519.PublicKey) {
+	reply := func(contextID tag.ID, status amp.OpStatus, val tag.Value) {
+		tx, _ := amp.MarshalAttr(amp.MetaNodeID, val.TagSpec().ID, val)
+		tx.SetContextID(contextID)
+		tx.Status = status
+		via.SendTx(tx)
+	}
+
+	var challenge *amp.LoginChallenge
+	for {
+		tx, err := via.RecvTx()
+		if err != nil {
+			return
+		}
+		val, _ := tx.CheckMetaAttr(reg)
+		switch msg := val.(type) {
+		case *amp.Login:
+			challenge = amp.NewLoginChallenge()
+			reply(tx.ContextID(), amp.OpStatus_Syncing, challenge)
+		case *amp.LoginResponse:
+			if !ed25519.Verify(devicePub, challenge.Hash, msg.HashResponse) {
+				reply(tx.ContextID(), amp.OpStatus_Closed, amp.ErrorToValue(amp.ErrAccessDenied))
+				continue
+			}
+			reply(tx.ContextID(), amp.OpStatus_Synced, &amp.LoginCheckpoint{AccessToken: "token"})
+		case *amp.PinRequest:
+			state, _ := amp.MarshalAttr(tag.ID{0, 0, 7}, (&amp.Tag{}).TagSpec().ID, &amp.Tag{Text: msg.PinTarget.URL})
+			state.SetContextID(tx.ContextID())
+			state.Status = amp.OpStatus_Synced
+			via.SendTx(state)
+		default:
+			if tx.Status == amp.OpStatus_Closed {
+				closed := amp.NewTxMsg(true)
+				closed.SetContextID(tx.ContextID())
+				closed.Status = amp.OpStatus_Closed
+				via.SendTx(closed)
+				continue
+			}
+			tx.Status = amp.OpStatus_Synced
+			via.SendTx(tx)
+		}
+	}
+}
+
+func TestClientSession(t *testing.T) {
+	root, _ := task.Start(&task.Task{})
+	defer root.Close()
+
+	reg := amp.NewRegistry()
+	amp.RegisterBuiltinTypes(reg)
+	pub, priv, _ := ed25519.GenerateKey(nil)
+
+	clientEnd, hostEnd := newPipe()
+	go serveFake(hostEnd, reg, pub)
+
+	sess, err := Start(root, clientEnd, Opts{
+		OnChallenge: func(challenge *amp.LoginChallenge) (*amp.LoginResponse, error) {
+			return amp.SignChallenge(priv, challenge), nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	checkpoint, err := sess.Login(ctx, &amp.Login{})
+	if err != nil || checkpoint.AccessToken != "token" {
+		t.Fatalf("login failed: %v", err)
+	}
+
+	pin, err := sess.Pin("badges:", PinOpts{Maintain: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	state, err := pin.AwaitSynced(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if labels := Values[*amp.Tag](state); len(labels) != 1 || labels[0].Text != "badges:" {
+		t.Fatalf("unexpected state: %+v", state)
+	}
+
+	if err = pin.Upsert(tag.ID{0, 0, 9}, tag.ID{}, &amp.Tag{Text: "hello"}); err != nil {
+		t.Fatal(err)
+	}
+	update, err := pin.Next(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if labels := Values[*amp.Tag](update); len(labels) != 1 || labels[0].Text != "hello" || update.Elements[0].CellID != (tag.ID{0, 0, 9}) {
+		t.Fatalf("unexpected update: %+v", update)
+	}
+
+	if err = pin.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = pin.Next(ctx); err == nil {
+		t.Fatal("expected closed pin to return an error")
+	}
+}
@@ -0,0 +1,1021 @@
+package codegen
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+)
+
+// CSharpNamespace is the namespace of the C# output, matching the csharp_namespace of amp.proto (which the output replaces).
+const CSharpNamespace = "AMP"
+
+// WriteCSharp writes a C# source file for Unity (or any .NET Standard 2.1 runtime) declaring:
+//   - a class for each message type in the schema, with Encode() and MergeFrom(), and an enum for each enum type
+//   - AttrSpecs, a table of each registered attr (and AttrSpecs.ByID)
+//   - TxMsg, which (de)serializes the flat tx buffers exchanged with libamp
+//   - Native, P/Invoke bindings for the C API exported by cmd/amp.sdk.lib.cgo
+//   - Host, Session, and PinHandle: idiomatic wrappers where pin updates are raised as events by Session.Poll()
+//
+// The schema must include the builtin amp attrs (see amp.RegisterBuiltinTypes).
+func WriteCSharp(w io.Writer, schema *Schema) error {
+	attrNames := make(map[string]bool)
+	for _, name := range []string{"ByID", "AttrSpecs"} {
+		attrNames[name] = true
+	}
+	for _, msg := range schema.Messages {
+		if csRuntimeTypes[msg.ShortName] {
+			return fmt.Errorf("message %s collides with a C# runtime type", msg.Name)
+		}
+	}
+	hasPinRequest := false
+	for _, attr := range schema.Attrs {
+		name := csAttrName(attr.Canonic)
+		if attrNames[name] {
+			return fmt.Errorf("attr %q collides with C# name %q", attr.Canonic, name)
+		}
+		attrNames[name] = true
+		if name == "PinRequest" && attr.Message != nil && attr.Message.Name == "amp.PinRequest" {
+			hasPinRequest = true
+		}
+	}
+	if !hasPinRequest {
+		return fmt.Errorf("schema is missing the builtin amp attrs -- see amp.RegisterBuiltinTypes")
+	}
+
+	out := bufio.NewWriter(w)
+	strings.NewReplacer(
+		"$Namespace", CSharpNamespace,
+		"$TxHeaderSize", strconv.Itoa(int(amp.Const_TxHeader_Size)),
+		"$TxHeaderVersion", strconv.Itoa(int(amp.Const_TxHeader_Version)),
+		"$TxHeaderMarker", fmt.Sprintf("0x%X", int(amp.Const_TxHeader_Marker)),
+		"$TxMaxFields", strconv.Itoa(int(amp.TxField_MaxFields)),
+		"$MetaNodeID", fmt.Sprintf("%dUL, %dUL, %dUL", amp.MetaNodeID[0], amp.MetaNodeID[1], amp.MetaNodeID[2]),
+	).WriteString(out, csHeader)
+
+	for _, enum := range schema.Enums {
+		fmt.Fprintf(out, "\n    // %s\n    public enum %s\n    {\n", enum.Name, enum.ShortName)
+		for _, val := range enum.Values {
+			fmt.Fprintf(out, "        %s = %d,\n", val.Name, val.Number)
+		}
+		out.WriteString("    }\n")
+	}
+
+	for _, msg := range schema.Messages {
+		writeCSMessage(out, msg)
+	}
+
+	out.WriteString("\n    public static class AttrSpecs\n    {\n")
+	for _, attr := range schema.Attrs {
+		typ := "AttrSpec"
+		if attr.Message != nil {
+			typ = "AttrSpec<" + attr.Message.ShortName + ">"
+		}
+		fmt.Fprintf(out, "        public static readonly %s %s = new %s(%q, new TagID(0x%XUL, 0x%XUL, 0x%XUL));\n",
+			typ, csAttrName(attr.Canonic), typ, attr.Canonic, attr.ID[0], attr.ID[1], attr.ID[2])
+	}
+	out.WriteString("\n        public static readonly Dictionary<TagID, AttrSpec> ByID = new Dictionary<TagID, AttrSpec>\n        {\n")
+	for _, attr := range schema.Attrs {
+		name := csAttrName(attr.Canonic)
+		fmt.Fprintf(out, "            { %s.ID, %s },\n", name, name)
+	}
+	out.WriteString("        };\n    }\n}\n")
+	return out.Flush()
+}
+
+// csRuntimeTypes are the types declared by csHeader.
+var csRuntimeTypes = map[string]bool{
+	"IProtoMessage": true,
+	"ProtoWriter":   true,
+	"ProtoReader":   true,
+	"TagID":         true,
+	"TxOp":          true,
+	"TxMsg":         true,
+	"AttrSpec":      true,
+	"AttrSpecs":     true,
+	"AmpException":  true,
+	"Native":        true,
+	"Host":          true,
+	"Session":       true,
+	"PinHandle":     true,
+}
+
+// csAttrName returns the AttrSpecs member name of an attr, e.g. "amp.attr.Tag" => "Tag".
+func csAttrName(canonic string) string {
+	name := strings.TrimPrefix(canonic, amp.AttrSpec.Canonic+".")
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+// csFieldName returns the C# member name of a field, which may not be the name of its class or a generated method.
+func csFieldName(msg *Message, field *Field) string {
+	switch field.Name {
+	case msg.ShortName, "Encode", "EncodeTo", "MergeFrom", "Decode":
+		return field.Name + "_"
+	}
+	return field.Name
+}
+
+// csType returns the C# type of a single value of the given field.
+func csType(field *Field) string {
+	switch field.Kind {
+	case KindBool:
+		return "bool"
+	case KindInt32, KindSint32, KindSfixed32:
+		return "int"
+	case KindUint32, KindFixed32:
+		return "uint"
+	case KindInt64, KindSint64, KindSfixed64:
+		return "long"
+	case KindUint64, KindFixed64:
+		return "ulong"
+	case KindFloat:
+		return "float"
+	case KindDouble:
+		return "double"
+	case KindString:
+		return "string"
+	case KindEnum:
+		return field.Enum.ShortName
+	case KindMessage:
+		return field.Message.ShortName
+	case KindMap:
+		return "Dictionary<" + csType(field.Key) + ", " + csType(field.Value) + ">"
+	default:
+		return "byte[]"
+	}
+}
+
+// csMethod returns the ProtoReader / ProtoWriter method suffix for a scalar kind.
+func csMethod(kind Kind) string {
+	switch kind {
+	case KindBool:
+		return "Bool"
+	case KindInt32, KindEnum:
+		return "Int32"
+	case KindInt64:
+		return "Int64"
+	case KindUint32:
+		return "UInt32"
+	case KindUint64:
+		return "UInt64"
+	case KindSint32:
+		return "SInt32"
+	case KindSint64:
+		return "SInt64"
+	case KindFixed32:
+		return "Fixed32"
+	case KindFixed64:
+		return "Fixed64"
+	case KindSfixed32:
+		return "SFixed32"
+	case KindSfixed64:
+		return "SFixed64"
+	case KindFloat:
+		return "Float"
+	case KindDouble:
+		return "Double"
+	case KindString:
+		return "String"
+	case KindMessage:
+		return "Message"
+	default:
+		return "Bytes"
+	}
+}
+
+// csWrite returns the statement writing a single value of the given field to a ProtoWriter.
+func csWrite(writer string, field *Field, expr string) string {
+	if field.Kind == KindEnum {
+		expr = "(int)" + expr
+	}
+	return fmt.Sprintf("%s.Write%s(%s);", writer, csMethod(field.Kind), expr)
+}
+
+// csRead returns the expression reading a single value of the given field from a ProtoReader.
+func csRead(reader string, field *Field) string {
+	switch field.Kind {
+	case KindEnum:
+		return fmt.Sprintf("(%s)%s.ReadInt32()", field.Enum.ShortName, reader)
+	case KindMessage:
+		return fmt.Sprintf("%s.ReadMessage<%s>()", reader, field.Message.ShortName)
+	}
+	return fmt.Sprintf("%s.Read%s()", reader, csMethod(field.Kind))
+}
+
+// csZero returns the C# value of an absent field value.
+func csZero(field *Field) string {
+	switch field.Kind {
+	case KindString:
+		return `""`
+	case KindBytes:
+		return "Array.Empty<byte>()"
+	case KindMessage, KindMap:
+		return "new " + csType(field) + "()"
+	}
+	return "default(" + csType(field) + ")"
+}
+
+func writeCSMessage(out *bufio.Writer, msg *Message) {
+	name := msg.ShortName
+
+	fmt.Fprintf(out, "\n    // %s\n    public sealed partial class %s : IProtoMessage\n    {\n", msg.Name, name)
+	for _, field := range msg.Fields {
+		typ := csType(field)
+		switch {
+		case field.Repeated:
+			fmt.Fprintf(out, "        public List<%s> %s = new List<%s>();\n", typ, csFieldName(msg, field), typ)
+		case field.Kind == KindMessage:
+			fmt.Fprintf(out, "        public %s %s;\n", typ, csFieldName(msg, field))
+		case field.Kind == KindString, field.Kind == KindBytes, field.Kind == KindMap:
+			fmt.Fprintf(out, "        public %s %s = %s;\n", typ, csFieldName(msg, field), csZero(field))
+		default:
+			fmt.Fprintf(out, "        public %s %s;\n", typ, csFieldName(msg, field))
+		}
+	}
+
+	fmt.Fprintf(out, "\n        public static %s Decode(byte[] buf)\n        {\n", name)
+	fmt.Fprintf(out, "            var m = new %s();\n            m.MergeFrom(new ProtoReader(buf));\n            return m;\n        }\n", name)
+	out.WriteString("\n        public byte[] Encode()\n        {\n            var w = new ProtoWriter();\n            EncodeTo(w);\n            return w.ToArray();\n        }\n")
+
+	// encoder
+	out.WriteString("\n        public void EncodeTo(ProtoWriter w)\n        {\n")
+	for _, field := range msg.Fields {
+		f := "this." + csFieldName(msg, field)
+		wire := field.Kind.WireType()
+		switch {
+		case field.Kind == KindMap:
+			fmt.Fprintf(out, "            foreach (var kv in %s)\n            {\n                var e = new ProtoWriter();\n", f)
+			fmt.Fprintf(out, "                e.WriteKey(1, %d); %s\n", field.Key.Kind.WireType(), csWrite("e", field.Key, "kv.Key"))
+			fmt.Fprintf(out, "                e.WriteKey(2, %d); %s\n", field.Value.Kind.WireType(), csWrite("e", field.Value, "kv.Value"))
+			fmt.Fprintf(out, "                w.WriteKey(%d, 2); w.WriteBytes(e.ToArray());\n            }\n", field.Number)
+		case field.Repeated && field.Kind.Packable():
+			fmt.Fprintf(out, "            if (%s.Count > 0)\n            {\n                var p = new ProtoWriter();\n", f)
+			fmt.Fprintf(out, "                foreach (var v in %s) %s\n", f, csWrite("p", field, "v"))
+			fmt.Fprintf(out, "                w.WriteKey(%d, 2); w.WriteBytes(p.ToArray());\n            }\n", field.Number)
+		case field.Repeated:
+			fmt.Fprintf(out, "            foreach (var v in %s) { w.WriteKey(%d, %d); %s }\n", f, field.Number, wire, csWrite("w", field, "v"))
+		default:
+			var cond string // proto3 omits default values
+			switch field.Kind {
+			case KindMessage:
+				cond = f + " != null"
+			case KindString:
+				cond = "!string.IsNullOrEmpty(" + f + ")"
+			case KindBytes:
+				cond = f + " != null && " + f + ".Length > 0"
+			case KindBool:
+				cond = f
+			default:
+				cond = f + " != 0"
+			}
+			fmt.Fprintf(out, "            if (%s) { w.WriteKey(%d, %d); %s }\n", cond, field.Number, wire, csWrite("w", field, f))
+		}
+	}
+	out.WriteString("        }\n")
+
+	// decoder
+	out.WriteString("\n        public void MergeFrom(ProtoReader r)\n        {\n            while (!r.Done)\n            {\n")
+	out.WriteString("                int field = r.ReadKey(out int wire);\n                switch (field)\n                {\n")
+	for _, field := range msg.Fields {
+		f := "this." + csFieldName(msg, field)
+		fmt.Fprintf(out, "                    case %d:\n", field.Number)
+		switch {
+		case field.Kind == KindMap:
+			fmt.Fprintf(out, "                    {\n                        var e = r.ReadSub();\n                        %s k = %s;\n                        %s v = %s;\n",
+				csType(field.Key), csZero(field.Key), csType(field.Value), csZero(field.Value))
+			out.WriteString("                        while (!e.Done)\n                        {\n                            int ef = e.ReadKey(out int ew);\n")
+			fmt.Fprintf(out, "                            if (ef == 1) k = %s;\n", csRead("e", field.Key))
+			fmt.Fprintf(out, "                            else if (ef == 2) v = %s;\n", csRead("e", field.Value))
+			out.WriteString("                            else e.Skip(ew);\n                        }\n")
+			fmt.Fprintf(out, "                        %s[k] = v;\n                        break;\n                    }\n", f)
+			continue
+		case field.Repeated && field.Kind.Packable():
+			fmt.Fprintf(out, "                        if (wire == 2) { var p = r.ReadSub(); while (!p.Done) %s.Add(%s); }\n", f, csRead("p", field))
+			fmt.Fprintf(out, "                        else %s.Add(%s);\n", f, csRead("r", field))
+		case field.Repeated:
+			fmt.Fprintf(out, "                        %s.Add(%s);\n", f, csRead("r", field))
+		default:
+			fmt.Fprintf(out, "                        %s = %s;\n", f, csRead("r", field))
+		}
+		out.WriteString("                        break;\n")
+	}
+	out.WriteString("                    default:\n                        r.Skip(wire);\n                        break;\n                }\n            }\n        }\n    }\n")
+}
+
+const csHeader = `// Code generated by amp/codegen from an amp.Registry. DO NOT EDIT.
+
+using System;
+using System.Collections.Generic;
+using System.IO;
+using System.Runtime.InteropServices;
+using System.Text;
+
+namespace $Namespace
+{
+    // IProtoMessage is implemented by each generated message class.
+    public interface IProtoMessage
+    {
+        byte[] Encode();
+        void EncodeTo(ProtoWriter w);
+        void MergeFrom(ProtoReader r);
+    }
+
+    // ProtoWriter appends protobuf wire-format fields.
+    public sealed class ProtoWriter
+    {
+        readonly MemoryStream buf = new MemoryStream();
+
+        public int Length => (int)buf.Length;
+        public byte[] ToArray() => buf.ToArray();
+
+        public void WriteRaw(byte[] src, int offset, int count) => buf.Write(src, offset, count);
+        public void WriteKey(int field, int wire) => WriteUInt64((ulong)((field << 3) | wire));
+
+        public void WriteUInt64(ulong v)
+        {
+            for (; v >= 0x80; v >>= 7)
+                buf.WriteByte((byte)(v | 0x80));
+            buf.WriteByte((byte)v);
+        }
+
+        public void WriteInt64(long v) => WriteUInt64(unchecked((ulong)v));
+        public void WriteInt32(int v) => WriteUInt64(unchecked((ulong)(long)v));
+        public void WriteUInt32(uint v) => WriteUInt64(v);
+        public void WriteBool(bool v) => buf.WriteByte(v ? (byte)1 : (byte)0);
+        public void WriteSInt32(int v) => WriteUInt32(unchecked((uint)((v << 1) ^ (v >> 31))));
+        public void WriteSInt64(long v) => WriteUInt64(unchecked((ulong)((v << 1) ^ (v >> 63))));
+
+        public void WriteFixed32(uint v)
+        {
+            for (int i = 0; i < 32; i += 8)
+                buf.WriteByte((byte)(v >> i));
+        }
+
+        public void WriteFixed64(ulong v)
+        {
+            for (int i = 0; i < 64; i += 8)
+                buf.WriteByte((byte)(v >> i));
+        }
+
+        public void WriteSFixed32(int v) => WriteFixed32(unchecked((uint)v));
+        public void WriteSFixed64(long v) => WriteFixed64(unchecked((ulong)v));
+        public void WriteFloat(float v) => WriteFixed32(unchecked((uint)BitConverter.SingleToInt32Bits(v)));
+        public void WriteDouble(double v) => WriteFixed64(unchecked((ulong)BitConverter.DoubleToInt64Bits(v)));
+
+        public void WriteBytes(byte[] v)
+        {
+            WriteUInt32((uint)v.Length);
+            buf.Write(v, 0, v.Length);
+        }
+
+        public void WriteString(string v) => WriteBytes(Encoding.UTF8.GetBytes(v));
+        public void WriteMessage(IProtoMessage v) => WriteBytes(v.Encode());
+    }
+
+    // ProtoReader reads protobuf wire-format fields.
+    public sealed class ProtoReader
+    {
+        readonly byte[] buf;
+        readonly int end;
+        int pos;
+
+        public ProtoReader(byte[] buf) : this(buf, 0, buf.Length) { }
+
+        public ProtoReader(byte[] buf, int start, int end)
+        {
+            this.buf = buf;
+            this.pos = start;
+            this.end = end;
+        }
+
+        public bool Done => pos >= end;
+
+        void Need(int n)
+        {
+            if (n < 0 || pos + n > end)
+                throw new InvalidDataException("proto: truncated field");
+        }
+
+        public ulong ReadUInt64()
+        {
+            ulong n = 0;
+            for (int shift = 0; shift < 64; shift += 7)
+            {
+                Need(1);
+                byte b = buf[pos++];
+                n |= (ulong)(b & 0x7F) << shift;
+                if (b < 0x80)
+                    return n;
+            }
+            throw new InvalidDataException("proto: malformed varint");
+        }
+
+        public int ReadKey(out int wire)
+        {
+            ulong k = ReadUInt64();
+            wire = (int)(k & 7);
+            return (int)(k >> 3);
+        }
+
+        public long ReadInt64() => unchecked((long)ReadUInt64());
+        public int ReadInt32() => unchecked((int)ReadUInt64());
+        public uint ReadUInt32() => unchecked((uint)ReadUInt64());
+        public bool ReadBool() => ReadUInt64() != 0;
+        public int ReadSInt32() { uint n = ReadUInt32(); return unchecked((int)(n >> 1) ^ -(int)(n & 1)); }
+        public long ReadSInt64() { ulong n = ReadUInt64(); return unchecked((long)(n >> 1) ^ -(long)(n & 1)); }
+
+        public uint ReadFixed32()
+        {
+            Need(4);
+            uint v = 0;
+            for (int i = 0; i < 32; i += 8)
+                v |= (uint)buf[pos++] << i;
+            return v;
+        }
+
+        public ulong ReadFixed64()
+        {
+            Need(8);
+            ulong v = 0;
+            for (int i = 0; i < 64; i += 8)
+                v |= (ulong)buf[pos++] << i;
+            return v;
+        }
+
+        public int ReadSFixed32() => unchecked((int)ReadFixed32());
+        public long ReadSFixed64() => unchecked((long)ReadFixed64());
+        public float ReadFloat() => BitConverter.Int32BitsToSingle(unchecked((int)ReadFixed32()));
+        public double ReadDouble() => BitConverter.Int64BitsToDouble(unchecked((long)ReadFixed64()));
+
+        public byte[] ReadBytes()
+        {
+            int n = (int)ReadUInt32();
+            Need(n);
+            var v = new byte[n];
+            Buffer.BlockCopy(buf, pos, v, 0, n);
+            pos += n;
+            return v;
+        }
+
+        public string ReadString() => Encoding.UTF8.GetString(ReadBytes());
+
+        // ReadSub returns a reader over the next length-delimited field (e.g. a packed array, map entry, or message).
+        public ProtoReader ReadSub()
+        {
+            int n = (int)ReadUInt32();
+            Need(n);
+            var r = new ProtoReader(buf, pos, pos + n);
+            pos += n;
+            return r;
+        }
+
+        public T ReadMessage<T>() where T : IProtoMessage, new()
+        {
+            var v = new T();
+            v.MergeFrom(ReadSub());
+            return v;
+        }
+
+        public void Skip(int wire)
+        {
+            switch (wire)
+            {
+                case 0: ReadUInt64(); break;
+                case 1: Need(8); pos += 8; break;
+                case 2: { int n = (int)ReadUInt32(); Need(n); pos += n; break; }
+                case 5: Need(4); pos += 4; break;
+                default: throw new InvalidDataException("proto: unsupported wire type " + wire);
+            }
+        }
+    }
+
+    // TagID is a tag.ID: a 192-bit identifier for a cell, attr, item, or edit.
+    public readonly struct TagID : IEquatable<TagID>
+    {
+        public readonly ulong ID_0, ID_1, ID_2;
+
+        public TagID(ulong id0, ulong id1, ulong id2)
+        {
+            ID_0 = id0;
+            ID_1 = id1;
+            ID_2 = id2;
+        }
+
+        public static readonly TagID Nil = default(TagID);
+        public static readonly TagID MetaNodeID = new TagID($MetaNodeID);
+
+        const ulong NanosecStep = 0x44B82FA1C; // see tag.NanosecStep
+        const ulong EntropyMask = 0x3FFFFFFFF; // see tag.EntropyMask
+        static readonly object seedMu = new object();
+        static ulong seed = unchecked((ulong)DateTime.UtcNow.Ticks);
+
+        // Now returns a new time-based TagID with entropy (see tag.Now).
+        public static TagID Now()
+        {
+            long ticks = DateTime.UtcNow.Ticks - DateTime.UnixEpoch.Ticks;
+            unchecked
+            {
+                ulong secs = (ulong)(ticks / TimeSpan.TicksPerSecond);
+                ulong ns = (ulong)(ticks % TimeSpan.TicksPerSecond) * 100;
+                ulong nsF64 = ns * NanosecStep;
+                lock (seedMu)
+                {
+                    seed = 377377733 * nsF64 ^ seed;
+                    return new TagID(secs << 16 | nsF64 >> 48, (nsF64 << 16) ^ (seed & EntropyMask), seed * nsF64);
+                }
+            }
+        }
+
+        // Genesis returns the EditID of the first edit made by a tx with this GenesisID (see tag.Genesis).
+        public TagID Genesis() => new TagID(ID_0, ID_1 >> 32, ID_2);
+
+        public bool IsNil => ID_0 == 0 && ID_1 == 0 && ID_2 == 0;
+
+        public bool Equals(TagID other) => ID_0 == other.ID_0 && ID_1 == other.ID_1 && ID_2 == other.ID_2;
+        public override bool Equals(object obj) => obj is TagID other && Equals(other);
+        public override int GetHashCode() => (ID_0 ^ ID_1 ^ ID_2).GetHashCode();
+        public override string ToString() => ID_0.ToString("x") + "-" + ID_1.ToString("x") + "-" + ID_2.ToString("x");
+    }
+
+    // TxOp is a single element operation of a TxMsg, whose value is TxMsg.OpValue().
+    public struct TxOp
+    {
+        public TxOpCode OpCode;
+        public TagID CellID, AttrID, ItemID, EditID;
+        public int DataOfs, DataLen;
+    }
+
+    // TxMsg is an amp tx, (de)serialized in the flat form exchanged with libamp (see amp.TxMsg.MarshalToBuffer).
+    public sealed class TxMsg
+    {
+        const int HeaderSize = $TxHeaderSize;
+        const int HeaderVersion = $TxHeaderVersion;
+        const int HeaderMarker = $TxHeaderMarker;
+        const int MaxFields = $TxMaxFields;
+
+        public TxEnvelope Envelope = new TxEnvelope();
+        public readonly List<TxOp> Ops = new List<TxOp>();
+        readonly MemoryStream data = new MemoryStream();
+
+        // New returns an empty tx with a new GenesisID.
+        public static TxMsg New()
+        {
+            var tx = new TxMsg();
+            tx.GenesisID = TagID.Now();
+            return tx;
+        }
+
+        public OpStatus Status
+        {
+            get => Envelope.Status;
+            set => Envelope.Status = value;
+        }
+
+        public TagID ContextID
+        {
+            get => new TagID(unchecked((ulong)Envelope.ContextID_0), Envelope.ContextID_1, Envelope.ContextID_2);
+            set { Envelope.ContextID_0 = unchecked((long)value.ID_0); Envelope.ContextID_1 = value.ID_1; Envelope.ContextID_2 = value.ID_2; }
+        }
+
+        public TagID GenesisID
+        {
+            get => new TagID(unchecked((ulong)Envelope.GenesisID_0), Envelope.GenesisID_1, Envelope.GenesisID_2);
+            set { Envelope.GenesisID_0 = unchecked((long)value.ID_0); Envelope.GenesisID_1 = value.ID_1; Envelope.GenesisID_2 = value.ID_2; }
+        }
+
+        // AddOp appends an op whose EditID is derived from this tx's GenesisID.
+        public void AddOp(TxOpCode opCode, TagID cellID, TagID attrID, TagID itemID, byte[] value)
+        {
+            var op = new TxOp
+            {
+                OpCode = opCode,
+                CellID = cellID,
+                AttrID = attrID,
+                ItemID = itemID,
+                EditID = GenesisID.Genesis(),
+                DataOfs = (int)data.Length,
+            };
+            if (value != null)
+            {
+                data.Write(value, 0, value.Length);
+                op.DataLen = value.Length;
+            }
+            Ops.Add(op);
+        }
+
+        // Upsert appends an op setting the given element value.
+        public void Upsert<T>(TagID cellID, AttrSpec<T> attr, TagID itemID, T value) where T : IProtoMessage, new()
+        {
+            AddOp(TxOpCode.TxOpCode_UpsertElement, cellID, attr.ID, itemID, value.Encode());
+        }
+
+        // OpValue returns the serialized value of the given op.
+        public byte[] OpValue(int idx)
+        {
+            var op = Ops[idx];
+            var v = new byte[op.DataLen];
+            Buffer.BlockCopy(data.GetBuffer(), op.DataOfs, v, 0, op.DataLen);
+            return v;
+        }
+
+        // Value decodes the value of the given op if its attr is registered, otherwise returning its serialized value.
+        public object Value(int idx)
+        {
+            var buf = OpValue(idx);
+            return AttrSpecs.ByID.TryGetValue(Ops[idx].AttrID, out var attr) ? attr.DecodeValue(buf) : buf;
+        }
+
+        public byte[] Marshal()
+        {
+            Envelope.OpCount = (ulong)Ops.Count;
+
+            var w = new ProtoWriter();
+            w.WriteRaw(new byte[HeaderSize], 0, HeaderSize);
+            w.WriteMessage(Envelope);
+
+            var prv = new ulong[MaxFields];
+            var cur = new ulong[MaxFields];
+            foreach (var op in Ops)
+            {
+                w.WriteUInt64(0); // skip bytes (future use)
+                w.WriteUInt64((ulong)op.OpCode);
+                w.WriteUInt64((ulong)op.DataLen);
+                w.WriteUInt64((ulong)op.DataOfs);
+
+                // indexed by amp.TxField -- only fields that differ from the previous op are written
+                cur[1] = op.CellID.ID_0; cur[2] = op.CellID.ID_1; cur[3] = op.CellID.ID_2;
+                cur[4] = op.AttrID.ID_0; cur[5] = op.AttrID.ID_1; cur[6] = op.AttrID.ID_2;
+                cur[7] = op.ItemID.ID_0; cur[8] = op.ItemID.ID_1; cur[9] = op.ItemID.ID_2;
+                cur[10] = op.EditID.ID_0; cur[11] = op.EditID.ID_1; cur[12] = op.EditID.ID_2;
+
+                ulong hasFields = 0;
+                for (int i = 0; i < MaxFields; i++)
+                    if (cur[i] != prv[i])
+                        hasFields |= 1UL << i;
+                w.WriteUInt64(hasFields);
+                for (int i = 0; i < MaxFields; i++)
+                    if ((hasFields & (1UL << i)) != 0)
+                        w.WriteFixed64(cur[i]);
+
+                Array.Copy(cur, prv, MaxFields);
+            }
+
+            int bodyLen = w.Length;
+            w.WriteRaw(data.GetBuffer(), 0, (int)data.Length);
+
+            var buf = w.ToArray();
+            buf[0] = (byte)(HeaderMarker >> 16);
+            buf[1] = (byte)(HeaderMarker >> 8);
+            buf[2] = (byte)HeaderMarker;
+            buf[3] = HeaderVersion;
+            PutUInt32(buf, 4, (uint)bodyLen);
+            PutUInt32(buf, 8, (uint)data.Length);
+            return buf;
+        }
+
+        public static TxMsg Unmarshal(byte[] buf, int len)
+        {
+            if (len < HeaderSize ||
+                buf[0] != (byte)(HeaderMarker >> 16) || buf[1] != (byte)(HeaderMarker >> 8) || buf[2] != (byte)HeaderMarker ||
+                buf[3] < HeaderVersion)
+                throw new InvalidDataException("amp: malformed tx");
+
+            int bodyLen = (int)GetUInt32(buf, 4);
+            int dataLen = (int)GetUInt32(buf, 8);
+            if (bodyLen < HeaderSize || dataLen < 0 || bodyLen + dataLen > len)
+                throw new InvalidDataException("amp: malformed tx");
+
+            var r = new ProtoReader(buf, HeaderSize, bodyLen);
+            var tx = new TxMsg();
+            tx.Envelope = r.ReadMessage<TxEnvelope>();
+
+            var cur = new ulong[MaxFields];
+            for (ulong n = 0; n < tx.Envelope.OpCount; n++)
+            {
+                r.Skip(2); // skip bytes (future use)
+                var op = new TxOp
+                {
+                    OpCode = (TxOpCode)r.ReadUInt64(),
+                    DataLen = (int)r.ReadUInt64(),
+                    DataOfs = (int)r.ReadUInt64(),
+                };
+                ulong hasFields = r.ReadUInt64();
+                for (int i = 0; i < MaxFields; i++)
+                    if ((hasFields & (1UL << i)) != 0)
+                        cur[i] = r.ReadFixed64();
+
+                op.CellID = new TagID(cur[1], cur[2], cur[3]);
+                op.AttrID = new TagID(cur[4], cur[5], cur[6]);
+                op.ItemID = new TagID(cur[7], cur[8], cur[9]);
+                op.EditID = new TagID(cur[10], cur[11], cur[12]);
+                if (op.DataOfs < 0 || op.DataLen < 0 || op.DataOfs + op.DataLen > dataLen)
+                    throw new InvalidDataException("amp: malformed tx op");
+                tx.Ops.Add(op);
+            }
+            tx.data.Write(buf, bodyLen, dataLen);
+            return tx;
+        }
+
+        static void PutUInt32(byte[] buf, int ofs, uint v)
+        {
+            for (int i = 0; i < 4; i++)
+                buf[ofs + i] = (byte)(v >> (8 * i));
+        }
+
+        static uint GetUInt32(byte[] buf, int ofs)
+        {
+            return (uint)(buf[ofs] | buf[ofs + 1] << 8 | buf[ofs + 2] << 16 | buf[ofs + 3] << 24);
+        }
+    }
+
+    // AttrSpec is a registered attr whose element values are not a generated message type.
+    public class AttrSpec
+    {
+        public readonly string Canonic;
+        public readonly TagID ID;
+
+        public AttrSpec(string canonic, TagID id)
+        {
+            Canonic = canonic;
+            ID = id;
+        }
+
+        // DecodeValue decodes a serialized element value of this attr.
+        public virtual object DecodeValue(byte[] buf) => buf;
+
+        public override string ToString() => Canonic;
+    }
+
+    // AttrSpec<T> is a registered attr whose element values are of type T.
+    public sealed class AttrSpec<T> : AttrSpec where T : IProtoMessage, new()
+    {
+        public AttrSpec(string canonic, TagID id) : base(canonic, id) { }
+
+        public T Decode(byte[] buf)
+        {
+            var v = new T();
+            v.MergeFrom(new ProtoReader(buf));
+            return v;
+        }
+
+        public override object DecodeValue(byte[] buf) => Decode(buf);
+    }
+
+    // AmpException is an error reported by libamp.
+    public sealed class AmpException : Exception
+    {
+        public readonly ErrCode Code;
+
+        public AmpException(ErrCode code, string msg) : base(msg)
+        {
+            Code = code;
+        }
+    }
+
+    // Native declares the C API exported by cmd/amp.sdk.lib.cgo (libamp), where a negative return value is a negated ErrCode.
+    public static class Native
+    {
+#if UNITY_IOS && !UNITY_EDITOR
+        const string Lib = "__Internal";
+#else
+        const string Lib = "amp";
+#endif
+
+        [DllImport(Lib)] public static extern long amp_host_start(byte[] config);
+        [DllImport(Lib)] public static extern long amp_session_open(long host);
+        [DllImport(Lib)] public static extern long amp_session_connect(byte[] url);
+        [DllImport(Lib)] public static extern int amp_tx_send(long sess, byte[] buf, int len);
+        [DllImport(Lib)] public static extern int amp_tx_recv(long sess, byte[] buf, int capacity, out int needed, int timeoutMs);
+        [DllImport(Lib)] public static extern int amp_close(long handle);
+        [DllImport(Lib)] public static extern int amp_last_error(byte[] buf, int capacity);
+
+        // CString returns the given string as a NUL-terminated UTF-8 buffer.
+        public static byte[] CString(string s) => Encoding.UTF8.GetBytes(s + "\0");
+
+        public static string LastError()
+        {
+            var buf = new byte[1024];
+            int n = Math.Min(amp_last_error(buf, buf.Length), buf.Length - 1);
+            return Encoding.UTF8.GetString(buf, 0, n);
+        }
+
+        // Check throws an AmpException if the given return value is an error.
+        public static long Check(long rc)
+        {
+            if (rc < 0)
+                throw new AmpException((ErrCode)(int)(-rc), LastError());
+            return rc;
+        }
+    }
+
+    // Host is a host embedded in libamp (which must be linked with a host implementation).
+    public sealed class Host : IDisposable
+    {
+        long handle;
+
+        Host(long handle)
+        {
+            this.handle = handle;
+        }
+
+        // Start starts an embedded host from an implementation-defined config (e.g. JSON).
+        public static Host Start(string config) => new Host(Native.Check(Native.amp_host_start(Native.CString(config))));
+
+        public Session OpenSession() => new Session(Native.Check(Native.amp_session_open(handle)));
+
+        public void Dispose()
+        {
+            if (handle > 0)
+                Native.amp_close(handle);
+            handle = 0;
+        }
+    }
+
+    // Session is a session with an amp host.
+    // Txs from the host are buffered by libamp until Poll() is called (e.g. from a MonoBehaviour's Update), which raises events on the calling thread.
+    public sealed class Session : IDisposable
+    {
+        long handle;
+        byte[] recvBuf = new byte[64 * 1024];
+        internal readonly Dictionary<TagID, PinHandle> pins = new Dictionary<TagID, PinHandle>();
+
+        // OnTx is raised for each tx received that is not addressed to an open PinHandle.
+        public event Action<TxMsg> OnTx;
+
+        internal Session(long handle)
+        {
+            this.handle = handle;
+        }
+
+        // Connect opens a session with a remote host serving amp's gRPC HostSession (e.g. "https://host:5192").
+        public static Session Connect(string url) => new Session(Native.Check(Native.amp_session_connect(Native.CString(url))));
+
+        public void Send(TxMsg tx)
+        {
+            var buf = tx.Marshal();
+            Native.Check(Native.amp_tx_send(handle, buf, buf.Length));
+        }
+
+        // SendMeta sends a single value of a meta attr (e.g. a PinRequest) under the given context ID.
+        public void SendMeta<T>(TagID contextID, OpStatus status, AttrSpec<T> attr, T value) where T : IProtoMessage, new()
+        {
+            var tx = TxMsg.New();
+            tx.ContextID = contextID;
+            tx.Status = status;
+            tx.Upsert(TagID.MetaNodeID, attr, TagID.Nil, value);
+            Send(tx);
+        }
+
+        // Recv returns the next tx from the host, waiting up to the given timeout (or indefinitely if < 0), or null if none arrived.
+        public TxMsg Recv(int timeoutMs)
+        {
+            for (;;)
+            {
+                int n = Native.amp_tx_recv(handle, recvBuf, recvBuf.Length, out int needed, timeoutMs);
+                if (n >= 0)
+                    return TxMsg.Unmarshal(recvBuf, n);
+                if (n == -(int)ErrCode.ErrCode_Timeout)
+                    return null;
+                if (needed > recvBuf.Length)
+                {
+                    recvBuf = new byte[needed];
+                    continue;
+                }
+                Native.Check(n);
+            }
+        }
+
+        // Poll dispatches up to maxTxs txs already received from the host, returning the number dispatched.
+        public int Poll(int maxTxs = 256)
+        {
+            int count = 0;
+            for (; count < maxTxs; count++)
+            {
+                var tx = Recv(0);
+                if (tx == null)
+                    break;
+                if (pins.TryGetValue(tx.ContextID, out var pin))
+                    pin.Deliver(tx);
+                else
+                    OnTx?.Invoke(tx);
+            }
+            return count;
+        }
+
+        // Pin pins the given URL, where updates are raised as PinHandle events during Poll().
+        public PinHandle Pin(string url, StateSync sync = StateSync.StateSync_Maintain, params TagID[] attrs)
+        {
+            var req = new PinRequest
+            {
+                PinTarget = new Tag { URL = url },
+                StateSync = sync,
+            };
+            foreach (var attrID in attrs)
+                req.PinAttrs.Add(new Tag { ID_0 = unchecked((long)attrID.ID_0), ID_1 = attrID.ID_1, ID_2 = attrID.ID_2 });
+
+            var pin = new PinHandle(this, TagID.Now());
+            pins[pin.ReqID] = pin;
+            try
+            {
+                SendMeta(pin.ReqID, OpStatus.OpStatus_Syncing, AttrSpecs.PinRequest, req);
+            }
+            catch
+            {
+                pins.Remove(pin.ReqID);
+                throw;
+            }
+            return pin;
+        }
+
+        public void Dispose()
+        {
+            if (handle > 0)
+                Native.amp_close(handle);
+            handle = 0;
+            pins.Clear();
+        }
+    }
+
+    // PinHandle is an open pin request, closed by the host (see OnClosed) or via Dispose().
+    public sealed class PinHandle : IDisposable
+    {
+        public readonly Session Session;
+        public readonly TagID ReqID;
+        public bool IsOpen { get; private set; } = true;
+
+        // OnUpdate is raised for each tx sent by the host for this pin.
+        public event Action<TxMsg> OnUpdate;
+
+        // OnSynced is raised after an update that completes the pin's state (see OpStatus_Synced).
+        public event Action OnSynced;
+
+        // OnClosed is raised once the host closes this pin.
+        public event Action OnClosed;
+
+        internal PinHandle(Session sess, TagID reqID)
+        {
+            Session = sess;
+            ReqID = reqID;
+        }
+
+        internal void Deliver(TxMsg tx)
+        {
+            if (tx.Status == OpStatus.OpStatus_Closed)
+            {
+                IsOpen = false;
+                Session.pins.Remove(ReqID);
+                OnClosed?.Invoke();
+                return;
+            }
+            OnUpdate?.Invoke(tx);
+            if (tx.Status == OpStatus.OpStatus_Synced)
+                OnSynced?.Invoke();
+        }
+
+        // Commit sends the given tx to be merged into the pinned cell.
+        public void Commit(TxMsg tx)
+        {
+            tx.ContextID = ReqID;
+            Session.Send(tx);
+        }
+
+        // Upsert commits a single element value.
+        public void Upsert<T>(TagID cellID, AttrSpec<T> attr, TagID itemID, T value) where T : IProtoMessage, new()
+        {
+            var tx = TxMsg.New();
+            tx.Upsert(cellID, attr, itemID, value);
+            Commit(tx);
+        }
+
+        // Delete commits the deletion of a single element.
+        public void Delete(TagID cellID, TagID attrID, TagID itemID)
+        {
+            var tx = TxMsg.New();
+            tx.AddOp(TxOpCode.TxOpCode_DeleteElement, cellID, attrID, itemID, null);
+            Commit(tx);
+        }
+
+        // Dispose signals the host to close this pin.
+        public void Dispose()
+        {
+            if (!IsOpen)
+                return;
+            IsOpen = false;
+            Session.pins.Remove(ReqID);
+
+            var tx = TxMsg.New();
+            tx.ContextID = ReqID;
+            tx.Status = OpStatus.OpStatus_Closed;
+            Session.Send(tx);
+        }
+    }
+`
@@ -0,0 +1,329 @@
+// Package codegen generates client code in other languages from the attr value types registered in an amp.Registry, keeping clients in lockstep with host schemas.
+//
+// NewSchema reflects each registered prototype into a language-neutral Schema, from which each generator (e.g. WriteTypeScript) emits types and (de)serializers for the protobuf wire form of element values.
+package codegen
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/gogo/protobuf/proto"
+)
+
+// Kind is the protobuf scalar type of a Field, or KindMessage / KindMap.
+type Kind int
+
+const (
+	KindBool Kind = iota
+	KindInt32
+	KindInt64
+	KindUint32
+	KindUint64
+	KindSint32
+	KindSint64
+	KindFixed32
+	KindFixed64
+	KindSfixed32
+	KindSfixed64
+	KindFloat
+	KindDouble
+	KindString
+	KindBytes
+	KindEnum
+	KindMessage
+	KindMap
+)
+
+// WireType returns the protobuf wire type of a single (unpacked) value of this kind.
+func (kind Kind) WireType() int {
+	switch kind {
+	case KindFixed64, KindSfixed64, KindDouble:
+		return 1
+	case KindString, KindBytes, KindMessage, KindMap:
+		return 2
+	case KindFixed32, KindSfixed32, KindFloat:
+		return 5
+	default:
+		return 0
+	}
+}
+
+// Packable returns true if repeated values of this kind are packed (the proto3 default).
+func (kind Kind) Packable() bool {
+	return kind.WireType() != 2
+}
+
+// Is64 returns true if this kind is a 64-bit integer (which some languages can't represent as a native number).
+func (kind Kind) Is64() bool {
+	switch kind {
+	case KindInt64, KindUint64, KindSint64, KindFixed64, KindSfixed64:
+		return true
+	}
+	return false
+}
+
+// Schema describes the registered attrs and the message and enum types they reference.
+type Schema struct {
+	Attrs    []Attr     // sorted by Canonic
+	Messages []*Message // sorted by Name
+	Enums    []*Enum    // sorted by Name
+
+	messages map[reflect.Type]*Message
+	enums    map[string]*Enum
+	names    map[string]string // short name => proto name claiming it
+}
+
+// Attr is a registered attr and the message type of its element values.
+type Attr struct {
+	tag.Spec
+	Message *Message // nil if the attr's prototype is not a protobuf message
+}
+
+// Message is a protobuf message type.
+type Message struct {
+	Name      string // fully qualified proto name, e.g. "amp.Tag"
+	ShortName string // unique unqualified name, e.g. "Tag"
+	Fields    []*Field
+}
+
+// Field is a field of a Message.
+type Field struct {
+	Name     string
+	Number   int
+	Kind     Kind
+	Repeated bool
+	Message  *Message // if KindMessage
+	Enum     *Enum    // if KindEnum
+	Key      *Field   // if KindMap
+	Value    *Field   // if KindMap
+}
+
+// Enum is a protobuf enum type.
+type Enum struct {
+	Name      string
+	ShortName string
+	Values    []EnumValue // sorted by Number
+}
+
+type EnumValue struct {
+	Name   string
+	Number int32
+}
+
+// NewSchema reflects the attrs registered in the given Registry, along with amp.TxEnvelope and amp.TxOpCode.
+func NewSchema(reg amp.Registry) (*Schema, error) {
+	schema := &Schema{
+		messages: make(map[reflect.Type]*Message),
+		enums:    make(map[string]*Enum),
+		names:    make(map[string]string),
+	}
+
+	defs := reg.ListAttrs()
+	sort.Slice(defs, func(i, j int) bool {
+		return defs[i].Canonic < defs[j].Canonic
+	})
+	for _, def := range defs {
+		attr := Attr{
+			Spec: def.Spec,
+		}
+		if _, isProto := def.Prototype.(proto.Message); isProto {
+			var err error
+			if attr.Message, err = schema.message(reflect.TypeOf(def.Prototype)); err != nil {
+				return nil, fmt.Errorf("attr %q: %w", def.Canonic, err)
+			}
+		}
+		schema.Attrs = append(schema.Attrs, attr)
+	}
+
+	// clients (de)serialize TxMsgs themselves, so include the types a TxMsg is composed of
+	if _, err := schema.message(reflect.TypeOf(&amp.TxEnvelope{})); err != nil {
+		return nil, err
+	}
+	schema.enum("amp.TxOpCode")
+
+	sort.Slice(schema.Messages, func(i, j int) bool {
+		return schema.Messages[i].Name < schema.Messages[j].Name
+	})
+	sort.Slice(schema.Enums, func(i, j int) bool {
+		return schema.Enums[i].Name < schema.Enums[j].Name
+	})
+	return schema, nil
+}
+
+// shortName returns the unqualified form of a proto name, qualified only as needed to be unique.
+func (schema *Schema) shortName(protoName string) string {
+	short := protoName[strings.LastIndexByte(protoName, '.')+1:]
+	if claimed, taken := schema.names[short]; taken && claimed != protoName {
+		short = strings.ReplaceAll(protoName, ".", "_")
+	}
+	schema.names[short] = protoName
+	return short
+}
+
+func (schema *Schema) message(typ reflect.Type) (*Message, error) {
+	if typ.Kind() != reflect.Ptr {
+		typ = reflect.PointerTo(typ)
+	}
+	if msg := schema.messages[typ]; msg != nil {
+		return msg, nil
+	}
+	pb, ok := reflect.New(typ.Elem()).Interface().(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("%v is not a protobuf message", typ)
+	}
+	msg := &Message{
+		Name: proto.MessageName(pb),
+	}
+	if msg.Name == "" {
+		msg.Name = typ.Elem().Name()
+	}
+	msg.ShortName = schema.shortName(msg.Name)
+	schema.messages[typ] = msg
+	schema.Messages = append(schema.Messages, msg)
+
+	structType := typ.Elem()
+	for i := 0; i < structType.NumField(); i++ {
+		sf := structType.Field(i)
+		pbTag := sf.Tag.Get("protobuf")
+		if pbTag == "" {
+			continue // e.g. XXX_ fields
+		}
+		field, err := schema.field(sf.Type, pbTag)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", msg.Name, sf.Name, err)
+		}
+		if sf.Type.Kind() == reflect.Map {
+			field.Kind = KindMap
+			field.Repeated = false
+			if field.Key, err = schema.field(sf.Type.Key(), sf.Tag.Get("protobuf_key")); err != nil {
+				return nil, err
+			}
+			if field.Value, err = schema.field(sf.Type.Elem(), sf.Tag.Get("protobuf_val")); err != nil {
+				return nil, err
+			}
+		}
+		msg.Fields = append(msg.Fields, field)
+	}
+	return msg, nil
+}
+
+// field reflects a field from its Go type and protobuf struct tag (e.g. `protobuf:"varint,2,opt,name=Status,proto3,enum=amp.OpStatus"`).
+func (schema *Schema) field(typ reflect.Type, pbTag string) (*Field, error) {
+	parts := strings.Split(pbTag, ",")
+	if len(parts) < 3 {
+		return nil, fmt.Errorf("malformed protobuf tag %q", pbTag)
+	}
+	field := &Field{
+		Repeated: parts[2] == "rep",
+	}
+	var err error
+	if field.Number, err = strconv.Atoi(parts[1]); err != nil {
+		return nil, fmt.Errorf("malformed protobuf tag %q", pbTag)
+	}
+	enumName := ""
+	for _, part := range parts[3:] {
+		if name, found := strings.CutPrefix(part, "name="); found {
+			field.Name = name
+		} else if name, found := strings.CutPrefix(part, "enum="); found {
+			enumName = name
+		}
+	}
+
+	if typ.Kind() == reflect.Map {
+		return field, nil // Key and Value are reflected by the caller
+	}
+	if field.Repeated && typ.Kind() == reflect.Slice {
+		typ = typ.Elem()
+	}
+	goKind := typ.Kind()
+	if goKind == reflect.Ptr {
+		goKind = typ.Elem().Kind()
+	}
+
+	switch wire := parts[0]; {
+	case enumName != "":
+		field.Kind = KindEnum
+		field.Enum = schema.enum(enumName)
+	case wire == "zigzag32":
+		field.Kind = KindSint32
+	case wire == "zigzag64":
+		field.Kind = KindSint64
+	case wire == "varint":
+		switch goKind {
+		case reflect.Bool:
+			field.Kind = KindBool
+		case reflect.Int32:
+			field.Kind = KindInt32
+		case reflect.Int64:
+			field.Kind = KindInt64
+		case reflect.Uint32:
+			field.Kind = KindUint32
+		case reflect.Uint64:
+			field.Kind = KindUint64
+		default:
+			return nil, fmt.Errorf("unsupported varint type %v", typ)
+		}
+	case wire == "fixed32":
+		switch goKind {
+		case reflect.Float32:
+			field.Kind = KindFloat
+		case reflect.Int32:
+			field.Kind = KindSfixed32
+		default:
+			field.Kind = KindFixed32
+		}
+	case wire == "fixed64":
+		switch goKind {
+		case reflect.Float64:
+			field.Kind = KindDouble
+		case reflect.Int64:
+			field.Kind = KindSfixed64
+		default:
+			field.Kind = KindFixed64
+		}
+	case wire == "bytes":
+		switch {
+		case goKind == reflect.String:
+			field.Kind = KindString
+		case goKind == reflect.Slice && typ.Elem().Kind() == reflect.Uint8:
+			field.Kind = KindBytes
+		case goKind == reflect.Struct:
+			field.Kind = KindMessage
+			if field.Message, err = schema.message(typ); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("unsupported bytes type %v", typ)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported wire type %q", wire)
+	}
+	return field, nil
+}
+
+func (schema *Schema) enum(protoName string) *Enum {
+	if enum := schema.enums[protoName]; enum != nil {
+		return enum
+	}
+	enum := &Enum{
+		Name:      protoName,
+		ShortName: schema.shortName(protoName),
+	}
+	for name, num := range proto.EnumValueMap(protoName) {
+		enum.Values = append(enum.Values, EnumValue{Name: name, Number: num})
+	}
+	sort.Slice(enum.Values, func(i, j int) bool {
+		if enum.Values[i].Number != enum.Values[j].Number {
+			return enum.Values[i].Number < enum.Values[j].Number
+		}
+		return enum.Values[i].Name < enum.Values[j].Name
+	})
+	schema.enums[protoName] = enum
+	schema.Enums = append(schema.Enums, enum)
+	return enum
+}
@@ -0,0 +1,371 @@
+package codegen
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteTypeScript writes a TypeScript module declaring an interface, encoder, and decoder for each message type in the schema, an enum for each enum type,
+// and AttrSpecs, a table of each registered attr by canonic name (and AttrsByID, by base32 ID).
+//
+// 64-bit integers are represented as bigint, so the output targets ES2020 or later.
+func WriteTypeScript(w io.Writer, schema *Schema) error {
+	out := bufio.NewWriter(w)
+	out.WriteString(tsHeader)
+
+	for _, enum := range schema.Enums {
+		fmt.Fprintf(out, "\nexport enum %s {\n", enum.ShortName)
+		for _, val := range enum.Values {
+			fmt.Fprintf(out, "  %s = %d,\n", val.Name, val.Number)
+		}
+		out.WriteString("}\n")
+	}
+
+	for _, msg := range schema.Messages {
+		writeTSMessage(out, msg)
+	}
+
+	out.WriteString("\nexport const AttrSpecs: { [canonic: string]: AttrCodec<any> } = {\n")
+	for _, attr := range schema.Attrs {
+		encode, decode := "(v: Uint8Array) => v", "(buf: Uint8Array) => buf"
+		if attr.Message != nil {
+			encode, decode = "encode"+attr.Message.ShortName, "decode"+attr.Message.ShortName
+		}
+		fmt.Fprintf(out, "  %q: { id: %q, idParts: [%dn, %dn, %dn], encode: %s, decode: %s },\n",
+			attr.Canonic, attr.ID.Base32(), attr.ID[0], attr.ID[1], attr.ID[2], encode, decode)
+	}
+	out.WriteString("};\n")
+	out.WriteString(tsFooter)
+	return out.Flush()
+}
+
+// tsType returns the TypeScript type of a single value of the given field.
+func tsType(field *Field) string {
+	switch field.Kind {
+	case KindBool:
+		return "boolean"
+	case KindString:
+		return "string"
+	case KindBytes:
+		return "Uint8Array"
+	case KindEnum:
+		return field.Enum.ShortName
+	case KindMessage:
+		return field.Message.ShortName
+	case KindMap:
+		return "{ [key: string]: " + tsType(field.Value) + " }"
+	}
+	if field.Kind.Is64() {
+		return "bigint"
+	}
+	return "number"
+}
+
+// tsMethod returns the ProtoReader / ProtoWriter method for a scalar kind.
+func tsMethod(kind Kind) string {
+	switch kind {
+	case KindEnum:
+		return "int32"
+	case KindBool:
+		return "bool"
+	case KindInt32:
+		return "int32"
+	case KindInt64:
+		return "int64"
+	case KindUint32:
+		return "uint32"
+	case KindUint64:
+		return "uint64"
+	case KindSint32:
+		return "sint32"
+	case KindSint64:
+		return "sint64"
+	case KindFixed32:
+		return "fixed32"
+	case KindFixed64:
+		return "fixed64"
+	case KindSfixed32:
+		return "sfixed32"
+	case KindSfixed64:
+		return "sfixed64"
+	case KindFloat:
+		return "float"
+	case KindDouble:
+		return "double"
+	case KindString:
+		return "string"
+	default:
+		return "bytes"
+	}
+}
+
+// tsMapKey returns the expression converting a map key (always a string in TypeScript) to the key field's type.
+func tsMapKey(field *Field, expr string) string {
+	switch {
+	case field.Kind == KindString:
+		return expr
+	case field.Kind == KindBool:
+		return expr + ` === "true"`
+	case field.Kind.Is64():
+		return "BigInt(" + expr + ")"
+	default:
+		return "Number(" + expr + ")"
+	}
+}
+
+func writeTSMessage(out *bufio.Writer, msg *Message) {
+	name := msg.ShortName
+
+	fmt.Fprintf(out, "\n// %s\nexport interface %s {\n", msg.Name, name)
+	for _, field := range msg.Fields {
+		typ := tsType(field)
+		if field.Repeated {
+			typ += "[]"
+		}
+		fmt.Fprintf(out, "  %s?: %s;\n", field.Name, typ)
+	}
+	out.WriteString("}\n")
+
+	// encoder
+	fmt.Fprintf(out, "\nexport function encode%s(m: %s): Uint8Array {\n  const w = new ProtoWriter();\n", name, name)
+	for _, field := range msg.Fields {
+		f := "m." + field.Name
+		wire := field.Kind.WireType()
+		switch {
+		case field.Kind == KindMap:
+			fmt.Fprintf(out, "  for (const [k, v] of Object.entries(%s ?? {})) {\n", f)
+			out.WriteString("    const e = new ProtoWriter();\n")
+			fmt.Fprintf(out, "    e.key(1, %d); e.%s(%s);\n", field.Key.Kind.WireType(), tsMethod(field.Key.Kind), tsMapKey(field.Key, "k"))
+			if field.Value.Kind == KindMessage {
+				fmt.Fprintf(out, "    e.key(2, 2); e.bytes(encode%s(v));\n", field.Value.Message.ShortName)
+			} else {
+				fmt.Fprintf(out, "    e.key(2, %d); e.%s(v);\n", field.Value.Kind.WireType(), tsMethod(field.Value.Kind))
+			}
+			fmt.Fprintf(out, "    w.key(%d, 2); w.bytes(e.finish());\n  }\n", field.Number)
+		case field.Kind == KindMessage && field.Repeated:
+			fmt.Fprintf(out, "  for (const v of %s ?? []) { w.key(%d, 2); w.bytes(encode%s(v)); }\n", f, field.Number, field.Message.ShortName)
+		case field.Kind == KindMessage:
+			fmt.Fprintf(out, "  if (%s !== undefined) { w.key(%d, 2); w.bytes(encode%s(%s)); }\n", f, field.Number, field.Message.ShortName, f)
+		case field.Repeated && field.Kind.Packable():
+			fmt.Fprintf(out, "  if (%s?.length) { const p = new ProtoWriter(); for (const v of %s) p.%s(v); w.key(%d, 2); w.bytes(p.finish()); }\n",
+				f, f, tsMethod(field.Kind), field.Number)
+		case field.Repeated:
+			fmt.Fprintf(out, "  for (const v of %s ?? []) { w.key(%d, %d); w.%s(v); }\n", f, field.Number, wire, tsMethod(field.Kind))
+		case field.Kind == KindBytes:
+			fmt.Fprintf(out, "  if (%s?.length) { w.key(%d, 2); w.bytes(%s); }\n", f, field.Number, f)
+		default:
+			fmt.Fprintf(out, "  if (%s) { w.key(%d, %d); w.%s(%s); }\n", f, field.Number, wire, tsMethod(field.Kind), f) // proto3 omits default values
+		}
+	}
+	out.WriteString("  return w.finish();\n}\n")
+
+	// decoder
+	fmt.Fprintf(out, "\nexport function decode%s(buf: Uint8Array): %s {\n  const m: %s = {};\n  const r = new ProtoReader(buf);\n", name, name, name)
+	out.WriteString("  while (!r.done()) {\n    const [field, wire] = r.key();\n    switch (field) {\n")
+	for _, field := range msg.Fields {
+		f := "m." + field.Name
+		fmt.Fprintf(out, "      case %d:\n", field.Number)
+		var read string
+		switch field.Kind {
+		case KindMessage:
+			read = "decode" + field.Message.ShortName + "(r.bytes())"
+		case KindEnum:
+			read = "r.int32() as " + field.Enum.ShortName
+		default:
+			read = "r." + tsMethod(field.Kind) + "()"
+		}
+		switch {
+		case field.Kind == KindMap:
+			keyZero, valZero := tsZero(field.Key), tsZero(field.Value)
+			valRead := "e." + tsMethod(field.Value.Kind) + "()"
+			if field.Value.Kind == KindMessage {
+				valRead = "decode" + field.Value.Message.ShortName + "(e.bytes())"
+			}
+			fmt.Fprintf(out, "        {\n          const e = r.sub();\n          let k: %s = %s, v: %s = %s;\n", tsType(field.Key), keyZero, tsType(field.Value), valZero)
+			out.WriteString("          while (!e.done()) {\n            const [ef, ew] = e.key();\n")
+			fmt.Fprintf(out, "            if (ef === 1) k = e.%s();\n            else if (ef === 2) v = %s;\n            else e.skip(ew);\n          }\n", tsMethod(field.Key.Kind), valRead)
+			fmt.Fprintf(out, "          (%s ??= {})[String(k)] = v;\n        }\n", f)
+		case field.Repeated && field.Kind.Packable():
+			packedRead := strings.Replace(read, "r.", "p.", 1)
+			fmt.Fprintf(out, "        if (wire === 2) { const p = r.sub(); while (!p.done()) (%s ??= []).push(%s); }\n", f, packedRead)
+			fmt.Fprintf(out, "        else (%s ??= []).push(%s);\n", f, read)
+		case field.Repeated:
+			fmt.Fprintf(out, "        (%s ??= []).push(%s);\n", f, read)
+		default:
+			fmt.Fprintf(out, "        %s = %s;\n", f, read)
+		}
+		out.WriteString("        break;\n")
+	}
+	out.WriteString("      default:\n        r.skip(wire);\n    }\n  }\n  return m;\n}\n")
+}
+
+// tsZero returns the TypeScript zero value of a single value of the given field.
+func tsZero(field *Field) string {
+	switch field.Kind {
+	case KindBool:
+		return "false"
+	case KindString:
+		return `""`
+	case KindBytes:
+		return "new Uint8Array()"
+	case KindMessage:
+		return "{}"
+	case KindEnum:
+		return "0 as " + field.Enum.ShortName
+	}
+	if field.Kind.Is64() {
+		return "0n"
+	}
+	return "0"
+}
+
+const tsHeader = `// Code generated by amp/codegen from an amp.Registry. DO NOT EDIT.
+
+/* eslint-disable */
+
+// AttrCodec encodes and decodes the element values of a registered attr.
+export interface AttrCodec<T> {
+  id: string; // base32 tag.ID
+  idParts: [bigint, bigint, bigint]; // tag.ID as carried in a TxOp
+  encode: (v: T) => Uint8Array;
+  decode: (buf: Uint8Array) => T;
+}
+
+// ProtoWriter appends protobuf wire-format fields.
+export class ProtoWriter {
+  private buf: number[] = [];
+
+  finish(): Uint8Array {
+    return Uint8Array.from(this.buf);
+  }
+
+  key(field: number, wire: number) {
+    this.uint64(BigInt(field * 8 + wire));
+  }
+
+  uint64(v: bigint) {
+    let n = BigInt.asUintN(64, v);
+    while (n >= 0x80n) {
+      this.buf.push(Number(n & 0x7fn) | 0x80);
+      n >>= 7n;
+    }
+    this.buf.push(Number(n));
+  }
+
+  int64(v: bigint) { this.uint64(v); }
+  int32(v: number) { this.uint64(BigInt(v)); }
+  uint32(v: number) { this.uint64(BigInt(v >>> 0)); }
+  bool(v: boolean) { this.buf.push(v ? 1 : 0); }
+  sint32(v: number) { this.uint32((v << 1) ^ (v >> 31)); }
+  sint64(v: bigint) { this.uint64((v << 1n) ^ (v >> 63n)); }
+
+  private le(bytes: number, set: (view: DataView) => void) {
+    const view = new DataView(new ArrayBuffer(bytes));
+    set(view);
+    for (let i = 0; i < bytes; i++) this.buf.push(view.getUint8(i));
+  }
+
+  fixed32(v: number) { this.le(4, (d) => d.setUint32(0, v, true)); }
+  sfixed32(v: number) { this.le(4, (d) => d.setInt32(0, v, true)); }
+  fixed64(v: bigint) { this.le(8, (d) => d.setBigUint64(0, BigInt.asUintN(64, v), true)); }
+  sfixed64(v: bigint) { this.le(8, (d) => d.setBigInt64(0, BigInt.asIntN(64, v), true)); }
+  float(v: number) { this.le(4, (d) => d.setFloat32(0, v, true)); }
+  double(v: number) { this.le(8, (d) => d.setFloat64(0, v, true)); }
+
+  bytes(v: Uint8Array) {
+    this.uint32(v.length);
+    for (const b of v) this.buf.push(b);
+  }
+
+  string(v: string) {
+    this.bytes(new TextEncoder().encode(v));
+  }
+}
+
+// ProtoReader reads protobuf wire-format fields.
+export class ProtoReader {
+  pos: number;
+
+  constructor(private buf: Uint8Array, start = 0, private end = buf.length) {
+    this.pos = start;
+  }
+
+  done(): boolean {
+    return this.pos >= this.end;
+  }
+
+  uint64(): bigint {
+    let n = 0n;
+    for (let shift = 0n; ; shift += 7n) {
+      if (this.pos >= this.end) throw new Error("proto: truncated varint");
+      const b = this.buf[this.pos++];
+      n |= BigInt(b & 0x7f) << shift;
+      if (b < 0x80) return n;
+    }
+  }
+
+  key(): [number, number] {
+    const k = Number(this.uint64());
+    return [Math.floor(k / 8), k & 7];
+  }
+
+  int64(): bigint { return BigInt.asIntN(64, this.uint64()); }
+  int32(): number { return Number(BigInt.asIntN(32, this.uint64())); }
+  uint32(): number { return Number(BigInt.asUintN(32, this.uint64())); }
+  bool(): boolean { return this.uint64() !== 0n; }
+  sint32(): number { const n = this.uint32(); return (n >>> 1) ^ -(n & 1); }
+  sint64(): bigint { const n = this.uint64(); return BigInt.asIntN(64, (n >> 1n) ^ -(n & 1n)); }
+
+  private le(bytes: number): DataView {
+    if (this.pos + bytes > this.end) throw new Error("proto: truncated field");
+    const view = new DataView(this.buf.buffer, this.buf.byteOffset + this.pos, bytes);
+    this.pos += bytes;
+    return view;
+  }
+
+  fixed32(): number { return this.le(4).getUint32(0, true); }
+  sfixed32(): number { return this.le(4).getInt32(0, true); }
+  fixed64(): bigint { return this.le(8).getBigUint64(0, true); }
+  sfixed64(): bigint { return this.le(8).getBigInt64(0, true); }
+  float(): number { return this.le(4).getFloat32(0, true); }
+  double(): number { return this.le(8).getFloat64(0, true); }
+
+  bytes(): Uint8Array {
+    const n = this.uint32();
+    if (this.pos + n > this.end) throw new Error("proto: truncated bytes");
+    const v = this.buf.slice(this.pos, this.pos + n);
+    this.pos += n;
+    return v;
+  }
+
+  string(): string {
+    return new TextDecoder().decode(this.bytes());
+  }
+
+  // sub returns a reader over the next length-delimited field (e.g. a packed array or map entry).
+  sub(): ProtoReader {
+    const n = this.uint32();
+    const r = new ProtoReader(this.buf, this.pos, this.pos + n);
+    this.pos += n;
+    return r;
+  }
+
+  skip(wire: number) {
+    switch (wire) {
+      case 0: this.uint64(); break;
+      case 1: this.pos += 8; break;
+      case 2: this.pos += this.uint32(); break;
+      case 5: this.pos += 4; break;
+      default: throw new Error("proto: unsupported wire type " + wire);
+    }
+  }
+}
+`
+
+const tsFooter = `
+export const AttrsByID: { [id: string]: AttrCodec<any> } = Object.fromEntries(
+  Object.values(AttrSpecs).map((codec) => [codec.id, codec]),
+);
+`
@@ -0,0 +1,73 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+)
+
+func TestTypeScript(t *testing.T) {
+	reg := amp.NewRegistry()
+	amp.RegisterBuiltinTypes(reg)
+	schema, err := NewSchema(reg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(schema.Attrs) != len(reg.ListAttrs()) {
+		t.Fatalf("expected %d attrs, got %d", len(reg.ListAttrs()), len(schema.Attrs))
+	}
+
+	var ts strings.Builder
+	if err = WriteTypeScript(&ts, schema); err != nil {
+		t.Fatal(err)
+	}
+	tagSpec := (&amp.Tag{}).TagSpec()
+	for _, expect := range []string{
+		"export interface Tag {\n  ID_0?: bigint;",
+		"if (m.Text) { w.key(13, 2); w.string(m.Text); }",
+		"m.UserID = decodeTag(r.bytes());",            // nested message
+		"(m.CredentialIDs ??= []).push(r.bytes());",   // repeated bytes
+		"m.Code = r.int32() as ErrCode;",              // enum
+		"export enum ErrCode {\n  ErrCode_NoErr = 0,", // enum values
+		`"amp.attr.Tag": { id: "` + tagSpec.ID.Base32() + `"`,
+	} {
+		if !strings.Contains(ts.String(), expect) {
+			t.Errorf("TypeScript output missing %q", expect)
+		}
+	}
+}
+
+func TestCSharp(t *testing.T) {
+	reg := amp.NewRegistry()
+	amp.RegisterBuiltinTypes(reg)
+	schema, err := NewSchema(reg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var cs strings.Builder
+	if err = WriteCSharp(&cs, schema); err != nil {
+		t.Fatal(err)
+	}
+	id := (&amp.Tag{}).TagSpec().ID
+	for _, expect := range []string{
+		"public sealed partial class Tag : IProtoMessage\n    {\n        public long ID_0;",
+		"if (!string.IsNullOrEmpty(this.Text)) { w.WriteKey(13, 2); w.WriteString(this.Text); }",
+		"this.StateSync = (StateSync)r.ReadInt32();", // enum
+		"this.PinAttrs.Add(r.ReadMessage<Tag>());",   // repeated message
+		"public sealed partial class TxEnvelope",     // always included
+		"const int HeaderVersion = 51;",              // amp.Const_TxHeader_Version
+		"[DllImport(Lib)] public static extern int amp_tx_recv(",
+		fmt.Sprintf(`AttrSpec<Tag> Tag = new AttrSpec<Tag>("amp.attr.Tag", new TagID(0x%XUL,`, id[0]),
+	} {
+		if !strings.Contains(cs.String(), expect) {
+			t.Errorf("C# output missing %q", expect)
+		}
+	}
+
+	if err = WriteCSharp(&cs, &Schema{}); err == nil {
+		t.Error("expected error for schema without builtin attrs")
+	}
+}
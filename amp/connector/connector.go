@@ -0,0 +1,191 @@
+// Package connector bridges a cell subtree to a message broker, so amp hosts can participate in existing event-driven backends:
+//   - each tx committed to the subtree is published to a topic (a NATS subject or Kafka topic) as a serialized TxMsg
+//   - optionally, txs received on a topic are merged back into the subtree
+//
+// NATS is supported via DialNATS.  Kafka (or any other broker) is supported by adapting a client library to Broker,
+// since a Broker only needs to publish to and subscribe to a topic.
+package connector
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/amp/replica"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// Message is a message published to or received from a Broker.
+type Message struct {
+	Topic string
+	Key   []byte // partitions messages (e.g. a Kafka message key); nil if the broker has no keys
+	Value []byte
+}
+
+// Broker publishes and subscribes to topics of a message broker -- concurrency safe.
+type Broker interface {
+
+	// Publishes a message to the given topic.
+	Publish(msg Message) error
+
+	// Calls fn for each message received on the given topic until ctx closes.
+	// fn is called serially and should return promptly.
+	Subscribe(ctx task.Context, topic string, fn func(msg Message)) error
+}
+
+// Opts specifies a bridged subtree.
+type Opts struct {
+	Root   tag.ID          // root cell of the bridged subtree
+	Cells  replica.Replica // host store whose commits are published and into which consumed txs are merged
+	Broker Broker
+	Topic  string   // NATS subject or Kafka topic that txs are published to
+	Attrs  []tag.ID // if set, only ops of these attrs are published
+
+	// If set, txs received on ConsumeTopic (or Topic if empty) are merged into Cells.
+	// Txs this bridge published are not merged back, and txs it merged are not published again.
+	Consume      bool
+	ConsumeTopic string
+}
+
+// Start starts bridging the given subtree as a child of the given context until it closes.
+// Each message published is keyed by the subtree's root ID (in base32), so brokers with partitions keep its txs in order.
+func Start(parent task.Context, opts Opts) (task.Context, error) {
+	if opts.Root.IsNil() {
+		return nil, amp.ErrBadTarget
+	}
+	if opts.Cells == nil || opts.Broker == nil || opts.Topic == "" {
+		return nil, amp.ErrCode_BadRequest.Error("connector: missing Cells, Broker, or Topic")
+	}
+	if opts.ConsumeTopic == "" {
+		opts.ConsumeTopic = opts.Topic
+	}
+
+	bridge := &bridge{
+		opts:    opts,
+		key:     []byte(opts.Root.Base32()),
+		bridged: make(map[tag.ID]struct{}),
+	}
+	return parent.StartChild(&task.Task{
+		Info: task.Info{
+			Label: "connector: " + opts.Topic,
+		},
+		OnRun: func(ctx task.Context) {
+			opts.Cells.WatchTxs(ctx, opts.Root, func(tx *amp.TxMsg) {
+				bridge.publish(ctx, tx)
+			})
+			if opts.Consume {
+				err := opts.Broker.Subscribe(ctx, opts.ConsumeTopic, func(msg Message) {
+					bridge.consume(ctx, msg)
+				})
+				if err != nil {
+					ctx.Log().Errorf("subscribe %q failed: %v", opts.ConsumeTopic, err)
+				}
+			}
+			<-ctx.Closing()
+		},
+	})
+}
+
+type bridge struct {
+	opts Opts
+	key  []byte
+
+	mu      sync.Mutex
+	bridged map[tag.ID]struct{} // GenesisIDs of txs published or merged (if consuming), used to suppress echoes
+}
+
+// maxBridged bounds the echo set should a broker not echo published txs back.
+const maxBridged = 4096
+
+func (bridge *bridge) addBridged(genesisID tag.ID) {
+	bridge.mu.Lock()
+	defer bridge.mu.Unlock()
+	if len(bridge.bridged) >= maxBridged {
+		clear(bridge.bridged)
+	}
+	bridge.bridged[genesisID] = struct{}{}
+}
+
+func (bridge *bridge) removeBridged(genesisID tag.ID) bool {
+	bridge.mu.Lock()
+	defer bridge.mu.Unlock()
+	_, exists := bridge.bridged[genesisID]
+	delete(bridge.bridged, genesisID)
+	return exists
+}
+
+func (bridge *bridge) publish(ctx task.Context, tx *amp.TxMsg) {
+	genesisID := tx.GenesisID()
+	if bridge.removeBridged(genesisID) {
+		return // merged by this bridge
+	}
+	out := bridge.filter(tx)
+	if out == nil {
+		return
+	}
+	if bridge.opts.Consume {
+		bridge.addBridged(genesisID)
+	}
+
+	var buf []byte
+	out.MarshalToBuffer(&buf)
+	err := bridge.opts.Broker.Publish(Message{
+		Topic: bridge.opts.Topic,
+		Key:   bridge.key,
+		Value: buf,
+	})
+	if err != nil {
+		ctx.Log().Warnf("publish %s failed: %v", genesisID.Base32Suffix(), err)
+	}
+}
+
+// filter returns a tx containing only the ops of tx to be published (or nil if none).
+func (bridge *bridge) filter(tx *amp.TxMsg) *amp.TxMsg {
+	attrs := bridge.opts.Attrs
+	if len(attrs) == 0 {
+		return tx
+	}
+	var out *amp.TxMsg
+	for i := range tx.Ops {
+		op := tx.Ops[i]
+		if !contains(attrs, op.AttrID) {
+			continue
+		}
+		if out == nil {
+			out = amp.NewTxMsg(false)
+			out.TxEnvelope = tx.TxEnvelope
+			out.OpCount = 0
+		}
+		out.MarshalOpWithBuf(&op, tx.DataStore[op.DataOfs:op.DataOfs+op.DataLen])
+	}
+	return out
+}
+
+func contains(IDs []tag.ID, ID tag.ID) bool {
+	for _, id := range IDs {
+		if id == ID {
+			return true
+		}
+	}
+	return false
+}
+
+func (bridge *bridge) consume(ctx task.Context, msg Message) {
+	tx, err := amp.ReadTxMsg(bytes.NewReader(msg.Value))
+	if err != nil {
+		ctx.Log().Warnf("dropped malformed message on %q: %v", msg.Topic, err)
+		return
+	}
+	defer tx.ReleaseRef()
+
+	genesisID := tx.GenesisID()
+	if bridge.removeBridged(genesisID) {
+		return // published by this bridge
+	}
+	bridge.addBridged(genesisID)
+	if err = bridge.opts.Cells.MergeTx(tx); err != nil {
+		bridge.removeBridged(genesisID)
+		ctx.Log().Warnf("merge %s failed: %v", genesisID.Base32Suffix(), err)
+	}
+}
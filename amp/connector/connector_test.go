@@ -0,0 +1,199 @@
+package connector
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// testNATS is a minimal NATS server routing PUBs to SUBs of other connections by exact subject.
+type testNATS struct {
+	ln   net.Listener
+	mu   sync.Mutex
+	subs map[string]map[net.Conn]string // subject => conn => sid
+}
+
+func startTestNATS(t *testing.T) *testNATS {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := &testNATS{
+		ln:   ln,
+		subs: make(map[string]map[net.Conn]string),
+	}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go srv.serve(conn)
+		}
+	}()
+	return srv
+}
+
+func (srv *testNATS) serve(conn net.Conn) {
+	defer conn.Close()
+	rd := bufio.NewReader(conn)
+	fmt.Fprintf(conn, "INFO {\"server_id\":\"test\"}\r\n")
+	for {
+		line, err := rd.ReadString('\n')
+		if err != nil {
+			return
+		}
+		fields := strings.Fields(line)
+		switch fields[0] {
+		case "PING":
+			fmt.Fprintf(conn, "PONG\r\n")
+		case "SUB":
+			srv.mu.Lock()
+			if srv.subs[fields[1]] == nil {
+				srv.subs[fields[1]] = make(map[net.Conn]string)
+			}
+			srv.subs[fields[1]][conn] = fields[2]
+			srv.mu.Unlock()
+		case "PUB":
+			n, _ := strconv.Atoi(fields[2])
+			payload := make([]byte, n+2)
+			io.ReadFull(rd, payload)
+			srv.mu.Lock()
+			for sub, sid := range srv.subs[fields[1]] {
+				if sub != conn { // echo disabled
+					fmt.Fprintf(sub, "MSG %s %s %d\r\n%s", fields[1], sid, n, payload)
+				}
+			}
+			srv.mu.Unlock()
+		}
+	}
+}
+
+// testCells is a Replica that commits txs by calling its watchers.
+type testCells struct {
+	mu       sync.Mutex
+	watchers []func(tx *amp.TxMsg)
+	merged   chan *amp.TxMsg
+}
+
+func (cells *testCells) ReadSince(rootID tag.ID, after tag.ID, fn func(tx *amp.TxMsg) error) error {
+	return nil
+}
+
+func (cells *testCells) WatchTxs(ctx task.Context, rootID tag.ID, fn func(tx *amp.TxMsg)) {
+	cells.mu.Lock()
+	cells.watchers = append(cells.watchers, fn)
+	cells.mu.Unlock()
+}
+
+func (cells *testCells) MergeTx(tx *amp.TxMsg) error {
+	tx.AddRef()
+	cells.merged <- tx
+	cells.commit(tx)
+	return nil
+}
+
+func (cells *testCells) commit(tx *amp.TxMsg) {
+	cells.mu.Lock()
+	defer cells.mu.Unlock()
+	for _, fn := range cells.watchers {
+		fn(tx)
+	}
+}
+
+func TestNATSBridge(t *testing.T) {
+	root, _ := task.Start(&task.Task{})
+	defer root.Close()
+
+	srv := startTestNATS(t)
+	defer srv.ln.Close()
+	serverURL := "nats://" + srv.ln.Addr().String()
+
+	bridgeConn, err := DialNATS(serverURL, NATSOpts{Name: "bridge"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bridgeConn.Close()
+	peer, err := DialNATS(serverURL, NATSOpts{Name: "peer"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer peer.Close()
+
+	received := make(chan Message, 4)
+	peer.Subscribe(root, "cells", func(msg Message) {
+		received <- msg
+	})
+
+	cells := &testCells{merged: make(chan *amp.TxMsg, 4)}
+	cellID := tag.ID{0, 0, 7}
+	_, err = Start(root, Opts{
+		Root:    cellID,
+		Cells:   cells,
+		Broker:  bridgeConn,
+		Topic:   "cells",
+		Consume: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(50 * time.Millisecond) // await SUBs
+
+	tagAttr := (&amp.Tag{}).TagSpec().ID
+	readTag := func(buf []byte) string {
+		tx, err := amp.ReadTxMsg(bytes.NewReader(buf))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var val amp.Tag
+		if err = tx.UnmarshalOpValue(0, &val); err != nil {
+			t.Fatal(err)
+		}
+		return val.Text
+	}
+
+	// committed txs are published
+	tx, _ := amp.MarshalAttr(cellID, tagAttr, &amp.Tag{Text: "outbound"})
+	tx.SetGenesisID(tag.Now())
+	cells.commit(tx)
+	select {
+	case msg := <-received:
+		if text := readTag(msg.Value); text != "outbound" {
+			t.Fatalf("published %q", text)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("tx not published")
+	}
+
+	// consumed txs are merged and not published back
+	tx, _ = amp.MarshalAttr(cellID, tagAttr, &amp.Tag{Text: "inbound"})
+	tx.SetGenesisID(tag.Now())
+	var buf []byte
+	tx.MarshalToBuffer(&buf)
+	peer.Publish(Message{Topic: "cells", Value: buf})
+	select {
+	case merged := <-cells.merged:
+		var val amp.Tag
+		if merged.UnmarshalOpValue(0, &val); val.Text != "inbound" {
+			t.Fatalf("merged %q", val.Text)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("tx not merged")
+	}
+	select {
+	case <-received:
+		t.Fatal("merged tx echoed")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
@@ -0,0 +1,173 @@
+// Package diag implements a HostService serving Prometheus metrics and net/http/pprof profiles, so operators can inspect and profile a live host without code changes:
+//
+//	GET /metrics                 task, runtime, and registered collectors' metrics in the Prometheus text format
+//	GET /debug/pprof/            index of the runtime's profiles (e.g. heap, goroutine, mutex)
+//	GET /debug/pprof/profile     CPU profile over ?seconds={n}
+//	GET /debug/pprof/trace       execution trace over ?seconds={n}
+//	GET /livez                   200 if the host's liveness checks pass, otherwise 503 (see Opts.Health)
+//	GET /readyz                  200 if all of the host's health checks pass, otherwise 503; /healthz is an alias
+//	GET, PUT /loglevels          log levels per subsystem, changeable at runtime (see Opts.LogLevels and log.Levels)
+//
+// Opts.Routes adds further admin endpoints, such as an amp.FlightRecorder's dump of recent txs.
+//
+// Profiles expose a host's internals, so the service only binds a loopback address unless Opts.AllowRemote is set (e.g. for an admin network).
+package diag
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"sync"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/log"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// Collector writes a subsystem's metrics as /metrics is scraped (e.g. a blob.Cache's hit rates).
+type Collector func(w *MetricWriter)
+
+// Opts specifies a diagnostics Service.
+type Opts struct {
+	Addr        string                  // address to listen on; if empty, "127.0.0.1:6060"
+	AllowRemote bool                    // if set, Addr may be a non-loopback address
+	Collectors  []Collector             // written after the built-in task and runtime metrics
+	Routes      map[string]http.Handler // additional admin endpoints by path, e.g. "/debug/txs": an amp.FlightRecorder
+	Health      *amp.HealthChecks       // if set, served via /livez and /readyz
+	LogLevels   *log.Levels             // if set, served via /loglevels
+	StopWait    time.Duration           // longest GracefulStop waits for in-flight requests (e.g. a CPU profile); if <= 0, 5s
+}
+
+// Service is an amp.HostService serving metrics and profiles on its own listener.
+type Service struct {
+	task.Context
+	opts    Opts
+	mux     *http.ServeMux
+	started time.Time
+
+	mu       sync.Mutex
+	server   *http.Server
+	listener net.Listener
+	host     amp.Host
+}
+
+var _ amp.HostService = (*Service)(nil)
+
+func NewService(opts Opts) *Service {
+	if opts.Addr == "" {
+		opts.Addr = "127.0.0.1:6060"
+	}
+	if opts.StopWait <= 0 {
+		opts.StopWait = 5 * time.Second
+	}
+	svc := &Service{
+		opts: opts,
+		mux:  http.NewServeMux(),
+	}
+	svc.mux.HandleFunc("/metrics", svc.serveMetrics)
+	svc.mux.HandleFunc("/debug/pprof/", pprof.Index)
+	svc.mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	svc.mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	svc.mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	svc.mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	if opts.Health != nil {
+		svc.mux.HandleFunc("/livez", svc.serveHealth)
+		svc.mux.HandleFunc("/readyz", svc.serveHealth)
+		svc.mux.HandleFunc("/healthz", svc.serveHealth)
+	}
+	if opts.LogLevels != nil {
+		svc.mux.Handle("/loglevels", opts.LogLevels)
+	}
+	for path, handler := range opts.Routes {
+		svc.mux.Handle(path, handler)
+	}
+	return svc
+}
+
+// Handler returns the handler serving /metrics and /debug/pprof/, e.g. to mount on an existing admin server.
+func (svc *Service) Handler() http.Handler {
+	return svc.mux
+}
+
+// Addr returns the address the service is listening on (e.g. the port chosen for ":0"), or "" if not started.
+func (svc *Service) Addr() string {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	if svc.listener == nil {
+		return ""
+	}
+	return svc.listener.Addr().String()
+}
+
+func (svc *Service) StartService(on amp.Host) error {
+	if !svc.opts.AllowRemote {
+		if err := checkLoopback(svc.opts.Addr); err != nil {
+			return err
+		}
+	}
+	listener, err := net.Listen("tcp", svc.opts.Addr)
+	if err != nil {
+		return amp.ErrCode_ProviderErr.Wrap(err)
+	}
+	server := &http.Server{
+		Handler:           svc.mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	ctx, err := on.StartChild(&task.Task{
+		Info: task.Info{
+			Label: "diag: " + listener.Addr().String(),
+		},
+		OnRun: func(ctx task.Context) {
+			if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
+				ctx.Log().Warnf("diag: %v", err)
+			}
+		},
+		OnClosing: func() {
+			server.Close()
+		},
+	})
+	if err != nil {
+		listener.Close()
+		return err
+	}
+
+	svc.mu.Lock()
+	svc.server = server
+	svc.listener = listener
+	svc.host = on
+	svc.started = time.Now()
+	svc.mu.Unlock()
+	svc.Context = ctx
+	return nil
+}
+
+// GracefulStop stops accepting connections and waits (up to Opts.StopWait) for in-flight requests to complete.
+func (svc *Service) GracefulStop() {
+	svc.mu.Lock()
+	server := svc.server
+	svc.mu.Unlock()
+	if server == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), svc.opts.StopWait)
+	defer cancel()
+	server.Shutdown(ctx)
+}
+
+// checkLoopback returns an error unless addr's host is a loopback address (or "localhost").
+func checkLoopback(addr string) error {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return amp.ErrCode_BadRequest.Wrap(err)
+	}
+	if host == "localhost" {
+		return nil
+	}
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		return nil
+	}
+	return amp.ErrCode_BadRequest.Errorf("diag: %q is not a loopback address (see Opts.AllowRemote)", addr)
+}
@@ -0,0 +1,53 @@
+package diag
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// healthJSON is the JSON form of an amp.HealthReport.
+type healthJSON struct {
+	Live   bool              `json:"live"`
+	Ready  bool              `json:"ready"`
+	Checks []healthCheckJSON `json:"checks"`
+}
+
+type healthCheckJSON struct {
+	Name      string `json:"name"`
+	OK        bool   `json:"ok"`
+	Liveness  bool   `json:"liveness,omitempty"`
+	Detail    string `json:"detail,omitempty"`
+	LatencyMs int64  `json:"latencyMs"`
+}
+
+// serveHealth runs the health checks, replying 503 if the probed state (liveness for /livez, otherwise readiness) is not met.
+func (svc *Service) serveHealth(w http.ResponseWriter, r *http.Request) {
+	report := svc.opts.Health.Run(r.Context())
+	out := healthJSON{
+		Live:   report.Live,
+		Ready:  report.Ready,
+		Checks: make([]healthCheckJSON, len(report.Results)),
+	}
+	for i, result := range report.Results {
+		out.Checks[i] = healthCheckJSON{
+			Name:      result.Name,
+			OK:        result.Err == nil,
+			Liveness:  result.Liveness,
+			LatencyMs: result.Latency.Milliseconds(),
+		}
+		if result.Err != nil {
+			out.Checks[i].Detail = result.Err.Error()
+		}
+	}
+
+	ok := report.Ready
+	if r.URL.Path == "/livez" {
+		ok = report.Live
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if !ok {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	json.NewEncoder(w).Encode(out)
+}
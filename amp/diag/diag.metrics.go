@@ -0,0 +1,174 @@
+package diag
+
+import (
+	"bufio"
+	"net/http"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/amp/std"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// MetricWriter writes metrics in the Prometheus text exposition format (version 0.0.4).
+// Samples of the same metric must be written consecutively, since its HELP and TYPE lines are written before its first sample.
+type MetricWriter struct {
+	w    *bufio.Writer
+	last string // name of the metric last written
+}
+
+// Counter writes a sample of a cumulative counter, where labels are name and value pairs.
+func (mw *MetricWriter) Counter(name, help string, val float64, labels ...string) {
+	mw.sample(name, "counter", help, val, labels)
+}
+
+// Gauge writes a sample of a value that can go up and down, where labels are name and value pairs.
+func (mw *MetricWriter) Gauge(name, help string, val float64, labels ...string) {
+	mw.sample(name, "gauge", help, val, labels)
+}
+
+func (mw *MetricWriter) sample(name, kind, help string, val float64, labels []string) {
+	w := mw.w
+	if name != mw.last {
+		mw.last = name
+		w.WriteString("# HELP ")
+		w.WriteString(name)
+		w.WriteByte(' ')
+		w.WriteString(strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(help))
+		w.WriteString("\n# TYPE ")
+		w.WriteString(name)
+		w.WriteByte(' ')
+		w.WriteString(kind)
+		w.WriteByte('\n')
+	}
+	w.WriteString(name)
+	if len(labels) > 1 {
+		w.WriteByte('{')
+		for i := 0; i+1 < len(labels); i += 2 {
+			if i > 0 {
+				w.WriteByte(',')
+			}
+			w.WriteString(labels[i])
+			w.WriteString(`="`)
+			w.WriteString(strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`).Replace(labels[i+1]))
+			w.WriteByte('"')
+		}
+		w.WriteByte('}')
+	}
+	w.WriteByte(' ')
+	w.WriteString(strconv.FormatFloat(val, 'g', -1, 64))
+	w.WriteByte('\n')
+}
+
+func (svc *Service) serveMetrics(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	mw := &MetricWriter{
+		w: bufio.NewWriter(w),
+	}
+	svc.mu.Lock()
+	started := svc.started
+	host := svc.host
+	svc.mu.Unlock()
+	if !started.IsZero() {
+		mw.Gauge("amp_uptime_seconds", "Seconds since the diagnostics service started.", time.Since(started).Seconds())
+	}
+	writeTaskMetrics(mw)
+	writeSlowOpMetrics(mw)
+	writeAppUsageMetrics(mw, host)
+	writeRuntimeMetrics(mw)
+	for _, collect := range svc.opts.Collectors {
+		collect(mw)
+	}
+	mw.w.Flush()
+}
+
+// writeTaskMetrics writes the task subsystem's counters -- see task.ReadMetrics().
+func writeTaskMetrics(mw *MetricWriter) {
+	m := task.ReadMetrics()
+	mw.Counter("amp_tasks_started_total", "Task contexts started.", float64(m.Started))
+	mw.Counter("amp_tasks_closed_total", "Task contexts fully closed.", float64(m.Closed))
+	mw.Gauge("amp_tasks_live", "Task contexts started but not yet fully closed.", float64(m.Live))
+
+	classes := make([]string, 0, len(m.ByLabel))
+	for class := range m.ByLabel {
+		classes = append(classes, class)
+	}
+	sort.Strings(classes)
+	for _, class := range classes {
+		mw.Gauge("amp_task_class_live", "Live task contexts by label class.", float64(m.ByLabel[class].Live), "class", class)
+	}
+	for _, class := range classes {
+		mw.Counter("amp_task_class_started_total", "Task contexts started by label class.", float64(m.ByLabel[class].Started), "class", class)
+	}
+	for _, class := range classes {
+		mw.Gauge("amp_task_class_lifetime_mean_seconds", "Mean lifetime of closed task contexts by label class.", m.ByLabel[class].MeanLifetime().Seconds(), "class", class)
+	}
+	for _, class := range classes {
+		mw.Gauge("amp_task_class_lifetime_max_seconds", "Longest lifetime of a closed task context by label class.", m.ByLabel[class].MaxLifetime.Seconds(), "class", class)
+	}
+}
+
+// writeSlowOpMetrics writes the count of pins and app callbacks that exceeded the slow op threshold -- see std.SlowOpPolicy.
+func writeSlowOpMetrics(mw *MetricWriter) {
+	counts := std.ReadSlowOpMetrics()
+	keys := make([]std.SlowOpKey, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].App != keys[j].App {
+			return keys[i].App < keys[j].App
+		}
+		return keys[i].Phase < keys[j].Phase
+	})
+	for _, key := range keys {
+		mw.Counter("amp_slow_ops_total", "Pin phases that exceeded the slow op threshold, by app and phase.", float64(counts[key]), "app", key.App, "phase", key.Phase)
+	}
+}
+
+// writeAppUsageMetrics writes the approximate resource usage of each app -- see std.AppUsage.
+func writeAppUsageMetrics(mw *MetricWriter, host amp.Host) {
+	var root task.Context
+	if host != nil {
+		root = host
+	}
+	usages := std.ReadAppUsage(root)
+	for _, u := range usages {
+		mw.Counter("amp_app_busy_seconds_total", "Time spent in pin phases, by app.", u.Busy.Seconds(), "app", u.App)
+	}
+	for _, u := range usages {
+		mw.Counter("amp_app_alloc_bytes_total", "Approximate heap bytes allocated during pin phases, by app.", float64(u.AllocBytes), "app", u.App)
+	}
+	for _, u := range usages {
+		mw.Counter("amp_app_txs_pushed_total", "Txs pushed to clients, by app.", float64(u.TxsPushed), "app", u.App)
+	}
+	for _, u := range usages {
+		mw.Counter("amp_app_pushed_bytes_total", "Op value bytes pushed to clients, by app.", float64(u.BytesPushed), "app", u.App)
+	}
+	if root != nil {
+		for _, u := range usages {
+			mw.Gauge("amp_app_tasks", "Live tasks within each app's contexts.", float64(u.Tasks), "app", u.App)
+		}
+	}
+}
+
+func writeRuntimeMetrics(mw *MetricWriter) {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+	mw.Gauge("go_goroutines", "Number of goroutines that currently exist.", float64(runtime.NumGoroutine()))
+	mw.Gauge("go_memstats_heap_alloc_bytes", "Bytes of allocated heap objects.", float64(mem.HeapAlloc))
+	mw.Gauge("go_memstats_heap_inuse_bytes", "Bytes in in-use heap spans.", float64(mem.HeapInuse))
+	mw.Gauge("go_memstats_sys_bytes", "Bytes of memory obtained from the OS.", float64(mem.Sys))
+	mw.Counter("go_memstats_alloc_bytes_total", "Cumulative bytes allocated for heap objects.", float64(mem.TotalAlloc))
+	mw.Counter("go_gc_cycles_total", "Completed GC cycles.", float64(mem.NumGC))
+	mw.Counter("go_gc_pause_seconds_total", "Cumulative GC stop-the-world pause time.", time.Duration(mem.PauseTotalNs).Seconds())
+}
@@ -0,0 +1,112 @@
+package diag
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/log"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// testHost implements only what a Service uses.
+type testHost struct {
+	amp.Host
+	ctx task.Context
+}
+
+func (host *testHost) StartChild(task *task.Task) (task.Context, error) {
+	return host.ctx.StartChild(task)
+}
+
+func (host *testHost) Closing() <-chan struct{} {
+	return host.ctx.Closing()
+}
+
+// Forwarded so that metrics can inspect the host's task tree.
+func (host *testHost) Done() <-chan struct{}                        { return host.ctx.Done() }
+func (host *testHost) Info() task.Info                              { return host.ctx.Info() }
+func (host *testHost) Log() log.Logger                              { return host.ctx.Log() }
+func (host *testHost) Clock() task.Clock                            { return host.ctx.Clock() }
+func (host *testHost) GetChildren(in []task.Context) []task.Context { return host.ctx.GetChildren(in) }
+
+func TestService(t *testing.T) {
+	root, _ := task.Start(&task.Task{
+		Info: task.Info{Label: "diag test"},
+	})
+	defer root.Close()
+	host := &testHost{ctx: root}
+
+	if err := NewService(Opts{Addr: "0.0.0.0:0"}).StartService(host); err == nil {
+		t.Fatal("expected a non-loopback address to be refused")
+	}
+
+	health := &amp.HealthChecks{}
+	health.Add(amp.CheckHostStarted(host), amp.HealthCheck{
+		Name: "storage",
+		Check: func(ctx context.Context) error {
+			return amp.ErrCode_StorageFailure.Error("unreachable")
+		},
+	})
+	svc := NewService(Opts{
+		Addr:   "127.0.0.1:0",
+		Health: health,
+		Collectors: []Collector{func(w *MetricWriter) {
+			w.Gauge("test_depth", "A \"test\" gauge.", 2, "queue", `a"b`)
+			w.Gauge("test_depth", "A \"test\" gauge.", 3, "queue", "c")
+		}},
+	})
+	if err := svc.StartService(host); err != nil {
+		t.Fatal(err)
+	}
+	defer svc.Close()
+
+	getStatus := func(path string) (int, string) {
+		resp, err := http.Get("http://" + svc.Addr() + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, string(body)
+	}
+	get := func(path string) string {
+		status, body := getStatus(path)
+		if status != http.StatusOK {
+			t.Fatalf("GET %s: %d", path, status)
+		}
+		return body
+	}
+
+	metrics := get("/metrics")
+	for _, want := range []string{
+		"# TYPE amp_tasks_live gauge\n",
+		`amp_task_class_live{class="diag"} 1`,
+		"# TYPE go_goroutines gauge\n",
+		"# HELP test_depth A \"test\" gauge.\n# TYPE test_depth gauge\ntest_depth{queue=\"a\\\"b\"} 2\ntest_depth{queue=\"c\"} 3\n",
+	} {
+		if !strings.Contains(metrics, want) {
+			t.Fatalf("metrics missing %q:\n%s", want, metrics)
+		}
+	}
+	if !strings.Contains(get("/debug/pprof/"), "goroutine") {
+		t.Fatal("expected the pprof index")
+	}
+
+	// the host is live but not ready while storage is unreachable
+	if status, _ := getStatus("/livez"); status != http.StatusOK {
+		t.Fatalf("expected live, got %d", status)
+	}
+	status, body := getStatus("/readyz")
+	if status != http.StatusServiceUnavailable || !strings.Contains(body, `"detail":"unreachable"`) {
+		t.Fatalf("expected not ready, got %d: %s", status, body)
+	}
+
+	svc.GracefulStop()
+	if _, err := http.Get("http://" + svc.Addr() + "/metrics"); err == nil {
+		t.Fatal("expected the listener to be closed")
+	}
+}
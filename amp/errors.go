@@ -1,21 +1,48 @@
 package amp
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"time"
 )
 
 var (
-	ErrMalformedTx   = ErrCode_MalformedTx.Error("bad varint")
-	ErrStreamClosed  = ErrCode_NotConnected.Error("stream closed")
-	ErrCellNotFound  = ErrCode_CellNotFound.Error("cell not found")
-	ErrRequestClosed = ErrCode_RequestClosed .Error("client request closed")
-	ErrNotPinnable   = ErrCode_PinFailed.Error("not pinnable")
-	ErrUnimplemented = ErrCode_PinFailed.Error("not implemented")
-	ErrBadTarget     = ErrCode_MalformedTx.Error("missing target ID")
-	ErrNothingToPin  = ErrCode_PinFailed.Error("nothing to pin")
-	ErrShuttingDown  = ErrCode_ShuttingDown.Error("shutting down")
-	ErrTimeout       = ErrCode_Timeout.Error("timeout")
-	ErrNoAuthToken   = ErrCode_AuthFailed.Error("no auth token")
+	ErrMalformedTx       = ErrCode_MalformedTx.Error("bad varint")
+	ErrStreamClosed      = ErrCode_NotConnected.Error("stream closed")
+	ErrCellNotFound      = ErrCode_CellNotFound.Error("cell not found")
+	ErrRequestClosed     = ErrCode_RequestClosed.Error("client request closed")
+	ErrNotPinnable       = ErrCode_PinFailed.Error("not pinnable")
+	ErrUnimplemented     = ErrCode_Unimplemented.Error("not implemented")
+	ErrBadTarget         = ErrCode_MalformedTx.Error("missing target ID")
+	ErrNothingToPin      = ErrCode_PinFailed.Error("nothing to pin")
+	ErrShuttingDown      = ErrCode_ShuttingDown.Error("shutting down")
+	ErrTimeout           = ErrCode_Timeout.Error("timeout")
+	ErrNoAuthToken       = ErrCode_AuthFailed.Error("no auth token")
+	ErrAliasNotFound     = ErrCode_CellNotFound.Error("alias not found")
+	ErrAliasClaimed      = ErrCode_InsufficientPermissions.Error("alias claimed by another app")
+	ErrLeaseHeld         = ErrCode_InsufficientPermissions.Error("cell leased by another session")
+	ErrLeaseNotHeld      = ErrCode_BadRequest.Error("cell lease not held")
+	ErrNotScheduled      = ErrCode_RequestNotFound.Error("tx not scheduled")
+	ErrNothingToUndo     = ErrCode_NothingToCommit.Error("nothing to undo")
+	ErrNothingToRedo     = ErrCode_NothingToCommit.Error("nothing to redo")
+	ErrNoUpload          = ErrCode_RequestNotFound.Error("upload not found")
+	ErrAccessDenied      = ErrCode_InsufficientPermissions.Error("access denied")
+	ErrOverQuota         = ErrCode_QuotaExceeded.Error("quota exceeded")
+	ErrBadCapability     = ErrCode_AuthFailed.Error("invalid capability token")
+	ErrCapabilityExpired = ErrCode_SessionExpired.Error("capability token expired")
+	ErrDeviceNotFound    = ErrCode_AuthFailed.Error("device not enrolled")
+	ErrDeviceRevoked     = ErrCode_AuthFailed.Error("device revoked")
+	ErrDeviceConflict    = ErrCode_InsufficientPermissions.Error("device enrolled with another key")
+	ErrBadAPIKey         = ErrCode_AuthFailed.Error("invalid API key")
+	ErrAPIKeyExpired     = ErrCode_SessionExpired.Error("API key expired")
+	ErrBadPasskey        = ErrCode_AuthFailed.Error("passkey verification failed")
+	ErrPasskeyNotFound   = ErrCode_AuthFailed.Error("passkey not registered")
+	ErrUserKeyNotFound   = ErrCode_RequestNotFound.Error("user has no key")
+	ErrClientHeldKey     = ErrCode_UnsupportedOp.Error("private key is held by the client")
+	ErrNotSealedTo       = ErrCode_AuthFailed.Error("content not sealed to this user")
+	ErrOAuthNotGranted   = ErrCode_AuthFailed.Error("no OAuth grant for provider")
+	ErrOAuthBadState     = ErrCode_AuthFailed.Error("OAuth redirect not recognized or expired")
 )
 
 // Error makes our custom error type conform to a standard Go error
@@ -32,15 +59,47 @@ func (err *Err) Error() string {
 	return err.Msg
 }
 
+// Is reports whether target is an *Err with the same code and msg, so errors.Is() matches a sentinel (e.g. ErrCellNotFound) after it has crossed the wire or been given a user message.
+func (err *Err) Is(target error) bool {
+	other, ok := target.(*Err)
+	return ok && other.Code == err.Code && other.Msg == err.Msg
+}
+
+// WithUserMsg returns a copy of this error with the given message for the user -- see UserMessage().
+func (err *Err) WithUserMsg(userMsg string) *Err {
+	dupe := *err
+	dupe.UserMsg = userMsg
+	return &dupe
+}
+
+// WithRetryAfter returns a copy of this error marked retryable after the given delay (e.g. as a provider requested).
+func (err *Err) WithRetryAfter(delay time.Duration) *Err {
+	dupe := *err
+	dupe.Retryable = true
+	dupe.RetryAfterMs = delay.Milliseconds()
+	return &dupe
+}
+
+// RetryAfter returns the suggested delay before a retry, or 0 if none.
+func (err *Err) RetryAfter() time.Duration {
+	return time.Duration(err.RetryAfterMs) * time.Millisecond
+}
+
+func newErr(code ErrCode, msg string) *Err {
+	return &Err{
+		Code:      code,
+		Msg:       msg,
+		Category:  code.Category(),
+		Retryable: code.Retryable(),
+	}
+}
+
 // Error returns an *Err with the given error code
 func (code ErrCode) Error(msg string) error {
 	if code == ErrCode_NoErr {
 		return nil
 	}
-	return &Err{
-		Code: code,
-		Msg:  msg,
-	}
+	return newErr(code, msg)
 }
 
 // Errorf returns an *Err with the given error code and msg.
@@ -49,17 +108,10 @@ func (code ErrCode) Errorf(format string, msgArgs ...interface{}) error {
 	if code == ErrCode_NoErr {
 		return nil
 	}
-
-	err := &Err{
-		Code: code,
-	}
-	if len(msgArgs) == 0 {
-		err.Msg = format
-	} else {
-		err.Msg = fmt.Sprintf(format, msgArgs...)
+	if len(msgArgs) > 0 {
+		format = fmt.Sprintf(format, msgArgs...)
 	}
-
-	return err
+	return newErr(code, format)
 }
 
 // Wrap returns a ReqErr with the given error code and "cause" error
@@ -67,20 +119,127 @@ func (code ErrCode) Wrap(cause error) error {
 	if cause == nil {
 		return nil
 	}
-	return &Err{
-		Code: code,
-		Msg:  cause.Error(),
+	return newErr(code, cause.Error())
+}
+
+var errCategories = map[ErrCode]ErrCategory{
+	ErrCode_UnnamedErr:              ErrCategory_Internal,
+	ErrCode_InternalErr:             ErrCategory_Internal,
+	ErrCode_UnsupportedOp:           ErrCategory_Unsupported,
+	ErrCode_Unimplemented:           ErrCategory_Unsupported,
+	ErrCode_Timeout:                 ErrCategory_Unavailable,
+	ErrCode_ShuttingDown:            ErrCategory_Unavailable,
+	ErrCode_NotConnected:            ErrCategory_Unavailable,
+	ErrCode_AuthFailed:              ErrCategory_Auth,
+	ErrCode_LoginFailed:             ErrCategory_Auth,
+	ErrCode_SessionExpired:          ErrCategory_Auth,
+	ErrCode_NotReady:                ErrCategory_Unavailable,
+	ErrCode_RequestNotFound:         ErrCategory_NotFound,
+	ErrCode_RequestClosed:           ErrCategory_Canceled,
+	ErrCode_BadRequest:              ErrCategory_Invalid,
+	ErrCode_InvalidURI:              ErrCategory_Invalid,
+	ErrCode_BadValue:                ErrCategory_Invalid,
+	ErrCode_InvalidTag:              ErrCategory_Invalid,
+	ErrCode_AttrNotFound:            ErrCategory_NotFound,
+	ErrCode_NothingToCommit:         ErrCategory_Conflict,
+	ErrCode_CommitFailed:            ErrCategory_Internal,
+	ErrCode_SpaceNotFound:           ErrCategory_NotFound,
+	ErrCode_StorageFailure:          ErrCategory_Unavailable,
+	ErrCode_AppNotFound:             ErrCategory_NotFound,
+	ErrCode_MalformedTx:             ErrCategory_Invalid,
+	ErrCode_BadSchema:               ErrCategory_Invalid,
+	ErrCode_DataFailure:             ErrCategory_Internal,
+	ErrCode_ExportErr:               ErrCategory_Internal,
+	ErrCode_PinFailed:               ErrCategory_Invalid,
+	ErrCode_CellNotFound:            ErrCategory_NotFound,
+	ErrCode_ProviderErr:             ErrCategory_Unavailable,
+	ErrCode_ViolatesAppendOnly:      ErrCategory_Conflict,
+	ErrCode_InsufficientPermissions: ErrCategory_Permission,
+	ErrCode_QuotaExceeded:           ErrCategory_Quota,
+}
+
+// Category returns how a client should react to this code.
+func (code ErrCode) Category() ErrCategory {
+	if code == ErrCode_NoErr {
+		return ErrCategory_Unknown
+	}
+	if category, exists := errCategories[code]; exists {
+		return category
 	}
+	return ErrCategory_Internal
 }
 
-func GetErrCode(err error) ErrCode {
+// Retryable returns true if a request failing with this code may succeed if retried unchanged (i.e. the failure is transient).
+func (code ErrCode) Retryable() bool {
+	return code.Category() == ErrCategory_Unavailable
+}
+
+// AsErr returns err as an *Err, classifying errors not made via an ErrCode:
+//   - an *Err within err's chain (see errors.As) is returned, with its Category filled in if unset (e.g. from an older peer)
+//   - context.DeadlineExceeded and context.Canceled are ErrCode_Timeout and ErrCode_RequestClosed
+//   - any other error is ErrCode_UnnamedErr
+//
+// Returns nil if err is nil.
+func AsErr(err error) *Err {
 	if err == nil {
-		return ErrCode_NoErr
+		return nil
 	}
+	var ampErr *Err
+	switch {
+	case errors.As(err, &ampErr):
+		if ampErr.Category == ErrCategory_Unknown && ampErr.Code != ErrCode_NoErr {
+			classified := *ampErr
+			classified.Category = ampErr.Code.Category()
+			classified.Retryable = ampErr.Retryable || ampErr.Code.Retryable()
+			ampErr = &classified
+		}
+		return ampErr
+	case errors.Is(err, context.DeadlineExceeded):
+		return newErr(ErrCode_Timeout, err.Error())
+	case errors.Is(err, context.Canceled):
+		return newErr(ErrCode_RequestClosed, err.Error())
+	default:
+		return newErr(ErrCode_UnnamedErr, err.Error())
+	}
+}
 
-	if artErr, ok := err.(*Err); ok {
-		return artErr.Code
+// IsRetryable returns true if the request that failed with err may succeed if retried unchanged.
+func IsRetryable(err error) bool {
+	ampErr := AsErr(err)
+	return ampErr != nil && ampErr.Retryable
+}
+
+var userMsgs = map[ErrCategory]string{
+	ErrCategory_Unknown:     "Something went wrong.",
+	ErrCategory_Internal:    "Something went wrong.",
+	ErrCategory_Invalid:     "The request was not valid.",
+	ErrCategory_Auth:        "Please sign in again.",
+	ErrCategory_Permission:  "You don't have permission to do that.",
+	ErrCategory_NotFound:    "That item could not be found.",
+	ErrCategory_Unavailable: "The service is temporarily unavailable. Please try again.",
+	ErrCategory_Quota:       "A limit has been reached.",
+	ErrCategory_Unsupported: "That isn't supported.",
+	ErrCategory_Conflict:    "That can't be done right now.",
+	ErrCategory_Canceled:    "The request was canceled.",
+}
+
+// UserMessage returns a message about err suitable for showing to the user: its UserMsg if set, otherwise a generic message for its category.
+// Msg is not shown since it is written for developers and may expose internals.
+func UserMessage(err error) string {
+	ampErr := AsErr(err)
+	if ampErr == nil {
+		return ""
+	}
+	if ampErr.UserMsg != "" {
+		return ampErr.UserMsg
 	}
+	return userMsgs[ampErr.Category]
+}
 
-	return ErrCode_UnnamedErr
+// GetErrCode returns the ErrCode of err -- see AsErr().
+func GetErrCode(err error) ErrCode {
+	if err == nil {
+		return ErrCode_NoErr
+	}
+	return AsErr(err).Code
 }
@@ -0,0 +1,441 @@
+// Package gateway implements a HostService exposing pin and mutate operations over HTTP and JSON, so web apps and scripts can use a host without a TxMsg client library.
+//
+// Each HTTP request opens a short-lived Session over an in-process Transport, signed in with the API key presented as a bearer token (see amp.APIKeys):
+//
+//	GET  /v1/pin/{url}                 pins {url} and returns its state as JSON once synced
+//	GET  /v1/pin/{url}?wait=30s        long-polls: after the state is synced, returns the next update (or 204 if none arrives in time)
+//	GET  /v1/pin/{url}                 with "Accept: text/event-stream", streams the state and each update as server-sent events
+//	POST /v1/pin/{url}                 commits the JSON ops in the body to the pinned cell and returns the resulting state
+//
+// A tx is presented as:
+//
+//	{"status": "Synced", "ops": [{"op": "upsert", "cell": "{base32}", "attr": "{base32}", "item": "{base32}", "value": {...}}]}
+//
+// where each value is the JSON form of the attr's registered type (or base64 if the attr's type is not registered).
+//
+// Service.GraphQLHandler serves the same pins as a GraphQL schema generated from the registered attrs,
+// and Service.OpenAPIHandler serves an OpenAPI 3 document describing the above, generated from the registered apps and attrs.
+package gateway
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/log"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// Opts specifies a gateway Service.
+type Opts struct {
+	PathPrefix string        // if empty, "/v1/pin/"
+	MaxWait    time.Duration // longest long-poll allowed; if <= 0, 60s
+	SyncWait   time.Duration // longest wait for a pin's state to sync; if <= 0, 30s
+}
+
+// Service is an amp.HostService and http.Handler.
+type Service struct {
+	task.Context
+	opts Opts
+	host amp.Host
+	wg   sync.WaitGroup // in-flight HTTP requests
+}
+
+var (
+	_ amp.HostService = (*Service)(nil)
+	_ http.Handler    = (*Service)(nil)
+)
+
+func NewService(opts Opts) *Service {
+	if opts.PathPrefix == "" {
+		opts.PathPrefix = "/v1/pin/"
+	}
+	if opts.MaxWait <= 0 {
+		opts.MaxWait = 60 * time.Second
+	}
+	if opts.SyncWait <= 0 {
+		opts.SyncWait = 30 * time.Second
+	}
+	return &Service{
+		opts: opts,
+	}
+}
+
+func (svc *Service) StartService(on amp.Host) error {
+	ctx, err := on.StartChild(&task.Task{
+		Info: task.Info{
+			Label:     "gateway",
+			LogFields: log.Fields{log.FieldSubsystem: amp.SubsystemTransport},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	svc.Context = ctx
+	svc.host = on
+	return nil
+}
+
+// GracefulStop blocks until in-flight HTTP requests complete.
+func (svc *Service) GracefulStop() {
+	svc.wg.Wait()
+}
+
+// Op is the JSON form of an amp.TxOp.
+type Op struct {
+	Op    string          `json:"op"` // "upsert", "delete", "defer", or "ephemeral"
+	Cell  string          `json:"cell"`
+	Attr  string          `json:"attr"`
+	Item  string          `json:"item,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// Tx is the JSON form of an amp.TxMsg.
+type Tx struct {
+	Status string `json:"status,omitempty"`
+	Ops    []Op   `json:"ops"`
+}
+
+var opNames = map[amp.TxOpCode]string{
+	amp.TxOpCode_UpsertElement:    "upsert",
+	amp.TxOpCode_DeleteElement:    "delete",
+	amp.TxOpCode_DeferElement:     "defer",
+	amp.TxOpCode_EphemeralElement: "ephemeral",
+}
+
+func (svc *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if svc.host == nil {
+		http.Error(w, "gateway not started", http.StatusServiceUnavailable)
+		return
+	}
+	svc.wg.Add(1)
+	defer svc.wg.Done()
+
+	target, found := strings.CutPrefix(r.URL.Path, svc.opts.PathPrefix)
+	if !found || target == "" {
+		http.NotFound(w, r)
+		return
+	}
+	if r.URL.RawQuery != "" {
+		target += "?" + r.URL.RawQuery
+	}
+
+	var wait time.Duration
+	if str := r.URL.Query().Get("wait"); str != "" {
+		var err error
+		if wait, err = time.ParseDuration(str); err != nil || wait <= 0 {
+			http.Error(w, "bad wait duration", http.StatusBadRequest)
+			return
+		}
+		wait = min(wait, svc.opts.MaxWait)
+	}
+	streaming := strings.Contains(r.Header.Get("Accept"), "text/event-stream")
+
+	var body *Tx
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPost:
+		body = &Tx{}
+		if err := json.NewDecoder(io.LimitReader(r.Body, 8<<20)).Decode(body); err != nil {
+			http.Error(w, "bad JSON body: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// a commit needs the pin to remain open until merged
+	pin, err := svc.startPin(r.Context(), bearerLogin(r), target, nil, wait > 0 || streaming || body != nil)
+	if err != nil {
+		writeErr(w, err)
+		return
+	}
+	defer pin.close()
+
+	if body != nil {
+		if err = pin.commit(body); err != nil {
+			writeErr(w, err)
+			return
+		}
+	}
+
+	syncCtx, cancel := context.WithTimeout(r.Context(), svc.opts.SyncWait)
+	state, err := pin.awaitSynced(syncCtx)
+	cancel()
+	if err != nil {
+		writeErr(w, err)
+		return
+	}
+
+	switch {
+	case streaming:
+		pin.stream(r.Context(), w, state)
+	case wait > 0:
+		waitCtx, cancel := context.WithTimeout(r.Context(), wait)
+		update, err := pin.next(waitCtx)
+		cancel()
+		if err == context.DeadlineExceeded {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if err != nil {
+			writeErr(w, err)
+			return
+		}
+		writeJSON(w, update)
+	default:
+		writeJSON(w, state)
+	}
+}
+
+// bearerLogin returns a Login presenting the request's bearer token as an API key.
+func bearerLogin(r *http.Request) *amp.Login {
+	login := &amp.Login{}
+	if token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); found {
+		login.APIKey = token
+	}
+	return login
+}
+
+func writeJSON(w http.ResponseWriter, tx *Tx) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(tx)
+}
+
+func writeErr(w http.ResponseWriter, err error) {
+	ampErr := amp.AsErr(err)
+	status := http.StatusBadGateway
+	switch ampErr.Category {
+	case amp.ErrCategory_Auth:
+		status = http.StatusUnauthorized
+	case amp.ErrCategory_Permission:
+		status = http.StatusForbidden
+	case amp.ErrCategory_NotFound:
+		status = http.StatusNotFound
+	case amp.ErrCategory_Invalid:
+		status = http.StatusBadRequest
+	case amp.ErrCategory_Quota:
+		status = http.StatusTooManyRequests
+	case amp.ErrCategory_Conflict:
+		status = http.StatusConflict
+	case amp.ErrCategory_Unsupported:
+		status = http.StatusNotImplemented
+	}
+	switch ampErr.Code {
+	case amp.ErrCode_Timeout:
+		status = http.StatusGatewayTimeout
+	case amp.ErrCode_ShuttingDown, amp.ErrCode_NotReady:
+		status = http.StatusServiceUnavailable
+	}
+	if delay := ampErr.RetryAfter(); delay > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int((delay+time.Second-1)/time.Second)))
+	}
+	http.Error(w, err.Error(), status)
+}
+
+// gatewayPin is a single pin request made on behalf of an HTTP request over its own Session.
+type gatewayPin struct {
+	sess  amp.Session
+	via   *transport
+	reqID tag.ID
+}
+
+// startPin pins the given target, limited to the given attrs (or all attrs if none).
+func (svc *Service) startPin(ctx context.Context, login *amp.Login, target string, pinAttrs []*amp.Tag, maintain bool) (*gatewayPin, error) {
+	via := newTransport("gateway " + target)
+	sess, err := svc.host.StartNewSession(svc, via)
+	if err != nil {
+		return nil, err
+	}
+	pin := &gatewayPin{
+		sess:  sess,
+		via:   via,
+		reqID: tag.Now(),
+	}
+
+	// STEP 1: sign in
+	loginTx, err := amp.MarshalAttr(amp.MetaNodeID, login.TagSpec().ID, login)
+	if err == nil {
+		err = via.toHost(ctx, loginTx)
+	}
+
+	// pin the target
+	if err == nil {
+		req := &amp.PinRequest{
+			PinTarget: &amp.Tag{URL: target},
+			PinAttrs:  pinAttrs,
+			StateSync: amp.StateSync_CloseOnSync,
+		}
+		if maintain {
+			req.StateSync = amp.StateSync_Maintain
+		}
+		var reqTx *amp.TxMsg
+		if reqTx, err = amp.MarshalAttr(amp.MetaNodeID, req.TagSpec().ID, req); err == nil {
+			reqTx.SetContextID(pin.reqID)
+			err = via.toHost(ctx, reqTx)
+		}
+	}
+	if err != nil {
+		pin.close()
+		return nil, err
+	}
+	return pin, nil
+}
+
+func (pin *gatewayPin) close() {
+	pin.via.Close()
+	pin.sess.Close()
+}
+
+// commit sends the given ops to be merged into the pinned cell.
+func (pin *gatewayPin) commit(body *Tx) error {
+	tx := amp.NewTxMsg(true)
+	tx.SetContextID(pin.reqID)
+	for _, oi := range body.Ops {
+		op := amp.TxOp{}
+		op.OpCode = amp.TxOpCode_UpsertElement
+		if oi.Op == "delete" {
+			op.OpCode = amp.TxOpCode_DeleteElement
+		}
+		var err error
+		if op.CellID, err = tag.FromBase32(oi.Cell); err != nil {
+			return amp.ErrCode_BadRequest.Errorf("bad cell ID %q", oi.Cell)
+		}
+		if op.AttrID, err = tag.FromBase32(oi.Attr); err != nil {
+			return amp.ErrCode_BadRequest.Errorf("bad attr ID %q", oi.Attr)
+		}
+		if oi.Item != "" {
+			if op.ItemID, err = tag.FromBase32(oi.Item); err != nil {
+				return amp.ErrCode_BadRequest.Errorf("bad item ID %q", oi.Item)
+			}
+		}
+		op.EditID = tag.Genesis(tx.GenesisID())
+
+		var val tag.Value
+		if op.OpCode == amp.TxOpCode_UpsertElement && len(oi.Value) > 0 {
+			if val, err = pin.sess.MakeValue(op.AttrID); err != nil {
+				return err
+			}
+			if err = json.Unmarshal(oi.Value, val); err != nil {
+				return amp.ErrCode_BadValue.Errorf("bad value for attr %s: %v", oi.Attr, err)
+			}
+		}
+		if err = tx.MarshalOp(&op, val); err != nil {
+			return err
+		}
+	}
+	return pin.via.toHost(context.Background(), tx)
+}
+
+// awaitSynced returns the pin's state, accumulated until the host signals OpStatus_Synced.
+func (pin *gatewayPin) awaitSynced(ctx context.Context) (*Tx, error) {
+	state := &Tx{}
+	for {
+		tx, err := pin.next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		state.Ops = append(state.Ops, tx.Ops...)
+		if tx.Status == amp.OpStatus_Synced.String() {
+			state.Status = tx.Status
+			return state, nil
+		}
+	}
+}
+
+// next returns the next tx sent by the host for this pin, or the error the host reported.
+func (pin *gatewayPin) next(ctx context.Context) (*Tx, error) {
+	for {
+		tx, err := pin.via.fromHost(ctx)
+		if err != nil {
+			return nil, err
+		}
+		contextID := tx.ContextID()
+		if contextID.IsSet() && contextID != pin.reqID {
+			tx.ReleaseRef()
+			continue // e.g. a reply to the login
+		}
+		if len(tx.Ops) == 1 && tx.Ops[0].CellID == amp.MetaNodeID && tx.Ops[0].AttrID == (&amp.Err{}).TagSpec().ID {
+			reported := &amp.Err{}
+			err = tx.UnmarshalOpValue(0, reported)
+			tx.ReleaseRef()
+			if err != nil {
+				return nil, err
+			}
+			return nil, reported
+		}
+		if tx.Status == amp.OpStatus_Closed {
+			tx.ReleaseRef()
+			return nil, amp.ErrRequestClosed
+		}
+		out := pin.toJSON(tx)
+		tx.ReleaseRef()
+		return out, nil
+	}
+}
+
+func (pin *gatewayPin) toJSON(tx *amp.TxMsg) *Tx {
+	out := &Tx{
+		Status: tx.Status.String(),
+		Ops:    make([]Op, 0, len(tx.Ops)),
+	}
+	for i, op := range tx.Ops {
+		oi := Op{
+			Op:   opNames[op.OpCode],
+			Cell: op.CellID.Base32(),
+			Attr: op.AttrID.Base32(),
+		}
+		if op.ItemID.IsSet() {
+			oi.Item = op.ItemID.Base32()
+		}
+		if op.DataLen > 0 {
+			oi.Value = pin.valueJSON(tx, i)
+		}
+		out.Ops = append(out.Ops, oi)
+	}
+	return out
+}
+
+func (pin *gatewayPin) valueJSON(tx *amp.TxMsg, idx int) json.RawMessage {
+	if val, err := pin.sess.MakeValue(tx.Ops[idx].AttrID); err == nil {
+		if err = tx.UnmarshalOpValue(idx, val); err == nil {
+			if buf, err := json.Marshal(val); err == nil {
+				return buf
+			}
+		}
+	}
+	op := tx.Ops[idx]
+	buf, _ := json.Marshal(base64.StdEncoding.EncodeToString(tx.DataStore[op.DataOfs : op.DataOfs+op.DataLen]))
+	return buf
+}
+
+// stream writes the given state and then each update as server-sent events until the client disconnects or the pin closes.
+func (pin *gatewayPin) stream(ctx context.Context, w http.ResponseWriter, state *Tx) {
+	flusher, _ := w.(http.Flusher)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+
+	for tx := state; ; {
+		buf, _ := json.Marshal(tx)
+		if _, err := io.WriteString(w, "data: "+string(buf)+"\n\n"); err != nil {
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		var err error
+		if tx, err = pin.next(ctx); err != nil {
+			return
+		}
+	}
+}
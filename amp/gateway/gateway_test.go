@@ -0,0 +1,273 @@
+package gateway
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// echoHost serves each pin with a single label element and echoes back any committed tx.
+// Only the methods used by the gateway are implemented.
+type echoHost struct {
+	amp.Host
+	ctx task.Context
+	reg amp.Registry
+}
+
+type echoSession struct {
+	amp.Session
+	ctx task.Context
+	reg amp.Registry
+}
+
+func (host *echoHost) StartChild(task *task.Task) (task.Context, error) {
+	return host.ctx.StartChild(task)
+}
+
+func (host *echoHost) StartNewSession(parent amp.HostService, via amp.Transport) (amp.Session, error) {
+	ctx, err := host.ctx.StartChild(&task.Task{
+		Info: task.Info{Label: via.Label()},
+		OnRun: func(ctx task.Context) {
+			var login amp.Login
+			var reqID tag.ID
+			for {
+				tx, err := via.RecvTx()
+				if err != nil {
+					return
+				}
+				val, _ := tx.CheckMetaAttr(host.reg)
+				switch msg := val.(type) {
+				case *amp.Login:
+					login = *msg
+				case *amp.PinRequest:
+					if login.APIKey != "secret" {
+						reply, _ := amp.MarshalAttr(amp.MetaNodeID, (&amp.Err{}).TagSpec().ID, amp.ErrorToValue(amp.ErrAccessDenied))
+						reply.SetContextID(tx.ContextID())
+						via.SendTx(reply)
+						continue
+					}
+					reqID = tx.ContextID()
+					state, _ := amp.MarshalAttr(tag.ID{0, 0, 7}, (&amp.Tag{}).TagSpec().ID, &amp.Tag{Text: msg.PinTarget.URL})
+					state.SetContextID(reqID)
+					state.Status = amp.OpStatus_Synced
+					via.SendTx(state)
+				default:
+					tx.Status = amp.OpStatus_Synced
+					via.SendTx(tx)
+				}
+			}
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &echoSession{ctx: ctx, reg: host.reg}, nil
+}
+
+func (host *echoHost) HostRegistry() amp.Registry {
+	return host.reg
+}
+
+func (sess *echoSession) Close() error {
+	return sess.ctx.Close()
+}
+
+func (sess *echoSession) MakeValue(attrSpec tag.ID) (tag.Value, error) {
+	return sess.reg.MakeValue(attrSpec)
+}
+
+func TestGateway(t *testing.T) {
+	root, _ := task.Start(&task.Task{})
+	defer root.Close()
+
+	reg := amp.NewRegistry()
+	amp.RegisterBuiltinTypes(reg)
+	svc := NewService(Opts{})
+	if err := svc.StartService(&echoHost{ctx: root, reg: reg}); err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(svc)
+	defer server.Close()
+
+	get := func(key string) (*http.Response, *Tx) {
+		req, _ := http.NewRequest(http.MethodGet, server.URL+"/v1/pin/badges:", nil)
+		req.Header.Set("Authorization", "Bearer "+key)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		tx := &Tx{}
+		json.NewDecoder(resp.Body).Decode(tx)
+		return resp, tx
+	}
+
+	if resp, _ := get("wrong"); resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected 403, got %d", resp.StatusCode)
+	}
+	resp, state := get("secret")
+	if resp.StatusCode != http.StatusOK || state.Status != "OpStatus_Synced" || len(state.Ops) != 1 || !strings.Contains(string(state.Ops[0].Value), `"Text":"badges:"`) {
+		t.Fatalf("unexpected snapshot (%d): %+v", resp.StatusCode, state)
+	}
+
+	body := `{"ops": [{"op": "upsert", "cell": "` + tag.ID{0, 0, 9}.Base32() + `", "attr": "` + (&amp.Tag{}).TagSpec().ID.Base32() + `", "value": {"Text": "hello"}}]}`
+	req, _ := http.NewRequest(http.MethodPost, server.URL+"/v1/pin/badges:", strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer secret")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	json.NewDecoder(resp.Body).Decode(state)
+	if resp.StatusCode != http.StatusOK || len(state.Ops) != 1 {
+		t.Fatalf("unexpected commit response (%d): %+v", resp.StatusCode, state)
+	}
+}
+
+func TestGraphQL(t *testing.T) {
+	root, _ := task.Start(&task.Task{})
+	defer root.Close()
+
+	reg := amp.NewRegistry()
+	amp.RegisterBuiltinTypes(reg)
+	svc := NewService(Opts{})
+	if err := svc.StartService(&echoHost{ctx: root, reg: reg}); err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(svc.GraphQLHandler())
+	defer server.Close()
+
+	resp, err := http.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sdl, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !strings.Contains(string(sdl), "Tag: [TagElement!]!") || !strings.Contains(string(sdl), "type Tag {") {
+		t.Fatalf("schema missing Tag attr:\n%s", sdl)
+	}
+
+	post := func(query string, accept string) *http.Response {
+		body, _ := json.Marshal(map[string]any{
+			"query":     query,
+			"variables": map[string]any{"url": "badges:"},
+		})
+		req, _ := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(string(body)))
+		req.Header.Set("Authorization", "Bearer secret")
+		req.Header.Set("Accept", accept)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+
+	query := `query Badges($url: String!) { pin(url: $url) { status cells { id tags: Tag { value { Text } } } } }`
+	resp = post(query, "application/json")
+	result, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	expect := `{"data":{"pin":{"cells":[{"id":"` + tag.ID{0, 0, 7}.Base32() + `","tags":[{"value":{"Text":"badges:"}}]}],"status":"Synced"}}}`
+	if strings.TrimSpace(string(result)) != expect {
+		t.Fatalf("unexpected query result:\n%s", result)
+	}
+
+	resp = post(`{ pin(url: $url) { cells { Nope } } }`, "application/json")
+	result, _ = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !strings.Contains(string(result), `"errors"`) {
+		t.Fatalf("expected an error for an unknown field:\n%s", result)
+	}
+
+	resp = post(`subscription { pin(url: $url) { cells { Tag { value { Text } } } } }`, "text/event-stream")
+	defer resp.Body.Close()
+	event, err := bufio.NewReader(resp.Body).ReadString('\n')
+	if err != nil || !strings.HasPrefix(event, "data: ") || !strings.Contains(event, `"Text":"badges:"`) {
+		t.Fatalf("unexpected subscription event %q (%v)", event, err)
+	}
+}
+
+func TestGraphQLDepth(t *testing.T) {
+	nested := func(open, close string, depth int) string {
+		return strings.Repeat(open, depth) + strings.Repeat(close, depth)
+	}
+	if _, err := parseGraphQL(nested("{ a ", "}", maxGQLDepth), nil); err != nil {
+		t.Fatalf("expected max depth to parse, got %v", err)
+	}
+	for _, query := range []string{
+		nested("{ a ", "}", maxGQLDepth+1),
+		nested("{ a ", "}", 500000),
+		"{ a(x: " + nested("[", "]", 500000) + ") }",
+	} {
+		if _, err := parseGraphQL(query, nil); err == nil || !strings.Contains(err.Error(), "max depth") {
+			t.Fatalf("expected deep query to be rejected, got %v", err)
+		}
+	}
+}
+
+func TestOpenAPI(t *testing.T) {
+	root, _ := task.Start(&task.Task{})
+	defer root.Close()
+
+	reg := amp.NewRegistry()
+	amp.RegisterBuiltinTypes(reg)
+	reg.RegisterApp(&amp.App{
+		AppSpec:     amp.AppSpec.With("test.notes"),
+		Desc:        "notes",
+		Invocations: []string{"notes"},
+	})
+	svc := NewService(Opts{})
+	if err := svc.StartService(&echoHost{ctx: root, reg: reg}); err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(svc.OpenAPIHandler())
+	defer server.Close()
+
+	resp, err := http.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var doc struct {
+		OpenAPI string `json:"openapi"`
+		Paths   map[string]struct {
+			Parameters []struct {
+				Examples map[string]struct{ Value string } `json:"examples"`
+			} `json:"parameters"`
+		} `json:"paths"`
+		Components struct {
+			Schemas map[string]struct {
+				Properties map[string]map[string]any `json:"properties"`
+			} `json:"schemas"`
+		} `json:"components"`
+		Attrs map[string]struct {
+			Canonic string         `json:"canonic"`
+			Schema  map[string]any `json:"schema"`
+		} `json:"x-amp-attrs"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		t.Fatal(err)
+	}
+
+	pinPath, exists := doc.Paths["/v1/pin/{url}"]
+	if doc.OpenAPI != "3.0.3" || !exists || pinPath.Parameters[0].Examples["notes"].Value != "notes:" {
+		t.Fatalf("unexpected paths: %+v", doc.Paths)
+	}
+	tagAttr := doc.Attrs[(&amp.Tag{}).TagSpec().ID.Base32()]
+	if tagAttr.Canonic != "amp.attr.Tag" || tagAttr.Schema["$ref"] != "#/components/schemas/Tag" {
+		t.Fatalf("unexpected Tag attr: %+v", tagAttr)
+	}
+	if text := doc.Components.Schemas["Tag"].Properties["Text"]; text["type"] != "string" {
+		t.Fatalf("unexpected Tag schema: %+v", doc.Components.Schemas["Tag"])
+	}
+	if _, exists = doc.Components.Schemas["Tx"].Properties["ops"]; !exists {
+		t.Fatal("missing Tx schema")
+	}
+}
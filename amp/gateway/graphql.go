@@ -0,0 +1,674 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+// GraphQLHandler returns an http.Handler serving a GraphQL schema generated from the host's registered attrs:
+//
+//	POST {"query": "...", "variables": {...}}   executes a query, or for a subscription, streams each update as a server-sent event
+//	GET  ?query=...                             same as POST, without variables (e.g. for an EventSource)
+//	GET                                        returns the schema (SDL)
+//
+// A query pins each root field's url and resolves once synced, where attr fields selected on a Cell become the pin's PinAttrs:
+//
+//	query { pin(url: "badges:") { status cells { id Tag { item value { Text } } } } }
+//
+// A subscription pins a single url and sends the synced state and then each update (as a delta) until the client disconnects.
+// Mutations are not offered; commit ops via the pin endpoint instead.
+func (svc *Service) GraphQLHandler() http.Handler {
+	return http.HandlerFunc(svc.serveGraphQL)
+}
+
+// gqlKind classifies a gqlType.
+type gqlKind int
+
+const (
+	gqlScalar gqlKind = iota
+	gqlObject
+	gqlList
+)
+
+// gqlType is a GraphQL output type: a scalar, an object of named fields, or a list.
+type gqlType struct {
+	Name    string
+	Kind    gqlKind
+	NonNull bool
+	Elem    *gqlType            // if gqlList
+	Fields  map[string]*gqlType // if gqlObject
+	Order   []string            // field names in declaration order
+	Args    map[string]string   // field name => SDL argument list
+}
+
+func (t *gqlType) String() string {
+	str := t.Name
+	if t.Kind == gqlList {
+		str = "[" + t.Elem.String() + "]"
+	}
+	if t.NonNull {
+		str += "!"
+	}
+	return str
+}
+
+func (t *gqlType) addField(name string, fieldType *gqlType, args string) {
+	if t.Fields == nil {
+		t.Fields = make(map[string]*gqlType)
+	}
+	if _, exists := t.Fields[name]; !exists {
+		t.Order = append(t.Order, name)
+	}
+	t.Fields[name] = fieldType
+	if args != "" {
+		if t.Args == nil {
+			t.Args = make(map[string]string)
+		}
+		t.Args[name] = args
+	}
+}
+
+var (
+	gqlString  = &gqlType{Name: "String"}
+	gqlInt     = &gqlType{Name: "Int"}
+	gqlFloat   = &gqlType{Name: "Float"}
+	gqlBoolean = &gqlType{Name: "Boolean"}
+	gqlID      = &gqlType{Name: "ID"}
+	gqlJSON    = &gqlType{Name: "JSON"} // custom scalar passing through a value's JSON form
+)
+
+func nonNull(t *gqlType) *gqlType {
+	dup := *t
+	dup.NonNull = true
+	return &dup
+}
+
+func listOf(t *gqlType) *gqlType {
+	return &gqlType{Kind: gqlList, Elem: t}
+}
+
+// gqlSchema is generated from a Registry's attr definitions.
+type gqlSchema struct {
+	Query        *gqlType
+	Subscription *gqlType
+	Pin          *gqlType
+	Cell         *gqlType
+	Element      *gqlType
+	attrs        map[string]tag.ID // Cell field name => attr ID
+	types        map[string]*gqlType
+	typeOrder    []string
+	goTypes      map[reflect.Type]*gqlType
+}
+
+func newSchema(reg amp.Registry) *gqlSchema {
+	schema := &gqlSchema{
+		attrs:   make(map[string]tag.ID),
+		types:   make(map[string]*gqlType),
+		goTypes: make(map[reflect.Type]*gqlType),
+	}
+
+	schema.Element = schema.newObject("Element")
+	schema.Element.addField("op", gqlString, "")
+	schema.Element.addField("attr", nonNull(gqlID), "")
+	schema.Element.addField("item", gqlID, "")
+	schema.Element.addField("value", gqlJSON, "")
+
+	schema.Cell = schema.newObject("Cell")
+	schema.Cell.addField("id", nonNull(gqlID), "")
+	schema.Cell.addField("elements", nonNull(listOf(nonNull(schema.Element))), "attr: ID")
+
+	defs := reg.ListAttrs()
+	sort.Slice(defs, func(i, j int) bool {
+		return defs[i].Canonic < defs[j].Canonic
+	})
+	for _, def := range defs {
+		name := schema.attrFieldName(def.Canonic)
+		schema.attrs[name] = def.ID
+
+		elem := schema.newObject(name + "Element")
+		elem.addField("op", gqlString, "")
+		elem.addField("item", gqlID, "")
+		elem.addField("value", schema.goType(reflect.TypeOf(def.Prototype)), "")
+		schema.Cell.addField(name, nonNull(listOf(nonNull(elem))), "")
+	}
+
+	schema.Pin = schema.newObject("Pin")
+	schema.Pin.addField("status", gqlString, "")
+	schema.Pin.addField("cells", nonNull(listOf(nonNull(schema.Cell))), "")
+	schema.Pin.addField("cell", schema.Cell, "id: ID!")
+
+	schema.Query = schema.newObject("Query")
+	schema.Query.addField("pin", schema.Pin, "url: String!")
+	schema.Subscription = schema.newObject("Subscription")
+	schema.Subscription.addField("pin", schema.Pin, "url: String!")
+	return schema
+}
+
+func (schema *gqlSchema) newObject(name string) *gqlType {
+	for i := 2; schema.types[name] != nil; i++ {
+		name = fmt.Sprintf("%s%d", strings.TrimRight(name, "0123456789"), i)
+	}
+	t := &gqlType{
+		Name: name,
+		Kind: gqlObject,
+	}
+	schema.types[name] = t
+	schema.typeOrder = append(schema.typeOrder, name)
+	return t
+}
+
+// attrFieldName returns a unique GraphQL name for an attr, using as few trailing canonic components as possible.
+// e.g. "amp.attr.Tag" => "Tag", "amp.attr.LoginCheckpoint.oauth-grant" => "LoginCheckpoint_oauth_grant"
+func (schema *gqlSchema) attrFieldName(canonic string) string {
+	parts := strings.Split(canonic, ".")
+	if len(parts) > 2 {
+		parts = parts[2:] // drop the context, e.g. "amp.attr"
+	}
+	name := ""
+	for i := len(parts) - 1; i >= 0; i-- {
+		name = sanitizeName(strings.Join(parts[i:], "_"))
+		if _, taken := schema.attrs[name]; !taken && !isCellBuiltin(name) {
+			return name
+		}
+	}
+	for i := 2; ; i++ {
+		if suffixed := fmt.Sprintf("%s_%d", name, i); schema.attrs[suffixed].IsNil() {
+			return suffixed
+		}
+	}
+}
+
+func isCellBuiltin(name string) bool {
+	return name == "id" || name == "elements" || name == "__typename"
+}
+
+func sanitizeName(str string) string {
+	var b strings.Builder
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		if !isNameChar(c) {
+			c = '_'
+		}
+		if i == 0 && c >= '0' && c <= '9' {
+			b.WriteByte('_')
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+// goType returns the GraphQL type of a Go value's JSON form.
+func (schema *gqlSchema) goType(typ reflect.Type) *gqlType {
+	if typ == nil {
+		return gqlJSON
+	}
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	switch typ.Kind() {
+	case reflect.String:
+		return gqlString
+	case reflect.Bool:
+		return gqlBoolean
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return gqlInt
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		return gqlFloat // GraphQL Int is 32-bit
+	case reflect.Slice, reflect.Array:
+		if typ.Elem().Kind() == reflect.Uint8 {
+			return gqlString // base64
+		}
+		return listOf(schema.goType(typ.Elem()))
+	case reflect.Struct:
+		if t := schema.goTypes[typ]; t != nil {
+			return t
+		}
+		obj := schema.newObject(sanitizeName(typ.Name()))
+		schema.goTypes[typ] = obj
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			name, ok := jsonFieldName(field)
+			if ok {
+				obj.addField(name, schema.goType(field.Type), "")
+			}
+		}
+		if len(obj.Fields) == 0 {
+			obj.addField("_", gqlBoolean, "") // GraphQL objects require at least one field
+		}
+		return obj
+	default:
+		return gqlJSON // maps, interfaces
+	}
+}
+
+// jsonFieldName returns the name encoding/json uses for a struct field, or false if it is omitted.
+func jsonFieldName(field reflect.StructField) (string, bool) {
+	if !field.IsExported() || field.Anonymous || strings.HasPrefix(field.Name, "XXX_") {
+		return "", false
+	}
+	name := field.Name
+	if jsonTag := field.Tag.Get("json"); jsonTag != "" {
+		if jsonTag == "-" {
+			return "", false
+		}
+		if tagName, _, _ := strings.Cut(jsonTag, ","); tagName != "" {
+			name = tagName
+		}
+	}
+	if sanitizeName(name) != name {
+		return "", false
+	}
+	return name, true
+}
+
+// SDL returns the schema in GraphQL schema definition language.
+func (schema *gqlSchema) SDL() string {
+	var b strings.Builder
+	b.WriteString("scalar JSON\n\nschema {\n  query: Query\n  subscription: Subscription\n}\n")
+
+	names := append([]string(nil), schema.typeOrder...)
+	sort.Strings(names)
+	for _, name := range names {
+		t := schema.types[name]
+		fmt.Fprintf(&b, "\ntype %s {\n", name)
+		for _, fieldName := range t.Order {
+			args := ""
+			if t.Args[fieldName] != "" {
+				args = "(" + t.Args[fieldName] + ")"
+			}
+			fmt.Fprintf(&b, "  %s%s: %s\n", fieldName, args, t.Fields[fieldName])
+		}
+		b.WriteString("}\n")
+	}
+	return b.String()
+}
+
+// gqlRequest is a GraphQL-over-HTTP request body.
+type gqlRequest struct {
+	Query         string         `json:"query"`
+	Variables     map[string]any `json:"variables"`
+	OperationName string         `json:"operationName"`
+}
+
+type gqlError struct {
+	Message string `json:"message"`
+	Path    []any  `json:"path,omitempty"`
+}
+
+type gqlResponse struct {
+	Data   map[string]any `json:"data"`
+	Errors []gqlError     `json:"errors,omitempty"`
+}
+
+func (svc *Service) serveGraphQL(w http.ResponseWriter, r *http.Request) {
+	if svc.host == nil {
+		http.Error(w, "gateway not started", http.StatusServiceUnavailable)
+		return
+	}
+	svc.wg.Add(1)
+	defer svc.wg.Done()
+
+	schema := newSchema(svc.host.HostRegistry())
+
+	var req gqlRequest
+	switch r.Method {
+	case http.MethodGet:
+		if req.Query = r.URL.Query().Get("query"); req.Query == "" {
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			io.WriteString(w, schema.SDL())
+			return
+		}
+	case http.MethodPost:
+		if err := json.NewDecoder(io.LimitReader(r.Body, 1<<20)).Decode(&req); err != nil {
+			http.Error(w, "bad JSON body: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	op, err := parseGraphQL(req.Query, req.Variables)
+	if err != nil {
+		writeGraphQL(w, &gqlResponse{Errors: []gqlError{{Message: err.Error()}}})
+		return
+	}
+
+	login := bearerLogin(r)
+	if op.Kind == "subscription" {
+		svc.subscribe(r.Context(), w, schema, login, op)
+		return
+	}
+
+	resp := &gqlResponse{
+		Data: make(map[string]any),
+	}
+	for _, field := range op.Fields {
+		val, _, err := svc.resolveRoot(r.Context(), schema, schema.Query, login, field, false)
+		resp.Data[field.Alias] = val
+		if err != nil {
+			resp.Errors = append(resp.Errors, gqlError{Message: err.Error(), Path: []any{field.Alias}})
+		}
+	}
+	writeGraphQL(w, resp)
+}
+
+func writeGraphQL(w http.ResponseWriter, resp *gqlResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// resolveRoot pins the url given to a root field and resolves its selection from the synced state.
+// If keepOpen is set, the pin is returned open (for the caller to close) so its updates can be received.
+func (svc *Service) resolveRoot(ctx context.Context, schema *gqlSchema, root *gqlType, login *amp.Login, field *gqlField, keepOpen bool) (any, *gatewayPin, error) {
+	switch field.Name {
+	case "__typename":
+		return root.Name, nil, nil
+	case "pin":
+	default:
+		return nil, nil, fmt.Errorf("%s has no field %q", root.Name, field.Name)
+	}
+	url, _ := field.Args["url"].(string)
+	if url == "" {
+		return nil, nil, fmt.Errorf("pin requires a url")
+	}
+
+	pin, err := svc.startPin(ctx, login, url, schema.pinAttrs(field), keepOpen)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	syncCtx, cancel := context.WithTimeout(ctx, svc.opts.SyncWait)
+	state, err := pin.awaitSynced(syncCtx)
+	cancel()
+	var val any
+	if err == nil {
+		val, err = schema.resolvePin(state, field.Fields)
+	}
+	if err != nil || !keepOpen {
+		pin.close()
+		pin = nil
+	}
+	return val, pin, err
+}
+
+// subscribe resolves a subscription's single root field against the pin's state and then each update, sending each result as a server-sent event.
+func (svc *Service) subscribe(ctx context.Context, w http.ResponseWriter, schema *gqlSchema, login *amp.Login, op *gqlOp) {
+	if len(op.Fields) != 1 {
+		writeGraphQL(w, &gqlResponse{Errors: []gqlError{{Message: "a subscription must select exactly one root field"}}})
+		return
+	}
+	field := op.Fields[0]
+
+	val, pin, err := svc.resolveRoot(ctx, schema, schema.Subscription, login, field, true)
+	if pin == nil {
+		resp := &gqlResponse{Data: map[string]any{field.Alias: val}}
+		if err != nil {
+			resp.Errors = []gqlError{{Message: err.Error(), Path: []any{field.Alias}}}
+		}
+		writeGraphQL(w, resp)
+		return
+	}
+	defer pin.close()
+
+	flusher, _ := w.(http.Flusher)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+
+	for {
+		buf, _ := json.Marshal(&gqlResponse{Data: map[string]any{field.Alias: val}})
+		if _, err := io.WriteString(w, "data: "+string(buf)+"\n\n"); err != nil {
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		update, err := pin.next(ctx)
+		if err != nil {
+			return
+		}
+		if val, err = schema.resolvePin(update, field.Fields); err != nil {
+			return
+		}
+	}
+}
+
+// pinAttrs returns the attrs selected on cells of a pin field, or nil if all attrs are needed.
+func (schema *gqlSchema) pinAttrs(pinField *gqlField) []*amp.Tag {
+	var attrs []*amp.Tag
+	for _, field := range pinField.Fields {
+		if field.Name != "cells" && field.Name != "cell" {
+			continue
+		}
+		for _, sel := range field.Fields {
+			if sel.Name == "elements" {
+				return nil
+			}
+			if attrID, exists := schema.attrs[sel.Name]; exists {
+				attr := &amp.Tag{}
+				attr.SetID(attrID)
+				attrs = append(attrs, attr)
+			}
+		}
+	}
+	return attrs
+}
+
+// gqlElement is an element of a pin's state as presented to a resolver.
+type gqlElement struct {
+	Op    string
+	Attr  string
+	Item  string
+	Value json.RawMessage
+}
+
+// resolvePin resolves a Pin selection from the given tx, where later ops to an element replace earlier ones.
+func (schema *gqlSchema) resolvePin(tx *Tx, sel []*gqlField) (any, error) {
+	type cellState struct {
+		id    string
+		elems []gqlElement
+		index map[string]int // attr/item => index in elems
+	}
+	var cells []*cellState
+	cellsByID := make(map[string]*cellState)
+	for _, op := range tx.Ops {
+		cell := cellsByID[op.Cell]
+		if cell == nil {
+			cell = &cellState{
+				id:    op.Cell,
+				index: make(map[string]int),
+			}
+			cellsByID[op.Cell] = cell
+			cells = append(cells, cell)
+		}
+		elem := gqlElement{Op: op.Op, Attr: op.Attr, Item: op.Item, Value: op.Value}
+		key := op.Attr + "/" + op.Item
+		if i, exists := cell.index[key]; exists {
+			cell.elems[i] = elem
+		} else {
+			cell.index[key] = len(cell.elems)
+			cell.elems = append(cell.elems, elem)
+		}
+	}
+
+	resolveCell := func(cell *cellState, sel []*gqlField) (any, error) {
+		out := make(map[string]any, len(sel))
+		for _, field := range sel {
+			switch field.Name {
+			case "__typename":
+				out[field.Alias] = schema.Cell.Name
+			case "id":
+				out[field.Alias] = cell.id
+			case "elements":
+				attrFilter, _ := field.Args["attr"].(string)
+				list := []any{}
+				for _, elem := range cell.elems {
+					if attrFilter != "" && attrFilter != elem.Attr {
+						continue
+					}
+					val, err := schema.resolveElement(schema.Element, elem, field.Fields)
+					if err != nil {
+						return nil, err
+					}
+					list = append(list, val)
+				}
+				out[field.Alias] = list
+			default:
+				attrID, exists := schema.attrs[field.Name]
+				if !exists {
+					return nil, fmt.Errorf("Cell has no field %q", field.Name)
+				}
+				attr := attrID.Base32()
+				elemType := schema.Cell.Fields[field.Name].Elem
+				list := []any{}
+				for _, elem := range cell.elems {
+					if elem.Attr != attr {
+						continue
+					}
+					val, err := schema.resolveElement(elemType, elem, field.Fields)
+					if err != nil {
+						return nil, err
+					}
+					list = append(list, val)
+				}
+				out[field.Alias] = list
+			}
+		}
+		return out, nil
+	}
+
+	out := make(map[string]any, len(sel))
+	for _, field := range sel {
+		switch field.Name {
+		case "__typename":
+			out[field.Alias] = schema.Pin.Name
+		case "status":
+			out[field.Alias] = strings.TrimPrefix(tx.Status, "OpStatus_")
+		case "cells":
+			list := []any{}
+			for _, cell := range cells {
+				val, err := resolveCell(cell, field.Fields)
+				if err != nil {
+					return nil, err
+				}
+				list = append(list, val)
+			}
+			out[field.Alias] = list
+		case "cell":
+			cellID, _ := field.Args["id"].(string)
+			if cell := cellsByID[cellID]; cell != nil {
+				val, err := resolveCell(cell, field.Fields)
+				if err != nil {
+					return nil, err
+				}
+				out[field.Alias] = val
+			} else {
+				out[field.Alias] = nil
+			}
+		default:
+			return nil, fmt.Errorf("Pin has no field %q", field.Name)
+		}
+	}
+	return out, nil
+}
+
+func (schema *gqlSchema) resolveElement(elemType *gqlType, elem gqlElement, sel []*gqlField) (any, error) {
+	elemType = schema.types[elemType.Name]
+	out := make(map[string]any, len(sel))
+	for _, field := range sel {
+		switch field.Name {
+		case "__typename":
+			out[field.Alias] = elemType.Name
+		case "op":
+			out[field.Alias] = elem.Op
+		case "attr":
+			if elemType != schema.Element {
+				return nil, fmt.Errorf("%s has no field %q", elemType.Name, field.Name)
+			}
+			out[field.Alias] = elem.Attr
+		case "item":
+			if elem.Item != "" {
+				out[field.Alias] = elem.Item
+			} else {
+				out[field.Alias] = nil
+			}
+		case "value":
+			var val any
+			if len(elem.Value) > 0 {
+				dec := json.NewDecoder(bytes.NewReader(elem.Value))
+				dec.UseNumber()
+				if err := dec.Decode(&val); err != nil {
+					return nil, err
+				}
+			}
+			projected, err := schema.project(val, elemType.Fields["value"], field.Fields)
+			if err != nil {
+				return nil, err
+			}
+			out[field.Alias] = projected
+		default:
+			return nil, fmt.Errorf("%s has no field %q", elemType.Name, field.Name)
+		}
+	}
+	return out, nil
+}
+
+// project returns the given decoded JSON value reduced to the selected fields of the given type.
+func (schema *gqlSchema) project(val any, t *gqlType, sel []*gqlField) (any, error) {
+	if val == nil {
+		return nil, nil
+	}
+	switch t.Kind {
+	case gqlList:
+		list, ok := val.([]any)
+		if !ok {
+			return nil, nil
+		}
+		out := make([]any, len(list))
+		for i, vi := range list {
+			var err error
+			if out[i], err = schema.project(vi, t.Elem, sel); err != nil {
+				return nil, err
+			}
+		}
+		return out, nil
+	case gqlObject:
+		if len(sel) == 0 {
+			return nil, fmt.Errorf("field of type %s requires a selection", t.Name)
+		}
+		obj, _ := val.(map[string]any)
+		out := make(map[string]any, len(sel))
+		for _, field := range sel {
+			if field.Name == "__typename" {
+				out[field.Alias] = t.Name
+				continue
+			}
+			fieldType, exists := t.Fields[field.Name]
+			if !exists {
+				return nil, fmt.Errorf("%s has no field %q", t.Name, field.Name)
+			}
+			fieldVal, err := schema.project(obj[field.Name], fieldType, field.Fields)
+			if err != nil {
+				return nil, err
+			}
+			out[field.Alias] = fieldVal
+		}
+		return out, nil
+	default:
+		if len(sel) > 0 && t != gqlJSON {
+			return nil, fmt.Errorf("scalar %s has no fields", t.Name)
+		}
+		return val, nil
+	}
+}
@@ -0,0 +1,325 @@
+package gateway
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// gqlOp is a parsed GraphQL operation.
+// Only the subset of GraphQL the gateway serves is supported: a single query or subscription of fields, aliases, and arguments (no fragments or directives).
+type gqlOp struct {
+	Kind   string // "query" or "subscription"
+	Fields []*gqlField
+}
+
+type gqlField struct {
+	Alias  string // response key; the field name if no alias
+	Name   string
+	Args   map[string]any
+	Fields []*gqlField // selection set, if any
+}
+
+// maxGQLDepth bounds the nesting of selection sets and argument values, so a deeply nested query can't exhaust the stack.
+const maxGQLDepth = 32
+
+type gqlParser struct {
+	src   string
+	pos   int
+	depth int // current nesting of selection sets and list or object values
+	vars  map[string]any
+}
+
+func parseGraphQL(src string, vars map[string]any) (op *gqlOp, err error) {
+	p := &gqlParser{
+		src:  src,
+		vars: vars,
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if perr, ok := r.(gqlSyntaxErr); ok {
+				err = perr
+				return
+			}
+			panic(r)
+		}
+	}()
+
+	op = &gqlOp{
+		Kind: "query",
+	}
+	if p.peek() != "{" {
+		op.Kind = p.name()
+		switch op.Kind {
+		case "query", "subscription":
+		case "mutation":
+			p.fail("mutations are not supported; POST ops to the pin endpoint instead")
+		default:
+			p.fail("unexpected %q", op.Kind)
+		}
+		if tok := p.peek(); tok != "{" && tok != "(" {
+			p.name() // operation name
+		}
+		if p.peek() == "(" {
+			p.skipVariableDefs()
+		}
+	}
+	op.Fields = p.selectionSet()
+	if p.peek() != "" {
+		p.fail("only a single operation is supported")
+	}
+	return op, nil
+}
+
+type gqlSyntaxErr string
+
+func (err gqlSyntaxErr) Error() string {
+	return string(err)
+}
+
+func (p *gqlParser) fail(format string, args ...any) {
+	panic(gqlSyntaxErr(fmt.Sprintf("graphql: offset %d: ", p.pos) + fmt.Sprintf(format, args...)))
+}
+
+// skipIgnored skips whitespace, commas, and comments, which GraphQL treats as insignificant.
+func (p *gqlParser) skipIgnored() {
+	for p.pos < len(p.src) {
+		switch c := p.src[p.pos]; {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',' || c == 0xEF || c == 0xBB || c == 0xBF:
+			p.pos++
+		case c == '#':
+			for p.pos < len(p.src) && p.src[p.pos] != '\n' {
+				p.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+// peek returns the next punctuator or name (without consuming it), or "" at the end of input.
+func (p *gqlParser) peek() string {
+	p.skipIgnored()
+	if p.pos >= len(p.src) {
+		return ""
+	}
+	if isNameStart(p.src[p.pos]) {
+		end := p.pos
+		for end < len(p.src) && isNameChar(p.src[end]) {
+			end++
+		}
+		return p.src[p.pos:end]
+	}
+	if strings.HasPrefix(p.src[p.pos:], "...") {
+		return "..."
+	}
+	return p.src[p.pos : p.pos+1]
+}
+
+func (p *gqlParser) expect(punct string) {
+	if tok := p.peek(); tok != punct {
+		p.fail("expected %q, found %q", punct, tok)
+	}
+	p.pos += len(punct)
+}
+
+func isNameStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isNameChar(c byte) bool {
+	return isNameStart(c) || (c >= '0' && c <= '9')
+}
+
+// nest enters a selection set or list or object value, failing if nested too deeply; the caller decrements p.depth on leaving.
+func (p *gqlParser) nest() {
+	if p.depth++; p.depth > maxGQLDepth {
+		p.fail("nesting exceeds max depth of %d", maxGQLDepth)
+	}
+}
+
+func (p *gqlParser) name() string {
+	tok := p.peek()
+	if tok == "" || !isNameStart(tok[0]) {
+		p.fail("expected a name, found %q", tok)
+	}
+	p.pos += len(tok)
+	return tok
+}
+
+func (p *gqlParser) selectionSet() []*gqlField {
+	p.nest()
+	defer func() { p.depth-- }()
+	p.expect("{")
+	var fields []*gqlField
+	for p.peek() != "}" {
+		switch tok := p.peek(); tok {
+		case "":
+			p.fail("unterminated selection set")
+		case "...":
+			p.fail("fragments are not supported")
+		case "@":
+			p.fail("directives are not supported")
+		}
+		fields = append(fields, p.field())
+	}
+	p.expect("}")
+	return fields
+}
+
+func (p *gqlParser) field() *gqlField {
+	field := &gqlField{}
+	field.Name = p.name()
+	field.Alias = field.Name
+	if p.peek() == ":" {
+		p.expect(":")
+		field.Name = p.name()
+	}
+	if p.peek() == "(" {
+		p.expect("(")
+		field.Args = make(map[string]any)
+		for p.peek() != ")" {
+			argName := p.name()
+			p.expect(":")
+			field.Args[argName] = p.value()
+		}
+		p.expect(")")
+	}
+	if p.peek() == "{" {
+		field.Fields = p.selectionSet()
+	}
+	return field
+}
+
+func (p *gqlParser) skipVariableDefs() {
+	p.expect("(")
+	depth := 1
+	for depth > 0 {
+		switch p.peek() {
+		case "":
+			p.fail("unterminated variable definitions")
+		case "(":
+			depth++
+		case ")":
+			depth--
+		case "\"":
+			p.stringValue()
+			continue
+		}
+		p.pos++
+	}
+}
+
+// value parses an argument value, resolving variables.
+func (p *gqlParser) value() any {
+	switch tok := p.peek(); {
+	case tok == "$":
+		p.pos++
+		name := p.name()
+		return p.vars[name]
+	case tok == "\"":
+		return p.stringValue()
+	case tok == "[":
+		p.nest()
+		defer func() { p.depth-- }()
+		p.expect("[")
+		list := []any{}
+		for p.peek() != "]" {
+			if p.peek() == "" {
+				p.fail("unterminated list")
+			}
+			list = append(list, p.value())
+		}
+		p.expect("]")
+		return list
+	case tok == "{":
+		p.nest()
+		defer func() { p.depth-- }()
+		p.expect("{")
+		obj := map[string]any{}
+		for p.peek() != "}" {
+			key := p.name()
+			p.expect(":")
+			obj[key] = p.value()
+		}
+		p.expect("}")
+		return obj
+	case tok == "true":
+		p.pos += 4
+		return true
+	case tok == "false":
+		p.pos += 5
+		return false
+	case tok == "null":
+		p.pos += 4
+		return nil
+	case tok != "" && isNameStart(tok[0]):
+		return p.name() // enum value
+	case tok == "-" || (tok != "" && tok[0] >= '0' && tok[0] <= '9'):
+		start := p.pos
+		p.pos++
+		for p.pos < len(p.src) && strings.IndexByte("0123456789.eE+-", p.src[p.pos]) >= 0 {
+			p.pos++
+		}
+		num := p.src[start:p.pos]
+		if i, err := strconv.ParseInt(num, 10, 64); err == nil {
+			return i
+		}
+		f, err := strconv.ParseFloat(num, 64)
+		if err != nil {
+			p.fail("bad number %q", num)
+		}
+		return f
+	default:
+		p.fail("unexpected %q", tok)
+		return nil
+	}
+}
+
+func (p *gqlParser) stringValue() string {
+	p.expect("\"")
+	var b strings.Builder
+	for {
+		if p.pos >= len(p.src) || p.src[p.pos] == '\n' {
+			p.fail("unterminated string")
+		}
+		c := p.src[p.pos]
+		p.pos++
+		switch c {
+		case '"':
+			return b.String()
+		case '\\':
+			if p.pos >= len(p.src) {
+				p.fail("unterminated string")
+			}
+			esc := p.src[p.pos]
+			p.pos++
+			switch esc {
+			case 'n':
+				b.WriteByte('\n')
+			case 't':
+				b.WriteByte('\t')
+			case 'r':
+				b.WriteByte('\r')
+			case 'b':
+				b.WriteByte('\b')
+			case 'f':
+				b.WriteByte('\f')
+			case 'u':
+				if p.pos+4 > len(p.src) {
+					p.fail("bad unicode escape")
+				}
+				r, err := strconv.ParseUint(p.src[p.pos:p.pos+4], 16, 32)
+				if err != nil {
+					p.fail("bad unicode escape")
+				}
+				b.WriteRune(rune(r))
+				p.pos += 4
+			default:
+				b.WriteByte(esc) // \" \\ \/
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+}
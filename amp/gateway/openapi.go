@@ -0,0 +1,259 @@
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+)
+
+// OpenAPIHandler returns an http.Handler serving an OpenAPI 3 document (JSON) describing the pin endpoint, generated from the host's registered apps and attrs.
+func (svc *Service) OpenAPIHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if svc.host == nil {
+			http.Error(w, "gateway not started", http.StatusServiceUnavailable)
+			return
+		}
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		enc.Encode(svc.OpenAPI(svc.host.HostRegistry()))
+	})
+}
+
+// OpenAPI returns the OpenAPI 3 document (in its JSON form) describing the pin endpoint for the given Registry:
+//   - each registered app is a tag, and its invocations are examples of the url path parameter
+//   - each registered attr's value type is a component schema, listed by attr ID under the "x-amp-attrs" extension
+func (svc *Service) OpenAPI(reg amp.Registry) map[string]any {
+	doc := &openAPI{
+		schemas: make(map[string]any),
+		goTypes: make(map[reflect.Type]string),
+	}
+	doc.schemas["Op"], doc.schemas["Tx"] = nil, nil // reserved
+
+	defs := reg.ListAttrs()
+	sort.Slice(defs, func(i, j int) bool {
+		return defs[i].Canonic < defs[j].Canonic
+	})
+	attrs := make(map[string]any, len(defs))
+	var values []any
+	for _, def := range defs {
+		valSchema := doc.schemaOf(reflect.TypeOf(def.Prototype))
+		attrs[def.ID.Base32()] = map[string]any{
+			"canonic": def.Canonic,
+			"schema":  valSchema,
+		}
+		values = append(values, valSchema)
+	}
+	values = append(values, map[string]any{"type": "string", "format": "byte"})
+
+	doc.schemas["Op"] = map[string]any{
+		"type":     "object",
+		"required": []string{"op", "cell", "attr"},
+		"properties": map[string]any{
+			"op":   map[string]any{"type": "string", "enum": []string{"upsert", "delete", "defer", "ephemeral"}},
+			"cell": map[string]any{"type": "string", "description": "base32 cell ID"},
+			"attr": map[string]any{"type": "string", "description": "base32 attr ID -- see x-amp-attrs"},
+			"item": map[string]any{"type": "string", "description": "base32 item ID"},
+			"value": map[string]any{
+				"description": "JSON form of the attr's registered value type (or base64 if the attr is not registered)",
+				"anyOf":       values,
+			},
+		},
+	}
+	doc.schemas["Tx"] = map[string]any{
+		"type":     "object",
+		"required": []string{"ops"},
+		"properties": map[string]any{
+			"status": map[string]any{"type": "string", "description": "amp.OpStatus, e.g. OpStatus_Synced"},
+			"ops":    map[string]any{"type": "array", "items": ref("Op")},
+		},
+	}
+
+	tags := []any{}
+	examples := make(map[string]any)
+	apps := reg.ListApps()
+	sort.Slice(apps, func(i, j int) bool {
+		return apps[i].AppSpec.Canonic < apps[j].AppSpec.Canonic
+	})
+	for _, app := range apps {
+		tags = append(tags, map[string]any{
+			"name":              app.AppSpec.Canonic,
+			"description":       app.Desc,
+			"x-amp-version":     app.Version,
+			"x-amp-invocations": app.Invocations,
+		})
+		for _, invocation := range app.Invocations {
+			examples[invocation] = map[string]any{
+				"summary": app.Desc,
+				"value":   invocation + ":",
+			}
+		}
+	}
+
+	txContent := map[string]any{
+		"application/json": map[string]any{"schema": ref("Tx")},
+	}
+	errResponses := func(responses map[string]any) map[string]any {
+		for status, desc := range map[string]string{
+			"400": "malformed request, ops, or values",
+			"401": "missing or invalid API key",
+			"403": "insufficient permissions",
+			"404": "cell or app not found",
+			"409": "the cell's state does not permit the commit",
+			"429": "quota exceeded",
+			"501": "operation not supported",
+			"502": "host error",
+			"503": "host shutting down or not ready (see Retry-After)",
+			"504": "timed out waiting for the pin to sync",
+		} {
+			responses[status] = map[string]any{"description": desc}
+		}
+		return responses
+	}
+
+	urlParam := map[string]any{
+		"name":        "url",
+		"in":          "path",
+		"required":    true,
+		"description": "pin URL, e.g. an app invocation such as \"badges:\" (may contain '/')",
+		"schema":      map[string]any{"type": "string"},
+	}
+	if len(examples) > 0 {
+		urlParam["examples"] = examples
+	}
+
+	return map[string]any{
+		"openapi": "3.0.3",
+		"info": map[string]any{
+			"title":       "amp gateway",
+			"version":     "1",
+			"description": "Pins and commits to amp cells over HTTP and JSON.",
+		},
+		"tags":     tags,
+		"security": []any{map[string]any{"apiKey": []string{}}},
+		"paths": map[string]any{
+			svc.opts.PathPrefix + "{url}": map[string]any{
+				"parameters": []any{urlParam},
+				"get": map[string]any{
+					"operationId": "pin",
+					"summary":     "pins a url and returns its state once synced",
+					"description": `With "wait", long-polls for the next update after the state syncs. With "Accept: text/event-stream", streams the state and each update as server-sent events.`,
+					"parameters": []any{map[string]any{
+						"name":        "wait",
+						"in":          "query",
+						"description": fmt.Sprintf("long-poll duration, e.g. \"30s\" (at most %v)", svc.opts.MaxWait),
+						"schema":      map[string]any{"type": "string"},
+					}},
+					"responses": errResponses(map[string]any{
+						"200": map[string]any{
+							"description": "the pin's state (or with wait, the next update)",
+							"content": map[string]any{
+								"application/json":  map[string]any{"schema": ref("Tx")},
+								"text/event-stream": map[string]any{"schema": map[string]any{"type": "string", "description": "a data event per Tx"}},
+							},
+						},
+						"204": map[string]any{"description": "no update arrived within wait"},
+					}),
+				},
+				"post": map[string]any{
+					"operationId": "commit",
+					"summary":     "commits ops to a pinned cell and returns the resulting state",
+					"requestBody": map[string]any{"required": true, "content": txContent},
+					"responses": errResponses(map[string]any{
+						"200": map[string]any{"description": "the pin's state", "content": txContent},
+					}),
+				},
+			},
+		},
+		"components": map[string]any{
+			"schemas": doc.schemas,
+			"securitySchemes": map[string]any{
+				"apiKey": map[string]any{
+					"type":        "http",
+					"scheme":      "bearer",
+					"description": "an API key (see amp.APIKeys)",
+				},
+			},
+		},
+		"x-amp-attrs": attrs,
+	}
+}
+
+// openAPI accumulates component schemas reflected from Go types.
+type openAPI struct {
+	schemas map[string]any
+	goTypes map[reflect.Type]string // => schema name
+}
+
+func (doc *openAPI) taken(name string) bool {
+	_, taken := doc.schemas[name]
+	return taken
+}
+
+func ref(name string) map[string]any {
+	return map[string]any{"$ref": "#/components/schemas/" + name}
+}
+
+// schemaOf returns the schema of a Go value's JSON form, adding a component schema for each struct type.
+func (doc *openAPI) schemaOf(typ reflect.Type) map[string]any {
+	if typ == nil {
+		return map[string]any{}
+	}
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	switch typ.Kind() {
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return map[string]any{"type": "integer", "format": "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer", "format": "int64"}
+	case reflect.Float32:
+		return map[string]any{"type": "number", "format": "float"}
+	case reflect.Float64:
+		return map[string]any{"type": "number", "format": "double"}
+	case reflect.Slice, reflect.Array:
+		if typ.Elem().Kind() == reflect.Uint8 {
+			return map[string]any{"type": "string", "format": "byte"}
+		}
+		return map[string]any{"type": "array", "items": doc.schemaOf(typ.Elem())}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": doc.schemaOf(typ.Elem())}
+	case reflect.Struct:
+		if name, exists := doc.goTypes[typ]; exists {
+			return ref(name)
+		}
+		name := sanitizeName(typ.Name())
+		for i := 2; doc.taken(name); i++ {
+			name = fmt.Sprintf("%s%d", strings.TrimRight(name, "0123456789"), i)
+		}
+		doc.goTypes[typ] = name
+		props := make(map[string]any)
+		doc.schemas[name] = map[string]any{
+			"type":       "object",
+			"properties": props,
+		}
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			if fieldName, ok := jsonFieldName(field); ok {
+				props[fieldName] = doc.schemaOf(field.Type)
+			}
+		}
+		return ref(name)
+	default:
+		return map[string]any{} // any value
+	}
+}
@@ -0,0 +1,80 @@
+package gateway
+
+import (
+	"context"
+	"sync"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+)
+
+// transport is an in-process amp.Transport between a gateway request and its Session.
+type transport struct {
+	label     string
+	toSess    chan *amp.TxMsg // gateway -> host
+	toGateway chan *amp.TxMsg // host -> gateway
+	closing   chan struct{}
+	closeOnce sync.Once
+}
+
+func newTransport(label string) *transport {
+	return &transport{
+		label:     label,
+		toSess:    make(chan *amp.TxMsg, 4),
+		toGateway: make(chan *amp.TxMsg, 64),
+		closing:   make(chan struct{}),
+	}
+}
+
+func (t *transport) Label() string {
+	return t.label
+}
+
+func (t *transport) Close() error {
+	t.closeOnce.Do(func() {
+		close(t.closing)
+	})
+	return nil
+}
+
+// SendTx retains tx until the gateway has consumed it.
+func (t *transport) SendTx(tx *amp.TxMsg) error {
+	tx.AddRef()
+	select {
+	case t.toGateway <- tx:
+		return nil
+	case <-t.closing:
+		tx.ReleaseRef()
+		return amp.ErrStreamClosed
+	}
+}
+
+func (t *transport) RecvTx() (*amp.TxMsg, error) {
+	select {
+	case tx := <-t.toSess:
+		return tx, nil
+	case <-t.closing:
+		return nil, amp.ErrStreamClosed
+	}
+}
+
+func (t *transport) toHost(ctx context.Context, tx *amp.TxMsg) error {
+	select {
+	case t.toSess <- tx:
+		return nil
+	case <-t.closing:
+		return amp.ErrStreamClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (t *transport) fromHost(ctx context.Context) (*amp.TxMsg, error) {
+	select {
+	case tx := <-t.toGateway:
+		return tx, nil
+	case <-t.closing:
+		return nil, amp.ErrStreamClosed
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
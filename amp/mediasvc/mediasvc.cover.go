@@ -0,0 +1,108 @@
+package mediasvc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"sync"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/amp/std"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/blob"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/media"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// maxCoverFetches bounds how many covers of a page are downloaded concurrently.
+const maxCoverFetches = 4
+
+// coverCache caches cover art in a session's blob store, keyed by the hash of its URL, so each image is downloaded once.
+type coverCache struct {
+	sess   amp.Session
+	store  blob.Store
+	client *http.Client
+
+	mu   sync.Mutex
+	refs map[string]std.AssetRef // cover URL => prepared asset
+}
+
+func newCoverCache(sess amp.Session, store blob.Store, client *http.Client) *coverCache {
+	return &coverCache{
+		sess:   sess,
+		store:  store,
+		client: client,
+		refs:   make(map[string]std.AssetRef),
+	}
+}
+
+// get returns the prepared cover of the given URL, if any.
+func (cache *coverCache) get(coverURL string) (std.AssetRef, bool) {
+	if cache == nil || coverURL == "" {
+		return std.AssetRef{}, false
+	}
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	ref, ok := cache.refs[coverURL]
+	return ref, ok
+}
+
+// prepare caches and prepares the covers of the given items not already prepared.
+// A cover that fails to download is logged and omitted rather than failing the page.
+func (cache *coverCache) prepare(ctx task.Context, items []*Item) {
+	if cache == nil {
+		return
+	}
+	sem := make(chan struct{}, maxCoverFetches)
+	wg := sync.WaitGroup{}
+	for _, item := range items {
+		coverURL := item.CoverURL
+		if _, prepared := cache.get(coverURL); prepared || coverURL == "" {
+			continue
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			ref, err := cache.fetch(ctx, coverURL)
+			if err != nil {
+				ctx.Log().Warnf("mediasvc: cover %q: %v", coverURL, err)
+				return
+			}
+			cache.mu.Lock()
+			cache.refs[coverURL] = ref
+			cache.mu.Unlock()
+		}()
+	}
+	wg.Wait()
+}
+
+// fetch downloads the given cover into the blob store (unless already present) and prepares it as an asset.
+func (cache *coverCache) fetch(ctx context.Context, coverURL string) (std.AssetRef, error) {
+	sum := sha256.Sum256([]byte(coverURL))
+	key := "mediasvc/covers/" + hex.EncodeToString(sum[:])
+
+	info, err := cache.store.Stat(ctx, key)
+	if err != nil {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, coverURL, nil)
+		if err != nil {
+			return std.AssetRef{}, amp.ErrCode_BadValue.Wrap(err)
+		}
+		resp, err := cache.client.Do(req)
+		if err != nil {
+			return std.AssetRef{}, amp.ErrCode_ProviderErr.Wrap(err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return std.AssetRef{}, amp.ErrCode_ProviderErr.Errorf("GET cover: %s", resp.Status)
+		}
+		info = blob.Info{ContentType: resp.Header.Get("Content-Type")}
+		if err = cache.store.Put(ctx, key, resp.Body, info); err != nil {
+			return std.AssetRef{}, err
+		}
+	}
+	return std.PrepareAsset(cache.sess, media.NewBlobAsset(cache.store, key, info.ContentType), std.AssetOpts{})
+}
@@ -0,0 +1,443 @@
+// Package mediasvc is a toolkit for apps integrating music and photo services, so a new service integration is mostly configuration.
+//
+// It provides the machinery common to such apps:
+//   - OAuth grants via the host's OAuthService, begun on demand when a user first pins the service
+//   - catalog paging, presenting a window of a collection at a time (see std.CollectionWindow) and tracking page tokens for services that require them
+//   - playable asset URLs, resolved as an item is pinned and refreshed before they expire
+//   - cover art, cached in the session's blob store so each image is downloaded once
+//
+// A service implements Catalog, or configures a RESTCatalog for a typical JSON API.
+// A collection is pinned via "{invocation}:[?collection={id}][&offset={n}][&limit={n}]" and a playable item via "{invocation}:?item={id}".
+package mediasvc
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/amp/std"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// refreshRetry is how long a failed refresh of a playable URL waits before retrying.
+const refreshRetry = 15 * time.Second
+
+// Pin URL query parameters naming the pinned collection (or if absent, the catalog's root) or playable item.
+const (
+	CollectionParam = "collection"
+	ItemParam       = "item"
+)
+
+// Catalog is the service-specific part of a media service app.
+type Catalog interface {
+
+	// Returns a page of the items of the given collection ("" denotes the catalog's root) starting at the given cursor.
+	Browse(ctx context.Context, client *http.Client, collectionID string, cursor Cursor, limit int) (*Page, error)
+
+	// Returns the playable asset of the given item.
+	Resolve(ctx context.Context, client *http.Client, itemID string) (*Playable, error)
+}
+
+// Cursor is the position of a page within a collection.
+type Cursor struct {
+	Offset int    // index of the page's first item
+	Token  string // the page token the service returned with the preceding page, if known; "" for the first page
+}
+
+// Page is a page of a collection's items.
+type Page struct {
+	Items     []*Item
+	Total     int    // size of the collection, or -1 if unknown
+	NextToken string // token of the next page, for services that page by token
+}
+
+// Item is an entry of a collection: a nested collection (e.g. an album, playlist, or folder) or a playable item (e.g. a track or photo).
+type Item struct {
+	ID           string
+	IsCollection bool
+	Title        string
+	Subtitle     string         // e.g. an artist or album
+	CoverURL     string         // cover art or thumbnail, cached in the session's blob store
+	Info         *std.MediaInfo // optional metadata (e.g. duration or dimensions)
+}
+
+// Playable is an item's asset URL, typically signed and short lived.
+type Playable struct {
+	URL         string
+	ContentType string
+	Expires     time.Time // zero if the URL does not expire
+}
+
+// Opts specifies a media service app.
+type Opts struct {
+	AppSpec    tag.Spec
+	Desc       string
+	Version    string // if empty, "v1.0.0"
+	Invocation string // e.g. "photos"
+	Catalog    Catalog
+
+	// If Provider.Name is set, the service is called on behalf of each user via their OAuth grant, which is begun as the user first pins the service.
+	// Otherwise, the service is called without authorization.
+	Provider amp.OAuthProvider
+	Client   *http.Client // client for unauthorized service requests and cover downloads; if nil, a client with a 30s timeout
+
+	PageSize      int           // items per window if the pin request does not specify; if <= 0, 50
+	MaxPageSize   int           // if <= 0, 200
+	RefreshMargin time.Duration // a playable URL is resolved again this long before it expires; if <= 0, 1m
+}
+
+// RegisterApp registers a media service app.
+//
+// A collection's cell has a CellLabel and CellWindow property, and a child cell per item in the window.
+// Each item's cell has a CellLabel, CellCaption (its subtitle), CellCover, and CellMediaInfo property.
+// Once a playable item's cell is pinned, its asset URL is added as a CellMedia property and while pinned with StateSync_Maintain, refreshed before it expires.
+func RegisterApp(reg amp.Registry, opts Opts) error {
+	if opts.Catalog == nil || opts.Invocation == "" {
+		return amp.ErrCode_BadRequest.Error("mediasvc: missing Catalog or Invocation")
+	}
+	if opts.Version == "" {
+		opts.Version = "v1.0.0"
+	}
+	if opts.Client == nil {
+		opts.Client = &http.Client{Timeout: 30 * time.Second}
+	}
+	if opts.PageSize <= 0 {
+		opts.PageSize = 50
+	}
+	if opts.MaxPageSize <= 0 {
+		opts.MaxPageSize = 200
+	}
+	if opts.RefreshMargin <= 0 {
+		opts.RefreshMargin = time.Minute
+	}
+
+	return reg.RegisterApp(&amp.App{
+		AppSpec:     opts.AppSpec,
+		Desc:        opts.Desc,
+		Version:     opts.Version,
+		Invocations: []string{opts.Invocation},
+		NewAppInstance: func(ctx amp.AppContext) (amp.AppInstance, error) {
+			if opts.Provider.Name != "" {
+				oauth := amp.ServicesOf(ctx.Session()).OAuth
+				if oauth == nil {
+					return nil, amp.ErrCode_Unimplemented.Error("mediasvc: host has no OAuth service")
+				}
+				if err := oauth.RegisterProvider(opts.Provider); err != nil {
+					return nil, err
+				}
+			}
+			app := &appInst{
+				Opts:   &opts,
+				tokens: make(map[string]string),
+			}
+			if store := amp.ServicesOf(ctx.Session()).BlobStore; store != nil {
+				app.covers = newCoverCache(ctx.Session(), store, opts.Client)
+			}
+			app.AppContext = ctx
+			app.Instance = app
+			return app, nil
+		},
+	})
+}
+
+// appInst is an instance of a media service app, holding a session's service client, page tokens, and prepared covers.
+type appInst struct {
+	std.App[*appInst]
+	*Opts
+
+	covers *coverCache // nil if the session has no blob store
+
+	mu     sync.Mutex
+	client *http.Client      // authorized via the user's grant
+	tokens map[string]string // collection ID and offset => page token
+}
+
+func (app *appInst) ServeRequest(op amp.Requester) (amp.Pin, error) {
+	req := op.Request()
+
+	// the client reports the URL the provider redirected to once the user grants access
+	if tx := req.CommitTx; tx != nil {
+		for i, op := range tx.Ops {
+			if op.AttrID != std.LaunchURL {
+				continue
+			}
+			launch := amp.LaunchURL{}
+			if err := tx.UnmarshalOpValue(i, &launch); err != nil {
+				return nil, err
+			}
+			if _, err := amp.HandleOAuthRedirect(app, app.Session(), &launch); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	client, err := app.serviceClient()
+	if err == amp.ErrOAuthNotGranted {
+		if err = amp.BeginOAuth(app.Session(), req.ID, app.Provider.Name); err == nil {
+			err = amp.ErrOAuthNotGranted
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var collectionID, itemID string
+	if req.Values != nil {
+		collectionID = req.Values.Get(CollectionParam)
+		itemID = req.Values.Get(ItemParam)
+	}
+	if itemID != "" {
+		return app.PinAndServe(app.newPlayableCell(app, client, &Item{ID: itemID}), op)
+	}
+	offset, limit := std.ParseWindow(req.Values, app.PageSize, app.MaxPageSize)
+	collection := &Item{
+		ID:           collectionID,
+		IsCollection: true,
+		Title:        app.Desc,
+	}
+	return app.PinAndServe(app.newCollectionCell(app, client, collection, offset, limit), op)
+}
+
+// serviceClient returns the client through which the service is called, or amp.ErrOAuthNotGranted.
+func (app *appInst) serviceClient() (*http.Client, error) {
+	app.mu.Lock()
+	defer app.mu.Unlock()
+	if app.client != nil {
+		return app.client, nil
+	}
+	if app.Provider.Name == "" {
+		app.client = app.Client
+		return app.client, nil
+	}
+	login := app.Session().Login()
+	if login.UserID == nil {
+		return nil, amp.ErrAccessDenied
+	}
+	src, err := amp.ServicesOf(app.Session()).OAuth.TokenSource(login.UserID.AsID(), app.Provider.Name)
+	if err != nil {
+		return nil, err
+	}
+	client := amp.NewOAuthClient(src)
+	app.client = client
+	return client, nil
+}
+
+// cellIDOf returns the cell ID of a collection or item, so its cell is stable across pins.
+func (app *appInst) cellIDOf(item *Item) tag.ID {
+	if item.IsCollection {
+		return app.AppSpec.ID.WithToken("collection:" + item.ID)
+	}
+	return app.AppSpec.ID.WithToken("item:" + item.ID)
+}
+
+// cursor returns the cursor of the given window, with the page token returned by the preceding page if known.
+func (app *appInst) cursor(collectionID string, offset int) Cursor {
+	app.mu.Lock()
+	defer app.mu.Unlock()
+	return Cursor{
+		Offset: offset,
+		Token:  app.tokens[collectionID+"@"+strconv.Itoa(offset)],
+	}
+}
+
+// setNextToken records the page token of the window following the given one.
+func (app *appInst) setNextToken(collectionID string, offset int, token string) {
+	if token == "" {
+		return
+	}
+	app.mu.Lock()
+	app.tokens[collectionID+"@"+strconv.Itoa(offset)] = token
+	app.mu.Unlock()
+}
+
+func (app *appInst) newCollectionCell(ctx task.Context, client *http.Client, collection *Item, offset, limit int) *collectionCell {
+	cell := &collectionCell{
+		ctx:        ctx,
+		app:        app,
+		client:     client,
+		collection: collection,
+		limit:      limit,
+	}
+	cell.window.Offset = int64(offset)
+	cell.window.Total = -1
+	cell.ID = app.cellIDOf(collection)
+	cell.Compute = cell.computeItems
+	cell.Attrs = cell.marshalCollection
+	return cell
+}
+
+// collectionCell presents a window of a collection's items.
+type collectionCell struct {
+	std.ComputedCell[*appInst]
+	ctx        task.Context
+	app        *appInst
+	client     *http.Client
+	collection *Item
+	limit      int
+
+	mu     sync.Mutex
+	window std.CollectionWindow // as of the last page
+}
+
+func (cell *collectionCell) computeItems() ([]std.Cell[*appInst], error) {
+	app := cell.app
+	cell.mu.Lock()
+	offset := int(cell.window.Offset)
+	cell.mu.Unlock()
+
+	page, err := app.Catalog.Browse(cell.ctx, cell.client, cell.collection.ID, app.cursor(cell.collection.ID, offset), cell.limit)
+	if err != nil {
+		return nil, err
+	}
+	app.setNextToken(cell.collection.ID, offset+len(page.Items), page.NextToken)
+	app.covers.prepare(cell.ctx, page.Items)
+
+	cell.mu.Lock()
+	cell.window.Count = int64(len(page.Items))
+	cell.window.Total = int64(page.Total)
+	cell.mu.Unlock()
+
+	children := make([]std.Cell[*appInst], len(page.Items))
+	for i, item := range page.Items {
+		if item.IsCollection {
+			children[i] = app.newCollectionCell(cell.ctx, cell.client, item, 0, app.PageSize)
+		} else {
+			children[i] = app.newPlayableCell(cell.ctx, cell.client, item)
+		}
+	}
+	return children, nil
+}
+
+func (cell *collectionCell) marshalCollection(w std.CellWriter) {
+	cell.mu.Lock()
+	window := cell.window
+	cell.mu.Unlock()
+	cell.app.putItem(w, cell.collection)
+	w.PutItem(std.CellWindow, &window)
+}
+
+// putItem writes the properties common to collection and playable cells.
+func (app *appInst) putItem(w std.CellWriter, item *Item) {
+	w.PutText(std.CellLabel, item.Title)
+	if item.Subtitle != "" {
+		w.PutText(std.CellCaption, item.Subtitle)
+	}
+	if item.Info != nil {
+		w.PutItem(std.CellMediaInfo, item.Info)
+	}
+	if cover, prepared := app.covers.get(item.CoverURL); prepared {
+		cover.PutAs(w, std.CellCover)
+	}
+}
+
+func (app *appInst) newPlayableCell(ctx task.Context, client *http.Client, item *Item) *playableCell {
+	cell := &playableCell{
+		ctx:    ctx,
+		app:    app,
+		client: client,
+		item:   item,
+	}
+	cell.ID = app.cellIDOf(item)
+	cell.Inputs = []*std.Signal{&cell.resolved}
+	cell.Compute = cell.computeNone
+	cell.Attrs = cell.marshalPlayable
+	return cell
+}
+
+// playableCell presents a playable item and once pinned, its asset URL.
+type playableCell struct {
+	std.ComputedCell[*appInst]
+	ctx      task.Context
+	app      *appInst
+	client   *http.Client
+	item     *Item
+	resolved std.Signal // notified when the asset URL is refreshed
+
+	mu       sync.Mutex
+	playable *Playable
+}
+
+func (cell *playableCell) PinInto(pin *std.Pin[*appInst]) error {
+	if err := cell.resolve(); err != nil {
+		return err
+	}
+	if err := cell.ComputedCell.PinInto(pin); err != nil {
+		return err
+	}
+	if pin.Sync != amp.StateSync_Maintain {
+		return nil
+	}
+	_, err := pin.Context().StartChild(&task.Task{
+		Info: task.Info{
+			Label: "refresh: " + cell.item.ID,
+		},
+		OnRun: func(ctx task.Context) {
+			retry := time.Duration(0)
+			for {
+				cell.mu.Lock()
+				expires := cell.playable.Expires
+				cell.mu.Unlock()
+				if expires.IsZero() {
+					return
+				}
+				wait := max(time.Until(expires)-cell.app.RefreshMargin, retry, time.Second)
+				timer := ctx.Clock().NewTimer(wait)
+				select {
+				case <-timer.C():
+					retry = 0
+					if err := cell.resolve(); err != nil {
+						ctx.Log().Warnf("mediasvc: refresh failed: %v", err)
+						retry = refreshRetry
+					}
+				case <-ctx.Closing():
+					timer.Stop()
+					return
+				}
+			}
+		},
+	})
+	return err
+}
+
+// resolve (re)resolves this item's asset URL if it is unresolved or about to expire, notifying pins if it changed.
+func (cell *playableCell) resolve() error {
+	cell.mu.Lock()
+	current := cell.playable
+	cell.mu.Unlock()
+	if current != nil && (current.Expires.IsZero() || time.Until(current.Expires) > cell.app.RefreshMargin) {
+		return nil
+	}
+
+	playable, err := cell.app.Catalog.Resolve(cell.ctx, cell.client, cell.item.ID)
+	if err != nil {
+		return err
+	}
+	cell.mu.Lock()
+	cell.playable = playable
+	cell.mu.Unlock()
+	if current != nil {
+		cell.resolved.Notify()
+	}
+	return nil
+}
+
+func (cell *playableCell) computeNone() ([]std.Cell[*appInst], error) {
+	return nil, nil
+}
+
+func (cell *playableCell) marshalPlayable(w std.CellWriter) {
+	cell.app.putItem(w, cell.item)
+	cell.mu.Lock()
+	playable := cell.playable
+	cell.mu.Unlock()
+	if playable != nil {
+		w.PutItem(std.CellMedia, &amp.Tag{
+			URL:         playable.URL,
+			ContentType: playable.ContentType,
+		})
+	}
+}
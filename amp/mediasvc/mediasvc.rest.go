@@ -0,0 +1,222 @@
+package mediasvc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/amp/std"
+)
+
+// RESTCatalog is a Catalog of a typical JSON API, specified by URL templates and the paths of fields within its responses.
+//
+// A URL template is relative to BaseURL and may contain {id}, {offset}, {limit}, and {token}, which are expanded (query escaped).
+// A path names a field of a JSON response as dot-separated object keys and array indices, e.g. "album.images.0.url".
+type RESTCatalog struct {
+	BaseURL       string     // e.g. "https://api.example.com/v1"
+	RootURL       string     // lists the catalog's root, e.g. "/me/playlists?offset={offset}&limit={limit}"
+	CollectionURL string     // lists a collection, e.g. "/playlists/{id}/tracks?offset={offset}&limit={limit}"
+	ItemsPath     string     // array of items within a listing, e.g. "items"
+	TotalPath     string     // optional: collection size within a listing, e.g. "total"
+	NextTokenPath string     // optional: next page token within a listing, e.g. "nextPageToken"
+	Fields        ItemFields // fields of each item
+
+	ResolveURL      string // returns an item's playable asset, e.g. "/tracks/{id}/stream"
+	PlayURLPath     string // asset URL within the response, e.g. "url"
+	ContentTypePath string // optional: e.g. "mimeType"
+	ExpiresInPath   string // optional: seconds until the asset URL expires, e.g. "expires_in"
+}
+
+// ItemFields specifies the paths of an item's fields, relative to the item.
+type ItemFields struct {
+	ID              string   // e.g. "id"
+	Title           string   // e.g. "name"
+	Subtitle        string   // optional: e.g. "artists.0.name"
+	CoverURL        string   // optional: e.g. "images.0.url"
+	Kind            string   // optional: names the item's kind, e.g. "type"
+	CollectionKinds []string // kinds denoting a collection, e.g. "album", "playlist"; if Kind is empty, the root's items are collections
+	DurationMs      string   // optional: e.g. "duration_ms"
+	Width           string   // optional
+	Height          string   // optional
+}
+
+func (cat *RESTCatalog) Browse(ctx context.Context, client *http.Client, collectionID string, cursor Cursor, limit int) (*Page, error) {
+	tmpl := cat.CollectionURL
+	if collectionID == "" {
+		tmpl = cat.RootURL
+	}
+	doc, err := cat.get(ctx, client, expandURL(tmpl, map[string]string{
+		"id":     collectionID,
+		"offset": strconv.Itoa(cursor.Offset),
+		"limit":  strconv.Itoa(limit),
+		"token":  cursor.Token,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	page := &Page{
+		Total:     -1,
+		NextToken: lookupString(doc, cat.NextTokenPath),
+	}
+	if total, ok := lookupNumber(doc, cat.TotalPath); ok {
+		page.Total = int(total)
+	}
+	elems, _ := lookup(doc, cat.ItemsPath).([]any)
+	for _, elem := range elems {
+		page.Items = append(page.Items, cat.itemOf(elem, collectionID == ""))
+	}
+	return page, nil
+}
+
+func (cat *RESTCatalog) Resolve(ctx context.Context, client *http.Client, itemID string) (*Playable, error) {
+	doc, err := cat.get(ctx, client, expandURL(cat.ResolveURL, map[string]string{
+		"id": itemID,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	playable := &Playable{
+		URL:         lookupString(doc, cat.PlayURLPath),
+		ContentType: lookupString(doc, cat.ContentTypePath),
+	}
+	if playable.URL == "" {
+		return nil, amp.ErrCode_ProviderErr.Errorf("mediasvc: item %q has no playable URL", itemID)
+	}
+	if secs, ok := lookupNumber(doc, cat.ExpiresInPath); ok && secs > 0 {
+		playable.Expires = time.Now().Add(time.Duration(secs * float64(time.Second)))
+	}
+	return playable, nil
+}
+
+func (cat *RESTCatalog) itemOf(elem any, atRoot bool) *Item {
+	fields := &cat.Fields
+	item := &Item{
+		ID:       lookupString(elem, fields.ID),
+		Title:    lookupString(elem, fields.Title),
+		Subtitle: lookupString(elem, fields.Subtitle),
+		CoverURL: lookupString(elem, fields.CoverURL),
+	}
+	if item.CoverURL != "" {
+		if base, err := url.Parse(cat.BaseURL); err == nil {
+			if ref, err := url.Parse(item.CoverURL); err == nil {
+				item.CoverURL = base.ResolveReference(ref).String() // covers are often relative to the API's host
+			}
+		}
+	}
+	if fields.Kind == "" {
+		item.IsCollection = atRoot
+	} else {
+		kind := lookupString(elem, fields.Kind)
+		for _, collectionKind := range fields.CollectionKinds {
+			if kind == collectionKind {
+				item.IsCollection = true
+			}
+		}
+	}
+
+	info := &std.MediaInfo{}
+	if ms, ok := lookupNumber(elem, fields.DurationMs); ok {
+		info.DurationMs = int64(ms)
+	}
+	if w, ok := lookupNumber(elem, fields.Width); ok {
+		info.Width = int32(w)
+	}
+	if h, ok := lookupNumber(elem, fields.Height); ok {
+		info.Height = int32(h)
+	}
+	if *info != (std.MediaInfo{}) {
+		item.Info = info
+	}
+	return item
+}
+
+// get requests the given URL (relative to BaseURL) and decodes its JSON response.
+func (cat *RESTCatalog) get(ctx context.Context, client *http.Client, relURL string) (any, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(cat.BaseURL, "/")+relURL, nil)
+	if err != nil {
+		return nil, amp.ErrCode_BadRequest.Wrap(err)
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, amp.ErrCode_ProviderErr.Wrap(err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized:
+		return nil, amp.ErrCode_AuthFailed.Errorf("mediasvc: GET %s: %s", req.URL.Path, resp.Status)
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, amp.ErrCellNotFound
+	case resp.StatusCode/100 != 2:
+		return nil, amp.ErrCode_ProviderErr.Errorf("mediasvc: GET %s: %s", req.URL.Path, resp.Status)
+	}
+	var doc any
+	if err = json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return nil, amp.ErrCode_ProviderErr.Errorf("mediasvc: GET %s: %v", req.URL.Path, err)
+	}
+	return doc, nil
+}
+
+// expandURL replaces each {name} in tmpl with its query escaped value.
+func expandURL(tmpl string, vars map[string]string) string {
+	for name, val := range vars {
+		tmpl = strings.ReplaceAll(tmpl, "{"+name+"}", url.QueryEscape(val))
+	}
+	return tmpl
+}
+
+// lookup returns the value at the given path within a decoded JSON value, or nil if absent.
+// An empty path returns nil (rather than the value itself) so that an unspecified field is absent.
+func lookup(val any, path string) any {
+	if path == "" {
+		return nil
+	}
+	for _, key := range strings.Split(path, ".") {
+		switch elem := val.(type) {
+		case map[string]any:
+			val = elem[key]
+		case []any:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(elem) {
+				return nil
+			}
+			val = elem[i]
+		default:
+			return nil
+		}
+	}
+	return val
+}
+
+func lookupString(val any, path string) string {
+	switch v := lookup(val, path).(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func lookupNumber(val any, path string) (float64, bool) {
+	switch v := lookup(val, path).(type) {
+	case float64:
+		return v, true
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
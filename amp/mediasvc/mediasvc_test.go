@@ -0,0 +1,145 @@
+package mediasvc
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp/std"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/blob"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+func TestRESTCatalog(t *testing.T) {
+	coverFetches, resolves := 0, 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch r.URL.Path {
+		case "/me/playlists":
+			fmt.Fprint(w, `{"items": [{"id": "P1", "name": "Mix", "images": [{"url": "/covers/p1.png"}]}]}`)
+		case "/playlists/P1/tracks":
+			if q.Get("limit") != "2" {
+				t.Errorf("unexpected limit: %v", q)
+			}
+			switch q.Get("token") {
+			case "":
+				fmt.Fprint(w, `{"total": 3, "next": "T2", "items": [
+					{"id": "A", "name": "One", "artist": {"name": "Ada"}, "duration_ms": 1000, "images": [{"url": "/covers/p1.png"}]},
+					{"id": "B", "name": "Two"}
+				]}`)
+			case "T2":
+				fmt.Fprint(w, `{"total": 3, "items": [{"id": "C", "name": "Three"}]}`)
+			default:
+				t.Errorf("unexpected token: %v", q)
+			}
+		case "/tracks/A/stream":
+			resolves++
+			fmt.Fprintf(w, `{"url": "https://cdn.example.com/a.mp3?sig=%d", "mime": "audio/mpeg", "expires_in": 1}`, resolves)
+		case "/covers/p1.png":
+			coverFetches++
+			w.Header().Set("Content-Type", "image/png")
+			w.Write([]byte("png"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	ctx, _ := task.Start(&task.Task{
+		Info: task.Info{Label: "mediasvc test"},
+	})
+	defer ctx.Close()
+
+	store, err := blob.NewDirStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := &Opts{
+		PageSize:      2,
+		RefreshMargin: time.Minute,
+		Catalog: &RESTCatalog{
+			BaseURL:       srv.URL,
+			RootURL:       "/me/playlists",
+			CollectionURL: "/playlists/{id}/tracks?limit={limit}&token={token}",
+			ItemsPath:     "items",
+			TotalPath:     "total",
+			NextTokenPath: "next",
+			Fields: ItemFields{
+				ID:         "id",
+				Title:      "name",
+				Subtitle:   "artist.name",
+				CoverURL:   "images.0.url",
+				DurationMs: "duration_ms",
+			},
+			ResolveURL:      "/tracks/{id}/stream",
+			PlayURLPath:     "url",
+			ContentTypePath: "mime",
+			ExpiresInPath:   "expires_in",
+		},
+	}
+	app := &appInst{
+		Opts:   opts,
+		tokens: make(map[string]string),
+		covers: newCoverCache(nil, store, srv.Client()),
+	}
+	client := srv.Client()
+
+	// the root's items are collections, with relative cover URLs resolved against the service's base URL
+	root := app.newCollectionCell(ctx, client, &Item{IsCollection: true}, 0, 2)
+	items, err := root.computeItems()
+	if err != nil {
+		t.Fatal(err)
+	}
+	playlist, ok := items[0].(*collectionCell)
+	if len(items) != 1 || !ok || playlist.collection.Title != "Mix" || root.window.Total != -1 {
+		t.Fatalf("unexpected root: %+v", items)
+	}
+	coverURL := srv.URL + "/covers/p1.png"
+	if playlist.collection.CoverURL != coverURL {
+		t.Fatalf("unexpected cover URL: %q", playlist.collection.CoverURL)
+	}
+
+	// the first window of a playlist, whose page token is remembered for the next window
+	items, err = app.newCollectionCell(ctx, client, playlist.collection, 0, 2).computeItems()
+	if err != nil {
+		t.Fatal(err)
+	}
+	track := items[0].(*playableCell)
+	if len(items) != 2 || track.item.Subtitle != "Ada" || track.item.Info.DurationMs != 1000 {
+		t.Fatalf("unexpected tracks: %+v", track.item)
+	}
+	next := app.newCollectionCell(ctx, client, playlist.collection, 2, 2)
+	if items, err = next.computeItems(); err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 1 || next.window.Offset != 2 || next.window.Count != 1 || next.window.Total != 3 {
+		t.Fatalf("unexpected window: %+v", next.window)
+	}
+
+	// a cover shared by several items is downloaded once, and thereafter served from the blob store
+	if ref, ok := app.covers.get(coverURL); !ok || ref.Inline == nil || coverFetches != 1 {
+		t.Fatalf("cover not cached: %+v (%d fetches)", ref, coverFetches)
+	}
+	app.covers.refs = make(map[string]std.AssetRef)
+	app.covers.prepare(ctx, []*Item{{CoverURL: coverURL}})
+	if _, ok := app.covers.get(coverURL); !ok || coverFetches != 1 {
+		t.Fatalf("cover should be served from the blob store, got %d fetches", coverFetches)
+	}
+
+	// a playable URL is resolved once, and again as it nears expiry
+	if err = track.resolve(); err != nil {
+		t.Fatal(err)
+	}
+	if err = track.resolve(); err != nil {
+		t.Fatal(err)
+	}
+	rev := track.resolved.Revision()
+	if resolves != 2 || track.playable.ContentType != "audio/mpeg" {
+		t.Fatalf("expected a refresh of a URL expiring within the margin, got %d resolves: %+v", resolves, track.playable)
+	}
+	if rev == 0 {
+		t.Fatal("expected a refresh to notify pins")
+	}
+}
@@ -0,0 +1,40 @@
+// Package replica mirrors cell subtrees between hosts, one-way or bidirectionally, so an edge host can serve a cached copy of another host's cells.
+//
+// Bidirectional replicas converge using a last-writer-wins (LWW) merge per element, where TxOp.EditID orders concurrent edits.
+package replica
+
+import (
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// Replica is a host's store of a cell subtree participating in replication.
+type Replica interface {
+
+	// Calls fn with each tx affecting the subtree rooted at rootID, in commit order, starting after the tx with the given GenesisID.
+	// If after is nil, all txs are read.
+	ReadSince(rootID tag.ID, after tag.ID, fn func(tx *amp.TxMsg) error) error
+
+	// Calls fn each time a tx affecting the subtree rooted at rootID is committed, until ctx closes.
+	WatchTxs(ctx task.Context, rootID tag.ID, fn func(tx *amp.TxMsg))
+
+	// Merges a tx replicated from a peer, retaining the tx's GenesisID.
+	// An implementation typically filters the tx through an LWW so that concurrent edits converge.
+	MergeTx(tx *amp.TxMsg) error
+}
+
+// Opts specifies a replicated subtree.
+type Opts struct {
+	Root          tag.ID  // root cell of the replicated subtree
+	Source        Replica // origin of replicated txs
+	Mirror        Replica // receives txs from Source
+	Bidirectional bool    // if set, txs committed to Mirror are also replicated to Source
+
+	// Resumes replication after the given txs (GenesisIDs), typically as last reported by OnProgress.
+	SourceCursor tag.ID
+	MirrorCursor tag.ID
+
+	// Optional: called after each tx is replicated so cursors can be persisted.
+	OnProgress func(fromSource bool, cursor tag.ID)
+}
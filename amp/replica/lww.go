@@ -0,0 +1,46 @@
+package replica
+
+import (
+	"sync"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+)
+
+// LWW is a last-writer-wins register per element -- concurrency safe.
+// An element's winning op is the op with the greatest EditID, so replicas merging the same ops in any order converge.
+type LWW struct {
+	mu    sync.Mutex
+	edits map[amp.ElementID]amp.TxOpID
+}
+
+// NewLWW returns an empty LWW.
+func NewLWW() *LWW {
+	return &LWW{
+		edits: make(map[amp.ElementID]amp.TxOpID),
+	}
+}
+
+// Merge returns a tx containing only the ops of the given tx that win over previously merged ops (or nil if none win).
+// The returned tx retains the given tx's envelope (e.g. GenesisID).
+func (lww *LWW) Merge(tx *amp.TxMsg) *amp.TxMsg {
+	lww.mu.Lock()
+	defer lww.mu.Unlock()
+
+	var merged *amp.TxMsg
+	for i := range tx.Ops {
+		op := tx.Ops[i]
+		elemID := amp.ElementID{op.CellID, op.AttrID, op.ItemID}
+		if prev, exists := lww.edits[elemID]; exists && op.EditID.CompareTo(prev.EditID) <= 0 {
+			continue
+		}
+		lww.edits[elemID] = op.TxOpID
+
+		if merged == nil {
+			merged = amp.NewTxMsg(false)
+			merged.TxEnvelope = tx.TxEnvelope
+			merged.OpCount = 0
+		}
+		merged.MarshalOpWithBuf(&op, tx.DataStore[op.DataOfs:op.DataOfs+op.DataLen])
+	}
+	return merged
+}
@@ -0,0 +1,138 @@
+package replica_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/amp/replica"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+var labelAttr = amp.AttrSpec.With("Tag.label").ID
+
+// memReplica is an in-memory Replica holding the latest value of each element.
+type memReplica struct {
+	mu       sync.Mutex
+	lww      *replica.LWW
+	txs      []*amp.TxMsg
+	values   map[tag.ID]string // ItemID => label
+	watchers []func(tx *amp.TxMsg)
+}
+
+func newMemReplica() *memReplica {
+	return &memReplica{
+		lww:    replica.NewLWW(),
+		values: make(map[tag.ID]string),
+	}
+}
+
+func (r *memReplica) ReadSince(rootID tag.ID, after tag.ID, fn func(tx *amp.TxMsg) error) error {
+	r.mu.Lock()
+	txs := append([]*amp.TxMsg(nil), r.txs...)
+	r.mu.Unlock()
+
+	reading := after.IsNil()
+	for _, tx := range txs {
+		if reading {
+			if err := fn(tx); err != nil {
+				return err
+			}
+		}
+		reading = reading || tx.GenesisID() == after
+	}
+	return nil
+}
+
+func (r *memReplica) WatchTxs(ctx task.Context, rootID tag.ID, fn func(tx *amp.TxMsg)) {
+	r.mu.Lock()
+	r.watchers = append(r.watchers, fn)
+	r.mu.Unlock()
+}
+
+func (r *memReplica) MergeTx(tx *amp.TxMsg) error {
+	if merged := r.lww.Merge(tx); merged != nil {
+		r.commit(merged)
+	}
+	return nil
+}
+
+// Local edit
+func (r *memReplica) set(cellID, itemID tag.ID, label string) {
+	tx := amp.NewTxMsg(true)
+	tx.Upsert(cellID, labelAttr, itemID, &amp.Tag{Text: label})
+	r.commit(r.lww.Merge(tx))
+}
+
+func (r *memReplica) commit(tx *amp.TxMsg) {
+	r.mu.Lock()
+	r.txs = append(r.txs, tx)
+	for _, op := range tx.Ops {
+		val := &amp.Tag{}
+		tx.LoadItem(op.AttrID, op.ItemID, val)
+		r.values[op.ItemID] = val.Text
+	}
+	watchers := append([]func(*amp.TxMsg){}, r.watchers...)
+	r.mu.Unlock()
+
+	for _, fn := range watchers {
+		fn(tx)
+	}
+}
+
+func (r *memReplica) get(itemID tag.ID) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.values[itemID]
+}
+
+func TestReplication(t *testing.T) {
+	host, _ := task.Start(&task.Task{Info: task.Info{Label: "host"}})
+	defer host.Close()
+
+	rootID := tag.Now()
+	itemA, itemB, shared := tag.Now(), tag.Now(), tag.Now()
+
+	studio, edge := newMemReplica(), newMemReplica()
+	studio.set(rootID, itemA, "before start")
+
+	_, err := replica.Start(host, replica.Opts{
+		Root:          rootID,
+		Source:        studio,
+		Mirror:        edge,
+		Bidirectional: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	studio.set(rootID, shared, "studio edit")
+	edge.set(rootID, itemB, "edge edit")
+	edge.set(rootID, shared, "later edge edit")
+
+	expect := map[tag.ID]string{
+		itemA:  "before start",
+		itemB:  "edge edit",
+		shared: "later edge edit",
+	}
+	deadline := time.Now().Add(time.Second)
+	for itemID, label := range expect {
+		for studio.get(itemID) != label || edge.get(itemID) != label {
+			if time.Now().After(deadline) {
+				t.Fatalf("replicas did not converge: studio=%q edge=%q, expected %q", studio.get(itemID), edge.get(itemID), label)
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	// no echoes
+	time.Sleep(10 * time.Millisecond)
+	studio.mu.Lock()
+	n := len(studio.txs)
+	studio.mu.Unlock()
+	if n != 4 {
+		t.Fatalf("expected 4 studio txs, got %d", n)
+	}
+}
@@ -0,0 +1,132 @@
+package replica
+
+import (
+	"sync"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// Start starts replicating the given subtree as a child of the given context until it closes.
+// Replication first catches up from each cursor and then follows live commits.
+func Start(parent task.Context, opts Opts) (task.Context, error) {
+	if opts.Root.IsNil() {
+		return nil, amp.ErrBadTarget
+	}
+
+	rep := &replicator{
+		opts:    opts,
+		relayed: newRecentIDs(4096),
+	}
+	return parent.StartChild(&task.Task{
+		Info: task.Info{
+			Label: "replica: " + opts.Root.Base32Suffix(),
+		},
+		OnRun: func(ctx task.Context) {
+			rep.follow(ctx, opts.Source, opts.Mirror, true, opts.SourceCursor)
+			if opts.Bidirectional {
+				rep.follow(ctx, opts.Mirror, opts.Source, false, opts.MirrorCursor)
+			}
+			<-ctx.Closing()
+		},
+	})
+}
+
+type replicator struct {
+	opts    Opts
+	relayed *recentIDs // GenesisIDs of txs merged into a replica, used to suppress echoes
+}
+
+// Replicates txs from src to dst, catching up from cursor and then following live commits.
+func (rep *replicator) follow(ctx task.Context, src, dst Replica, fromSource bool, cursor tag.ID) {
+	var mu sync.Mutex // serializes relays from this src
+	caughtUp := false
+	var backlog []*amp.TxMsg
+
+	// Watch first so no commit is missed between catching up and following
+	src.WatchTxs(ctx, rep.opts.Root, func(tx *amp.TxMsg) {
+		mu.Lock()
+		defer mu.Unlock()
+		if !caughtUp {
+			tx.AddRef()
+			backlog = append(backlog, tx)
+			return
+		}
+		rep.relay(ctx, tx, dst, fromSource)
+	})
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	seen := make(map[tag.ID]struct{})
+	err := src.ReadSince(rep.opts.Root, cursor, func(tx *amp.TxMsg) error {
+		seen[tx.GenesisID()] = struct{}{}
+		rep.relay(ctx, tx, dst, fromSource)
+		return nil
+	})
+	if err != nil {
+		ctx.Log().Warnf("catch up failed: %v", err)
+	}
+	for _, tx := range backlog {
+		if _, dupe := seen[tx.GenesisID()]; !dupe {
+			rep.relay(ctx, tx, dst, fromSource)
+		}
+		tx.ReleaseRef()
+	}
+	backlog = nil
+	caughtUp = true
+}
+
+func (rep *replicator) relay(ctx task.Context, tx *amp.TxMsg, dst Replica, fromSource bool) {
+	genesisID := tx.GenesisID()
+
+	// A tx this replicator merged into src is not sent back to where it came from
+	if rep.relayed.remove(genesisID) {
+		return
+	}
+	if rep.opts.Bidirectional {
+		rep.relayed.add(genesisID)
+	}
+	if err := dst.MergeTx(tx); err != nil {
+		ctx.Log().Warnf("merge failed: %v", err)
+		return
+	}
+	if rep.opts.OnProgress != nil {
+		rep.opts.OnProgress(fromSource, genesisID)
+	}
+}
+
+// recentIDs is a bounded set, evicting the oldest ID when full.
+type recentIDs struct {
+	mu    sync.Mutex
+	ids   map[tag.ID]struct{}
+	order []tag.ID
+	next  int
+}
+
+func newRecentIDs(capacity int) *recentIDs {
+	return &recentIDs{
+		ids:   make(map[tag.ID]struct{}, capacity),
+		order: make([]tag.ID, capacity),
+	}
+}
+
+func (r *recentIDs) add(id tag.ID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.ids, r.order[r.next])
+	r.order[r.next] = id
+	r.next = (r.next + 1) % len(r.order)
+	r.ids[id] = struct{}{}
+}
+
+func (r *recentIDs) remove(id tag.ID) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, exists := r.ids[id]
+	delete(r.ids, id)
+	return exists
+}
@@ -0,0 +1,107 @@
+package rpc
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+)
+
+// Connect opens a HostSession.Connect stream to the given base URL (e.g. "https://host:5192") and returns it as an amp.Transport.
+// The given client must speak HTTP/2 (e.g. an http.Client whose Transport has ForceAttemptHTTP2 set).
+func Connect(ctx context.Context, client *http.Client, baseURL string) (amp.Transport, error) {
+	pr, pw := io.Pipe()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(baseURL, "/")+ConnectMethod, pr)
+	if err != nil {
+		return nil, amp.ErrCode_InvalidURI.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/grpc+proto")
+	req.Header.Set("Te", "trailers")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		pw.Close()
+		return nil, amp.ErrCode_NotConnected.Wrap(err)
+	}
+	if resp.StatusCode != http.StatusOK || resp.ProtoMajor < 2 {
+		resp.Body.Close()
+		pw.Close()
+		return nil, amp.ErrCode_NotConnected.Errorf("HostSession.Connect failed: %s (%s)", resp.Status, resp.Proto)
+	}
+	if status := resp.Header.Get("Grpc-Status"); status != "" && status != "0" {
+		resp.Body.Close()
+		pw.Close()
+		return nil, amp.ErrCode_NotConnected.Errorf("HostSession.Connect failed: %s", resp.Header.Get("Grpc-Message"))
+	}
+
+	return &clientTransport{
+		label: "rpc " + baseURL,
+		resp:  resp,
+		pw:    pw,
+	}, nil
+}
+
+// clientTransport is the client side of a HostSession.Connect stream.
+type clientTransport struct {
+	label     string
+	resp      *http.Response
+	recvScrap []byte
+
+	sendMu    sync.Mutex
+	pw        *io.PipeWriter
+	sendScrap []byte
+
+	closeOnce sync.Once
+}
+
+func (t *clientTransport) Label() string {
+	return t.label
+}
+
+func (t *clientTransport) Close() error {
+	t.closeOnce.Do(func() {
+		t.pw.Close()
+		t.resp.Body.Close()
+	})
+	return nil
+}
+
+func (t *clientTransport) SendTx(tx *amp.TxMsg) error {
+	t.sendMu.Lock()
+	defer t.sendMu.Unlock()
+
+	if err := writeFrame(t.pw, FrameFromTx(tx), &t.sendScrap); err != nil {
+		return amp.ErrStreamClosed
+	}
+	return nil
+}
+
+// SendTxs writes the frames of all the given txs in a single write.
+func (t *clientTransport) SendTxs(txs []*amp.TxMsg) error {
+	t.sendMu.Lock()
+	defer t.sendMu.Unlock()
+
+	if err := writeFrames(t.pw, txs, &t.sendScrap); err != nil {
+		return amp.ErrStreamClosed
+	}
+	return nil
+}
+
+// RecvTx returns ErrStreamClosed once the host closes the stream normally, or the error status the host reported.
+func (t *clientTransport) RecvTx() (*amp.TxMsg, error) {
+	frame, err := readFrame(t.resp.Body, &t.recvScrap)
+	if err == nil {
+		return frame.ToTx(), nil
+	}
+	if err == io.EOF {
+		if status := t.resp.Trailer.Get("Grpc-Status"); status != "" && status != "0" {
+			return nil, amp.ErrCode_NotConnected.Errorf("host closed session (status %s): %s", status, t.resp.Trailer.Get("Grpc-Message"))
+		}
+	} else if _, isAmpErr := err.(*amp.Err); isAmpErr {
+		return nil, err
+	}
+	return nil, amp.ErrStreamClosed
+}
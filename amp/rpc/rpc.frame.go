@@ -0,0 +1,144 @@
+package rpc
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+const (
+	// ConnectMethod is the HTTP/2 path of HostSession.Connect -- see rpc.proto
+	ConnectMethod = "/amp.rpc.HostSession/Connect"
+
+	// MaxFrameSize is the largest TxFrame accepted, in bytes.
+	MaxFrameSize = 16 << 20
+)
+
+// FrameFromTx returns the TxFrame form of the given tx.
+func FrameFromTx(tx *amp.TxMsg) *TxFrame {
+	frame := &TxFrame{
+		Envelope: &amp.TxEnvelope{},
+		Ops:      make([]*TxFrameOp, len(tx.Ops)),
+	}
+	*frame.Envelope = tx.TxEnvelope
+	frame.Envelope.OpCount = uint64(len(tx.Ops))
+
+	for i, op := range tx.Ops {
+		frame.Ops[i] = &TxFrameOp{
+			OpCode:   op.OpCode,
+			CellID_0: op.CellID[0],
+			CellID_1: op.CellID[1],
+			CellID_2: op.CellID[2],
+			AttrID_0: op.AttrID[0],
+			AttrID_1: op.AttrID[1],
+			AttrID_2: op.AttrID[2],
+			ItemID_0: op.ItemID[0],
+			ItemID_1: op.ItemID[1],
+			ItemID_2: op.ItemID[2],
+			EditID_0: op.EditID[0],
+			EditID_1: op.EditID[1],
+			EditID_2: op.EditID[2],
+			Value:    tx.DataStore[op.DataOfs : op.DataOfs+op.DataLen],
+		}
+	}
+	return frame
+}
+
+// ToTx returns a new TxMsg (with one reference) from this TxFrame.
+func (frame *TxFrame) ToTx() *amp.TxMsg {
+	tx := amp.NewTxMsg(false)
+	if frame.Envelope != nil {
+		tx.TxEnvelope = *frame.Envelope
+	}
+	tx.OpCount = 0
+	for _, fi := range frame.Ops {
+		op := amp.TxOp{
+			OpCode: fi.OpCode,
+		}
+		op.CellID = tag.ID{fi.CellID_0, fi.CellID_1, fi.CellID_2}
+		op.AttrID = tag.ID{fi.AttrID_0, fi.AttrID_1, fi.AttrID_2}
+		op.ItemID = tag.ID{fi.ItemID_0, fi.ItemID_1, fi.ItemID_2}
+		op.EditID = tag.ID{fi.EditID_0, fi.EditID_1, fi.EditID_2}
+		tx.MarshalOpWithBuf(&op, fi.Value)
+	}
+	return tx
+}
+
+// writeFrame writes a length-prefixed gRPC message (uncompressed).
+func writeFrame(w io.Writer, frame *TxFrame, scrap *[]byte) error {
+	buf, err := appendFrame((*scrap)[:0], frame)
+	if err != nil {
+		return err
+	}
+	*scrap = buf
+	_, err = w.Write(buf)
+	return err
+}
+
+// writeFrames writes a length-prefixed gRPC message for each tx in a single write.
+func writeFrames(w io.Writer, txs []*amp.TxMsg, scrap *[]byte) error {
+	buf := (*scrap)[:0]
+	for _, tx := range txs {
+		var err error
+		if buf, err = appendFrame(buf, FrameFromTx(tx)); err != nil {
+			return err
+		}
+	}
+	*scrap = buf
+	_, err := w.Write(buf)
+	return err
+}
+
+// appendFrame appends a length-prefixed gRPC message (uncompressed) to dst.
+func appendFrame(dst []byte, frame *TxFrame) ([]byte, error) {
+	size := frame.Size()
+	start := len(dst)
+	if cap(dst) < start+5+size {
+		grown := make([]byte, start, start+5+size)
+		copy(grown, dst)
+		dst = grown
+	}
+	dst = dst[:start+5+size]
+	dst[start] = 0 // not compressed
+	binary.BigEndian.PutUint32(dst[start+1:start+5], uint32(size))
+	if _, err := frame.MarshalToSizedBuffer(dst[start+5:]); err != nil {
+		return dst[:start], err
+	}
+	return dst, nil
+}
+
+// readFrame reads a length-prefixed gRPC message, returning io.EOF at the end of the stream.
+func readFrame(r io.Reader, scrap *[]byte) (*TxFrame, error) {
+	var prefix [5]byte
+	if _, err := io.ReadFull(r, prefix[:]); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return nil, amp.ErrMalformedTx
+		}
+		return nil, err
+	}
+	if prefix[0] != 0 {
+		return nil, amp.ErrCode_MalformedTx.Error("compressed frames are not supported")
+	}
+	size := binary.BigEndian.Uint32(prefix[1:5])
+	if size > MaxFrameSize {
+		return nil, amp.ErrCode_MalformedTx.Errorf("frame size %d exceeds limit", size)
+	}
+
+	buf := *scrap
+	if cap(buf) < int(size) {
+		buf = make([]byte, size)
+	}
+	buf = buf[:size]
+	*scrap = buf
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, amp.ErrMalformedTx
+	}
+
+	frame := &TxFrame{}
+	if err := frame.Unmarshal(buf); err != nil {
+		return nil, amp.ErrMalformedTx
+	}
+	return frame, nil
+}
@@ -0,0 +1,1137 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: amp/rpc/rpc.proto
+
+// package rpc is the canonical gRPC form of the amp session protocol.
+//
+// A client opens a HostSession.Connect stream and exchanges TxFrames exactly as it would TxMsgs over any other amp.Transport:
+// the first tx sent is typically a Login, followed by PinRequests, commits, and so on.
+// Clients in any language can be generated from this file (and amp/amp.proto) with protoc and the gRPC plugin for that language.
+
+package rpc
+
+import (
+	bytes "bytes"
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	amp "github.com/art-media-platform/amp-sdk-go/amp"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TxFrame is a TxMsg (see amp.TxEnvelope) as sent over a HostSession.
+type TxFrame struct {
+	// tx status, routing, and context -- OpCount is ignored in favor of len(Ops)
+	Envelope *amp.TxEnvelope `protobuf:"bytes,1,opt,name=Envelope,proto3" json:"Envelope,omitempty"`
+	// operations in this tx
+	Ops []*TxFrameOp `protobuf:"bytes,2,rep,name=Ops,proto3" json:"Ops,omitempty"`
+}
+
+func (m *TxFrame) Reset()      { *m = TxFrame{} }
+func (*TxFrame) ProtoMessage() {}
+func (*TxFrame) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8559953fba7eb251, []int{0}
+}
+func (m *TxFrame) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxFrame) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxFrame.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxFrame) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxFrame.Merge(m, src)
+}
+func (m *TxFrame) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxFrame) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxFrame.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxFrame proto.InternalMessageInfo
+
+func (m *TxFrame) GetEnvelope() *amp.TxEnvelope {
+	if m != nil {
+		return m.Envelope
+	}
+	return nil
+}
+
+func (m *TxFrame) GetOps() []*TxFrameOp {
+	if m != nil {
+		return m.Ops
+	}
+	return nil
+}
+
+// TxFrameOp is a single TxOp within a TxFrame.
+type TxFrameOp struct {
+	OpCode   amp.TxOpCode `protobuf:"varint,1,opt,name=OpCode,proto3,enum=amp.TxOpCode" json:"OpCode,omitempty"`
+	CellID_0 uint64       `protobuf:"fixed64,2,opt,name=CellID_0,json=CellID0,proto3" json:"CellID_0,omitempty"`
+	CellID_1 uint64       `protobuf:"fixed64,3,opt,name=CellID_1,json=CellID1,proto3" json:"CellID_1,omitempty"`
+	CellID_2 uint64       `protobuf:"fixed64,4,opt,name=CellID_2,json=CellID2,proto3" json:"CellID_2,omitempty"`
+	AttrID_0 uint64       `protobuf:"fixed64,5,opt,name=AttrID_0,json=AttrID0,proto3" json:"AttrID_0,omitempty"`
+	AttrID_1 uint64       `protobuf:"fixed64,6,opt,name=AttrID_1,json=AttrID1,proto3" json:"AttrID_1,omitempty"`
+	AttrID_2 uint64       `protobuf:"fixed64,7,opt,name=AttrID_2,json=AttrID2,proto3" json:"AttrID_2,omitempty"`
+	ItemID_0 uint64       `protobuf:"fixed64,8,opt,name=ItemID_0,json=ItemID0,proto3" json:"ItemID_0,omitempty"`
+	ItemID_1 uint64       `protobuf:"fixed64,9,opt,name=ItemID_1,json=ItemID1,proto3" json:"ItemID_1,omitempty"`
+	ItemID_2 uint64       `protobuf:"fixed64,10,opt,name=ItemID_2,json=ItemID2,proto3" json:"ItemID_2,omitempty"`
+	EditID_0 uint64       `protobuf:"fixed64,11,opt,name=EditID_0,json=EditID0,proto3" json:"EditID_0,omitempty"`
+	EditID_1 uint64       `protobuf:"fixed64,12,opt,name=EditID_1,json=EditID1,proto3" json:"EditID_1,omitempty"`
+	EditID_2 uint64       `protobuf:"fixed64,13,opt,name=EditID_2,json=EditID2,proto3" json:"EditID_2,omitempty"`
+	// the element value: the protobuf serialization of the attr's registered type (or empty for a delete)
+	Value []byte `protobuf:"bytes,16,opt,name=Value,proto3" json:"Value,omitempty"`
+}
+
+func (m *TxFrameOp) Reset()      { *m = TxFrameOp{} }
+func (*TxFrameOp) ProtoMessage() {}
+func (*TxFrameOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8559953fba7eb251, []int{1}
+}
+func (m *TxFrameOp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxFrameOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxFrameOp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxFrameOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxFrameOp.Merge(m, src)
+}
+func (m *TxFrameOp) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxFrameOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxFrameOp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxFrameOp proto.InternalMessageInfo
+
+func (m *TxFrameOp) GetOpCode() amp.TxOpCode {
+	if m != nil {
+		return m.OpCode
+	}
+	return amp.TxOpCode_Nil
+}
+
+func (m *TxFrameOp) GetCellID_0() uint64 {
+	if m != nil {
+		return m.CellID_0
+	}
+	return 0
+}
+
+func (m *TxFrameOp) GetCellID_1() uint64 {
+	if m != nil {
+		return m.CellID_1
+	}
+	return 0
+}
+
+func (m *TxFrameOp) GetCellID_2() uint64 {
+	if m != nil {
+		return m.CellID_2
+	}
+	return 0
+}
+
+func (m *TxFrameOp) GetAttrID_0() uint64 {
+	if m != nil {
+		return m.AttrID_0
+	}
+	return 0
+}
+
+func (m *TxFrameOp) GetAttrID_1() uint64 {
+	if m != nil {
+		return m.AttrID_1
+	}
+	return 0
+}
+
+func (m *TxFrameOp) GetAttrID_2() uint64 {
+	if m != nil {
+		return m.AttrID_2
+	}
+	return 0
+}
+
+func (m *TxFrameOp) GetItemID_0() uint64 {
+	if m != nil {
+		return m.ItemID_0
+	}
+	return 0
+}
+
+func (m *TxFrameOp) GetItemID_1() uint64 {
+	if m != nil {
+		return m.ItemID_1
+	}
+	return 0
+}
+
+func (m *TxFrameOp) GetItemID_2() uint64 {
+	if m != nil {
+		return m.ItemID_2
+	}
+	return 0
+}
+
+func (m *TxFrameOp) GetEditID_0() uint64 {
+	if m != nil {
+		return m.EditID_0
+	}
+	return 0
+}
+
+func (m *TxFrameOp) GetEditID_1() uint64 {
+	if m != nil {
+		return m.EditID_1
+	}
+	return 0
+}
+
+func (m *TxFrameOp) GetEditID_2() uint64 {
+	if m != nil {
+		return m.EditID_2
+	}
+	return 0
+}
+
+func (m *TxFrameOp) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*TxFrame)(nil), "amp.rpc.TxFrame")
+	proto.RegisterType((*TxFrameOp)(nil), "amp.rpc.TxFrameOp")
+}
+
+func init() { proto.RegisterFile("amp/rpc/rpc.proto", fileDescriptor_8559953fba7eb251) }
+
+var fileDescriptor_8559953fba7eb251 = []byte{
+	// 424 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0xd2, 0xb1, 0x6e, 0xd4, 0x30,
+	0x1c, 0x06, 0xf0, 0xf8, 0x8e, 0x5e, 0xae, 0xbe, 0x1e, 0x14, 0x8b, 0xc1, 0x74, 0xb0, 0xa2, 0x0a,
+	0xa4, 0x48, 0x28, 0xb9, 0xd8, 0xbc, 0x00, 0xe5, 0x28, 0xa2, 0x03, 0x3a, 0x14, 0x2a, 0x06, 0x84,
+	0x84, 0xd2, 0xc4, 0x94, 0x88, 0x24, 0xb6, 0x12, 0x17, 0x75, 0xe4, 0x01, 0x18, 0x78, 0x0c, 0xc4,
+	0x93, 0x30, 0xde, 0xd8, 0x91, 0xcb, 0x2d, 0x8c, 0x7d, 0x04, 0x94, 0x38, 0x54, 0x7f, 0xc1, 0x90,
+	0xe1, 0xfb, 0x7e, 0xd6, 0xb7, 0xe4, 0x8f, 0xef, 0x26, 0xa5, 0x5e, 0xd4, 0x3a, 0xed, 0xbe, 0x50,
+	0xd7, 0xca, 0x28, 0xe2, 0x26, 0xa5, 0x0e, 0x6b, 0x9d, 0x1e, 0xcc, 0x3b, 0xeb, 0x42, 0xdf, 0x1f,
+	0xbe, 0xc3, 0xee, 0xe9, 0xe5, 0xf3, 0x3a, 0x29, 0x25, 0x79, 0x84, 0xa7, 0xc7, 0xd5, 0x67, 0x59,
+	0x28, 0x2d, 0x29, 0xf2, 0x90, 0x3f, 0x13, 0x77, 0xc2, 0xee, 0xe1, 0xe9, 0xe5, 0xdf, 0x3a, 0xbe,
+	0x79, 0x40, 0x1e, 0xe0, 0xf1, 0x4a, 0x37, 0x74, 0xe4, 0x8d, 0xfd, 0x99, 0x20, 0xe1, 0xb0, 0x1e,
+	0x0e, 0x5b, 0x2b, 0x1d, 0x77, 0x7c, 0xf8, 0x75, 0x8c, 0x77, 0x6f, 0x2a, 0xf2, 0x10, 0x4f, 0x56,
+	0x7a, 0xa9, 0x32, 0x3b, 0x7f, 0x5b, 0xcc, 0x87, 0x79, 0x5b, 0xc6, 0x03, 0x92, 0xfb, 0x78, 0xba,
+	0x94, 0x45, 0x71, 0xf2, 0xec, 0x7d, 0x44, 0x47, 0x1e, 0xf2, 0x27, 0xb1, 0x6b, 0x73, 0x04, 0x88,
+	0xd3, 0x31, 0x24, 0x0e, 0x48, 0xd0, 0x5b, 0x90, 0x44, 0x47, 0x47, 0xc6, 0xd4, 0xfd, 0xe0, 0x8e,
+	0x25, 0x9b, 0x23, 0x40, 0x9c, 0x4e, 0x20, 0x71, 0x40, 0x82, 0xba, 0x90, 0xfa, 0xc1, 0x13, 0x23,
+	0xcb, 0x7e, 0x70, 0x6a, 0xc9, 0xe6, 0x08, 0x10, 0xa7, 0xbb, 0x90, 0x38, 0x20, 0x41, 0x31, 0xa4,
+	0x7e, 0xf0, 0x38, 0xcb, 0x4d, 0x3f, 0x38, 0xb3, 0x64, 0x73, 0x04, 0x88, 0xd3, 0x3d, 0x48, 0x1c,
+	0x90, 0xa0, 0x73, 0x48, 0x82, 0xdc, 0xc3, 0x3b, 0x6f, 0x92, 0xe2, 0x42, 0xd2, 0x7d, 0x0f, 0xf9,
+	0x7b, 0xb1, 0x0d, 0xe2, 0x09, 0x9e, 0xbd, 0x50, 0x8d, 0x79, 0x2d, 0x9b, 0x26, 0x57, 0x15, 0xe1,
+	0xd8, 0x5d, 0xaa, 0xaa, 0x92, 0xa9, 0x21, 0xfb, 0xff, 0xfe, 0xc1, 0x83, 0xff, 0x1a, 0x1f, 0x45,
+	0xe8, 0xa9, 0x5e, 0x6f, 0x98, 0x73, 0xb5, 0x61, 0xce, 0xf5, 0x86, 0xa1, 0x2f, 0x2d, 0x43, 0xdf,
+	0x5b, 0x86, 0x7e, 0xb6, 0x0c, 0xad, 0x5b, 0x86, 0x7e, 0xb5, 0x0c, 0xfd, 0x6e, 0x99, 0x73, 0xdd,
+	0x32, 0xf4, 0x6d, 0xcb, 0x9c, 0xf5, 0x96, 0x39, 0x57, 0x5b, 0xe6, 0xbc, 0x8d, 0xce, 0x73, 0xf3,
+	0xf1, 0xe2, 0x2c, 0x4c, 0x55, 0xb9, 0x48, 0x6a, 0x13, 0x94, 0x32, 0xcb, 0x93, 0x40, 0x17, 0x89,
+	0xf9, 0xa0, 0xea, 0xb2, 0xbb, 0xc6, 0xa0, 0xc9, 0x3e, 0x05, 0xe7, 0x6a, 0x31, 0x1c, 0xee, 0x8f,
+	0x91, 0x7b, 0xf4, 0xf2, 0x55, 0x18, 0xeb, 0xf4, 0x6c, 0xd2, 0xdf, 0xe9, 0xe3, 0x3f, 0x03, 0x00,
+	0x53, 0xb7, 0x48, 0x01, 0xd4, 0x02, 0x00, 0x00,
+}
+
+func (this *TxFrame) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TxFrame)
+	if !ok {
+		that2, ok := that.(TxFrame)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Envelope.Equal(that1.Envelope) {
+		return false
+	}
+	if len(this.Ops) != len(that1.Ops) {
+		return false
+	}
+	for i := range this.Ops {
+		if !this.Ops[i].Equal(that1.Ops[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *TxFrameOp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TxFrameOp)
+	if !ok {
+		that2, ok := that.(TxFrameOp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.OpCode != that1.OpCode {
+		return false
+	}
+	if this.CellID_0 != that1.CellID_0 {
+		return false
+	}
+	if this.CellID_1 != that1.CellID_1 {
+		return false
+	}
+	if this.CellID_2 != that1.CellID_2 {
+		return false
+	}
+	if this.AttrID_0 != that1.AttrID_0 {
+		return false
+	}
+	if this.AttrID_1 != that1.AttrID_1 {
+		return false
+	}
+	if this.AttrID_2 != that1.AttrID_2 {
+		return false
+	}
+	if this.ItemID_0 != that1.ItemID_0 {
+		return false
+	}
+	if this.ItemID_1 != that1.ItemID_1 {
+		return false
+	}
+	if this.ItemID_2 != that1.ItemID_2 {
+		return false
+	}
+	if this.EditID_0 != that1.EditID_0 {
+		return false
+	}
+	if this.EditID_1 != that1.EditID_1 {
+		return false
+	}
+	if this.EditID_2 != that1.EditID_2 {
+		return false
+	}
+	if !bytes.Equal(this.Value, that1.Value) {
+		return false
+	}
+	return true
+}
+func (this *TxFrame) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&rpc.TxFrame{")
+	if this.Envelope != nil {
+		s = append(s, "Envelope: "+fmt.Sprintf("%#v", this.Envelope)+",\n")
+	}
+	if this.Ops != nil {
+		s = append(s, "Ops: "+fmt.Sprintf("%#v", this.Ops)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TxFrameOp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 18)
+	s = append(s, "&rpc.TxFrameOp{")
+	s = append(s, "OpCode: "+fmt.Sprintf("%#v", this.OpCode)+",\n")
+	s = append(s, "CellID_0: "+fmt.Sprintf("%#v", this.CellID_0)+",\n")
+	s = append(s, "CellID_1: "+fmt.Sprintf("%#v", this.CellID_1)+",\n")
+	s = append(s, "CellID_2: "+fmt.Sprintf("%#v", this.CellID_2)+",\n")
+	s = append(s, "AttrID_0: "+fmt.Sprintf("%#v", this.AttrID_0)+",\n")
+	s = append(s, "AttrID_1: "+fmt.Sprintf("%#v", this.AttrID_1)+",\n")
+	s = append(s, "AttrID_2: "+fmt.Sprintf("%#v", this.AttrID_2)+",\n")
+	s = append(s, "ItemID_0: "+fmt.Sprintf("%#v", this.ItemID_0)+",\n")
+	s = append(s, "ItemID_1: "+fmt.Sprintf("%#v", this.ItemID_1)+",\n")
+	s = append(s, "ItemID_2: "+fmt.Sprintf("%#v", this.ItemID_2)+",\n")
+	s = append(s, "EditID_0: "+fmt.Sprintf("%#v", this.EditID_0)+",\n")
+	s = append(s, "EditID_1: "+fmt.Sprintf("%#v", this.EditID_1)+",\n")
+	s = append(s, "EditID_2: "+fmt.Sprintf("%#v", this.EditID_2)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringRpc(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *TxFrame) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxFrame) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxFrame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ops) > 0 {
+		for iNdEx := len(m.Ops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Envelope != nil {
+		{
+			size, err := m.Envelope.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxFrameOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxFrameOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxFrameOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintRpc(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.EditID_2 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.EditID_2))
+		i--
+		dAtA[i] = 0x69
+	}
+	if m.EditID_1 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.EditID_1))
+		i--
+		dAtA[i] = 0x61
+	}
+	if m.EditID_0 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.EditID_0))
+		i--
+		dAtA[i] = 0x59
+	}
+	if m.ItemID_2 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.ItemID_2))
+		i--
+		dAtA[i] = 0x51
+	}
+	if m.ItemID_1 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.ItemID_1))
+		i--
+		dAtA[i] = 0x49
+	}
+	if m.ItemID_0 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.ItemID_0))
+		i--
+		dAtA[i] = 0x41
+	}
+	if m.AttrID_2 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.AttrID_2))
+		i--
+		dAtA[i] = 0x39
+	}
+	if m.AttrID_1 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.AttrID_1))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.AttrID_0 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.AttrID_0))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.CellID_2 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.CellID_2))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.CellID_1 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.CellID_1))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.CellID_0 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.CellID_0))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.OpCode != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.OpCode))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRpc(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRpc(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TxFrame) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Envelope != nil {
+		l = m.Envelope.Size()
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	if len(m.Ops) > 0 {
+		for _, e := range m.Ops {
+			l = e.Size()
+			n += 1 + l + sovRpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxFrameOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OpCode != 0 {
+		n += 1 + sovRpc(uint64(m.OpCode))
+	}
+	if m.CellID_0 != 0 {
+		n += 9
+	}
+	if m.CellID_1 != 0 {
+		n += 9
+	}
+	if m.CellID_2 != 0 {
+		n += 9
+	}
+	if m.AttrID_0 != 0 {
+		n += 9
+	}
+	if m.AttrID_1 != 0 {
+		n += 9
+	}
+	if m.AttrID_2 != 0 {
+		n += 9
+	}
+	if m.ItemID_0 != 0 {
+		n += 9
+	}
+	if m.ItemID_1 != 0 {
+		n += 9
+	}
+	if m.ItemID_2 != 0 {
+		n += 9
+	}
+	if m.EditID_0 != 0 {
+		n += 9
+	}
+	if m.EditID_1 != 0 {
+		n += 9
+	}
+	if m.EditID_2 != 0 {
+		n += 9
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 2 + l + sovRpc(uint64(l))
+	}
+	return n
+}
+
+func sovRpc(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRpc(x uint64) (n int) {
+	return sovRpc(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *TxFrame) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForOps := "[]*TxFrameOp{"
+	for _, f := range this.Ops {
+		repeatedStringForOps += strings.Replace(f.String(), "TxFrameOp", "TxFrameOp", 1) + ","
+	}
+	repeatedStringForOps += "}"
+	s := strings.Join([]string{`&TxFrame{`,
+		`Envelope:` + strings.Replace(fmt.Sprintf("%v", this.Envelope), "TxEnvelope", "amp.TxEnvelope", 1) + `,`,
+		`Ops:` + repeatedStringForOps + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TxFrameOp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TxFrameOp{`,
+		`OpCode:` + fmt.Sprintf("%v", this.OpCode) + `,`,
+		`CellID_0:` + fmt.Sprintf("%v", this.CellID_0) + `,`,
+		`CellID_1:` + fmt.Sprintf("%v", this.CellID_1) + `,`,
+		`CellID_2:` + fmt.Sprintf("%v", this.CellID_2) + `,`,
+		`AttrID_0:` + fmt.Sprintf("%v", this.AttrID_0) + `,`,
+		`AttrID_1:` + fmt.Sprintf("%v", this.AttrID_1) + `,`,
+		`AttrID_2:` + fmt.Sprintf("%v", this.AttrID_2) + `,`,
+		`ItemID_0:` + fmt.Sprintf("%v", this.ItemID_0) + `,`,
+		`ItemID_1:` + fmt.Sprintf("%v", this.ItemID_1) + `,`,
+		`ItemID_2:` + fmt.Sprintf("%v", this.ItemID_2) + `,`,
+		`EditID_0:` + fmt.Sprintf("%v", this.EditID_0) + `,`,
+		`EditID_1:` + fmt.Sprintf("%v", this.EditID_1) + `,`,
+		`EditID_2:` + fmt.Sprintf("%v", this.EditID_2) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringRpc(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *TxFrame) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxFrame: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxFrame: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Envelope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Envelope == nil {
+				m.Envelope = &amp.TxEnvelope{}
+			}
+			if err := m.Envelope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ops = append(m.Ops, &TxFrameOp{})
+			if err := m.Ops[len(m.Ops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxFrameOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxFrameOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxFrameOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpCode", wireType)
+			}
+			m.OpCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpCode |= amp.TxOpCode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellID_0", wireType)
+			}
+			m.CellID_0 = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellID_0 = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellID_1", wireType)
+			}
+			m.CellID_1 = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellID_1 = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellID_2", wireType)
+			}
+			m.CellID_2 = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellID_2 = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttrID_0", wireType)
+			}
+			m.AttrID_0 = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttrID_0 = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttrID_1", wireType)
+			}
+			m.AttrID_1 = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttrID_1 = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttrID_2", wireType)
+			}
+			m.AttrID_2 = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttrID_2 = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemID_0", wireType)
+			}
+			m.ItemID_0 = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ItemID_0 = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 9:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemID_1", wireType)
+			}
+			m.ItemID_1 = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ItemID_1 = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 10:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemID_2", wireType)
+			}
+			m.ItemID_2 = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ItemID_2 = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 11:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EditID_0", wireType)
+			}
+			m.EditID_0 = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EditID_0 = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 12:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EditID_1", wireType)
+			}
+			m.EditID_1 = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EditID_1 = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 13:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EditID_2", wireType)
+			}
+			m.EditID_2 = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EditID_2 = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRpc(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRpc
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRpc
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRpc
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRpc        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRpc          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRpc = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,207 @@
+package rpc
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/log"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// Service is an amp.HostService serving HostSession (see rpc.proto) as an http.Handler.
+//
+// gRPC requires HTTP/2, so serve it over TLS (where net/http negotiates HTTP/2 automatically) or behind an h2c handler.
+type Service struct {
+	task.Context
+	host amp.Host
+	wg   sync.WaitGroup // in-flight sessions
+}
+
+var (
+	_ amp.HostService = (*Service)(nil)
+	_ http.Handler    = (*Service)(nil)
+)
+
+func NewService() *Service {
+	return &Service{}
+}
+
+func (svc *Service) StartService(on amp.Host) error {
+	ctx, err := on.StartChild(&task.Task{
+		Info: task.Info{
+			Label:     "rpc",
+			LogFields: log.Fields{log.FieldSubsystem: amp.SubsystemTransport},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	svc.Context = ctx
+	svc.host = on
+	return nil
+}
+
+// GracefulStop blocks until open sessions close.
+func (svc *Service) GracefulStop() {
+	svc.wg.Wait()
+}
+
+// gRPC status codes -- see https://grpc.github.io/grpc/core/md_doc_statuscodes.html
+const (
+	codeOK              = 0
+	codeInvalidArgument = 3
+	codeUnimplemented   = 12
+	codeInternal        = 13
+	codeUnavailable     = 14
+)
+
+func (svc *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || !strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+		http.Error(w, "expected a gRPC request", http.StatusUnsupportedMediaType)
+		return
+	}
+	w.Header().Set("Content-Type", "application/grpc+proto")
+	w.Header().Set("Trailer", "Grpc-Status, Grpc-Message")
+
+	if r.URL.Path != ConnectMethod {
+		setStatus(w, codeUnimplemented, "unknown method "+r.URL.Path)
+		return
+	}
+	if r.ProtoMajor < 2 {
+		setStatus(w, codeUnimplemented, "gRPC requires HTTP/2")
+		return
+	}
+	if svc.host == nil {
+		setStatus(w, codeUnavailable, "rpc service not started")
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		setStatus(w, codeInternal, "response writer can't flush")
+		return
+	}
+	svc.wg.Add(1)
+	defer svc.wg.Done()
+
+	w.WriteHeader(http.StatusOK)
+	via := &serverTransport{
+		label:   "rpc " + r.RemoteAddr,
+		body:    r.Body,
+		w:       w,
+		flusher: flusher,
+		closing: make(chan struct{}),
+	}
+	via.flusher.Flush()
+
+	sess, err := svc.host.StartNewSession(svc, via)
+	if err != nil {
+		setStatus(w, codeUnavailable, err.Error())
+		return
+	}
+
+	select {
+	case <-via.closing:
+	case <-r.Context().Done():
+	case <-svc.Closing():
+	}
+	sess.Close()
+	via.Close()
+
+	// the body may not be read once this handler returns, so unblock any RecvTx in progress and wait for the session to finish
+	r.Body.Close()
+	<-sess.Done()
+
+	code, msg := codeOK, ""
+	if err, _ := via.recvErr.Load().(error); err != nil {
+		code, msg = codeInvalidArgument, err.Error()
+	}
+	via.sendMu.Lock()
+	setStatus(w, code, msg)
+	via.sendMu.Unlock()
+}
+
+func setStatus(w http.ResponseWriter, code int, msg string) {
+	w.Header().Set("Grpc-Status", strconv.Itoa(code))
+	if msg != "" {
+		w.Header().Set("Grpc-Message", msg)
+	}
+}
+
+// serverTransport is the amp.Transport for a single HostSession.Connect stream.
+type serverTransport struct {
+	label     string
+	body      io.Reader
+	recvScrap []byte
+	recvErr   atomic.Value // error set if the client sent a malformed frame
+
+	sendMu    sync.Mutex
+	w         io.Writer
+	flusher   http.Flusher
+	sendScrap []byte
+	closed    bool
+
+	closing   chan struct{}
+	closeOnce sync.Once
+}
+
+func (t *serverTransport) Label() string {
+	return t.label
+}
+
+func (t *serverTransport) Close() error {
+	t.closeOnce.Do(func() {
+		t.sendMu.Lock()
+		t.closed = true
+		t.sendMu.Unlock()
+		close(t.closing)
+	})
+	return nil
+}
+
+func (t *serverTransport) SendTx(tx *amp.TxMsg) error {
+	t.sendMu.Lock()
+	defer t.sendMu.Unlock()
+
+	if t.closed {
+		return amp.ErrStreamClosed
+	}
+	if err := writeFrame(t.w, FrameFromTx(tx), &t.sendScrap); err != nil {
+		return amp.ErrStreamClosed
+	}
+	t.flusher.Flush()
+	return nil
+}
+
+// SendTxs writes the frames of all the given txs and flushes once.
+func (t *serverTransport) SendTxs(txs []*amp.TxMsg) error {
+	t.sendMu.Lock()
+	defer t.sendMu.Unlock()
+
+	if t.closed {
+		return amp.ErrStreamClosed
+	}
+	if err := writeFrames(t.w, txs, &t.sendScrap); err != nil {
+		return amp.ErrStreamClosed
+	}
+	t.flusher.Flush()
+	return nil
+}
+
+func (t *serverTransport) RecvTx() (*amp.TxMsg, error) {
+	frame, err := readFrame(t.body, &t.recvScrap)
+	if err != nil {
+		if err != io.EOF {
+			if _, isAmpErr := err.(*amp.Err); isAmpErr {
+				t.recvErr.Store(err)
+			}
+		}
+		t.Close()
+		return nil, amp.ErrStreamClosed
+	}
+	return frame.ToTx(), nil
+}
@@ -0,0 +1,123 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// echoHost starts sessions that send back each tx received, marked as synced.
+type echoHost struct {
+	amp.Host
+	ctx task.Context
+}
+
+type echoSession struct {
+	amp.Session
+	ctx task.Context
+}
+
+func (host *echoHost) StartChild(task *task.Task) (task.Context, error) {
+	return host.ctx.StartChild(task)
+}
+
+func (host *echoHost) StartNewSession(parent amp.HostService, via amp.Transport) (amp.Session, error) {
+	ctx, err := host.ctx.StartChild(&task.Task{
+		Info: task.Info{Label: via.Label()},
+		OnRun: func(ctx task.Context) {
+			for {
+				tx, err := via.RecvTx()
+				if err != nil {
+					return
+				}
+				tx.Status = amp.OpStatus_Synced
+				err = via.SendTx(tx)
+				tx.ReleaseRef()
+				if err != nil {
+					return
+				}
+			}
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &echoSession{ctx: ctx}, nil
+}
+
+func (sess *echoSession) Close() error {
+	return sess.ctx.Close()
+}
+
+func (sess *echoSession) Done() <-chan struct{} {
+	return sess.ctx.Done()
+}
+
+func TestHostSession(t *testing.T) {
+	root, _ := task.Start(&task.Task{})
+	defer root.Close()
+
+	svc := NewService()
+	if err := svc.StartService(&echoHost{ctx: root}); err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewUnstartedServer(svc)
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	defer server.Close()
+
+	via, err := Connect(context.Background(), server.Client(), server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer via.Close()
+
+	cellID := tag.ID{0, 1, 2}
+	sent, _ := amp.MarshalAttr(cellID, (&amp.Tag{}).TagSpec().ID, &amp.Tag{Text: "hello"})
+	sent.SetContextID(tag.ID{0, 0, 42})
+	if err = via.SendTx(sent); err != nil {
+		t.Fatal(err)
+	}
+
+	echo, err := via.RecvTx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if echo.Status != amp.OpStatus_Synced || echo.ContextID() != (tag.ID{0, 0, 42}) || len(echo.Ops) != 1 || echo.Ops[0].CellID != cellID {
+		t.Fatalf("unexpected echo: %+v", echo.TxEnvelope)
+	}
+	got := &amp.Tag{}
+	if err = echo.UnmarshalOpValue(0, got); err != nil || got.Text != "hello" {
+		t.Fatalf("unexpected echoed value %q (%v)", got.Text, err)
+	}
+}
+
+// plainWriter is an http.ResponseWriter that can't flush, as some middleware wraps one.
+type plainWriter struct {
+	http.ResponseWriter
+}
+
+func TestNoFlusher(t *testing.T) {
+	root, _ := task.Start(&task.Task{})
+	defer root.Close()
+
+	svc := NewService()
+	if err := svc.StartService(&echoHost{ctx: root}); err != nil {
+		t.Fatal(err)
+	}
+	req := httptest.NewRequest(http.MethodPost, ConnectMethod, strings.NewReader(""))
+	req.Header.Set("Content-Type", "application/grpc")
+	req.ProtoMajor = 2
+	rec := httptest.NewRecorder()
+	svc.ServeHTTP(plainWriter{rec}, req)
+	if status := rec.Header().Get("Grpc-Status"); status != strconv.Itoa(codeInternal) {
+		t.Fatalf("expected status %d, got %q", codeInternal, status)
+	}
+}
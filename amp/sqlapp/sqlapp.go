@@ -0,0 +1,351 @@
+// Package sqlapp is a base app that maps SQL tables and queries to cell trees, so internal tools can surface a database in amp clients with near-zero code.
+//
+// A table is pinned via "{invocation}:?table={name}", where the pinned cell has a child cell per row:
+//   - each column of a row is a text property of the row's cell (see ColumnProperty)
+//   - while pinned with StateSync_Maintain, the table's query is re-run every Table.Refresh
+//   - a request committing column properties to row cells is written back via Table.Update
+package sqlapp
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/amp/std"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// TableParam is the pin URL query parameter naming the table to pin.
+const TableParam = "table"
+
+// ColumnTag is the property spec that column properties are derived from.
+var ColumnTag = std.TextTag.With("column")
+
+// ColumnProperty returns the property ID a column's values are presented as.
+func ColumnProperty(column string) tag.ID {
+	return ColumnTag.ID.WithToken(column)
+}
+
+// Table maps a SQL query to a cell with a child cell per row.
+type Table struct {
+	Name        string        // pinned via the "table" URL param
+	Query       string        // returns one row per child cell, e.g. "SELECT id, name, status FROM orders"
+	KeyColumn   string        // uniquely identifies a row, from which its cell ID is derived; if empty, the first column
+	LabelColumn string        // if set, this column is also presented as each row's CellLabel
+	Refresh     time.Duration // if > 0, Query is re-run at this interval while pinned with StateSync_Maintain
+
+	// If set, a row is written back by executing Update with the row's values of UpdateArgs as args (in order), where committed columns replace current values.
+	// For example, "UPDATE orders SET status = ? WHERE id = ?" with UpdateArgs {"status", "id"}.
+	Update     string
+	UpdateArgs []string
+}
+
+// Opts specifies a SQL datasource app.
+type Opts struct {
+	AppSpec    tag.Spec
+	Desc       string
+	Version    string // if empty, "v1.0.0"
+	Invocation string // e.g. "orders", pinned via "orders:?table={name}"
+	DB         *sql.DB
+	Tables     []Table
+	Timeout    time.Duration // limits each query or write back; if <= 0, 30s
+}
+
+// RegisterApp registers an app serving the given tables.
+func RegisterApp(reg amp.Registry, opts Opts) error {
+	if opts.DB == nil || opts.Invocation == "" {
+		return amp.ErrCode_BadRequest.Error("sqlapp: missing DB or Invocation")
+	}
+	if opts.Version == "" {
+		opts.Version = "v1.0.0"
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = 30 * time.Second
+	}
+
+	// Table state is shared by all app instances so that a write back refreshes every session's pins
+	tables := make(map[string]*tableState, len(opts.Tables))
+	for _, table := range opts.Tables {
+		if len(table.UpdateArgs) > 0 && table.Update == "" {
+			return amp.ErrCode_BadRequest.Errorf("sqlapp: table %q has UpdateArgs but no Update", table.Name)
+		}
+		tables[table.Name] = &tableState{
+			Table:   table,
+			db:      opts.DB,
+			timeout: opts.Timeout,
+			cellID:  opts.AppSpec.ID.WithToken(table.Name),
+		}
+	}
+
+	return reg.RegisterApp(&amp.App{
+		AppSpec:     opts.AppSpec,
+		Desc:        opts.Desc,
+		Version:     opts.Version,
+		Invocations: []string{opts.Invocation},
+		NewAppInstance: func(ctx amp.AppContext) (amp.AppInstance, error) {
+			app := &appInst{
+				tables: tables,
+			}
+			app.AppContext = ctx
+			app.Instance = app
+			return app, nil
+		},
+	})
+}
+
+type appInst struct {
+	std.App[*appInst]
+	tables map[string]*tableState
+}
+
+func (app *appInst) ServeRequest(op amp.Requester) (amp.Pin, error) {
+	req := op.Request()
+	var name string
+	if req.Values != nil {
+		name = req.Values.Get(TableParam)
+	}
+	table := app.tables[name]
+	if table == nil {
+		return nil, amp.ErrCode_BadRequest.Errorf("sqlapp: unknown table %q", name)
+	}
+
+	if tx := req.CommitTx; tx != nil {
+		if err := table.commit(app, tx); err != nil {
+			return nil, err
+		}
+	}
+
+	cell := &tableCell{
+		table: table,
+	}
+	cell.ID = table.cellID
+	cell.Inputs = []*std.Signal{&table.changed}
+	cell.Compute = cell.computeRows
+	return app.PinAndServe(cell, op)
+}
+
+// tableState is the most recently queried rows of a Table.
+type tableState struct {
+	Table
+	db      *sql.DB
+	timeout time.Duration
+	cellID  tag.ID
+	changed std.Signal // notified to re-run the query
+
+	mu      sync.Mutex
+	columns []string
+	rows    map[tag.ID]*row // by row cell ID
+}
+
+type row struct {
+	cellID tag.ID
+	values map[string]string // by column
+}
+
+// rowID returns the cell ID of the row with the given key.
+func (table *tableState) rowID(key string) tag.ID {
+	return table.cellID.Then(tag.FromToken(key))
+}
+
+// query runs the table's query, returning its rows in result order.
+func (table *tableState) query(ctx context.Context) ([]*row, error) {
+	ctx, cancel := context.WithTimeout(ctx, table.timeout)
+	defer cancel()
+
+	result, err := table.db.QueryContext(ctx, table.Query)
+	if err != nil {
+		return nil, amp.ErrCode_ProviderErr.Errorf("sqlapp: query %q failed: %v", table.Name, err)
+	}
+	defer result.Close()
+
+	columns, err := result.Columns()
+	if err != nil {
+		return nil, amp.ErrCode_ProviderErr.Errorf("sqlapp: query %q failed: %v", table.Name, err)
+	}
+	keyColumn := table.KeyColumn
+	if keyColumn == "" && len(columns) > 0 {
+		keyColumn = columns[0]
+	}
+
+	var rows []*row
+	byID := make(map[tag.ID]*row)
+	vals := make([]sql.NullString, len(columns))
+	dst := make([]any, len(columns))
+	for i := range vals {
+		dst[i] = &vals[i]
+	}
+	for result.Next() {
+		if err = result.Scan(dst...); err != nil {
+			return nil, amp.ErrCode_ProviderErr.Errorf("sqlapp: query %q failed: %v", table.Name, err)
+		}
+		r := &row{
+			values: make(map[string]string, len(columns)),
+		}
+		for i, column := range columns {
+			if vals[i].Valid {
+				r.values[column] = vals[i].String
+			}
+		}
+		key, hasKey := r.values[keyColumn]
+		if !hasKey {
+			return nil, amp.ErrCode_ProviderErr.Errorf("sqlapp: table %q row has no %q", table.Name, keyColumn)
+		}
+		r.cellID = table.rowID(key)
+		byID[r.cellID] = r
+		rows = append(rows, r)
+	}
+	if err = result.Err(); err != nil {
+		return nil, amp.ErrCode_ProviderErr.Errorf("sqlapp: query %q failed: %v", table.Name, err)
+	}
+
+	table.mu.Lock()
+	table.columns = columns
+	table.rows = byID
+	table.mu.Unlock()
+	return rows, nil
+}
+
+// commit writes back the column properties of each row cell in tx and then notifies the table's pins.
+func (table *tableState) commit(ctx context.Context, tx *amp.TxMsg) error {
+	if table.Update == "" {
+		return amp.ErrCode_BadRequest.Errorf("sqlapp: table %q is read-only", table.Name)
+	}
+
+	table.mu.Lock()
+	if table.rows == nil {
+		table.mu.Unlock()
+		if _, err := table.query(ctx); err != nil {
+			return err
+		}
+		table.mu.Lock()
+	}
+	byProperty := make(map[tag.ID]string, len(table.columns))
+	for _, column := range table.columns {
+		byProperty[ColumnProperty(column)] = column
+	}
+
+	// Merge committed columns over each row's current values
+	edits := make(map[tag.ID]map[string]string)
+	var err error
+	for i, op := range tx.Ops {
+		if op.AttrID != std.CellProperties.ID || op.OpCode != amp.TxOpCode_UpsertElement {
+			continue
+		}
+		column, isColumn := byProperty[op.ItemID]
+		r := table.rows[op.CellID]
+		if !isColumn || r == nil {
+			continue
+		}
+		var val amp.Tag
+		if err = tx.UnmarshalOpValue(i, &val); err != nil {
+			break
+		}
+		edit := edits[op.CellID]
+		if edit == nil {
+			edit = make(map[string]string, len(r.values))
+			for k, v := range r.values {
+				edit[k] = v
+			}
+			edits[op.CellID] = edit
+		}
+		edit[column] = val.Text
+	}
+	table.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	if len(edits) == 0 {
+		return nil
+	}
+
+	defer table.changed.Notify()
+	for _, edit := range edits {
+		args := make([]any, len(table.UpdateArgs))
+		for i, column := range table.UpdateArgs {
+			if val, exists := edit[column]; exists {
+				args[i] = val
+			}
+		}
+		execCtx, cancel := context.WithTimeout(ctx, table.timeout)
+		_, err := table.db.ExecContext(execCtx, table.Update, args...)
+		cancel()
+		if err != nil {
+			return amp.ErrCode_ProviderErr.Errorf("sqlapp: update %q failed: %v", table.Name, err)
+		}
+	}
+	return nil
+}
+
+// tableCell presents a table's rows as child cells.
+type tableCell struct {
+	std.ComputedCell[*appInst]
+	table *tableState
+}
+
+func (cell *tableCell) PinInto(pin *std.Pin[*appInst]) error {
+	if err := cell.ComputedCell.PinInto(pin); err != nil {
+		return err
+	}
+	if pin.Sync != amp.StateSync_Maintain || cell.table.Refresh <= 0 {
+		return nil
+	}
+	_, err := pin.Context().StartChild(&task.Task{
+		Info: task.Info{
+			Label: fmt.Sprintf("refresh: %s every %v", cell.table.Name, cell.table.Refresh),
+		},
+		OnRun: func(ctx task.Context) {
+			for {
+				timer := ctx.Clock().NewTimer(cell.table.Refresh)
+				select {
+				case <-timer.C():
+					cell.table.changed.Notify()
+				case <-ctx.Closing():
+					timer.Stop()
+					return
+				}
+			}
+		},
+	})
+	return err
+}
+
+func (cell *tableCell) computeRows() ([]std.Cell[*appInst], error) {
+	rows, err := cell.table.query(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	children := make([]std.Cell[*appInst], len(rows))
+	for i, r := range rows {
+		child := &rowCell{
+			row:         r,
+			labelColumn: cell.table.LabelColumn,
+		}
+		child.ID = r.cellID
+		children[i] = child
+	}
+	return children, nil
+}
+
+// rowCell presents a row's columns as text properties.
+type rowCell struct {
+	std.CellNode[*appInst]
+	row         *row
+	labelColumn string
+}
+
+func (cell *rowCell) PinInto(pin *std.Pin[*appInst]) error {
+	return nil
+}
+
+func (cell *rowCell) MarshalAttrs(w std.CellWriter) {
+	for column, val := range cell.row.values {
+		w.PutText(ColumnProperty(column), val)
+	}
+	if label, exists := cell.row.values[cell.labelColumn]; exists {
+		w.PutText(std.CellLabel, label)
+	}
+}
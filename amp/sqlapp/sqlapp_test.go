@@ -0,0 +1,112 @@
+package sqlapp
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/amp/std"
+)
+
+// testDriver serves a fixed "orders" table and records executed statements.
+type testDriver struct {
+	rows  [][]driver.Value
+	execs [][]driver.Value
+}
+
+func (d *testDriver) Open(name string) (driver.Conn, error) { return testConn{d}, nil }
+
+type testConn struct{ d *testDriver }
+
+func (c testConn) Prepare(query string) (driver.Stmt, error) { return nil, driver.ErrSkip }
+func (c testConn) Close() error                              { return nil }
+func (c testConn) Begin() (driver.Tx, error)                 { return nil, driver.ErrSkip }
+
+func (c testConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return &testRows{rows: c.d.rows}, nil
+}
+
+func (c testConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	vals := make([]driver.Value, len(args))
+	for i, arg := range args {
+		vals[i] = arg.Value
+	}
+	c.d.execs = append(c.d.execs, vals)
+	return driver.RowsAffected(1), nil
+}
+
+type testRows struct {
+	rows [][]driver.Value
+	next int
+}
+
+func (r *testRows) Columns() []string { return []string{"id", "name", "status"} }
+func (r *testRows) Close() error      { return nil }
+
+func (r *testRows) Next(dst []driver.Value) error {
+	if r.next >= len(r.rows) {
+		return io.EOF
+	}
+	copy(dst, r.rows[r.next])
+	r.next++
+	return nil
+}
+
+func TestTable(t *testing.T) {
+	drv := &testDriver{
+		rows: [][]driver.Value{
+			{int64(1), "widget", "open"},
+			{int64(2), "gadget", nil},
+		},
+	}
+	sql.Register("sqlapp_test", drv)
+	db, _ := sql.Open("sqlapp_test", "")
+	defer db.Close()
+
+	table := &tableState{
+		Table: Table{
+			Name:       "orders",
+			Query:      "SELECT id, name, status FROM orders",
+			Update:     "UPDATE orders SET status = ? WHERE id = ?",
+			UpdateArgs: []string{"status", "id"},
+		},
+		db:      db,
+		timeout: time.Second,
+	}
+	ctx := context.Background()
+	rows, err := table.query(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 || rows[0].values["name"] != "widget" || rows[0].cellID != table.rowID("1") {
+		t.Fatalf("unexpected rows: %+v", rows)
+	}
+	if _, hasStatus := rows[1].values["status"]; hasStatus {
+		t.Fatal("NULL column should be absent")
+	}
+
+	// commit a new status for row 1
+	tx := amp.NewTxMsg(true)
+	op := amp.TxOp{}
+	op.OpCode = amp.TxOpCode_UpsertElement
+	op.CellID = table.rowID("1")
+	op.AttrID = std.CellProperties.ID
+	op.ItemID = ColumnProperty("status")
+	if err = tx.MarshalOp(&op, &amp.Tag{Text: "shipped"}); err != nil {
+		t.Fatal(err)
+	}
+	rev := table.changed.Revision()
+	if err = table.commit(ctx, tx); err != nil {
+		t.Fatal(err)
+	}
+	if len(drv.execs) != 1 || drv.execs[0][0] != "shipped" || drv.execs[0][1] != "1" {
+		t.Fatalf("unexpected write back: %v", drv.execs)
+	}
+	if table.changed.Revision() == rev {
+		t.Fatal("commit should notify the table's pins")
+	}
+}
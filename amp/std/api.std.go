@@ -3,6 +3,9 @@
 package std
 
 import (
+	"sync"
+	"time"
+
 	"github.com/art-media-platform/amp-sdk-go/amp"
 	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
 	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
@@ -13,6 +16,10 @@ import (
 type App[AppT amp.AppInstance] struct {
 	amp.AppContext
 	Instance AppT
+
+	pinsMu sync.Mutex
+	pins   map[tag.ID]int      // number of live pins per cell -- see amp.PinObserver
+	live   map[*Pin[AppT]]bool // maintained pins -- see Broadcast()
 }
 
 // Cell is how std makes calls against a cell
@@ -23,6 +30,7 @@ type Cell[AppT amp.AppInstance] interface {
 	PinInto(dst *Pin[AppT]) error
 
 	// MarshalAttrs is called after PinInto to serialize the cell's pinned attributes.
+	// The children of a pin may be marshalled concurrently (see SessionMarshalWorkers), so a cell must not write state shared with its siblings.
 	MarshalAttrs(w CellWriter)
 }
 
@@ -31,15 +39,37 @@ type CellNode[AppT amp.AppInstance] struct {
 	ID tag.ID
 }
 
+// PushPolicy limits how often a Pin pushes element updates to its client.
+// Updates to an attr arriving faster than allowed are conflated, meaning only the latest value of each of its elements is pushed.
+type PushPolicy struct {
+	MinInterval time.Duration // min time between pushes of the same attr (of a cell); if <= 0, updates are pushed immediately
+
+	// If a push blocks longer than SlowPush for SlowPushLimit consecutive pushes, the pin's consumer is considered persistently slow.
+	// OnSlowConsumer is then called as well as Op.OnSlowConsumer() if Op implements amp.SlowConsumerObserver.
+	SlowPush       time.Duration // if <= 0, slow consumer detection is off
+	SlowPushLimit  int           // if <= 0, 3 is used
+	OnSlowConsumer func(metrics amp.PinMetrics)
+}
+
 // Wraps the pinned state of a cell -- implements amp.Pin
 type Pin[AppT amp.AppInstance] struct {
-	Op   amp.Requester // originating request
-	Cell Cell[AppT]    // pinned cell
-	App  AppT          // parent app instance
-	Sync amp.StateSync // Op.Request().StateSync
+	Op     amp.Requester // originating request
+	Cell   Cell[AppT]    // pinned cell
+	App    AppT          // parent app instance
+	Sync   amp.StateSync // Op.Request().StateSync
+	Policy PushPolicy    // applied by PushUpdate(); once serving, change via SetPolicy()
 
-	children map[tag.ID]Cell[AppT] // child cells
-	ctx      task.Context          // task context for this pin
+	childMu  sync.RWMutex                 // guards children, dropped, and awaiting
+	children map[tag.ID]Cell[AppT]        // child cells
+	dropped  []tag.ID                     // children removed since the last pushState() -- see replaceChildren()
+	awaiting map[tag.ID][]chan Cell[AppT] // requests waiting for a child cell to be created
+	ctx      task.Context                 // task context for this pin
+	attrsMu  sync.RWMutex                 // guards attrs, pusher, and Policy once serving
+	attrs    amp.AttrMask                 // attrs selected by the client -- see UpdatePin()
+	pusher   *conflator                   // conflates updates according to Policy
+	metrics  pinMetrics                   // see Metrics()
+	arena    marshalArena                 // reused across pushState() calls
+	pinned   bool                         // set once Cell.PinInto() succeeds
 }
 
 type CellWriter interface {
@@ -47,6 +77,11 @@ type CellWriter interface {
 
 	PutText(propertyID tag.ID, val string)
 	PutItem(propertyID tag.ID, val tag.Value)
+
+	// Deferred analogs of Upsert() and PutItem() for heavy attrs (e.g. full-resolution images, long descriptions).
+	// The value is only sent if the client explicitly pins the attr (or property); otherwise the element is advertised via TxOpCode_DeferElement.
+	UpsertDeferred(op *amp.TxOp, val tag.Value)
+	PutItemDeferred(propertyID tag.ID, val tag.Value)
 }
 
 const (
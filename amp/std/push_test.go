@@ -0,0 +1,163 @@
+package std
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+func TestConflator(t *testing.T) {
+	var (
+		sent     []*amp.TxMsg
+		label    = &amp.Tag{}
+		now      = time.Now()
+		interval = time.Hour // flushes are driven explicitly
+	)
+	c := newConflator(func() time.Duration { return interval }, func(tx *amp.TxMsg) error {
+		sent = append(sent, tx)
+		return nil
+	})
+	c.now = func() time.Time { return now }
+	defer c.close()
+
+	cellID := tag.Now()
+	push := func(attrID, itemID tag.ID, text string) {
+		op := amp.TxOp{}
+		op.OpCode = amp.TxOpCode_UpsertElement
+		op.CellID = cellID
+		op.AttrID = attrID
+		op.ItemID = itemID
+		label.Text = text
+		buf, _ := label.MarshalToStore(nil)
+		if err := c.push(op, buf); err != nil {
+			t.Fatal(err)
+		}
+	}
+	textOf := func(tx *amp.TxMsg, idx int) string {
+		var val amp.Tag
+		if err := tx.UnmarshalOpValue(idx, &val); err != nil {
+			t.Fatal(err)
+		}
+		return val.Text
+	}
+
+	progressAttr := tag.ID{0, 0, 2001}
+	for i := 0; i < 10; i++ {
+		push(CellProperties.ID, CellLabel, string(rune('a'+i)))
+	}
+	push(CellProperties.ID, CellCaption, "caption") // same attr, so also held
+	push(progressAttr, tag.ID{}, "50%")             // another attr is limited separately
+
+	if len(sent) != 2 || c.queueDepth() != 2 {
+		t.Fatalf("expected the first push of each attr only, got %d pushes, %d held", len(sent), c.queueDepth())
+	}
+
+	c.flush()
+	if len(sent) != 2 {
+		t.Fatal("held updates flushed before due")
+	}
+
+	now = now.Add(interval)
+	c.flush()
+	if len(sent) != 3 || c.queueDepth() != 0 {
+		t.Fatalf("expected held updates in one push, got %d pushes, %d held", len(sent), c.queueDepth())
+	}
+	latest := sent[2]
+	if len(latest.Ops) != 2 {
+		t.Fatalf("expected the latest value of each held element, got %d ops", len(latest.Ops))
+	}
+	for i, op := range latest.Ops {
+		if op.ItemID == CellLabel && textOf(latest, i) != "j" {
+			t.Fatalf("expected latest value to be pushed, got %q", textOf(latest, i))
+		}
+	}
+
+	// a shorter interval takes effect with the next push
+	interval = 0
+	push(CellProperties.ID, CellLabel, "now")
+	if len(sent) != 4 {
+		t.Fatal("expected push to be immediate once MinInterval is cleared")
+	}
+}
+
+// blockingRequester blocks each PushTx for the given delay, as a slow client would.
+type blockingRequester struct {
+	slowRequester
+	delay time.Duration
+	err   error
+}
+
+func (op *blockingRequester) PushTx(tx *amp.TxMsg) error {
+	time.Sleep(op.delay)
+	return op.err
+}
+
+func TestPinMetrics(t *testing.T) {
+	var slow []amp.PinMetrics
+	op := &blockingRequester{delay: 5 * time.Millisecond}
+	pin := &Pin[amp.AppInstance]{
+		App: &slowApp{info: task.Info{Label: "metricsapp: 1"}},
+		Op:  op,
+		Policy: PushPolicy{
+			SlowPush:      time.Millisecond,
+			SlowPushLimit: 2,
+			OnSlowConsumer: func(metrics amp.PinMetrics) {
+				slow = append(slow, metrics)
+			},
+		},
+	}
+
+	push := func() {
+		tx := amp.NewTxMsg(true)
+		tx.DataStore = append(tx.DataStore, "0123456789"...)
+		pin.pushTx(tx)
+	}
+	push()
+	if len(slow) != 0 {
+		t.Fatal("a single slow push should not be reported")
+	}
+	push()
+	if len(slow) != 1 || slow[0].SlowPushes != 2 {
+		t.Fatalf("expected a slow consumer after 2 slow pushes, got %+v", slow)
+	}
+	push()
+	if len(slow) != 1 {
+		t.Fatal("a persistently slow consumer should be reported once")
+	}
+
+	m := pin.Metrics()
+	if m.TxsPushed != 3 || m.BytesPushed != 30 || m.TimeBlocked < 3*op.delay || m.SlowPushes != 3 {
+		t.Fatalf("unexpected metrics: %+v", m)
+	}
+
+	// a fast push resets the count of consecutive slow pushes
+	op.delay = 0
+	pin.SetPolicy(PushPolicy{SlowPush: time.Hour})
+	push()
+	if pin.Metrics().SlowPushes != 0 {
+		t.Fatal("fast push should reset slow pushes")
+	}
+
+	// a held push that fails is reported rather than dropped
+	var failed error
+	op.err = errors.New("closed")
+	c := newConflator(func() time.Duration { return time.Hour }, pin.pushTx)
+	c.onError = func(err error) { failed = err }
+	now := time.Now()
+	c.now = func() time.Time { return now }
+	defer c.close()
+	for i := 0; i < 2; i++ {
+		txOp := amp.TxOp{}
+		txOp.AttrID = CellProperties.ID
+		c.push(txOp, nil)
+	}
+	now = now.Add(time.Hour)
+	c.flush()
+	if failed != op.err {
+		t.Fatalf("expected held push error to be reported, got %v", failed)
+	}
+}
@@ -24,12 +24,14 @@ var (
 	CellSynopsis   = TextTag.With("synopsis").ID
 	CellCollection = TextTag.With("collection").ID
 	CellAuthor     = TextTag.With("author").ID
+	CellBody       = TextTag.With("body").ID // long-form text (e.g. an email body), typically deferred
 
 	CellPropertyTagID = CellProperty.With("TagID")
 	OrderByPlayID     = CellPropertyTagID.With("order-by.play").ID
 	OrderByTimeID     = CellPropertyTagID.With("order-by.time").ID
 	OrderByGeoID      = CellPropertyTagID.With("order-by.geo").ID
 	OrderByAreaID     = CellPropertyTagID.With("order-by.area").ID
+	OrderByRankID     = CellPropertyTagID.With("order-by.rank").ID // ascending, e.g. the rank of a search hit
 
 	CellTags   = CellProperty.With("Tags")
 	CellLinks  = CellTags.With("links").ID
@@ -39,14 +41,42 @@ var (
 	CellMedia = CellTag.With("content.media").ID
 	CellCover = CellTag.With("content.cover").ID
 	CellVis   = CellTag.With("content.vis").ID
+	CellLink  = CellTag.With("content.link").ID // references another cell (e.g. a search hit)
 
-	CellFileInfo = CellProperty.With("FileInfo").ID
+	CellFileInfo    = CellProperty.With("FileInfo").ID
+	CellMediaInfo   = CellProperty.With("MediaInfo").ID   // see ExtractMediaInfo
+	CellInline      = CellProperty.With("InlineAsset").ID // see InlineAssetID
+	CellEmbedding   = CellProperty.With("Embedding.content").ID
+	CellLocation    = CellProperty.With("LatLng.location").ID
+	CellGeometry    = CellProperty.With("Geometry.shape").ID
+	CellLease       = CellProperty.With("CellLease").ID        // see amp.LeaseTable
+	CellPresence    = CellProperty.With("Presence").ID         // see amp.PresenceTable
+	CellBadge       = CellProperty.With("Badge").ID            // see amp.NotificationService
+	CellDevice      = CellProperty.With("Device").ID           // see amp.DeviceRegistry
+	CellUserProfile = CellProperty.With("UserProfile").ID      // see amp/sys/user
+	CellEvent       = CellProperty.With("CalendarEvent").ID    // see amp/sys/dav
+	CellContact     = CellProperty.With("Contact").ID          // see amp/sys/dav
+	CellWindow      = CellProperty.With("CollectionWindow").ID // see ParseWindow
+	CellHealth      = CellProperty.With("HealthStatus").ID     // see amp/sys/health
+)
+
+// Ephemeral attrs are broadcast to current pins via App.Broadcast() but never persisted or replayed on re-pin.
+// For each, ItemID identifies the participant (typically Login.UserID).
+var (
+	EphemeralAttr = amp.AttrSpec.With("ephemeral")
+	CellCursors   = EphemeralAttr.With("Position.cursor").ID  // caret or selection position within a cell
+	CellPointers  = EphemeralAttr.With("Position.pointer").ID // live pointer position over a cell
+	CellTyping    = EphemeralAttr.With("Tag.typing").ID       // present while a participant is typing
+	CellMembers   = EphemeralAttr.With("Tag.member").ID       // present while a participant is a member of a room or channel
 )
 
 const (
 	// URL prefix for a glyph and is typically followed by a media (mime) type.
 	GenericGlyphURL = "amp:glyph/"
 
+	// URL prefix for an asset delivered inline and is followed by the Base32 property ID of its InlineAsset -- see AssetRef.PutAs
+	InlineAssetURL = "amp:inline/"
+
 	GenericImageType = "image/*"
 	GenericAudioType = "audio/*"
 	GenericVideoType = "video/*"
@@ -75,6 +105,30 @@ func (v *Position) New() tag.Value {
 	return &Position{}
 }
 
+func (v *LatLng) MarshalToStore(in []byte) (out []byte, err error) {
+	return amp.MarshalPbToStore(v, in)
+}
+
+func (v *LatLng) TagSpec() tag.Spec {
+	return amp.AttrSpec.With("LatLng")
+}
+
+func (v *LatLng) New() tag.Value {
+	return &LatLng{}
+}
+
+func (v *Geometry) MarshalToStore(in []byte) (out []byte, err error) {
+	return amp.MarshalPbToStore(v, in)
+}
+
+func (v *Geometry) TagSpec() tag.Spec {
+	return amp.AttrSpec.With("Geometry")
+}
+
+func (v *Geometry) New() tag.Value {
+	return &Geometry{}
+}
+
 func (v *FSInfo) MarshalToStore(in []byte) (out []byte, err error) {
 	return amp.MarshalPbToStore(v, in)
 }
@@ -96,3 +150,150 @@ func (v *FSInfo) SetCreatedAt(t time.Time) {
 	tag := tag.FromTime(t, false)
 	v.CreatedAt = int64(tag[0])
 }
+
+func (v *Embedding) MarshalToStore(in []byte) (out []byte, err error) {
+	return amp.MarshalPbToStore(v, in)
+}
+
+func (v *Embedding) TagSpec() tag.Spec {
+	return amp.AttrSpec.With("Embedding")
+}
+
+func (v *Embedding) New() tag.Value {
+	return &Embedding{}
+}
+
+func (v *TimeSeries) MarshalToStore(in []byte) (out []byte, err error) {
+	return amp.MarshalPbToStore(v, in)
+}
+
+func (v *TimeSeries) TagSpec() tag.Spec {
+	return amp.AttrSpec.With("TimeSeries")
+}
+
+func (v *TimeSeries) New() tag.Value {
+	return &TimeSeries{}
+}
+
+func (v *MediaInfo) MarshalToStore(in []byte) (out []byte, err error) {
+	return amp.MarshalPbToStore(v, in)
+}
+
+func (v *MediaInfo) TagSpec() tag.Spec {
+	return amp.AttrSpec.With("MediaInfo")
+}
+
+func (v *MediaInfo) New() tag.Value {
+	return &MediaInfo{}
+}
+
+func (v *InlineAsset) MarshalToStore(in []byte) (out []byte, err error) {
+	return amp.MarshalPbToStore(v, in)
+}
+
+func (v *InlineAsset) TagSpec() tag.Spec {
+	return amp.AttrSpec.With("InlineAsset")
+}
+
+func (v *InlineAsset) New() tag.Value {
+	return &InlineAsset{}
+}
+
+func (v *UserProfile) MarshalToStore(in []byte) (out []byte, err error) {
+	return amp.MarshalPbToStore(v, in)
+}
+
+func (v *UserProfile) TagSpec() tag.Spec {
+	return amp.AttrSpec.With("UserProfile")
+}
+
+func (v *UserProfile) New() tag.Value {
+	return &UserProfile{}
+}
+
+func (v *CalendarEvent) MarshalToStore(in []byte) (out []byte, err error) {
+	return amp.MarshalPbToStore(v, in)
+}
+
+func (v *CalendarEvent) TagSpec() tag.Spec {
+	return amp.AttrSpec.With("CalendarEvent")
+}
+
+func (v *CalendarEvent) New() tag.Value {
+	return &CalendarEvent{}
+}
+
+func (v *Attendee) MarshalToStore(in []byte) (out []byte, err error) {
+	return amp.MarshalPbToStore(v, in)
+}
+
+func (v *Attendee) TagSpec() tag.Spec {
+	return amp.AttrSpec.With("Attendee")
+}
+
+func (v *Attendee) New() tag.Value {
+	return &Attendee{}
+}
+
+func (v *Contact) MarshalToStore(in []byte) (out []byte, err error) {
+	return amp.MarshalPbToStore(v, in)
+}
+
+func (v *Contact) TagSpec() tag.Spec {
+	return amp.AttrSpec.With("Contact")
+}
+
+func (v *Contact) New() tag.Value {
+	return &Contact{}
+}
+
+func (v *CollectionWindow) MarshalToStore(in []byte) (out []byte, err error) {
+	return amp.MarshalPbToStore(v, in)
+}
+
+func (v *CollectionWindow) TagSpec() tag.Spec {
+	return amp.AttrSpec.With("CollectionWindow")
+}
+
+func (v *CollectionWindow) New() tag.Value {
+	return &CollectionWindow{}
+}
+
+func (v *HealthStatus) MarshalToStore(in []byte) (out []byte, err error) {
+	return amp.MarshalPbToStore(v, in)
+}
+
+func (v *HealthStatus) TagSpec() tag.Spec {
+	return amp.AttrSpec.With("HealthStatus")
+}
+
+func (v *HealthStatus) New() tag.Value {
+	return &HealthStatus{}
+}
+
+// Merge applies the set fields of the given update to this profile, so apps can each write only the settings they own.
+// A preference with an empty value is removed.
+func (v *UserProfile) Merge(update *UserProfile) {
+	if update.DisplayName != "" {
+		v.DisplayName = update.DisplayName
+	}
+	if update.Avatar != nil {
+		v.Avatar = update.Avatar
+	}
+	if update.Locale != "" {
+		v.Locale = update.Locale
+	}
+	if update.TimeZone != "" {
+		v.TimeZone = update.TimeZone
+	}
+	for key, val := range update.Preferences {
+		if val == "" {
+			delete(v.Preferences, key)
+			continue
+		}
+		if v.Preferences == nil {
+			v.Preferences = make(map[string]string)
+		}
+		v.Preferences[key] = val
+	}
+}
@@ -0,0 +1,222 @@
+package std
+
+import (
+	"sync"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// Signal is a change notifier for state that computed cells depend on -- concurrency safe.
+// An app embeds or holds a Signal alongside state it owns and calls Notify() whenever that state changes.
+type Signal struct {
+	mu     sync.Mutex
+	rev    uint64
+	nextID int
+	subs   map[int]func()
+}
+
+// Notify marks this Signal as changed and notifies its dependents.
+func (sig *Signal) Notify() {
+	sig.mu.Lock()
+	sig.rev++
+	subs := make([]func(), 0, len(sig.subs))
+	for _, fn := range sig.subs {
+		subs = append(subs, fn)
+	}
+	sig.mu.Unlock()
+
+	for _, fn := range subs {
+		fn()
+	}
+}
+
+// Revision returns the number of times Notify() has been called.
+func (sig *Signal) Revision() uint64 {
+	sig.mu.Lock()
+	defer sig.mu.Unlock()
+	return sig.rev
+}
+
+// Calls fn after each Notify() until the returned func is called.
+func (sig *Signal) subscribe(fn func()) (unsubscribe func()) {
+	sig.mu.Lock()
+	defer sig.mu.Unlock()
+
+	if sig.subs == nil {
+		sig.subs = make(map[int]func())
+	}
+	id := sig.nextID
+	sig.nextID++
+	sig.subs[id] = fn
+
+	return func() {
+		sig.mu.Lock()
+		delete(sig.subs, id)
+		sig.mu.Unlock()
+	}
+}
+
+// ComputedCell is a Cell whose children are computed from a function over other state (e.g. joins, filters, aggregations).
+//
+// Compute() is only called when one or more Inputs have changed since the last call.
+// While pinned with StateSync_Maintain, a change to any input recomputes the children and pushes the pin's state.
+type ComputedCell[AppT amp.AppInstance] struct {
+	CellNode[AppT]
+	Inputs  []*Signal                    // state this cell depends on
+	Compute func() ([]Cell[AppT], error) // returns this cell's children from the current state of Inputs
+	Attrs   func(w CellWriter)           // optional: marshals this cell's own attrs
+
+	mu       sync.Mutex
+	gen      uint64       // incremented by each Compute(), so each pin can tell if it has pushed the latest result
+	revs     []uint64     // input revisions as of the last Compute()
+	children []Cell[AppT] // result of the last Compute()
+}
+
+func (cell *ComputedCell[AppT]) PinInto(pin *Pin[AppT]) error {
+	children, gen, err := cell.refresh()
+	if err != nil {
+		return err
+	}
+	for _, child := range children {
+		pin.AddChild(child)
+	}
+
+	if pin.Sync == amp.StateSync_Maintain && len(cell.Inputs) > 0 {
+		return cell.watchInputs(pin, gen)
+	}
+	return nil
+}
+
+func (cell *ComputedCell[AppT]) MarshalAttrs(w CellWriter) {
+	if cell.Attrs != nil {
+		cell.Attrs(w)
+	}
+}
+
+// refresh calls Compute() if any input has changed since the last call, otherwise the previous result is returned.
+// gen identifies the result returned, so pins sharing this cell each push a result once no matter which of them computed it.
+func (cell *ComputedCell[AppT]) refresh() (children []Cell[AppT], gen uint64, err error) {
+	cell.mu.Lock()
+	defer cell.mu.Unlock()
+
+	revs := make([]uint64, len(cell.Inputs))
+	for i, input := range cell.Inputs {
+		revs[i] = input.Revision()
+	}
+
+	if cell.gen > 0 && len(revs) == len(cell.revs) {
+		same := true
+		for i := range revs {
+			if revs[i] != cell.revs[i] {
+				same = false
+				break
+			}
+		}
+		if same {
+			return cell.children, cell.gen, nil
+		}
+	}
+
+	children, err = cell.Compute()
+	if err != nil {
+		return nil, 0, err
+	}
+	cell.gen++
+	cell.revs = revs
+	cell.children = children
+	return children, cell.gen, nil
+}
+
+// watchInputs recomputes and pushes this cell's state whenever an input changes while the given pin is open.
+// pushed is the generation of the result the pin was last synced with.
+func (cell *ComputedCell[AppT]) watchInputs(pin *Pin[AppT], pushed uint64) error {
+	changed := make(chan struct{}, 1)
+	onChange := func() {
+		select {
+		case changed <- struct{}{}:
+		default:
+		}
+	}
+
+	unsubs := make([]func(), len(cell.Inputs))
+	for i, input := range cell.Inputs {
+		unsubs[i] = input.subscribe(onChange)
+	}
+
+	_, err := pin.ctx.StartChild(&task.Task{
+		Info: task.Info{
+			Label: "computed: " + cell.ID.Base32Suffix(),
+		},
+		OnRun: func(ctx task.Context) {
+			for {
+				select {
+				case <-changed:
+					var children []Cell[AppT]
+					var gen uint64
+					err := pin.timeOp(PhaseCompute, func() (err error) {
+						children, gen, err = cell.refresh()
+						return err
+					})
+					if err == nil && gen > pushed {
+						pushed = gen
+						pin.replaceChildren(children)
+						err = pin.pushState()
+					}
+					if err != nil {
+						ctx.Log().Warnf("recompute failed: %v", err)
+					}
+				case <-ctx.Closing():
+					return
+				}
+			}
+		},
+		OnClosing: func() {
+			for _, unsub := range unsubs {
+				unsub()
+			}
+		},
+	})
+	if err != nil {
+		for _, unsub := range unsubs {
+			unsub()
+		}
+	}
+	return err
+}
+
+// replaceChildren replaces this pin's child cells with the given cells, swapping them in at once so a concurrent marshal sees either set in full.
+// Children no longer present are deleted from the client with the next pushState().
+func (pin *Pin[AppT]) replaceChildren(children []Cell[AppT]) {
+	next := make(map[tag.ID]Cell[AppT], len(children))
+	for _, sub := range children {
+		child := sub.Root()
+		if child.ID.IsNil() {
+			child.ID = tag.Now()
+		}
+		next[child.ID] = sub
+	}
+
+	var ready []chan Cell[AppT]
+	var readyCells []Cell[AppT]
+	pin.childMu.Lock()
+	for childID := range pin.children {
+		if _, kept := next[childID]; !kept {
+			pin.dropped = append(pin.dropped, childID)
+		}
+	}
+	pin.children = next
+	for childID, sub := range next {
+		for _, ch := range pin.awaiting[childID] {
+			ready = append(ready, ch)
+			readyCells = append(readyCells, sub)
+		}
+		delete(pin.awaiting, childID)
+	}
+	pin.childMu.Unlock()
+
+	for i, ch := range ready {
+		ch <- readyCells[i]
+	}
+}
@@ -0,0 +1,60 @@
+package std
+
+import (
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+// liveTracker is implemented by App so that PinAndServe can track maintained pins for Broadcast().
+type liveTracker[AppT amp.AppInstance] interface {
+	trackLive(pin *Pin[AppT], live bool)
+}
+
+func trackLive[AppT amp.AppInstance](app AppT, pin *Pin[AppT], live bool) {
+	if tracker, ok := any(app).(liveTracker[AppT]); ok {
+		tracker.trackLive(pin, live)
+	}
+}
+
+func (app *App[AppT]) trackLive(pin *Pin[AppT], live bool) {
+	app.pinsMu.Lock()
+	defer app.pinsMu.Unlock()
+
+	if live {
+		if app.live == nil {
+			app.live = make(map[*Pin[AppT]]bool)
+		}
+		app.live[pin] = true
+	} else {
+		delete(app.live, pin)
+	}
+}
+
+// Broadcast sends an ephemeral element to every maintained pin of the given cell (as a root or child cell), returning the number of pins sent to.
+// The element is never persisted or replayed on re-pin, making it suited for cursors, typing indicators, and pointer positions (see CellCursors).
+// Each pin's PushPolicy applies, so high frequency updates (e.g. pointer moves) are conflated.
+func (app *App[AppT]) Broadcast(cellID, attrID, itemID tag.ID, val tag.Value) int {
+	app.pinsMu.Lock()
+	pins := make([]*Pin[AppT], 0, len(app.live))
+	for pin := range app.live {
+		pins = append(pins, pin)
+	}
+	app.pinsMu.Unlock()
+
+	op := amp.TxOp{}
+	op.OpCode = amp.TxOpCode_EphemeralElement
+	op.CellID = cellID
+	op.AttrID = attrID
+	op.ItemID = itemID
+
+	sent := 0
+	for _, pin := range pins {
+		if pin.GetCell(cellID) == nil {
+			continue
+		}
+		if err := pin.PushUpdate(op, val); err == nil {
+			sent++
+		}
+	}
+	return sent
+}
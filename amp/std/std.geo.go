@@ -0,0 +1,120 @@
+package std
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/geo"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+// Pin URL query parameters for geospatial queries, e.g.
+//
+//	"amp://venues/?bbox=37.7,-122.5,37.8,-122.4"    -- cells within a viewport (south,west,north,east)
+//	"amp://venues/?near=37.77,-122.42&radius=500"   -- cells within 500 meters of a point
+const (
+	GeoBoundsParam = "bbox"
+	GeoNearParam   = "near"
+	GeoRadiusParam = "radius"
+	GeoLimitParam  = "limit"
+)
+
+// GeoQuery is a bounding box or radius query parsed from pin params.
+type GeoQuery struct {
+	Bounds geo.Rect  // set for a bounding box query
+	Center geo.Point // set for a radius query
+	Meters float64   // radius; 0 denotes a bounding box query
+	Limit  int       // max results (0 denotes no limit)
+}
+
+// ParseGeoQuery returns the geospatial query of the given request.
+func ParseGeoQuery(req *amp.Request) (GeoQuery, error) {
+	var query GeoQuery
+	if req.Values == nil {
+		return query, amp.ErrCode_BadRequest.Error("missing geo query")
+	}
+
+	if str := req.Values.Get(GeoLimitParam); str != "" {
+		limit, err := strconv.Atoi(str)
+		if err != nil || limit < 0 {
+			return query, amp.ErrCode_BadRequest.Errorf("bad %q param", GeoLimitParam)
+		}
+		query.Limit = limit
+	}
+
+	if str := req.Values.Get(GeoBoundsParam); str != "" {
+		coords, err := parseCoords(str, 4)
+		if err != nil {
+			return query, amp.ErrCode_BadRequest.Errorf("bad %q param: %v", GeoBoundsParam, err)
+		}
+		query.Bounds = geo.Rect{
+			Min: geo.Point{Lat: coords[0], Lng: coords[1]},
+			Max: geo.Point{Lat: coords[2], Lng: coords[3]},
+		}
+		return query, nil
+	}
+
+	if str := req.Values.Get(GeoNearParam); str != "" {
+		coords, err := parseCoords(str, 2)
+		if err != nil {
+			return query, amp.ErrCode_BadRequest.Errorf("bad %q param: %v", GeoNearParam, err)
+		}
+		query.Center = geo.Point{Lat: coords[0], Lng: coords[1]}
+		query.Meters, err = strconv.ParseFloat(req.Values.Get(GeoRadiusParam), 64)
+		if err != nil || query.Meters <= 0 {
+			return query, amp.ErrCode_BadRequest.Errorf("bad %q param", GeoRadiusParam)
+		}
+		return query, nil
+	}
+
+	return query, amp.ErrCode_BadRequest.Error("missing geo query")
+}
+
+// Parses n comma separated coordinates, validating each lat/lng pair.
+func parseCoords(str string, n int) ([]float64, error) {
+	fields := strings.Split(str, ",")
+	if len(fields) != n {
+		return nil, amp.ErrCode_BadRequest.Errorf("expected %d coordinates", n)
+	}
+	coords := make([]float64, n)
+	for i, fi := range fields {
+		x, err := strconv.ParseFloat(strings.TrimSpace(fi), 64)
+		if err != nil {
+			return nil, err
+		}
+		if (i%2 == 0 && (x < -90 || x > 90)) || (i%2 == 1 && (x < -180 || x > 180)) {
+			return nil, amp.ErrCode_BadRequest.Error("coordinate out of range")
+		}
+		coords[i] = x
+	}
+	return coords, nil
+}
+
+// QueryGeo returns the cells in the given index matching the given query.
+// Radius query results are ranked by ascending distance.
+func QueryGeo(idx geo.Index, query GeoQuery) []tag.ID {
+	if query.Meters <= 0 {
+		return idx.Search(query.Bounds, query.Limit)
+	}
+	hits := idx.Within(query.Center, query.Meters, query.Limit)
+	cellIDs := make([]tag.ID, len(hits))
+	for i, hit := range hits {
+		cellIDs[i] = hit.ItemID
+	}
+	return cellIDs
+}
+
+// Point returns this LatLng as a geo.Point.
+func (v *LatLng) Point() geo.Point {
+	return geo.Point{Lat: v.Lat, Lng: v.Lng}
+}
+
+// Bounds returns the smallest geo.Rect containing this Geometry.
+func (v *Geometry) Bounds() geo.Rect {
+	pts := make([]geo.Point, len(v.Points))
+	for i, pt := range v.Points {
+		pts[i] = pt.Point()
+	}
+	return geo.Bounds(pts...)
+}
@@ -0,0 +1,61 @@
+package std
+
+import (
+	"sync"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+// LazyValue is a tag.Value whose content is loaded the first time it is marshalled.
+// Passed to CellWriter.PutItemDeferred(), a heavy value (e.g. an email body fetched from a server) is only loaded once a client pins it.
+type LazyValue struct {
+	Prototype tag.Value                 // determines TagSpec() and New()
+	Load      func() (tag.Value, error) // called at most once
+
+	once sync.Once
+	val  tag.Value
+	err  error
+}
+
+func (v *LazyValue) load() (tag.Value, error) {
+	v.once.Do(func() {
+		v.val, v.err = v.Load()
+	})
+	return v.val, v.err
+}
+
+func (v *LazyValue) TagSpec() tag.Spec {
+	return v.Prototype.TagSpec()
+}
+
+func (v *LazyValue) New() tag.Value {
+	return v.Prototype.New()
+}
+
+func (v *LazyValue) MarshalToStore(in []byte) (out []byte, err error) {
+	val, err := v.load()
+	if err != nil {
+		return in, err
+	}
+	return val.MarshalToStore(in)
+}
+
+func (v *LazyValue) Size() int {
+	if val, err := v.load(); err == nil {
+		return val.Size()
+	}
+	return 0
+}
+
+func (v *LazyValue) MarshalToSizedBuffer(dst []byte) (int, error) {
+	val, err := v.load()
+	if err != nil {
+		return 0, err
+	}
+	return val.MarshalToSizedBuffer(dst)
+}
+
+func (v *LazyValue) Unmarshal(src []byte) error {
+	return amp.ErrCode_UnsupportedOp.Error("LazyValue is write-only")
+}
@@ -0,0 +1,47 @@
+package std
+
+import (
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+// Writes the current lease (if any) on the cell being written as the CellLease property.
+func (w *cellWriter) putLease(leases amp.LeaseTable) {
+	if leases == nil {
+		return
+	}
+	if lease := leases.GetLease(w.cellID); lease != nil {
+		w.PutItem(CellLease, lease)
+	}
+}
+
+// Pushes lease changes of this pin's cells to the client while this pin is open.
+func (pin *Pin[AppT]) watchLeases() {
+	leases := amp.ServicesOf(pin.App.Session()).Leases
+	if leases == nil {
+		return
+	}
+
+	leases.WatchLeases(pin.ctx, func(cellID tag.ID, lease *amp.CellLease) {
+		if pin.GetCell(cellID) == nil {
+			return
+		}
+
+		op := amp.TxOp{}
+		op.OpCode = amp.TxOpCode_UpsertElement
+		op.CellID = cellID
+		op.AttrID = CellProperties.ID
+		op.ItemID = CellLease
+		if lease == nil {
+			op.OpCode = amp.TxOpCode_DeleteElement
+		}
+
+		var val tag.Value
+		if lease != nil {
+			val = lease
+		}
+		if err := pin.PushUpdate(op, val); err != nil && err != amp.ErrRequestClosed {
+			pin.ctx.Log().Warnf("lease push failed: %v", err)
+		}
+	})
+}
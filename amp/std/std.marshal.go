@@ -0,0 +1,110 @@
+package std
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+// SessionMarshalWorkers is how many goroutines a session may use at once to marshal the child cells of its pins.
+// A pin with many children (e.g. a large media collection) is marshalled by as many of them as are free, so its first push is not bound to a single core.
+// If <= 1, children are always marshalled serially.
+var SessionMarshalWorkers = runtime.GOMAXPROCS(0)
+
+// MinChildrenPerWorker is the fewest child cells worth handing to an additional marshal worker.
+const MinChildrenPerWorker = 64
+
+var gMarshalBudgets sync.Map // amp.Session => marshalBudget
+
+// marshalBudget holds a token for each worker a session may use in addition to the goroutine pushing state.
+type marshalBudget chan struct{}
+
+func marshalBudgetOf(sess amp.Session) marshalBudget {
+	if budget, ok := gMarshalBudgets.Load(sess); ok {
+		return budget.(marshalBudget)
+	}
+	budget, loaded := gMarshalBudgets.LoadOrStore(sess, make(marshalBudget, max(SessionMarshalWorkers-1, 0)))
+	if !loaded {
+		go func() {
+			<-sess.Done()
+			gMarshalBudgets.Delete(sess)
+		}()
+	}
+	return budget.(marshalBudget)
+}
+
+// acquire claims up to n tokens without blocking, returning how many were claimed.
+func (budget marshalBudget) acquire(n int) int {
+	for i := 0; i < n; i++ {
+		select {
+		case budget <- struct{}{}:
+		default:
+			return i
+		}
+	}
+	return n
+}
+
+func (budget marshalBudget) release(n int) {
+	for ; n > 0; n-- {
+		<-budget
+	}
+}
+
+// marshalChildren marshals the given children of the pinned cell into w.tx, fanning out to workers claimed from budget.
+// Each worker marshals a contiguous run of children into its own tx, which are then appended to w.tx in order.
+func (pin *Pin[AppT]) marshalChildren(w *cellWriter, children []tag.ID, leases amp.LeaseTable, budget marshalBudget) {
+	pinnedID := w.cellID
+	marshal := func(w *cellWriter, childIDs []tag.ID) {
+		for _, childID := range childIDs {
+			w.cellID = childID
+			w.tx.Upsert(pinnedID, CellChildren.ID, childID, nil) // link child to pinned cell
+			pin.children[childID].MarshalAttrs(w)
+			w.putLease(leases)
+			if w.err != nil {
+				return
+			}
+		}
+	}
+
+	extra := 0
+	if budget != nil {
+		extra = budget.acquire(len(children)/MinChildrenPerWorker - 1)
+		defer budget.release(extra)
+	}
+	if extra <= 0 {
+		marshal(w, children)
+		return
+	}
+
+	runLen := (len(children) + extra) / (extra + 1)
+	workers := make([]cellWriter, extra)
+	wg := sync.WaitGroup{}
+	for i := range workers {
+		workers[i] = cellWriter{
+			tx:    amp.NewTxMsg(false),
+			attrs: w.attrs,
+			text:  &amp.Tag{},
+		}
+		wg.Add(1)
+		go func(worker *cellWriter, run []tag.ID) {
+			defer wg.Done()
+			marshal(worker, run)
+		}(&workers[i], children[min((i+1)*runLen, len(children)):min((i+2)*runLen, len(children))])
+	}
+	marshal(w, children[:runLen])
+	wg.Wait()
+
+	for i := range workers {
+		worker := &workers[i]
+		if w.err == nil {
+			w.err = worker.err
+		}
+		if w.err == nil {
+			w.tx.AppendOps(worker.tx)
+		}
+		worker.tx.ReleaseRef()
+	}
+}
@@ -0,0 +1,179 @@
+package std
+
+import (
+	"io"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/media"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/metadata"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+// ExtractMediaInfo reads the given asset's headers and embedded tags, returning a MediaInfo suitable for CellWriter.PutItem() (see CellMediaInfo).
+// The asset must be ready to read (e.g. published or started), and only its headers and tags are read, so this is inexpensive even for large media.
+func ExtractMediaInfo(asset media.Asset) (*MediaInfo, error) {
+	reader, err := asset.NewAssetReader()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	md, err := metadata.Extract(reader, asset.ContentType())
+	if err != nil {
+		return nil, err
+	}
+	byteSize, err := reader.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &MediaInfo{
+		ContentType: md.ContentType,
+		ByteSize:    byteSize,
+		Width:       int32(md.Width),
+		Height:      int32(md.Height),
+		DurationMs:  md.Duration.Milliseconds(),
+		Orientation: int32(md.Orientation),
+		CameraMake:  md.CameraMake,
+		CameraModel: md.CameraModel,
+		Title:       md.Title,
+		Artist:      md.Artist,
+		Album:       md.Album,
+		Genre:       md.Genre,
+		Year:        int32(md.Year),
+		Track:       int32(md.Track),
+	}
+	if !md.TakenAt.IsZero() {
+		takenAt := tag.FromTime(md.TakenAt, false)
+		info.TakenAt = int64(takenAt[0])
+	}
+	if md.HasLocation {
+		info.Location = &LatLng{
+			Lat: md.Lat,
+			Lng: md.Lng,
+		}
+	}
+	return info, nil
+}
+
+// PublishAsset publishes an asset via the session's publisher and extracts its metadata,
+// so an app can put both the asset's URL and its MediaInfo on a cell (e.g. as CellMedia and CellMediaInfo).
+// If extraction fails, the asset is still published and a nil MediaInfo is returned.
+func PublishAsset(sess amp.Session, asset media.Asset, opts media.PublishOpts) (url string, info *MediaInfo, err error) {
+	url, err = sess.AssetPublisher().PublishAsset(asset, opts)
+	if err != nil {
+		return "", nil, err
+	}
+	info, _ = ExtractMediaInfo(asset)
+	return url, info, nil
+}
+
+// DefaultInlineMax is the size up to which PrepareAsset delivers assets inline when AssetOpts.InlineMax is 0.
+const DefaultInlineMax = 8 << 10
+
+// AssetOpts specifies how PrepareAsset delivers an asset.
+type AssetOpts struct {
+	media.PublishOpts
+
+	// Assets up to this size (e.g. icons and small thumbnails) ride inline within txs rather than being published for fetching over HTTP.
+	// If 0, DefaultInlineMax is used; if < 0, assets are never inlined.
+	InlineMax int64
+}
+
+// AssetRef references an asset from a cell property -- see PrepareAsset.
+type AssetRef struct {
+	Tag    *amp.Tag     // written as the property; Tag.URL is where the asset is published
+	Inline *InlineAsset // if set, the asset's content, written alongside the property (see InlineAssetID)
+}
+
+// InlineAssetID returns the property ID of the InlineAsset accompanying the given property.
+func InlineAssetID(propertyID tag.ID) tag.ID {
+	return CellInline.With(propertyID)
+}
+
+// PrepareAsset reads the given asset for inline delivery if it is no larger than opts.InlineMax, otherwise publishes it via the session's publisher.
+// Since an AssetRef is typically written each time a cell is pinned, an app prepares an asset once and retains the AssetRef.
+func PrepareAsset(sess amp.Session, asset media.Asset, opts AssetOpts) (AssetRef, error) {
+	if opts.InlineMax == 0 {
+		opts.InlineMax = DefaultInlineMax
+	}
+	if opts.InlineMax > 0 {
+		inline, err := ReadInlineAsset(asset, opts.InlineMax)
+		if err != nil {
+			return AssetRef{}, err
+		}
+		if inline != nil {
+			return AssetRef{
+				Tag: &amp.Tag{
+					ContentType: inline.ContentType,
+				},
+				Inline: inline,
+			}, nil
+		}
+	}
+
+	url, err := sess.AssetPublisher().PublishAsset(asset, opts.PublishOpts)
+	if err != nil {
+		return AssetRef{}, err
+	}
+	return AssetRef{
+		Tag: &amp.Tag{
+			ContentType: asset.ContentType(),
+			URL:         url,
+		},
+	}, nil
+}
+
+// ReadInlineAsset reads the content of the given asset if it is no larger than maxSize, otherwise returns nil.
+// The asset must be ready to read (e.g. started).
+func ReadInlineAsset(asset media.Asset, maxSize int64) (*InlineAsset, error) {
+	reader, err := asset.NewAssetReader()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	size, err := reader.Seek(0, io.SeekEnd)
+	if err != nil || size > maxSize {
+		return nil, err
+	}
+	if _, err = reader.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	inline := &InlineAsset{
+		ContentType: asset.ContentType(),
+		Content:     make([]byte, size),
+	}
+	if _, err = io.ReadFull(reader, inline.Content); err != nil {
+		return nil, err
+	}
+	if stat, ok := asset.(media.AssetStat); ok {
+		inline.ETag = stat.ETag()
+	}
+	return inline, nil
+}
+
+// PutAs writes this AssetRef as the given property of the cell being written.
+// If the asset is inline, its Tag.URL refers to its InlineAsset (see InlineAssetURL), which is written alongside.
+func (ref AssetRef) PutAs(w CellWriter, propertyID tag.ID) {
+	if ref.Inline == nil {
+		w.PutItem(propertyID, ref.Tag)
+		return
+	}
+	inlineID := InlineAssetID(propertyID)
+	assetTag := *ref.Tag
+	assetTag.URL = InlineAssetURL + inlineID.Base32()
+	w.PutItem(propertyID, &assetTag)
+	w.PutItem(inlineID, ref.Inline)
+}
+
+// Prefetch hints that the given assets (typically those of this pin's cells) will likely be requested soon,
+// so the host warms its caches or pushes small assets to the client over this pin's tx stream.
+// Hints are advisory: they are dropped if the session has no Prefetcher or once this pin closes.
+func (pin *Pin[AppT]) Prefetch(hints ...*amp.PrefetchHint) {
+	prefetcher := amp.ServicesOf(pin.App.Session()).Prefetcher
+	if prefetcher == nil || len(hints) == 0 {
+		return
+	}
+	prefetcher.Prefetch(pin.ctx, pin.Op, hints...)
+}
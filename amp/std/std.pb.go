@@ -9,6 +9,7 @@ import (
 	fmt "fmt"
 	amp "github.com/art-media-platform/amp-sdk-go/amp"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -62,6 +63,33 @@ func (CordType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_b6f70fdd671fe185, []int{0}
 }
 
+type GeometryType int32
+
+const (
+	GeometryType_Unspecified GeometryType = 0
+	GeometryType_Point       GeometryType = 1
+	GeometryType_LineString  GeometryType = 2
+	GeometryType_Polygon     GeometryType = 3
+)
+
+var GeometryType_name = map[int32]string{
+	0: "GeometryType_Unspecified",
+	1: "GeometryType_Point",
+	2: "GeometryType_LineString",
+	3: "GeometryType_Polygon",
+}
+
+var GeometryType_value = map[string]int32{
+	"GeometryType_Unspecified": 0,
+	"GeometryType_Point":       1,
+	"GeometryType_LineString":  2,
+	"GeometryType_Polygon":     3,
+}
+
+func (GeometryType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b6f70fdd671fe185, []int{1}
+}
+
 type TRS_VisualScaleMode int32
 
 const (
@@ -80,7 +108,7 @@ var TRS_VisualScaleMode_value = map[string]int32{
 }
 
 func (TRS_VisualScaleMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_b6f70fdd671fe185, []int{4, 0}
+	return fileDescriptor_b6f70fdd671fe185, []int{6, 0}
 }
 
 // Position describes a position in space and/or time using a given coordinate system.
@@ -159,6 +187,110 @@ func (m *Position) GetROU() float32 {
 	return 0
 }
 
+// LatLng is a point on the WGS84 geoid.
+type LatLng struct {
+	Lat float64 `protobuf:"fixed64,1,opt,name=Lat,proto3" json:"Lat,omitempty"`
+	Lng float64 `protobuf:"fixed64,2,opt,name=Lng,proto3" json:"Lng,omitempty"`
+}
+
+func (m *LatLng) Reset()      { *m = LatLng{} }
+func (*LatLng) ProtoMessage() {}
+func (*LatLng) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6f70fdd671fe185, []int{1}
+}
+func (m *LatLng) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LatLng) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LatLng.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LatLng) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LatLng.Merge(m, src)
+}
+func (m *LatLng) XXX_Size() int {
+	return m.Size()
+}
+func (m *LatLng) XXX_DiscardUnknown() {
+	xxx_messageInfo_LatLng.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LatLng proto.InternalMessageInfo
+
+func (m *LatLng) GetLat() float64 {
+	if m != nil {
+		return m.Lat
+	}
+	return 0
+}
+
+func (m *LatLng) GetLng() float64 {
+	if m != nil {
+		return m.Lng
+	}
+	return 0
+}
+
+// Geometry is a point, path, or area on the WGS84 geoid (e.g. a venue, route, or region).
+type Geometry struct {
+	Type   GeometryType `protobuf:"varint,1,opt,name=Type,proto3,enum=std.GeometryType" json:"Type,omitempty"`
+	Points []*LatLng    `protobuf:"bytes,2,rep,name=Points,proto3" json:"Points,omitempty"`
+}
+
+func (m *Geometry) Reset()      { *m = Geometry{} }
+func (*Geometry) ProtoMessage() {}
+func (*Geometry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6f70fdd671fe185, []int{2}
+}
+func (m *Geometry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Geometry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Geometry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Geometry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Geometry.Merge(m, src)
+}
+func (m *Geometry) XXX_Size() int {
+	return m.Size()
+}
+func (m *Geometry) XXX_DiscardUnknown() {
+	xxx_messageInfo_Geometry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Geometry proto.InternalMessageInfo
+
+func (m *Geometry) GetType() GeometryType {
+	if m != nil {
+		return m.Type
+	}
+	return GeometryType_Unspecified
+}
+
+func (m *Geometry) GetPoints() []*LatLng {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
 type FSInfo struct {
 	Mode        string `protobuf:"bytes,1,opt,name=Mode,proto3" json:"Mode,omitempty"`
 	IsDir       bool   `protobuf:"varint,2,opt,name=IsDir,proto3" json:"IsDir,omitempty"`
@@ -173,7 +305,7 @@ type FSInfo struct {
 func (m *FSInfo) Reset()      { *m = FSInfo{} }
 func (*FSInfo) ProtoMessage() {}
 func (*FSInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6f70fdd671fe185, []int{1}
+	return fileDescriptor_b6f70fdd671fe185, []int{3}
 }
 func (m *FSInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -281,7 +413,7 @@ type Placement struct {
 func (m *Placement) Reset()      { *m = Placement{} }
 func (*Placement) ProtoMessage() {}
 func (*Placement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6f70fdd671fe185, []int{2}
+	return fileDescriptor_b6f70fdd671fe185, []int{4}
 }
 func (m *Placement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -393,7 +525,7 @@ type BadgeDigit struct {
 func (m *BadgeDigit) Reset()      { *m = BadgeDigit{} }
 func (*BadgeDigit) ProtoMessage() {}
 func (*BadgeDigit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6f70fdd671fe185, []int{3}
+	return fileDescriptor_b6f70fdd671fe185, []int{5}
 }
 func (m *BadgeDigit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -461,7 +593,7 @@ type TRS struct {
 func (m *TRS) Reset()      { *m = TRS{} }
 func (*TRS) ProtoMessage() {}
 func (*TRS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6f70fdd671fe185, []int{4}
+	return fileDescriptor_b6f70fdd671fe185, []int{6}
 }
 func (m *TRS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -560,25 +692,25 @@ func (m *TRS) GetRotate3() float32 {
 	return 0
 }
 
-type DataSegment struct {
-	ByteOfs    uint64 `protobuf:"varint,5,opt,name=ByteOfs,proto3" json:"ByteOfs,omitempty"`
-	ByteSz     uint64 `protobuf:"varint,6,opt,name=ByteSz,proto3" json:"ByteSz,omitempty"`
-	InlineData []byte `protobuf:"bytes,7,opt,name=InlineData,proto3" json:"InlineData,omitempty"`
-	StreamURI  string `protobuf:"bytes,9,opt,name=StreamURI,proto3" json:"StreamURI,omitempty"`
-	BlobID     int64  `protobuf:"varint,10,opt,name=BlobID,proto3" json:"BlobID,omitempty"`
+// Embedding is a vector embedding of a cell's content (e.g. an image, audio, or text), enabling similarity queries.
+type Embedding struct {
+	// Identifies the model that produced this embedding -- only embeddings from the same model are comparable.
+	Model string `protobuf:"bytes,1,opt,name=Model,proto3" json:"Model,omitempty"`
+	// Embedding vector components
+	Vector []float32 `protobuf:"fixed32,2,rep,packed,name=Vector,proto3" json:"Vector,omitempty"`
 }
 
-func (m *DataSegment) Reset()      { *m = DataSegment{} }
-func (*DataSegment) ProtoMessage() {}
-func (*DataSegment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6f70fdd671fe185, []int{5}
+func (m *Embedding) Reset()      { *m = Embedding{} }
+func (*Embedding) ProtoMessage() {}
+func (*Embedding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6f70fdd671fe185, []int{7}
 }
-func (m *DataSegment) XXX_Unmarshal(b []byte) error {
+func (m *Embedding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DataSegment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Embedding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DataSegment.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Embedding.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -588,1178 +720,7238 @@ func (m *DataSegment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *DataSegment) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DataSegment.Merge(m, src)
+func (m *Embedding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Embedding.Merge(m, src)
 }
-func (m *DataSegment) XXX_Size() int {
+func (m *Embedding) XXX_Size() int {
 	return m.Size()
 }
-func (m *DataSegment) XXX_DiscardUnknown() {
-	xxx_messageInfo_DataSegment.DiscardUnknown(m)
+func (m *Embedding) XXX_DiscardUnknown() {
+	xxx_messageInfo_Embedding.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DataSegment proto.InternalMessageInfo
+var xxx_messageInfo_Embedding proto.InternalMessageInfo
 
-func (m *DataSegment) GetByteOfs() uint64 {
+func (m *Embedding) GetModel() string {
 	if m != nil {
-		return m.ByteOfs
+		return m.Model
+	}
+	return ""
+}
+
+func (m *Embedding) GetVector() []float32 {
+	if m != nil {
+		return m.Vector
+	}
+	return nil
+}
+
+// TimeSeries is a run of consecutive fixed-width buckets summarizing samples over time (e.g. telemetry or analytics).
+// Bucket i spans [Start + i*Step, Start + (i+1)*Step) and buckets without samples have Count == 0.
+type TimeSeries struct {
+	Start  int64     `protobuf:"varint,1,opt,name=Start,proto3" json:"Start,omitempty"`
+	Step   int64     `protobuf:"varint,2,opt,name=Step,proto3" json:"Step,omitempty"`
+	Counts []uint32  `protobuf:"varint,4,rep,packed,name=Counts,proto3" json:"Counts,omitempty"`
+	Means  []float64 `protobuf:"fixed64,5,rep,packed,name=Means,proto3" json:"Means,omitempty"`
+	Mins   []float64 `protobuf:"fixed64,6,rep,packed,name=Mins,proto3" json:"Mins,omitempty"`
+	Maxs   []float64 `protobuf:"fixed64,7,rep,packed,name=Maxs,proto3" json:"Maxs,omitempty"`
+}
+
+func (m *TimeSeries) Reset()      { *m = TimeSeries{} }
+func (*TimeSeries) ProtoMessage() {}
+func (*TimeSeries) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6f70fdd671fe185, []int{8}
+}
+func (m *TimeSeries) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeSeries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeSeries.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimeSeries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeSeries.Merge(m, src)
+}
+func (m *TimeSeries) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeSeries) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeSeries.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeSeries proto.InternalMessageInfo
+
+func (m *TimeSeries) GetStart() int64 {
+	if m != nil {
+		return m.Start
 	}
 	return 0
 }
 
-func (m *DataSegment) GetByteSz() uint64 {
+func (m *TimeSeries) GetStep() int64 {
 	if m != nil {
-		return m.ByteSz
+		return m.Step
 	}
 	return 0
 }
 
-func (m *DataSegment) GetInlineData() []byte {
+func (m *TimeSeries) GetCounts() []uint32 {
 	if m != nil {
-		return m.InlineData
+		return m.Counts
 	}
 	return nil
 }
 
-func (m *DataSegment) GetStreamURI() string {
+func (m *TimeSeries) GetMeans() []float64 {
 	if m != nil {
-		return m.StreamURI
+		return m.Means
 	}
-	return ""
+	return nil
 }
 
-func (m *DataSegment) GetBlobID() int64 {
+func (m *TimeSeries) GetMins() []float64 {
 	if m != nil {
-		return m.BlobID
+		return m.Mins
 	}
-	return 0
+	return nil
 }
 
-func init() {
-	proto.RegisterEnum("std.CordType", CordType_name, CordType_value)
-	proto.RegisterEnum("std.TRS_VisualScaleMode", TRS_VisualScaleMode_name, TRS_VisualScaleMode_value)
-	proto.RegisterType((*Position)(nil), "std.Position")
-	proto.RegisterType((*FSInfo)(nil), "std.FSInfo")
-	proto.RegisterType((*Placement)(nil), "std.Placement")
-	proto.RegisterType((*BadgeDigit)(nil), "std.BadgeDigit")
-	proto.RegisterType((*TRS)(nil), "std.TRS")
-	proto.RegisterType((*DataSegment)(nil), "std.DataSegment")
+func (m *TimeSeries) GetMaxs() []float64 {
+	if m != nil {
+		return m.Maxs
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("amp/std/std.proto", fileDescriptor_b6f70fdd671fe185) }
-
-var fileDescriptor_b6f70fdd671fe185 = []byte{
-	// 831 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0x4f, 0x6f, 0x1b, 0x45,
-	0x18, 0xc6, 0x3d, 0x76, 0xec, 0xd8, 0x6f, 0x9a, 0xb0, 0x8c, 0x02, 0x0c, 0xa5, 0x5a, 0x59, 0xe6,
-	0xe2, 0x06, 0xc5, 0x89, 0xed, 0x82, 0xb8, 0x00, 0xca, 0x9f, 0xb6, 0x58, 0xc2, 0xc4, 0x9d, 0x4d,
-	0x4a, 0x9a, 0x4b, 0x34, 0xf1, 0x4e, 0xdc, 0x11, 0xbb, 0x3b, 0xcb, 0xee, 0x18, 0xb5, 0x3d, 0xf1,
-	0x11, 0xb8, 0xf0, 0x1d, 0x50, 0xef, 0x7c, 0x07, 0x8e, 0x39, 0xf6, 0xc0, 0x81, 0x38, 0x17, 0x8e,
-	0xfd, 0x00, 0x1c, 0xd0, 0xbc, 0xbb, 0x5e, 0x9b, 0x20, 0x0e, 0x96, 0xdf, 0xe7, 0xf7, 0xec, 0x9f,
-	0x79, 0xdf, 0x79, 0x76, 0xe0, 0x5d, 0x11, 0xc6, 0x3b, 0xa9, 0xf1, 0xed, 0xaf, 0x13, 0x27, 0xda,
-	0x68, 0x5a, 0x49, 0x8d, 0x7f, 0x77, 0xdd, 0x72, 0x11, 0xc6, 0x19, 0x6b, 0xfd, 0x00, 0xf5, 0x91,
-	0x4e, 0x95, 0x51, 0x3a, 0xa2, 0xf7, 0xa1, 0x7e, 0xa0, 0x13, 0xff, 0xf8, 0x65, 0x2c, 0x19, 0x69,
-	0x92, 0xf6, 0x46, 0x6f, 0xbd, 0x63, 0xef, 0x9e, 0x43, 0x5e, 0xd8, 0xf4, 0x0e, 0x90, 0x27, 0xac,
-	0xd2, 0x24, 0x6d, 0xc2, 0xc9, 0x13, 0xab, 0x38, 0x5b, 0xc9, 0x14, 0xb7, 0xca, 0x63, 0xd5, 0x4c,
-	0x79, 0xd4, 0x81, 0x0a, 0x3f, 0x3a, 0x61, 0xb5, 0x26, 0x69, 0x97, 0xb9, 0x2d, 0x5b, 0x7f, 0x10,
-	0xa8, 0x3d, 0xf2, 0x06, 0xd1, 0xa5, 0xa6, 0x14, 0x56, 0x86, 0xda, 0xcf, 0xde, 0xd6, 0xe0, 0x58,
-	0xd3, 0x4d, 0xa8, 0x0e, 0xd2, 0x43, 0x95, 0xb0, 0x72, 0x93, 0xb4, 0xeb, 0x3c, 0x13, 0xf6, 0xca,
-	0x6f, 0x45, 0x28, 0xf1, 0x9d, 0x0d, 0x8e, 0x35, 0x65, 0xb0, 0x6a, 0xff, 0xbf, 0x91, 0x11, 0xbe,
-	0xbc, 0xca, 0xe7, 0x92, 0x36, 0x61, 0xed, 0x40, 0x47, 0x46, 0x46, 0x06, 0x9b, 0xa9, 0xe2, 0x4d,
-	0xcb, 0x88, 0xde, 0x83, 0xc6, 0x41, 0x22, 0x85, 0x91, 0xfe, 0x9e, 0x61, 0xab, 0x4d, 0xd2, 0xae,
-	0xf0, 0x05, 0xa0, 0x2e, 0xc0, 0x50, 0xfb, 0xea, 0x52, 0xa1, 0x5d, 0x47, 0x7b, 0x89, 0xd0, 0xbb,
-	0x50, 0xdf, 0x7f, 0x69, 0xa4, 0xa7, 0x5e, 0x49, 0xd6, 0x40, 0xb7, 0xd0, 0xad, 0xbf, 0x09, 0x34,
-	0x46, 0x81, 0x18, 0xcb, 0x50, 0x46, 0xc6, 0xae, 0x7b, 0xa4, 0xd3, 0x5d, 0xec, 0x90, 0x70, 0xac,
-	0x73, 0xd6, 0xc5, 0x06, 0x33, 0xd6, 0xcd, 0x59, 0x2f, 0x9f, 0x29, 0xd6, 0xf4, 0x7d, 0xa8, 0x79,
-	0x63, 0x11, 0xc8, 0x5d, 0x6c, 0xaf, 0xcc, 0x73, 0x55, 0xf0, 0x2e, 0x36, 0x36, 0xe7, 0xdd, 0x82,
-	0xf7, 0xf2, 0x69, 0xe7, 0xca, 0xf2, 0x87, 0xd3, 0x40, 0x26, 0xa7, 0xd8, 0x68, 0x99, 0xe7, 0xaa,
-	0xe0, 0xcf, 0xb0, 0xc3, 0x39, 0x7f, 0x56, 0xf0, 0x33, 0xec, 0x6d, 0xce, 0xcf, 0xe8, 0xc7, 0x50,
-	0x1b, 0x4a, 0x93, 0xa8, 0x31, 0xbb, 0x83, 0xe9, 0x58, 0xeb, 0xd8, 0x1c, 0x65, 0x88, 0xe7, 0x56,
-	0xeb, 0x29, 0xc0, 0xbe, 0xf0, 0x27, 0xf2, 0x50, 0x4d, 0x94, 0xb1, 0x63, 0xde, 0x0b, 0xe3, 0x40,
-	0x99, 0x69, 0xbe, 0xcb, 0x15, 0xbe, 0x00, 0x74, 0x0b, 0x9c, 0x42, 0x0c, 0xb5, 0x3f, 0x0d, 0xa6,
-	0x29, 0x0e, 0xa5, 0xc2, 0xff, 0xc3, 0x5b, 0xbf, 0x95, 0xa1, 0x72, 0xcc, 0x3d, 0xba, 0x01, 0xe5,
-	0xd3, 0x2e, 0xbb, 0x8f, 0x63, 0x2a, 0x9f, 0x76, 0x51, 0xf7, 0xd8, 0x56, 0xae, 0x7b, 0xa8, 0xfb,
-	0xec, 0x93, 0x5c, 0xf7, 0xe9, 0x67, 0xd0, 0xc0, 0x31, 0x60, 0xce, 0x7a, 0xb8, 0x6e, 0x86, 0xa9,
-	0x3e, 0xe6, 0x5e, 0xe7, 0xa9, 0x4a, 0xa7, 0x22, 0x28, 0x7c, 0xbe, 0xb8, 0x74, 0x69, 0xc8, 0xfd,
-	0xff, 0x19, 0xf2, 0x83, 0xdb, 0x43, 0xc6, 0xaa, 0xcf, 0x3e, 0x5d, 0xe2, 0x7d, 0x1b, 0x52, 0xae,
-	0x8d, 0x30, 0xb2, 0xcb, 0xbe, 0x40, 0x63, 0x2e, 0x17, 0x4e, 0x8f, 0x7d, 0xb9, 0xec, 0xf4, 0x16,
-	0x4e, 0x9f, 0x7d, 0xb5, 0xec, 0xf4, 0x5b, 0xbb, 0xf0, 0xce, 0xad, 0x35, 0xd3, 0x75, 0x68, 0xec,
-	0x4d, 0x8d, 0x46, 0xe0, 0x94, 0xe8, 0x06, 0xc0, 0x23, 0xf5, 0x42, 0xfa, 0x99, 0x26, 0xad, 0x5f,
-	0x08, 0xac, 0x1d, 0x0a, 0x23, 0x3c, 0x39, 0xc1, 0x40, 0x32, 0x58, 0xb5, 0x51, 0x3d, 0xba, 0x4c,
-	0x31, 0x3d, 0x2b, 0x7c, 0x2e, 0x6d, 0x07, 0x18, 0xe2, 0x57, 0x18, 0x9f, 0x15, 0x9e, 0x2b, 0xfb,
-	0x31, 0x0c, 0xa2, 0x40, 0x45, 0xd2, 0x3e, 0x06, 0x23, 0x74, 0x87, 0x2f, 0x11, 0xbb, 0xc7, 0x9e,
-	0x49, 0xa4, 0x08, 0x4f, 0xf8, 0x00, 0x13, 0xd3, 0xe0, 0x0b, 0x80, 0x4f, 0x0d, 0xf4, 0xc5, 0xe0,
-	0x90, 0x01, 0xee, 0x6c, 0xae, 0xb6, 0x5e, 0x93, 0xc5, 0x69, 0x43, 0x19, 0x6c, 0xce, 0xeb, 0xf3,
-	0x93, 0x28, 0x8d, 0xe5, 0x18, 0xbf, 0x34, 0xa7, 0x44, 0x37, 0xc1, 0x29, 0x9c, 0xa3, 0xc4, 0x97,
-	0x89, 0xf4, 0x1d, 0x42, 0xef, 0x01, 0x2b, 0xe8, 0x28, 0x10, 0x91, 0x3c, 0x3f, 0x10, 0x89, 0x91,
-	0xa9, 0x12, 0x91, 0x53, 0xa5, 0x1f, 0xc1, 0x07, 0xb7, 0xdc, 0xaf, 0xe5, 0x8b, 0x87, 0x3f, 0xca,
-	0x88, 0x3b, 0x35, 0xfa, 0x21, 0xbc, 0x57, 0x98, 0x8f, 0xa5, 0x56, 0xfe, 0xb9, 0x17, 0x3f, 0x97,
-	0x89, 0x74, 0xe0, 0x5f, 0xab, 0xc8, 0xac, 0xef, 0x1e, 0x7b, 0x9f, 0x3f, 0x70, 0xd6, 0xf6, 0xe3,
-	0xab, 0x6b, 0xb7, 0xf4, 0xe6, 0xda, 0x2d, 0xbd, 0xbd, 0x76, 0xc9, 0x4f, 0x33, 0x97, 0xfc, 0x3a,
-	0x73, 0xc9, 0xef, 0x33, 0x97, 0x5c, 0xcd, 0x5c, 0xf2, 0xe7, 0xcc, 0x25, 0x7f, 0xcd, 0xdc, 0xd2,
-	0xdb, 0x99, 0x4b, 0x7e, 0xbe, 0x71, 0x4b, 0x57, 0x37, 0x6e, 0xe9, 0xcd, 0x8d, 0x5b, 0x3a, 0xdb,
-	0x9d, 0x28, 0xf3, 0x7c, 0x7a, 0xd1, 0x19, 0xeb, 0x70, 0x47, 0x24, 0x66, 0x3b, 0x94, 0xbe, 0x12,
-	0xdb, 0x71, 0x20, 0xcc, 0xa5, 0x4e, 0x42, 0x7b, 0x08, 0x6f, 0xa7, 0xfe, 0xf7, 0xdb, 0x13, 0xbd,
-	0x93, 0x9f, 0xd5, 0xaf, 0xcb, 0xab, 0x7b, 0xc3, 0x51, 0xc7, 0x33, 0xfe, 0x45, 0x0d, 0x8f, 0xe7,
-	0xfe, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd9, 0x0a, 0xdb, 0xb8, 0xc7, 0x05, 0x00, 0x00,
+// MediaInfo describes an asset's content as extracted from its headers and embedded tags (e.g. EXIF or ID3) -- see std.ExtractMediaInfo
+// Zero values denote unknown fields.
+type MediaInfo struct {
+	ContentType string  `protobuf:"bytes,1,opt,name=ContentType,proto3" json:"ContentType,omitempty"`
+	ByteSize    int64   `protobuf:"varint,2,opt,name=ByteSize,proto3" json:"ByteSize,omitempty"`
+	Width       int32   `protobuf:"varint,3,opt,name=Width,proto3" json:"Width,omitempty"`
+	Height      int32   `protobuf:"varint,4,opt,name=Height,proto3" json:"Height,omitempty"`
+	DurationMs  int64   `protobuf:"varint,5,opt,name=DurationMs,proto3" json:"DurationMs,omitempty"`
+	Orientation int32   `protobuf:"varint,6,opt,name=Orientation,proto3" json:"Orientation,omitempty"`
+	TakenAt     int64   `protobuf:"varint,7,opt,name=TakenAt,proto3" json:"TakenAt,omitempty"`
+	CameraMake  string  `protobuf:"bytes,8,opt,name=CameraMake,proto3" json:"CameraMake,omitempty"`
+	CameraModel string  `protobuf:"bytes,9,opt,name=CameraModel,proto3" json:"CameraModel,omitempty"`
+	Location    *LatLng `protobuf:"bytes,10,opt,name=Location,proto3" json:"Location,omitempty"`
+	Title       string  `protobuf:"bytes,12,opt,name=Title,proto3" json:"Title,omitempty"`
+	Artist      string  `protobuf:"bytes,13,opt,name=Artist,proto3" json:"Artist,omitempty"`
+	Album       string  `protobuf:"bytes,14,opt,name=Album,proto3" json:"Album,omitempty"`
+	Genre       string  `protobuf:"bytes,15,opt,name=Genre,proto3" json:"Genre,omitempty"`
+	Year        int32   `protobuf:"varint,16,opt,name=Year,proto3" json:"Year,omitempty"`
+	Track       int32   `protobuf:"varint,17,opt,name=Track,proto3" json:"Track,omitempty"`
 }
 
-func (x CordType) String() string {
-	s, ok := CordType_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+func (m *MediaInfo) Reset()      { *m = MediaInfo{} }
+func (*MediaInfo) ProtoMessage() {}
+func (*MediaInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6f70fdd671fe185, []int{9}
 }
-func (x TRS_VisualScaleMode) String() string {
-	s, ok := TRS_VisualScaleMode_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+func (m *MediaInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (m *Position) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MediaInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MediaInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *Position) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MediaInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MediaInfo.Merge(m, src)
+}
+func (m *MediaInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *MediaInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_MediaInfo.DiscardUnknown(m)
 }
 
-func (m *Position) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ROU != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.ROU))))
-		i--
-		dAtA[i] = 0x35
-	}
-	if m.S != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.S))))
-		i--
-		dAtA[i] = 0x29
-	}
-	if m.R != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.R))))
-		i--
-		dAtA[i] = 0x21
+var xxx_messageInfo_MediaInfo proto.InternalMessageInfo
+
+func (m *MediaInfo) GetContentType() string {
+	if m != nil {
+		return m.ContentType
 	}
-	if m.Q != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Q))))
-		i--
-		dAtA[i] = 0x19
+	return ""
+}
+
+func (m *MediaInfo) GetByteSize() int64 {
+	if m != nil {
+		return m.ByteSize
 	}
-	if m.CordType != 0 {
-		i = encodeVarintStd(dAtA, i, uint64(m.CordType))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *MediaInfo) GetWidth() int32 {
+	if m != nil {
+		return m.Width
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *FSInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MediaInfo) GetHeight() int32 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *FSInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MediaInfo) GetDurationMs() int64 {
+	if m != nil {
+		return m.DurationMs
+	}
+	return 0
 }
 
-func (m *FSInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ByteSize != 0 {
-		i = encodeVarintStd(dAtA, i, uint64(m.ByteSize))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.ModifiedAt != 0 {
-		i = encodeVarintStd(dAtA, i, uint64(m.ModifiedAt))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.CreatedAt != 0 {
-		i = encodeVarintStd(dAtA, i, uint64(m.CreatedAt))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.ContentType) > 0 {
-		i -= len(m.ContentType)
-		copy(dAtA[i:], m.ContentType)
-		i = encodeVarintStd(dAtA, i, uint64(len(m.ContentType)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.NameLen != 0 {
-		i = encodeVarintStd(dAtA, i, uint64(m.NameLen))
-		i--
-		dAtA[i] = 0x20
+func (m *MediaInfo) GetOrientation() int32 {
+	if m != nil {
+		return m.Orientation
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintStd(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *MediaInfo) GetTakenAt() int64 {
+	if m != nil {
+		return m.TakenAt
 	}
-	if m.IsDir {
-		i--
-		if m.IsDir {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *MediaInfo) GetCameraMake() string {
+	if m != nil {
+		return m.CameraMake
 	}
-	if len(m.Mode) > 0 {
-		i -= len(m.Mode)
-		copy(dAtA[i:], m.Mode)
-		i = encodeVarintStd(dAtA, i, uint64(len(m.Mode)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MediaInfo) GetCameraModel() string {
+	if m != nil {
+		return m.CameraModel
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Placement) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MediaInfo) GetLocation() *LatLng {
+	if m != nil {
+		return m.Location
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Placement) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MediaInfo) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
 }
 
-func (m *Placement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Metric != 0 {
-		i = encodeVarintStd(dAtA, i, uint64(m.Metric))
-		i--
-		dAtA[i] = 0x60
+func (m *MediaInfo) GetArtist() string {
+	if m != nil {
+		return m.Artist
 	}
-	if m.EulerZ != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.EulerZ))))
-		i--
-		dAtA[i] = 0x4d
+	return ""
+}
+
+func (m *MediaInfo) GetAlbum() string {
+	if m != nil {
+		return m.Album
 	}
-	if m.EulerY != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.EulerY))))
-		i--
-		dAtA[i] = 0x45
+	return ""
+}
+
+func (m *MediaInfo) GetGenre() string {
+	if m != nil {
+		return m.Genre
 	}
-	if m.EulerX != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.EulerX))))
-		i--
-		dAtA[i] = 0x3d
+	return ""
+}
+
+func (m *MediaInfo) GetYear() int32 {
+	if m != nil {
+		return m.Year
 	}
-	if m.Scale2 != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Scale2))))
-		i--
-		dAtA[i] = 0x35
+	return 0
+}
+
+func (m *MediaInfo) GetTrack() int32 {
+	if m != nil {
+		return m.Track
 	}
-	if m.Scale1 != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Scale1))))
-		i--
-		dAtA[i] = 0x2d
+	return 0
+}
+
+// InlineAsset carries a small asset's content within a tx, sparing the client a separate HTTP fetch -- see std.PrepareAsset
+type InlineAsset struct {
+	ContentType string `protobuf:"bytes,1,opt,name=ContentType,proto3" json:"ContentType,omitempty"`
+	ETag        string `protobuf:"bytes,2,opt,name=ETag,proto3" json:"ETag,omitempty"`
+	Content     []byte `protobuf:"bytes,3,opt,name=Content,proto3" json:"Content,omitempty"`
+}
+
+func (m *InlineAsset) Reset()      { *m = InlineAsset{} }
+func (*InlineAsset) ProtoMessage() {}
+func (*InlineAsset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6f70fdd671fe185, []int{10}
+}
+func (m *InlineAsset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InlineAsset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InlineAsset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Scale0 != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Scale0))))
-		i--
-		dAtA[i] = 0x25
+}
+func (m *InlineAsset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InlineAsset.Merge(m, src)
+}
+func (m *InlineAsset) XXX_Size() int {
+	return m.Size()
+}
+func (m *InlineAsset) XXX_DiscardUnknown() {
+	xxx_messageInfo_InlineAsset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InlineAsset proto.InternalMessageInfo
+
+func (m *InlineAsset) GetContentType() string {
+	if m != nil {
+		return m.ContentType
 	}
-	if m.Pos2 != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Pos2))))
-		i--
-		dAtA[i] = 0x19
+	return ""
+}
+
+func (m *InlineAsset) GetETag() string {
+	if m != nil {
+		return m.ETag
 	}
-	if m.Pos1 != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Pos1))))
-		i--
-		dAtA[i] = 0x11
+	return ""
+}
+
+func (m *InlineAsset) GetContent() []byte {
+	if m != nil {
+		return m.Content
 	}
-	if m.Pos0 != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Pos0))))
-		i--
-		dAtA[i] = 0x9
+	return nil
+}
+
+// UserProfile is a user's standard profile and settings, served and written via the "user:" sys app -- see amp/sys/user
+// Zero values denote unset fields, for which a client uses its own default.
+type UserProfile struct {
+	DisplayName string   `protobuf:"bytes,1,opt,name=DisplayName,proto3" json:"DisplayName,omitempty"`
+	Avatar      *amp.Tag `protobuf:"bytes,2,opt,name=Avatar,proto3" json:"Avatar,omitempty"`
+	Locale      string   `protobuf:"bytes,3,opt,name=Locale,proto3" json:"Locale,omitempty"`
+	TimeZone    string   `protobuf:"bytes,4,opt,name=TimeZone,proto3" json:"TimeZone,omitempty"`
+	// Preferences are keyed by a dotted name, prefixed by the app that defines it (e.g. "ui.theme" or "com.example.player.autoplay").
+	Preferences map[string]string `protobuf:"bytes,8,rep,name=Preferences,proto3" json:"Preferences,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *UserProfile) Reset()      { *m = UserProfile{} }
+func (*UserProfile) ProtoMessage() {}
+func (*UserProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6f70fdd671fe185, []int{11}
+}
+func (m *UserProfile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserProfile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *UserProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserProfile.Merge(m, src)
+}
+func (m *UserProfile) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserProfile.DiscardUnknown(m)
 }
 
-func (m *BadgeDigit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UserProfile proto.InternalMessageInfo
+
+func (m *UserProfile) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *BadgeDigit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *UserProfile) GetAvatar() *amp.Tag {
+	if m != nil {
+		return m.Avatar
+	}
+	return nil
 }
 
-func (m *BadgeDigit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AmplitudeModulus != 0 {
-		i = encodeVarintStd(dAtA, i, uint64(m.AmplitudeModulus))
-		i--
-		dAtA[i] = 0x10
+func (m *UserProfile) GetLocale() string {
+	if m != nil {
+		return m.Locale
 	}
-	if m.Amplitude != 0 {
-		i = encodeVarintStd(dAtA, i, uint64(m.Amplitude))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *UserProfile) GetTimeZone() string {
+	if m != nil {
+		return m.TimeZone
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *TRS) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *UserProfile) GetPreferences() map[string]string {
+	if m != nil {
+		return m.Preferences
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *TRS) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CalendarEvent is a calendar event (an iCalendar VEVENT), as served via the "dav:" sys app -- see amp/sys/dav
+// Times are UTC << 16 (see tag.FromTime) and zero values denote unset fields.
+type CalendarEvent struct {
+	UID         string      `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
+	Summary     string      `protobuf:"bytes,2,opt,name=Summary,proto3" json:"Summary,omitempty"`
+	Description string      `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
+	Location    string      `protobuf:"bytes,4,opt,name=Location,proto3" json:"Location,omitempty"`
+	StartAt     int64       `protobuf:"varint,5,opt,name=StartAt,proto3" json:"StartAt,omitempty"`
+	EndAt       int64       `protobuf:"varint,6,opt,name=EndAt,proto3" json:"EndAt,omitempty"`
+	AllDay      bool        `protobuf:"varint,7,opt,name=AllDay,proto3" json:"AllDay,omitempty"`
+	TimeZone    string      `protobuf:"bytes,8,opt,name=TimeZone,proto3" json:"TimeZone,omitempty"`
+	Recurrence  string      `protobuf:"bytes,9,opt,name=Recurrence,proto3" json:"Recurrence,omitempty"`
+	Status      string      `protobuf:"bytes,10,opt,name=Status,proto3" json:"Status,omitempty"`
+	Organizer   *Attendee   `protobuf:"bytes,11,opt,name=Organizer,proto3" json:"Organizer,omitempty"`
+	Attendees   []*Attendee `protobuf:"bytes,12,rep,name=Attendees,proto3" json:"Attendees,omitempty"`
 }
 
-func (m *TRS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Rotate3 != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Rotate3))))
-		i--
-		dAtA[i] = 0x3
-		i--
-		dAtA[i] = 0xfd
-	}
-	if m.Rotate2 != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Rotate2))))
-		i--
-		dAtA[i] = 0x3
-		i--
-		dAtA[i] = 0xf5
-	}
-	if m.Rotate1 != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Rotate1))))
-		i--
-		dAtA[i] = 0x3
-		i--
-		dAtA[i] = 0xed
-	}
-	if m.Scale3 != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Scale3))))
-		i--
-		dAtA[i] = 0x3
-		i--
-		dAtA[i] = 0xad
-	}
-	if m.Scale2 != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Scale2))))
-		i--
-		dAtA[i] = 0x3
-		i--
-		dAtA[i] = 0xa5
-	}
-	if m.Scale1 != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Scale1))))
-		i--
-		dAtA[i] = 0x3
-		i--
-		dAtA[i] = 0x9d
-	}
-	if m.ScaleMode != 0 {
-		i = encodeVarintStd(dAtA, i, uint64(m.ScaleMode))
-		i--
-		dAtA[i] = 0x3
-		i--
-		dAtA[i] = 0x90
+func (m *CalendarEvent) Reset()      { *m = CalendarEvent{} }
+func (*CalendarEvent) ProtoMessage() {}
+func (*CalendarEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6f70fdd671fe185, []int{12}
+}
+func (m *CalendarEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CalendarEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CalendarEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.X3 != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.X3))))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xd9
+}
+func (m *CalendarEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CalendarEvent.Merge(m, src)
+}
+func (m *CalendarEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *CalendarEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_CalendarEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CalendarEvent proto.InternalMessageInfo
+
+func (m *CalendarEvent) GetUID() string {
+	if m != nil {
+		return m.UID
 	}
-	if m.X2 != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.X2))))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xd1
+	return ""
+}
+
+func (m *CalendarEvent) GetSummary() string {
+	if m != nil {
+		return m.Summary
 	}
-	if m.X1 != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.X1))))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xc9
+	return ""
+}
+
+func (m *CalendarEvent) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *DataSegment) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CalendarEvent) GetLocation() string {
+	if m != nil {
+		return m.Location
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DataSegment) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CalendarEvent) GetStartAt() int64 {
+	if m != nil {
+		return m.StartAt
+	}
+	return 0
 }
 
-func (m *DataSegment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlobID != 0 {
-		i = encodeVarintStd(dAtA, i, uint64(m.BlobID))
-		i--
-		dAtA[i] = 0x50
+func (m *CalendarEvent) GetEndAt() int64 {
+	if m != nil {
+		return m.EndAt
 	}
-	if len(m.StreamURI) > 0 {
-		i -= len(m.StreamURI)
-		copy(dAtA[i:], m.StreamURI)
-		i = encodeVarintStd(dAtA, i, uint64(len(m.StreamURI)))
-		i--
-		dAtA[i] = 0x4a
+	return 0
+}
+
+func (m *CalendarEvent) GetAllDay() bool {
+	if m != nil {
+		return m.AllDay
 	}
-	if len(m.InlineData) > 0 {
-		i -= len(m.InlineData)
-		copy(dAtA[i:], m.InlineData)
-		i = encodeVarintStd(dAtA, i, uint64(len(m.InlineData)))
-		i--
-		dAtA[i] = 0x3a
+	return false
+}
+
+func (m *CalendarEvent) GetTimeZone() string {
+	if m != nil {
+		return m.TimeZone
 	}
-	if m.ByteSz != 0 {
-		i = encodeVarintStd(dAtA, i, uint64(m.ByteSz))
-		i--
-		dAtA[i] = 0x30
+	return ""
+}
+
+func (m *CalendarEvent) GetRecurrence() string {
+	if m != nil {
+		return m.Recurrence
 	}
-	if m.ByteOfs != 0 {
-		i = encodeVarintStd(dAtA, i, uint64(m.ByteOfs))
-		i--
-		dAtA[i] = 0x28
+	return ""
+}
+
+func (m *CalendarEvent) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintStd(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStd(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *CalendarEvent) GetOrganizer() *Attendee {
+	if m != nil {
+		return m.Organizer
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (this *Position) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *CalendarEvent) GetAttendees() []*Attendee {
+	if m != nil {
+		return m.Attendees
 	}
+	return nil
+}
 
-	that1, ok := that.(*Position)
-	if !ok {
-		that2, ok := that.(Position)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// Attendee is a participant of a CalendarEvent.
+type Attendee struct {
+	Email  string `protobuf:"bytes,1,opt,name=Email,proto3" json:"Email,omitempty"`
+	Name   string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Role   string `protobuf:"bytes,3,opt,name=Role,proto3" json:"Role,omitempty"`
+	Status string `protobuf:"bytes,4,opt,name=Status,proto3" json:"Status,omitempty"`
+}
+
+func (m *Attendee) Reset()      { *m = Attendee{} }
+func (*Attendee) ProtoMessage() {}
+func (*Attendee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6f70fdd671fe185, []int{13}
+}
+func (m *Attendee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Attendee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Attendee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.CordType != that1.CordType {
-		return false
-	}
-	if this.Q != that1.Q {
-		return false
-	}
-	if this.R != that1.R {
-		return false
-	}
-	if this.S != that1.S {
-		return false
+}
+func (m *Attendee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Attendee.Merge(m, src)
+}
+func (m *Attendee) XXX_Size() int {
+	return m.Size()
+}
+func (m *Attendee) XXX_DiscardUnknown() {
+	xxx_messageInfo_Attendee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Attendee proto.InternalMessageInfo
+
+func (m *Attendee) GetEmail() string {
+	if m != nil {
+		return m.Email
 	}
-	if this.ROU != that1.ROU {
-		return false
+	return ""
+}
+
+func (m *Attendee) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return true
+	return ""
 }
-func (this *FSInfo) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *Attendee) GetRole() string {
+	if m != nil {
+		return m.Role
 	}
+	return ""
+}
 
-	that1, ok := that.(*FSInfo)
-	if !ok {
-		that2, ok := that.(FSInfo)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *Attendee) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Mode != that1.Mode {
-		return false
-	}
-	if this.IsDir != that1.IsDir {
-		return false
-	}
-	if this.Name != that1.Name {
-		return false
-	}
-	if this.NameLen != that1.NameLen {
-		return false
+	return ""
+}
+
+// Contact is an address book entry (a vCard), as served via the "dav:" sys app -- see amp/sys/dav
+type Contact struct {
+	UID        string          `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
+	FullName   string          `protobuf:"bytes,2,opt,name=FullName,proto3" json:"FullName,omitempty"`
+	GivenName  string          `protobuf:"bytes,3,opt,name=GivenName,proto3" json:"GivenName,omitempty"`
+	FamilyName string          `protobuf:"bytes,4,opt,name=FamilyName,proto3" json:"FamilyName,omitempty"`
+	Org        string          `protobuf:"bytes,5,opt,name=Org,proto3" json:"Org,omitempty"`
+	Title      string          `protobuf:"bytes,6,opt,name=Title,proto3" json:"Title,omitempty"`
+	Emails     []*ContactValue `protobuf:"bytes,7,rep,name=Emails,proto3" json:"Emails,omitempty"`
+	Phones     []*ContactValue `protobuf:"bytes,8,rep,name=Phones,proto3" json:"Phones,omitempty"`
+	Addresses  []*ContactValue `protobuf:"bytes,9,rep,name=Addresses,proto3" json:"Addresses,omitempty"`
+	Birthday   string          `protobuf:"bytes,10,opt,name=Birthday,proto3" json:"Birthday,omitempty"`
+	Note       string          `protobuf:"bytes,11,opt,name=Note,proto3" json:"Note,omitempty"`
+}
+
+func (m *Contact) Reset()      { *m = Contact{} }
+func (*Contact) ProtoMessage() {}
+func (*Contact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6f70fdd671fe185, []int{14}
+}
+func (m *Contact) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Contact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Contact.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.ContentType != that1.ContentType {
-		return false
+}
+func (m *Contact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Contact.Merge(m, src)
+}
+func (m *Contact) XXX_Size() int {
+	return m.Size()
+}
+func (m *Contact) XXX_DiscardUnknown() {
+	xxx_messageInfo_Contact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Contact proto.InternalMessageInfo
+
+func (m *Contact) GetUID() string {
+	if m != nil {
+		return m.UID
 	}
-	if this.CreatedAt != that1.CreatedAt {
-		return false
+	return ""
+}
+
+func (m *Contact) GetFullName() string {
+	if m != nil {
+		return m.FullName
 	}
-	if this.ModifiedAt != that1.ModifiedAt {
-		return false
+	return ""
+}
+
+func (m *Contact) GetGivenName() string {
+	if m != nil {
+		return m.GivenName
 	}
-	if this.ByteSize != that1.ByteSize {
-		return false
+	return ""
+}
+
+func (m *Contact) GetFamilyName() string {
+	if m != nil {
+		return m.FamilyName
 	}
-	return true
+	return ""
 }
-func (this *Placement) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *Contact) GetOrg() string {
+	if m != nil {
+		return m.Org
 	}
+	return ""
+}
 
-	that1, ok := that.(*Placement)
-	if !ok {
-		that2, ok := that.(Placement)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *Contact) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *Contact) GetEmails() []*ContactValue {
+	if m != nil {
+		return m.Emails
 	}
-	if this.Pos0 != that1.Pos0 {
-		return false
+	return nil
+}
+
+func (m *Contact) GetPhones() []*ContactValue {
+	if m != nil {
+		return m.Phones
 	}
-	if this.Pos1 != that1.Pos1 {
-		return false
+	return nil
+}
+
+func (m *Contact) GetAddresses() []*ContactValue {
+	if m != nil {
+		return m.Addresses
 	}
-	if this.Pos2 != that1.Pos2 {
-		return false
+	return nil
+}
+
+func (m *Contact) GetBirthday() string {
+	if m != nil {
+		return m.Birthday
 	}
-	if this.Scale0 != that1.Scale0 {
-		return false
+	return ""
+}
+
+func (m *Contact) GetNote() string {
+	if m != nil {
+		return m.Note
 	}
-	if this.Scale1 != that1.Scale1 {
-		return false
+	return ""
+}
+
+// ContactValue is a typed value of a Contact, such as a work email.
+type ContactValue struct {
+	Type  string `protobuf:"bytes,1,opt,name=Type,proto3" json:"Type,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=Value,proto3" json:"Value,omitempty"`
+}
+
+func (m *ContactValue) Reset()      { *m = ContactValue{} }
+func (*ContactValue) ProtoMessage() {}
+func (*ContactValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6f70fdd671fe185, []int{15}
+}
+func (m *ContactValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContactValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContactValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.Scale2 != that1.Scale2 {
-		return false
+}
+func (m *ContactValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactValue.Merge(m, src)
+}
+func (m *ContactValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContactValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContactValue proto.InternalMessageInfo
+
+func (m *ContactValue) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	if this.EulerX != that1.EulerX {
-		return false
+	return ""
+}
+
+func (m *ContactValue) GetValue() string {
+	if m != nil {
+		return m.Value
 	}
-	if this.EulerY != that1.EulerY {
-		return false
+	return ""
+}
+
+// CollectionWindow is the range of a large collection presented as the child cells of a pinned cell.
+// A client pages or scrolls by pinning the cell again with other window params -- see std.WindowOffsetParam
+type CollectionWindow struct {
+	Offset int64 `protobuf:"varint,1,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	Count  int64 `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+	Total  int64 `protobuf:"varint,3,opt,name=Total,proto3" json:"Total,omitempty"`
+}
+
+func (m *CollectionWindow) Reset()      { *m = CollectionWindow{} }
+func (*CollectionWindow) ProtoMessage() {}
+func (*CollectionWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6f70fdd671fe185, []int{16}
+}
+func (m *CollectionWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CollectionWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CollectionWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.EulerZ != that1.EulerZ {
-		return false
+}
+func (m *CollectionWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectionWindow.Merge(m, src)
+}
+func (m *CollectionWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *CollectionWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectionWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectionWindow proto.InternalMessageInfo
+
+func (m *CollectionWindow) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
 	}
-	if this.Metric != that1.Metric {
-		return false
+	return 0
+}
+
+func (m *CollectionWindow) GetCount() int64 {
+	if m != nil {
+		return m.Count
 	}
-	return true
+	return 0
 }
-func (this *BadgeDigit) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *CollectionWindow) GetTotal() int64 {
+	if m != nil {
+		return m.Total
 	}
+	return 0
+}
 
-	that1, ok := that.(*BadgeDigit)
-	if !ok {
-		that2, ok := that.(BadgeDigit)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// HealthStatus is the outcome of a host health check -- see amp.HealthChecks.
+type HealthStatus struct {
+	Name      string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	OK        bool   `protobuf:"varint,2,opt,name=OK,proto3" json:"OK,omitempty"`
+	Liveness  bool   `protobuf:"varint,3,opt,name=Liveness,proto3" json:"Liveness,omitempty"`
+	Detail    string `protobuf:"bytes,4,opt,name=Detail,proto3" json:"Detail,omitempty"`
+	LatencyMs int64  `protobuf:"varint,5,opt,name=LatencyMs,proto3" json:"LatencyMs,omitempty"`
+	CheckedAt int64  `protobuf:"varint,6,opt,name=CheckedAt,proto3" json:"CheckedAt,omitempty"`
+}
+
+func (m *HealthStatus) Reset()      { *m = HealthStatus{} }
+func (*HealthStatus) ProtoMessage() {}
+func (*HealthStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6f70fdd671fe185, []int{17}
+}
+func (m *HealthStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HealthStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HealthStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Amplitude != that1.Amplitude {
-		return false
+}
+func (m *HealthStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthStatus.Merge(m, src)
+}
+func (m *HealthStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *HealthStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealthStatus proto.InternalMessageInfo
+
+func (m *HealthStatus) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if this.AmplitudeModulus != that1.AmplitudeModulus {
-		return false
+	return ""
+}
+
+func (m *HealthStatus) GetOK() bool {
+	if m != nil {
+		return m.OK
 	}
-	return true
+	return false
 }
-func (this *TRS) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *HealthStatus) GetLiveness() bool {
+	if m != nil {
+		return m.Liveness
 	}
+	return false
+}
 
-	that1, ok := that.(*TRS)
-	if !ok {
-		that2, ok := that.(TRS)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *HealthStatus) GetDetail() string {
+	if m != nil {
+		return m.Detail
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *HealthStatus) GetLatencyMs() int64 {
+	if m != nil {
+		return m.LatencyMs
 	}
-	if this.X1 != that1.X1 {
-		return false
+	return 0
+}
+
+func (m *HealthStatus) GetCheckedAt() int64 {
+	if m != nil {
+		return m.CheckedAt
 	}
-	if this.X2 != that1.X2 {
-		return false
+	return 0
+}
+
+type DataSegment struct {
+	ByteOfs    uint64 `protobuf:"varint,5,opt,name=ByteOfs,proto3" json:"ByteOfs,omitempty"`
+	ByteSz     uint64 `protobuf:"varint,6,opt,name=ByteSz,proto3" json:"ByteSz,omitempty"`
+	InlineData []byte `protobuf:"bytes,7,opt,name=InlineData,proto3" json:"InlineData,omitempty"`
+	StreamURI  string `protobuf:"bytes,9,opt,name=StreamURI,proto3" json:"StreamURI,omitempty"`
+	BlobID     int64  `protobuf:"varint,10,opt,name=BlobID,proto3" json:"BlobID,omitempty"`
+}
+
+func (m *DataSegment) Reset()      { *m = DataSegment{} }
+func (*DataSegment) ProtoMessage() {}
+func (*DataSegment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6f70fdd671fe185, []int{18}
+}
+func (m *DataSegment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataSegment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataSegment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.X3 != that1.X3 {
-		return false
+}
+func (m *DataSegment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataSegment.Merge(m, src)
+}
+func (m *DataSegment) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataSegment) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataSegment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataSegment proto.InternalMessageInfo
+
+func (m *DataSegment) GetByteOfs() uint64 {
+	if m != nil {
+		return m.ByteOfs
 	}
-	if this.ScaleMode != that1.ScaleMode {
-		return false
+	return 0
+}
+
+func (m *DataSegment) GetByteSz() uint64 {
+	if m != nil {
+		return m.ByteSz
 	}
-	if this.Scale1 != that1.Scale1 {
-		return false
+	return 0
+}
+
+func (m *DataSegment) GetInlineData() []byte {
+	if m != nil {
+		return m.InlineData
 	}
-	if this.Scale2 != that1.Scale2 {
-		return false
+	return nil
+}
+
+func (m *DataSegment) GetStreamURI() string {
+	if m != nil {
+		return m.StreamURI
 	}
-	if this.Scale3 != that1.Scale3 {
-		return false
+	return ""
+}
+
+func (m *DataSegment) GetBlobID() int64 {
+	if m != nil {
+		return m.BlobID
 	}
-	if this.Rotate1 != that1.Rotate1 {
-		return false
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("std.CordType", CordType_name, CordType_value)
+	proto.RegisterEnum("std.GeometryType", GeometryType_name, GeometryType_value)
+	proto.RegisterEnum("std.TRS_VisualScaleMode", TRS_VisualScaleMode_name, TRS_VisualScaleMode_value)
+	proto.RegisterType((*Position)(nil), "std.Position")
+	proto.RegisterType((*LatLng)(nil), "std.LatLng")
+	proto.RegisterType((*Geometry)(nil), "std.Geometry")
+	proto.RegisterType((*FSInfo)(nil), "std.FSInfo")
+	proto.RegisterType((*Placement)(nil), "std.Placement")
+	proto.RegisterType((*BadgeDigit)(nil), "std.BadgeDigit")
+	proto.RegisterType((*TRS)(nil), "std.TRS")
+	proto.RegisterType((*Embedding)(nil), "std.Embedding")
+	proto.RegisterType((*TimeSeries)(nil), "std.TimeSeries")
+	proto.RegisterType((*MediaInfo)(nil), "std.MediaInfo")
+	proto.RegisterType((*InlineAsset)(nil), "std.InlineAsset")
+	proto.RegisterType((*UserProfile)(nil), "std.UserProfile")
+	proto.RegisterMapType((map[string]string)(nil), "std.UserProfile.PreferencesEntry")
+	proto.RegisterType((*CalendarEvent)(nil), "std.CalendarEvent")
+	proto.RegisterType((*Attendee)(nil), "std.Attendee")
+	proto.RegisterType((*Contact)(nil), "std.Contact")
+	proto.RegisterType((*ContactValue)(nil), "std.ContactValue")
+	proto.RegisterType((*CollectionWindow)(nil), "std.CollectionWindow")
+	proto.RegisterType((*HealthStatus)(nil), "std.HealthStatus")
+	proto.RegisterType((*DataSegment)(nil), "std.DataSegment")
+}
+
+func init() { proto.RegisterFile("amp/std/std.proto", fileDescriptor_b6f70fdd671fe185) }
+
+var fileDescriptor_b6f70fdd671fe185 = []byte{
+	// 1814 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x57, 0xcf, 0x6f, 0x1c, 0x49,
+	0xf5, 0x77, 0xf7, 0xd8, 0xe3, 0xe9, 0x1a, 0xdb, 0x3b, 0x69, 0xe5, 0xbb, 0xdf, 0x22, 0xac, 0x46,
+	0x43, 0x23, 0x84, 0x93, 0x25, 0x4e, 0x3c, 0x5e, 0x50, 0x40, 0x62, 0xd1, 0x64, 0xc6, 0x71, 0xac,
+	0xb5, 0xb1, 0xb7, 0xda, 0x76, 0x7e, 0x48, 0x28, 0x94, 0xa7, 0x9f, 0xc7, 0x25, 0xf7, 0x8f, 0xa1,
+	0xba, 0x26, 0x64, 0xc2, 0x85, 0x33, 0xe2, 0xc0, 0x85, 0x7f, 0x80, 0x13, 0xda, 0x3b, 0xff, 0x00,
+	0x27, 0x8e, 0x39, 0x70, 0xd8, 0x03, 0x07, 0xe2, 0x5c, 0xb8, 0xb1, 0x7f, 0x00, 0x07, 0xf4, 0x5e,
+	0xd7, 0xf4, 0xb4, 0xbd, 0x59, 0x89, 0x83, 0xe5, 0xf7, 0xf9, 0xbc, 0xd7, 0x55, 0xaf, 0xde, 0xaf,
+	0xaa, 0x61, 0x37, 0x64, 0x32, 0xbe, 0x97, 0x9b, 0x08, 0xff, 0x36, 0xc6, 0x3a, 0x33, 0x99, 0x5f,
+	0xcb, 0x4d, 0x74, 0x6b, 0x15, 0x79, 0x99, 0x8c, 0x0b, 0x2e, 0xf8, 0x15, 0x6b, 0x1c, 0x66, 0xb9,
+	0x32, 0x2a, 0x4b, 0xfd, 0xdb, 0xac, 0xd1, 0xcf, 0x74, 0x74, 0x34, 0x1d, 0x03, 0x77, 0x3a, 0xce,
+	0xfa, 0x5a, 0x77, 0x75, 0x03, 0xbf, 0x9e, 0x91, 0xa2, 0x54, 0xfb, 0x2b, 0xcc, 0xf9, 0x9c, 0xd7,
+	0x3a, 0xce, 0xba, 0x23, 0x9c, 0xcf, 0x11, 0x09, 0xbe, 0x58, 0x20, 0x81, 0x28, 0xe4, 0x4b, 0x05,
+	0x0a, 0xfd, 0x16, 0xab, 0x89, 0x83, 0x63, 0x5e, 0xef, 0x38, 0xeb, 0xae, 0x40, 0x31, 0xf8, 0x01,
+	0xab, 0xef, 0x49, 0xb3, 0x97, 0x8e, 0x50, 0xb7, 0x27, 0x0d, 0xed, 0xe5, 0x08, 0x14, 0x89, 0x49,
+	0x47, 0xdc, 0xb5, 0x4c, 0x3a, 0x0a, 0x4e, 0x58, 0x63, 0x07, 0xb2, 0x04, 0x8c, 0x9e, 0xfa, 0xdf,
+	0x63, 0x8b, 0x15, 0xe7, 0x6e, 0x90, 0x73, 0x33, 0x25, 0x39, 0x48, 0x6a, 0xff, 0xbb, 0xac, 0x7e,
+	0x98, 0xa9, 0xd4, 0xe4, 0xdc, 0xed, 0xd4, 0xd6, 0x9b, 0xdd, 0x26, 0x19, 0x16, 0x7b, 0x0a, 0xab,
+	0x0a, 0xfe, 0xe1, 0xb0, 0xfa, 0xa3, 0x70, 0x37, 0x3d, 0xcb, 0x7c, 0x9f, 0x2d, 0xee, 0x67, 0x51,
+	0xb1, 0xac, 0x27, 0x48, 0xf6, 0x6f, 0xb2, 0xa5, 0xdd, 0x7c, 0xa0, 0x34, 0xb9, 0xd2, 0x10, 0x05,
+	0x40, 0xcb, 0x9f, 0xcb, 0x04, 0xe8, 0xe4, 0x9e, 0x20, 0xd9, 0xe7, 0x6c, 0x19, 0xff, 0xef, 0x41,
+	0x4a, 0x21, 0x58, 0x12, 0x33, 0xe8, 0x77, 0x58, 0xb3, 0x9f, 0xa5, 0x06, 0x52, 0x43, 0x5e, 0x2f,
+	0xd1, 0x47, 0x55, 0xca, 0xff, 0x88, 0x79, 0x7d, 0x0d, 0xd2, 0x40, 0xd4, 0x33, 0x7c, 0xb9, 0xe3,
+	0xac, 0xd7, 0xc4, 0x9c, 0xf0, 0xdb, 0x8c, 0xed, 0x67, 0x91, 0x3a, 0x53, 0xa4, 0x6e, 0x90, 0xba,
+	0xc2, 0xf8, 0xb7, 0x58, 0xe3, 0xe1, 0xd4, 0x40, 0xa8, 0x5e, 0x03, 0xf7, 0x48, 0x5b, 0xe2, 0xe0,
+	0x3f, 0x0e, 0xf3, 0x0e, 0x63, 0x39, 0x84, 0x04, 0x52, 0x83, 0x7e, 0x1f, 0x66, 0xf9, 0x7d, 0x1b,
+	0x69, 0x92, 0x2d, 0xb7, 0x69, 0x63, 0x4d, 0xb2, 0xe5, 0xba, 0x36, 0xb3, 0x24, 0xfb, 0x1f, 0xb2,
+	0x7a, 0x38, 0x94, 0x31, 0xdc, 0xa7, 0xe3, 0xb9, 0xc2, 0xa2, 0x92, 0xdf, 0xe4, 0x4b, 0x15, 0x7e,
+	0xb3, 0xe4, 0xbb, 0x36, 0xe7, 0x16, 0x21, 0xbf, 0x3d, 0x89, 0x41, 0x3f, 0xa5, 0x83, 0xba, 0xc2,
+	0xa2, 0x92, 0x7f, 0xc6, 0x1b, 0x15, 0xfe, 0x59, 0xc9, 0x3f, 0xe7, 0x5e, 0x85, 0x7f, 0x8e, 0xd9,
+	0xdd, 0x07, 0xa3, 0xd5, 0x90, 0xaf, 0x50, 0x19, 0x34, 0x37, 0xb0, 0x9a, 0x0b, 0x4a, 0x58, 0x55,
+	0x70, 0xc2, 0xd8, 0x43, 0x19, 0x8d, 0x60, 0xa0, 0x46, 0xca, 0x60, 0x98, 0x7b, 0xc9, 0x38, 0x56,
+	0x66, 0x62, 0xb3, 0x5c, 0x13, 0x73, 0xc2, 0xbf, 0xc3, 0x5a, 0x25, 0xd8, 0xcf, 0xa2, 0x49, 0x3c,
+	0xc9, 0x29, 0x28, 0x35, 0xf1, 0x35, 0x3e, 0xf8, 0x8b, 0xcb, 0x6a, 0x47, 0x22, 0xf4, 0xd7, 0x98,
+	0xfb, 0x74, 0x93, 0xdf, 0xa6, 0x30, 0xb9, 0x4f, 0x37, 0x09, 0x77, 0xf9, 0x1d, 0x8b, 0xbb, 0x84,
+	0xb7, 0xf8, 0xc7, 0x16, 0x6f, 0xf9, 0x3f, 0x62, 0x1e, 0x85, 0x81, 0xea, 0xac, 0x4b, 0x7e, 0x73,
+	0xaa, 0xca, 0x23, 0x11, 0x6e, 0x9c, 0xa8, 0x7c, 0x22, 0xe3, 0x52, 0x2f, 0xe6, 0xa6, 0x95, 0x20,
+	0x6f, 0x7d, 0x43, 0x90, 0x3f, 0xb9, 0x1e, 0x64, 0x92, 0xb6, 0xf8, 0x0f, 0x2b, 0xfc, 0x16, 0x16,
+	0xa9, 0xc8, 0x8c, 0x34, 0xb0, 0xc9, 0x7f, 0x4a, 0x8a, 0x19, 0x9c, 0x6b, 0xba, 0xfc, 0xd3, 0xaa,
+	0xa6, 0x3b, 0xd7, 0x6c, 0xf1, 0x9f, 0x55, 0x35, 0x5b, 0xc1, 0x7d, 0xf6, 0xc1, 0x35, 0x9f, 0xfd,
+	0x55, 0xe6, 0xf5, 0x26, 0x26, 0x23, 0xa2, 0xb5, 0xe0, 0xaf, 0x31, 0xf6, 0x48, 0xbd, 0x82, 0xa8,
+	0xc0, 0x4e, 0xf0, 0x63, 0xe6, 0x6d, 0x27, 0xa7, 0x10, 0x45, 0x2a, 0x1d, 0x61, 0x6f, 0xe1, 0x37,
+	0xb1, 0x6d, 0xb8, 0x02, 0xa0, 0xeb, 0x27, 0x30, 0x34, 0x99, 0xa6, 0xae, 0x75, 0x85, 0x45, 0xc1,
+	0xef, 0x1d, 0xc6, 0x8e, 0x54, 0x02, 0x21, 0x68, 0x05, 0x39, 0x7e, 0x1c, 0x1a, 0xa9, 0x8d, 0xcd,
+	0x63, 0x01, 0xb0, 0x70, 0x43, 0x03, 0x63, 0x9b, 0x37, 0x92, 0x71, 0xc1, 0x7e, 0x36, 0xc1, 0x31,
+	0xb0, 0xd8, 0xa9, 0xad, 0xaf, 0x0a, 0x8b, 0x68, 0x7b, 0x90, 0x69, 0xce, 0x97, 0x3a, 0xb5, 0x75,
+	0x47, 0x14, 0x80, 0x86, 0x80, 0x4a, 0x73, 0x5e, 0x27, 0x92, 0x64, 0xe2, 0xe4, 0xab, 0x9c, 0x2f,
+	0x5b, 0x4e, 0xbe, 0xca, 0x83, 0xbf, 0xd6, 0x98, 0xb7, 0x0f, 0x91, 0x92, 0x34, 0x3a, 0xae, 0xb5,
+	0xb8, 0xf3, 0xf5, 0x16, 0xaf, 0x36, 0xa9, 0x7b, 0xb5, 0x49, 0xd1, 0x93, 0x27, 0x2a, 0x32, 0xe7,
+	0xd4, 0x6f, 0x4b, 0xa2, 0x00, 0xe8, 0xf7, 0x63, 0x50, 0xa3, 0x73, 0x63, 0xe7, 0x89, 0x45, 0x38,
+	0x0e, 0x06, 0x13, 0x2d, 0x71, 0x54, 0xef, 0xe7, 0xd4, 0x74, 0x35, 0x51, 0x61, 0xd0, 0x97, 0x03,
+	0xad, 0x20, 0x35, 0x44, 0x50, 0xf7, 0x2d, 0x89, 0x2a, 0x85, 0x19, 0x3d, 0x92, 0x17, 0x90, 0x96,
+	0xc3, 0x66, 0x06, 0x71, 0xed, 0xbe, 0x4c, 0x40, 0xcb, 0x7d, 0x79, 0x01, 0xd4, 0x88, 0x9e, 0xa8,
+	0x30, 0x74, 0xce, 0x02, 0x51, 0xe2, 0x3c, 0x7b, 0xce, 0x39, 0xe5, 0x7f, 0x9f, 0x35, 0xf6, 0xb2,
+	0x61, 0xb1, 0x35, 0xeb, 0x38, 0xd7, 0xc7, 0x6e, 0xa9, 0xc4, 0x43, 0x1f, 0x29, 0x13, 0x03, 0xb5,
+	0xaf, 0x27, 0x0a, 0x80, 0x87, 0xee, 0x69, 0xa3, 0x72, 0xc3, 0x57, 0x89, 0xb6, 0x08, 0xad, 0x7b,
+	0xf1, 0xe9, 0x24, 0xe1, 0x6b, 0x85, 0x35, 0x01, 0x64, 0x77, 0x20, 0xd5, 0xc0, 0x3f, 0x28, 0x58,
+	0x02, 0x98, 0xae, 0x67, 0x20, 0x35, 0x6f, 0xd1, 0xc9, 0x49, 0xa6, 0xdd, 0xb4, 0x1c, 0x5e, 0xf0,
+	0x1b, 0x45, 0x88, 0x09, 0x04, 0xbf, 0x60, 0xcd, 0xdd, 0x34, 0x56, 0x29, 0xf4, 0xf2, 0x1c, 0xcc,
+	0xff, 0x90, 0x45, 0x9f, 0x2d, 0x6e, 0x1f, 0xc9, 0xe2, 0x62, 0xf2, 0x04, 0xc9, 0x18, 0x4d, 0x6b,
+	0x42, 0xf9, 0x5b, 0x11, 0x33, 0x18, 0xfc, 0xce, 0x65, 0xcd, 0xe3, 0x1c, 0xf4, 0xa1, 0xce, 0xce,
+	0x54, 0x4c, 0xd1, 0x1b, 0xa8, 0x7c, 0x1c, 0xcb, 0x29, 0xdd, 0x1e, 0x76, 0xfd, 0x0a, 0xe5, 0x77,
+	0x58, 0xbd, 0xf7, 0x52, 0x1a, 0x59, 0xdc, 0x37, 0xcd, 0x6e, 0x83, 0x86, 0xda, 0x91, 0x1c, 0x09,
+	0xcb, 0x63, 0x80, 0x30, 0x84, 0xf1, 0xec, 0xf2, 0xb1, 0x08, 0xeb, 0x0b, 0xbb, 0xe3, 0x79, 0x96,
+	0x02, 0xd5, 0x8b, 0x27, 0x4a, 0xec, 0xf7, 0x59, 0xf3, 0x50, 0xc3, 0x19, 0x68, 0x48, 0x87, 0x90,
+	0xf3, 0x06, 0xdd, 0x86, 0xdf, 0xa1, 0xb4, 0x54, 0xdc, 0xdb, 0xa8, 0xd8, 0x6c, 0xa7, 0x46, 0x4f,
+	0x45, 0xf5, 0xab, 0x5b, 0x9f, 0xb2, 0xd6, 0x75, 0x03, 0xbc, 0xa6, 0x2f, 0x60, 0x6a, 0x0f, 0x82,
+	0x22, 0xc6, 0xf9, 0xa5, 0x8c, 0x27, 0x60, 0x23, 0x54, 0x80, 0x9f, 0xb8, 0x0f, 0x9c, 0xe0, 0xdf,
+	0x2e, 0x5b, 0xed, 0xcb, 0x18, 0xd2, 0x48, 0xea, 0xed, 0x97, 0x78, 0x1b, 0xb5, 0x58, 0xed, 0x78,
+	0x77, 0x30, 0xfb, 0xfa, 0x78, 0x77, 0x80, 0xa1, 0x0c, 0x27, 0x49, 0x22, 0xf5, 0xd4, 0x7e, 0x3f,
+	0x83, 0x14, 0x3a, 0xc8, 0x87, 0x5a, 0x8d, 0xa9, 0xb2, 0x6a, 0x36, 0x74, 0x73, 0x0a, 0x03, 0x50,
+	0x16, 0x9e, 0x0d, 0xc0, 0x0c, 0xd3, 0xba, 0x38, 0x1f, 0x7a, 0xc6, 0xf6, 0xcb, 0x0c, 0xa2, 0xbf,
+	0xdb, 0x29, 0x5e, 0xab, 0x75, 0xe2, 0x0b, 0x40, 0x55, 0x18, 0xc7, 0x03, 0x39, 0xa5, 0xfe, 0x68,
+	0x08, 0x8b, 0xae, 0x04, 0xb9, 0x71, 0x2d, 0xc8, 0x6d, 0xc6, 0x04, 0x0c, 0x27, 0x9a, 0xe2, 0x63,
+	0x3b, 0xa3, 0xc2, 0xd0, 0x48, 0x36, 0xd2, 0x4c, 0x72, 0x6a, 0x0b, 0x4f, 0x58, 0xe4, 0x7f, 0xcc,
+	0xbc, 0x03, 0x3d, 0x92, 0xa9, 0x7a, 0x0d, 0x9a, 0x37, 0x29, 0xeb, 0xc5, 0x73, 0xab, 0x67, 0x0c,
+	0xa4, 0x11, 0x80, 0x98, 0xeb, 0xd1, 0x78, 0x46, 0xe7, 0x7c, 0xa5, 0x53, 0x7b, 0x8f, 0x71, 0xa9,
+	0x0f, 0x7e, 0xc9, 0x1a, 0x33, 0x40, 0xe7, 0x4c, 0xa4, 0x2a, 0x67, 0x2d, 0x81, 0xf2, 0x1d, 0xe3,
+	0x56, 0xde, 0x31, 0x3e, 0x5b, 0x14, 0x59, 0x59, 0x5e, 0x24, 0x57, 0x7c, 0x5f, 0xac, 0xfa, 0x1e,
+	0xfc, 0xdd, 0x2d, 0x6a, 0x5f, 0x0e, 0xdf, 0x97, 0xcd, 0x5b, 0xac, 0xf1, 0x68, 0x12, 0xc7, 0x95,
+	0x1d, 0x4a, 0x8c, 0x57, 0xf1, 0x8e, 0x7a, 0x09, 0x69, 0xe5, 0x19, 0x35, 0x27, 0x30, 0x96, 0x8f,
+	0x64, 0xa2, 0xe2, 0xa2, 0x4f, 0x8a, 0x3d, 0x2b, 0x0c, 0xee, 0x75, 0xa0, 0x47, 0xf6, 0x25, 0x85,
+	0xe2, 0x7c, 0x9a, 0xd4, 0xab, 0xd3, 0xe4, 0x36, 0xab, 0xd3, 0x41, 0x8b, 0xd1, 0xdd, 0xb4, 0x4f,
+	0x45, 0xeb, 0xf1, 0x09, 0x96, 0xa6, 0xb0, 0x06, 0x68, 0x7a, 0x78, 0x9e, 0xa5, 0x65, 0x7b, 0xbc,
+	0xcf, 0xb4, 0x30, 0xf0, 0xef, 0x31, 0xaf, 0x17, 0x45, 0x1a, 0xf2, 0x1c, 0x72, 0xee, 0x7d, 0x93,
+	0xf5, 0xdc, 0x86, 0x66, 0xbf, 0xd2, 0xe6, 0x3c, 0x92, 0x53, 0x9b, 0xfc, 0x12, 0x53, 0x0a, 0x32,
+	0x03, 0xbc, 0x69, 0x53, 0x90, 0x19, 0x08, 0x1e, 0xb0, 0x95, 0xea, 0x52, 0x68, 0x53, 0x19, 0x48,
+	0x24, 0xe3, 0x81, 0x4f, 0xaa, 0x8d, 0x46, 0x20, 0x38, 0x61, 0xad, 0x7e, 0x16, 0xc7, 0x30, 0xc4,
+	0xb2, 0x7f, 0xa2, 0xd2, 0x28, 0xfb, 0x35, 0x26, 0xef, 0xe0, 0xec, 0x2c, 0x87, 0xd9, 0x55, 0x69,
+	0x11, 0xae, 0x40, 0x37, 0xa1, 0xbd, 0x8e, 0x0a, 0x40, 0x81, 0xcc, 0x8c, 0x8c, 0x29, 0x29, 0x35,
+	0x51, 0x80, 0xe0, 0x4f, 0x0e, 0x5b, 0x79, 0x0c, 0x32, 0x36, 0xe7, 0xb6, 0x6a, 0x67, 0x95, 0xe3,
+	0x54, 0x2a, 0x67, 0x8d, 0xb9, 0x07, 0x9f, 0xd9, 0x87, 0xb2, 0x7b, 0xf0, 0x19, 0x75, 0x24, 0xa6,
+	0x14, 0xf2, 0x9c, 0x56, 0x6b, 0x88, 0x12, 0xa3, 0x53, 0x03, 0x30, 0x58, 0x90, 0xb6, 0xa2, 0x0a,
+	0x84, 0x75, 0xb1, 0x27, 0x0d, 0xa4, 0xc3, 0x69, 0x79, 0xb7, 0xcd, 0x09, 0xd4, 0xf6, 0xcf, 0x61,
+	0x78, 0x01, 0xf3, 0x8e, 0x9d, 0x13, 0xc1, 0x1f, 0x1d, 0xd6, 0x1c, 0x48, 0x23, 0x43, 0x18, 0xd1,
+	0x6b, 0x97, 0xb3, 0x65, 0xbc, 0x62, 0x0f, 0xce, 0x8a, 0x95, 0x16, 0xc5, 0x0c, 0xe2, 0xee, 0x28,
+	0x86, 0xaf, 0x69, 0x91, 0x45, 0x61, 0x11, 0xd6, 0x5d, 0x71, 0x1f, 0xe0, 0x32, 0xd4, 0xfb, 0x2b,
+	0xa2, 0xc2, 0xe0, 0xfe, 0xa1, 0xd1, 0x20, 0x93, 0x63, 0xb1, 0x6b, 0x5b, 0x7c, 0x4e, 0xd0, 0xaa,
+	0x71, 0x76, 0xba, 0x3b, 0xa0, 0x24, 0xd7, 0x84, 0x45, 0x77, 0xbe, 0x70, 0xe6, 0x3f, 0xa8, 0x7c,
+	0xce, 0x6e, 0xce, 0xe4, 0x17, 0xc7, 0x69, 0x3e, 0x86, 0x21, 0x3d, 0xe3, 0x5b, 0x0b, 0xfe, 0x4d,
+	0xd6, 0x2a, 0x35, 0x07, 0x3a, 0x02, 0x0d, 0x51, 0xcb, 0xf1, 0x3f, 0x62, 0xbc, 0x64, 0x0f, 0x63,
+	0x99, 0xc2, 0x8b, 0xbe, 0xd4, 0x06, 0x72, 0x25, 0xd3, 0xd6, 0x92, 0xff, 0x6d, 0xf6, 0xff, 0xd7,
+	0xb4, 0x8f, 0xe1, 0x15, 0x4e, 0x57, 0xd1, 0xaa, 0xfb, 0xdf, 0x62, 0xff, 0x57, 0x2a, 0x77, 0x20,
+	0x53, 0xd1, 0x8b, 0x70, 0x7c, 0x0e, 0x1a, 0x5a, 0xec, 0x8a, 0x17, 0x85, 0xea, 0xc9, 0x4e, 0xf8,
+	0xe0, 0x93, 0x56, 0xf3, 0xce, 0x6f, 0xd8, 0x4a, 0xf5, 0xa7, 0x14, 0xee, 0x5f, 0xc5, 0xd7, 0x7c,
+	0xfe, 0x90, 0xf9, 0x57, 0xb4, 0xf4, 0xa3, 0xaa, 0xe5, 0xa0, 0x5f, 0x57, 0xf8, 0x3d, 0x95, 0x42,
+	0x68, 0xb4, 0x4a, 0x47, 0x2d, 0x17, 0x37, 0xbf, 0xf6, 0x51, 0x3c, 0x1d, 0x65, 0x69, 0xab, 0xf6,
+	0x70, 0xfc, 0xe6, 0x6d, 0x7b, 0xe1, 0xcb, 0xb7, 0xed, 0x85, 0xaf, 0xde, 0xb6, 0x9d, 0xdf, 0x5e,
+	0xb6, 0x9d, 0x3f, 0x5f, 0xb6, 0x9d, 0xbf, 0x5d, 0xb6, 0x9d, 0x37, 0x97, 0x6d, 0xe7, 0x9f, 0x97,
+	0x6d, 0xe7, 0x5f, 0x97, 0xed, 0x85, 0xaf, 0x2e, 0xdb, 0xce, 0x1f, 0xde, 0xb5, 0x17, 0xde, 0xbc,
+	0x6b, 0x2f, 0x7c, 0xf9, 0xae, 0xbd, 0xf0, 0xfc, 0xfe, 0x48, 0x99, 0xf3, 0xc9, 0xe9, 0xc6, 0x30,
+	0x4b, 0xee, 0x49, 0x6d, 0xee, 0x26, 0xf8, 0x2c, 0xbb, 0x3b, 0x8e, 0xa5, 0x39, 0xcb, 0x74, 0x82,
+	0x3f, 0x72, 0xef, 0xe6, 0xd1, 0xc5, 0xdd, 0x51, 0x76, 0xcf, 0xfe, 0x16, 0xfe, 0xc2, 0x5d, 0xee,
+	0xed, 0x1f, 0x6e, 0x84, 0x26, 0x3a, 0xad, 0xd3, 0xcf, 0xdf, 0xad, 0xff, 0x0e, 0x00, 0x8e, 0xfa,
+	0x09, 0x94, 0x27, 0x0f, 0x00, 0x00,
+}
+
+func (x CordType) String() string {
+	s, ok := CordType_name[int32(x)]
+	if ok {
+		return s
 	}
-	if this.Rotate2 != that1.Rotate2 {
-		return false
+	return strconv.Itoa(int(x))
+}
+func (x GeometryType) String() string {
+	s, ok := GeometryType_name[int32(x)]
+	if ok {
+		return s
 	}
-	if this.Rotate3 != that1.Rotate3 {
-		return false
+	return strconv.Itoa(int(x))
+}
+func (x TRS_VisualScaleMode) String() string {
+	s, ok := TRS_VisualScaleMode_name[int32(x)]
+	if ok {
+		return s
 	}
-	return true
+	return strconv.Itoa(int(x))
 }
-func (this *DataSegment) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *Position) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
 
-	that1, ok := that.(*DataSegment)
-	if !ok {
-		that2, ok := that.(DataSegment)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ByteOfs != that1.ByteOfs {
-		return false
+func (m *Position) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Position) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ROU != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.ROU))))
+		i--
+		dAtA[i] = 0x35
 	}
-	if this.ByteSz != that1.ByteSz {
-		return false
+	if m.S != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.S))))
+		i--
+		dAtA[i] = 0x29
 	}
-	if !bytes.Equal(this.InlineData, that1.InlineData) {
-		return false
+	if m.R != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.R))))
+		i--
+		dAtA[i] = 0x21
 	}
-	if this.StreamURI != that1.StreamURI {
-		return false
+	if m.Q != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Q))))
+		i--
+		dAtA[i] = 0x19
 	}
-	if this.BlobID != that1.BlobID {
-		return false
+	if m.CordType != 0 {
+		i = encodeVarintStd(dAtA, i, uint64(m.CordType))
+		i--
+		dAtA[i] = 0x8
 	}
-	return true
+	return len(dAtA) - i, nil
 }
-func (this *Position) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *LatLng) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&std.Position{")
-	s = append(s, "CordType: "+fmt.Sprintf("%#v", this.CordType)+",\n")
-	s = append(s, "Q: "+fmt.Sprintf("%#v", this.Q)+",\n")
-	s = append(s, "R: "+fmt.Sprintf("%#v", this.R)+",\n")
-	s = append(s, "S: "+fmt.Sprintf("%#v", this.S)+",\n")
-	s = append(s, "ROU: "+fmt.Sprintf("%#v", this.ROU)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return dAtA[:n], nil
 }
-func (this *FSInfo) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *LatLng) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LatLng) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Lng != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Lng))))
+		i--
+		dAtA[i] = 0x11
 	}
-	s := make([]string, 0, 12)
-	s = append(s, "&std.FSInfo{")
-	s = append(s, "Mode: "+fmt.Sprintf("%#v", this.Mode)+",\n")
-	s = append(s, "IsDir: "+fmt.Sprintf("%#v", this.IsDir)+",\n")
-	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
-	s = append(s, "NameLen: "+fmt.Sprintf("%#v", this.NameLen)+",\n")
-	s = append(s, "ContentType: "+fmt.Sprintf("%#v", this.ContentType)+",\n")
-	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
-	s = append(s, "ModifiedAt: "+fmt.Sprintf("%#v", this.ModifiedAt)+",\n")
-	s = append(s, "ByteSize: "+fmt.Sprintf("%#v", this.ByteSize)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if m.Lat != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Lat))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
 }
-func (this *Placement) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *Geometry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := make([]string, 0, 14)
-	s = append(s, "&std.Placement{")
-	s = append(s, "Pos0: "+fmt.Sprintf("%#v", this.Pos0)+",\n")
-	s = append(s, "Pos1: "+fmt.Sprintf("%#v", this.Pos1)+",\n")
-	s = append(s, "Pos2: "+fmt.Sprintf("%#v", this.Pos2)+",\n")
-	s = append(s, "Scale0: "+fmt.Sprintf("%#v", this.Scale0)+",\n")
-	s = append(s, "Scale1: "+fmt.Sprintf("%#v", this.Scale1)+",\n")
-	s = append(s, "Scale2: "+fmt.Sprintf("%#v", this.Scale2)+",\n")
-	s = append(s, "EulerX: "+fmt.Sprintf("%#v", this.EulerX)+",\n")
-	s = append(s, "EulerY: "+fmt.Sprintf("%#v", this.EulerY)+",\n")
-	s = append(s, "EulerZ: "+fmt.Sprintf("%#v", this.EulerZ)+",\n")
-	s = append(s, "Metric: "+fmt.Sprintf("%#v", this.Metric)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *BadgeDigit) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 6)
-	s = append(s, "&std.BadgeDigit{")
-	s = append(s, "Amplitude: "+fmt.Sprintf("%#v", this.Amplitude)+",\n")
-	s = append(s, "AmplitudeModulus: "+fmt.Sprintf("%#v", this.AmplitudeModulus)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *TRS) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 14)
-	s = append(s, "&std.TRS{")
-	s = append(s, "X1: "+fmt.Sprintf("%#v", this.X1)+",\n")
-	s = append(s, "X2: "+fmt.Sprintf("%#v", this.X2)+",\n")
-	s = append(s, "X3: "+fmt.Sprintf("%#v", this.X3)+",\n")
-	s = append(s, "ScaleMode: "+fmt.Sprintf("%#v", this.ScaleMode)+",\n")
-	s = append(s, "Scale1: "+fmt.Sprintf("%#v", this.Scale1)+",\n")
-	s = append(s, "Scale2: "+fmt.Sprintf("%#v", this.Scale2)+",\n")
-	s = append(s, "Scale3: "+fmt.Sprintf("%#v", this.Scale3)+",\n")
-	s = append(s, "Rotate1: "+fmt.Sprintf("%#v", this.Rotate1)+",\n")
-	s = append(s, "Rotate2: "+fmt.Sprintf("%#v", this.Rotate2)+",\n")
-	s = append(s, "Rotate3: "+fmt.Sprintf("%#v", this.Rotate3)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DataSegment) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 9)
-	s = append(s, "&std.DataSegment{")
-	s = append(s, "ByteOfs: "+fmt.Sprintf("%#v", this.ByteOfs)+",\n")
-	s = append(s, "ByteSz: "+fmt.Sprintf("%#v", this.ByteSz)+",\n")
-	s = append(s, "InlineData: "+fmt.Sprintf("%#v", this.InlineData)+",\n")
-	s = append(s, "StreamURI: "+fmt.Sprintf("%#v", this.StreamURI)+",\n")
-	s = append(s, "BlobID: "+fmt.Sprintf("%#v", this.BlobID)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return dAtA[:n], nil
 }
-func valueToGoStringStd(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
-	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+
+func (m *Geometry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Position) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *Geometry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CordType != 0 {
-		n += 1 + sovStd(uint64(m.CordType))
-	}
-	if m.Q != 0 {
-		n += 9
-	}
-	if m.R != 0 {
-		n += 9
-	}
-	if m.S != 0 {
-		n += 9
+	if len(m.Points) > 0 {
+		for iNdEx := len(m.Points) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Points[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStd(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.ROU != 0 {
-		n += 5
+	if m.Type != 0 {
+		i = encodeVarintStd(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *FSInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FSInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FSInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FSInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Mode)
-	if l > 0 {
-		n += 1 + l + sovStd(uint64(l))
+	if m.ByteSize != 0 {
+		i = encodeVarintStd(dAtA, i, uint64(m.ByteSize))
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.IsDir {
-		n += 2
+	if m.ModifiedAt != 0 {
+		i = encodeVarintStd(dAtA, i, uint64(m.ModifiedAt))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovStd(uint64(l))
+	if m.CreatedAt != 0 {
+		i = encodeVarintStd(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.NameLen != 0 {
-		n += 1 + sovStd(uint64(m.NameLen))
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.ContentType)
-	if l > 0 {
-		n += 1 + l + sovStd(uint64(l))
+	if m.NameLen != 0 {
+		i = encodeVarintStd(dAtA, i, uint64(m.NameLen))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.CreatedAt != 0 {
-		n += 1 + sovStd(uint64(m.CreatedAt))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.ModifiedAt != 0 {
-		n += 1 + sovStd(uint64(m.ModifiedAt))
+	if m.IsDir {
+		i--
+		if m.IsDir {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.ByteSize != 0 {
-		n += 1 + sovStd(uint64(m.ByteSize))
+	if len(m.Mode) > 0 {
+		i -= len(m.Mode)
+		copy(dAtA[i:], m.Mode)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.Mode)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Placement) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Placement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Placement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Placement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pos0 != 0 {
-		n += 9
-	}
-	if m.Pos1 != 0 {
-		n += 9
+	if m.Metric != 0 {
+		i = encodeVarintStd(dAtA, i, uint64(m.Metric))
+		i--
+		dAtA[i] = 0x60
 	}
-	if m.Pos2 != 0 {
-		n += 9
+	if m.EulerZ != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.EulerZ))))
+		i--
+		dAtA[i] = 0x4d
 	}
-	if m.Scale0 != 0 {
-		n += 5
+	if m.EulerY != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.EulerY))))
+		i--
+		dAtA[i] = 0x45
 	}
-	if m.Scale1 != 0 {
-		n += 5
+	if m.EulerX != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.EulerX))))
+		i--
+		dAtA[i] = 0x3d
 	}
 	if m.Scale2 != 0 {
-		n += 5
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Scale2))))
+		i--
+		dAtA[i] = 0x35
 	}
-	if m.EulerX != 0 {
-		n += 5
+	if m.Scale1 != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Scale1))))
+		i--
+		dAtA[i] = 0x2d
 	}
-	if m.EulerY != 0 {
-		n += 5
+	if m.Scale0 != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Scale0))))
+		i--
+		dAtA[i] = 0x25
 	}
-	if m.EulerZ != 0 {
-		n += 5
+	if m.Pos2 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Pos2))))
+		i--
+		dAtA[i] = 0x19
 	}
-	if m.Metric != 0 {
-		n += 1 + sovStd(uint64(m.Metric))
+	if m.Pos1 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Pos1))))
+		i--
+		dAtA[i] = 0x11
 	}
-	return n
+	if m.Pos0 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Pos0))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *BadgeDigit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BadgeDigit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BadgeDigit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BadgeDigit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Amplitude != 0 {
-		n += 1 + sovStd(uint64(m.Amplitude))
-	}
 	if m.AmplitudeModulus != 0 {
-		n += 1 + sovStd(uint64(m.AmplitudeModulus))
+		i = encodeVarintStd(dAtA, i, uint64(m.AmplitudeModulus))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.Amplitude != 0 {
+		i = encodeVarintStd(dAtA, i, uint64(m.Amplitude))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TRS) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TRS) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TRS) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TRS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.X1 != 0 {
-		n += 10
+	if m.Rotate3 != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Rotate3))))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xfd
 	}
-	if m.X2 != 0 {
-		n += 10
+	if m.Rotate2 != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Rotate2))))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xf5
 	}
-	if m.X3 != 0 {
-		n += 10
+	if m.Rotate1 != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Rotate1))))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xed
 	}
-	if m.ScaleMode != 0 {
-		n += 2 + sovStd(uint64(m.ScaleMode))
+	if m.Scale3 != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Scale3))))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xad
+	}
+	if m.Scale2 != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Scale2))))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xa5
 	}
 	if m.Scale1 != 0 {
-		n += 6
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Scale1))))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x9d
 	}
-	if m.Scale2 != 0 {
-		n += 6
+	if m.ScaleMode != 0 {
+		i = encodeVarintStd(dAtA, i, uint64(m.ScaleMode))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x90
 	}
-	if m.Scale3 != 0 {
-		n += 6
+	if m.X3 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.X3))))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd9
 	}
-	if m.Rotate1 != 0 {
-		n += 6
+	if m.X2 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.X2))))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd1
 	}
-	if m.Rotate2 != 0 {
-		n += 6
+	if m.X1 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.X1))))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc9
 	}
-	if m.Rotate3 != 0 {
-		n += 6
+	return len(dAtA) - i, nil
+}
+
+func (m *Embedding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DataSegment) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Embedding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Embedding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Vector) > 0 {
+		for iNdEx := len(m.Vector) - 1; iNdEx >= 0; iNdEx-- {
+			f1 := math.Float32bits(float32(m.Vector[iNdEx]))
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(f1))
+		}
+		i = encodeVarintStd(dAtA, i, uint64(len(m.Vector)*4))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Model) > 0 {
+		i -= len(m.Model)
+		copy(dAtA[i:], m.Model)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.Model)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimeSeries) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeSeries) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimeSeries) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ByteOfs != 0 {
-		n += 1 + sovStd(uint64(m.ByteOfs))
+	if len(m.Maxs) > 0 {
+		for iNdEx := len(m.Maxs) - 1; iNdEx >= 0; iNdEx-- {
+			f2 := math.Float64bits(float64(m.Maxs[iNdEx]))
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f2))
+		}
+		i = encodeVarintStd(dAtA, i, uint64(len(m.Maxs)*8))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.ByteSz != 0 {
-		n += 1 + sovStd(uint64(m.ByteSz))
+	if len(m.Mins) > 0 {
+		for iNdEx := len(m.Mins) - 1; iNdEx >= 0; iNdEx-- {
+			f3 := math.Float64bits(float64(m.Mins[iNdEx]))
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f3))
+		}
+		i = encodeVarintStd(dAtA, i, uint64(len(m.Mins)*8))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.InlineData)
-	if l > 0 {
-		n += 1 + l + sovStd(uint64(l))
+	if len(m.Means) > 0 {
+		for iNdEx := len(m.Means) - 1; iNdEx >= 0; iNdEx-- {
+			f4 := math.Float64bits(float64(m.Means[iNdEx]))
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f4))
+		}
+		i = encodeVarintStd(dAtA, i, uint64(len(m.Means)*8))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.StreamURI)
-	if l > 0 {
-		n += 1 + l + sovStd(uint64(l))
+	if len(m.Counts) > 0 {
+		dAtA6 := make([]byte, len(m.Counts)*10)
+		var j5 int
+		for _, num := range m.Counts {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintStd(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.BlobID != 0 {
-		n += 1 + sovStd(uint64(m.BlobID))
+	if m.Step != 0 {
+		i = encodeVarintStd(dAtA, i, uint64(m.Step))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.Start != 0 {
+		i = encodeVarintStd(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovStd(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MediaInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozStd(x uint64) (n int) {
-	return sovStd(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MediaInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *Position) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *MediaInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Track != 0 {
+		i = encodeVarintStd(dAtA, i, uint64(m.Track))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
 	}
-	s := strings.Join([]string{`&Position{`,
-		`CordType:` + fmt.Sprintf("%v", this.CordType) + `,`,
-		`Q:` + fmt.Sprintf("%v", this.Q) + `,`,
-		`R:` + fmt.Sprintf("%v", this.R) + `,`,
-		`S:` + fmt.Sprintf("%v", this.S) + `,`,
-		`ROU:` + fmt.Sprintf("%v", this.ROU) + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.Year != 0 {
+		i = encodeVarintStd(dAtA, i, uint64(m.Year))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.Genre) > 0 {
+		i -= len(m.Genre)
+		copy(dAtA[i:], m.Genre)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.Genre)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.Album) > 0 {
+		i -= len(m.Album)
+		copy(dAtA[i:], m.Album)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.Album)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Artist) > 0 {
+		i -= len(m.Artist)
+		copy(dAtA[i:], m.Artist)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.Artist)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Location != nil {
+		{
+			size, err := m.Location.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStd(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.CameraModel) > 0 {
+		i -= len(m.CameraModel)
+		copy(dAtA[i:], m.CameraModel)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.CameraModel)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.CameraMake) > 0 {
+		i -= len(m.CameraMake)
+		copy(dAtA[i:], m.CameraMake)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.CameraMake)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.TakenAt != 0 {
+		i = encodeVarintStd(dAtA, i, uint64(m.TakenAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Orientation != 0 {
+		i = encodeVarintStd(dAtA, i, uint64(m.Orientation))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DurationMs != 0 {
+		i = encodeVarintStd(dAtA, i, uint64(m.DurationMs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Height != 0 {
+		i = encodeVarintStd(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Width != 0 {
+		i = encodeVarintStd(dAtA, i, uint64(m.Width))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ByteSize != 0 {
+		i = encodeVarintStd(dAtA, i, uint64(m.ByteSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (this *FSInfo) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *InlineAsset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&FSInfo{`,
-		`Mode:` + fmt.Sprintf("%v", this.Mode) + `,`,
-		`IsDir:` + fmt.Sprintf("%v", this.IsDir) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`NameLen:` + fmt.Sprintf("%v", this.NameLen) + `,`,
-		`ContentType:` + fmt.Sprintf("%v", this.ContentType) + `,`,
-		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
-		`ModifiedAt:` + fmt.Sprintf("%v", this.ModifiedAt) + `,`,
-		`ByteSize:` + fmt.Sprintf("%v", this.ByteSize) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *Placement) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *InlineAsset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InlineAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	s := strings.Join([]string{`&Placement{`,
-		`Pos0:` + fmt.Sprintf("%v", this.Pos0) + `,`,
-		`Pos1:` + fmt.Sprintf("%v", this.Pos1) + `,`,
-		`Pos2:` + fmt.Sprintf("%v", this.Pos2) + `,`,
-		`Scale0:` + fmt.Sprintf("%v", this.Scale0) + `,`,
-		`Scale1:` + fmt.Sprintf("%v", this.Scale1) + `,`,
-		`Scale2:` + fmt.Sprintf("%v", this.Scale2) + `,`,
-		`EulerX:` + fmt.Sprintf("%v", this.EulerX) + `,`,
-		`EulerY:` + fmt.Sprintf("%v", this.EulerY) + `,`,
-		`EulerZ:` + fmt.Sprintf("%v", this.EulerZ) + `,`,
-		`Metric:` + fmt.Sprintf("%v", this.Metric) + `,`,
-		`}`,
-	}, "")
-	return s
+	if len(m.ETag) > 0 {
+		i -= len(m.ETag)
+		copy(dAtA[i:], m.ETag)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.ETag)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (this *BadgeDigit) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *UserProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&BadgeDigit{`,
-		`Amplitude:` + fmt.Sprintf("%v", this.Amplitude) + `,`,
-		`AmplitudeModulus:` + fmt.Sprintf("%v", this.AmplitudeModulus) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *TRS) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *UserProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Preferences) > 0 {
+		for k := range m.Preferences {
+			v := m.Preferences[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintStd(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintStd(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintStd(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
+		}
 	}
-	s := strings.Join([]string{`&TRS{`,
-		`X1:` + fmt.Sprintf("%v", this.X1) + `,`,
-		`X2:` + fmt.Sprintf("%v", this.X2) + `,`,
-		`X3:` + fmt.Sprintf("%v", this.X3) + `,`,
-		`ScaleMode:` + fmt.Sprintf("%v", this.ScaleMode) + `,`,
-		`Scale1:` + fmt.Sprintf("%v", this.Scale1) + `,`,
-		`Scale2:` + fmt.Sprintf("%v", this.Scale2) + `,`,
-		`Scale3:` + fmt.Sprintf("%v", this.Scale3) + `,`,
-		`Rotate1:` + fmt.Sprintf("%v", this.Rotate1) + `,`,
-		`Rotate2:` + fmt.Sprintf("%v", this.Rotate2) + `,`,
-		`Rotate3:` + fmt.Sprintf("%v", this.Rotate3) + `,`,
-		`}`,
-	}, "")
-	return s
+	if len(m.TimeZone) > 0 {
+		i -= len(m.TimeZone)
+		copy(dAtA[i:], m.TimeZone)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.TimeZone)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Locale) > 0 {
+		i -= len(m.Locale)
+		copy(dAtA[i:], m.Locale)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.Locale)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Avatar != nil {
+		{
+			size, err := m.Avatar.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStd(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DisplayName) > 0 {
+		i -= len(m.DisplayName)
+		copy(dAtA[i:], m.DisplayName)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.DisplayName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (this *DataSegment) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *CalendarEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CalendarEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CalendarEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attendees) > 0 {
+		for iNdEx := len(m.Attendees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attendees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStd(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.Organizer != nil {
+		{
+			size, err := m.Organizer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStd(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Recurrence) > 0 {
+		i -= len(m.Recurrence)
+		copy(dAtA[i:], m.Recurrence)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.Recurrence)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.TimeZone) > 0 {
+		i -= len(m.TimeZone)
+		copy(dAtA[i:], m.TimeZone)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.TimeZone)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.AllDay {
+		i--
+		if m.AllDay {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EndAt != 0 {
+		i = encodeVarintStd(dAtA, i, uint64(m.EndAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartAt != 0 {
+		i = encodeVarintStd(dAtA, i, uint64(m.StartAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Location) > 0 {
+		i -= len(m.Location)
+		copy(dAtA[i:], m.Location)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.Location)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Summary) > 0 {
+		i -= len(m.Summary)
+		copy(dAtA[i:], m.Summary)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.Summary)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UID) > 0 {
+		i -= len(m.UID)
+		copy(dAtA[i:], m.UID)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.UID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Attendee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Attendee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Attendee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Contact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Contact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Contact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Note) > 0 {
+		i -= len(m.Note)
+		copy(dAtA[i:], m.Note)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.Note)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Birthday) > 0 {
+		i -= len(m.Birthday)
+		copy(dAtA[i:], m.Birthday)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.Birthday)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Addresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStd(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Phones) > 0 {
+		for iNdEx := len(m.Phones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Phones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStd(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Emails) > 0 {
+		for iNdEx := len(m.Emails) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Emails[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStd(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Org) > 0 {
+		i -= len(m.Org)
+		copy(dAtA[i:], m.Org)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.Org)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FamilyName) > 0 {
+		i -= len(m.FamilyName)
+		copy(dAtA[i:], m.FamilyName)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.FamilyName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.GivenName) > 0 {
+		i -= len(m.GivenName)
+		copy(dAtA[i:], m.GivenName)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.GivenName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FullName) > 0 {
+		i -= len(m.FullName)
+		copy(dAtA[i:], m.FullName)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.FullName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UID) > 0 {
+		i -= len(m.UID)
+		copy(dAtA[i:], m.UID)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.UID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContactValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContactValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContactValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CollectionWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CollectionWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CollectionWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Total != 0 {
+		i = encodeVarintStd(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Count != 0 {
+		i = encodeVarintStd(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Offset != 0 {
+		i = encodeVarintStd(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HealthStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HealthStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HealthStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CheckedAt != 0 {
+		i = encodeVarintStd(dAtA, i, uint64(m.CheckedAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LatencyMs != 0 {
+		i = encodeVarintStd(dAtA, i, uint64(m.LatencyMs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Detail) > 0 {
+		i -= len(m.Detail)
+		copy(dAtA[i:], m.Detail)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.Detail)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Liveness {
+		i--
+		if m.Liveness {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OK {
+		i--
+		if m.OK {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataSegment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataSegment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataSegment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlobID != 0 {
+		i = encodeVarintStd(dAtA, i, uint64(m.BlobID))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.StreamURI) > 0 {
+		i -= len(m.StreamURI)
+		copy(dAtA[i:], m.StreamURI)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.StreamURI)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.InlineData) > 0 {
+		i -= len(m.InlineData)
+		copy(dAtA[i:], m.InlineData)
+		i = encodeVarintStd(dAtA, i, uint64(len(m.InlineData)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ByteSz != 0 {
+		i = encodeVarintStd(dAtA, i, uint64(m.ByteSz))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ByteOfs != 0 {
+		i = encodeVarintStd(dAtA, i, uint64(m.ByteOfs))
+		i--
+		dAtA[i] = 0x28
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStd(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStd(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (this *Position) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Position)
+	if !ok {
+		that2, ok := that.(Position)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CordType != that1.CordType {
+		return false
+	}
+	if this.Q != that1.Q {
+		return false
+	}
+	if this.R != that1.R {
+		return false
+	}
+	if this.S != that1.S {
+		return false
+	}
+	if this.ROU != that1.ROU {
+		return false
+	}
+	return true
+}
+func (this *LatLng) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LatLng)
+	if !ok {
+		that2, ok := that.(LatLng)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Lat != that1.Lat {
+		return false
+	}
+	if this.Lng != that1.Lng {
+		return false
+	}
+	return true
+}
+func (this *Geometry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Geometry)
+	if !ok {
+		that2, ok := that.(Geometry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if len(this.Points) != len(that1.Points) {
+		return false
+	}
+	for i := range this.Points {
+		if !this.Points[i].Equal(that1.Points[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *FSInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FSInfo)
+	if !ok {
+		that2, ok := that.(FSInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Mode != that1.Mode {
+		return false
+	}
+	if this.IsDir != that1.IsDir {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.NameLen != that1.NameLen {
+		return false
+	}
+	if this.ContentType != that1.ContentType {
+		return false
+	}
+	if this.CreatedAt != that1.CreatedAt {
+		return false
+	}
+	if this.ModifiedAt != that1.ModifiedAt {
+		return false
+	}
+	if this.ByteSize != that1.ByteSize {
+		return false
+	}
+	return true
+}
+func (this *Placement) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Placement)
+	if !ok {
+		that2, ok := that.(Placement)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Pos0 != that1.Pos0 {
+		return false
+	}
+	if this.Pos1 != that1.Pos1 {
+		return false
+	}
+	if this.Pos2 != that1.Pos2 {
+		return false
+	}
+	if this.Scale0 != that1.Scale0 {
+		return false
+	}
+	if this.Scale1 != that1.Scale1 {
+		return false
+	}
+	if this.Scale2 != that1.Scale2 {
+		return false
+	}
+	if this.EulerX != that1.EulerX {
+		return false
+	}
+	if this.EulerY != that1.EulerY {
+		return false
+	}
+	if this.EulerZ != that1.EulerZ {
+		return false
+	}
+	if this.Metric != that1.Metric {
+		return false
+	}
+	return true
+}
+func (this *BadgeDigit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BadgeDigit)
+	if !ok {
+		that2, ok := that.(BadgeDigit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Amplitude != that1.Amplitude {
+		return false
+	}
+	if this.AmplitudeModulus != that1.AmplitudeModulus {
+		return false
+	}
+	return true
+}
+func (this *TRS) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TRS)
+	if !ok {
+		that2, ok := that.(TRS)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.X1 != that1.X1 {
+		return false
+	}
+	if this.X2 != that1.X2 {
+		return false
+	}
+	if this.X3 != that1.X3 {
+		return false
+	}
+	if this.ScaleMode != that1.ScaleMode {
+		return false
+	}
+	if this.Scale1 != that1.Scale1 {
+		return false
+	}
+	if this.Scale2 != that1.Scale2 {
+		return false
+	}
+	if this.Scale3 != that1.Scale3 {
+		return false
+	}
+	if this.Rotate1 != that1.Rotate1 {
+		return false
+	}
+	if this.Rotate2 != that1.Rotate2 {
+		return false
+	}
+	if this.Rotate3 != that1.Rotate3 {
+		return false
+	}
+	return true
+}
+func (this *Embedding) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Embedding)
+	if !ok {
+		that2, ok := that.(Embedding)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Model != that1.Model {
+		return false
+	}
+	if len(this.Vector) != len(that1.Vector) {
+		return false
+	}
+	for i := range this.Vector {
+		if this.Vector[i] != that1.Vector[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *TimeSeries) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TimeSeries)
+	if !ok {
+		that2, ok := that.(TimeSeries)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Start != that1.Start {
+		return false
+	}
+	if this.Step != that1.Step {
+		return false
+	}
+	if len(this.Counts) != len(that1.Counts) {
+		return false
+	}
+	for i := range this.Counts {
+		if this.Counts[i] != that1.Counts[i] {
+			return false
+		}
+	}
+	if len(this.Means) != len(that1.Means) {
+		return false
+	}
+	for i := range this.Means {
+		if this.Means[i] != that1.Means[i] {
+			return false
+		}
+	}
+	if len(this.Mins) != len(that1.Mins) {
+		return false
+	}
+	for i := range this.Mins {
+		if this.Mins[i] != that1.Mins[i] {
+			return false
+		}
+	}
+	if len(this.Maxs) != len(that1.Maxs) {
+		return false
+	}
+	for i := range this.Maxs {
+		if this.Maxs[i] != that1.Maxs[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *MediaInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MediaInfo)
+	if !ok {
+		that2, ok := that.(MediaInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ContentType != that1.ContentType {
+		return false
+	}
+	if this.ByteSize != that1.ByteSize {
+		return false
+	}
+	if this.Width != that1.Width {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.DurationMs != that1.DurationMs {
+		return false
+	}
+	if this.Orientation != that1.Orientation {
+		return false
+	}
+	if this.TakenAt != that1.TakenAt {
+		return false
+	}
+	if this.CameraMake != that1.CameraMake {
+		return false
+	}
+	if this.CameraModel != that1.CameraModel {
+		return false
+	}
+	if !this.Location.Equal(that1.Location) {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Artist != that1.Artist {
+		return false
+	}
+	if this.Album != that1.Album {
+		return false
+	}
+	if this.Genre != that1.Genre {
+		return false
+	}
+	if this.Year != that1.Year {
+		return false
+	}
+	if this.Track != that1.Track {
+		return false
+	}
+	return true
+}
+func (this *InlineAsset) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*InlineAsset)
+	if !ok {
+		that2, ok := that.(InlineAsset)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ContentType != that1.ContentType {
+		return false
+	}
+	if this.ETag != that1.ETag {
+		return false
+	}
+	if !bytes.Equal(this.Content, that1.Content) {
+		return false
+	}
+	return true
+}
+func (this *UserProfile) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UserProfile)
+	if !ok {
+		that2, ok := that.(UserProfile)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DisplayName != that1.DisplayName {
+		return false
+	}
+	if !this.Avatar.Equal(that1.Avatar) {
+		return false
+	}
+	if this.Locale != that1.Locale {
+		return false
+	}
+	if this.TimeZone != that1.TimeZone {
+		return false
+	}
+	if len(this.Preferences) != len(that1.Preferences) {
+		return false
+	}
+	for i := range this.Preferences {
+		if this.Preferences[i] != that1.Preferences[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *CalendarEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CalendarEvent)
+	if !ok {
+		that2, ok := that.(CalendarEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.UID != that1.UID {
+		return false
+	}
+	if this.Summary != that1.Summary {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Location != that1.Location {
+		return false
+	}
+	if this.StartAt != that1.StartAt {
+		return false
+	}
+	if this.EndAt != that1.EndAt {
+		return false
+	}
+	if this.AllDay != that1.AllDay {
+		return false
+	}
+	if this.TimeZone != that1.TimeZone {
+		return false
+	}
+	if this.Recurrence != that1.Recurrence {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if !this.Organizer.Equal(that1.Organizer) {
+		return false
+	}
+	if len(this.Attendees) != len(that1.Attendees) {
+		return false
+	}
+	for i := range this.Attendees {
+		if !this.Attendees[i].Equal(that1.Attendees[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *Attendee) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Attendee)
+	if !ok {
+		that2, ok := that.(Attendee)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Email != that1.Email {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Role != that1.Role {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	return true
+}
+func (this *Contact) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Contact)
+	if !ok {
+		that2, ok := that.(Contact)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.UID != that1.UID {
+		return false
+	}
+	if this.FullName != that1.FullName {
+		return false
+	}
+	if this.GivenName != that1.GivenName {
+		return false
+	}
+	if this.FamilyName != that1.FamilyName {
+		return false
+	}
+	if this.Org != that1.Org {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if len(this.Emails) != len(that1.Emails) {
+		return false
+	}
+	for i := range this.Emails {
+		if !this.Emails[i].Equal(that1.Emails[i]) {
+			return false
+		}
+	}
+	if len(this.Phones) != len(that1.Phones) {
+		return false
+	}
+	for i := range this.Phones {
+		if !this.Phones[i].Equal(that1.Phones[i]) {
+			return false
+		}
+	}
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
+	}
+	for i := range this.Addresses {
+		if !this.Addresses[i].Equal(that1.Addresses[i]) {
+			return false
+		}
+	}
+	if this.Birthday != that1.Birthday {
+		return false
+	}
+	if this.Note != that1.Note {
+		return false
+	}
+	return true
+}
+func (this *ContactValue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContactValue)
+	if !ok {
+		that2, ok := that.(ContactValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	return true
+}
+func (this *CollectionWindow) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CollectionWindow)
+	if !ok {
+		that2, ok := that.(CollectionWindow)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Offset != that1.Offset {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	if this.Total != that1.Total {
+		return false
+	}
+	return true
+}
+func (this *HealthStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HealthStatus)
+	if !ok {
+		that2, ok := that.(HealthStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.OK != that1.OK {
+		return false
+	}
+	if this.Liveness != that1.Liveness {
+		return false
+	}
+	if this.Detail != that1.Detail {
+		return false
+	}
+	if this.LatencyMs != that1.LatencyMs {
+		return false
+	}
+	if this.CheckedAt != that1.CheckedAt {
+		return false
+	}
+	return true
+}
+func (this *DataSegment) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DataSegment)
+	if !ok {
+		that2, ok := that.(DataSegment)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ByteOfs != that1.ByteOfs {
+		return false
+	}
+	if this.ByteSz != that1.ByteSz {
+		return false
+	}
+	if !bytes.Equal(this.InlineData, that1.InlineData) {
+		return false
+	}
+	if this.StreamURI != that1.StreamURI {
+		return false
+	}
+	if this.BlobID != that1.BlobID {
+		return false
+	}
+	return true
+}
+func (this *Position) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&std.Position{")
+	s = append(s, "CordType: "+fmt.Sprintf("%#v", this.CordType)+",\n")
+	s = append(s, "Q: "+fmt.Sprintf("%#v", this.Q)+",\n")
+	s = append(s, "R: "+fmt.Sprintf("%#v", this.R)+",\n")
+	s = append(s, "S: "+fmt.Sprintf("%#v", this.S)+",\n")
+	s = append(s, "ROU: "+fmt.Sprintf("%#v", this.ROU)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *LatLng) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&std.LatLng{")
+	s = append(s, "Lat: "+fmt.Sprintf("%#v", this.Lat)+",\n")
+	s = append(s, "Lng: "+fmt.Sprintf("%#v", this.Lng)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Geometry) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&std.Geometry{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	if this.Points != nil {
+		s = append(s, "Points: "+fmt.Sprintf("%#v", this.Points)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *FSInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&std.FSInfo{")
+	s = append(s, "Mode: "+fmt.Sprintf("%#v", this.Mode)+",\n")
+	s = append(s, "IsDir: "+fmt.Sprintf("%#v", this.IsDir)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "NameLen: "+fmt.Sprintf("%#v", this.NameLen)+",\n")
+	s = append(s, "ContentType: "+fmt.Sprintf("%#v", this.ContentType)+",\n")
+	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
+	s = append(s, "ModifiedAt: "+fmt.Sprintf("%#v", this.ModifiedAt)+",\n")
+	s = append(s, "ByteSize: "+fmt.Sprintf("%#v", this.ByteSize)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Placement) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 14)
+	s = append(s, "&std.Placement{")
+	s = append(s, "Pos0: "+fmt.Sprintf("%#v", this.Pos0)+",\n")
+	s = append(s, "Pos1: "+fmt.Sprintf("%#v", this.Pos1)+",\n")
+	s = append(s, "Pos2: "+fmt.Sprintf("%#v", this.Pos2)+",\n")
+	s = append(s, "Scale0: "+fmt.Sprintf("%#v", this.Scale0)+",\n")
+	s = append(s, "Scale1: "+fmt.Sprintf("%#v", this.Scale1)+",\n")
+	s = append(s, "Scale2: "+fmt.Sprintf("%#v", this.Scale2)+",\n")
+	s = append(s, "EulerX: "+fmt.Sprintf("%#v", this.EulerX)+",\n")
+	s = append(s, "EulerY: "+fmt.Sprintf("%#v", this.EulerY)+",\n")
+	s = append(s, "EulerZ: "+fmt.Sprintf("%#v", this.EulerZ)+",\n")
+	s = append(s, "Metric: "+fmt.Sprintf("%#v", this.Metric)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BadgeDigit) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&std.BadgeDigit{")
+	s = append(s, "Amplitude: "+fmt.Sprintf("%#v", this.Amplitude)+",\n")
+	s = append(s, "AmplitudeModulus: "+fmt.Sprintf("%#v", this.AmplitudeModulus)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TRS) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 14)
+	s = append(s, "&std.TRS{")
+	s = append(s, "X1: "+fmt.Sprintf("%#v", this.X1)+",\n")
+	s = append(s, "X2: "+fmt.Sprintf("%#v", this.X2)+",\n")
+	s = append(s, "X3: "+fmt.Sprintf("%#v", this.X3)+",\n")
+	s = append(s, "ScaleMode: "+fmt.Sprintf("%#v", this.ScaleMode)+",\n")
+	s = append(s, "Scale1: "+fmt.Sprintf("%#v", this.Scale1)+",\n")
+	s = append(s, "Scale2: "+fmt.Sprintf("%#v", this.Scale2)+",\n")
+	s = append(s, "Scale3: "+fmt.Sprintf("%#v", this.Scale3)+",\n")
+	s = append(s, "Rotate1: "+fmt.Sprintf("%#v", this.Rotate1)+",\n")
+	s = append(s, "Rotate2: "+fmt.Sprintf("%#v", this.Rotate2)+",\n")
+	s = append(s, "Rotate3: "+fmt.Sprintf("%#v", this.Rotate3)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Embedding) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&std.Embedding{")
+	s = append(s, "Model: "+fmt.Sprintf("%#v", this.Model)+",\n")
+	s = append(s, "Vector: "+fmt.Sprintf("%#v", this.Vector)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TimeSeries) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&std.TimeSeries{")
+	s = append(s, "Start: "+fmt.Sprintf("%#v", this.Start)+",\n")
+	s = append(s, "Step: "+fmt.Sprintf("%#v", this.Step)+",\n")
+	s = append(s, "Counts: "+fmt.Sprintf("%#v", this.Counts)+",\n")
+	s = append(s, "Means: "+fmt.Sprintf("%#v", this.Means)+",\n")
+	s = append(s, "Mins: "+fmt.Sprintf("%#v", this.Mins)+",\n")
+	s = append(s, "Maxs: "+fmt.Sprintf("%#v", this.Maxs)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MediaInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 20)
+	s = append(s, "&std.MediaInfo{")
+	s = append(s, "ContentType: "+fmt.Sprintf("%#v", this.ContentType)+",\n")
+	s = append(s, "ByteSize: "+fmt.Sprintf("%#v", this.ByteSize)+",\n")
+	s = append(s, "Width: "+fmt.Sprintf("%#v", this.Width)+",\n")
+	s = append(s, "Height: "+fmt.Sprintf("%#v", this.Height)+",\n")
+	s = append(s, "DurationMs: "+fmt.Sprintf("%#v", this.DurationMs)+",\n")
+	s = append(s, "Orientation: "+fmt.Sprintf("%#v", this.Orientation)+",\n")
+	s = append(s, "TakenAt: "+fmt.Sprintf("%#v", this.TakenAt)+",\n")
+	s = append(s, "CameraMake: "+fmt.Sprintf("%#v", this.CameraMake)+",\n")
+	s = append(s, "CameraModel: "+fmt.Sprintf("%#v", this.CameraModel)+",\n")
+	if this.Location != nil {
+		s = append(s, "Location: "+fmt.Sprintf("%#v", this.Location)+",\n")
+	}
+	s = append(s, "Title: "+fmt.Sprintf("%#v", this.Title)+",\n")
+	s = append(s, "Artist: "+fmt.Sprintf("%#v", this.Artist)+",\n")
+	s = append(s, "Album: "+fmt.Sprintf("%#v", this.Album)+",\n")
+	s = append(s, "Genre: "+fmt.Sprintf("%#v", this.Genre)+",\n")
+	s = append(s, "Year: "+fmt.Sprintf("%#v", this.Year)+",\n")
+	s = append(s, "Track: "+fmt.Sprintf("%#v", this.Track)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *InlineAsset) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&std.InlineAsset{")
+	s = append(s, "ContentType: "+fmt.Sprintf("%#v", this.ContentType)+",\n")
+	s = append(s, "ETag: "+fmt.Sprintf("%#v", this.ETag)+",\n")
+	s = append(s, "Content: "+fmt.Sprintf("%#v", this.Content)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UserProfile) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&std.UserProfile{")
+	s = append(s, "DisplayName: "+fmt.Sprintf("%#v", this.DisplayName)+",\n")
+	if this.Avatar != nil {
+		s = append(s, "Avatar: "+fmt.Sprintf("%#v", this.Avatar)+",\n")
+	}
+	s = append(s, "Locale: "+fmt.Sprintf("%#v", this.Locale)+",\n")
+	s = append(s, "TimeZone: "+fmt.Sprintf("%#v", this.TimeZone)+",\n")
+	keysForPreferences := make([]string, 0, len(this.Preferences))
+	for k, _ := range this.Preferences {
+		keysForPreferences = append(keysForPreferences, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForPreferences)
+	mapStringForPreferences := "map[string]string{"
+	for _, k := range keysForPreferences {
+		mapStringForPreferences += fmt.Sprintf("%#v: %#v,", k, this.Preferences[k])
+	}
+	mapStringForPreferences += "}"
+	if this.Preferences != nil {
+		s = append(s, "Preferences: "+mapStringForPreferences+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CalendarEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 16)
+	s = append(s, "&std.CalendarEvent{")
+	s = append(s, "UID: "+fmt.Sprintf("%#v", this.UID)+",\n")
+	s = append(s, "Summary: "+fmt.Sprintf("%#v", this.Summary)+",\n")
+	s = append(s, "Description: "+fmt.Sprintf("%#v", this.Description)+",\n")
+	s = append(s, "Location: "+fmt.Sprintf("%#v", this.Location)+",\n")
+	s = append(s, "StartAt: "+fmt.Sprintf("%#v", this.StartAt)+",\n")
+	s = append(s, "EndAt: "+fmt.Sprintf("%#v", this.EndAt)+",\n")
+	s = append(s, "AllDay: "+fmt.Sprintf("%#v", this.AllDay)+",\n")
+	s = append(s, "TimeZone: "+fmt.Sprintf("%#v", this.TimeZone)+",\n")
+	s = append(s, "Recurrence: "+fmt.Sprintf("%#v", this.Recurrence)+",\n")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	if this.Organizer != nil {
+		s = append(s, "Organizer: "+fmt.Sprintf("%#v", this.Organizer)+",\n")
+	}
+	if this.Attendees != nil {
+		s = append(s, "Attendees: "+fmt.Sprintf("%#v", this.Attendees)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Attendee) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&std.Attendee{")
+	s = append(s, "Email: "+fmt.Sprintf("%#v", this.Email)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Role: "+fmt.Sprintf("%#v", this.Role)+",\n")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Contact) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 15)
+	s = append(s, "&std.Contact{")
+	s = append(s, "UID: "+fmt.Sprintf("%#v", this.UID)+",\n")
+	s = append(s, "FullName: "+fmt.Sprintf("%#v", this.FullName)+",\n")
+	s = append(s, "GivenName: "+fmt.Sprintf("%#v", this.GivenName)+",\n")
+	s = append(s, "FamilyName: "+fmt.Sprintf("%#v", this.FamilyName)+",\n")
+	s = append(s, "Org: "+fmt.Sprintf("%#v", this.Org)+",\n")
+	s = append(s, "Title: "+fmt.Sprintf("%#v", this.Title)+",\n")
+	if this.Emails != nil {
+		s = append(s, "Emails: "+fmt.Sprintf("%#v", this.Emails)+",\n")
+	}
+	if this.Phones != nil {
+		s = append(s, "Phones: "+fmt.Sprintf("%#v", this.Phones)+",\n")
+	}
+	if this.Addresses != nil {
+		s = append(s, "Addresses: "+fmt.Sprintf("%#v", this.Addresses)+",\n")
+	}
+	s = append(s, "Birthday: "+fmt.Sprintf("%#v", this.Birthday)+",\n")
+	s = append(s, "Note: "+fmt.Sprintf("%#v", this.Note)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ContactValue) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&std.ContactValue{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CollectionWindow) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&std.CollectionWindow{")
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	s = append(s, "Total: "+fmt.Sprintf("%#v", this.Total)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *HealthStatus) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&std.HealthStatus{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "OK: "+fmt.Sprintf("%#v", this.OK)+",\n")
+	s = append(s, "Liveness: "+fmt.Sprintf("%#v", this.Liveness)+",\n")
+	s = append(s, "Detail: "+fmt.Sprintf("%#v", this.Detail)+",\n")
+	s = append(s, "LatencyMs: "+fmt.Sprintf("%#v", this.LatencyMs)+",\n")
+	s = append(s, "CheckedAt: "+fmt.Sprintf("%#v", this.CheckedAt)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DataSegment) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&std.DataSegment{")
+	s = append(s, "ByteOfs: "+fmt.Sprintf("%#v", this.ByteOfs)+",\n")
+	s = append(s, "ByteSz: "+fmt.Sprintf("%#v", this.ByteSz)+",\n")
+	s = append(s, "InlineData: "+fmt.Sprintf("%#v", this.InlineData)+",\n")
+	s = append(s, "StreamURI: "+fmt.Sprintf("%#v", this.StreamURI)+",\n")
+	s = append(s, "BlobID: "+fmt.Sprintf("%#v", this.BlobID)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringStd(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *Position) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CordType != 0 {
+		n += 1 + sovStd(uint64(m.CordType))
+	}
+	if m.Q != 0 {
+		n += 9
+	}
+	if m.R != 0 {
+		n += 9
+	}
+	if m.S != 0 {
+		n += 9
+	}
+	if m.ROU != 0 {
+		n += 5
+	}
+	return n
+}
+
+func (m *LatLng) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Lat != 0 {
+		n += 9
+	}
+	if m.Lng != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *Geometry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovStd(uint64(m.Type))
+	}
+	if len(m.Points) > 0 {
+		for _, e := range m.Points {
+			l = e.Size()
+			n += 1 + l + sovStd(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FSInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Mode)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	if m.IsDir {
+		n += 2
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	if m.NameLen != 0 {
+		n += 1 + sovStd(uint64(m.NameLen))
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovStd(uint64(m.CreatedAt))
+	}
+	if m.ModifiedAt != 0 {
+		n += 1 + sovStd(uint64(m.ModifiedAt))
+	}
+	if m.ByteSize != 0 {
+		n += 1 + sovStd(uint64(m.ByteSize))
+	}
+	return n
+}
+
+func (m *Placement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pos0 != 0 {
+		n += 9
+	}
+	if m.Pos1 != 0 {
+		n += 9
+	}
+	if m.Pos2 != 0 {
+		n += 9
+	}
+	if m.Scale0 != 0 {
+		n += 5
+	}
+	if m.Scale1 != 0 {
+		n += 5
+	}
+	if m.Scale2 != 0 {
+		n += 5
+	}
+	if m.EulerX != 0 {
+		n += 5
+	}
+	if m.EulerY != 0 {
+		n += 5
+	}
+	if m.EulerZ != 0 {
+		n += 5
+	}
+	if m.Metric != 0 {
+		n += 1 + sovStd(uint64(m.Metric))
+	}
+	return n
+}
+
+func (m *BadgeDigit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Amplitude != 0 {
+		n += 1 + sovStd(uint64(m.Amplitude))
+	}
+	if m.AmplitudeModulus != 0 {
+		n += 1 + sovStd(uint64(m.AmplitudeModulus))
+	}
+	return n
+}
+
+func (m *TRS) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.X1 != 0 {
+		n += 10
+	}
+	if m.X2 != 0 {
+		n += 10
+	}
+	if m.X3 != 0 {
+		n += 10
+	}
+	if m.ScaleMode != 0 {
+		n += 2 + sovStd(uint64(m.ScaleMode))
+	}
+	if m.Scale1 != 0 {
+		n += 6
+	}
+	if m.Scale2 != 0 {
+		n += 6
+	}
+	if m.Scale3 != 0 {
+		n += 6
+	}
+	if m.Rotate1 != 0 {
+		n += 6
+	}
+	if m.Rotate2 != 0 {
+		n += 6
+	}
+	if m.Rotate3 != 0 {
+		n += 6
+	}
+	return n
+}
+
+func (m *Embedding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Model)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	if len(m.Vector) > 0 {
+		n += 1 + sovStd(uint64(len(m.Vector)*4)) + len(m.Vector)*4
+	}
+	return n
+}
+
+func (m *TimeSeries) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovStd(uint64(m.Start))
+	}
+	if m.Step != 0 {
+		n += 1 + sovStd(uint64(m.Step))
+	}
+	if len(m.Counts) > 0 {
+		l = 0
+		for _, e := range m.Counts {
+			l += sovStd(uint64(e))
+		}
+		n += 1 + sovStd(uint64(l)) + l
+	}
+	if len(m.Means) > 0 {
+		n += 1 + sovStd(uint64(len(m.Means)*8)) + len(m.Means)*8
+	}
+	if len(m.Mins) > 0 {
+		n += 1 + sovStd(uint64(len(m.Mins)*8)) + len(m.Mins)*8
+	}
+	if len(m.Maxs) > 0 {
+		n += 1 + sovStd(uint64(len(m.Maxs)*8)) + len(m.Maxs)*8
+	}
+	return n
+}
+
+func (m *MediaInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	if m.ByteSize != 0 {
+		n += 1 + sovStd(uint64(m.ByteSize))
+	}
+	if m.Width != 0 {
+		n += 1 + sovStd(uint64(m.Width))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStd(uint64(m.Height))
+	}
+	if m.DurationMs != 0 {
+		n += 1 + sovStd(uint64(m.DurationMs))
+	}
+	if m.Orientation != 0 {
+		n += 1 + sovStd(uint64(m.Orientation))
+	}
+	if m.TakenAt != 0 {
+		n += 1 + sovStd(uint64(m.TakenAt))
+	}
+	l = len(m.CameraMake)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	l = len(m.CameraModel)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	if m.Location != nil {
+		l = m.Location.Size()
+		n += 1 + l + sovStd(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	l = len(m.Artist)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	l = len(m.Album)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	l = len(m.Genre)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	if m.Year != 0 {
+		n += 2 + sovStd(uint64(m.Year))
+	}
+	if m.Track != 0 {
+		n += 2 + sovStd(uint64(m.Track))
+	}
+	return n
+}
+
+func (m *InlineAsset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	l = len(m.ETag)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	return n
+}
+
+func (m *UserProfile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DisplayName)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	if m.Avatar != nil {
+		l = m.Avatar.Size()
+		n += 1 + l + sovStd(uint64(l))
+	}
+	l = len(m.Locale)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	l = len(m.TimeZone)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	if len(m.Preferences) > 0 {
+		for k, v := range m.Preferences {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovStd(uint64(len(k))) + 1 + len(v) + sovStd(uint64(len(v)))
+			n += mapEntrySize + 1 + sovStd(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *CalendarEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UID)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	l = len(m.Summary)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	l = len(m.Location)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	if m.StartAt != 0 {
+		n += 1 + sovStd(uint64(m.StartAt))
+	}
+	if m.EndAt != 0 {
+		n += 1 + sovStd(uint64(m.EndAt))
+	}
+	if m.AllDay {
+		n += 2
+	}
+	l = len(m.TimeZone)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	l = len(m.Recurrence)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	if m.Organizer != nil {
+		l = m.Organizer.Size()
+		n += 1 + l + sovStd(uint64(l))
+	}
+	if len(m.Attendees) > 0 {
+		for _, e := range m.Attendees {
+			l = e.Size()
+			n += 1 + l + sovStd(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Attendee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	return n
+}
+
+func (m *Contact) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UID)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	l = len(m.FullName)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	l = len(m.GivenName)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	l = len(m.FamilyName)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	l = len(m.Org)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	if len(m.Emails) > 0 {
+		for _, e := range m.Emails {
+			l = e.Size()
+			n += 1 + l + sovStd(uint64(l))
+		}
+	}
+	if len(m.Phones) > 0 {
+		for _, e := range m.Phones {
+			l = e.Size()
+			n += 1 + l + sovStd(uint64(l))
+		}
+	}
+	if len(m.Addresses) > 0 {
+		for _, e := range m.Addresses {
+			l = e.Size()
+			n += 1 + l + sovStd(uint64(l))
+		}
+	}
+	l = len(m.Birthday)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	l = len(m.Note)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	return n
+}
+
+func (m *ContactValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	return n
+}
+
+func (m *CollectionWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		n += 1 + sovStd(uint64(m.Offset))
+	}
+	if m.Count != 0 {
+		n += 1 + sovStd(uint64(m.Count))
+	}
+	if m.Total != 0 {
+		n += 1 + sovStd(uint64(m.Total))
+	}
+	return n
+}
+
+func (m *HealthStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	if m.OK {
+		n += 2
+	}
+	if m.Liveness {
+		n += 2
+	}
+	l = len(m.Detail)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	if m.LatencyMs != 0 {
+		n += 1 + sovStd(uint64(m.LatencyMs))
+	}
+	if m.CheckedAt != 0 {
+		n += 1 + sovStd(uint64(m.CheckedAt))
+	}
+	return n
+}
+
+func (m *DataSegment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ByteOfs != 0 {
+		n += 1 + sovStd(uint64(m.ByteOfs))
+	}
+	if m.ByteSz != 0 {
+		n += 1 + sovStd(uint64(m.ByteSz))
+	}
+	l = len(m.InlineData)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	l = len(m.StreamURI)
+	if l > 0 {
+		n += 1 + l + sovStd(uint64(l))
+	}
+	if m.BlobID != 0 {
+		n += 1 + sovStd(uint64(m.BlobID))
+	}
+	return n
+}
+
+func sovStd(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStd(x uint64) (n int) {
+	return sovStd(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *Position) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Position{`,
+		`CordType:` + fmt.Sprintf("%v", this.CordType) + `,`,
+		`Q:` + fmt.Sprintf("%v", this.Q) + `,`,
+		`R:` + fmt.Sprintf("%v", this.R) + `,`,
+		`S:` + fmt.Sprintf("%v", this.S) + `,`,
+		`ROU:` + fmt.Sprintf("%v", this.ROU) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LatLng) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LatLng{`,
+		`Lat:` + fmt.Sprintf("%v", this.Lat) + `,`,
+		`Lng:` + fmt.Sprintf("%v", this.Lng) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Geometry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPoints := "[]*LatLng{"
+	for _, f := range this.Points {
+		repeatedStringForPoints += strings.Replace(f.String(), "LatLng", "LatLng", 1) + ","
+	}
+	repeatedStringForPoints += "}"
+	s := strings.Join([]string{`&Geometry{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Points:` + repeatedStringForPoints + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FSInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FSInfo{`,
+		`Mode:` + fmt.Sprintf("%v", this.Mode) + `,`,
+		`IsDir:` + fmt.Sprintf("%v", this.IsDir) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`NameLen:` + fmt.Sprintf("%v", this.NameLen) + `,`,
+		`ContentType:` + fmt.Sprintf("%v", this.ContentType) + `,`,
+		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
+		`ModifiedAt:` + fmt.Sprintf("%v", this.ModifiedAt) + `,`,
+		`ByteSize:` + fmt.Sprintf("%v", this.ByteSize) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Placement) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Placement{`,
+		`Pos0:` + fmt.Sprintf("%v", this.Pos0) + `,`,
+		`Pos1:` + fmt.Sprintf("%v", this.Pos1) + `,`,
+		`Pos2:` + fmt.Sprintf("%v", this.Pos2) + `,`,
+		`Scale0:` + fmt.Sprintf("%v", this.Scale0) + `,`,
+		`Scale1:` + fmt.Sprintf("%v", this.Scale1) + `,`,
+		`Scale2:` + fmt.Sprintf("%v", this.Scale2) + `,`,
+		`EulerX:` + fmt.Sprintf("%v", this.EulerX) + `,`,
+		`EulerY:` + fmt.Sprintf("%v", this.EulerY) + `,`,
+		`EulerZ:` + fmt.Sprintf("%v", this.EulerZ) + `,`,
+		`Metric:` + fmt.Sprintf("%v", this.Metric) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BadgeDigit) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BadgeDigit{`,
+		`Amplitude:` + fmt.Sprintf("%v", this.Amplitude) + `,`,
+		`AmplitudeModulus:` + fmt.Sprintf("%v", this.AmplitudeModulus) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TRS) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TRS{`,
+		`X1:` + fmt.Sprintf("%v", this.X1) + `,`,
+		`X2:` + fmt.Sprintf("%v", this.X2) + `,`,
+		`X3:` + fmt.Sprintf("%v", this.X3) + `,`,
+		`ScaleMode:` + fmt.Sprintf("%v", this.ScaleMode) + `,`,
+		`Scale1:` + fmt.Sprintf("%v", this.Scale1) + `,`,
+		`Scale2:` + fmt.Sprintf("%v", this.Scale2) + `,`,
+		`Scale3:` + fmt.Sprintf("%v", this.Scale3) + `,`,
+		`Rotate1:` + fmt.Sprintf("%v", this.Rotate1) + `,`,
+		`Rotate2:` + fmt.Sprintf("%v", this.Rotate2) + `,`,
+		`Rotate3:` + fmt.Sprintf("%v", this.Rotate3) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Embedding) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Embedding{`,
+		`Model:` + fmt.Sprintf("%v", this.Model) + `,`,
+		`Vector:` + fmt.Sprintf("%v", this.Vector) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TimeSeries) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TimeSeries{`,
+		`Start:` + fmt.Sprintf("%v", this.Start) + `,`,
+		`Step:` + fmt.Sprintf("%v", this.Step) + `,`,
+		`Counts:` + fmt.Sprintf("%v", this.Counts) + `,`,
+		`Means:` + fmt.Sprintf("%v", this.Means) + `,`,
+		`Mins:` + fmt.Sprintf("%v", this.Mins) + `,`,
+		`Maxs:` + fmt.Sprintf("%v", this.Maxs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MediaInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MediaInfo{`,
+		`ContentType:` + fmt.Sprintf("%v", this.ContentType) + `,`,
+		`ByteSize:` + fmt.Sprintf("%v", this.ByteSize) + `,`,
+		`Width:` + fmt.Sprintf("%v", this.Width) + `,`,
+		`Height:` + fmt.Sprintf("%v", this.Height) + `,`,
+		`DurationMs:` + fmt.Sprintf("%v", this.DurationMs) + `,`,
+		`Orientation:` + fmt.Sprintf("%v", this.Orientation) + `,`,
+		`TakenAt:` + fmt.Sprintf("%v", this.TakenAt) + `,`,
+		`CameraMake:` + fmt.Sprintf("%v", this.CameraMake) + `,`,
+		`CameraModel:` + fmt.Sprintf("%v", this.CameraModel) + `,`,
+		`Location:` + strings.Replace(this.Location.String(), "LatLng", "LatLng", 1) + `,`,
+		`Title:` + fmt.Sprintf("%v", this.Title) + `,`,
+		`Artist:` + fmt.Sprintf("%v", this.Artist) + `,`,
+		`Album:` + fmt.Sprintf("%v", this.Album) + `,`,
+		`Genre:` + fmt.Sprintf("%v", this.Genre) + `,`,
+		`Year:` + fmt.Sprintf("%v", this.Year) + `,`,
+		`Track:` + fmt.Sprintf("%v", this.Track) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *InlineAsset) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&InlineAsset{`,
+		`ContentType:` + fmt.Sprintf("%v", this.ContentType) + `,`,
+		`ETag:` + fmt.Sprintf("%v", this.ETag) + `,`,
+		`Content:` + fmt.Sprintf("%v", this.Content) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UserProfile) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForPreferences := make([]string, 0, len(this.Preferences))
+	for k, _ := range this.Preferences {
+		keysForPreferences = append(keysForPreferences, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForPreferences)
+	mapStringForPreferences := "map[string]string{"
+	for _, k := range keysForPreferences {
+		mapStringForPreferences += fmt.Sprintf("%v: %v,", k, this.Preferences[k])
+	}
+	mapStringForPreferences += "}"
+	s := strings.Join([]string{`&UserProfile{`,
+		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
+		`Avatar:` + strings.Replace(fmt.Sprintf("%v", this.Avatar), "Tag", "amp.Tag", 1) + `,`,
+		`Locale:` + fmt.Sprintf("%v", this.Locale) + `,`,
+		`TimeZone:` + fmt.Sprintf("%v", this.TimeZone) + `,`,
+		`Preferences:` + mapStringForPreferences + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CalendarEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForAttendees := "[]*Attendee{"
+	for _, f := range this.Attendees {
+		repeatedStringForAttendees += strings.Replace(f.String(), "Attendee", "Attendee", 1) + ","
+	}
+	repeatedStringForAttendees += "}"
+	s := strings.Join([]string{`&CalendarEvent{`,
+		`UID:` + fmt.Sprintf("%v", this.UID) + `,`,
+		`Summary:` + fmt.Sprintf("%v", this.Summary) + `,`,
+		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
+		`Location:` + fmt.Sprintf("%v", this.Location) + `,`,
+		`StartAt:` + fmt.Sprintf("%v", this.StartAt) + `,`,
+		`EndAt:` + fmt.Sprintf("%v", this.EndAt) + `,`,
+		`AllDay:` + fmt.Sprintf("%v", this.AllDay) + `,`,
+		`TimeZone:` + fmt.Sprintf("%v", this.TimeZone) + `,`,
+		`Recurrence:` + fmt.Sprintf("%v", this.Recurrence) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Organizer:` + strings.Replace(this.Organizer.String(), "Attendee", "Attendee", 1) + `,`,
+		`Attendees:` + repeatedStringForAttendees + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Attendee) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Attendee{`,
+		`Email:` + fmt.Sprintf("%v", this.Email) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Contact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForEmails := "[]*ContactValue{"
+	for _, f := range this.Emails {
+		repeatedStringForEmails += strings.Replace(f.String(), "ContactValue", "ContactValue", 1) + ","
+	}
+	repeatedStringForEmails += "}"
+	repeatedStringForPhones := "[]*ContactValue{"
+	for _, f := range this.Phones {
+		repeatedStringForPhones += strings.Replace(f.String(), "ContactValue", "ContactValue", 1) + ","
+	}
+	repeatedStringForPhones += "}"
+	repeatedStringForAddresses := "[]*ContactValue{"
+	for _, f := range this.Addresses {
+		repeatedStringForAddresses += strings.Replace(f.String(), "ContactValue", "ContactValue", 1) + ","
+	}
+	repeatedStringForAddresses += "}"
+	s := strings.Join([]string{`&Contact{`,
+		`UID:` + fmt.Sprintf("%v", this.UID) + `,`,
+		`FullName:` + fmt.Sprintf("%v", this.FullName) + `,`,
+		`GivenName:` + fmt.Sprintf("%v", this.GivenName) + `,`,
+		`FamilyName:` + fmt.Sprintf("%v", this.FamilyName) + `,`,
+		`Org:` + fmt.Sprintf("%v", this.Org) + `,`,
+		`Title:` + fmt.Sprintf("%v", this.Title) + `,`,
+		`Emails:` + repeatedStringForEmails + `,`,
+		`Phones:` + repeatedStringForPhones + `,`,
+		`Addresses:` + repeatedStringForAddresses + `,`,
+		`Birthday:` + fmt.Sprintf("%v", this.Birthday) + `,`,
+		`Note:` + fmt.Sprintf("%v", this.Note) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContactValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContactValue{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CollectionWindow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CollectionWindow{`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`Total:` + fmt.Sprintf("%v", this.Total) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HealthStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HealthStatus{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`OK:` + fmt.Sprintf("%v", this.OK) + `,`,
+		`Liveness:` + fmt.Sprintf("%v", this.Liveness) + `,`,
+		`Detail:` + fmt.Sprintf("%v", this.Detail) + `,`,
+		`LatencyMs:` + fmt.Sprintf("%v", this.LatencyMs) + `,`,
+		`CheckedAt:` + fmt.Sprintf("%v", this.CheckedAt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DataSegment) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DataSegment{`,
+		`ByteOfs:` + fmt.Sprintf("%v", this.ByteOfs) + `,`,
+		`ByteSz:` + fmt.Sprintf("%v", this.ByteSz) + `,`,
+		`InlineData:` + fmt.Sprintf("%v", this.InlineData) + `,`,
+		`StreamURI:` + fmt.Sprintf("%v", this.StreamURI) + `,`,
+		`BlobID:` + fmt.Sprintf("%v", this.BlobID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringStd(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *Position) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Position: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CordType", wireType)
+			}
+			m.CordType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CordType |= CordType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Q", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Q = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.R = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.S = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ROU", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.ROU = float32(math.Float32frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LatLng) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LatLng: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LatLng: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lat", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Lat = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lng", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Lng = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Geometry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Geometry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Geometry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= GeometryType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Points = append(m.Points, &LatLng{})
+			if err := m.Points[len(m.Points)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FSInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FSInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FSInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDir", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsDir = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameLen", wireType)
+			}
+			m.NameLen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NameLen |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedAt", wireType)
+			}
+			m.ModifiedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ModifiedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByteSize", wireType)
+			}
+			m.ByteSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ByteSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Placement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Placement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Placement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pos0", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Pos0 = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pos1", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Pos1 = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pos2", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Pos2 = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scale0", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Scale0 = float32(math.Float32frombits(v))
+		case 5:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scale1", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Scale1 = float32(math.Float32frombits(v))
+		case 6:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scale2", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Scale2 = float32(math.Float32frombits(v))
+		case 7:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EulerX", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.EulerX = float32(math.Float32frombits(v))
+		case 8:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EulerY", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.EulerY = float32(math.Float32frombits(v))
+		case 9:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EulerZ", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.EulerZ = float32(math.Float32frombits(v))
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metric", wireType)
+			}
+			m.Metric = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Metric |= amp.Metric(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BadgeDigit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BadgeDigit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BadgeDigit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amplitude", wireType)
+			}
+			m.Amplitude = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amplitude |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmplitudeModulus", wireType)
+			}
+			m.AmplitudeModulus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AmplitudeModulus |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TRS) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TRS: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TRS: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 41:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field X1", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.X1 = float64(math.Float64frombits(v))
+		case 42:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field X2", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.X2 = float64(math.Float64frombits(v))
+		case 43:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field X3", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.X3 = float64(math.Float64frombits(v))
+		case 50:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleMode", wireType)
+			}
+			m.ScaleMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScaleMode |= TRS_VisualScaleMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 51:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scale1", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Scale1 = float32(math.Float32frombits(v))
+		case 52:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scale2", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Scale2 = float32(math.Float32frombits(v))
+		case 53:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scale3", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Scale3 = float32(math.Float32frombits(v))
+		case 61:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rotate1", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Rotate1 = float32(math.Float32frombits(v))
+		case 62:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rotate2", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Rotate2 = float32(math.Float32frombits(v))
+		case 63:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rotate3", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Rotate3 = float32(math.Float32frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Embedding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Embedding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Embedding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Model", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Model = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				v2 := float32(math.Float32frombits(v))
+				m.Vector = append(m.Vector, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStd
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStd
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStd
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.Vector) == 0 {
+					m.Vector = make([]float32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					v2 := float32(math.Float32frombits(v))
+					m.Vector = append(m.Vector, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vector", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimeSeries) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimeSeries: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimeSeries: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			m.Step = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Step |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStd
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Counts = append(m.Counts, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStd
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStd
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStd
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Counts) == 0 {
+					m.Counts = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStd
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Counts = append(m.Counts, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
+			}
+		case 5:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Means = append(m.Means, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStd
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStd
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStd
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.Means) == 0 {
+					m.Means = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Means = append(m.Means, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Means", wireType)
+			}
+		case 6:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Mins = append(m.Mins, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStd
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStd
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStd
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.Mins) == 0 {
+					m.Mins = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Mins = append(m.Mins, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mins", wireType)
+			}
+		case 7:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Maxs = append(m.Maxs, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStd
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStd
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStd
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.Maxs) == 0 {
+					m.Maxs = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Maxs = append(m.Maxs, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maxs", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MediaInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MediaInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MediaInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByteSize", wireType)
+			}
+			m.ByteSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ByteSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Width", wireType)
+			}
+			m.Width = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Width |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationMs", wireType)
+			}
+			m.DurationMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orientation", wireType)
+			}
+			m.Orientation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Orientation |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakenAt", wireType)
+			}
+			m.TakenAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TakenAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CameraMake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CameraMake = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CameraModel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CameraModel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Location == nil {
+				m.Location = &LatLng{}
+			}
+			if err := m.Location.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Artist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Artist = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Album", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Album = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Genre", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Genre = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Year", wireType)
+			}
+			m.Year = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Year |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Track", wireType)
+			}
+			m.Track = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Track |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InlineAsset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InlineAsset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InlineAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ETag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ETag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = append(m.Content[:0], dAtA[iNdEx:postIndex]...)
+			if m.Content == nil {
+				m.Content = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Avatar", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Avatar == nil {
+				m.Avatar = &amp.Tag{}
+			}
+			if err := m.Avatar.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locale", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locale = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Preferences == nil {
+				m.Preferences = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStd
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStd
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthStd
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthStd
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStd
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthStd
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthStd
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipStd(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthStd
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Preferences[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CalendarEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CalendarEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CalendarEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Summary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Location = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartAt", wireType)
+			}
+			m.StartAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndAt", wireType)
+			}
+			m.EndAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllDay", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllDay = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recurrence", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recurrence = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Organizer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Organizer == nil {
+				m.Organizer = &Attendee{}
+			}
+			if err := m.Organizer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attendees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attendees = append(m.Attendees, &Attendee{})
+			if err := m.Attendees[len(m.Attendees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&DataSegment{`,
-		`ByteOfs:` + fmt.Sprintf("%v", this.ByteOfs) + `,`,
-		`ByteSz:` + fmt.Sprintf("%v", this.ByteSz) + `,`,
-		`InlineData:` + fmt.Sprintf("%v", this.InlineData) + `,`,
-		`StreamURI:` + fmt.Sprintf("%v", this.StreamURI) + `,`,
-		`BlobID:` + fmt.Sprintf("%v", this.BlobID) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringStd(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *Position) Unmarshal(dAtA []byte) error {
+func (m *Attendee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1782,17 +7974,17 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Position: wiretype end group for non-group")
+			return fmt.Errorf("proto: Attendee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Attendee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CordType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
 			}
-			m.CordType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStd
@@ -1802,55 +7994,120 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CordType |= CordType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Q", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Q = float64(math.Float64frombits(v))
-		case 4:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+			m.Email = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.R = float64(math.Float64frombits(v))
-		case 5:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.S = float64(math.Float64frombits(v))
-		case 6:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ROU", wireType)
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.ROU = float32(math.Float32frombits(v))
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStd(dAtA[iNdEx:])
@@ -1872,7 +8129,7 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FSInfo) Unmarshal(dAtA []byte) error {
+func (m *Contact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1895,15 +8152,47 @@ func (m *FSInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FSInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: Contact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FSInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Contact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FullName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1931,13 +8220,13 @@ func (m *FSInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mode = string(dAtA[iNdEx:postIndex])
+			m.FullName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsDir", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GivenName", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStd
@@ -1947,15 +8236,27 @@ func (m *FSInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsDir = bool(v != 0)
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GivenName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FamilyName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1983,13 +8284,13 @@ func (m *FSInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.FamilyName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NameLen", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Org", wireType)
 			}
-			m.NameLen = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStd
@@ -1999,14 +8300,27 @@ func (m *FSInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NameLen |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Org = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2034,13 +8348,13 @@ func (m *FSInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContentType = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Emails", wireType)
 			}
-			m.CreatedAt = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStd
@@ -2050,16 +8364,31 @@ func (m *FSInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreatedAt |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Emails = append(m.Emails, &ContactValue{})
+			if err := m.Emails[len(m.Emails)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedAt", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phones", wireType)
 			}
-			m.ModifiedAt = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStd
@@ -2069,16 +8398,31 @@ func (m *FSInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ModifiedAt |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phones = append(m.Phones, &ContactValue{})
+			if err := m.Phones[len(m.Phones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ByteSize", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			m.ByteSize = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStd
@@ -2088,11 +8432,90 @@ func (m *FSInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ByteSize |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, &ContactValue{})
+			if err := m.Addresses[len(m.Addresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Birthday", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Birthday = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Note", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Note = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStd(dAtA[iNdEx:])
@@ -2114,7 +8537,7 @@ func (m *FSInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Placement) Unmarshal(dAtA []byte) error {
+func (m *ContactValue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2137,116 +8560,49 @@ func (m *Placement) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Placement: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContactValue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Placement: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContactValue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pos0", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Pos0 = float64(math.Float64frombits(v))
-		case 2:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pos1", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Pos1 = float64(math.Float64frombits(v))
-		case 3:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pos2", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Pos2 = float64(math.Float64frombits(v))
-		case 4:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scale0", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Scale0 = float32(math.Float32frombits(v))
-		case 5:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scale1", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Scale1 = float32(math.Float32frombits(v))
-		case 6:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scale2", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Scale2 = float32(math.Float32frombits(v))
-		case 7:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EulerX", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.EulerX = float32(math.Float32frombits(v))
-		case 8:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EulerY", wireType)
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.EulerY = float32(math.Float32frombits(v))
-		case 9:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EulerZ", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.EulerZ = float32(math.Float32frombits(v))
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metric", wireType)
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			m.Metric = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStd
@@ -2256,11 +8612,24 @@ func (m *Placement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Metric |= amp.Metric(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStd(dAtA[iNdEx:])
@@ -2282,7 +8651,7 @@ func (m *Placement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BadgeDigit) Unmarshal(dAtA []byte) error {
+func (m *CollectionWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2305,17 +8674,17 @@ func (m *BadgeDigit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BadgeDigit: wiretype end group for non-group")
+			return fmt.Errorf("proto: CollectionWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BadgeDigit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CollectionWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amplitude", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			m.Amplitude = 0
+			m.Offset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStd
@@ -2325,16 +8694,16 @@ func (m *BadgeDigit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amplitude |= int64(b&0x7F) << shift
+				m.Offset |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmplitudeModulus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			m.AmplitudeModulus = 0
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStd
@@ -2344,7 +8713,26 @@ func (m *BadgeDigit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AmplitudeModulus |= int64(b&0x7F) << shift
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2370,7 +8758,7 @@ func (m *BadgeDigit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TRS) Unmarshal(dAtA []byte) error {
+func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2393,50 +8781,49 @@ func (m *TRS) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TRS: wiretype end group for non-group")
+			return fmt.Errorf("proto: HealthStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TRS: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HealthStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 41:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field X1", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.X1 = float64(math.Float64frombits(v))
-		case 42:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field X2", wireType)
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.X2 = float64(math.Float64frombits(v))
-		case 43:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field X3", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.X3 = float64(math.Float64frombits(v))
-		case 50:
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScaleMode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OK", wireType)
 			}
-			m.ScaleMode = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStd
@@ -2446,77 +8833,102 @@ func (m *TRS) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ScaleMode |= TRS_VisualScaleMode(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 51:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scale1", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			m.OK = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liveness", wireType)
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Scale1 = float32(math.Float32frombits(v))
-		case 52:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scale2", wireType)
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			m.Liveness = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Scale2 = float32(math.Float32frombits(v))
-		case 53:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scale3", wireType)
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStd
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Scale3 = float32(math.Float32frombits(v))
-		case 61:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rotate1", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStd
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Rotate1 = float32(math.Float32frombits(v))
-		case 62:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rotate2", wireType)
+			m.Detail = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatencyMs", wireType)
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			m.LatencyMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatencyMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Rotate2 = float32(math.Float32frombits(v))
-		case 63:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rotate3", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckedAt", wireType)
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			m.CheckedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Rotate3 = float32(math.Float32frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStd(dAtA[iNdEx:])
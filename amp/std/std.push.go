@@ -0,0 +1,281 @@
+package std
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+// PushUpdate pushes a single element update to this pin's client, subject to Pin.Policy.
+// If the element's attr was pushed less than Policy.MinInterval ago, the update is held and replaced by any subsequent update to the same element.
+func (pin *Pin[AppT]) PushUpdate(op amp.TxOp, val tag.Value) error {
+	if !pin.attrsSelected(op.AttrID) && !(op.AttrID == CellProperties.ID && pin.attrsSelected(op.ItemID)) {
+		return nil
+	}
+
+	var buf []byte
+	if val != nil {
+		var err error
+		if buf, err = val.MarshalToStore(nil); err != nil {
+			return err
+		}
+	}
+
+	pin.attrsMu.Lock()
+	if pin.pusher == nil {
+		pin.pusher = newConflator(pin.minInterval, pin.pushTx)
+		pin.pusher.onError = func(err error) {
+			pin.ctx.Log().Warnf("held push failed: %v", err)
+		}
+	}
+	pusher := pin.pusher
+	pin.attrsMu.Unlock()
+
+	return pusher.push(op, buf)
+}
+
+// SetPolicy replaces this pin's push policy, taking effect with the next push.
+func (pin *Pin[AppT]) SetPolicy(policy PushPolicy) {
+	pin.attrsMu.Lock()
+	pin.Policy = policy
+	pin.attrsMu.Unlock()
+}
+
+func (pin *Pin[AppT]) policy() PushPolicy {
+	pin.attrsMu.RLock()
+	defer pin.attrsMu.RUnlock()
+	return pin.Policy
+}
+
+func (pin *Pin[AppT]) minInterval() time.Duration {
+	pin.attrsMu.RLock()
+	defer pin.attrsMu.RUnlock()
+	return pin.Policy.MinInterval
+}
+
+// Metrics returns a snapshot of this pin's push activity.
+func (pin *Pin[AppT]) Metrics() amp.PinMetrics {
+	m := amp.PinMetrics{
+		TxsPushed:   pin.metrics.txsPushed.Load(),
+		BytesPushed: pin.metrics.bytesPushed.Load(),
+		TimeBlocked: time.Duration(pin.metrics.timeBlocked.Load()),
+		SlowPushes:  int(pin.metrics.slowPushes.Load()),
+	}
+
+	pin.attrsMu.RLock()
+	pusher := pin.pusher
+	pin.attrsMu.RUnlock()
+
+	if pusher != nil {
+		m.QueueDepth = pusher.queueDepth()
+	}
+	return m
+}
+
+type pinMetrics struct {
+	txsPushed   atomic.Int64
+	bytesPushed atomic.Int64
+	timeBlocked atomic.Int64 // time.Duration
+	slowPushes  atomic.Int32 // consecutive
+}
+
+// pushTx sends the given tx to the pin's Requester and records push metrics.
+func (pin *Pin[AppT]) pushTx(tx *amp.TxMsg) error {
+	bytes := int64(len(tx.DataStore))
+	ops := int64(len(tx.Ops))
+	start := time.Now()
+	err := pin.Op.PushTx(tx)
+	blocked := time.Since(start)
+
+	m := &pin.metrics
+	m.txsPushed.Add(1)
+	m.bytesPushed.Add(bytes)
+	m.timeBlocked.Add(int64(blocked))
+	pin.countPush(ops, bytes)
+
+	policy := pin.policy()
+	if policy.SlowPush <= 0 {
+		return err
+	}
+	if blocked < policy.SlowPush {
+		m.slowPushes.Store(0)
+		return err
+	}
+
+	limit := policy.SlowPushLimit
+	if limit <= 0 {
+		limit = 3
+	}
+	if int(m.slowPushes.Add(1)) == limit {
+		metrics := pin.Metrics()
+		if policy.OnSlowConsumer != nil {
+			policy.OnSlowConsumer(metrics)
+		}
+		if observer, ok := pin.Op.(amp.SlowConsumerObserver); ok {
+			observer.OnSlowConsumer(metrics)
+		}
+	}
+	return err
+}
+
+func (pin *Pin[AppT]) closePusher() {
+	pin.attrsMu.Lock()
+	pusher := pin.pusher
+	pin.attrsMu.Unlock()
+
+	if pusher != nil {
+		pusher.close()
+	}
+}
+
+func (pin *Pin[AppT]) attrsSelected(attrID tag.ID) bool {
+	pin.attrsMu.RLock()
+	defer pin.attrsMu.RUnlock()
+	return pin.attrs.Selects(attrID)
+}
+
+// conflator rate limits pushes per attr (of a cell), retaining only the latest value of each element held back.
+type conflator struct {
+	mu       sync.Mutex
+	interval func() time.Duration // read each push so policy changes take effect
+	now      func() time.Time
+	send     func(tx *amp.TxMsg) error
+	onError  func(err error) // if set, called when sending held ops fails
+	pushedAt map[attrKey]time.Time
+	held     map[attrKey]time.Time // due time of each attr with pending ops
+	pending  map[amp.ElementID]pendingOp
+	timer    *time.Timer
+	closed   bool
+}
+
+// attrKey identifies an attr of a cell.
+type attrKey [2]tag.ID
+
+type pendingOp struct {
+	op  amp.TxOp
+	buf []byte
+}
+
+func newConflator(interval func() time.Duration, send func(tx *amp.TxMsg) error) *conflator {
+	return &conflator{
+		interval: interval,
+		now:      time.Now,
+		send:     send,
+		pushedAt: make(map[attrKey]time.Time),
+		held:     make(map[attrKey]time.Time),
+		pending:  make(map[amp.ElementID]pendingOp),
+	}
+}
+
+func (c *conflator) push(op amp.TxOp, buf []byte) error {
+	interval := c.interval()
+
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return amp.ErrRequestClosed
+	}
+	key := attrKey{op.CellID, op.AttrID}
+	_, held := c.held[key]
+	if interval <= 0 && !held {
+		c.mu.Unlock()
+		return c.sendOps([]pendingOp{{op: op, buf: buf}})
+	}
+
+	now := c.now()
+	due := c.pushedAt[key].Add(interval)
+	if !held && !now.Before(due) {
+		c.pushedAt[key] = now
+		c.mu.Unlock()
+		return c.sendOps([]pendingOp{{op: op, buf: buf}})
+	}
+
+	// keep latest
+	c.pending[amp.ElementID{op.CellID, op.AttrID, op.ItemID}] = pendingOp{op: op, buf: buf}
+	if !held {
+		c.held[key] = due
+	}
+	c.scheduleFlush(now)
+	c.mu.Unlock()
+	return nil
+}
+
+// Schedules flush() for the earliest due time of a held attr -- caller holds c.mu
+func (c *conflator) scheduleFlush(now time.Time) {
+	var next time.Time
+	for _, due := range c.held {
+		if next.IsZero() || due.Before(next) {
+			next = due
+		}
+	}
+	if next.IsZero() {
+		return
+	}
+	delay := next.Sub(now)
+	if c.timer == nil {
+		c.timer = time.AfterFunc(delay, c.flush)
+	} else {
+		c.timer.Reset(delay)
+	}
+}
+
+// flush sends the pending ops of each attr that is due.
+func (c *conflator) flush() {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return
+	}
+	now := c.now()
+	var ready []pendingOp
+	for key, due := range c.held {
+		if now.Before(due) {
+			continue
+		}
+		for elemID, pi := range c.pending {
+			if elemID[0] == key[0] && elemID[1] == key[1] {
+				ready = append(ready, pi)
+				delete(c.pending, elemID)
+			}
+		}
+		c.pushedAt[key] = now
+		delete(c.held, key)
+	}
+	c.scheduleFlush(now)
+	c.mu.Unlock()
+
+	if len(ready) > 0 {
+		if err := c.sendOps(ready); err != nil && c.onError != nil {
+			c.onError(err)
+		}
+	}
+}
+
+func (c *conflator) sendOps(ops []pendingOp) error {
+	tx := amp.NewTxMsg(true)
+	for i := range ops {
+		tx.MarshalOpWithBuf(&ops[i].op, ops[i].buf)
+	}
+	return c.send(tx)
+}
+
+func (c *conflator) queueDepth() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.pending)
+}
+
+// Discards any held updates and stops future flushes.
+func (c *conflator) close() {
+	c.mu.Lock()
+	c.closed = true
+	if c.timer != nil {
+		c.timer.Stop()
+	}
+	c.pending = nil
+	c.held = nil
+	c.mu.Unlock()
+}
@@ -0,0 +1,80 @@
+package std
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/vecindex"
+)
+
+// Pin URL query parameters for similarity queries, e.g. "amp://photos/similar?like={cellID}&k=20"
+const (
+	SimilarToParam  = "like"   // base32 ID of a reference cell
+	SimilarVecParam = "vector" // comma separated floats
+	SimilarKParam   = "k"      // max number of results
+)
+
+// SimilarQuery is a nearest neighbor query parsed from pin params.
+// Either CellID or Vector is set.
+type SimilarQuery struct {
+	CellID tag.ID    // reference cell whose embedding is the query
+	Vector []float32 // explicit query embedding
+	K      int       // max results
+}
+
+// ParseSimilarQuery returns the similarity query of the given request, using defaultK if no k param is given.
+func ParseSimilarQuery(req *amp.Request, defaultK int) (SimilarQuery, error) {
+	query := SimilarQuery{
+		K: defaultK,
+	}
+	if req.Values == nil {
+		return query, amp.ErrCode_BadRequest.Error("missing similarity query")
+	}
+
+	if str := req.Values.Get(SimilarKParam); str != "" {
+		k, err := strconv.Atoi(str)
+		if err != nil || k <= 0 {
+			return query, amp.ErrCode_BadRequest.Errorf("bad %q param", SimilarKParam)
+		}
+		query.K = k
+	}
+
+	if str := req.Values.Get(SimilarToParam); str != "" {
+		cellID, err := tag.FromBase32(str)
+		if err != nil {
+			return query, amp.ErrCode_BadRequest.Errorf("bad %q param: %v", SimilarToParam, err)
+		}
+		query.CellID = cellID
+		return query, nil
+	}
+
+	if str := req.Values.Get(SimilarVecParam); str != "" {
+		fields := strings.Split(str, ",")
+		query.Vector = make([]float32, len(fields))
+		for i, fi := range fields {
+			x, err := strconv.ParseFloat(strings.TrimSpace(fi), 32)
+			if err != nil {
+				return query, amp.ErrCode_BadRequest.Errorf("bad %q param: %v", SimilarVecParam, err)
+			}
+			query.Vector[i] = float32(x)
+		}
+		return query, nil
+	}
+
+	return query, amp.ErrCode_BadRequest.Error("missing similarity query")
+}
+
+// Similar queries the given index, returning hits ranked by descending similarity.
+// A query by reference cell excludes the reference cell itself.
+func Similar(idx vecindex.Index, query SimilarQuery) ([]vecindex.Hit, error) {
+	if query.Vector != nil {
+		return idx.Nearest(query.Vector, query.K)
+	}
+	hits, err := idx.NearestTo(query.CellID, query.K)
+	if err == vecindex.ErrItemNotFound {
+		err = amp.ErrCellNotFound
+	}
+	return hits, err
+}
@@ -0,0 +1,154 @@
+package std
+
+import (
+	"bytes"
+	"fmt"
+	"runtime"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// Phases of serving a pin timed for slow op detection -- see SlowOpPolicy.
+const (
+	PhaseMakeReady = "MakeReady" // AppInstance.MakeReady()
+	PhasePinInto   = "PinInto"   // Cell.PinInto()
+	PhasePushState = "PushState" // marshalling (Cell.MarshalAttrs) and pushing a pin's state
+	PhaseCompute   = "Compute"   // ComputedCell.Compute() as its inputs change
+)
+
+// SlowOpPolicy configures detection of pins and app callbacks that take too long (e.g. a blocking network call in PinInto).
+// A slow op is logged as a warning with its URL, app, and a stack sample of the goroutine running it, and counted -- see ReadSlowOpMetrics().
+type SlowOpPolicy struct {
+	Threshold time.Duration   // an op taking longer is reported; if <= 0, detection is off
+	OnSlowOp  func(op SlowOp) // optional: called in addition to logging (e.g. to export to a tracing system)
+}
+
+// SlowOp describes a pin phase that exceeded SlowOpPolicy.Threshold.
+type SlowOp struct {
+	Phase   string // e.g. PhasePinInto
+	App     string // the app's UID (see amp.LogFieldApp), or its context label
+	URL     string // the pin request's target URL, if any
+	CellID  tag.ID
+	Elapsed time.Duration
+	Stack   string // the goroutine's stack as the threshold elapsed, showing where the op was blocked
+	Err     error  // the op's outcome
+}
+
+// SlowOpKey identifies a class of slow ops for metrics.
+type SlowOpKey struct {
+	App   string
+	Phase string
+}
+
+var (
+	gSlowPolicy atomic.Pointer[SlowOpPolicy]
+	gSlowCounts sync.Map // SlowOpKey => *atomic.Int64
+)
+
+func init() {
+	SetSlowOpPolicy(SlowOpPolicy{
+		Threshold: 2 * time.Second,
+	})
+}
+
+// SetSlowOpPolicy replaces the process-wide slow op policy (by default, ops over 2s are reported).
+func SetSlowOpPolicy(policy SlowOpPolicy) {
+	gSlowPolicy.Store(&policy)
+}
+
+// ReadSlowOpMetrics returns the number of slow ops reported since process start, by app and phase.
+func ReadSlowOpMetrics() map[SlowOpKey]int64 {
+	counts := make(map[SlowOpKey]int64)
+	gSlowCounts.Range(func(key, val any) bool {
+		counts[key.(SlowOpKey)] = val.(*atomic.Int64).Load()
+		return true
+	})
+	return counts
+}
+
+// timeOp runs fn, reporting it as a slow op of the given pin if it exceeds the policy's threshold.
+// Once the threshold elapses while fn is running, the stack of the calling goroutine is sampled so the report shows where fn is blocked.
+// The op's elapsed time and allocations are also added to the app's usage -- see ReadAppUsage().
+func (pin *Pin[AppT]) timeOp(phase string, fn func() error) error {
+	policy := gSlowPolicy.Load()
+	if policy.Threshold <= 0 {
+		return pin.measurePhase(fn)
+	}
+
+	goid := currentGoroutineID()
+	sampled := make(chan string, 1)
+	start := time.Now()
+	watchdog := time.AfterFunc(policy.Threshold, func() {
+		sampled <- goroutineStack(goid)
+	})
+	err := pin.measurePhase(fn)
+	if watchdog.Stop() {
+		return err
+	}
+
+	op := SlowOp{
+		Phase:   phase,
+		App:     appName(pin.App),
+		CellID:  pin.Cell.Root().ID,
+		Elapsed: time.Since(start),
+		Stack:   <-sampled,
+		Err:     err,
+	}
+	if req := pin.Op.Request(); req != nil && req.PinTarget != nil {
+		op.URL = req.PinTarget.URL
+	}
+	reportSlowOp(pin.ctx, policy, op)
+	return err
+}
+
+func reportSlowOp(ctx task.Context, policy *SlowOpPolicy, op SlowOp) {
+	key := SlowOpKey{App: op.App, Phase: op.Phase}
+	counter, _ := gSlowCounts.LoadOrStore(key, &atomic.Int64{})
+	counter.(*atomic.Int64).Add(1)
+
+	if ctx != nil {
+		ctx.Log().Warnf("slow %s (%v) of %q by %s:\n%s", op.Phase, op.Elapsed.Truncate(time.Millisecond), op.URL, op.App, op.Stack)
+	}
+	if policy.OnSlowOp != nil {
+		policy.OnSlowOp(op)
+	}
+}
+
+func appName(app amp.AppInstance) string {
+	info := app.Info()
+	if uid, ok := info.LogFields[amp.LogFieldApp]; ok {
+		return fmt.Sprint(uid)
+	}
+	return task.LabelClass(info.Label)
+}
+
+// currentGoroutineID parses the calling goroutine's ID from its stack header ("goroutine 18 [running]:").
+func currentGoroutineID() uint64 {
+	var buf [64]byte
+	header := buf[:runtime.Stack(buf[:], false)]
+	header = bytes.TrimPrefix(header, []byte("goroutine "))
+	if i := bytes.IndexByte(header, ' '); i > 0 {
+		header = header[:i]
+	}
+	id, _ := strconv.ParseUint(string(header), 10, 64)
+	return id
+}
+
+// goroutineStack returns the stack of the given goroutine, or "" if it is not found (e.g. it has exited).
+func goroutineStack(goid uint64) string {
+	buf := make([]byte, 1<<20)
+	buf = buf[:runtime.Stack(buf, true)]
+	prefix := []byte("goroutine " + strconv.FormatUint(goid, 10) + " ")
+	for _, stack := range bytes.Split(buf, []byte("\n\n")) {
+		if bytes.HasPrefix(stack, prefix) {
+			return string(stack)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,180 @@
+package std
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+// CellState is an immutable snapshot of a StoredCell's elements.
+type CellState struct {
+	Rev    uint64                   // incremented by each write
+	cellID tag.ID                   // cell the elements belong to
+	elems  map[amp.ElementID][]byte // marshalled values -- never modified once published
+}
+
+// Len returns the number of elements in this snapshot.
+func (state *CellState) Len() int {
+	return len(state.elems)
+}
+
+// Get unmarshals the given element into dst, returning ErrCellNotFound if not present.
+func (state *CellState) Get(attrID, itemID tag.ID, dst tag.Value) error {
+	data, exists := state.elems[amp.ElementID{state.cellID, attrID, itemID}]
+	if !exists {
+		return amp.ErrCellNotFound
+	}
+	return dst.Unmarshal(data)
+}
+
+// with returns a copy of this snapshot with the given element replaced (or removed if data is nil).
+func (state *CellState) with(elemID amp.ElementID, data []byte) *CellState {
+	next := &CellState{
+		Rev:    state.Rev + 1,
+		cellID: state.cellID,
+		elems:  make(map[amp.ElementID][]byte, len(state.elems)+1),
+	}
+	for id, val := range state.elems {
+		next.elems[id] = val
+	}
+	if data == nil {
+		delete(next.elems, elemID)
+	} else {
+		next.elems[elemID] = data
+	}
+	return next
+}
+
+// StoredCell is a cell whose elements are persisted in the app's host-managed CellStore and served from copy-on-write snapshots.
+//
+// A pin marshals whichever snapshot is current when it syncs, so each client sees a consistent state and an initial sync neither blocks nor is blocked by writers.
+// Each write publishes a new snapshot and re-syncs the cell's maintained pins (see ComputedCell).
+type StoredCell[AppT amp.AppInstance] struct {
+	ComputedCell[AppT]
+	Store amp.CellStore
+
+	writeMu sync.Mutex // serializes writers
+	state   atomic.Pointer[CellState]
+	changed Signal
+}
+
+// NewStoredCell returns a cell presenting the elements stored under the given cell ID (loaded once first pinned).
+func NewStoredCell[AppT amp.AppInstance](cellID tag.ID, store amp.CellStore) *StoredCell[AppT] {
+	cell := &StoredCell[AppT]{
+		Store: store,
+	}
+	cell.ID = cellID
+	cell.Inputs = []*Signal{&cell.changed}
+	cell.Compute = cell.load
+	cell.Attrs = cell.marshalState
+	return cell
+}
+
+// State returns the current snapshot of this cell, loading it from Store if needed.
+func (cell *StoredCell[AppT]) State() (*CellState, error) {
+	if state := cell.state.Load(); state != nil {
+		return state, nil
+	}
+
+	cell.writeMu.Lock()
+	defer cell.writeMu.Unlock()
+	if state := cell.state.Load(); state != nil {
+		return state, nil
+	}
+	state := &CellState{
+		cellID: cell.ID,
+		elems:  make(map[amp.ElementID][]byte),
+	}
+	err := cell.Store.ForEachElement(cell.ID, func(elemID amp.ElementID, data []byte) error {
+		state.elems[elemID] = append([]byte(nil), data...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	cell.state.Store(state)
+	return state, nil
+}
+
+// Put writes the given element through to Store (nil deletes it) and publishes a new snapshot.
+func (cell *StoredCell[AppT]) Put(attrID, itemID tag.ID, val tag.Value) error {
+	var data []byte
+	if val != nil {
+		var err error
+		if data, err = val.MarshalToStore(nil); err != nil {
+			return err
+		}
+		if data == nil {
+			data = []byte{} // an empty value is not a deletion
+		}
+	}
+	if _, err := cell.State(); err != nil {
+		return err
+	}
+
+	elemID := amp.ElementID{cell.ID, attrID, itemID}
+	cell.writeMu.Lock()
+	err := cell.Store.PutElement(elemID, val)
+	if err == nil {
+		cell.state.Store(cell.state.Load().with(elemID, data))
+	}
+	cell.writeMu.Unlock()
+
+	if err != nil {
+		return err
+	}
+	cell.changed.Notify()
+	return nil
+}
+
+func (cell *StoredCell[AppT]) load() ([]Cell[AppT], error) {
+	_, err := cell.State()
+	return nil, err
+}
+
+func (cell *StoredCell[AppT]) marshalState(w CellWriter) {
+	state := cell.state.Load()
+	if state == nil {
+		return
+	}
+	op := amp.TxOp{}
+	op.OpCode = amp.TxOpCode_UpsertElement
+	val := &storedValue{}
+	for elemID, data := range state.elems {
+		op.CellID, op.AttrID, op.ItemID = elemID[0], elemID[1], elemID[2]
+		val.data = data
+		w.Upsert(&op, val)
+	}
+}
+
+// storedValue is a tag.Value already in marshalled form.
+type storedValue struct {
+	data []byte
+}
+
+func (v *storedValue) TagSpec() tag.Spec {
+	return tag.Spec{}
+}
+
+func (v *storedValue) New() tag.Value {
+	return &storedValue{}
+}
+
+func (v *storedValue) MarshalToStore(in []byte) (out []byte, err error) {
+	return append(in, v.data...), nil
+}
+
+func (v *storedValue) Size() int {
+	return len(v.data)
+}
+
+func (v *storedValue) MarshalToSizedBuffer(dst []byte) (int, error) {
+	return copy(dst[len(dst)-len(v.data):], v.data), nil
+}
+
+func (v *storedValue) Unmarshal(src []byte) error {
+	v.data = append(v.data[:0], src...)
+	return nil
+}
@@ -3,9 +3,12 @@ package std
 import (
 	fmt "fmt"
 	reflect "reflect"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/log"
 	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
 	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
 )
@@ -20,11 +23,14 @@ func PinAndServe[AppT amp.AppInstance](cell Cell[AppT], app AppT, op amp.Request
 		root.ID = tag.Now()
 	}
 
+	req := op.Request()
 	pin := &Pin[AppT]{
 		Op:       op,
 		App:      app,
 		Cell:     cell,
+		Sync:     req.StateSync,
 		children: make(map[tag.ID]Cell[AppT]),
+		attrs:    req.AttrMask(),
 	}
 
 	label := "pin: " + root.ID.Base32Suffix()
@@ -32,32 +38,62 @@ func PinAndServe[AppT amp.AppInstance](cell Cell[AppT], app AppT, op amp.Request
 		label += fmt.Sprintf(", Cell.(*%v)", reflect.TypeOf(cell).Elem().Name())
 	}
 
-	var err error
-	pin.ctx, err = app.StartChild(&task.Task{
+	_, err := app.StartChild(&task.Task{
 		Info: task.Info{
 			Label:     label,
 			IdleClose: time.Microsecond,
+			LogFields: log.Fields{amp.LogFieldCell: root.ID.Base32()},
+		},
+		OnStart: func(pinContext task.Context) error {
+			pin.ctx = pinContext // available to Cell.PinInto()
+			return nil
 		},
 		OnRun: func(pinContext task.Context) {
-			err := pin.App.MakeReady(op)
+			span := task.SpanOf(pinContext)
+			span.SetAttrs(log.Fields{
+				amp.SpanAttrPinSync:  pin.Sync.String(),
+				amp.SpanAttrPinAttrs: len(req.PinAttrs),
+			})
+
+			err := pin.timeOp(PhaseMakeReady, func() error {
+				return pin.App.MakeReady(op)
+			})
 			if err == nil {
-				err = cell.PinInto(pin)
+				err = pin.timeOp(PhasePinInto, func() error {
+					return cell.PinInto(pin)
+				})
 			}
 			if err == nil {
-				err = pin.pushState()
+				pin.pinned = true
+				notifyPinned(app, root.ID, +1)
+				updatePresence(app, root.ID, +1)
+				err = pin.timeOp(PhasePushState, pin.pushState)
+			}
+			if err == nil && pin.Sync == amp.StateSync_Maintain {
+				pin.watchLeases()
+				trackLive(app, pin, true)
 			}
 			if err != nil {
+				span.RecordError(err)
 				if err != amp.ErrShuttingDown {
 					pinContext.Log().Warnf("op failed: %v", err)
 				}
-			} else if op.Request().StateSync == amp.StateSync_Maintain {
+			} else if pin.Sync == amp.StateSync_Maintain {
 				<-pinContext.Closing()
 			}
 			op.OnComplete(err)
 		},
 		OnClosing: func() {
+			trackLive(app, pin, false)
+			pin.closePusher()
 			pin.ReleasePin()
 		},
+		OnClosed: func() {
+			if pin.pinned { // OnRun has completed
+				notifyPinned(app, root.ID, -1)
+				updatePresence(app, root.ID, -1)
+			}
+		},
 	})
 	if err != nil {
 		return nil, err
@@ -66,6 +102,79 @@ func PinAndServe[AppT amp.AppInstance](cell Cell[AppT], app AppT, op amp.Request
 	return pin, nil
 }
 
+// pinCounter is implemented by App so that PinAndServe can track the number of live pins per cell.
+type pinCounter interface {
+	addPin(cellID tag.ID, delta int) int
+}
+
+// Notifies the app (if it implements amp.PinObserver) that a cell was pinned (delta > 0) or unpinned (delta < 0).
+// OnFirstPin() and OnLastUnpin() are only called if the app embeds std.App.
+func notifyPinned(app amp.AppInstance, cellID tag.ID, delta int) {
+	observer, ok := app.(amp.PinObserver)
+	if !ok {
+		return
+	}
+
+	count := -1
+	if counter, ok := app.(pinCounter); ok {
+		count = counter.addPin(cellID, delta)
+	}
+
+	if delta > 0 {
+		observer.OnCellPinned(cellID)
+		if count == 1 {
+			observer.OnFirstPin(cellID)
+		}
+	} else {
+		observer.OnCellUnpinned(cellID)
+		if count == 0 {
+			observer.OnLastUnpin(cellID)
+		}
+	}
+}
+
+// Reports a cell being pinned or unpinned by the session's user to the host's presence table.
+func updatePresence(app amp.AppInstance, cellID tag.ID, delta int) {
+	sess := app.Session()
+	if presence := amp.ServicesOf(sess).Presence; presence != nil {
+		login := sess.Login()
+		presence.UpdatePresence(cellID, login.UserID, delta)
+	}
+}
+
+func (app *App[AppT]) addPin(cellID tag.ID, delta int) int {
+	app.pinsMu.Lock()
+	defer app.pinsMu.Unlock()
+
+	if app.pins == nil {
+		app.pins = make(map[tag.ID]int)
+	}
+	count := app.pins[cellID] + delta
+	if count <= 0 {
+		count = 0
+		delete(app.pins, cellID)
+	} else {
+		app.pins[cellID] = count
+	}
+	return count
+}
+
+// OnCellPinned implements amp.PinObserver -- override to be notified each time a cell is pinned.
+func (app *App[AppT]) OnCellPinned(cellID tag.ID) {
+}
+
+// OnCellUnpinned implements amp.PinObserver -- override to be notified each time a pin of a cell closes.
+func (app *App[AppT]) OnCellUnpinned(cellID tag.ID) {
+}
+
+// OnFirstPin implements amp.PinObserver -- override to start watchers for a cell when it is first pinned.
+func (app *App[AppT]) OnFirstPin(cellID tag.ID) {
+}
+
+// OnLastUnpin implements amp.PinObserver -- override to stop watchers for a cell when it is no longer pinned.
+func (app *App[AppT]) OnLastUnpin(cellID tag.ID) {
+}
+
 func (app *App[AppT]) MakeReady(op amp.Requester) error {
 	return nil
 }
@@ -83,6 +192,8 @@ func (pin *Pin[AppT]) ReleasePin() {
 	// override for cleanup
 }
 
+// AddChild adds a child cell to this pin.
+// Any requests waiting for this cell to be created (see PinRequest.WaitForTarget) are then served.
 func (pin *Pin[AppT]) AddChild(sub Cell[AppT]) {
 	child := sub.Root()
 	childID := child.ID
@@ -90,13 +201,24 @@ func (pin *Pin[AppT]) AddChild(sub Cell[AppT]) {
 		childID = tag.Now()
 		child.ID = childID
 	}
+
+	pin.childMu.Lock()
 	pin.children[childID] = sub
+	awaiting := pin.awaiting[childID]
+	delete(pin.awaiting, childID)
+	pin.childMu.Unlock()
+
+	for _, ready := range awaiting {
+		ready <- sub
+	}
 }
 
 func (pin *Pin[AppT]) GetCell(target tag.ID) Cell[AppT] {
 	if target == pin.Cell.Root().ID {
 		return pin.Cell
 	}
+	pin.childMu.RLock()
+	defer pin.childMu.RUnlock()
 	if cell, exists := pin.children[target]; exists {
 		return cell
 	}
@@ -109,52 +231,220 @@ func (pin *Pin[AppT]) Context() task.Context {
 
 func (pin *Pin[AppT]) ServeRequest(op amp.Requester) (amp.Pin, error) {
 	req := op.Request()
-	cell := pin.GetCell(req.TargetID())
+	target, err := req.ResolveTarget(amp.ServicesOf(pin.App.Session()).Aliases)
+	if err != nil {
+		return nil, err
+	}
+	cell := pin.GetCell(target)
 	if cell == nil {
+		if req.WaitForTarget {
+			return pin.awaitChild(target, op)
+		}
 		return nil, amp.ErrCellNotFound
 	}
 	return PinAndServe(cell, pin.App, op)
 }
 
-func (pin *Pin[AppT]) pushState() error {
-	tx := amp.NewTxMsg(true)
+// awaitChild serves a request whose target cell does not yet exist.
+// The client is sent OpStatus_Pending and the request is served once AddChild() is called for the target.
+func (pin *Pin[AppT]) awaitChild(target tag.ID, op amp.Requester) (amp.Pin, error) {
+	ready := make(chan Cell[AppT], 1)
 
-	if pin.Op.Request().StateSync > amp.StateSync_None {
-		pinnedID := pin.Cell.Root().ID
-
-		w := cellWriter{
-			tx:     tx,
-			cellID: pinnedID,
+	pin.childMu.Lock()
+	cell, exists := pin.children[target]
+	if !exists {
+		if pin.awaiting == nil {
+			pin.awaiting = make(map[tag.ID][]chan Cell[AppT])
 		}
+		pin.awaiting[target] = append(pin.awaiting[target], ready)
+	}
+	pin.childMu.Unlock()
 
-		tx.Upsert(amp.MetaNodeID, CellChildren.ID, pinnedID, nil) // export the root cell ID
-		pin.Cell.MarshalAttrs(&w)
-		if w.err != nil {
-			return w.err
-		}
+	if exists {
+		return PinAndServe(cell, pin.App, op)
+	}
+
+	awaiting := &awaitingPin{}
+	var err error
+	awaiting.ctx, err = pin.ctx.StartChild(&task.Task{
+		Info: task.Info{
+			Label:     "pending: " + target.Base32Suffix(),
+			IdleClose: time.Microsecond,
+		},
+		OnRun: func(ctx task.Context) {
+			tx := amp.NewTxMsg(true)
+			tx.Status = amp.OpStatus_Pending
+			if err := op.PushTx(tx); err != nil {
+				op.OnComplete(err)
+				return
+			}
 
-		for childID, child := range pin.children {
-			w.cellID = childID
-			tx.Upsert(pinnedID, CellChildren.ID, childID, nil) // link child to pinned cell
-			child.MarshalAttrs(&w)
-			if w.err != nil {
-				return w.err
+			select {
+			case cell := <-ready:
+				served, err := PinAndServe(cell, pin.App, op)
+				if err != nil {
+					op.OnComplete(err)
+					return
+				}
+				select {
+				case <-served.Context().Done():
+				case <-ctx.Closing():
+					served.Context().Close()
+				}
+			case <-ctx.Closing():
+				op.OnComplete(amp.ErrRequestClosed)
+			}
+		},
+		OnClosing: func() {
+			pin.childMu.Lock()
+			waiters := pin.awaiting[target]
+			for i, wi := range waiters {
+				if wi == ready {
+					pin.awaiting[target] = append(waiters[:i], waiters[i+1:]...)
+					break
+				}
 			}
+			if len(pin.awaiting[target]) == 0 {
+				delete(pin.awaiting, target)
+			}
+			pin.childMu.Unlock()
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return awaiting, nil
+}
+
+// awaitingPin is the amp.Pin returned for a request waiting on its target cell to be created.
+type awaitingPin struct {
+	ctx task.Context
+}
+
+func (pin *awaitingPin) Context() task.Context {
+	return pin.ctx
+}
+
+func (pin *awaitingPin) ServeRequest(op amp.Requester) (amp.Pin, error) {
+	return nil, amp.ErrCellNotFound
+}
+
+// UpdatePin replaces the attrs selected by this pin and, if this pin is maintained, pushes state so newly selected attrs are sent.
+func (pin *Pin[AppT]) UpdatePin(update *amp.PinUpdate) error {
+	attrs := amp.NewAttrMask(update.PinAttrs)
+
+	pin.attrsMu.Lock()
+	pin.attrs = attrs
+	pin.attrsMu.Unlock()
+
+	if pin.Sync != amp.StateSync_Maintain {
+		return nil
+	}
+	return pin.pushState()
+}
+
+func (pin *Pin[AppT]) pushState() error {
+	tx := amp.NewTxMsg(true)
+
+	if pin.Sync > amp.StateSync_None {
+		if err := pin.marshalState(tx); err != nil {
+			tx.ReleaseRef()
+			return err
 		}
 	}
 
 	tx.Status = amp.OpStatus_Synced
-	return pin.Op.PushTx(tx)
+	return pin.pushTx(tx)
+}
+
+// marshalState marshals the pinned cell and its children into tx, using the pin's marshalArena to avoid per-op allocations.
+func (pin *Pin[AppT]) marshalState(tx *amp.TxMsg) error {
+	arena := &pin.arena
+	arena.mu.Lock()
+	defer arena.mu.Unlock()
+
+	// size the tx from the previous push so a large cell (e.g. a media collection) is not regrown op by op
+	tx.DataStore = slices.Grow(tx.DataStore, arena.dataLen)
+	tx.Ops = slices.Grow(tx.Ops, arena.opCount)
+
+	pinnedID := pin.Cell.Root().ID
+	leases := amp.ServicesOf(pin.App.Session()).Leases
+
+	pin.attrsMu.RLock()
+	w := cellWriter{
+		tx:     tx,
+		cellID: pinnedID,
+		attrs:  pin.attrs,
+		text:   &arena.text,
+	}
+	pin.attrsMu.RUnlock()
+
+	tx.Upsert(amp.MetaNodeID, CellChildren.ID, pinnedID, nil) // export the root cell ID
+	pin.Cell.MarshalAttrs(&w)
+	w.putLease(leases)
+	if w.err != nil {
+		return w.err
+	}
+
+	pin.childMu.Lock()
+	dropped := pin.dropped
+	pin.dropped = nil
+	pin.childMu.Unlock()
+
+	pin.childMu.RLock()
+	for _, childID := range dropped {
+		if _, readded := pin.children[childID]; !readded {
+			op := amp.TxOp{}
+			op.OpCode = amp.TxOpCode_DeleteElement
+			op.CellID = pinnedID
+			op.AttrID = CellChildren.ID
+			op.ItemID = childID
+			op.EditID = tag.Genesis(tx.GenesisID())
+			tx.MarshalOp(&op, nil) // unlink child from pinned cell
+		}
+	}
+	var budget marshalBudget
+	if len(pin.children) >= 2*MinChildrenPerWorker {
+		budget = marshalBudgetOf(pin.App.Session())
+	}
+	children := make([]tag.ID, 0, len(pin.children))
+	for childID := range pin.children {
+		children = append(children, childID)
+	}
+	pin.marshalChildren(&w, children, leases, budget)
+	pin.childMu.RUnlock()
+	if w.err != nil {
+		return w.err
+	}
+
+	arena.dataLen = len(tx.DataStore)
+	arena.opCount = len(tx.Ops)
+	return nil
+}
+
+// marshalArena holds state a Pin reuses across pushState() calls.
+type marshalArena struct {
+	mu      sync.Mutex // serializes pushState() marshalling
+	text    amp.Tag    // scratch value for cellWriter.PutText()
+	dataLen int        // DataStore size of the previous push
+	opCount int        // op count of the previous push
 }
 
 type cellWriter struct {
-	cellID tag.ID     // cache for Cell.Root().ID
-	tx     *amp.TxMsg // in-progress transaction
+	cellID tag.ID       // cache for Cell.Root().ID
+	tx     *amp.TxMsg   // in-progress transaction
+	attrs  amp.AttrMask // attrs selected by the client
+	text   *amp.Tag     // if set, reused by PutText() rather than allocating a Tag per op
 	err    error
 }
 
+// A cell property is written if either the properties attr or the property itself is selected.
+func (w *cellWriter) selectsProperty(propertyID tag.ID) bool {
+	return w.attrs.Selects(CellProperties.ID) || w.attrs.Selects(propertyID)
+}
+
 func (w *cellWriter) PutText(propertyID tag.ID, value string) {
-	if w.err != nil {
+	if w.err != nil || !w.selectsProperty(propertyID) {
 		return
 	}
 	op := amp.TxOp{}
@@ -162,16 +452,20 @@ func (w *cellWriter) PutText(propertyID tag.ID, value string) {
 	op.CellID = w.cellID
 	op.AttrID = CellProperties.ID
 	op.ItemID = propertyID
-	err := w.tx.MarshalOp(&op, &amp.Tag{
+	text := w.text
+	if text == nil {
+		text = &amp.Tag{}
+	}
+	*text = amp.Tag{
 		Text: value,
-	})
-	if err != nil {
+	}
+	if err := w.tx.MarshalOp(&op, text); err != nil {
 		w.err = err
 	}
 }
 
 func (w *cellWriter) PutItem(propertyID tag.ID, value tag.Value) {
-	if w.err != nil {
+	if w.err != nil || !w.selectsProperty(propertyID) {
 		return
 	}
 	op := amp.TxOp{}
@@ -185,14 +479,46 @@ func (w *cellWriter) PutItem(propertyID tag.ID, value tag.Value) {
 }
 
 func (w *cellWriter) Upsert(op *amp.TxOp, val tag.Value) {
-	if w.err != nil {
+	if w.err != nil || !w.attrs.Selects(op.AttrID) {
+		return
+	}
+	if err := w.tx.MarshalOp(op, val); err != nil {
+		w.err = err
+	}
+}
+
+func (w *cellWriter) UpsertDeferred(op *amp.TxOp, val tag.Value) {
+	if w.err != nil || !w.attrs.Selects(op.AttrID) {
 		return
 	}
+	if !w.attrs.Includes(op.AttrID) {
+		deferred := *op // leave the caller's op intact for reuse
+		deferred.OpCode = amp.TxOpCode_DeferElement
+		op, val = &deferred, nil
+	}
 	if err := w.tx.MarshalOp(op, val); err != nil {
 		w.err = err
 	}
 }
 
+func (w *cellWriter) PutItemDeferred(propertyID tag.ID, value tag.Value) {
+	if w.err != nil || !w.selectsProperty(propertyID) {
+		return
+	}
+	op := amp.TxOp{}
+	op.OpCode = amp.TxOpCode_UpsertElement
+	op.CellID = w.cellID
+	op.AttrID = CellProperties.ID
+	op.ItemID = propertyID
+	if !w.attrs.Includes(propertyID) {
+		op.OpCode = amp.TxOpCode_DeferElement
+		value = nil
+	}
+	if err := w.tx.MarshalOp(&op, value); err != nil {
+		w.err = err
+	}
+}
+
 /*
 func (tx *TxMsg) PutMultiple(propertyIDs []tag.ID, serialize tag.Value) error {
 	op := PropertyOp{}
@@ -0,0 +1,34 @@
+package std
+
+import (
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/textindex"
+)
+
+// TextQueryParam is the pin URL query parameter holding a text query, e.g. "amp://notes/?q=grocery+list"
+const TextQueryParam = "q"
+
+// TextQuery returns the text query of the given request (or "" if none was given).
+func TextQuery(req *amp.Request) string {
+	if req.Values == nil {
+		return ""
+	}
+	return req.Values.Get(TextQueryParam)
+}
+
+// SearchIndex queries the given text index on behalf of an app, returning hits suitable for amp.Searcher.
+// If query.Attrs is given, only fields put under those attr IDs are searched, so an app should key each field by the attr it indexes.
+// Each hit's Label is left for the caller to fill in.
+func SearchIndex(idx textindex.Index, appID tag.ID, query amp.SearchQuery) []amp.SearchHit {
+	found := idx.Search(query.Text, query.Limit, query.Attrs...)
+	hits := make([]amp.SearchHit, len(found))
+	for i, fi := range found {
+		hits[i] = amp.SearchHit{
+			CellID: fi.DocID,
+			AppID:  appID,
+			Score:  fi.Score,
+		}
+	}
+	return hits
+}
@@ -0,0 +1,107 @@
+package std
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/timeseries"
+)
+
+// Pin URL query parameters for time range queries, e.g. "amp://telemetry/cpu?from=-24h&res=5m"
+//
+// Times are RFC 3339, unix seconds, or a duration relative to now (e.g. "-90m").
+// The resolution is a duration (e.g. "1s", "5m").
+const (
+	TimeFromParam = "from"
+	TimeToParam   = "to"
+	TimeResParam  = "res"
+)
+
+// TimeRange is a time range query parsed from pin params.
+type TimeRange struct {
+	From       time.Time
+	To         time.Time
+	Resolution time.Duration
+}
+
+// Times outside this range are rejected, keeping time series arithmetic (in unix nanoseconds) from overflowing.
+var (
+	minQueryTime = time.Date(1700, 1, 1, 0, 0, 0, 0, time.UTC)
+	maxQueryTime = time.Date(2200, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// ParseTimeRange returns the time range of the given request, where absent params default to the given range.
+func ParseTimeRange(req *amp.Request, defaults TimeRange) (TimeRange, error) {
+	tr := defaults
+	if req.Values == nil {
+		return tr, nil
+	}
+
+	now := time.Now()
+	var err error
+	if str := req.Values.Get(TimeFromParam); str != "" {
+		if tr.From, err = parseTime(str, now); err != nil {
+			return tr, amp.ErrCode_BadRequest.Errorf("bad %q param: %v", TimeFromParam, err)
+		}
+	}
+	if str := req.Values.Get(TimeToParam); str != "" {
+		if tr.To, err = parseTime(str, now); err != nil {
+			return tr, amp.ErrCode_BadRequest.Errorf("bad %q param: %v", TimeToParam, err)
+		}
+	}
+	if str := req.Values.Get(TimeResParam); str != "" {
+		if tr.Resolution, err = time.ParseDuration(str); err != nil || tr.Resolution <= 0 {
+			return tr, amp.ErrCode_BadRequest.Errorf("bad %q param", TimeResParam)
+		}
+	}
+	if tr.To.Before(tr.From) {
+		return tr, amp.ErrCode_BadRequest.Error("time range ends before it starts")
+	}
+	return tr, nil
+}
+
+func parseTime(str string, now time.Time) (time.Time, error) {
+	t, err := parseTimeParam(str, now)
+	if err == nil && (t.Before(minQueryTime) || t.After(maxQueryTime)) {
+		err = errors.New("time out of range")
+	}
+	return t, err
+}
+
+func parseTimeParam(str string, now time.Time) (time.Time, error) {
+	if strings.HasPrefix(str, "-") || strings.HasPrefix(str, "+") {
+		if d, err := time.ParseDuration(str); err == nil {
+			return now.Add(d), nil
+		}
+	}
+	if secs, err := strconv.ParseInt(str, 10, 64); err == nil {
+		return time.Unix(secs, 0), nil
+	}
+	return time.Parse(time.RFC3339, str)
+}
+
+// QueryTimeSeries queries the given series over the given range, returning a TimeSeries suitable for CellWriter.PutItem().
+func QueryTimeSeries(series timeseries.Series, tr TimeRange) *TimeSeries {
+	buckets, step := series.Query(tr.From, tr.To, tr.Resolution)
+
+	ts := &TimeSeries{
+		Step:   int64(step),
+		Counts: make([]uint32, len(buckets)),
+		Means:  make([]float64, len(buckets)),
+		Mins:   make([]float64, len(buckets)),
+		Maxs:   make([]float64, len(buckets)),
+	}
+	if len(buckets) > 0 {
+		ts.Start = buckets[0].Start
+	}
+	for i, bi := range buckets {
+		ts.Counts[i] = bi.Count
+		ts.Means[i] = bi.Mean()
+		ts.Mins[i] = bi.Min
+		ts.Maxs[i] = bi.Max
+	}
+	return ts
+}
@@ -0,0 +1,139 @@
+package std
+
+import (
+	"fmt"
+	"runtime/metrics"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// AppUsage is the approximate resource usage of an app's instances, summed across sessions, so a misbehaving app can be identified on a shared host.
+//
+// Go does not account CPU or memory per goroutine, so usage is measured around the pin phases an app runs (see PhasePinInto etc):
+// Busy is their elapsed time and AllocBytes is the growth of the process-wide heap allocation counter during them.
+// Since other goroutines allocate concurrently, AllocBytes overstates an app's share on a busy host, but ranks apps usefully.
+type AppUsage struct {
+	App         string        // the app's UID (see amp.LogFieldApp), or its context label
+	Phases      int64         // pin phases run
+	Busy        time.Duration // time spent in pin phases
+	AllocBytes  uint64        // heap bytes allocated during pin phases (approximate)
+	TxsPushed   int64         // txs pushed to clients
+	OpsPushed   int64         // ops within TxsPushed
+	BytesPushed int64         // op value bytes within TxsPushed
+	Tasks       int           // live tasks (each typically a goroutine) within the app's contexts; see ReadAppUsage
+}
+
+type appUsage struct {
+	phases      atomic.Int64
+	busy        atomic.Int64 // time.Duration
+	allocBytes  atomic.Uint64
+	txsPushed   atomic.Int64
+	opsPushed   atomic.Int64
+	bytesPushed atomic.Int64
+}
+
+var gAppUsage sync.Map // app name => *appUsage
+
+// usage returns the usage accumulator of the pin's app.
+func (pin *Pin[AppT]) usage() *appUsage {
+	name := appName(pin.App)
+	if u, exists := gAppUsage.Load(name); exists {
+		return u.(*appUsage)
+	}
+	u, _ := gAppUsage.LoadOrStore(name, &appUsage{})
+	return u.(*appUsage)
+}
+
+// heapAllocs returns the process-wide count of heap bytes allocated.
+func heapAllocs() uint64 {
+	sample := [1]metrics.Sample{{Name: "/gc/heap/allocs:bytes"}}
+	metrics.Read(sample[:])
+	if sample[0].Value.Kind() != metrics.KindUint64 {
+		return 0
+	}
+	return sample[0].Value.Uint64()
+}
+
+// measurePhase runs fn, adding its elapsed time and allocations to the usage of the pin's app.
+func (pin *Pin[AppT]) measurePhase(fn func() error) error {
+	allocs := heapAllocs()
+	start := time.Now()
+	err := fn()
+	elapsed := time.Since(start)
+	allocated := heapAllocs() - allocs
+
+	u := pin.usage()
+	u.phases.Add(1)
+	u.busy.Add(int64(elapsed))
+	u.allocBytes.Add(allocated)
+	return err
+}
+
+func (pin *Pin[AppT]) countPush(ops, bytes int64) {
+	u := pin.usage()
+	u.txsPushed.Add(1)
+	u.opsPushed.Add(ops)
+	u.bytesPushed.Add(bytes)
+}
+
+// ReadAppUsage returns the usage of each app since process start, ordered by app.
+// If root is given (typically the host), each app's live tasks within it are also counted.
+func ReadAppUsage(root task.Context) []AppUsage {
+	var usages []AppUsage
+	byApp := make(map[string]int)
+	gAppUsage.Range(func(key, val any) bool {
+		u := val.(*appUsage)
+		byApp[key.(string)] = len(usages)
+		usages = append(usages, AppUsage{
+			App:         key.(string),
+			Phases:      u.phases.Load(),
+			Busy:        time.Duration(u.busy.Load()),
+			AllocBytes:  u.allocBytes.Load(),
+			TxsPushed:   u.txsPushed.Load(),
+			OpsPushed:   u.opsPushed.Load(),
+			BytesPushed: u.bytesPushed.Load(),
+		})
+		return true
+	})
+
+	if root != nil {
+		for app, tasks := range countAppTasks(task.Inspect(root)) {
+			i, exists := byApp[app]
+			if !exists {
+				i = len(usages)
+				byApp[app] = i
+				usages = append(usages, AppUsage{App: app})
+			}
+			usages[i].Tasks = tasks
+		}
+	}
+
+	sort.Slice(usages, func(i, j int) bool {
+		return usages[i].App < usages[j].App
+	})
+	return usages
+}
+
+// countAppTasks counts the tasks within each app's contexts, attributing a task to its nearest ancestor (or self) having an amp.LogFieldApp.
+func countAppTasks(root *task.TaskState) map[string]int {
+	counts := make(map[string]int)
+	var visit func(ts *task.TaskState, app string)
+	visit = func(ts *task.TaskState, app string) {
+		if uid, ok := ts.LogFields[amp.LogFieldApp]; ok {
+			app = fmt.Sprint(uid)
+		}
+		if app != "" {
+			counts[app]++
+		}
+		for _, child := range ts.Children {
+			visit(child, app)
+		}
+	}
+	visit(root, "")
+	return counts
+}
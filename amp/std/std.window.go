@@ -0,0 +1,30 @@
+package std
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// Pin URL query parameters selecting the window of a large collection to present as child cells -- see CollectionWindow.
+const (
+	WindowOffsetParam = "offset"
+	WindowLimitParam  = "limit"
+)
+
+// ParseWindow returns the window selected by the given pin URL params, where limit defaults to defaultLimit and is capped at maxLimit.
+func ParseWindow(values url.Values, defaultLimit, maxLimit int) (offset, limit int) {
+	limit = defaultLimit
+	if values == nil {
+		return 0, limit
+	}
+	if n, err := strconv.Atoi(values.Get(WindowOffsetParam)); err == nil && n > 0 {
+		offset = n
+	}
+	if n, err := strconv.Atoi(values.Get(WindowLimitParam)); err == nil && n > 0 {
+		limit = n
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+	return offset, limit
+}
@@ -0,0 +1,791 @@
+package std
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/amp/store"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/blob"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/media"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/textindex"
+)
+
+func TestUpsertDeferred(t *testing.T) {
+	videoAttr := tag.ID{0, 0, 1001}
+	otherAttr := tag.ID{0, 0, 1002}
+	video := &amp.Tag{URL: "clip.mp4"}
+
+	write := func(attrs amp.AttrMask) (*amp.TxMsg, amp.TxOp) {
+		tx := amp.NewTxMsg(true)
+		w := cellWriter{
+			tx:     tx,
+			cellID: tag.ID{0, 0, 7},
+			attrs:  attrs,
+		}
+		op := amp.TxOp{}
+		op.OpCode = amp.TxOpCode_UpsertElement
+		op.CellID = w.cellID
+		op.AttrID = videoAttr
+		w.UpsertDeferred(&op, video)
+		if w.err != nil {
+			t.Fatal(w.err)
+		}
+		return tx, op
+	}
+
+	// not explicitly pinned: the element is advertised without its value
+	tx, op := write(nil)
+	if op.OpCode != amp.TxOpCode_UpsertElement {
+		t.Fatal("UpsertDeferred modified the caller's op")
+	}
+	if len(tx.Ops) != 1 || tx.Ops[0].OpCode != amp.TxOpCode_DeferElement || tx.Ops[0].DataLen != 0 {
+		t.Fatalf("expected a DeferElement op without a value, got %+v", tx.Ops)
+	}
+
+	// pinned: the value is sent
+	tx, _ = write(amp.AttrMask{videoAttr: {}})
+	var got amp.Tag
+	if len(tx.Ops) != 1 || tx.Ops[0].OpCode != amp.TxOpCode_UpsertElement {
+		t.Fatalf("expected an UpsertElement op, got %+v", tx.Ops)
+	}
+	if err := tx.UnmarshalOpValue(0, &got); err != nil || got.URL != video.URL {
+		t.Fatalf("expected pinned value to be sent, got %v, %v", got.URL, err)
+	}
+
+	// not selected at all: nothing is written
+	if tx, _ = write(amp.AttrMask{otherAttr: {}}); len(tx.Ops) != 0 {
+		t.Fatalf("expected no ops for an unselected attr, got %d", len(tx.Ops))
+	}
+}
+
+// testSession implements only what serving a pin uses.
+type testSession struct {
+	amp.Session
+}
+
+func (sess *testSession) Login() amp.Login { return amp.Login{} }
+
+// testContext is an amp.AppContext running within a task tree.
+type testContext struct {
+	task.Context
+	media.Publisher
+	sess testSession
+}
+
+func (ctx *testContext) Session() amp.Session                          { return &ctx.sess }
+func (ctx *testContext) LocalDataPath() string                         { return "" }
+func (ctx *testContext) GetAppAttr(attrID tag.ID, dst tag.Value) error { return amp.ErrCellNotFound }
+func (ctx *testContext) PutAppAttr(attrID tag.ID, src tag.Value) error { return nil }
+func (ctx *testContext) CellStore() amp.CellStore                      { return nil }
+
+// testApp is an app instance that records its first and last pins of each cell.
+type testApp struct {
+	App[*testApp]
+
+	mu    sync.Mutex
+	first []tag.ID
+	last  []tag.ID
+}
+
+func newTestApp(t *testing.T) *testApp {
+	ctx, err := task.Start(&task.Task{Info: task.Info{Label: "testapp"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ctx.Close() })
+	app := &testApp{}
+	app.AppContext = &testContext{Context: ctx}
+	app.Instance = app
+	return app
+}
+
+func (app *testApp) ServeRequest(op amp.Requester) (amp.Pin, error) {
+	return nil, amp.ErrCellNotFound
+}
+
+func (app *testApp) OnFirstPin(cellID tag.ID) {
+	app.mu.Lock()
+	app.first = append(app.first, cellID)
+	app.mu.Unlock()
+}
+
+func (app *testApp) OnLastUnpin(cellID tag.ID) {
+	app.mu.Lock()
+	app.last = append(app.last, cellID)
+	app.mu.Unlock()
+}
+
+func (app *testApp) counts() (first, last int) {
+	app.mu.Lock()
+	defer app.mu.Unlock()
+	return len(app.first), len(app.last)
+}
+
+// testCell is a cell with no attrs.
+type testCell struct {
+	CellNode[*testApp]
+}
+
+func (cell *testCell) PinInto(pin *Pin[*testApp]) error {
+	return nil
+}
+
+func (cell *testCell) MarshalAttrs(w CellWriter) {
+}
+
+// testRequester records the txs pushed to it and its completion.
+type testRequester struct {
+	req  amp.Request
+	txs  chan *amp.TxMsg
+	done chan error
+}
+
+func newTestRequester(sync amp.StateSync) *testRequester {
+	op := &testRequester{
+		txs:  make(chan *amp.TxMsg, 16),
+		done: make(chan error, 1),
+	}
+	op.req.StateSync = sync
+	return op
+}
+
+func (op *testRequester) Request() *amp.Request {
+	return &op.req
+}
+
+func (op *testRequester) PushTx(tx *amp.TxMsg) error {
+	tx.AddRef()
+	op.txs <- tx
+	return nil
+}
+
+func (op *testRequester) OnComplete(err error) {
+	op.done <- err
+}
+
+// nextTx returns the next tx pushed to op, failing if none arrives.
+func (op *testRequester) nextTx(t *testing.T) *amp.TxMsg {
+	t.Helper()
+	select {
+	case tx := <-op.txs:
+		return tx
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for a tx")
+		return nil
+	}
+}
+
+func (op *testRequester) awaitDone(t *testing.T) error {
+	t.Helper()
+	select {
+	case err := <-op.done:
+		return err
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the op to complete")
+		return nil
+	}
+}
+
+// newTestPin returns a pin of the given cell as PinAndServe would construct it, without serving it.
+func newTestPin(app *testApp, cell Cell[*testApp]) *Pin[*testApp] {
+	return &Pin[*testApp]{
+		Op:       newTestRequester(amp.StateSync_Maintain),
+		App:      app,
+		Cell:     cell,
+		children: make(map[tag.ID]Cell[*testApp]),
+		ctx:      app,
+	}
+}
+
+func TestAwaitChild(t *testing.T) {
+	app := newTestApp(t)
+	parent := newTestPin(app, &testCell{CellNode[*testApp]{ID: tag.Now()}})
+
+	// a request waiting on a child is served once the child is added
+	childID := tag.Now()
+	op := newTestRequester(amp.StateSync_CloseOnSync)
+	if _, err := parent.awaitChild(childID, op); err != nil {
+		t.Fatal(err)
+	}
+	if tx := op.nextTx(t); tx.Status != amp.OpStatus_Pending {
+		t.Fatalf("expected OpStatus_Pending, got %v", tx.Status)
+	}
+	parent.AddChild(&testCell{CellNode[*testApp]{ID: childID}})
+	if tx := op.nextTx(t); tx.Status != amp.OpStatus_Synced {
+		t.Fatalf("expected OpStatus_Synced, got %v", tx.Status)
+	}
+	if err := op.awaitDone(t); err != nil {
+		t.Fatal(err)
+	}
+
+	// a waiting request that closes no longer waits on its target
+	op = newTestRequester(amp.StateSync_CloseOnSync)
+	waiting, err := parent.awaitChild(tag.Now(), op)
+	if err != nil {
+		t.Fatal(err)
+	}
+	op.nextTx(t)
+	waiting.Context().Close()
+	<-waiting.Context().Done()
+	if err := op.awaitDone(t); err != amp.ErrRequestClosed {
+		t.Fatalf("expected ErrRequestClosed, got %v", err)
+	}
+	parent.childMu.RLock()
+	awaiting := len(parent.awaiting)
+	parent.childMu.RUnlock()
+	if awaiting != 0 {
+		t.Fatalf("expected no waiters once the waiting pin closed, got %d", awaiting)
+	}
+}
+
+func TestPinCounting(t *testing.T) {
+	for _, closeFirstPinFirst := range []bool{true, false} {
+		app := newTestApp(t)
+		cell := &testCell{CellNode[*testApp]{ID: tag.Now()}}
+
+		var pins [2]amp.Pin
+		for i := range pins {
+			op := newTestRequester(amp.StateSync_Maintain)
+			pin, err := app.PinAndServe(cell, op)
+			if err != nil {
+				t.Fatal(err)
+			}
+			op.nextTx(t) // synced
+			pins[i] = pin
+		}
+		if first, last := app.counts(); first != 1 || last != 0 {
+			t.Fatalf("expected 1 first pin and no last unpin, got %d, %d", first, last)
+		}
+
+		if !closeFirstPinFirst {
+			pins[0], pins[1] = pins[1], pins[0]
+		}
+		pins[0].Context().Close()
+		<-pins[0].Context().Done()
+		if first, last := app.counts(); first != 1 || last != 0 {
+			t.Fatalf("closing one of two pins: got %d first pins, %d last unpins", first, last)
+		}
+		pins[1].Context().Close()
+		<-pins[1].Context().Done()
+		if first, last := app.counts(); first != 1 || last != 1 || app.last[0] != cell.ID {
+			t.Fatalf("closing both pins: got %d first pins, %d last unpins", first, last)
+		}
+	}
+}
+
+func TestComputedCell(t *testing.T) {
+	var (
+		input    Signal
+		computes int
+	)
+	cell := &ComputedCell[amp.AppInstance]{
+		Inputs: []*Signal{&input},
+		Compute: func() ([]Cell[amp.AppInstance], error) {
+			computes++
+			return []Cell[amp.AppInstance]{
+				&ComputedCell[amp.AppInstance]{},
+			}, nil
+		},
+	}
+
+	if _, gen, _ := cell.refresh(); gen != 1 || computes != 1 {
+		t.Fatal("first refresh should compute")
+	}
+	if _, gen, _ := cell.refresh(); gen != 1 || computes != 1 {
+		t.Fatal("refresh should not recompute when inputs are unchanged")
+	}
+
+	notified := 0
+	unsub := input.subscribe(func() { notified++ })
+	input.Notify()
+	unsub()
+	input.Notify()
+	if notified != 1 {
+		t.Fatalf("expected 1 notification, got %d", notified)
+	}
+
+	if children, gen, _ := cell.refresh(); gen != 2 || computes != 2 || len(children) != 1 {
+		t.Fatal("refresh should recompute after an input changes")
+	}
+}
+
+func TestComputedCellPins(t *testing.T) {
+	app := newTestApp(t)
+
+	var (
+		input Signal
+		mu    sync.Mutex
+		count = 1
+	)
+	cell := &ComputedCell[*testApp]{
+		CellNode: CellNode[*testApp]{ID: tag.Now()},
+		Inputs:   []*Signal{&input},
+		Compute: func() ([]Cell[*testApp], error) {
+			mu.Lock()
+			defer mu.Unlock()
+			var children []Cell[*testApp]
+			for i := 0; i < count; i++ {
+				children = append(children, &testCell{CellNode[*testApp]{ID: tag.ID{0, 0, uint64(i + 1)}}})
+			}
+			return children, nil
+		},
+	}
+
+	// each maintained pin is pushed the recomputed state, whichever pin computed it
+	ops := []*testRequester{newTestRequester(amp.StateSync_Maintain), newTestRequester(amp.StateSync_Maintain)}
+	for _, op := range ops {
+		if _, err := app.PinAndServe(cell, op); err != nil {
+			t.Fatal(err)
+		}
+		op.nextTx(t)
+	}
+	mu.Lock()
+	count = 2
+	mu.Unlock()
+	input.Notify()
+
+	for i, op := range ops {
+		children := 0
+		for _, txOp := range op.nextTx(t).Ops {
+			if txOp.CellID == cell.ID && txOp.AttrID == CellChildren.ID && txOp.OpCode == amp.TxOpCode_UpsertElement {
+				children++
+			}
+		}
+		if children != 2 {
+			t.Fatalf("pin %d: expected 2 children pushed, got %d", i, children)
+		}
+	}
+}
+
+func TestComputedCellDropsChildren(t *testing.T) {
+	app := newTestApp(t)
+	childIDs := []tag.ID{tag.Now(), tag.Now(), tag.Now()}
+
+	var (
+		input Signal
+		mu    sync.Mutex
+		keep  = len(childIDs)
+	)
+	cell := &ComputedCell[*testApp]{
+		CellNode: CellNode[*testApp]{ID: tag.Now()},
+		Inputs:   []*Signal{&input},
+		Compute: func() ([]Cell[*testApp], error) {
+			mu.Lock()
+			defer mu.Unlock()
+			var children []Cell[*testApp]
+			for _, childID := range childIDs[:keep] {
+				children = append(children, &testCell{CellNode[*testApp]{ID: childID}})
+			}
+			return children, nil
+		},
+	}
+
+	linksOf := func(tx *amp.TxMsg) (upserts, deletes []tag.ID) {
+		for _, op := range tx.Ops {
+			if op.CellID != cell.ID || op.AttrID != CellChildren.ID {
+				continue
+			}
+			switch op.OpCode {
+			case amp.TxOpCode_UpsertElement:
+				upserts = append(upserts, op.ItemID)
+			case amp.TxOpCode_DeleteElement:
+				deletes = append(deletes, op.ItemID)
+			}
+		}
+		return
+	}
+
+	op := newTestRequester(amp.StateSync_Maintain)
+	if _, err := app.PinAndServe(cell, op); err != nil {
+		t.Fatal(err)
+	}
+	if upserts, deletes := linksOf(op.nextTx(t)); len(upserts) != 3 || len(deletes) != 0 {
+		t.Fatalf("expected 3 children, got %d upserts, %d deletes", len(upserts), len(deletes))
+	}
+
+	// shrinking the child set deletes the dropped children from the client
+	mu.Lock()
+	keep = 1
+	mu.Unlock()
+	input.Notify()
+
+	upserts, deletes := linksOf(op.nextTx(t))
+	if len(upserts) != 1 || upserts[0] != childIDs[0] {
+		t.Fatalf("expected the remaining child only, got %v", upserts)
+	}
+	slices.SortFunc(deletes, tag.ID.CompareTo)
+	dropped := slices.Clone(childIDs[1:])
+	slices.SortFunc(dropped, tag.ID.CompareTo)
+	if !slices.Equal(deletes, dropped) {
+		t.Fatalf("expected dropped children to be deleted, got %v", deletes)
+	}
+}
+
+func TestSearchIndex(t *testing.T) {
+	idx := textindex.NewIndex()
+	titleID, notesID := amp.AttrSpec.With("title.Tag").ID, amp.AttrSpec.With("notes.Tag").ID
+	photo, memo := tag.Now(), tag.Now()
+	idx.Put(photo, titleID, "harbor at dawn")
+	idx.Put(memo, notesID, "meet at the harbor")
+
+	appID := tag.Now()
+	if hits := SearchIndex(idx, appID, amp.SearchQuery{Text: "harbor"}); len(hits) != 2 {
+		t.Fatalf("expected both cells, got %v", hits)
+	}
+	hits := SearchIndex(idx, appID, amp.SearchQuery{Text: "harbor", Attrs: []tag.ID{titleID}})
+	if len(hits) != 1 || hits[0].CellID != photo || hits[0].AppID != appID {
+		t.Fatalf("expected only the titled cell, got %v", hits)
+	}
+}
+
+func TestParseTimeRange(t *testing.T) {
+	parse := func(query string) (TimeRange, error) {
+		values, _ := url.ParseQuery(query)
+		return ParseTimeRange(&amp.Request{Values: values}, TimeRange{})
+	}
+	if tr, err := parse("from=-1h&to=%2B1h&res=1s"); err != nil || tr.To.Sub(tr.From) != 2*time.Hour || tr.Resolution != time.Second {
+		t.Fatalf("unexpected range: %+v, %v", tr, err)
+	}
+	for _, query := range []string{"to=%2B2000000h", "from=-9000000h", "from=99999999999999"} {
+		if _, err := parse(query); err == nil {
+			t.Fatalf("expected %q to be rejected", query)
+		}
+	}
+}
+
+func TestExtractMediaInfo(t *testing.T) {
+	root, _ := task.Start(&task.Task{
+		Info: task.Info{Label: "root"},
+	})
+	defer root.Close()
+
+	// a WAV header declaring 1.5 seconds of 16 kB/s audio
+	wav := make([]byte, 44)
+	copy(wav, "RIFF")
+	copy(wav[8:], "WAVEfmt ")
+	binary.LittleEndian.PutUint32(wav[16:], 16)
+	binary.LittleEndian.PutUint32(wav[28:], 16000)
+	copy(wav[36:], "data")
+	binary.LittleEndian.PutUint32(wav[40:], 24000)
+
+	store, _ := blob.NewDirStore(t.TempDir())
+	store.Put(root, "clips/tone.bin", bytes.NewReader(wav), blob.Info{})
+	asset := media.NewBlobAsset(store, "clips/tone.bin", "")
+	if err := asset.OnStart(root); err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := ExtractMediaInfo(asset)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.ContentType != "audio/wave" || info.DurationMs != 1500 || info.ByteSize != 44 {
+		t.Fatalf("unexpected MediaInfo: %+v", info)
+	}
+}
+
+func TestPrepareAsset(t *testing.T) {
+	root, _ := task.Start(&task.Task{
+		Info: task.Info{Label: "root"},
+	})
+	defer root.Close()
+
+	store, _ := blob.NewDirStore(t.TempDir())
+	store.Put(root, "icons/star.svg", bytes.NewReader([]byte("<svg/>")), blob.Info{})
+	asset := media.NewBlobAsset(store, "icons/star.svg", "image/svg+xml")
+	if err := asset.OnStart(root); err != nil {
+		t.Fatal(err)
+	}
+
+	// larger than the given cutoff
+	if inline, err := ReadInlineAsset(asset, 4); err != nil || inline != nil {
+		t.Fatalf("expected asset not inlined: %v", err)
+	}
+
+	ref, err := PrepareAsset(nil, asset, AssetOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ref.Inline == nil || string(ref.Inline.Content) != "<svg/>" || ref.Inline.ContentType != "image/svg+xml" {
+		t.Fatalf("expected inline asset: %+v", ref)
+	}
+
+	w := cellWriter{
+		cellID: tag.Now(),
+		tx:     amp.NewTxMsg(true),
+	}
+	ref.PutAs(&w, CellGlyphs)
+	if w.err != nil || len(w.tx.Ops) != 2 {
+		t.Fatalf("expected glyph and inline asset ops: %v", w.err)
+	}
+
+	var glyph amp.Tag
+	var inline InlineAsset
+	w.tx.UnmarshalOpValue(0, &glyph)
+	w.tx.UnmarshalOpValue(1, &inline)
+	inlineID := InlineAssetID(CellGlyphs)
+	if glyph.URL != InlineAssetURL+inlineID.Base32() || w.tx.Ops[1].ItemID != inlineID || !bytes.Equal(inline.Content, ref.Inline.Content) {
+		t.Fatalf("unexpected ops: %+v, %+v", glyph, inline)
+	}
+}
+
+func TestUserProfileMerge(t *testing.T) {
+	profile := &UserProfile{
+		DisplayName: "Ada",
+		Locale:      "en-GB",
+		Preferences: map[string]string{"ui.theme": "dark", "ui.density": "compact"},
+	}
+
+	// round trip as the "user:" app stores it
+	buf, err := profile.MarshalToStore(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stored := &UserProfile{}
+	if err = stored.Unmarshal(buf); err != nil {
+		t.Fatal(err)
+	}
+
+	stored.Merge(&UserProfile{
+		Locale:      "fr-FR",
+		Preferences: map[string]string{"ui.theme": "", "com.example.player.autoplay": "off"},
+	})
+	if stored.DisplayName != "Ada" || stored.Locale != "fr-FR" {
+		t.Fatalf("unexpected profile after merge: %v", stored)
+	}
+	if _, has := stored.Preferences["ui.theme"]; has || stored.Preferences["ui.density"] != "compact" || stored.Preferences["com.example.player.autoplay"] != "off" {
+		t.Fatalf("unexpected preferences after merge: %v", stored.Preferences)
+	}
+}
+
+// slowApp implements only what slow op detection uses.
+type slowApp struct {
+	amp.AppInstance
+	info task.Info
+}
+
+func (app *slowApp) Info() task.Info {
+	return app.info
+}
+
+type slowRequester struct {
+	amp.Requester
+	req amp.Request
+}
+
+func (op *slowRequester) Request() *amp.Request {
+	return &op.req
+}
+
+func blockInPinInto(release chan struct{}) error {
+	<-release
+	return nil
+}
+
+func TestSlowOps(t *testing.T) {
+	var reported []SlowOp
+	SetSlowOpPolicy(SlowOpPolicy{
+		Threshold: 20 * time.Millisecond,
+		OnSlowOp: func(op SlowOp) {
+			reported = append(reported, op)
+		},
+	})
+	defer SetSlowOpPolicy(SlowOpPolicy{Threshold: 2 * time.Second})
+
+	cellID := tag.ID{0, 0, 7}
+	pin := &Pin[amp.AppInstance]{
+		App:  &slowApp{info: task.Info{Label: "slowapp: 1"}},
+		Op:   &slowRequester{req: amp.Request{PinRequest: amp.PinRequest{PinTarget: &amp.Tag{URL: "slow:"}}}},
+		Cell: &ComputedCell[amp.AppInstance]{CellNode: CellNode[amp.AppInstance]{ID: cellID}},
+	}
+
+	// a fast op is not reported
+	pin.timeOp(PhasePinInto, func() error { return nil })
+	if len(reported) != 0 {
+		t.Fatal("fast op reported")
+	}
+
+	// a slow op is reported with a stack sample taken while it was blocked
+	release := make(chan struct{})
+	time.AfterFunc(60*time.Millisecond, func() { close(release) })
+	pin.timeOp(PhasePinInto, func() error {
+		return blockInPinInto(release)
+	})
+	if len(reported) != 1 {
+		t.Fatalf("expected 1 slow op, got %d", len(reported))
+	}
+	op := reported[0]
+	if op.Phase != PhasePinInto || op.App != "slowapp" || op.URL != "slow:" || op.CellID != cellID || op.Elapsed < 50*time.Millisecond {
+		t.Fatalf("unexpected slow op: %+v", op)
+	}
+	if !strings.Contains(op.Stack, "blockInPinInto") {
+		t.Fatalf("stack sample should show where the op blocked:\n%s", op.Stack)
+	}
+	if ReadSlowOpMetrics()[SlowOpKey{App: "slowapp", Phase: PhasePinInto}] != 1 {
+		t.Fatal("slow op not counted")
+	}
+}
+
+func TestAppUsage(t *testing.T) {
+	pin := &Pin[amp.AppInstance]{
+		App: &slowApp{info: task.Info{Label: "usageapp"}},
+	}
+	var sink [][]byte
+	pin.timeOp(PhaseCompute, func() error {
+		for i := 0; i < 16; i++ {
+			sink = append(sink, make([]byte, 4096))
+		}
+		time.Sleep(5 * time.Millisecond)
+		return nil
+	})
+	pin.countPush(3, 120)
+
+	var usage *AppUsage
+	for _, u := range ReadAppUsage(nil) {
+		if u.App == "usageapp" {
+			usage = &u
+		}
+	}
+	if usage == nil || usage.Phases != 1 || usage.Busy < 5*time.Millisecond || usage.AllocBytes < 16*4096 {
+		t.Fatalf("unexpected usage: %+v (%d buffers)", usage, len(sink))
+	}
+	if usage.TxsPushed != 1 || usage.OpsPushed != 3 || usage.BytesPushed != 120 {
+		t.Fatalf("unexpected push usage: %+v", usage)
+	}
+}
+
+func TestMarshalChildren(t *testing.T) {
+	pin := &Pin[amp.AppInstance]{
+		children: make(map[tag.ID]Cell[amp.AppInstance]),
+	}
+	children := make([]tag.ID, 5*MinChildrenPerWorker)
+	for i := range children {
+		childID := tag.Now()
+		children[i] = childID
+		pin.children[childID] = &ComputedCell[amp.AppInstance]{
+			Attrs: func(w CellWriter) {
+				w.PutText(CellLabel, childID.Base32())
+			},
+		}
+	}
+
+	budget := make(marshalBudget, 3)
+	w := cellWriter{
+		tx:     amp.NewTxMsg(true),
+		cellID: tag.Now(),
+		text:   &amp.Tag{},
+	}
+	pin.marshalChildren(&w, children, nil, budget)
+	if w.err != nil || len(budget) != 0 {
+		t.Fatalf("marshalChildren failed: %v", w.err)
+	}
+	if len(w.tx.Ops) != 2*len(children) || w.tx.OpCount != uint64(len(w.tx.Ops)) {
+		t.Fatalf("expected %d ops, got %d", 2*len(children), len(w.tx.Ops))
+	}
+	labels := 0
+	for i, op := range w.tx.Ops {
+		if op.ItemID != CellLabel {
+			continue
+		}
+		var label amp.Tag
+		if err := w.tx.UnmarshalOpValue(i, &label); err != nil || label.Text != op.CellID.Base32() {
+			t.Fatalf("op %d: unexpected label %q: %v", i, label.Text, err)
+		}
+		labels++
+	}
+	if labels != len(children) {
+		t.Fatalf("expected %d labels, got %d", len(children), labels)
+	}
+}
+
+func TestStoredCell(t *testing.T) {
+	st := store.NewEncryptedStore(store.Opts{
+		KV:       store.NewMemKV(),
+		Keys:     store.NewKeyring(),
+		TenantID: tag.Now(),
+	})
+	cellID := tag.Now()
+	cell := NewStoredCell[amp.AppInstance](cellID, st)
+	attrID := CellProperties.ID
+	if err := cell.Put(attrID, CellLabel, &amp.Tag{Text: "before"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := cell.Put(attrID, CellCaption, &amp.Tag{Text: "caption"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// a snapshot taken before a write is unaffected by it
+	before, _ := cell.State()
+	if err := cell.Put(attrID, CellLabel, &amp.Tag{Text: "after"}); err != nil {
+		t.Fatal(err)
+	}
+	after, _ := cell.State()
+	label := &amp.Tag{}
+	if err := before.Get(attrID, CellLabel, label); err != nil || label.Text != "before" {
+		t.Fatalf("snapshot changed: %q, %v", label.Text, err)
+	}
+	if err := after.Get(attrID, CellLabel, label); err != nil || label.Text != "after" || after.Rev != before.Rev+1 {
+		t.Fatalf("unexpected state: %q, %v", label.Text, err)
+	}
+
+	// elements are persisted, so another cell over the same store sees them
+	reloaded, err := NewStoredCell[amp.AppInstance](cellID, st).State()
+	if err != nil || reloaded.Len() != 2 {
+		t.Fatalf("expected 2 stored elements: %v", err)
+	}
+
+	w := cellWriter{
+		tx:     amp.NewTxMsg(true),
+		cellID: cellID,
+	}
+	cell.MarshalAttrs(&w)
+	if w.err != nil || len(w.tx.Ops) != 2 {
+		t.Fatalf("expected 2 ops: %v", w.err)
+	}
+	if err := w.tx.LoadItem(attrID, CellLabel, label); err != nil || label.Text != "after" {
+		t.Fatalf("unexpected marshalled label: %q, %v", label.Text, err)
+	}
+}
+
+// BenchmarkCellWriter marshals a media collection's worth of child cells, with and without a marshalArena.
+func BenchmarkCellWriter(b *testing.B) {
+	childIDs := make([]tag.ID, 200)
+	for i := range childIDs {
+		childIDs[i] = tag.Now()
+	}
+	run := func(b *testing.B, arena *marshalArena) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			tx := amp.NewTxMsg(true)
+			w := cellWriter{
+				tx: tx,
+			}
+			if arena != nil {
+				tx.DataStore = slices.Grow(tx.DataStore, arena.dataLen)
+				tx.Ops = slices.Grow(tx.Ops, arena.opCount)
+				w.text = &arena.text
+			}
+			for _, childID := range childIDs {
+				w.cellID = childID
+				w.PutText(CellLabel, "IMG_0042.jpg")
+				w.PutText(CellCaption, "2024-06-01, 4032x3024")
+				w.PutText(CellCollection, "Summer 2024")
+			}
+			if arena != nil {
+				arena.dataLen, arena.opCount = len(tx.DataStore), len(tx.Ops)
+			}
+			tx.ReleaseRef()
+		}
+	}
+	b.Run("alloc", func(b *testing.B) { run(b, nil) })
+	b.Run("arena", func(b *testing.B) { run(b, &marshalArena{}) })
+}
@@ -0,0 +1,62 @@
+// Package store implements amp.CellStore over a key-value backend, sealing each stored element with AES-GCM so cell state is encrypted at rest.
+//
+// Each tenant (e.g. a user or org) has its own keys, identified by a key ID stored alongside each sealed value.
+// Rotating a tenant's key affects only new writes until Reseal() re-encrypts existing elements under the active key.
+//
+// A stored element is keyed by:
+//
+//	TenantID / ScopeID / CellID / AttrID / ItemID   -- each a 24 byte big-endian tag.ID
+//
+// and its sealed value is:
+//
+//	version (1) | keyID (4) | nonce (12) | AES-GCM ciphertext
+package store
+
+import (
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+// KV is a sorted key-value backend, typically provided by the host (e.g. an LSM or a directory of files).
+type KV interface {
+
+	// Returns the value for the given key, or ErrKeyNotFound.
+	Get(key []byte) ([]byte, error)
+
+	// Sets the value for the given key.
+	Put(key, val []byte) error
+
+	// Removes the given key; removing a key that is not present is not an error.
+	Delete(key []byte) error
+
+	// Calls fn for each key having the given prefix, in ascending key order.
+	// The key and value passed to fn are only valid for the duration of the call.
+	Scan(prefix []byte, fn func(key, val []byte) error) error
+}
+
+// Keyring provides per-tenant AES keys (16, 24, or 32 bytes), typically backed by a host KMS.
+type Keyring interface {
+
+	// Returns the key new values are sealed with for the given tenant.
+	ActiveKey(tenantID tag.ID) (keyID uint32, key []byte, err error)
+
+	// Returns a key previously issued for the given tenant, or ErrUnknownKey.
+	Key(tenantID tag.ID, keyID uint32) ([]byte, error)
+}
+
+// Opts specifies an encrypted cell store.
+type Opts struct {
+	KV       KV
+	Keys     Keyring
+	TenantID tag.ID // selects which keys seal stored values
+	ScopeID  tag.ID // partitions elements within a tenant (e.g. an AppSpec ID)
+}
+
+// SealVersion is the sealed value format written by a Store.
+const SealVersion = 1
+
+var (
+	ErrKeyNotFound = amp.ErrCode_CellNotFound.Error("key not found")
+	ErrUnknownKey  = amp.ErrCode_AuthFailed.Error("unknown encryption key")
+	ErrBadSeal     = amp.ErrCode_DataFailure.Error("sealed value failed to open")
+)
@@ -0,0 +1,113 @@
+package store
+
+import (
+	"crypto/rand"
+	"sync"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+// MemKeyring is an in-memory Keyring that generates a 256-bit key for a tenant on first use.
+type MemKeyring struct {
+	mu      sync.Mutex
+	tenants map[tag.ID]*tenantKeys
+}
+
+type tenantKeys struct {
+	active uint32
+	keys   map[uint32][]byte
+}
+
+func NewKeyring() *MemKeyring {
+	return &MemKeyring{
+		tenants: make(map[tag.ID]*tenantKeys),
+	}
+}
+
+func (kr *MemKeyring) ActiveKey(tenantID tag.ID) (uint32, []byte, error) {
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+
+	tk := kr.tenants[tenantID]
+	if tk == nil {
+		if _, err := kr.rotate(tenantID); err != nil {
+			return 0, nil, err
+		}
+		tk = kr.tenants[tenantID]
+	}
+	return tk.active, tk.keys[tk.active], nil
+}
+
+func (kr *MemKeyring) Key(tenantID tag.ID, keyID uint32) ([]byte, error) {
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+
+	if tk := kr.tenants[tenantID]; tk != nil {
+		if key := tk.keys[keyID]; key != nil {
+			return key, nil
+		}
+	}
+	return nil, ErrUnknownKey
+}
+
+// Rotate generates a new active key for the given tenant, returning its key ID.
+// Previous keys are retained so existing values can still be opened.
+func (kr *MemKeyring) Rotate(tenantID tag.ID) (uint32, error) {
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+	return kr.rotate(tenantID)
+}
+
+// AddKey adds an externally provisioned key for the given tenant, making it active if requested.
+func (kr *MemKeyring) AddKey(tenantID tag.ID, keyID uint32, key []byte, active bool) {
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+
+	tk := kr.tenantKeys(tenantID)
+	tk.keys[keyID] = append([]byte(nil), key...)
+	if active {
+		tk.active = keyID
+	}
+}
+
+// Removes all keys for the given tenant except the active key -- call after Reseal() completes.
+func (kr *MemKeyring) Retire(tenantID tag.ID) {
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+
+	if tk := kr.tenants[tenantID]; tk != nil {
+		for keyID := range tk.keys {
+			if keyID != tk.active {
+				delete(tk.keys, keyID)
+			}
+		}
+	}
+}
+
+func (kr *MemKeyring) rotate(tenantID tag.ID) (uint32, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return 0, err
+	}
+
+	tk := kr.tenantKeys(tenantID)
+	keyID := tk.active + 1
+	for tk.keys[keyID] != nil {
+		keyID++
+	}
+	tk.keys[keyID] = key
+	tk.active = keyID
+	return keyID, nil
+}
+
+// caller holds kr.mu
+func (kr *MemKeyring) tenantKeys(tenantID tag.ID) *tenantKeys {
+	tk := kr.tenants[tenantID]
+	if tk == nil {
+		tk = &tenantKeys{
+			keys: make(map[uint32][]byte),
+		}
+		kr.tenants[tenantID] = tk
+	}
+	return tk
+}
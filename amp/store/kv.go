@@ -0,0 +1,150 @@
+package store
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// NewMemKV returns a KV held in memory, useful for tests and ephemeral hosts.
+func NewMemKV() KV {
+	return &memKV{
+		vals: make(map[string][]byte),
+	}
+}
+
+type memKV struct {
+	mu   sync.RWMutex
+	vals map[string][]byte
+}
+
+func (kv *memKV) Get(key []byte) ([]byte, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+
+	val, exists := kv.vals[string(key)]
+	if !exists {
+		return nil, ErrKeyNotFound
+	}
+	return val, nil
+}
+
+func (kv *memKV) Put(key, val []byte) error {
+	kv.mu.Lock()
+	kv.vals[string(key)] = append([]byte(nil), val...)
+	kv.mu.Unlock()
+	return nil
+}
+
+func (kv *memKV) Delete(key []byte) error {
+	kv.mu.Lock()
+	delete(kv.vals, string(key))
+	kv.mu.Unlock()
+	return nil
+}
+
+func (kv *memKV) Scan(prefix []byte, fn func(key, val []byte) error) error {
+	kv.mu.RLock()
+	var keys []string
+	for key := range kv.vals {
+		if strings.HasPrefix(key, string(prefix)) {
+			keys = append(keys, key)
+		}
+	}
+	kv.mu.RUnlock()
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		kv.mu.RLock()
+		val, exists := kv.vals[key]
+		kv.mu.RUnlock()
+		if !exists {
+			continue
+		}
+		if err := fn([]byte(key), val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewDirKV returns a KV storing each value as a file in the given directory, named by its hex-encoded key.
+// Since hex encoding preserves byte order, a directory listing is in key order.
+func NewDirKV(dir string) (KV, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &dirKV{
+		dir: dir,
+	}, nil
+}
+
+type dirKV struct {
+	dir string
+}
+
+func (kv *dirKV) pathFor(key []byte) string {
+	return filepath.Join(kv.dir, hex.EncodeToString(key))
+}
+
+func (kv *dirKV) Get(key []byte) ([]byte, error) {
+	val, err := os.ReadFile(kv.pathFor(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrKeyNotFound
+	}
+	return val, err
+}
+
+// Put writes to a temp file and renames it so a crash never leaves a partial value.
+func (kv *dirKV) Put(key, val []byte) error {
+	path := kv.pathFor(key)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, val, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func (kv *dirKV) Delete(key []byte) error {
+	err := os.Remove(kv.pathFor(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+func (kv *dirKV) Scan(prefix []byte, fn func(key, val []byte) error) error {
+	entries, err := os.ReadDir(kv.dir)
+	if err != nil {
+		return err
+	}
+
+	hexPrefix := hex.EncodeToString(prefix)
+	for _, entry := range entries { // ReadDir returns entries sorted by name
+		name := entry.Name()
+		if !strings.HasPrefix(name, hexPrefix) || strings.HasSuffix(name, ".tmp") {
+			continue
+		}
+		key, err := hex.DecodeString(name)
+		if err != nil || !bytes.HasPrefix(key, prefix) {
+			continue
+		}
+		val, err := kv.Get(key)
+		if err == ErrKeyNotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err = fn(key, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+package store
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+const (
+	idSize     = 24
+	nonceSize  = 12
+	headerSize = 1 + 4 + nonceSize
+	prefixSize = 2 * idSize
+	keySize    = prefixSize + 3*idSize
+)
+
+// Store is an amp.CellStore that seals each element using its tenant's active key.
+type Store struct {
+	opts   Opts
+	prefix []byte // TenantID / ScopeID
+}
+
+var _ amp.CellStore = (*Store)(nil)
+
+// NewEncryptedStore returns a cell store over opts.KV whose values are encrypted at rest using keys from opts.Keys.
+func NewEncryptedStore(opts Opts) *Store {
+	st := &Store{
+		opts: opts,
+	}
+	st.prefix = opts.TenantID.AppendTo(st.prefix)
+	st.prefix = opts.ScopeID.AppendTo(st.prefix)
+	return st
+}
+
+func (st *Store) GetElement(elemID amp.ElementID, dst tag.Value) error {
+	key := st.elementKey(elemID)
+	sealed, err := st.opts.KV.Get(key)
+	if err == ErrKeyNotFound {
+		return amp.ErrCellNotFound
+	}
+	if err != nil {
+		return amp.ErrCode_StorageFailure.Wrap(err)
+	}
+	data, _, err := st.open(key, sealed)
+	if err != nil {
+		return err
+	}
+	return dst.Unmarshal(data)
+}
+
+func (st *Store) PutElement(elemID amp.ElementID, src tag.Value) error {
+	key := st.elementKey(elemID)
+	if src == nil {
+		return st.opts.KV.Delete(key)
+	}
+	data, err := src.MarshalToStore(nil)
+	if err != nil {
+		return err
+	}
+	sealed, err := st.seal(key, data)
+	if err != nil {
+		return err
+	}
+	return st.opts.KV.Put(key, sealed)
+}
+
+func (st *Store) ForEachElement(cellID tag.ID, fn func(elemID amp.ElementID, data []byte) error) error {
+	prefix := cellID.AppendTo(append([]byte(nil), st.prefix...))
+	return st.opts.KV.Scan(prefix, func(key, sealed []byte) error {
+		if len(key) != keySize {
+			return nil
+		}
+		data, _, err := st.open(key, sealed)
+		if err != nil {
+			return err
+		}
+		return fn(parseElementID(key[prefixSize:]), data)
+	})
+}
+
+// Reseal re-encrypts every element in this store not sealed with the tenant's active key, returning the number of elements rewritten.
+// Following a key rotation, once Reseal completes, the tenant's previous keys are no longer needed by this store.
+func (st *Store) Reseal() (int, error) {
+	activeID, _, err := st.opts.Keys.ActiveKey(st.opts.TenantID)
+	if err != nil {
+		return 0, err
+	}
+
+	type resealed struct {
+		key, sealed []byte
+	}
+	var rewrites []resealed
+	err = st.opts.KV.Scan(st.prefix, func(key, sealed []byte) error {
+		data, keyID, err := st.open(key, sealed)
+		if err != nil || keyID == activeID {
+			return err
+		}
+		key = append([]byte(nil), key...)
+		if sealed, err = st.seal(key, data); err != nil {
+			return err
+		}
+		rewrites = append(rewrites, resealed{key, sealed})
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for i, ri := range rewrites {
+		if err = st.opts.KV.Put(ri.key, ri.sealed); err != nil {
+			return i, err
+		}
+	}
+	return len(rewrites), nil
+}
+
+func (st *Store) elementKey(elemID amp.ElementID) []byte {
+	key := make([]byte, 0, keySize)
+	key = append(key, st.prefix...)
+	for _, id := range elemID {
+		key = id.AppendTo(key)
+	}
+	return key
+}
+
+func parseElementID(buf []byte) amp.ElementID {
+	var elemID amp.ElementID
+	for i := range elemID {
+		elemID[i], _ = tag.FromBytes(buf[i*idSize : (i+1)*idSize])
+	}
+	return elemID
+}
+
+// seal encrypts data with the tenant's active key, binding it to the given element key.
+func (st *Store) seal(key, data []byte) ([]byte, error) {
+	keyID, secret, err := st.opts.Keys.ActiveKey(st.opts.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	sealed := make([]byte, headerSize, headerSize+len(data)+aead.Overhead())
+	sealed[0] = SealVersion
+	binary.BigEndian.PutUint32(sealed[1:5], keyID)
+	nonce := sealed[5:headerSize]
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(sealed, nonce, data, key), nil
+}
+
+// open decrypts a value sealed for the given element key, also returning the ID of the key that sealed it.
+func (st *Store) open(key, sealed []byte) ([]byte, uint32, error) {
+	if len(sealed) < headerSize || sealed[0] != SealVersion {
+		return nil, 0, ErrBadSeal
+	}
+	keyID := binary.BigEndian.Uint32(sealed[1:5])
+	secret, err := st.opts.Keys.Key(st.opts.TenantID, keyID)
+	if err != nil {
+		return nil, 0, err
+	}
+	aead, err := newAEAD(secret)
+	if err != nil {
+		return nil, 0, err
+	}
+	data, err := aead.Open(nil, sealed[5:headerSize], sealed[headerSize:], key)
+	if err != nil {
+		return nil, 0, ErrBadSeal
+	}
+	return data, keyID, nil
+}
+
+func newAEAD(secret []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(secret)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,88 @@
+package store_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/amp/store"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+func TestEncryptedStore(t *testing.T) {
+	kv, err := store.NewDirKV(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := store.NewKeyring()
+	tenantID := tag.Now()
+	st := store.NewEncryptedStore(store.Opts{
+		KV:       kv,
+		Keys:     keys,
+		TenantID: tenantID,
+		ScopeID:  amp.AppSpec.ID,
+	})
+
+	cellID := tag.Now()
+	attrID := amp.AttrSpec.With("Tag.label").ID
+	elems := []amp.ElementID{
+		{cellID, attrID, tag.ID{0, 0, 1}},
+		{cellID, attrID, tag.ID{0, 0, 2}},
+	}
+	for i, elemID := range elems {
+		if err := st.PutElement(elemID, &amp.Tag{Text: "secret message " + string(rune('A'+i))}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// plaintext must not appear at rest
+	kv.Scan(nil, func(key, val []byte) error {
+		if bytes.Contains(val, []byte("secret message")) {
+			t.Error("plaintext stored at rest")
+		}
+		return nil
+	})
+
+	got := &amp.Tag{}
+	if err := st.GetElement(elems[1], got); err != nil || got.Text != "secret message B" {
+		t.Fatalf("GetElement: %q, %v", got.Text, err)
+	}
+
+	// a different tenant cannot see or open this tenant's elements
+	other := store.NewEncryptedStore(store.Opts{KV: kv, Keys: keys, TenantID: tag.Now(), ScopeID: amp.AppSpec.ID})
+	if err := other.GetElement(elems[0], &amp.Tag{}); err != amp.ErrCellNotFound {
+		t.Fatalf("expected ErrCellNotFound, got %v", err)
+	}
+
+	// rotate, then reseal existing elements under the new key
+	if _, err := keys.Rotate(tenantID); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := st.Reseal(); err != nil || n != len(elems) {
+		t.Fatalf("Reseal: %d, %v", n, err)
+	}
+	keys.Retire(tenantID)
+	if n, _ := st.Reseal(); n != 0 {
+		t.Fatalf("expected nothing to reseal, got %d", n)
+	}
+
+	var texts []string
+	err = st.ForEachElement(cellID, func(elemID amp.ElementID, data []byte) error {
+		val := &amp.Tag{}
+		if err := val.Unmarshal(data); err != nil {
+			return err
+		}
+		texts = append(texts, val.Text)
+		return nil
+	})
+	if err != nil || len(texts) != 2 || texts[0] != "secret message A" {
+		t.Fatalf("ForEachElement: %v, %v", texts, err)
+	}
+
+	if err := st.PutElement(elems[0], nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.GetElement(elems[0], &amp.Tag{}); err != amp.ErrCellNotFound {
+		t.Fatalf("expected deleted element, got %v", err)
+	}
+}
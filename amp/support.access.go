@@ -0,0 +1,222 @@
+package amp
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/media"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+// CellACL is an in-memory AccessControl where each cell is readable by the users granted access to it.
+type CellACL struct {
+	mu      sync.Mutex
+	readers map[tag.ID]map[tag.ID]struct{} // cellID => userIDs
+	public  map[tag.ID]struct{}            // cells readable by any signed in user
+	tokens  map[string]tag.ID              // access token => userID
+	roles   map[string]map[tag.ID]struct{} // role => userIDs
+}
+
+var _ AccessControl = (*CellACL)(nil)
+
+// NewCellACL returns an empty CellACL, where no cell is readable until granted.
+func NewCellACL() *CellACL {
+	return &CellACL{
+		readers: make(map[tag.ID]map[tag.ID]struct{}),
+		public:  make(map[tag.ID]struct{}),
+		tokens:  make(map[string]tag.ID),
+		roles:   make(map[string]map[tag.ID]struct{}),
+	}
+}
+
+// Grant allows the given user to read the given cell.
+func (acl *CellACL) Grant(cellID, userID tag.ID) {
+	acl.mu.Lock()
+	defer acl.mu.Unlock()
+
+	users := acl.readers[cellID]
+	if users == nil {
+		users = make(map[tag.ID]struct{})
+		acl.readers[cellID] = users
+	}
+	users[userID] = struct{}{}
+}
+
+// Revoke withdraws a grant made via Grant().
+func (acl *CellACL) Revoke(cellID, userID tag.ID) {
+	acl.mu.Lock()
+	defer acl.mu.Unlock()
+
+	if users := acl.readers[cellID]; users != nil {
+		delete(users, userID)
+		if len(users) == 0 {
+			delete(acl.readers, cellID)
+		}
+	}
+}
+
+// SetPublic sets if the given cell is readable by any signed in user.
+func (acl *CellACL) SetPublic(cellID tag.ID, public bool) {
+	acl.mu.Lock()
+	defer acl.mu.Unlock()
+
+	if public {
+		acl.public[cellID] = struct{}{}
+	} else {
+		delete(acl.public, cellID)
+	}
+}
+
+// GrantRole grants the given role to the given user.
+func (acl *CellACL) GrantRole(role string, userID tag.ID) {
+	acl.mu.Lock()
+	defer acl.mu.Unlock()
+
+	users := acl.roles[role]
+	if users == nil {
+		users = make(map[tag.ID]struct{})
+		acl.roles[role] = users
+	}
+	users[userID] = struct{}{}
+}
+
+// RevokeRole withdraws a grant made via GrantRole().
+func (acl *CellACL) RevokeRole(role string, userID tag.ID) {
+	acl.mu.Lock()
+	defer acl.mu.Unlock()
+
+	if users := acl.roles[role]; users != nil {
+		delete(users, userID)
+	}
+}
+
+// BindToken associates an access token with the given user, typically as a session signs in.
+func (acl *CellACL) BindToken(accessToken string, userID tag.ID) {
+	acl.mu.Lock()
+	acl.tokens[accessToken] = userID
+	acl.mu.Unlock()
+}
+
+// UnbindToken forgets an access token, typically as a session signs out.
+func (acl *CellACL) UnbindToken(accessToken string) {
+	acl.mu.Lock()
+	delete(acl.tokens, accessToken)
+	acl.mu.Unlock()
+}
+
+func (acl *CellACL) CanReadCell(userID, cellID tag.ID) error {
+	if userID.IsNil() {
+		return ErrAccessDenied
+	}
+
+	acl.mu.Lock()
+	defer acl.mu.Unlock()
+
+	if _, public := acl.public[cellID]; public {
+		return nil
+	}
+	if _, granted := acl.readers[cellID][userID]; granted {
+		return nil
+	}
+	return ErrAccessDenied
+}
+
+func (acl *CellACL) HasRole(userID tag.ID, role string) bool {
+	acl.mu.Lock()
+	defer acl.mu.Unlock()
+
+	_, granted := acl.roles[role][userID]
+	return granted
+}
+
+func (acl *CellACL) UserForToken(accessToken string) (tag.ID, error) {
+	acl.mu.Lock()
+	defer acl.mu.Unlock()
+
+	userID, exists := acl.tokens[accessToken]
+	if !exists || accessToken == "" {
+		return tag.ID{}, ErrNoAuthToken
+	}
+	return userID, nil
+}
+
+// AssetTokenCookie is the cookie a client may use to present its access token when fetching scoped assets.
+const AssetTokenCookie = "amp_token"
+
+// NewAssetAccess returns a media.AccessPolicy so that assets published via PublishCellAsset inherit their cell's ACL.
+// Requesters present their access token as a bearer token or via AssetTokenCookie.
+// Subjects and scopes are the base32 form of user and cell IDs respectively.
+func NewAssetAccess(ac AccessControl) media.AccessPolicy {
+	return assetAccess{ac}
+}
+
+type assetAccess struct {
+	ac AccessControl
+}
+
+func (aa assetAccess) Requester(r *http.Request) (string, error) {
+	token, _ := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if token == "" {
+		if cookie, err := r.Cookie(AssetTokenCookie); err == nil {
+			token = cookie.Value
+		}
+	}
+	userID, err := aa.ac.UserForToken(token)
+	if err != nil {
+		return "", media.ErrNotAuthenticated
+	}
+	return userID.Base32(), nil
+}
+
+func (aa assetAccess) CanRead(subject, scope string) error {
+	userID, err := tag.FromBase32(subject)
+	if err != nil {
+		return media.ErrAccessDenied
+	}
+	cellID, err := tag.FromBase32(scope)
+	if err != nil {
+		return media.ErrAccessDenied
+	}
+	if aa.ac.CanReadCell(userID, cellID) != nil {
+		return media.ErrAccessDenied
+	}
+	return nil
+}
+
+// PublishCellAsset publishes an asset referenced by the given cell so that only users who may read the cell may fetch it.
+// If opts.Private is set and opts.Subject is not, the URL is bound to the session's user, so it works without a token (e.g. in a native player).
+// If opts.Account is not set, bandwidth serving the asset is accounted to the session (see SessionAccount).
+// The session's publisher must be configured with an AccessPolicy such as NewAssetAccess().
+func PublishCellAsset(sess Session, cellID tag.ID, asset media.Asset, opts media.PublishOpts) (string, error) {
+	opts.Scope = cellID.Base32()
+	if opts.Private && opts.Subject == "" {
+		if login := sess.Login(); login.UserID != nil {
+			userID := *login.UserID
+			opts.Subject = userID.AsID().Base32()
+		}
+	}
+	if opts.Account == (media.Account{}) {
+		opts.Account = SessionAccount(sess, tag.ID{})
+	}
+	return sess.AssetPublisher().PublishAsset(asset, opts)
+}
+
+// SessionAccount returns the media.Account a session's bandwidth is accounted to (see media.PublishOpts.Account),
+// where the session is identified by its device and appID is optional.
+func SessionAccount(sess Session, appID tag.ID) media.Account {
+	var acct media.Account
+	login := sess.Login()
+	if login.DeviceID != nil {
+		deviceID := *login.DeviceID
+		acct.Session = deviceID.AsID().Base32()
+	}
+	if login.UserID != nil {
+		userID := *login.UserID
+		acct.User = userID.AsID().Base32()
+	}
+	if !appID.IsNil() {
+		acct.App = appID.Base32()
+	}
+	return acct
+}
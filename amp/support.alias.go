@@ -0,0 +1,98 @@
+package amp
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+// NewAliasTable returns an in-memory AliasTable.
+// A host wishing to persist aliases across restarts can wrap this or provide its own implementation.
+func NewAliasTable() AliasTable {
+	return &aliasTable{
+		aliases: make(map[string]aliasEntry),
+	}
+}
+
+// Implements AliasTable
+type aliasTable struct {
+	mu      sync.RWMutex
+	aliases map[string]aliasEntry
+}
+
+type aliasEntry struct {
+	appID  tag.ID
+	cellID tag.ID
+}
+
+// Aliases are case-insensitive and ignore surrounding whitespace and slashes.
+func normalizeAlias(alias string) string {
+	return strings.ToLower(strings.Trim(alias, " \t/"))
+}
+
+func (tbl *aliasTable) ClaimAlias(appID tag.ID, alias string, cellID tag.ID) error {
+	alias = normalizeAlias(alias)
+	if alias == "" {
+		return ErrCode_InvalidURI.Error("ClaimAlias: missing alias")
+	}
+	if cellID.IsNil() {
+		return ErrBadTarget
+	}
+
+	tbl.mu.Lock()
+	defer tbl.mu.Unlock()
+
+	if entry, exists := tbl.aliases[alias]; exists && entry.appID != appID {
+		return ErrAliasClaimed
+	}
+	tbl.aliases[alias] = aliasEntry{
+		appID:  appID,
+		cellID: cellID,
+	}
+	return nil
+}
+
+func (tbl *aliasTable) ReleaseAlias(appID tag.ID, alias string) error {
+	alias = normalizeAlias(alias)
+
+	tbl.mu.Lock()
+	defer tbl.mu.Unlock()
+
+	entry, exists := tbl.aliases[alias]
+	if !exists {
+		return ErrAliasNotFound
+	}
+	if entry.appID != appID {
+		return ErrAliasClaimed
+	}
+	delete(tbl.aliases, alias)
+	return nil
+}
+
+func (tbl *aliasTable) ResolveAlias(alias string) (cellID, appID tag.ID, err error) {
+	alias = normalizeAlias(alias)
+
+	tbl.mu.RLock()
+	defer tbl.mu.RUnlock()
+
+	entry, exists := tbl.aliases[alias]
+	if !exists {
+		return tag.ID{}, tag.ID{}, ErrAliasNotFound
+	}
+	return entry.cellID, entry.appID, nil
+}
+
+// ResolveTarget returns the cell ID targeted by this request.
+// If PinTarget has no explicit ID, PinTarget.URL is resolved using the given AliasTable (if non-nil).
+func (v *PinRequest) ResolveTarget(aliases AliasTable) (tag.ID, error) {
+	targetID := v.TargetID()
+	if targetID.IsSet() {
+		return targetID, nil
+	}
+	if v.PinTarget == nil || v.PinTarget.URL == "" || aliases == nil {
+		return tag.ID{}, ErrBadTarget
+	}
+	cellID, _, err := aliases.ResolveAlias(v.PinTarget.URL)
+	return cellID, err
+}
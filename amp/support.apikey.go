@@ -0,0 +1,160 @@
+package amp
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+// APIKeyPrefix prefixes each API key secret so that a leaked key is recognizable (e.g. by secret scanners).
+const APIKeyPrefix = "amp_"
+
+// APIKeyLogin returns the Login of a session presenting an API key (Login.APIKey), as used by a host during the session handshake.
+// The returned Login is signed in as the key's user and no longer bears the secret; the host retains the returned key to scope the session's requests (see APIKey.Permits).
+func APIKeyLogin(keys APIKeys, login Login) (Login, *APIKey, error) {
+	if login.APIKey == "" {
+		return Login{}, nil, ErrNoAuthToken
+	}
+	key, err := keys.Authenticate(login.APIKey)
+	if err != nil {
+		return Login{}, nil, err
+	}
+	if login.UserID != nil && login.UserID.AsID() != key.UserID {
+		return Login{}, nil, ErrBadAPIKey
+	}
+
+	login.APIKey = ""
+	login.Checkpoint = nil
+	login.UserID = &Tag{}
+	login.UserID.SetID(key.UserID)
+	return login, key, nil
+}
+
+// Permits returns nil if this key allows the given request to be served by the given app: the app must be in scope and, if the request commits a tx, the key must allow writes.
+func (key *APIKey) Permits(appID tag.ID, req *Request) error {
+	if req.CommitTx != nil && !key.Write {
+		return ErrAccessDenied
+	}
+	if len(key.Apps) == 0 {
+		return nil
+	}
+	for _, ai := range key.Apps {
+		if ai == appID {
+			return nil
+		}
+	}
+	return ErrAccessDenied
+}
+
+// NewAPIKeyStore returns an in-memory APIKeys, which retains only the SHA-256 hash of each secret.
+func NewAPIKeyStore() APIKeys {
+	return &apiKeyStore{
+		keys: make(map[tag.ID]*apiKeyEntry),
+	}
+}
+
+// Implements APIKeys
+type apiKeyStore struct {
+	mu   sync.Mutex
+	keys map[tag.ID]*apiKeyEntry // keyID => entry
+}
+
+type apiKeyEntry struct {
+	key  *APIKey // replaced rather than modified, so values returned by ListKeys() remain stable
+	hash [sha256.Size]byte
+}
+
+// A secret is APIKeyPrefix + KeyID (base32) + "_" + 32 random bytes (base64), so the key is found without scanning and then verified by hash.
+func (store *apiKeyStore) Issue(key APIKey) (string, error) {
+	if key.UserID.IsNil() {
+		return "", ErrCode_BadRequest.Error("API key requires a user")
+	}
+	entropy := make([]byte, 32)
+	if _, err := rand.Read(entropy); err != nil {
+		return "", ErrCode_InternalErr.Wrap(err)
+	}
+	key.KeyID = tag.Now()
+	key.CreatedAt = time.Now().Unix()
+	key.LastUsedAt = 0
+
+	secret := APIKeyPrefix + key.KeyID.Base32() + "_" + base64.RawURLEncoding.EncodeToString(entropy)
+
+	store.mu.Lock()
+	store.keys[key.KeyID] = &apiKeyEntry{
+		key:  &key,
+		hash: sha256.Sum256([]byte(secret)),
+	}
+	store.mu.Unlock()
+	return secret, nil
+}
+
+func (store *apiKeyStore) Authenticate(secret string) (*APIKey, error) {
+	body, found := strings.CutPrefix(secret, APIKeyPrefix)
+	if !found {
+		return nil, ErrBadAPIKey
+	}
+	keyStr, _, found := strings.Cut(body, "_")
+	if !found {
+		return nil, ErrBadAPIKey
+	}
+	keyID, err := tag.FromBase32(keyStr)
+	if err != nil {
+		return nil, ErrBadAPIKey
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	entry := store.keys[keyID]
+	if entry == nil {
+		return nil, ErrBadAPIKey
+	}
+	hash := sha256.Sum256([]byte(secret))
+	if subtle.ConstantTimeCompare(hash[:], entry.hash[:]) != 1 {
+		return nil, ErrBadAPIKey
+	}
+	now := time.Now().Unix()
+	if entry.key.ExpiresAt != 0 && now >= entry.key.ExpiresAt {
+		return nil, ErrAPIKeyExpired
+	}
+
+	used := *entry.key
+	used.LastUsedAt = now
+	entry.key = &used
+	return &used, nil
+}
+
+func (store *apiKeyStore) ListKeys(userID tag.ID) []*APIKey {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	var keys []*APIKey
+	for _, entry := range store.keys {
+		if entry.key.UserID == userID {
+			keys = append(keys, entry.key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].KeyID.CompareTo(keys[j].KeyID) > 0
+	})
+	return keys
+}
+
+func (store *apiKeyStore) RevokeKey(userID, keyID tag.ID) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	entry := store.keys[keyID]
+	if entry == nil || entry.key.UserID != userID {
+		return ErrBadAPIKey
+	}
+	delete(store.keys, keyID)
+	return nil
+}
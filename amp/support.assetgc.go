@@ -0,0 +1,340 @@
+package amp
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/blob"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// AssetRefStore persists the state of an AssetRefs table.
+type AssetRefStore interface {
+	PutCellRefs(cellID tag.ID, keys []string) error // empty keys deletes the cell's entry
+	PutOrphan(key string, since time.Time) error    // zero since deletes the key's entry
+	LoadRefs() (cellRefs map[tag.ID][]string, orphans map[string]time.Time, err error)
+}
+
+// AssetGCOpts configures StartAssetGC().
+type AssetGCOpts struct {
+	Blobs    blob.Store    // where collected assets are deleted from
+	Store    AssetRefStore // if nil, references do not survive a restart (unreferenced assets are then retained rather than collected)
+	Grace    time.Duration // how long an asset must be unreferenced before it is collected (default 24 hours)
+	Interval time.Duration // how often a GC pass runs; if <= 0, only explicit calls to Collect() delete assets
+}
+
+// StartAssetGC loads any persisted references and starts an AssetRefs table as a child of the given context, running a GC pass every opts.Interval.
+//
+// Only tracked assets are ever deleted: those once referenced by a cell or passed to Track().
+// So a host may share its blob store with other content, and forgetting state (e.g. with no AssetRefStore) leaks storage rather than losing it.
+func StartAssetGC(parent task.Context, opts AssetGCOpts) (AssetRefs, error) {
+	if opts.Grace <= 0 {
+		opts.Grace = 24 * time.Hour
+	}
+
+	gc := &assetGC{
+		opts:     opts,
+		cellRefs: make(map[tag.ID][]string),
+		refCount: make(map[string]int),
+		orphans:  make(map[string]time.Time),
+	}
+	if opts.Store != nil {
+		cellRefs, orphans, err := opts.Store.LoadRefs()
+		if err != nil {
+			return nil, err
+		}
+		for cellID, keys := range cellRefs {
+			gc.cellRefs[cellID] = keys
+			for _, key := range keys {
+				gc.refCount[key]++
+			}
+		}
+		for key, since := range orphans {
+			if gc.refCount[key] == 0 {
+				gc.orphans[key] = since
+			}
+		}
+	}
+
+	_, err := parent.StartChild(&task.Task{
+		Info: task.Info{
+			Label: "asset gc",
+		},
+		OnRun: gc.run,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gc, nil
+}
+
+// Implements AssetRefs
+type assetGC struct {
+	opts     AssetGCOpts
+	mu       sync.Mutex
+	cellRefs map[tag.ID][]string  // cellID => referenced keys
+	refCount map[string]int       // key => number of referencing cells
+	orphans  map[string]time.Time // key => when it became unreferenced
+}
+
+func (gc *assetGC) SetRefs(cellID tag.ID, keys []string) error {
+	keys = uniqueKeys(keys)
+	now := time.Now()
+
+	gc.mu.Lock()
+	defer gc.mu.Unlock()
+
+	for _, key := range keys {
+		if gc.refCount[key]++; gc.refCount[key] == 1 {
+			if err := gc.setOrphan(key, time.Time{}); err != nil {
+				return err
+			}
+		}
+	}
+	for _, key := range gc.cellRefs[cellID] {
+		if gc.refCount[key]--; gc.refCount[key] == 0 {
+			delete(gc.refCount, key)
+			if err := gc.setOrphan(key, now); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(keys) == 0 {
+		delete(gc.cellRefs, cellID)
+	} else {
+		gc.cellRefs[cellID] = keys
+	}
+	if store := gc.opts.Store; store != nil {
+		return store.PutCellRefs(cellID, keys)
+	}
+	return nil
+}
+
+func (gc *assetGC) Track(key string) error {
+	gc.mu.Lock()
+	defer gc.mu.Unlock()
+
+	if gc.refCount[key] > 0 {
+		return nil
+	}
+	if _, tracked := gc.orphans[key]; tracked {
+		return nil
+	}
+	return gc.setOrphan(key, time.Now())
+}
+
+// setOrphan marks a key as unreferenced since the given time (or referenced if zero) -- caller holds gc.mu
+func (gc *assetGC) setOrphan(key string, since time.Time) error {
+	if since.IsZero() {
+		if _, exists := gc.orphans[key]; !exists {
+			return nil
+		}
+		delete(gc.orphans, key)
+	} else {
+		gc.orphans[key] = since
+	}
+	if store := gc.opts.Store; store != nil {
+		return store.PutOrphan(key, since)
+	}
+	return nil
+}
+
+func (gc *assetGC) Referrers(key string) []tag.ID {
+	gc.mu.Lock()
+	defer gc.mu.Unlock()
+
+	var cells []tag.ID
+	for cellID, keys := range gc.cellRefs {
+		for _, ki := range keys {
+			if ki == key {
+				cells = append(cells, cellID)
+				break
+			}
+		}
+	}
+	sort.Slice(cells, func(i, j int) bool {
+		return cells[i].CompareTo(cells[j]) < 0
+	})
+	return cells
+}
+
+func (gc *assetGC) Collect(ctx context.Context) ([]string, error) {
+	cutoff := time.Now().Add(-gc.opts.Grace)
+
+	gc.mu.Lock()
+	var due []string
+	for key, since := range gc.orphans {
+		if !since.After(cutoff) {
+			due = append(due, key)
+		}
+	}
+	gc.mu.Unlock()
+	sort.Strings(due)
+
+	var collected []string
+	var firstErr error
+	for _, key := range due {
+		deleted, err := gc.collect(ctx, key, cutoff)
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+		if deleted {
+			collected = append(collected, key)
+		}
+	}
+	return collected, firstErr
+}
+
+// collect deletes the given blob unless it was referenced (or re-tracked) since Collect() began.
+// gc.mu is held while deleting so that the blob cannot gain a reference mid-delete.
+func (gc *assetGC) collect(ctx context.Context, key string, cutoff time.Time) (bool, error) {
+	gc.mu.Lock()
+	defer gc.mu.Unlock()
+
+	if since, exists := gc.orphans[key]; !exists || since.After(cutoff) {
+		return false, nil
+	}
+	if err := gc.opts.Blobs.Delete(ctx, key); err != nil {
+		return false, err
+	}
+	return true, gc.setOrphan(key, time.Time{})
+}
+
+func (gc *assetGC) run(ctx task.Context) {
+	if gc.opts.Interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(gc.opts.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			collected, err := gc.Collect(ctx)
+			if err != nil {
+				ctx.Log().Warnf("asset gc: %v", err)
+			}
+			if len(collected) > 0 {
+				ctx.Log().Infof(1, "asset gc: collected %d unreferenced assets", len(collected))
+			}
+		case <-ctx.Closing():
+			return
+		}
+	}
+}
+
+func uniqueKeys(keys []string) []string {
+	if len(keys) == 0 {
+		return nil
+	}
+	unique := append([]string(nil), keys...)
+	sort.Strings(unique)
+	n := 1
+	for _, key := range unique[1:] {
+		if key != unique[n-1] {
+			unique[n] = key
+			n++
+		}
+	}
+	return unique[:n]
+}
+
+// NewFileAssetRefStore returns an AssetRefStore that keeps each cell's references and each unreferenced key as a file in the given directory.
+func NewFileAssetRefStore(dirPath string) (AssetRefStore, error) {
+	if err := os.MkdirAll(dirPath, 0700); err != nil {
+		return nil, err
+	}
+	return &fileAssetRefStore{
+		dirPath: dirPath,
+	}, nil
+}
+
+// Implements AssetRefStore
+type fileAssetRefStore struct {
+	dirPath string
+}
+
+const (
+	cellRefsExt = ".refs"
+	orphanExt   = ".orphan"
+)
+
+// writeFile writes then renames so a crash never leaves a partial file, or removes the file if content is nil.
+func (store *fileAssetRefStore) writeFile(name string, content []byte) error {
+	pathname := filepath.Join(store.dirPath, name)
+	if content == nil {
+		err := os.Remove(pathname)
+		if os.IsNotExist(err) {
+			err = nil
+		}
+		return err
+	}
+	tmp := pathname + ".tmp"
+	if err := os.WriteFile(tmp, content, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, pathname)
+}
+
+func (store *fileAssetRefStore) PutCellRefs(cellID tag.ID, keys []string) error {
+	var content []byte
+	if len(keys) > 0 {
+		content = []byte(strings.Join(keys, "\n"))
+	}
+	return store.writeFile(cellID.Base32()+cellRefsExt, content)
+}
+
+func (store *fileAssetRefStore) PutOrphan(key string, since time.Time) error {
+	hash := sha256.Sum256([]byte(key))
+	var content []byte
+	if !since.IsZero() {
+		content = []byte(strconv.FormatInt(since.Unix(), 10) + "\n" + key)
+	}
+	return store.writeFile(hex.EncodeToString(hash[:16])+orphanExt, content)
+}
+
+func (store *fileAssetRefStore) LoadRefs() (map[tag.ID][]string, map[string]time.Time, error) {
+	entries, err := os.ReadDir(store.dirPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cellRefs := make(map[tag.ID][]string)
+	orphans := make(map[string]time.Time)
+	for _, entry := range entries {
+		name := entry.Name()
+		if !strings.HasSuffix(name, cellRefsExt) && !strings.HasSuffix(name, orphanExt) {
+			continue
+		}
+		buf, err := os.ReadFile(filepath.Join(store.dirPath, name))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if cellStr, isCell := strings.CutSuffix(name, cellRefsExt); isCell {
+			cellID, err := tag.FromBase32(cellStr)
+			if err != nil {
+				continue
+			}
+			cellRefs[cellID] = strings.Split(string(buf), "\n")
+		} else {
+			sinceStr, key, _ := strings.Cut(string(buf), "\n")
+			since, err := strconv.ParseInt(sinceStr, 10, 64)
+			if err != nil || key == "" {
+				continue
+			}
+			orphans[key] = time.Unix(since, 0)
+		}
+	}
+	return cellRefs, orphans, nil
+}
@@ -0,0 +1,230 @@
+package amp
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// RecordLogin records the outcome of a session's sign in, where err is nil if the login was accepted.
+func RecordLogin(audit SecurityAudit, login *Login, remote string, err error) error {
+	ev := SecurityEvent{
+		Kind:   EventLoginSucceeded,
+		Remote: remote,
+	}
+	if login.UserID != nil {
+		ev.UserID = login.UserID.AsID()
+	}
+	if login.ImpersonatorID != nil {
+		ev.ActorID = login.ImpersonatorID.AsID()
+	}
+	if login.DeviceID != nil {
+		ev.DeviceID = login.DeviceID.AsID()
+	}
+	if err != nil {
+		ev.Kind = EventLoginFailed
+		ev.Detail = err.Error()
+	}
+	return audit.RecordEvent(ev)
+}
+
+// RecordAccessDenied records that the given user was denied access to the given resource (e.g. a cell or app).
+func RecordAccessDenied(audit SecurityAudit, userID, target tag.ID, err error) error {
+	ev := SecurityEvent{
+		Kind:   EventAccessDenied,
+		UserID: userID,
+		Target: target,
+	}
+	if err != nil {
+		ev.Detail = err.Error()
+	}
+	return audit.RecordEvent(ev)
+}
+
+// SecurityLog is an in-memory SecurityAudit retaining the most recent events.
+// It also implements ImpersonationAudit, so impersonation sessions appear in the same trail.
+type SecurityLog struct {
+	mu       sync.Mutex
+	events   []SecurityEvent // ring buffer
+	next     int             // index of the next event in events
+	full     bool            // set once events has wrapped
+	watchers map[int]func(ev SecurityEvent)
+	nextID   int
+}
+
+var (
+	_ SecurityAudit      = (*SecurityLog)(nil)
+	_ ImpersonationAudit = (*SecurityLog)(nil)
+)
+
+// NewSecurityLog returns a SecurityLog retaining the given number of most recent events.
+func NewSecurityLog(capacity int) *SecurityLog {
+	return &SecurityLog{
+		events:   make([]SecurityEvent, max(capacity, 1)),
+		watchers: make(map[int]func(ev SecurityEvent)),
+	}
+}
+
+func (sl *SecurityLog) RecordEvent(ev SecurityEvent) error {
+	if ev.At.IsZero() {
+		ev.At = time.Now()
+	}
+
+	sl.mu.Lock()
+	sl.events[sl.next] = ev
+	sl.next++
+	if sl.next == len(sl.events) {
+		sl.next = 0
+		sl.full = true
+	}
+	watchers := make([]func(ev SecurityEvent), 0, len(sl.watchers))
+	for _, fn := range sl.watchers {
+		watchers = append(watchers, fn)
+	}
+	sl.mu.Unlock()
+
+	for _, fn := range watchers {
+		fn(ev)
+	}
+	return nil
+}
+
+func (sl *SecurityLog) RecordImpersonation(rec ImpersonationRecord) error {
+	return sl.RecordEvent(SecurityEvent{
+		At:      time.Unix(rec.At, 0),
+		Kind:    EventImpersonationOpened,
+		UserID:  rec.UserID,
+		ActorID: rec.AdminID,
+		Detail:  rec.Reason,
+	})
+}
+
+func (sl *SecurityLog) QueryEvents(q SecurityQuery) []SecurityEvent {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	var matches []SecurityEvent
+	start, count := 0, sl.next
+	if sl.full {
+		start, count = sl.next, len(sl.events)
+	}
+	for i := 0; i < count; i++ {
+		ev := sl.events[(start+i)%len(sl.events)]
+		if q.Matches(&ev) {
+			matches = append(matches, ev)
+		}
+	}
+	if q.Limit > 0 && len(matches) > q.Limit {
+		matches = matches[len(matches)-q.Limit:]
+	}
+	return matches
+}
+
+func (sl *SecurityLog) WatchEvents(ctx task.Context, fn func(ev SecurityEvent)) {
+	sl.mu.Lock()
+	id := sl.nextID
+	sl.nextID++
+	sl.watchers[id] = fn
+	sl.mu.Unlock()
+
+	go func() {
+		<-ctx.Closing()
+		sl.mu.Lock()
+		delete(sl.watchers, id)
+		sl.mu.Unlock()
+	}()
+}
+
+// Matches returns true if the given event satisfies this query (ignoring Limit).
+func (q *SecurityQuery) Matches(ev *SecurityEvent) bool {
+	if len(q.Kinds) > 0 {
+		match := false
+		for _, kind := range q.Kinds {
+			if kind == ev.Kind {
+				match = true
+				break
+			}
+		}
+		if !match {
+			return false
+		}
+	}
+	if q.UserID.IsSet() && q.UserID != ev.UserID {
+		return false
+	}
+	if !q.Since.IsZero() && ev.At.Before(q.Since) {
+		return false
+	}
+	if !q.Until.IsZero() && !ev.At.Before(q.Until) {
+		return false
+	}
+	return true
+}
+
+// securityEventJSON is the JSONL form of a SecurityEvent, where IDs are base32 and unset fields are omitted.
+type securityEventJSON struct {
+	At       string `json:"at"`
+	Kind     string `json:"kind"`
+	UserID   string `json:"user,omitempty"`
+	ActorID  string `json:"actor,omitempty"`
+	DeviceID string `json:"device,omitempty"`
+	Remote   string `json:"remote,omitempty"`
+	Target   string `json:"target,omitempty"`
+	Detail   string `json:"detail,omitempty"`
+}
+
+func base32OrEmpty(id tag.ID) string {
+	if id.IsNil() {
+		return ""
+	}
+	return id.Base32()
+}
+
+// WriteSecurityJSONL writes the given events to w as JSON Lines, one event per line, for ingestion by a SIEM.
+func WriteSecurityJSONL(w io.Writer, events []SecurityEvent) error {
+	enc := json.NewEncoder(w)
+	for _, ev := range events {
+		err := enc.Encode(securityEventJSON{
+			At:       ev.At.UTC().Format(time.RFC3339Nano),
+			Kind:     ev.Kind,
+			UserID:   base32OrEmpty(ev.UserID),
+			ActorID:  base32OrEmpty(ev.ActorID),
+			DeviceID: base32OrEmpty(ev.DeviceID),
+			Remote:   ev.Remote,
+			Target:   base32OrEmpty(ev.Target),
+			Detail:   ev.Detail,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StreamSecurityJSONL writes each event recorded by audit to w as JSON Lines until ctx closes or a write fails.
+// Events arriving faster than w accepts are buffered up to a limit, beyond which they are dropped rather than block the audit.
+func StreamSecurityJSONL(ctx task.Context, audit SecurityAudit, w io.Writer) error {
+	events := make(chan SecurityEvent, 256)
+	audit.WatchEvents(ctx, func(ev SecurityEvent) {
+		select {
+		case events <- ev:
+		default:
+			ctx.Log().Warnf("security event stream overflow: dropped %q", ev.Kind)
+		}
+	})
+
+	for {
+		select {
+		case ev := <-events:
+			if err := WriteSecurityJSONL(w, []SecurityEvent{ev}); err != nil {
+				return err
+			}
+		case <-ctx.Closing():
+			return nil
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package amp
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+// CapabilityParam is the PinTarget URL query parameter a client uses to present a capability token.
+const CapabilityParam = "cap"
+
+// Permits returns nil if this capability allows the given request: it must target this capability's cell and select only attrs it allows.
+func (capability *Capability) Permits(req *Request) error {
+	var targetID tag.ID
+	if req.PinTarget != nil {
+		targetID = req.PinTarget.AsID()
+	}
+	if targetID != capability.CellID {
+		return ErrAccessDenied
+	}
+	if len(capability.Attrs) == 0 {
+		return nil
+	}
+	mask := req.AttrMask()
+	if len(mask) == 0 {
+		return ErrAccessDenied // all attrs requested
+	}
+	for attrID := range mask {
+		allowed := false
+		for _, ai := range capability.Attrs {
+			if ai == attrID {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return ErrAccessDenied
+		}
+	}
+	return nil
+}
+
+// RequestCapability returns the capability presented by the given request (via CapabilityParam) after verifying it permits the request.
+// Returns nil and no error if the request presents no capability, in which case the session's own access applies.
+func RequestCapability(caps CapabilityTokens, req *Request) (*Capability, error) {
+	token := req.Values.Get(CapabilityParam)
+	if token == "" {
+		return nil, nil
+	}
+	capability, err := caps.Redeem(token)
+	if err != nil {
+		return nil, err
+	}
+	if err = capability.Permits(req); err != nil {
+		return nil, err
+	}
+	return capability, nil
+}
+
+// MintCapability mints a token allowing its bearer to pin the given cell (and if given, only the given attrs) for ttl.
+// The session's user must be able to read the cell, so a session can't delegate more access than it has.
+func MintCapability(sess Session, cellID tag.ID, attrs []tag.ID, ttl time.Duration) (string, error) {
+	login := sess.Login()
+	if login.UserID == nil {
+		return "", ErrAccessDenied
+	}
+	services := ServicesOf(sess)
+	if services.AccessControl == nil || services.Capabilities == nil {
+		return "", ErrUnimplemented
+	}
+	userID := login.UserID.AsID()
+	if err := services.AccessControl.CanReadCell(userID, cellID); err != nil {
+		return "", err
+	}
+	return services.Capabilities.Mint(Capability{
+		CellID: cellID,
+		Attrs:  attrs,
+		Issuer: userID,
+	}, ttl)
+}
+
+// DefaultCapabilityMaxTTL is the HMACCapabilities.MaxTTL used when none is specified.
+const DefaultCapabilityMaxTTL = 7 * 24 * time.Hour
+
+// HMACCapabilities is a CapabilityTokens whose tokens are self-contained and signed with a host secret, so no per-token state is kept.
+type HMACCapabilities struct {
+	MaxTTL time.Duration // Mint() clamps ttl to this, bounding how long a leaked token outlives a host restart; if <= 0, DefaultCapabilityMaxTTL
+
+	key  []byte
+	mu   sync.Mutex
+	gens map[tag.ID]int64 // issuer => revocation generation
+}
+
+var _ CapabilityTokens = (*HMACCapabilities)(nil)
+
+// NewHMACCapabilities returns a CapabilityTokens signing tokens with the given secret key, which should be at least 32 random bytes.
+// Tokens remain valid across host restarts as long as the key is unchanged, but revocations do not persist.
+func NewHMACCapabilities(key []byte) *HMACCapabilities {
+	return &HMACCapabilities{
+		key:  key,
+		gens: make(map[tag.ID]int64),
+	}
+}
+
+func (hc *HMACCapabilities) Mint(capability Capability, ttl time.Duration) (string, error) {
+	if capability.CellID.IsNil() || ttl <= 0 {
+		return "", ErrCode_BadRequest.Error("capability requires a cell and ttl")
+	}
+	maxTTL := hc.MaxTTL
+	if maxTTL <= 0 {
+		maxTTL = DefaultCapabilityMaxTTL
+	}
+	ttl = min(ttl, maxTTL)
+	capability.ExpiresAt = time.Now().Add(ttl).Unix()
+
+	var b strings.Builder
+	b.WriteString(capability.CellID.Base32())
+	b.WriteByte('.')
+	b.WriteString(capability.Issuer.Base32())
+	b.WriteByte('.')
+	b.WriteString(strconv.FormatInt(capability.ExpiresAt, 36))
+	for _, attrID := range capability.Attrs {
+		b.WriteByte('.')
+		b.WriteString(attrID.Base32())
+	}
+	payload := b.String()
+	return payload + "~" + hc.signature(payload, capability.Issuer), nil
+}
+
+func (hc *HMACCapabilities) Redeem(token string) (*Capability, error) {
+	payload, sig, found := strings.Cut(token, "~")
+	if !found {
+		return nil, ErrBadCapability
+	}
+	fields := strings.Split(payload, ".")
+	if len(fields) < 3 {
+		return nil, ErrBadCapability
+	}
+
+	capability := &Capability{}
+	var err error
+	if capability.CellID, err = tag.FromBase32(fields[0]); err != nil {
+		return nil, ErrBadCapability
+	}
+	if capability.Issuer, err = tag.FromBase32(fields[1]); err != nil {
+		return nil, ErrBadCapability
+	}
+	if capability.ExpiresAt, err = strconv.ParseInt(fields[2], 36, 64); err != nil {
+		return nil, ErrBadCapability
+	}
+	for _, field := range fields[3:] {
+		attrID, err := tag.FromBase32(field)
+		if err != nil {
+			return nil, ErrBadCapability
+		}
+		capability.Attrs = append(capability.Attrs, attrID)
+	}
+
+	if !hmac.Equal([]byte(sig), []byte(hc.signature(payload, capability.Issuer))) {
+		return nil, ErrBadCapability
+	}
+	if time.Now().Unix() > capability.ExpiresAt {
+		return nil, ErrCapabilityExpired
+	}
+	return capability, nil
+}
+
+func (hc *HMACCapabilities) RevokeIssuer(issuer tag.ID) {
+	hc.mu.Lock()
+	hc.gens[issuer]++
+	hc.mu.Unlock()
+}
+
+// The issuer's revocation generation is signed but not sent, so RevokeIssuer() invalidates prior tokens.
+func (hc *HMACCapabilities) signature(payload string, issuer tag.ID) string {
+	hc.mu.Lock()
+	gen := hc.gens[issuer]
+	hc.mu.Unlock()
+
+	mac := hmac.New(sha256.New, hc.key)
+	fmt.Fprintf(mac, "%s\n%d", payload, gen)
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
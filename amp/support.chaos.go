@@ -0,0 +1,217 @@
+package amp
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// ChaosOpts specifies the faults a Chaos injects.  Rates are probabilities in [0, 1].
+type ChaosOpts struct {
+	Seed      int64         // the same seed (and the same order of sessions, apps, and requests) injects the same faults
+	DropRate  float64       // per tx, the chance a Transport closes rather than sending or delivering it
+	DelayRate float64       // per tx, the chance it is delayed by up to MaxDelay
+	MaxDelay  time.Duration // if <= 0, 500ms
+	PanicRate float64       // per request, the chance an app instance panics in ServeRequest
+}
+
+// FaultKind is a kind of fault injected by a Chaos.
+type FaultKind int8
+
+const (
+	FaultDrop FaultKind = iota + 1
+	FaultDelay
+	FaultPanic
+)
+
+func (kind FaultKind) String() string {
+	switch kind {
+	case FaultDrop:
+		return "drop"
+	case FaultDelay:
+		return "delay"
+	case FaultPanic:
+		return "panic"
+	default:
+		return fmt.Sprintf("FaultKind(%d)", int8(kind))
+	}
+}
+
+// Fault is a fault injected by a Chaos.
+type Fault struct {
+	Kind  FaultKind
+	Label string        // the Transport's label or the app's UID
+	Seq   uint64        // the tx or request ordinal within the Transport or app instance
+	Delay time.Duration // if FaultDelay
+}
+
+// ChaosPanic is the value an app instance panics with when a Chaos injects FaultPanic.
+type ChaosPanic struct {
+	Fault
+}
+
+func (p ChaosPanic) Error() string {
+	return fmt.Sprintf("chaos: injected panic in %s (request %d)", p.Label, p.Seq)
+}
+
+// Chaos is a test-only host mode injecting transport drops, delayed txs, and app panics as determined by a seed,
+// so an app author can deterministically verify their reconnect and retry behavior.
+//
+// A test host wraps each session's Transport via Wrap() and each registered App via WrapApp().
+// Each Transport and app instance draws from its own random source (derived from the seed and the order in which it was wrapped),
+// so concurrent sessions do not perturb each other's faults.
+type Chaos struct {
+	opts ChaosOpts
+
+	mu     sync.Mutex
+	wraps  int64 // sources derived so far
+	faults []Fault
+}
+
+// NewChaos returns a Chaos injecting faults as specified.
+func NewChaos(opts ChaosOpts) *Chaos {
+	if opts.MaxDelay <= 0 {
+		opts.MaxDelay = 500 * time.Millisecond
+	}
+	return &Chaos{
+		opts: opts,
+	}
+}
+
+// Faults returns the faults injected so far, in the order injected.
+func (c *Chaos) Faults() []Fault {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]Fault(nil), c.faults...)
+}
+
+func (c *Chaos) newSource() *rand.Rand {
+	c.mu.Lock()
+	c.wraps++
+	seed := c.opts.Seed ^ (c.wraps * 0x5851F42D4C957F2D)
+	c.mu.Unlock()
+	return rand.New(rand.NewSource(seed))
+}
+
+func (c *Chaos) record(fault Fault) {
+	c.mu.Lock()
+	c.faults = append(c.faults, fault)
+	c.mu.Unlock()
+}
+
+// Wrap returns a Transport that injects drops and delays into the txs sent and received over via.
+// A dropped tx closes via, so both sides observe ErrStreamClosed as if the connection was lost.
+func (c *Chaos) Wrap(via Transport) Transport {
+	return &chaosTransport{
+		Transport: via,
+		chaos:     c,
+		rng:       c.newSource(),
+	}
+}
+
+type chaosTransport struct {
+	Transport
+	chaos *Chaos
+
+	mu  sync.Mutex
+	rng *rand.Rand
+	seq uint64
+}
+
+// roll decides the fault (if any) for the next tx.
+func (t *chaosTransport) roll() (fault Fault) {
+	opts := &t.chaos.opts
+	t.mu.Lock()
+	t.seq++
+	fault.Seq = t.seq
+	fault.Label = t.Transport.Label()
+	switch r := t.rng.Float64(); {
+	case r < opts.DropRate:
+		fault.Kind = FaultDrop
+	case r < opts.DropRate+opts.DelayRate:
+		fault.Kind = FaultDelay
+		fault.Delay = time.Duration(t.rng.Int63n(int64(opts.MaxDelay)) + 1)
+	}
+	t.mu.Unlock()
+
+	if fault.Kind != 0 {
+		t.chaos.record(fault)
+	}
+	return fault
+}
+
+func (t *chaosTransport) SendTx(tx *TxMsg) error {
+	switch fault := t.roll(); fault.Kind {
+	case FaultDrop:
+		t.Transport.Close() // as with any SendTx, the caller retains tx
+		return ErrStreamClosed
+	case FaultDelay:
+		time.Sleep(fault.Delay)
+	}
+	return t.Transport.SendTx(tx)
+}
+
+func (t *chaosTransport) RecvTx() (*TxMsg, error) {
+	tx, err := t.Transport.RecvTx()
+	if err != nil {
+		return tx, err
+	}
+	switch fault := t.roll(); fault.Kind {
+	case FaultDrop:
+		tx.ReleaseRef()
+		t.Transport.Close()
+		return nil, ErrStreamClosed
+	case FaultDelay:
+		time.Sleep(fault.Delay)
+	}
+	return tx, nil
+}
+
+// WrapApp returns a copy of the given App whose instances panic (with a ChaosPanic) in ServeRequest at ChaosOpts.PanicRate.
+// Optional interfaces an instance implements (e.g. PinObserver) are hidden by the wrapper.
+func (c *Chaos) WrapApp(app *App) *App {
+	wrapped := *app
+	wrapped.NewAppInstance = func(ctx AppContext) (AppInstance, error) {
+		inst, err := app.NewAppInstance(ctx)
+		if err != nil || inst == nil {
+			return inst, err
+		}
+		return &chaosInstance{
+			AppInstance: inst,
+			chaos:       c,
+			label:       app.AppSpec.Canonic,
+			rng:         c.newSource(),
+		}, nil
+	}
+	return &wrapped
+}
+
+type chaosInstance struct {
+	AppInstance
+	chaos *Chaos
+	label string
+
+	mu  sync.Mutex
+	rng *rand.Rand
+	seq uint64
+}
+
+func (inst *chaosInstance) ServeRequest(req Requester) (Pin, error) {
+	inst.mu.Lock()
+	inst.seq++
+	fault := Fault{
+		Label: inst.label,
+		Seq:   inst.seq,
+	}
+	if inst.rng.Float64() < inst.chaos.opts.PanicRate {
+		fault.Kind = FaultPanic
+	}
+	inst.mu.Unlock()
+
+	if fault.Kind == FaultPanic {
+		inst.chaos.record(fault)
+		panic(ChaosPanic{fault})
+	}
+	return inst.AppInstance.ServeRequest(req)
+}
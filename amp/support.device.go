@@ -0,0 +1,172 @@
+package amp
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// NewLoginChallenge returns a LoginChallenge bearing a random hash, which an enrolled device signs to prove possession of its key.
+func NewLoginChallenge() *LoginChallenge {
+	hash := make([]byte, 32)
+	rand.Read(hash)
+	return &LoginChallenge{
+		Hash: hash,
+	}
+}
+
+// SignChallenge returns the LoginResponse of a device holding the given private key -- used by clients.
+func SignChallenge(key ed25519.PrivateKey, challenge *LoginChallenge) *LoginResponse {
+	return &LoginResponse{
+		HashResponse: ed25519.Sign(key, challenge.Hash),
+	}
+}
+
+// NewDeviceRegistry returns an in-memory DeviceRegistry.
+func NewDeviceRegistry() DeviceRegistry {
+	return &deviceRegistry{
+		devices:  make(map[tag.ID]*Device),
+		watchers: make(map[int]deviceWatcher),
+	}
+}
+
+// Implements DeviceRegistry
+type deviceRegistry struct {
+	mu       sync.Mutex
+	devices  map[tag.ID]*Device // deviceID => device
+	watchers map[int]deviceWatcher
+	nextID   int
+}
+
+type deviceWatcher struct {
+	userID tag.ID // if nil, all users
+	fn     func(device *Device)
+}
+
+func (reg *deviceRegistry) Enroll(device *Device) error {
+	if device.DeviceID == nil || device.UserID == nil || len(device.PublicKey) != ed25519.PublicKeySize {
+		return ErrCode_BadRequest.Error("device requires an ID, user, and ed25519 public key")
+	}
+	deviceID, userID := device.DeviceID.AsID(), device.UserID.AsID()
+
+	reg.mu.Lock()
+	if prev := reg.devices[deviceID]; prev != nil {
+		reg.mu.Unlock()
+		if prev.UserID.AsID() != userID || !bytes.Equal(prev.PublicKey, device.PublicKey) || prev.RevokedAt != 0 {
+			return ErrDeviceConflict
+		}
+		return nil
+	}
+
+	// Devices are replaced rather than modified, so values returned by ListDevices() remain stable
+	enrolled := *device
+	enrolled.EnrolledAt = time.Now().Unix()
+	enrolled.LastSeenAt = enrolled.EnrolledAt
+	enrolled.RevokedAt = 0
+	reg.devices[deviceID] = &enrolled
+	watchers := reg.watchersOf(userID)
+	reg.mu.Unlock()
+
+	for _, fn := range watchers {
+		fn(&enrolled)
+	}
+	return nil
+}
+
+func (reg *deviceRegistry) Authenticate(deviceID tag.ID, challenge *LoginChallenge, response *LoginResponse) (*Device, error) {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
+	device := reg.devices[deviceID]
+	if device == nil {
+		return nil, ErrDeviceNotFound
+	}
+	if device.RevokedAt != 0 {
+		return nil, ErrDeviceRevoked
+	}
+	if challenge == nil || response == nil || !ed25519.Verify(device.PublicKey, challenge.Hash, response.HashResponse) {
+		return nil, ErrCode_AuthFailed.Error("device signature invalid")
+	}
+
+	seen := *device
+	seen.LastSeenAt = time.Now().Unix()
+	reg.devices[deviceID] = &seen
+	return &seen, nil
+}
+
+func (reg *deviceRegistry) ListDevices(userID tag.ID) []*Device {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
+	var devices []*Device
+	for _, device := range reg.devices {
+		if device.UserID.AsID() == userID {
+			devices = append(devices, device)
+		}
+	}
+	sort.Slice(devices, func(i, j int) bool {
+		if devices[i].LastSeenAt != devices[j].LastSeenAt {
+			return devices[i].LastSeenAt > devices[j].LastSeenAt
+		}
+		return devices[i].DeviceID.AsLiteral() < devices[j].DeviceID.AsLiteral()
+	})
+	return devices
+}
+
+func (reg *deviceRegistry) RevokeDevice(userID, deviceID tag.ID) error {
+	reg.mu.Lock()
+	device := reg.devices[deviceID]
+	if device == nil || device.UserID.AsID() != userID {
+		reg.mu.Unlock()
+		return ErrDeviceNotFound
+	}
+	if device.RevokedAt != 0 {
+		reg.mu.Unlock()
+		return nil
+	}
+	revoked := *device
+	revoked.RevokedAt = time.Now().Unix()
+	reg.devices[deviceID] = &revoked
+	watchers := reg.watchersOf(userID)
+	reg.mu.Unlock()
+
+	for _, fn := range watchers {
+		fn(&revoked)
+	}
+	return nil
+}
+
+// caller holds reg.mu
+func (reg *deviceRegistry) watchersOf(userID tag.ID) []func(device *Device) {
+	var watchers []func(device *Device)
+	for _, wi := range reg.watchers {
+		if wi.userID.IsNil() || wi.userID == userID {
+			watchers = append(watchers, wi.fn)
+		}
+	}
+	return watchers
+}
+
+func (reg *deviceRegistry) WatchDevices(ctx task.Context, userID tag.ID, fn func(device *Device)) {
+	reg.mu.Lock()
+	id := reg.nextID
+	reg.nextID++
+	reg.watchers[id] = deviceWatcher{
+		userID: userID,
+		fn:     fn,
+	}
+	reg.mu.Unlock()
+
+	go func() {
+		<-ctx.Closing()
+		reg.mu.Lock()
+		delete(reg.watchers, id)
+		reg.mu.Unlock()
+	}()
+}
@@ -0,0 +1,312 @@
+package amp
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+// FlightRecorderOpts specifies a FlightRecorder.
+type FlightRecorderOpts struct {
+	Capacity    int // txs retained per session; if <= 0, 256
+	MaxOps      int // op headers retained per tx; if <= 0, 16
+	SampleEvery int // if > 0, the serialized payload of every Nth tx is also retained
+	MaxPayload  int // bytes retained per sampled payload; if <= 0, 16k
+	KeepClosed  int // logs of closed sessions retained, so a desync can be examined after a client disconnects; if <= 0, 16
+}
+
+// FlightRecorder retains the most recent txs sent and received over each session's Transport, so an intermittent desync can be examined after the fact.
+// Recording is always on and cheap: each tx costs a fixed-size ring entry holding its envelope and op headers, while payloads are only retained when sampled.
+//
+// A FlightRecorder is an http.Handler serving Dump() as JSON (optionally filtered by ?session={label}), typically mounted on an admin listener.
+type FlightRecorder struct {
+	opts FlightRecorderOpts
+
+	mu     sync.Mutex
+	open   map[*flightRing]struct{}
+	closed []*flightRing // oldest first
+}
+
+// FlightEntry is a recorded tx.
+type FlightEntry struct {
+	Seq       uint64    // order of this tx within its session
+	At        time.Time // when the tx was sent or received
+	Sent      bool      // true if sent to the client, false if received from it
+	GenesisID tag.ID
+	ContextID tag.ID
+	Status    OpStatus
+	OpCount   int // number of ops in the tx, which may exceed len(Ops)
+	DataLen   int // bytes of op values
+	Ops       []FlightOp
+	Payload   []byte // if sampled, the serialized tx (truncated to MaxPayload)
+}
+
+// FlightOp is the header of a recorded TxOp.
+type FlightOp struct {
+	OpCode TxOpCode
+	TxOpID
+}
+
+// FlightLog is the recorded txs of a session, oldest first.
+type FlightLog struct {
+	Session  string // the Transport's label
+	Opened   time.Time
+	Closed   time.Time // zero if the session is open
+	Recorded uint64    // txs recorded, which may exceed len(Entries)
+	Entries  []FlightEntry
+}
+
+// NewFlightRecorder returns a FlightRecorder with no sessions.
+func NewFlightRecorder(opts FlightRecorderOpts) *FlightRecorder {
+	if opts.Capacity <= 0 {
+		opts.Capacity = 256
+	}
+	if opts.MaxOps <= 0 {
+		opts.MaxOps = 16
+	}
+	if opts.MaxPayload <= 0 {
+		opts.MaxPayload = 16 << 10
+	}
+	if opts.KeepClosed <= 0 {
+		opts.KeepClosed = 16
+	}
+	return &FlightRecorder{
+		opts: opts,
+		open: make(map[*flightRing]struct{}),
+	}
+}
+
+// Wrap returns a Transport recording each tx sent and received over via, logged under via.Label() until closed.
+func (fr *FlightRecorder) Wrap(via Transport) Transport {
+	ring := &flightRing{
+		fr:      fr,
+		label:   via.Label(),
+		opened:  time.Now(),
+		entries: make([]FlightEntry, fr.opts.Capacity),
+	}
+	fr.mu.Lock()
+	fr.open[ring] = struct{}{}
+	fr.mu.Unlock()
+	return &flightTransport{
+		Transport: via,
+		ring:      ring,
+	}
+}
+
+// Dump returns a snapshot of the logs of open sessions and recently closed sessions.
+func (fr *FlightRecorder) Dump() []FlightLog {
+	fr.mu.Lock()
+	rings := make([]*flightRing, 0, len(fr.closed)+len(fr.open))
+	rings = append(rings, fr.closed...)
+	for ring := range fr.open {
+		rings = append(rings, ring)
+	}
+	fr.mu.Unlock()
+
+	logs := make([]FlightLog, len(rings))
+	for i, ring := range rings {
+		logs[i] = ring.snapshot()
+	}
+	return logs
+}
+
+func (fr *FlightRecorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	logs := fr.Dump()
+	if session := r.URL.Query().Get("session"); session != "" {
+		var matches []FlightLog
+		for _, log := range logs {
+			if log.Session == session {
+				matches = append(matches, log)
+			}
+		}
+		logs = matches
+	}
+	w.Header().Set("Content-Type", "application/json")
+	WriteFlightJSON(w, logs)
+}
+
+func (fr *FlightRecorder) onClosed(ring *flightRing) {
+	fr.mu.Lock()
+	defer fr.mu.Unlock()
+	if _, open := fr.open[ring]; !open {
+		return
+	}
+	delete(fr.open, ring)
+	fr.closed = append(fr.closed, ring)
+	if over := len(fr.closed) - fr.opts.KeepClosed; over > 0 {
+		fr.closed = append(fr.closed[:0], fr.closed[over:]...)
+	}
+}
+
+// flightRing is the ring buffer of a session's recorded txs.
+type flightRing struct {
+	fr     *FlightRecorder
+	label  string
+	opened time.Time
+
+	mu      sync.Mutex
+	closed  time.Time
+	entries []FlightEntry
+	seq     uint64 // txs recorded; the next entry is entries[seq % len(entries)]
+}
+
+func (ring *flightRing) record(tx *TxMsg, sent bool) {
+	opts := &ring.fr.opts
+	ring.mu.Lock()
+	defer ring.mu.Unlock()
+
+	entry := &ring.entries[ring.seq%uint64(len(ring.entries))]
+	ops := entry.Ops[:0] // reuse the evicted entry's buffers
+	payload := entry.Payload[:0]
+	*entry = FlightEntry{
+		Seq:       ring.seq,
+		At:        time.Now(),
+		Sent:      sent,
+		GenesisID: tx.GenesisID(),
+		ContextID: tx.ContextID(),
+		Status:    tx.Status,
+		OpCount:   len(tx.Ops),
+		DataLen:   len(tx.DataStore),
+	}
+	for i := range tx.Ops[:min(len(tx.Ops), opts.MaxOps)] {
+		ops = append(ops, FlightOp{
+			OpCode: tx.Ops[i].OpCode,
+			TxOpID: tx.Ops[i].TxOpID,
+		})
+	}
+	entry.Ops = ops
+	if opts.SampleEvery > 0 && ring.seq%uint64(opts.SampleEvery) == 0 {
+		tx.MarshalToBuffer(&payload)
+		entry.Payload = payload[:min(len(payload), opts.MaxPayload)]
+	}
+	ring.seq++
+}
+
+func (ring *flightRing) snapshot() FlightLog {
+	ring.mu.Lock()
+	defer ring.mu.Unlock()
+
+	log := FlightLog{
+		Session:  ring.label,
+		Opened:   ring.opened,
+		Closed:   ring.closed,
+		Recorded: ring.seq,
+	}
+	n := uint64(len(ring.entries))
+	start := uint64(0)
+	if ring.seq > n {
+		start = ring.seq - n
+	}
+	log.Entries = make([]FlightEntry, 0, ring.seq-start)
+	for seq := start; seq < ring.seq; seq++ {
+		entry := ring.entries[seq%n]
+		entry.Ops = append([]FlightOp(nil), entry.Ops...)
+		if entry.Payload != nil {
+			entry.Payload = append([]byte(nil), entry.Payload...)
+		}
+		log.Entries = append(log.Entries, entry)
+	}
+	return log
+}
+
+// flightTransport records the txs passing through a Transport.
+type flightTransport struct {
+	Transport
+	ring      *flightRing
+	closeOnce sync.Once
+}
+
+func (t *flightTransport) SendTx(tx *TxMsg) error {
+	t.ring.record(tx, true) // before sending, since the transport may release tx
+	return t.Transport.SendTx(tx)
+}
+
+func (t *flightTransport) SendTxs(txs []*TxMsg) error {
+	for _, tx := range txs {
+		t.ring.record(tx, true)
+	}
+	return SendTxBatch(t.Transport, txs)
+}
+
+func (t *flightTransport) RecvTx() (*TxMsg, error) {
+	tx, err := t.Transport.RecvTx()
+	if err == nil {
+		t.ring.record(tx, false)
+	}
+	return tx, err
+}
+
+func (t *flightTransport) Close() error {
+	t.closeOnce.Do(func() {
+		t.ring.mu.Lock()
+		t.ring.closed = time.Now()
+		t.ring.mu.Unlock()
+		t.ring.fr.onClosed(t.ring)
+	})
+	return t.Transport.Close()
+}
+
+// flightEntryJSON is the JSON form of a FlightEntry, where IDs are base32 and unset fields are omitted.
+type flightEntryJSON struct {
+	Seq       uint64   `json:"seq"`
+	At        string   `json:"at"`
+	Dir       string   `json:"dir"` // "send" or "recv"
+	GenesisID string   `json:"genesis,omitempty"`
+	ContextID string   `json:"context,omitempty"`
+	Status    string   `json:"status"`
+	OpCount   int      `json:"opCount"`
+	DataLen   int      `json:"dataLen"`
+	Ops       []string `json:"ops,omitempty"` // "{op} {cell}/{attr}/{item}"
+	Payload   []byte   `json:"payload,omitempty"`
+}
+
+type flightLogJSON struct {
+	Session  string            `json:"session"`
+	Opened   string            `json:"opened"`
+	Closed   string            `json:"closed,omitempty"`
+	Recorded uint64            `json:"recorded"`
+	Entries  []flightEntryJSON `json:"entries"`
+}
+
+// WriteFlightJSON writes the given logs to w as a JSON array, e.g. to attach to a bug report.
+func WriteFlightJSON(w io.Writer, logs []FlightLog) error {
+	out := make([]flightLogJSON, len(logs))
+	for i, log := range logs {
+		out[i] = flightLogJSON{
+			Session:  log.Session,
+			Opened:   log.Opened.UTC().Format(time.RFC3339Nano),
+			Recorded: log.Recorded,
+			Entries:  make([]flightEntryJSON, len(log.Entries)),
+		}
+		if !log.Closed.IsZero() {
+			out[i].Closed = log.Closed.UTC().Format(time.RFC3339Nano)
+		}
+		for j, entry := range log.Entries {
+			ej := flightEntryJSON{
+				Seq:       entry.Seq,
+				At:        entry.At.UTC().Format(time.RFC3339Nano),
+				Dir:       "recv",
+				GenesisID: base32OrEmpty(entry.GenesisID),
+				ContextID: base32OrEmpty(entry.ContextID),
+				Status:    entry.Status.String(),
+				OpCount:   entry.OpCount,
+				DataLen:   entry.DataLen,
+				Payload:   entry.Payload,
+			}
+			if entry.Sent {
+				ej.Dir = "send"
+			}
+			for _, op := range entry.Ops {
+				ej.Ops = append(ej.Ops, op.OpCode.String()+" "+base32OrEmpty(op.CellID)+"/"+base32OrEmpty(op.AttrID)+"/"+base32OrEmpty(op.ItemID))
+			}
+			out[i].Entries[j] = ej
+		}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
@@ -0,0 +1,184 @@
+package amp
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/blob"
+)
+
+// HealthCheck is a named probe of something a host needs in order to serve.
+type HealthCheck struct {
+	Name     string
+	Liveness bool                            // if set, a failure means the host should be restarted; otherwise only that it should not receive traffic
+	Check    func(ctx context.Context) error // returns nil if healthy; ctx expires after HealthChecks.Timeout
+}
+
+// HealthResult is the outcome of a HealthCheck.
+type HealthResult struct {
+	Name     string
+	Liveness bool
+	Err      error // nil if healthy
+	Latency  time.Duration
+}
+
+// HealthReport is the outcome of running a host's health checks.
+type HealthReport struct {
+	At      time.Time
+	Live    bool // all liveness checks passed
+	Ready   bool // all checks passed
+	Results []HealthResult
+}
+
+// HealthChecks is the set of checks gating a host's liveness and readiness (e.g. for Kubernetes probes or a load balancer) -- concurrency safe.
+type HealthChecks struct {
+	Timeout time.Duration // longest a check may take before it fails; if <= 0, 5s
+
+	mu     sync.Mutex
+	checks []HealthCheck
+}
+
+// Add adds the given checks.
+func (hc *HealthChecks) Add(checks ...HealthCheck) {
+	hc.mu.Lock()
+	hc.checks = append(hc.checks, checks...)
+	hc.mu.Unlock()
+}
+
+// Run runs all checks concurrently and returns their results in the order added.
+func (hc *HealthChecks) Run(ctx context.Context) HealthReport {
+	hc.mu.Lock()
+	checks := append([]HealthCheck(nil), hc.checks...)
+	timeout := hc.Timeout
+	hc.mu.Unlock()
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+
+	report := HealthReport{
+		At:      time.Now(),
+		Live:    true,
+		Ready:   true,
+		Results: make([]HealthResult, len(checks)),
+	}
+	wg := sync.WaitGroup{}
+	for i, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			start := time.Now()
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			done := make(chan error, 1)
+			go func() {
+				done <- check.Check(ctx)
+			}()
+			var err error
+			select {
+			case err = <-done:
+			case <-ctx.Done():
+				err = ErrCode_Timeout.Errorf("health check %q timed out", check.Name)
+			}
+			report.Results[i] = HealthResult{
+				Name:     check.Name,
+				Liveness: check.Liveness,
+				Err:      err,
+				Latency:  time.Since(start),
+			}
+		}()
+	}
+	wg.Wait()
+
+	for _, result := range report.Results {
+		if result.Err != nil {
+			report.Ready = false
+			if result.Liveness {
+				report.Live = false
+			}
+		}
+	}
+	return report
+}
+
+// CheckHostStarted fails once the given host is shutting down, so traffic drains before it exits.
+func CheckHostStarted(host Host) HealthCheck {
+	return HealthCheck{
+		Name: "host",
+		Check: func(ctx context.Context) error {
+			if host == nil {
+				return ErrCode_NotReady.Error("host not started")
+			}
+			select {
+			case <-host.Closing():
+				return ErrShuttingDown
+			default:
+				return nil
+			}
+		},
+	}
+}
+
+// CheckRegistryPopulated fails until the given registry has at least minApps apps registered (or at least one if minApps <= 0).
+func CheckRegistryPopulated(reg Registry, minApps int) HealthCheck {
+	return HealthCheck{
+		Name: "registry",
+		Check: func(ctx context.Context) error {
+			if n := len(reg.ListApps()); n < max(minApps, 1) {
+				return ErrCode_NotReady.Errorf("%d apps registered", n)
+			}
+			return nil
+		},
+	}
+}
+
+// CheckStorageReachable fails if the given blob store cannot be queried, where a missing probe blob is healthy.
+func CheckStorageReachable(name string, store blob.Store) HealthCheck {
+	return HealthCheck{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			_, err := store.Stat(ctx, "healthz")
+			if err != nil && !errors.Is(err, blob.ErrNotFound) {
+				return ErrCode_StorageFailure.Wrap(err)
+			}
+			return nil
+		},
+	}
+}
+
+// CheckHeartbeat is a liveness check failing if the given heartbeat has not beat within maxAge (e.g. a session accept loop that has stalled).
+func CheckHeartbeat(name string, hb *Heartbeat, maxAge time.Duration) HealthCheck {
+	return HealthCheck{
+		Name:     name,
+		Liveness: true,
+		Check: func(ctx context.Context) error {
+			if age := hb.Age(); age > maxAge {
+				return ErrCode_NotReady.Errorf("no heartbeat for %v", age.Truncate(time.Millisecond))
+			}
+			return nil
+		},
+	}
+}
+
+// Heartbeat records when a long-running loop last made progress -- see CheckHeartbeat.
+// The loop calls Beat() each iteration and while idle (e.g. on a timer), so a stall is distinguishable from a lack of work.
+type Heartbeat struct {
+	last atomic.Int64 // UnixNano
+}
+
+// Beat records that the loop is making progress.
+func (hb *Heartbeat) Beat() {
+	hb.last.Store(time.Now().UnixNano())
+}
+
+// Age returns the time since the last Beat(), or a very long duration if there has been none.
+func (hb *Heartbeat) Age() time.Duration {
+	last := hb.last.Load()
+	if last == 0 {
+		return time.Duration(1<<63 - 1)
+	}
+	return time.Since(time.Unix(0, last))
+}
@@ -0,0 +1,85 @@
+package amp
+
+import (
+	"sync"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+// ImpersonationRecord is the audit entry of a session opened by an admin as another user.
+type ImpersonationRecord struct {
+	AdminID tag.ID // user who opened the session
+	UserID  tag.ID // user impersonated
+	Reason  string // given by the admin (e.g. a support ticket)
+	At      int64  // unix seconds
+}
+
+// ImpersonationAudit records impersonation sessions -- concurrency safe.
+type ImpersonationAudit interface {
+
+	// Records the given entry, where an error prevents the impersonation session from starting.
+	RecordImpersonation(rec ImpersonationRecord) error
+}
+
+// ImpersonateLogin returns the Login of a session signed in as the given user on behalf of the given admin, as used by Impersonator.StartImpersonation().
+// The admin must hold RoleImpersonate and not itself be impersonating, a reason is required, and the impersonation is recorded before the Login is returned.
+func ImpersonateLogin(ac AccessControl, audit ImpersonationAudit, admin Login, userID tag.ID, reason string) (Login, error) {
+	if admin.UserID == nil || admin.ImpersonatorID != nil {
+		return Login{}, ErrAccessDenied
+	}
+	adminID := admin.UserID.AsID()
+	if !ac.HasRole(adminID, RoleImpersonate) {
+		return Login{}, ErrAccessDenied
+	}
+	if reason == "" {
+		return Login{}, ErrCode_BadRequest.Error("impersonation requires a reason")
+	}
+	if userID.IsNil() {
+		return Login{}, ErrCode_BadRequest.Error("missing user to impersonate")
+	}
+
+	err := audit.RecordImpersonation(ImpersonationRecord{
+		AdminID: adminID,
+		UserID:  userID,
+		Reason:  reason,
+		At:      time.Now().Unix(),
+	})
+	if err != nil {
+		return Login{}, err
+	}
+
+	login := Login{
+		UserID:         &Tag{},
+		DeviceID:       admin.DeviceID,
+		HostAddress:    admin.HostAddress,
+		ImpersonatorID: admin.UserID,
+	}
+	login.UserID.SetID(userID)
+	return login, nil
+}
+
+// IsImpersonated returns true if this Login is of a session opened by an admin as another user -- see ImpersonatorID.
+func (login *Login) IsImpersonated() bool {
+	return login.ImpersonatorID != nil
+}
+
+// ImpersonationLog is an in-memory ImpersonationAudit.
+type ImpersonationLog struct {
+	mu      sync.Mutex
+	records []ImpersonationRecord
+}
+
+func (il *ImpersonationLog) RecordImpersonation(rec ImpersonationRecord) error {
+	il.mu.Lock()
+	il.records = append(il.records, rec)
+	il.mu.Unlock()
+	return nil
+}
+
+// Records returns the recorded impersonations, oldest first.
+func (il *ImpersonationLog) Records() []ImpersonationRecord {
+	il.mu.Lock()
+	defer il.mu.Unlock()
+	return append([]ImpersonationRecord(nil), il.records...)
+}
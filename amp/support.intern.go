@@ -0,0 +1,107 @@
+package amp
+
+import (
+	"strings"
+	"sync"
+	"unsafe"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/symbol"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+// Interner is implemented by a tag.Value whose decoded strings can be shared via a StringInterner.
+type Interner interface {
+	InternStrings(in *StringInterner)
+}
+
+// StringInterner shares the memory of equal strings decoded over a session (e.g. attr names and content types), keyed through the session's symbol.Table -- concurrency safe.
+//
+// Over a long session, the same strings arrive in txs again and again, so interning them means each is retained once rather than once per decoded value.
+// Only low-cardinality fields should be interned, and once maxStrings are held, only strings already interned are shared so memory stays bounded.
+type StringInterner struct {
+	table      symbol.Table
+	maxLen     int
+	maxStrings int
+	mu         sync.RWMutex
+	strs       map[symbol.ID]string
+}
+
+// NewStringInterner returns a StringInterner keyed through the given table, interning strings no longer than maxLen (if <= 0, 256), and at most maxStrings of them (if <= 0, 65536).
+func NewStringInterner(table symbol.Table, maxLen, maxStrings int) *StringInterner {
+	if maxLen <= 0 {
+		maxLen = 256
+	}
+	if maxStrings <= 0 {
+		maxStrings = 1 << 16
+	}
+	return &StringInterner{
+		table:      table,
+		maxLen:     maxLen,
+		maxStrings: maxStrings,
+		strs:       make(map[symbol.ID]string),
+	}
+}
+
+// Intern returns the shared instance of the given string (or str itself if it is too long to be worth interning or the interner is full).
+func (in *StringInterner) Intern(str string) string {
+	if in == nil || len(str) == 0 || len(str) > in.maxLen {
+		return str
+	}
+
+	in.mu.RLock()
+	full := len(in.strs) >= in.maxStrings
+	in.mu.RUnlock()
+
+	// GetSymbolID never retains the value buffer, so it can alias str
+	symID, _ := in.table.GetSymbolID(unsafe.Slice(unsafe.StringData(str), len(str)), !full)
+	if symID == 0 {
+		return str
+	}
+
+	in.mu.RLock()
+	shared, ok := in.strs[symID]
+	in.mu.RUnlock()
+	if ok {
+		return shared
+	}
+
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	if shared, ok = in.strs[symID]; ok {
+		return shared
+	}
+	if len(in.strs) >= in.maxStrings {
+		return str
+	}
+	shared = strings.Clone(str)
+	in.strs[symID] = shared
+	return shared
+}
+
+// UnmarshalOpValueInterned is UnmarshalOpValue, followed by interning the strings of out if it is an Interner.
+func (tx *TxMsg) UnmarshalOpValueInterned(idx int, out tag.Value, in *StringInterner) error {
+	if err := tx.UnmarshalOpValue(idx, out); err != nil {
+		return err
+	}
+	if interner, ok := out.(Interner); ok && in != nil {
+		interner.InternStrings(in)
+	}
+	return nil
+}
+
+// UID and Text are typically unique per item, so only ContentType and URL (e.g. attr specs and shared paths) are interned.
+func (v *Tag) InternStrings(in *StringInterner) {
+	v.ContentType = in.Intern(v.ContentType)
+	v.URL = in.Intern(v.URL)
+}
+
+func (v *Tags) InternStrings(in *StringInterner) {
+	if v.ID != nil {
+		v.ID.InternStrings(in)
+	}
+	for _, sub := range v.SubTags {
+		if sub != nil {
+			sub.InternStrings(in)
+		}
+	}
+}
@@ -0,0 +1,319 @@
+package amp
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+// SealVersion is the format of content sealed by SealTo():
+//
+//	version (1) | ephemeral X25519 key (32) | recipient count (uvarint) | recipients... | nonce (12) | AES-256-GCM ciphertext
+//
+// where each recipient is its UserID (24) followed by the content key wrapped for that recipient (nonce (12) | AES-GCM ciphertext (48)).
+// The header (everything before the content nonce) is authenticated with the content, so the recipient list can't be altered.
+const SealVersion = 1
+
+const (
+	sealKeySize     = 32
+	sealNonceSize   = 12
+	sealWrappedSize = sealNonceSize + sealKeySize + 16
+)
+
+// VerifySignature returns nil if sig is the signature of msg by the given key's user, otherwise ErrCode_AuthFailed.
+func VerifySignature(key *UserKey, msg, sig []byte) error {
+	if len(key.SigningKey) != ed25519.PublicKeySize || !ed25519.Verify(key.SigningKey, msg, sig) {
+		return ErrCode_AuthFailed.Error("signature invalid")
+	}
+	return nil
+}
+
+// SealTo seals plaintext so that only the users of the given keys can open it (see OpenSealed), whether their keys are host-held or client-held.
+func SealTo(recipients []*UserKey, plaintext []byte) ([]byte, error) {
+	if len(recipients) == 0 {
+		return nil, ErrCode_BadRequest.Error("no recipients to seal to")
+	}
+	ephemeral, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, ErrCode_InternalErr.Wrap(err)
+	}
+	contentKey := make([]byte, sealKeySize)
+	rand.Read(contentKey)
+
+	sealed := []byte{SealVersion}
+	sealed = append(sealed, ephemeral.PublicKey().Bytes()...)
+	sealed = binary.AppendUvarint(sealed, uint64(len(recipients)))
+	for _, key := range recipients {
+		if key.UserID == nil {
+			return nil, ErrCode_BadRequest.Error("recipient key missing user")
+		}
+		wrapKey, err := sealWrapKey(ephemeral, key.SealingKey)
+		if err != nil {
+			return nil, err
+		}
+		sealed = key.UserID.AsID().AppendTo(sealed)
+		if sealed, err = sealAppend(sealed, wrapKey, contentKey, nil); err != nil {
+			return nil, err
+		}
+	}
+	return sealAppend(sealed, contentKey, plaintext, sealed)
+}
+
+// OpenSealed opens content sealed to the given user using their private sealing key -- used by a client holding its own keys.
+func OpenSealed(userID tag.ID, sealingKey *ecdh.PrivateKey, sealed []byte) ([]byte, error) {
+	if len(sealed) < 1+32 || sealed[0] != SealVersion {
+		return nil, ErrCode_DataFailure.Error("unrecognized sealed format")
+	}
+	ephemeral, err := ecdh.X25519().NewPublicKey(sealed[1:33])
+	if err != nil {
+		return nil, ErrCode_DataFailure.Wrap(err)
+	}
+	count, n := binary.Uvarint(sealed[33:])
+	if n <= 0 || count > uint64(len(sealed))/(24+sealWrappedSize) {
+		return nil, ErrCode_DataFailure.Error("malformed sealed header")
+	}
+	pos := 33 + n
+	headerLen := pos + int(count)*(24+sealWrappedSize)
+	if len(sealed) < headerLen+sealNonceSize {
+		return nil, ErrCode_DataFailure.Error("malformed sealed header")
+	}
+
+	for i := uint64(0); i < count; i, pos = i+1, pos+24+sealWrappedSize {
+		recipient, _ := tag.FromBytes(sealed[pos : pos+24])
+		if recipient != userID {
+			continue
+		}
+		shared, err := sealingKey.ECDH(ephemeral)
+		if err != nil {
+			return nil, ErrCode_DataFailure.Wrap(err)
+		}
+		wrapKey := sealDeriveKey(shared, ephemeral.Bytes(), sealingKey.PublicKey().Bytes())
+		contentKey, err := sealOpen(wrapKey, sealed[pos+24:pos+24+sealWrappedSize], nil)
+		if err != nil {
+			return nil, ErrNotSealedTo
+		}
+		return sealOpen(contentKey, sealed[headerLen:], sealed[:headerLen])
+	}
+	return nil, ErrNotSealedTo
+}
+
+func sealWrapKey(ephemeral *ecdh.PrivateKey, recipientKey []byte) ([]byte, error) {
+	recipient, err := ecdh.X25519().NewPublicKey(recipientKey)
+	if err != nil {
+		return nil, ErrCode_BadRequest.Error("invalid sealing key")
+	}
+	shared, err := ephemeral.ECDH(recipient)
+	if err != nil {
+		return nil, ErrCode_BadRequest.Wrap(err)
+	}
+	return sealDeriveKey(shared, ephemeral.PublicKey().Bytes(), recipientKey), nil
+}
+
+// Binding both public keys into the derived key prevents a wrapped key from being replayed to another recipient.
+func sealDeriveKey(shared, ephemeralKey, recipientKey []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte("amp.seal.v1"))
+	h.Write(shared)
+	h.Write(ephemeralKey)
+	h.Write(recipientKey)
+	return h.Sum(nil)
+}
+
+// sealAppend appends nonce | AES-GCM(key, plaintext, aad) to dst.
+func sealAppend(dst, key, plaintext, aad []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, ErrCode_InternalErr.Wrap(err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, ErrCode_InternalErr.Wrap(err)
+	}
+	nonce := make([]byte, sealNonceSize)
+	rand.Read(nonce)
+	dst = append(dst, nonce...)
+	return gcm.Seal(dst, nonce, plaintext, aad), nil
+}
+
+func sealOpen(key, src, aad []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, ErrCode_InternalErr.Wrap(err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, ErrCode_InternalErr.Wrap(err)
+	}
+	if len(src) < sealNonceSize {
+		return nil, ErrNotSealedTo
+	}
+	plaintext, err := gcm.Open(nil, src[:sealNonceSize], src[sealNonceSize:], aad)
+	if err != nil {
+		return nil, ErrNotSealedTo
+	}
+	return plaintext, nil
+}
+
+// HandleUserKey registers the client-held key sent by a client for the session's user and replies with the user's key via the session controller.
+func HandleUserKey(sess Session, contextID tag.ID, msg *UserKey) error {
+	login := sess.Login()
+	if login.UserID == nil {
+		return ErrAccessDenied
+	}
+	keys := ServicesOf(sess).Keys
+	if keys == nil {
+		return ErrUnimplemented
+	}
+	key := *msg
+	key.UserID = login.UserID
+	key.ClientHeld = true
+	if err := keys.RegisterClientKey(&key); err != nil {
+		return err
+	}
+	reply, err := keys.UserKey(login.UserID.AsID())
+	if err != nil {
+		return err
+	}
+	return SendMetaAttr(sess, contextID, OpStatus_Synced, reply.TagSpec().ID, reply)
+}
+
+// NewKeyService returns an in-memory KeyService, where host-held private keys do not persist across host restarts.
+func NewKeyService() KeyService {
+	return &keyService{
+		users: make(map[tag.ID]*userKeys),
+	}
+}
+
+// Implements KeyService
+type keyService struct {
+	mu    sync.Mutex
+	users map[tag.ID]*userKeys
+}
+
+type userKeys struct {
+	public  *UserKey           // replaced rather than modified
+	signing ed25519.PrivateKey // nil if client-held
+	sealing *ecdh.PrivateKey   // nil if client-held
+}
+
+func (ks *keyService) Provision(userID tag.ID) (*UserKey, error) {
+	if userID.IsNil() {
+		return nil, ErrCode_BadRequest.Error("missing user")
+	}
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	if keys := ks.users[userID]; keys != nil {
+		return keys.public, nil
+	}
+
+	signingPub, signing, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, ErrCode_InternalErr.Wrap(err)
+	}
+	sealing, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, ErrCode_InternalErr.Wrap(err)
+	}
+	keys := &userKeys{
+		public: &UserKey{
+			UserID:     &Tag{},
+			SigningKey: signingPub,
+			SealingKey: sealing.PublicKey().Bytes(),
+			CreatedAt:  time.Now().Unix(),
+		},
+		signing: signing,
+		sealing: sealing,
+	}
+	keys.public.UserID.SetID(userID)
+	ks.users[userID] = keys
+	return keys.public, nil
+}
+
+func (ks *keyService) RegisterClientKey(key *UserKey) error {
+	if key.UserID == nil || len(key.SigningKey) != ed25519.PublicKeySize {
+		return ErrCode_BadRequest.Error("client key requires a user and ed25519 signing key")
+	}
+	if _, err := ecdh.X25519().NewPublicKey(key.SealingKey); err != nil {
+		return ErrCode_BadRequest.Error("invalid sealing key")
+	}
+	public := *key
+	public.ClientHeld = true
+	public.CreatedAt = time.Now().Unix()
+
+	ks.mu.Lock()
+	ks.users[key.UserID.AsID()] = &userKeys{
+		public: &public,
+	}
+	ks.mu.Unlock()
+	return nil
+}
+
+func (ks *keyService) keysOf(userID tag.ID) (*userKeys, error) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	keys := ks.users[userID]
+	if keys == nil {
+		return nil, ErrUserKeyNotFound
+	}
+	return keys, nil
+}
+
+func (ks *keyService) UserKey(userID tag.ID) (*UserKey, error) {
+	keys, err := ks.keysOf(userID)
+	if err != nil {
+		return nil, err
+	}
+	return keys.public, nil
+}
+
+func (ks *keyService) Sign(userID tag.ID, msg []byte) ([]byte, error) {
+	keys, err := ks.keysOf(userID)
+	if err != nil {
+		return nil, err
+	}
+	if keys.signing == nil {
+		return nil, ErrClientHeldKey
+	}
+	return ed25519.Sign(keys.signing, msg), nil
+}
+
+func (ks *keyService) Verify(userID tag.ID, msg, sig []byte) error {
+	keys, err := ks.keysOf(userID)
+	if err != nil {
+		return err
+	}
+	return VerifySignature(keys.public, msg, sig)
+}
+
+func (ks *keyService) Seal(recipients []tag.ID, plaintext []byte) ([]byte, error) {
+	keys := make([]*UserKey, len(recipients))
+	for i, userID := range recipients {
+		key, err := ks.UserKey(userID)
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = key
+	}
+	return SealTo(keys, plaintext)
+}
+
+func (ks *keyService) Open(userID tag.ID, sealed []byte) ([]byte, error) {
+	keys, err := ks.keysOf(userID)
+	if err != nil {
+		return nil, err
+	}
+	if keys.sealing == nil {
+		return nil, ErrClientHeldKey
+	}
+	return OpenSealed(userID, keys.sealing, sealed)
+}
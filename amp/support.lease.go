@@ -0,0 +1,220 @@
+package amp
+
+import (
+	"sync"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// NewLeaseTable returns an in-memory LeaseTable.
+func NewLeaseTable() LeaseTable {
+	return &leaseTable{
+		leases:   make(map[tag.ID]*heldLease),
+		holders:  make(map[int64]struct{}),
+		watchers: make(map[int]*leaseWatcher),
+	}
+}
+
+// Implements LeaseTable
+type leaseTable struct {
+	mu       sync.Mutex
+	leases   map[tag.ID]*heldLease
+	holders  map[int64]struct{} // holders being watched for closing
+	watchers map[int]*leaseWatcher
+	nextID   int
+}
+
+// leaseWatcher delivers lease changes to a watcher from its own goroutine, so a slow watcher (e.g. a pin pushing to a slow client) can't stall lease operations.
+// Changes to a cell arriving while the watcher is busy are coalesced, keeping only the latest.
+type leaseWatcher struct {
+	fn      func(cellID tag.ID, lease *CellLease)
+	mu      sync.Mutex
+	pending map[tag.ID]*CellLease // latest undelivered change per cell (nil if released)
+	order   []tag.ID              // cells of pending, in order of first change
+	wake    chan struct{}
+}
+
+type heldLease struct {
+	lease     *CellLease
+	holderTID int64       // task.Info.TID of the holder
+	expiry    *time.Timer // releases the lease when it expires
+}
+
+func (tbl *leaseTable) AcquireLease(holder task.Context, cellID tag.ID, lease *CellLease) error {
+	if cellID.IsNil() {
+		return ErrBadTarget
+	}
+	holderTID := holder.Info().TID
+	now := time.Now().Unix()
+	if lease.AcquiredAt == 0 {
+		lease.AcquiredAt = now
+	}
+
+	tbl.mu.Lock()
+	if held := tbl.leases[cellID]; held != nil {
+		if held.holderTID != holderTID && !held.expired(now) {
+			tbl.mu.Unlock()
+			return ErrLeaseHeld
+		}
+		held.stop()
+	}
+
+	held := &heldLease{
+		lease:     lease,
+		holderTID: holderTID,
+	}
+	if lease.ExpiresAt > 0 {
+		held.expiry = time.AfterFunc(time.Until(time.Unix(lease.ExpiresAt, 0)), func() {
+			tbl.release(cellID, held)
+		})
+	}
+	tbl.leases[cellID] = held
+
+	if _, watching := tbl.holders[holderTID]; !watching {
+		tbl.holders[holderTID] = struct{}{}
+		go func() {
+			<-holder.Closing()
+			tbl.releaseAll(holderTID)
+		}()
+	}
+	tbl.mu.Unlock()
+
+	tbl.notify(cellID, lease)
+	return nil
+}
+
+func (tbl *leaseTable) ReleaseLease(holder task.Context, cellID tag.ID) error {
+	tbl.mu.Lock()
+	held := tbl.leases[cellID]
+	tbl.mu.Unlock()
+
+	if held == nil || held.holderTID != holder.Info().TID {
+		return ErrLeaseNotHeld
+	}
+	tbl.release(cellID, held)
+	return nil
+}
+
+func (tbl *leaseTable) GetLease(cellID tag.ID) *CellLease {
+	tbl.mu.Lock()
+	defer tbl.mu.Unlock()
+
+	held := tbl.leases[cellID]
+	if held == nil || held.expired(time.Now().Unix()) {
+		return nil
+	}
+	return held.lease
+}
+
+func (tbl *leaseTable) WatchLeases(ctx task.Context, fn func(cellID tag.ID, lease *CellLease)) {
+	watcher := &leaseWatcher{
+		fn:      fn,
+		pending: make(map[tag.ID]*CellLease),
+		wake:    make(chan struct{}, 1),
+	}
+
+	tbl.mu.Lock()
+	id := tbl.nextID
+	tbl.nextID++
+	tbl.watchers[id] = watcher
+	tbl.mu.Unlock()
+
+	go func() {
+		for {
+			select {
+			case <-watcher.wake:
+				watcher.deliver()
+			case <-ctx.Closing():
+				tbl.mu.Lock()
+				delete(tbl.watchers, id)
+				tbl.mu.Unlock()
+				return
+			}
+		}
+	}()
+}
+
+// Removes the given lease if it is still current.
+func (tbl *leaseTable) release(cellID tag.ID, held *heldLease) {
+	tbl.mu.Lock()
+	current := tbl.leases[cellID] == held
+	if current {
+		held.stop()
+		delete(tbl.leases, cellID)
+	}
+	tbl.mu.Unlock()
+
+	if current {
+		tbl.notify(cellID, nil)
+	}
+}
+
+// Releases all leases held by the given holder.
+func (tbl *leaseTable) releaseAll(holderTID int64) {
+	var released []tag.ID
+
+	tbl.mu.Lock()
+	delete(tbl.holders, holderTID)
+	for cellID, held := range tbl.leases {
+		if held.holderTID == holderTID {
+			held.stop()
+			delete(tbl.leases, cellID)
+			released = append(released, cellID)
+		}
+	}
+	tbl.mu.Unlock()
+
+	for _, cellID := range released {
+		tbl.notify(cellID, nil)
+	}
+}
+
+// Queues the given change to each watcher without blocking.
+func (tbl *leaseTable) notify(cellID tag.ID, lease *CellLease) {
+	tbl.mu.Lock()
+	defer tbl.mu.Unlock()
+
+	for _, watcher := range tbl.watchers {
+		watcher.post(cellID, lease)
+	}
+}
+
+func (watcher *leaseWatcher) post(cellID tag.ID, lease *CellLease) {
+	watcher.mu.Lock()
+	if _, queued := watcher.pending[cellID]; !queued {
+		watcher.order = append(watcher.order, cellID)
+	}
+	watcher.pending[cellID] = lease
+	watcher.mu.Unlock()
+
+	select {
+	case watcher.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Calls the watcher's fn with each pending change.
+func (watcher *leaseWatcher) deliver() {
+	watcher.mu.Lock()
+	order := watcher.order
+	pending := watcher.pending
+	watcher.order = nil
+	watcher.pending = make(map[tag.ID]*CellLease, len(pending))
+	watcher.mu.Unlock()
+
+	for _, cellID := range order {
+		watcher.fn(cellID, pending[cellID])
+	}
+}
+
+func (held *heldLease) expired(now int64) bool {
+	return held.lease.ExpiresAt > 0 && now >= held.lease.ExpiresAt
+}
+
+func (held *heldLease) stop() {
+	if held.expiry != nil {
+		held.expiry.Stop()
+	}
+}
@@ -0,0 +1,263 @@
+package amp
+
+import (
+	"sync"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// NewNotificationService returns an in-memory NotificationService retaining up to maxPending notifications per user.
+// When a user's queue is full, the oldest notification is dropped.  If maxPending <= 0, 500 is used.
+func NewNotificationService(maxPending int) NotificationService {
+	if maxPending <= 0 {
+		maxPending = 500
+	}
+	return &notifier{
+		maxPending: maxPending,
+		inboxes:    make(map[tag.ID]*inbox),
+	}
+}
+
+// SubscribeSession delivers notifications for the session's user to the session's controller (context ID 0) until the session closes.
+func SubscribeSession(sess Session) {
+	svc := ServicesOf(sess).Notifications
+	login := sess.Login()
+	if svc == nil || login.UserID == nil {
+		return
+	}
+	userID := *login.UserID
+	svc.Subscribe(sess, userID.AsID(), func(n *Notification) error {
+		return SendMetaAttr(sess, tag.ID{}, OpStatus_Synced, n.TagSpec().ID, n)
+	})
+}
+
+// Implements NotificationService
+type notifier struct {
+	mu         sync.Mutex
+	maxPending int
+	inboxes    map[tag.ID]*inbox // by UserID
+	nextID     int
+}
+
+type inbox struct {
+	pending  []*Notification
+	subs     map[int]func(n *Notification) error
+	watchers map[int]func(badge *Badge)
+}
+
+// caller holds svc.mu
+func (svc *notifier) inbox(userID tag.ID) *inbox {
+	box := svc.inboxes[userID]
+	if box == nil {
+		box = &inbox{
+			subs:     make(map[int]func(n *Notification) error),
+			watchers: make(map[int]func(badge *Badge)),
+		}
+		svc.inboxes[userID] = box
+	}
+	return box
+}
+
+// caller holds svc.mu
+func (svc *notifier) pruneInbox(userID tag.ID) {
+	if box := svc.inboxes[userID]; box != nil && len(box.pending) == 0 && len(box.subs) == 0 && len(box.watchers) == 0 {
+		delete(svc.inboxes, userID)
+	}
+}
+
+func (svc *notifier) Notify(n *Notification) error {
+	if n.UserID == nil {
+		return ErrCode_BadRequest.Error("Notify: missing UserID")
+	}
+	userTag := *n.UserID
+	userID := userTag.AsID()
+	if n.ID == nil {
+		n.ID = &Tag{}
+		n.ID.SetID(tag.Now())
+	}
+	if n.CreatedAt == 0 {
+		n.CreatedAt = time.Now().Unix()
+	}
+	if n.AppID != nil {
+		n.AppID.AsID() // resolve once so later reads don't write
+	}
+
+	svc.mu.Lock()
+	box := svc.inbox(userID)
+	changed := []*Notification{n}
+	box.pending = append(box.pending, n)
+	if over := len(box.pending) - svc.maxPending; over > 0 {
+		changed = append(changed, box.pending[:over]...)
+		box.pending = append(box.pending[:0], box.pending[over:]...)
+	}
+	subs := make([]func(n *Notification) error, 0, len(box.subs))
+	for _, send := range box.subs {
+		subs = append(subs, send)
+	}
+	notify := box.badgeChanges(changed)
+	svc.mu.Unlock()
+
+	// A failed delivery leaves the notification pending for subsequent sessions
+	for _, send := range subs {
+		send(n)
+	}
+	notify()
+	return nil
+}
+
+func (svc *notifier) Ack(userID tag.ID, ack *NotificationAck) {
+	var appID tag.ID
+	if ack.AppID != nil {
+		appID = ack.AppID.AsID()
+	}
+	ids := make(map[tag.ID]struct{}, len(ack.IDs))
+	for _, id := range ack.IDs {
+		ids[id.AsID()] = struct{}{}
+	}
+
+	svc.mu.Lock()
+	box := svc.inboxes[userID]
+	if box == nil {
+		svc.mu.Unlock()
+		return
+	}
+	var cleared []*Notification
+	kept := box.pending[:0]
+	for _, n := range box.pending {
+		_, acked := ids[n.ID.AsID()]
+		if acked || (appID.IsSet() && n.AppID != nil && n.AppID.AsID() == appID) {
+			cleared = append(cleared, n)
+		} else {
+			kept = append(kept, n)
+		}
+	}
+	clear(box.pending[len(kept):])
+	box.pending = kept
+	notify := box.badgeChanges(cleared)
+	svc.pruneInbox(userID)
+	svc.mu.Unlock()
+
+	notify()
+}
+
+func (svc *notifier) Pending(userID tag.ID) []*Notification {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	if box := svc.inboxes[userID]; box != nil {
+		return append([]*Notification(nil), box.pending...)
+	}
+	return nil
+}
+
+func (svc *notifier) Badges(userID tag.ID) []*Badge {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	box := svc.inboxes[userID]
+	if box == nil {
+		return nil
+	}
+	var badges []*Badge
+	counts := make(map[tag.ID]*Badge)
+	for _, n := range box.pending {
+		appID := n.appID()
+		badge := counts[appID]
+		if badge == nil {
+			badge = &Badge{AppID: &Tag{}}
+			badge.AppID.SetID(appID)
+			counts[appID] = badge
+			badges = append(badges, badge)
+		}
+		badge.Count++
+	}
+	return badges
+}
+
+func (svc *notifier) Subscribe(ctx task.Context, userID tag.ID, send func(n *Notification) error) {
+	svc.mu.Lock()
+	box := svc.inbox(userID)
+	id := svc.nextID
+	svc.nextID++
+	box.subs[id] = send
+	pending := append([]*Notification(nil), box.pending...)
+	svc.mu.Unlock()
+
+	for _, n := range pending {
+		if send(n) != nil {
+			break
+		}
+	}
+
+	go func() {
+		<-ctx.Closing()
+		svc.mu.Lock()
+		delete(box.subs, id)
+		svc.pruneInbox(userID)
+		svc.mu.Unlock()
+	}()
+}
+
+func (svc *notifier) WatchBadges(ctx task.Context, userID tag.ID, fn func(badge *Badge)) {
+	svc.mu.Lock()
+	box := svc.inbox(userID)
+	id := svc.nextID
+	svc.nextID++
+	box.watchers[id] = fn
+	svc.mu.Unlock()
+
+	go func() {
+		<-ctx.Closing()
+		svc.mu.Lock()
+		delete(box.watchers, id)
+		svc.pruneInbox(userID)
+		svc.mu.Unlock()
+	}()
+}
+
+// Returns a func that notifies watchers of the current badge count of each app affected by the given notifications -- caller holds svc.mu
+func (box *inbox) badgeChanges(changed []*Notification) func() {
+	if len(box.watchers) == 0 || len(changed) == 0 {
+		return func() {}
+	}
+
+	var badges []*Badge
+	seen := make(map[tag.ID]struct{})
+	for _, n := range changed {
+		appID := n.appID()
+		if _, dupe := seen[appID]; dupe {
+			continue
+		}
+		seen[appID] = struct{}{}
+
+		badge := &Badge{AppID: &Tag{}}
+		badge.AppID.SetID(appID)
+		for _, pi := range box.pending {
+			if pi.appID() == appID {
+				badge.Count++
+			}
+		}
+		badges = append(badges, badge)
+	}
+
+	watchers := make([]func(badge *Badge), 0, len(box.watchers))
+	for _, fn := range box.watchers {
+		watchers = append(watchers, fn)
+	}
+	return func() {
+		for _, badge := range badges {
+			for _, fn := range watchers {
+				fn(badge)
+			}
+		}
+	}
+}
+
+func (n *Notification) appID() tag.ID {
+	if n.AppID == nil {
+		return tag.ID{}
+	}
+	return n.AppID.AsID()
+}
@@ -0,0 +1,361 @@
+package amp
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+// OAuthGrantAttr is the attr under which an OAuthService stores a user's grant (a LoginCheckpoint), where the CellID is the user and the ItemID is the provider.
+var OAuthGrantAttr = AttrSpec.With("LoginCheckpoint.oauth-grant").ID
+
+const (
+	oauthPendingTTL = 10 * time.Minute // how long a user has to complete a grant
+	oauthExpirySkew = time.Minute      // access tokens expiring within this are refreshed
+)
+
+// BeginOAuth sends the client a LaunchURL to grant access to the given provider -- see HandleOAuthRedirect.
+func BeginOAuth(sess Session, contextID tag.ID, provider string) error {
+	login := sess.Login()
+	if login.UserID == nil {
+		return ErrAccessDenied
+	}
+	oauth := ServicesOf(sess).OAuth
+	if oauth == nil {
+		return ErrUnimplemented
+	}
+	authURL, err := oauth.AuthCodeURL(login.UserID.AsID(), provider)
+	if err != nil {
+		return err
+	}
+	launch := &LaunchURL{
+		URL: authURL,
+	}
+	return SendMetaAttr(sess, contextID, OpStatus_Synced, launch.TagSpec().ID, launch)
+}
+
+// HandleOAuthRedirect completes a grant begun by BeginOAuth from the LaunchURL returned by the client (i.e. the URL the provider redirected to).
+func HandleOAuthRedirect(ctx context.Context, sess Session, msg *LaunchURL) (provider string, err error) {
+	login := sess.Login()
+	if login.UserID == nil {
+		return "", ErrAccessDenied
+	}
+	oauth := ServicesOf(sess).OAuth
+	if oauth == nil {
+		return "", ErrUnimplemented
+	}
+	return oauth.Exchange(ctx, login.UserID.AsID(), msg.URL)
+}
+
+// NewOAuthClient returns an http.Client authorizing each request with a token from src.
+func NewOAuthClient(src TokenSource) *http.Client {
+	return &http.Client{
+		Transport: &oauthTransport{
+			src:  src,
+			base: http.DefaultTransport,
+		},
+	}
+}
+
+type oauthTransport struct {
+	src  TokenSource
+	base http.RoundTripper
+}
+
+func (t *oauthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.src.Token(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	tokenType := token.TokenType
+	if tokenType == "" {
+		tokenType = "Bearer"
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", tokenType+" "+token.AccessToken)
+	return t.base.RoundTrip(req)
+}
+
+// NewOAuthService returns an OAuthService storing grants in the given CellStore (which should encrypt at rest -- see amp/store).
+// Token requests are made with httpClient, or http.DefaultClient if nil.
+func NewOAuthService(grants CellStore, httpClient *http.Client) OAuthService {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &oauthService{
+		grants:    grants,
+		client:    httpClient,
+		providers: make(map[string]OAuthProvider),
+		pending:   make(map[string]oauthPending),
+	}
+}
+
+// Implements OAuthService
+type oauthService struct {
+	grants CellStore
+	client *http.Client
+
+	mu        sync.Mutex
+	providers map[string]OAuthProvider
+	pending   map[string]oauthPending // state => pending grant
+	storeMu   sync.Mutex              // serializes grant read-modify-write
+}
+
+// oauthPending is a grant awaiting the user's redirect.
+type oauthPending struct {
+	userID   tag.ID
+	provider string
+	verifier string // PKCE code verifier
+	expires  time.Time
+}
+
+func (svc *oauthService) RegisterProvider(provider OAuthProvider) error {
+	if provider.Name == "" || provider.AuthURL == "" || provider.TokenURL == "" {
+		return ErrCode_BadRequest.Error("OAuth provider requires a name, auth URL, and token URL")
+	}
+	svc.mu.Lock()
+	svc.providers[provider.Name] = provider
+	svc.mu.Unlock()
+	return nil
+}
+
+func (svc *oauthService) provider(name string) (OAuthProvider, error) {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	provider, exists := svc.providers[name]
+	if !exists {
+		return OAuthProvider{}, ErrCode_BadRequest.Errorf("unknown OAuth provider %q", name)
+	}
+	return provider, nil
+}
+
+func randomURLToken() string {
+	buf := make([]byte, 32)
+	rand.Read(buf)
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
+
+// The state parameter binds the redirect to this user and provider, and PKCE (RFC 7636) binds the code to this host.
+func (svc *oauthService) AuthCodeURL(userID tag.ID, providerName string) (string, error) {
+	provider, err := svc.provider(providerName)
+	if err != nil {
+		return "", err
+	}
+	authURL, err := url.Parse(provider.AuthURL)
+	if err != nil {
+		return "", ErrCode_InvalidURI.Wrap(err)
+	}
+
+	state := randomURLToken()
+	pending := oauthPending{
+		userID:   userID,
+		provider: providerName,
+		verifier: randomURLToken(),
+		expires:  time.Now().Add(oauthPendingTTL),
+	}
+	challenge := sha256.Sum256([]byte(pending.verifier))
+
+	svc.mu.Lock()
+	now := time.Now()
+	for key, pi := range svc.pending {
+		if now.After(pi.expires) {
+			delete(svc.pending, key)
+		}
+	}
+	svc.pending[state] = pending
+	svc.mu.Unlock()
+
+	query := authURL.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", provider.ClientID)
+	query.Set("redirect_uri", provider.RedirectURL)
+	query.Set("state", state)
+	query.Set("code_challenge", base64.RawURLEncoding.EncodeToString(challenge[:]))
+	query.Set("code_challenge_method", "S256")
+	if len(provider.Scopes) > 0 {
+		query.Set("scope", strings.Join(provider.Scopes, " "))
+	}
+	authURL.RawQuery = query.Encode()
+	return authURL.String(), nil
+}
+
+func (svc *oauthService) Exchange(ctx context.Context, userID tag.ID, redirected string) (string, error) {
+	redirectURL, err := url.Parse(redirected)
+	if err != nil {
+		return "", ErrCode_InvalidURI.Wrap(err)
+	}
+	query := redirectURL.Query()
+
+	svc.mu.Lock()
+	state := query.Get("state")
+	pending, exists := svc.pending[state]
+	delete(svc.pending, state)
+	svc.mu.Unlock()
+
+	if !exists || pending.userID != userID || time.Now().After(pending.expires) {
+		return "", ErrOAuthBadState
+	}
+	if errStr := query.Get("error"); errStr != "" {
+		return "", ErrCode_AuthFailed.Errorf("OAuth grant declined: %s", errStr)
+	}
+	provider, err := svc.provider(pending.provider)
+	if err != nil {
+		return "", err
+	}
+
+	token, err := svc.requestToken(ctx, provider, url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {query.Get("code")},
+		"redirect_uri":  {provider.RedirectURL},
+		"code_verifier": {pending.verifier},
+	})
+	if err != nil {
+		return "", err
+	}
+	if err = svc.putGrant(userID, provider.Name, token); err != nil {
+		return "", err
+	}
+	return provider.Name, nil
+}
+
+func (svc *oauthService) TokenSource(userID tag.ID, providerName string) (TokenSource, error) {
+	provider, err := svc.provider(providerName)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = svc.getGrant(userID, providerName); err != nil {
+		return nil, err
+	}
+	return &oauthTokenSource{
+		svc:      svc,
+		userID:   userID,
+		provider: provider,
+	}, nil
+}
+
+func (svc *oauthService) Revoke(userID tag.ID, providerName string) error {
+	svc.storeMu.Lock()
+	defer svc.storeMu.Unlock()
+	return svc.grants.PutElement(oauthGrantID(userID, providerName), nil)
+}
+
+func oauthGrantID(userID tag.ID, providerName string) ElementID {
+	return ElementID{userID, OAuthGrantAttr, tag.FromToken(providerName)}
+}
+
+func (svc *oauthService) getGrant(userID tag.ID, providerName string) (*LoginCheckpoint, error) {
+	grant := &LoginCheckpoint{}
+	err := svc.grants.GetElement(oauthGrantID(userID, providerName), grant)
+	if errors.Is(err, ErrCellNotFound) {
+		return nil, ErrOAuthNotGranted
+	}
+	if err != nil {
+		return nil, err
+	}
+	return grant, nil
+}
+
+func (svc *oauthService) putGrant(userID tag.ID, providerName string, grant *LoginCheckpoint) error {
+	svc.storeMu.Lock()
+	defer svc.storeMu.Unlock()
+	return svc.grants.PutElement(oauthGrantID(userID, providerName), grant)
+}
+
+// oauthTokenJSON is a token endpoint response -- see RFC 6749 section 5.1
+type oauthTokenJSON struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int64  `json:"expires_in"`
+	Error        string `json:"error"`
+	ErrorDesc    string `json:"error_description"`
+}
+
+func (svc *oauthService) requestToken(ctx context.Context, provider OAuthProvider, form url.Values) (*LoginCheckpoint, error) {
+	form.Set("client_id", provider.ClientID)
+	if provider.ClientSecret != "" {
+		form.Set("client_secret", provider.ClientSecret)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, provider.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, ErrCode_InvalidURI.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := svc.client.Do(req)
+	if err != nil {
+		return nil, ErrCode_NotConnected.Wrap(err)
+	}
+	defer resp.Body.Close()
+
+	var body oauthTokenJSON
+	if err = json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&body); err != nil {
+		return nil, ErrCode_LoginFailed.Errorf("OAuth token response: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK || body.Error != "" || body.AccessToken == "" {
+		return nil, ErrCode_LoginFailed.Errorf("OAuth token request failed (%d): %s %s", resp.StatusCode, body.Error, body.ErrorDesc)
+	}
+
+	token := &LoginCheckpoint{
+		TokenType:    body.TokenType,
+		AccessToken:  body.AccessToken,
+		RefreshToken: body.RefreshToken,
+		URI:          provider.Name,
+	}
+	if body.ExpiresIn > 0 {
+		token.Expiry = time.Now().Unix() + body.ExpiresIn
+	}
+	return token, nil
+}
+
+// oauthTokenSource returns the stored access token, refreshing it when it is about to expire.
+type oauthTokenSource struct {
+	svc      *oauthService
+	userID   tag.ID
+	provider OAuthProvider
+	mu       sync.Mutex // serializes refreshes
+}
+
+func (src *oauthTokenSource) Token(ctx context.Context) (*LoginCheckpoint, error) {
+	src.mu.Lock()
+	defer src.mu.Unlock()
+
+	grant, err := src.svc.getGrant(src.userID, src.provider.Name)
+	if err != nil {
+		return nil, err
+	}
+	if grant.Expiry == 0 || time.Now().Add(oauthExpirySkew).Unix() < grant.Expiry {
+		return grant, nil
+	}
+	if grant.RefreshToken == "" {
+		return nil, ErrCode_SessionExpired.Error("OAuth token expired and can't be refreshed")
+	}
+
+	refreshed, err := src.svc.requestToken(ctx, src.provider, url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {grant.RefreshToken},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if refreshed.RefreshToken == "" {
+		refreshed.RefreshToken = grant.RefreshToken // providers may not rotate refresh tokens
+	}
+	if err = src.svc.putGrant(src.userID, src.provider.Name, refreshed); err != nil {
+		return nil, err
+	}
+	return refreshed, nil
+}
@@ -0,0 +1,285 @@
+package amp
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+// LoginTagPasskey is the Login.Tags token a client sets to sign in with a passkey -- see PasskeyAuth.
+const LoginTagPasskey = "passkey"
+
+// HasTag returns true if Login.Tags contains the given token.
+func (login *Login) HasTag(token string) bool {
+	fields := strings.FieldsFunc(login.Tags, func(r rune) bool {
+		return r == ' ' || r == '.' || r == ','
+	})
+	for _, fi := range fields {
+		if fi == token {
+			return true
+		}
+	}
+	return false
+}
+
+// SelectAuthProvider returns the first of the given providers accepting the given login, or nil if none do.
+func SelectAuthProvider(providers []AuthProvider, login *Login) AuthProvider {
+	for _, provider := range providers {
+		if provider.Accepts(login) {
+			return provider
+		}
+	}
+	return nil
+}
+
+// Passkey is a WebAuthn credential registered by a user.
+type Passkey struct {
+	CredentialID []byte
+	UserID       tag.ID
+	PublicKey    any    // *ecdsa.PublicKey (ES256) or ed25519.PublicKey (EdDSA)
+	SignCount    uint32 // last signature counter reported by the authenticator
+	Label        string
+	CreatedAt    int64 // unix seconds
+}
+
+// PasskeyAuth is an AuthProvider signing in users with WebAuthn passkeys, requiring no password or third party.
+//
+// A signed-in session registers a passkey via BeginRegistration() and FinishRegistration(), carrying the LoginChallenge and LoginResponse as it sees fit.
+// Thereafter, a Login bearing LoginTagPasskey is challenged to sign with a registered passkey (and if Login.UserID is set, one of that user's).
+// Attestation is not verified (i.e. "none"), so the host trusts any authenticator.
+type PasskeyAuth struct {
+	RelyingPartyID   string   // e.g. "example.com"
+	Origins          []string // origins the client may sign from, e.g. "https://example.com"
+	UserVerification bool     // if set, the authenticator must verify the user (e.g. biometric or PIN)
+
+	mu       sync.Mutex
+	passkeys map[string]*Passkey // CredentialID => passkey
+}
+
+var _ AuthProvider = (*PasskeyAuth)(nil)
+
+// NewPasskeyAuth returns an in-memory PasskeyAuth for the given relying party.
+func NewPasskeyAuth(relyingPartyID string, origins ...string) *PasskeyAuth {
+	return &PasskeyAuth{
+		RelyingPartyID: relyingPartyID,
+		Origins:        origins,
+		passkeys:       make(map[string]*Passkey),
+	}
+}
+
+// WebAuthn client data -- see https://www.w3.org/TR/webauthn-2/#dictionary-client-data
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+// Authenticator data flags -- see https://www.w3.org/TR/webauthn-2/#sctn-authenticator-data
+const (
+	authDataUserPresent  = 0x01
+	authDataUserVerified = 0x04
+	authDataMinLen       = 37 // rpIdHash (32) + flags (1) + signCount (4)
+)
+
+func (pa *PasskeyAuth) Accepts(login *Login) bool {
+	return login.HasTag(LoginTagPasskey)
+}
+
+func (pa *PasskeyAuth) Challenge(login *Login) (*LoginChallenge, error) {
+	challenge := NewLoginChallenge()
+	challenge.RelyingPartyID = pa.RelyingPartyID
+	if login.UserID != nil {
+		for _, passkey := range pa.Passkeys(login.UserID.AsID()) {
+			challenge.CredentialIDs = append(challenge.CredentialIDs, passkey.CredentialID)
+		}
+		if len(challenge.CredentialIDs) == 0 {
+			return nil, ErrPasskeyNotFound
+		}
+	}
+	return challenge, nil
+}
+
+func (pa *PasskeyAuth) Verify(login *Login, challenge *LoginChallenge, response *LoginResponse) (tag.ID, error) {
+	assertion := response.Passkey
+	if assertion == nil {
+		return tag.ID{}, ErrBadPasskey
+	}
+	if len(challenge.CredentialIDs) > 0 {
+		allowed := false
+		for _, credID := range challenge.CredentialIDs {
+			if bytes.Equal(credID, assertion.CredentialID) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return tag.ID{}, ErrBadPasskey
+		}
+	}
+	signCount, err := pa.verifyClient("webauthn.get", challenge, assertion.ClientDataJSON, assertion.AuthenticatorData)
+	if err != nil {
+		return tag.ID{}, err
+	}
+
+	pa.mu.Lock()
+	defer pa.mu.Unlock()
+
+	passkey := pa.passkeys[string(assertion.CredentialID)]
+	if passkey == nil {
+		return tag.ID{}, ErrPasskeyNotFound
+	}
+	if login.UserID != nil && login.UserID.AsID() != passkey.UserID {
+		return tag.ID{}, ErrBadPasskey
+	}
+
+	// The signature covers the authenticator data and the hash of the client data
+	clientHash := sha256.Sum256(assertion.ClientDataJSON)
+	signed := append(append([]byte(nil), assertion.AuthenticatorData...), clientHash[:]...)
+	switch key := passkey.PublicKey.(type) {
+	case *ecdsa.PublicKey:
+		digest := sha256.Sum256(signed)
+		if !ecdsa.VerifyASN1(key, digest[:], assertion.Signature) {
+			return tag.ID{}, ErrBadPasskey
+		}
+	case ed25519.PublicKey:
+		if !ed25519.Verify(key, signed, assertion.Signature) {
+			return tag.ID{}, ErrBadPasskey
+		}
+	default:
+		return tag.ID{}, ErrBadPasskey
+	}
+
+	// A counter that fails to advance suggests a cloned authenticator (authenticators not keeping a counter always report zero)
+	if signCount != 0 || passkey.SignCount != 0 {
+		if signCount <= passkey.SignCount {
+			return tag.ID{}, ErrBadPasskey
+		}
+	}
+	used := *passkey
+	used.SignCount = signCount
+	pa.passkeys[string(used.CredentialID)] = &used
+	return used.UserID, nil
+}
+
+// BeginRegistration returns the challenge for the given (signed in) user to create a new passkey.
+// The client's WebAuthn user.id is expected to be the user's ID -- see tag.ID.AppendTo().
+func (pa *PasskeyAuth) BeginRegistration(userID tag.ID) *LoginChallenge {
+	challenge := NewLoginChallenge()
+	challenge.RelyingPartyID = pa.RelyingPartyID
+	for _, passkey := range pa.Passkeys(userID) {
+		challenge.CredentialIDs = append(challenge.CredentialIDs, passkey.CredentialID) // WebAuthn excludeCredentials
+	}
+	return challenge
+}
+
+// FinishRegistration verifies the client's new passkey (LoginResponse.NewPasskey) created for the given challenge and registers it to the given user.
+func (pa *PasskeyAuth) FinishRegistration(userID tag.ID, challenge *LoginChallenge, response *LoginResponse) (*Passkey, error) {
+	cred := response.NewPasskey
+	if cred == nil || len(cred.CredentialID) == 0 {
+		return nil, ErrBadPasskey
+	}
+	signCount, err := pa.verifyClient("webauthn.create", challenge, cred.ClientDataJSON, cred.AuthenticatorData)
+	if err != nil {
+		return nil, err
+	}
+	publicKey, err := x509.ParsePKIXPublicKey(cred.PublicKey)
+	if err != nil {
+		return nil, ErrBadPasskey
+	}
+	switch publicKey.(type) {
+	case *ecdsa.PublicKey, ed25519.PublicKey:
+	default:
+		return nil, ErrCode_BadRequest.Error("unsupported passkey algorithm")
+	}
+
+	passkey := &Passkey{
+		CredentialID: cred.CredentialID,
+		UserID:       userID,
+		PublicKey:    publicKey,
+		SignCount:    signCount,
+		Label:        cred.Label,
+		CreatedAt:    time.Now().Unix(),
+	}
+
+	pa.mu.Lock()
+	defer pa.mu.Unlock()
+	if pa.passkeys[string(cred.CredentialID)] != nil {
+		return nil, ErrCode_InsufficientPermissions.Error("passkey already registered")
+	}
+	pa.passkeys[string(cred.CredentialID)] = passkey
+	return passkey, nil
+}
+
+// Passkeys returns the passkeys registered by the given user.
+func (pa *PasskeyAuth) Passkeys(userID tag.ID) []*Passkey {
+	pa.mu.Lock()
+	defer pa.mu.Unlock()
+
+	var passkeys []*Passkey
+	for _, passkey := range pa.passkeys {
+		if passkey.UserID == userID {
+			passkeys = append(passkeys, passkey)
+		}
+	}
+	return passkeys
+}
+
+// RemovePasskey unregisters the given passkey of the given user, returning ErrPasskeyNotFound if the user has no such passkey.
+func (pa *PasskeyAuth) RemovePasskey(userID tag.ID, credentialID []byte) error {
+	pa.mu.Lock()
+	defer pa.mu.Unlock()
+
+	passkey := pa.passkeys[string(credentialID)]
+	if passkey == nil || passkey.UserID != userID {
+		return ErrPasskeyNotFound
+	}
+	delete(pa.passkeys, string(credentialID))
+	return nil
+}
+
+// verifyClient checks the client data and authenticator data common to registration and assertion, returning the authenticator's signature counter.
+func (pa *PasskeyAuth) verifyClient(ceremony string, challenge *LoginChallenge, clientDataJSON, authData []byte) (uint32, error) {
+	var cd clientData
+	if err := json.Unmarshal(clientDataJSON, &cd); err != nil {
+		return 0, ErrBadPasskey
+	}
+	if cd.Type != ceremony || cd.Challenge != base64.RawURLEncoding.EncodeToString(challenge.Hash) {
+		return 0, ErrBadPasskey
+	}
+	originOK := false
+	for _, origin := range pa.Origins {
+		if cd.Origin == origin {
+			originOK = true
+			break
+		}
+	}
+	if !originOK {
+		return 0, ErrBadPasskey
+	}
+
+	if len(authData) < authDataMinLen {
+		return 0, ErrBadPasskey
+	}
+	rpHash := sha256.Sum256([]byte(pa.RelyingPartyID))
+	if !bytes.Equal(authData[:32], rpHash[:]) {
+		return 0, ErrBadPasskey
+	}
+	flags := authData[32]
+	if flags&authDataUserPresent == 0 {
+		return 0, ErrBadPasskey
+	}
+	if pa.UserVerification && flags&authDataUserVerified == 0 {
+		return 0, ErrBadPasskey
+	}
+	return binary.BigEndian.Uint32(authData[33:37]), nil
+}
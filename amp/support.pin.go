@@ -0,0 +1,48 @@
+package amp
+
+import (
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+// NewAttrMask forms an AttrMask from the given attr selectors.
+// A selector without an explicit ID is resolved from its UID or Text, otherwise from its URL as a tag.Spec.
+func NewAttrMask(attrs []*Tag) AttrMask {
+	if len(attrs) == 0 {
+		return nil
+	}
+	mask := make(AttrMask, len(attrs))
+	for _, attr := range attrs {
+		if attr == nil {
+			continue
+		}
+		attrID := attr.AsID()
+		if attrID.IsNil() && attr.URL != "" {
+			attrID = tag.ParseSpec(attr.URL).ID
+		}
+		if attrID.IsSet() {
+			mask[attrID] = struct{}{}
+		}
+	}
+	return mask
+}
+
+// Selects returns true if the given attr is selected by this mask.
+func (mask AttrMask) Selects(attrID tag.ID) bool {
+	if len(mask) == 0 {
+		return true
+	}
+	_, selected := mask[attrID]
+	return selected
+}
+
+// Includes returns true only if the given attr is explicitly listed in this mask.
+// This is how a deferred attr is distinguished from an attr implicitly selected by an empty mask.
+func (mask AttrMask) Includes(attrID tag.ID) bool {
+	_, included := mask[attrID]
+	return included
+}
+
+// AttrMask returns the attrs selected by this request.
+func (req *Request) AttrMask() AttrMask {
+	return NewAttrMask(req.PinAttrs)
+}
@@ -0,0 +1,184 @@
+package amp
+
+import (
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/blob"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// PrefetchOpts configures StartPrefetcher().
+type PrefetchOpts struct {
+	Blobs    blob.Store // where hinted BlobKeys are read from, warming it if it implements blob.Warmer (e.g. blob.Cache)
+	PushMax  int64      // assets up to this size are pushed to the client; if 0, 64 KB; if < 0, nothing is pushed
+	Workers  int        // max concurrent prefetches; if <= 0, 4
+	QueueMax int        // max queued hints, beyond which the lowest priority hints are dropped; if <= 0, 1024
+}
+
+// StartPrefetcher starts a Prefetcher as a child of the given context.
+func StartPrefetcher(parent task.Context, opts PrefetchOpts) (Prefetcher, error) {
+	if opts.PushMax == 0 {
+		opts.PushMax = 64 << 10
+	}
+	if opts.Workers <= 0 {
+		opts.Workers = 4
+	}
+	if opts.QueueMax <= 0 {
+		opts.QueueMax = 1024
+	}
+
+	pf := &prefetcher{
+		opts: opts,
+		wake: make(chan struct{}, opts.QueueMax),
+	}
+	_, err := parent.StartChild(&task.Task{
+		Info: task.Info{
+			Label: "prefetcher",
+		},
+		OnRun: func(ctx task.Context) {
+			var wg sync.WaitGroup
+			for i := 0; i < opts.Workers; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					pf.work(ctx)
+				}()
+			}
+			wg.Wait()
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pf, nil
+}
+
+// Implements Prefetcher
+type prefetcher struct {
+	opts  PrefetchOpts
+	mu    sync.Mutex
+	queue []*queuedHint // highest priority first, then oldest first
+	seq   uint64
+	wake  chan struct{} // signaled once per queued hint
+}
+
+type queuedHint struct {
+	hint *PrefetchHint
+	pin  task.Context
+	op   Requester
+	seq  uint64
+}
+
+func (pf *prefetcher) Prefetch(pin task.Context, op Requester, hints ...*PrefetchHint) {
+	pf.mu.Lock()
+	for _, hint := range hints {
+		pf.seq++
+		qh := &queuedHint{
+			hint: hint,
+			pin:  pin,
+			op:   op,
+			seq:  pf.seq,
+		}
+		i := sort.Search(len(pf.queue), func(i int) bool {
+			return pf.queue[i].hint.Priority < hint.Priority
+		})
+		pf.queue = append(pf.queue, nil)
+		copy(pf.queue[i+1:], pf.queue[i:])
+		pf.queue[i] = qh
+	}
+	if over := len(pf.queue) - pf.opts.QueueMax; over > 0 {
+		clear(pf.queue[pf.opts.QueueMax:])
+		pf.queue = pf.queue[:pf.opts.QueueMax]
+	}
+	pf.mu.Unlock()
+
+	for range hints {
+		select {
+		case pf.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (pf *prefetcher) pop() *queuedHint {
+	pf.mu.Lock()
+	defer pf.mu.Unlock()
+
+	if len(pf.queue) == 0 {
+		return nil
+	}
+	qh := pf.queue[0]
+	pf.queue[0] = nil
+	pf.queue = pf.queue[1:]
+	return qh
+}
+
+func (pf *prefetcher) work(ctx task.Context) {
+	for {
+		select {
+		case <-pf.wake:
+		case <-ctx.Closing():
+			return
+		}
+		if qh := pf.pop(); qh != nil {
+			if err := pf.prefetch(qh); err != nil {
+				ctx.Log().Infof(2, "prefetch %q: %v", qh.hint.BlobKey, err)
+			}
+		}
+	}
+}
+
+func (pf *prefetcher) prefetch(qh *queuedHint) error {
+	select {
+	case <-qh.pin.Closing():
+		return nil
+	default:
+	}
+
+	hint := qh.hint
+	if hint.BlobKey == "" || pf.opts.Blobs == nil {
+		return pf.send(qh, hint)
+	}
+	info, err := pf.opts.Blobs.Stat(qh.pin, hint.BlobKey)
+	if err != nil {
+		return pf.send(qh, hint)
+	}
+
+	if info.Size <= pf.opts.PushMax {
+		r, err := pf.opts.Blobs.Open(qh.pin, hint.BlobKey)
+		if err != nil {
+			return err
+		}
+		content, err := io.ReadAll(r)
+		r.Close()
+		if err != nil {
+			return err
+		}
+		return pf.send(qh, &AssetPush{
+			URL:         hint.URL,
+			BlobKey:     hint.BlobKey,
+			ContentType: info.ContentType,
+			ETag:        info.ETag,
+			Content:     content,
+		})
+	}
+
+	if warmer, ok := pf.opts.Blobs.(blob.Warmer); ok {
+		return warmer.Warm(qh.pin, hint.BlobKey)
+	}
+	return pf.send(qh, hint)
+}
+
+// send pushes a meta attr to the hint's requester.
+func (pf *prefetcher) send(qh *queuedHint, val tag.Value) error {
+	tx, err := MarshalAttr(MetaNodeID, val.TagSpec().ID, val)
+	if err != nil {
+		return err
+	}
+	tx.SetContextID(qh.op.Request().ID)
+	tx.Status = OpStatus_Synced
+	return qh.op.PushTx(tx)
+}
@@ -0,0 +1,124 @@
+package amp
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// NewPresenceTable returns an in-memory PresenceTable.
+func NewPresenceTable() PresenceTable {
+	return &presenceTable{
+		cells:    make(map[tag.ID]map[tag.ID]*Presence),
+		watchers: make(map[tag.ID]map[int]func(present []*Presence)),
+	}
+}
+
+// Implements PresenceTable
+type presenceTable struct {
+	mu       sync.Mutex
+	cells    map[tag.ID]map[tag.ID]*Presence // cellID => userID => presence
+	watchers map[tag.ID]map[int]func(present []*Presence)
+	nextID   int
+}
+
+func (tbl *presenceTable) UpdatePresence(cellID tag.ID, userID *Tag, delta int) {
+	if userID == nil || delta == 0 {
+		return
+	}
+	user := *userID // AsID() caches the ID, so don't modify the caller's tag
+	userKey := user.AsID()
+
+	tbl.mu.Lock()
+	users := tbl.cells[cellID]
+	if users == nil {
+		users = make(map[tag.ID]*Presence)
+		tbl.cells[cellID] = users
+	}
+
+	// Presence values are replaced rather than modified, so values returned by GetPresence() remain stable
+	prev := users[userKey]
+	next := &Presence{
+		UserID: &user,
+		Since:  time.Now().Unix(),
+	}
+	if prev != nil {
+		next.PinCount = prev.PinCount
+		next.Since = prev.Since
+	}
+	next.PinCount += int32(delta)
+
+	changed := true
+	if next.PinCount > 0 {
+		users[userKey] = next
+		changed = prev == nil // only joins and leaves are broadcast
+	} else {
+		delete(users, userKey)
+		if len(users) == 0 {
+			delete(tbl.cells, cellID)
+		}
+		changed = prev != nil
+	}
+
+	var watchers []func(present []*Presence)
+	var present []*Presence
+	if changed {
+		for _, fn := range tbl.watchers[cellID] {
+			watchers = append(watchers, fn)
+		}
+		present = tbl.getPresence(cellID)
+	}
+	tbl.mu.Unlock()
+
+	for _, fn := range watchers {
+		fn(present)
+	}
+}
+
+func (tbl *presenceTable) GetPresence(cellID tag.ID) []*Presence {
+	tbl.mu.Lock()
+	defer tbl.mu.Unlock()
+	return tbl.getPresence(cellID)
+}
+
+// caller holds tbl.mu
+func (tbl *presenceTable) getPresence(cellID tag.ID) []*Presence {
+	users := tbl.cells[cellID]
+	present := make([]*Presence, 0, len(users))
+	for _, pi := range users {
+		present = append(present, pi)
+	}
+	sort.Slice(present, func(i, j int) bool {
+		if present[i].Since != present[j].Since {
+			return present[i].Since < present[j].Since
+		}
+		return present[i].UserID.AsLiteral() < present[j].UserID.AsLiteral()
+	})
+	return present
+}
+
+func (tbl *presenceTable) WatchPresence(ctx task.Context, cellID tag.ID, fn func(present []*Presence)) {
+	tbl.mu.Lock()
+	id := tbl.nextID
+	tbl.nextID++
+	watchers := tbl.watchers[cellID]
+	if watchers == nil {
+		watchers = make(map[int]func(present []*Presence))
+		tbl.watchers[cellID] = watchers
+	}
+	watchers[id] = fn
+	tbl.mu.Unlock()
+
+	go func() {
+		<-ctx.Closing()
+		tbl.mu.Lock()
+		delete(tbl.watchers[cellID], id)
+		if len(tbl.watchers[cellID]) == 0 {
+			delete(tbl.watchers, cellID)
+		}
+		tbl.mu.Unlock()
+	}()
+}
@@ -0,0 +1,75 @@
+package amp
+
+import (
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+// RefreshLogin returns the given Login with its credentials replaced by the given checkpoint, as used by Reauther.Reauth().
+// The checkpoint's access token must be unexpired and bound to the Login's current user (see AccessControl.UserForToken), so a session can't switch users.
+func RefreshLogin(ac AccessControl, current Login, checkpoint *LoginCheckpoint) (Login, error) {
+	if checkpoint == nil || checkpoint.AccessToken == "" {
+		return Login{}, ErrNoAuthToken
+	}
+	if checkpoint.Expiry != 0 && checkpoint.Expiry <= time.Now().Unix() {
+		return Login{}, ErrCode_SessionExpired.Error("refreshed token already expired")
+	}
+	if current.UserID == nil {
+		return Login{}, ErrCode_AuthFailed.Error("session not signed in")
+	}
+	userID, err := ac.UserForToken(checkpoint.AccessToken)
+	if err != nil {
+		return Login{}, err
+	}
+	if userID != current.UserID.AsID() {
+		return Login{}, ErrCode_AuthFailed.Error("refreshed token is for a different user")
+	}
+
+	current.Checkpoint = checkpoint
+	return current, nil
+}
+
+// NotifyLoginRefreshed notifies each of the given app instances implementing LoginObserver of the session's refreshed Login.
+func NotifyLoginRefreshed(login Login, apps ...AppInstance) {
+	for _, app := range apps {
+		if observer, ok := app.(LoginObserver); ok {
+			observer.OnLoginRefreshed(login)
+		}
+	}
+}
+
+// HandleReauth performs a Reauth sent by the client, records the outcome in the host's security audit, and replies with the outcome via the session controller.
+func HandleReauth(sess Session, contextID tag.ID, msg *Reauth) error {
+	reply := &Reauth{}
+	login := sess.Login()
+	ev := SecurityEvent{
+		Kind: EventTokenRefreshed,
+	}
+	if login.UserID != nil {
+		ev.UserID = login.UserID.AsID()
+	}
+	if login.DeviceID != nil {
+		ev.DeviceID = login.DeviceID.AsID()
+	}
+
+	err := ErrCode_UnsupportedOp.Error("reauth not supported")
+	if reauther, ok := sess.(Reauther); ok {
+		err = reauther.Reauth(msg.Checkpoint)
+	}
+	if err != nil {
+		reply.Err = ErrorToValue(err).(*Err)
+		ev.Kind = EventTokenRefreshFailed
+		ev.Detail = err.Error()
+	} else {
+		reply.Checkpoint = sess.Login().Checkpoint
+	}
+	if audit := ServicesOf(sess).Audit; audit != nil {
+		audit.RecordEvent(ev)
+	}
+
+	if sendErr := SendMetaAttr(sess, contextID, OpStatus_Synced, reply.TagSpec().ID, reply); err == nil {
+		err = sendErr
+	}
+	return err
+}
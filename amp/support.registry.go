@@ -2,28 +2,77 @@ package amp
 
 import (
 	"reflect"
+	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
 )
 
 func NewRegistry() Registry {
-	reg := &registry{
+	reg := &registry{}
+	reg.snap.Store(&registrySnapshot{
 		appsByInvoke: make(map[string]*App),
 		appsByTag:    make(map[tag.ID]*App),
 		elemDefs:     make(map[tag.ID]AttrDef),
 		attrDefs:     make(map[tag.ID]AttrDef),
-	}
+	})
 	return reg
 }
 
 // Implements Registry
+//
+// Lookups are far more frequent than registrations (which mostly occur as a host or session starts),
+// so lookups read an immutable snapshot without locking while each registration swaps in an updated copy.
 type registry struct {
-	mu           sync.RWMutex
+	mu   sync.Mutex // serializes registrations
+	snap atomic.Pointer[registrySnapshot]
+}
+
+// registrySnapshot is never modified once stored in registry.snap (other than its resolve cache).
+//
+// Since each registration swaps in a new snapshot, a cached resolution never outlives the defs it was resolved against.
+type registrySnapshot struct {
 	appsByInvoke map[string]*App
 	appsByTag    map[tag.ID]*App
 	elemDefs     map[tag.ID]AttrDef
 	attrDefs     map[tag.ID]AttrDef
+	resolved     sync.Map     // spec expr => resolvedSpec
+	numResolved  atomic.Int32 // entries in resolved
+}
+
+// maxResolvedSpecs bounds a snapshot's resolve cache since spec expressions may originate from clients.
+const maxResolvedSpecs = 4096
+
+type resolvedSpec struct {
+	def AttrDef
+	err error
+}
+
+func (snap *registrySnapshot) clone() *registrySnapshot {
+	return &registrySnapshot{
+		appsByInvoke: cloneMap(snap.appsByInvoke),
+		appsByTag:    cloneMap(snap.appsByTag),
+		elemDefs:     cloneMap(snap.elemDefs),
+		attrDefs:     cloneMap(snap.attrDefs),
+	}
+}
+
+func cloneMap[K comparable, V any](src map[K]V) map[K]V {
+	dst := make(map[K]V, len(src)+1)
+	for k, v := range src {
+		dst[k] = v
+	}
+	return dst
+}
+
+// update applies the given edit to a copy of the current snapshot and swaps it in.
+func (reg *registry) update(edit func(snap *registrySnapshot)) {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	snap := reg.snap.Load().clone()
+	edit(snap)
+	reg.snap.Store(snap)
 }
 
 func (reg *registry) RegisterPrototype(context tag.Spec, prototype tag.Value, subTags string) tag.Spec {
@@ -36,71 +85,70 @@ func (reg *registry) RegisterPrototype(context tag.Spec, prototype tag.Value, su
 	}
 
 	attrSpec := context.With(subTags)
-	reg.mu.Lock()
-	defer reg.mu.Unlock()
-	reg.attrDefs[attrSpec.ID] = AttrDef{
-		Spec:      attrSpec,
-		Prototype: prototype,
-	}
+	reg.update(func(snap *registrySnapshot) {
+		snap.attrDefs[attrSpec.ID] = AttrDef{
+			Spec:      attrSpec,
+			Prototype: prototype,
+		}
+	})
 	return attrSpec
 }
 
 func (reg *registry) Import(other Registry) error {
-	src := other.(*registry)
+	src := other.(*registry).snap.Load()
 
-	src.mu.Lock()
-	defer src.mu.Unlock()
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
 
-	{
-		reg.mu.Lock()
-		for _, def := range src.elemDefs {
-			reg.elemDefs[def.ID] = def
-		}
-		for _, def := range src.attrDefs {
-			reg.attrDefs[def.ID] = def
-		}
-		reg.mu.Unlock()
+	// A new session's registry typically starts empty, so it can share the source's (immutable) snapshot outright.
+	dst := reg.snap.Load()
+	if len(dst.appsByTag) == 0 && len(dst.attrDefs) == 0 && len(dst.elemDefs) == 0 {
+		reg.snap.Store(src)
+		return nil
 	}
 
+	dst = dst.clone()
+	for _, def := range src.elemDefs {
+		dst.elemDefs[def.ID] = def
+	}
+	for _, def := range src.attrDefs {
+		dst.attrDefs[def.ID] = def
+	}
 	for _, app := range src.appsByTag {
-		if err := reg.RegisterApp(app); err != nil { //fix me
-			return err
-		}
+		dst.addApp(app)
 	}
+	reg.snap.Store(dst)
 	return nil
 }
 
 // Implements Registry
 func (reg *registry) RegisterApp(app *App) error {
-	appTag := app.AppSpec.ID
-
-	reg.mu.Lock()
-	defer reg.mu.Unlock()
+	reg.update(func(snap *registrySnapshot) {
+		snap.addApp(app)
+	})
+	return nil
+}
 
-	reg.appsByTag[appTag] = app
+func (snap *registrySnapshot) addApp(app *App) {
+	snap.appsByTag[app.AppSpec.ID] = app
 
 	for _, invok := range app.Invocations {
 		if invok != "" {
-			reg.appsByInvoke[invok] = app
+			snap.appsByInvoke[invok] = app
 		}
 	}
 
 	// invoke by full app ID
-	reg.appsByInvoke[app.AppSpec.Canonic] = app
+	snap.appsByInvoke[app.AppSpec.Canonic] = app
 
 	// invoke by first component of app ID
 	_, leafName := app.AppSpec.LeafTags(1)
-	reg.appsByInvoke[leafName] = app
-
-	return nil
+	snap.appsByInvoke[leafName] = app
 }
 
 // Implements Registry
 func (reg *registry) GetAppByTag(appTag tag.ID) (*App, error) {
-	reg.mu.RLock()
-	defer reg.mu.RUnlock()
-
-	app := reg.appsByTag[appTag]
+	app := reg.snap.Load().appsByTag[appTag]
 	if app == nil {
 		return nil, ErrCode_AppNotFound.Errorf("app not found: %s", appTag)
 	} else {
@@ -108,16 +156,33 @@ func (reg *registry) GetAppByTag(appTag tag.ID) (*App, error) {
 	}
 }
 
+// Implements Registry
+func (reg *registry) ListApps() []*App {
+	snap := reg.snap.Load()
+	apps := make([]*App, 0, len(snap.appsByTag))
+	for _, app := range snap.appsByTag {
+		apps = append(apps, app)
+	}
+	return apps
+}
+
+// Implements Registry
+func (reg *registry) ListAttrs() []AttrDef {
+	snap := reg.snap.Load()
+	defs := make([]AttrDef, 0, len(snap.attrDefs))
+	for _, def := range snap.attrDefs {
+		defs = append(defs, def)
+	}
+	return defs
+}
+
 // Implements Registry
 func (reg *registry) GetAppForInvocation(invocation string) (*App, error) {
 	if invocation == "" {
 		return nil, ErrCode_AppNotFound.Errorf("missing app invocation")
 	}
 
-	reg.mu.RLock()
-	defer reg.mu.RUnlock()
-
-	app := reg.appsByInvoke[invocation]
+	app := reg.snap.Load().appsByInvoke[invocation]
 	if app == nil {
 		return nil, ErrCode_AppNotFound.Errorf("app not found for invocation %q", invocation)
 	}
@@ -125,12 +190,13 @@ func (reg *registry) GetAppForInvocation(invocation string) (*App, error) {
 }
 
 func (reg *registry) MakeValue(attrSpec tag.ID) (tag.Value, error) {
+	snap := reg.snap.Load()
 
 	// Often, an attrID will be a unnamed scalar attr (which means we can get the elemDef directly.
 	// This is also essential during bootstrapping when the client sends a RegisterDefs is not registered yet.
-	def, exists := reg.elemDefs[attrSpec]
+	def, exists := snap.elemDefs[attrSpec]
 	if !exists {
-		def, exists = reg.attrDefs[attrSpec]
+		def, exists = snap.attrDefs[attrSpec]
 		if !exists {
 			return nil, ErrCode_AttrNotFound.Errorf("MakeValue: attr %s not found", attrSpec.String())
 		}
@@ -138,6 +204,28 @@ func (reg *registry) MakeValue(attrSpec tag.ID) (tag.Value, error) {
 	return def.Prototype.New(), nil
 }
 
+func (reg *registry) ResolveAttrSpec(expr string) (AttrDef, error) {
+	snap := reg.snap.Load()
+	if cached, ok := snap.resolved.Load(expr); ok {
+		res := cached.(*resolvedSpec)
+		return res.def, res.err
+	}
+
+	spec := tag.ParseSpec(expr)
+	res := &resolvedSpec{}
+	if def, exists := snap.attrDefs[spec.ID]; exists {
+		res.def = def
+	} else if def, exists = snap.elemDefs[spec.ID]; exists {
+		res.def = def
+	} else {
+		res.err = ErrCode_AttrNotFound.Errorf("ResolveAttrSpec: %q not found", expr)
+	}
+	if snap.numResolved.Add(1) <= maxResolvedSpecs {
+		snap.resolved.Store(strings.Clone(expr), res)
+	}
+	return res.def, res.err
+}
+
 /*
 func (reg *registry) RegisterDefs(defs *RegisterDefs) error {
 
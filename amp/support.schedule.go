@@ -0,0 +1,295 @@
+package amp
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// ScheduleStore persists the scheduled txs of a Scheduler.
+type ScheduleStore interface {
+	PutScheduled(st *ScheduledTx) error
+	DeleteScheduled(scheduleID tag.ID) error
+	LoadScheduled() ([]*ScheduledTx, error)
+}
+
+// SchedulerOpts configures StartScheduler().
+type SchedulerOpts struct {
+	Store       ScheduleStore                          // if nil, scheduled txs do not survive a restart
+	Fire        func(st *ScheduledTx, tx *TxMsg) error // delivers a due tx (typically to the app's ScheduledTxHandler)
+	RetryDelay  time.Duration                          // delay before retrying a failed delivery (default 1 minute)
+	MaxAttempts int                                    // failed deliveries before a scheduled tx is dropped (default 10)
+}
+
+// StartScheduler loads any persisted scheduled txs and starts a Scheduler as a child of the given context.
+func StartScheduler(parent task.Context, opts SchedulerOpts) (Scheduler, error) {
+	if opts.RetryDelay <= 0 {
+		opts.RetryDelay = time.Minute
+	}
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = 10
+	}
+
+	sch := &scheduler{
+		opts:    opts,
+		pending: make(map[tag.ID]*ScheduledTx),
+		wake:    make(chan struct{}, 1),
+	}
+	if opts.Store != nil {
+		loaded, err := opts.Store.LoadScheduled()
+		if err != nil {
+			return nil, err
+		}
+		for _, st := range loaded {
+			sch.pending[st.ID.AsID()] = st
+		}
+	}
+
+	_, err := parent.StartChild(&task.Task{
+		Info: task.Info{
+			Label: "scheduler",
+		},
+		OnRun: sch.run,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sch, nil
+}
+
+// Implements Scheduler
+type scheduler struct {
+	mu      sync.Mutex
+	opts    SchedulerOpts
+	pending map[tag.ID]*ScheduledTx
+	wake    chan struct{}
+}
+
+func (sch *scheduler) Schedule(appID tag.ID, fireAt time.Time, label string, tx *TxMsg) (tag.ID, error) {
+	scheduleID := tag.Now()
+	st := &ScheduledTx{
+		ID:     &Tag{},
+		AppID:  &Tag{},
+		FireAt: fireAt.Unix(),
+		Label:  label,
+	}
+	st.ID.SetID(scheduleID)
+	st.AppID.SetID(appID)
+	tx.MarshalToBuffer(&st.Tx)
+
+	if store := sch.opts.Store; store != nil {
+		if err := store.PutScheduled(st); err != nil {
+			return tag.ID{}, err
+		}
+	}
+
+	sch.mu.Lock()
+	sch.pending[scheduleID] = st
+	sch.mu.Unlock()
+
+	sch.signal()
+	return scheduleID, nil
+}
+
+func (sch *scheduler) Cancel(scheduleID tag.ID) error {
+	sch.mu.Lock()
+	_, exists := sch.pending[scheduleID]
+	delete(sch.pending, scheduleID)
+	sch.mu.Unlock()
+
+	if !exists {
+		return ErrNotScheduled
+	}
+	if store := sch.opts.Store; store != nil {
+		return store.DeleteScheduled(scheduleID)
+	}
+	return nil
+}
+
+func (sch *scheduler) ListScheduled(appID tag.ID) []*ScheduledTx {
+	sch.mu.Lock()
+	var list []*ScheduledTx
+	for _, st := range sch.pending {
+		if st.AppID.AsID() == appID {
+			list = append(list, st)
+		}
+	}
+	sch.mu.Unlock()
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].FireAt < list[j].FireAt
+	})
+	return list
+}
+
+func (sch *scheduler) signal() {
+	select {
+	case sch.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (sch *scheduler) run(ctx task.Context) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+		case <-sch.wake:
+		case <-ctx.Closing():
+			return
+		}
+
+		for _, st := range sch.takeDue(time.Now().Unix()) {
+			sch.fire(ctx, st)
+		}
+
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		timer.Reset(sch.untilNext())
+	}
+}
+
+// Removes and returns scheduled txs that are due.
+func (sch *scheduler) takeDue(now int64) []*ScheduledTx {
+	sch.mu.Lock()
+	defer sch.mu.Unlock()
+
+	var due []*ScheduledTx
+	for scheduleID, st := range sch.pending {
+		if st.FireAt <= now {
+			due = append(due, st)
+			delete(sch.pending, scheduleID)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool {
+		return due[i].FireAt < due[j].FireAt
+	})
+	return due
+}
+
+func (sch *scheduler) untilNext() time.Duration {
+	sch.mu.Lock()
+	defer sch.mu.Unlock()
+
+	next := int64(0)
+	for _, st := range sch.pending {
+		if next == 0 || st.FireAt < next {
+			next = st.FireAt
+		}
+	}
+	if next == 0 {
+		return time.Hour
+	}
+	return max(time.Until(time.Unix(next, 0)), 0)
+}
+
+func (sch *scheduler) fire(ctx task.Context, st *ScheduledTx) {
+	scheduleID := st.ID.AsID()
+	tx, err := ReadTxMsg(bytes.NewReader(st.Tx))
+	if err == nil {
+		err = sch.opts.Fire(st, tx)
+	}
+
+	if err != nil {
+		st.Attempts++
+		if int(st.Attempts) < sch.opts.MaxAttempts {
+			ctx.Log().Warnf("scheduled tx %q failed (attempt %d): %v", st.Label, st.Attempts, err)
+			st.FireAt = time.Now().Add(sch.opts.RetryDelay).Unix()
+			if store := sch.opts.Store; store != nil {
+				store.PutScheduled(st)
+			}
+			sch.mu.Lock()
+			sch.pending[scheduleID] = st
+			sch.mu.Unlock()
+			return
+		}
+		ctx.Log().Errorf("scheduled tx %q dropped after %d attempts: %v", st.Label, st.Attempts, err)
+	}
+
+	if store := sch.opts.Store; store != nil {
+		if err := store.DeleteScheduled(scheduleID); err != nil {
+			ctx.Log().Warnf("scheduled tx %q: %v", st.Label, err)
+		}
+	}
+}
+
+// NewFileScheduleStore returns a ScheduleStore that keeps each scheduled tx as a file in the given directory.
+func NewFileScheduleStore(dirPath string) (ScheduleStore, error) {
+	if err := os.MkdirAll(dirPath, 0700); err != nil {
+		return nil, err
+	}
+	return &fileScheduleStore{
+		dirPath: dirPath,
+	}, nil
+}
+
+// Implements ScheduleStore
+type fileScheduleStore struct {
+	dirPath string
+}
+
+const scheduledTxExt = ".scheduled"
+
+func (store *fileScheduleStore) pathname(scheduleID tag.ID) string {
+	return filepath.Join(store.dirPath, scheduleID.Base32()+scheduledTxExt)
+}
+
+func (store *fileScheduleStore) PutScheduled(st *ScheduledTx) error {
+	buf, err := st.Marshal()
+	if err != nil {
+		return err
+	}
+
+	// write then rename so a crash never leaves a partial file
+	pathname := store.pathname(st.ID.AsID())
+	tmp := pathname + ".tmp"
+	if err = os.WriteFile(tmp, buf, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, pathname)
+}
+
+func (store *fileScheduleStore) DeleteScheduled(scheduleID tag.ID) error {
+	err := os.Remove(store.pathname(scheduleID))
+	if os.IsNotExist(err) {
+		err = nil
+	}
+	return err
+}
+
+func (store *fileScheduleStore) LoadScheduled() ([]*ScheduledTx, error) {
+	entries, err := os.ReadDir(store.dirPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var loaded []*ScheduledTx
+	for _, entry := range entries {
+		if !strings.HasSuffix(entry.Name(), scheduledTxExt) {
+			continue
+		}
+		buf, err := os.ReadFile(filepath.Join(store.dirPath, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		st := &ScheduledTx{}
+		if err = st.Unmarshal(buf); err != nil {
+			return nil, err
+		}
+		loaded = append(loaded, st)
+	}
+	return loaded, nil
+}
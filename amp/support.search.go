@@ -0,0 +1,35 @@
+package amp
+
+import (
+	"sort"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+// MergeSearchHits merges ranked hit lists into a single list ranked by descending score.
+// If a cell appears more than once, only its highest scoring hit is retained.
+// If limit > 0, the result is truncated to at most limit hits.
+func MergeSearchHits(limit int, lists ...[]SearchHit) []SearchHit {
+	best := make(map[tag.ID]int)
+	var merged []SearchHit
+	for _, list := range lists {
+		for _, hit := range list {
+			if idx, exists := best[hit.CellID]; exists {
+				if hit.Score > merged[idx].Score {
+					merged[idx] = hit
+				}
+				continue
+			}
+			best[hit.CellID] = len(merged)
+			merged = append(merged, hit)
+		}
+	}
+
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].Score > merged[j].Score
+	})
+	if limit > 0 && len(merged) > limit {
+		merged = merged[:limit]
+	}
+	return merged
+}
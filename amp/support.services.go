@@ -0,0 +1,16 @@
+package amp
+
+import (
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// ServicesOf returns the Services offered by the given Host or Session (see ServiceProvider), or empty Services if it offers none.
+// The returned Services are never nil, so a caller need only check the service it uses.
+func ServicesOf(ctx task.Context) *Services {
+	if provider, ok := ctx.(ServiceProvider); ok {
+		if services := provider.Services(); services != nil {
+			return services
+		}
+	}
+	return &Services{}
+}
@@ -0,0 +1,147 @@
+package amp
+
+import (
+	"encoding/binary"
+	"math/bits"
+	"slices"
+	"unsafe"
+)
+
+// The op encoding (see MarshalOps) is:
+//
+//	skip (uvarint, always 0) | OpCode (uvarint) | DataLen (uvarint) | DataOfs (uvarint) | hasFields (uvarint) | changed ID words (8 bytes LE each)
+//
+// where bit TxField_CellID_0+i of hasFields is set if word i of the op's TxOpID differs from the previous op's.
+const (
+	opIDWords   = 12 // uint64 words in a TxOpID
+	maxOpLen    = 5*binary.MaxVarintLen64 + opIDWords*8
+	opFieldBase = uint(TxField_CellID_0)   // bit of hasFields for the first TxOpID word
+	opFieldMask = 1<<TxField_MaxFields - 1 // bits of hasFields that denote a following word
+)
+
+// opIDWordsOf returns the words of the given TxOpID, which is laid out as 4 consecutive tag.IDs (CellID, AttrID, ItemID, EditID).
+func opIDWordsOf(id *TxOpID) *[opIDWords]uint64 {
+	return (*[opIDWords]uint64)(unsafe.Pointer(id))
+}
+
+// putUvarint is binary.PutUvarint with a fast path for the single byte values that dominate an op.
+func putUvarint(buf []byte, v uint64) int {
+	if v < 0x80 {
+		buf[0] = byte(v)
+		return 1
+	}
+	return binary.PutUvarint(buf, v)
+}
+
+// uvarint is binary.Uvarint with a fast path for single byte values, returning n <= 0 on malformed input.
+func uvarint(buf []byte) (uint64, int) {
+	if len(buf) > 0 && buf[0] < 0x80 {
+		return uint64(buf[0]), 1
+	}
+	return binary.Uvarint(buf)
+}
+
+// appendOps appends the encoding of the given ops to dst.
+// Each op is written into space reserved up front, so the loop performs no appends and no per-field bounds checks.
+func appendOps(dst []byte, ops []TxOp) []byte {
+	var prev [opIDWords]uint64
+	for i := range ops {
+		op := &ops[i]
+		if cap(dst)-len(dst) < maxOpLen {
+			dst = slices.Grow(dst, maxOpLen*min(len(ops)-i, 64))
+		}
+		n := len(dst)
+		buf := dst[n : n+maxOpLen]
+
+		p := 0
+		buf[p] = 0 // skip bytes (future use)
+		p++
+		p += putUvarint(buf[p:], uint64(op.OpCode))
+		p += putUvarint(buf[p:], op.DataLen)
+		p += putUvarint(buf[p:], op.DataOfs)
+
+		// write only the ID words that differ from the previous op (with corresponding flags)
+		cur := opIDWordsOf(&op.TxOpID)
+		hasFields := uint64(0)
+		for w := range cur {
+			if cur[w] != prev[w] {
+				hasFields |= 1 << (opFieldBase + uint(w))
+			}
+		}
+		p += putUvarint(buf[p:], hasFields)
+		for changed := hasFields >> opFieldBase; changed != 0; changed &= changed - 1 {
+			binary.LittleEndian.PutUint64(buf[p:p+8], cur[bits.TrailingZeros64(changed)])
+			p += 8
+		}
+		prev = *cur
+		dst = dst[:n+p]
+	}
+	return dst
+}
+
+// readOps decodes opCount ops from src (see appendOps), appending them to ops.
+func readOps(ops []TxOp, src []byte, opCount uint64) ([]TxOp, error) {
+
+	// the smallest op encoding is 5 bytes, so a bogus opCount can't force a large allocation
+	if reserve := min(opCount, uint64(len(src)/5)); uint64(cap(ops)-len(ops)) < reserve {
+		grown := make([]TxOp, len(ops), len(ops)+int(reserve))
+		copy(grown, ops)
+		ops = grown
+	}
+
+	var cur [TxField_MaxFields]uint64
+	p := 0
+	for i := uint64(0); i < opCount; i++ {
+		var op TxOp
+
+		// skip (future use)
+		skip, n := uvarint(src[p:])
+		if n <= 0 || skip > uint64(len(src)-p-n) {
+			return ops, ErrMalformedTx
+		}
+		p += n + int(skip)
+
+		var opCode uint64
+		if opCode, n = uvarint(src[p:]); n <= 0 {
+			return ops, ErrMalformedTx
+		}
+		p += n
+		op.OpCode = TxOpCode(opCode)
+
+		if op.DataLen, n = uvarint(src[p:]); n <= 0 {
+			return ops, ErrMalformedTx
+		}
+		p += n
+
+		if op.DataOfs, n = uvarint(src[p:]); n <= 0 {
+			return ops, ErrMalformedTx
+		}
+		p += n
+
+		var hasFields uint64
+		if hasFields, n = uvarint(src[p:]); n <= 0 {
+			return ops, ErrMalformedTx
+		}
+		p += n
+
+		// check the length of all the words present at once so reading each needs no check
+		words := hasFields & opFieldMask
+		need := 8 * bits.OnesCount64(words)
+		if need > len(src)-p {
+			return ops, ErrMalformedTx
+		}
+		fields := src[p : p+need]
+		p += need
+		for f := 0; words != 0; f++ {
+			if words&1 != 0 {
+				cur[f] = binary.LittleEndian.Uint64(fields)
+				fields = fields[8:]
+			}
+			words >>= 1
+		}
+
+		copy(opIDWordsOf(&op.TxOpID)[:], cur[opFieldBase:opFieldBase+opIDWords])
+		ops = append(ops, op)
+	}
+	return ops, nil
+}
@@ -3,6 +3,8 @@ package amp
 import (
 	"encoding/binary"
 	"io"
+	"net"
+	"slices"
 	"sort"
 	"sync"
 	"sync/atomic"
@@ -26,6 +28,20 @@ func (header TxHeader) TxDataLen() int {
 	return int(binary.LittleEndian.Uint32(header[8:12]))
 }
 
+// TxLen returns the total byte length of the tx this header leads (including the header), or ErrMalformedTx if the header is invalid.
+// A reader of untrusted input can check this against the bytes available before reading the tx.
+func (header TxHeader) TxLen() (int64, error) {
+	marker := uint32(header[0])<<16 | uint32(header[1])<<8 | uint32(header[2])
+	if marker != uint32(Const_TxHeader_Marker) || header[3] < byte(Const_TxHeader_Version) {
+		return 0, ErrMalformedTx
+	}
+	bodyLen := header.TxBodyLen()
+	if bodyLen < int(Const_TxHeader_Size) {
+		return 0, ErrMalformedTx
+	}
+	return int64(bodyLen) + int64(header.TxDataLen()), nil
+}
+
 func NewTxMsg(genesis bool) *TxMsg {
 	tx := gTxMsgPool.Get().(*TxMsg)
 	tx.refCount = 1
@@ -232,16 +248,24 @@ func (tx *TxMsg) MarshalOpWithBuf(op *TxOp, valBuf []byte) {
 	tx.Ops = append(tx.Ops, *op)
 }
 
+// AppendOps appends the ops (and their values) of src to this tx, e.g. to combine txs marshalled concurrently.
+func (tx *TxMsg) AppendOps(src *TxMsg) {
+	base := uint64(len(tx.DataStore))
+	tx.DataStore = append(tx.DataStore, src.DataStore...)
+	for _, op := range src.Ops {
+		if op.DataLen > 0 {
+			op.DataOfs += base
+		}
+		tx.Ops = append(tx.Ops, op)
+	}
+	tx.OpCount += uint64(len(src.Ops))
+}
+
 func ReadTxMsg(stream io.Reader) (*TxMsg, error) {
+	// io.ReadFull tolerates a reader returning io.EOF alongside the final bytes (e.g. archive/tar)
 	readBytes := func(dst []byte) error {
-		for L := 0; L < len(dst); {
-			n, err := stream.Read(dst[L:])
-			if err != nil {
-				return err
-			}
-			L += n
-		}
-		return nil
+		_, err := io.ReadFull(stream, dst)
+		return err
 	}
 
 	var header TxHeader
@@ -249,12 +273,8 @@ func ReadTxMsg(stream io.Reader) (*TxMsg, error) {
 		return nil, err
 	}
 
-	marker := uint32(header[0])<<16 | uint32(header[1])<<8 | uint32(header[2])
-	if marker != uint32(Const_TxHeader_Marker) {
-		return nil, ErrMalformedTx
-	}
-	if header[3] < byte(Const_TxHeader_Version) {
-		return nil, ErrMalformedTx
+	if _, err := header.TxLen(); err != nil {
+		return nil, err
 	}
 
 	tx := NewTxMsg(false)
@@ -317,21 +337,50 @@ func (tx *TxMsg) MarshalToBuffer(dst *[]byte) {
 func (tx *TxMsg) MarshalHeaderAndOps(dst *[]byte) {
 	buf := (*dst)[:0]
 	if cap(buf) < 300 {
-		buf = make([]byte, 2048)
+		buf = make([]byte, 0, 2048)
 	}
+	*dst = tx.AppendHeaderAndOps(buf)
+}
 
-	headerAndOps := tx.MarshalOps(buf[:Const_TxHeader_Size])
+// AppendHeaderAndOps appends this tx's header and ops to dst, such that its DataStore completes the serialized tx.
+func (tx *TxMsg) AppendHeaderAndOps(dst []byte) []byte {
+	start := len(dst)
+	var blank TxHeader
+	headerAndOps := tx.MarshalOps(append(dst, blank[:]...))
 
-	header := headerAndOps[:Const_TxHeader_Size]
+	header := headerAndOps[start : start+int(Const_TxHeader_Size)]
 	header[0] = byte((Const_TxHeader_Marker >> 16) & 0xFF)
 	header[1] = byte((Const_TxHeader_Marker >> 8) & 0xFF)
 	header[2] = byte((Const_TxHeader_Marker >> 0) & 0xFF)
 	header[3] = byte(Const_TxHeader_Version)
 
-	binary.LittleEndian.PutUint32(header[4:8], uint32(len(headerAndOps)))
+	binary.LittleEndian.PutUint32(header[4:8], uint32(len(headerAndOps)-start))
 	binary.LittleEndian.PutUint32(header[8:12], uint32(len(tx.DataStore)))
 
-	*dst = headerAndOps
+	return headerAndOps
+}
+
+// WriteTxBatch writes the given txs to w as successive MarshalToWriter calls would, but as a single vectored write if w supports it (e.g. a *net.TCPConn).
+// Headers and ops are marshalled into scrap while each tx's DataStore is written in place rather than copied.
+func WriteTxBatch(w io.Writer, txs []*TxMsg, scrap *[]byte) (int64, error) {
+	headers := (*scrap)[:0]
+	ends := make([]int, len(txs))
+	for i, tx := range txs {
+		headers = tx.AppendHeaderAndOps(headers)
+		ends[i] = len(headers)
+	}
+	*scrap = headers
+
+	bufs := make(net.Buffers, 0, 2*len(txs))
+	start := 0
+	for i, tx := range txs {
+		bufs = append(bufs, headers[start:ends[i]])
+		if len(tx.DataStore) > 0 {
+			bufs = append(bufs, tx.DataStore)
+		}
+		start = ends[i]
+	}
+	return bufs.WriteTo(w)
 }
 
 func (tx *TxMsg) MarshalOps(dst []byte) []byte {
@@ -343,58 +392,11 @@ func (tx *TxMsg) MarshalOps(dst []byte) []byte {
 		dst = binary.AppendUvarint(dst, uint64(infoLen))
 
 		p := len(dst)
-		dst = append(dst, make([]byte, infoLen)...)
+		dst = slices.Grow(dst, infoLen+maxOpLen*min(len(tx.Ops), 64))[:p+infoLen]
 		tx.TxEnvelope.MarshalToSizedBuffer(dst[p : p+infoLen])
 	}
 
-	var (
-		op_prv [TxField_MaxFields]uint64
-		op_cur [TxField_MaxFields]uint64
-	)
-
-	for _, op := range tx.Ops {
-		dst = binary.AppendUvarint(dst, 0) // skip bytes (future use)
-		dst = binary.AppendUvarint(dst, uint64(op.OpCode))
-		dst = binary.AppendUvarint(dst, op.DataLen)
-		dst = binary.AppendUvarint(dst, op.DataOfs)
-
-		// detect body repeated fields and write only what changes (with corresponding flags)
-		{
-			op_cur[TxField_CellID_0] = op.CellID[0]
-			op_cur[TxField_CellID_1] = op.CellID[1]
-			op_cur[TxField_CellID_2] = op.CellID[2]
-
-			op_cur[TxField_AttrID_0] = op.AttrID[0]
-			op_cur[TxField_AttrID_1] = op.AttrID[1]
-			op_cur[TxField_AttrID_2] = op.AttrID[2]
-
-			op_cur[TxField_ItemID_0] = op.ItemID[0]
-			op_cur[TxField_ItemID_1] = op.ItemID[1]
-			op_cur[TxField_ItemID_2] = op.ItemID[2]
-
-			op_cur[TxField_EditID_0] = op.EditID[0]
-			op_cur[TxField_EditID_1] = op.EditID[1]
-			op_cur[TxField_EditID_2] = op.EditID[2]
-
-			hasFields := uint64(0)
-			for i, fi := range op_cur {
-				if fi != op_prv[i] {
-					hasFields |= (1 << i)
-				}
-			}
-
-			dst = binary.AppendUvarint(dst, hasFields)
-			for i, fi := range op_cur {
-				if hasFields&(1<<i) != 0 {
-					dst = binary.LittleEndian.AppendUint64(dst, fi)
-				}
-			}
-
-			op_prv = op_cur
-		}
-	}
-
-	return dst
+	return appendOps(dst, tx.Ops)
 }
 
 func (tx *TxMsg) UnmarshalBody(src []byte) error {
@@ -416,78 +418,9 @@ func (tx *TxMsg) UnmarshalBody(src []byte) error {
 		p += int(infoLen)
 	}
 
-	var (
-		op_cur [TxField_MaxFields]uint64
-	)
-
-	for i := uint64(0); i < tx.OpCount; i++ {
-		var op TxOp
-		var n int
-
-		// skip (future use)
-		var skip uint64
-		if skip, n = binary.Uvarint(src[p:]); n <= 0 {
-			return ErrMalformedTx
-		}
-		p += n + int(skip)
-
-		// OpCode
-		var opCode uint64
-		if opCode, n = binary.Uvarint(src[p:]); n <= 0 {
-			return ErrMalformedTx
-		}
-		p += n
-		op.OpCode = TxOpCode(opCode)
-
-		// DataLen
-		if op.DataLen, n = binary.Uvarint(src[p:]); n <= 0 {
-			return ErrMalformedTx
-		}
-		p += n
-
-		// DataOfs
-		if op.DataOfs, n = binary.Uvarint(src[p:]); n <= 0 {
-			return ErrMalformedTx
-		}
-		p += n
-
-		// hasFields
-		var hasFields uint64
-		if hasFields, n = binary.Uvarint(src[p:]); n <= 0 {
-			return ErrMalformedTx
-		}
-		p += n
-
-		for i := 0; i < int(TxField_MaxFields); i++ {
-			if hasFields&(1<<i) != 0 {
-				if p+8 > len(src) {
-					return ErrMalformedTx
-				}
-				op_cur[i] = binary.LittleEndian.Uint64(src[p:])
-				p += 8
-			}
-		}
-
-		op.CellID[0] = op_cur[TxField_CellID_0]
-		op.CellID[1] = op_cur[TxField_CellID_1]
-		op.CellID[2] = op_cur[TxField_CellID_2]
-
-		op.AttrID[0] = op_cur[TxField_AttrID_0]
-		op.AttrID[1] = op_cur[TxField_AttrID_1]
-		op.AttrID[2] = op_cur[TxField_AttrID_2]
-
-		op.ItemID[0] = op_cur[TxField_ItemID_0]
-		op.ItemID[1] = op_cur[TxField_ItemID_1]
-		op.ItemID[2] = op_cur[TxField_ItemID_2]
-
-		op.EditID[0] = op_cur[TxField_EditID_0]
-		op.EditID[1] = op_cur[TxField_EditID_1]
-		op.EditID[2] = op_cur[TxField_EditID_2]
-
-		tx.Ops = append(tx.Ops, op)
-	}
-
-	return nil
+	var err error
+	tx.Ops, err = readOps(tx.Ops, src[p:], tx.OpCount)
+	return err
 }
 
 func (op *TxOpID) CompareTo(oth *TxOpID) int {
@@ -505,3 +438,23 @@ func (op *TxOpID) CompareTo(oth *TxOpID) int {
 	}
 	return 0
 }
+
+// IsEphemeral returns true if this op is only delivered to current pins, meaning it must not be persisted or replayed.
+func (op *TxOp) IsEphemeral() bool {
+	return op.OpCode == TxOpCode_EphemeralElement
+}
+
+// DropEphemeral removes ephemeral ops from this tx (typically before it is persisted), returning the number of ops removed.
+// The DataStore is left intact, so the remaining ops remain valid.
+func (tx *TxMsg) DropEphemeral() int {
+	kept := tx.Ops[:0]
+	for _, op := range tx.Ops {
+		if !op.IsEphemeral() {
+			kept = append(kept, op)
+		}
+	}
+	dropped := len(tx.Ops) - len(kept)
+	tx.Ops = kept
+	tx.OpCount = uint64(len(kept))
+	return dropped
+}
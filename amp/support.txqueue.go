@@ -0,0 +1,243 @@
+package amp
+
+import (
+	"sync"
+	"time"
+)
+
+// SendTxBatch sends the given txs over via, in a single call if via is a BatchSender, otherwise one at a time.
+func SendTxBatch(via Transport, txs []*TxMsg) error {
+	if batcher, ok := via.(BatchSender); ok {
+		return batcher.SendTxs(txs)
+	}
+	for _, tx := range txs {
+		if err := via.SendTx(tx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TxSendQueueOpts specifies a TxSendQueue.
+type TxSendQueueOpts struct {
+	MaxBatch  int           // most txs handed to the Transport at once; if <= 0, 64
+	Depth     int           // txs queued before Send blocks; if <= 0, 256
+	MaxLinger time.Duration // upper bound of the adaptive batching window; if 0, 25ms, and if < 0, txs are never held back
+}
+
+// TxSendQueueStats reports what a TxSendQueue has measured of its client's link.
+type TxSendQueueStats struct {
+	RTT       time.Duration // smoothed round trip time -- see ObserveRTT()
+	DrainRate float64       // smoothed bytes per second the Transport accepts while sending
+	Window    time.Duration // how long a batch is currently held open for more txs
+}
+
+// TxSendQueue sends txs over a Transport from its own goroutine, handing it every tx that is ready at once as a single batch (see BatchSender).
+// While the Transport is busy sending, txs accumulate, so batches grow with load without adding latency when idle.
+//
+// Further, a batch is held open for a window that adapts to the link: the time to drain an average tx at the measured drain rate plus an eighth of the RTT (at most MaxLinger).
+// So a fast local link sends each tx with negligible delay, while a slow mobile link is sent fewer, larger txs.
+//
+// A HostSession sends its txs via a TxSendQueue so that a Transport over TCP or QUIC can issue one write per flush rather than per tx.
+type TxSendQueue struct {
+	via     Transport
+	opts    TxSendQueueOpts
+	txs     chan *TxMsg
+	closing chan struct{}
+	done    chan struct{}
+
+	closeOnce sync.Once
+	sends     sync.WaitGroup // Send() calls in progress, which Close() waits for before stopping run()
+	mu        sync.Mutex
+	closed    bool             // set by Close(), after which Send() fails -- guarded by mu
+	err       error            // first error from the Transport, after which txs are discarded
+	stats     TxSendQueueStats // guarded by mu
+	avgTx     float64          // smoothed bytes per tx, guarded by mu
+}
+
+const (
+	minLinger   = 50 * time.Microsecond // shorter windows are not worth a timer
+	approxOpLen = 48                    // approximate marshalled size of a TxOp
+)
+
+// NewTxSendQueue starts a TxSendQueue sending over via until closed.
+func NewTxSendQueue(via Transport, opts TxSendQueueOpts) *TxSendQueue {
+	if opts.MaxBatch <= 0 {
+		opts.MaxBatch = 64
+	}
+	if opts.Depth <= 0 {
+		opts.Depth = 256
+	}
+	if opts.MaxLinger == 0 {
+		opts.MaxLinger = 25 * time.Millisecond
+	}
+	q := &TxSendQueue{
+		via:     via,
+		opts:    opts,
+		txs:     make(chan *TxMsg, opts.Depth),
+		closing: make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	go q.run()
+	return q
+}
+
+// Send queues the given tx, blocking while the queue is full.
+// As with Transport.SendTx, the caller retains its reference to tx.
+// Once sending has failed (e.g. the Transport closed), that error is returned, and once closed, ErrStreamClosed.
+func (q *TxSendQueue) Send(tx *TxMsg) error {
+	q.mu.Lock()
+	err := q.err
+	if err == nil && q.closed {
+		err = ErrStreamClosed
+	}
+	if err == nil {
+		q.sends.Add(1)
+	}
+	q.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	defer q.sends.Done()
+
+	// run() drains q.txs until every Send() in progress is done, so a tx queued here is never stranded
+	tx.AddRef()
+	q.txs <- tx
+	return nil
+}
+
+// Err returns the error that stopped sending, if any.
+func (q *TxSendQueue) Err() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.err
+}
+
+// ObserveRTT adds a round trip time sample (e.g. from a heartbeat) to this queue's estimate of its client's link.
+func (q *TxSendQueue) ObserveRTT(rtt time.Duration) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.stats.RTT == 0 {
+		q.stats.RTT = rtt
+	} else {
+		q.stats.RTT += (rtt - q.stats.RTT) / 8
+	}
+	q.updateWindow()
+}
+
+// Stats returns what this queue has measured of its client's link.
+func (q *TxSendQueue) Stats() TxSendQueueStats {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.stats
+}
+
+// observeSend adds a batch send to the drain rate and tx size estimates.
+func (q *TxSendQueue) observeSend(batch []*TxMsg, elapsed time.Duration) {
+	bytes := 0
+	for _, tx := range batch {
+		bytes += int(Const_TxHeader_Size) + len(tx.Ops)*approxOpLen + len(tx.DataStore)
+	}
+	rate := float64(bytes) / max(elapsed, time.Microsecond).Seconds()
+	perTx := float64(bytes) / float64(len(batch))
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.stats.DrainRate == 0 {
+		q.stats.DrainRate, q.avgTx = rate, perTx
+	} else {
+		q.stats.DrainRate += (rate - q.stats.DrainRate) / 8
+		q.avgTx += (perTx - q.avgTx) / 8
+	}
+	q.updateWindow()
+}
+
+func (q *TxSendQueue) updateWindow() {
+	if q.opts.MaxLinger < 0 {
+		return
+	}
+	window := q.stats.RTT / 8
+	if q.stats.DrainRate > 0 {
+		window += time.Duration(q.avgTx / q.stats.DrainRate * float64(time.Second))
+	}
+	q.stats.Window = min(window, q.opts.MaxLinger)
+}
+
+// Close stops the queue once any Send() in progress returns and the txs already queued are sent, returning once done.
+func (q *TxSendQueue) Close() error {
+	q.closeOnce.Do(func() {
+		q.mu.Lock()
+		q.closed = true
+		q.mu.Unlock()
+		q.sends.Wait()
+		close(q.closing)
+	})
+	<-q.done
+	return nil
+}
+
+func (q *TxSendQueue) run() {
+	defer close(q.done)
+	batch := make([]*TxMsg, 0, q.opts.MaxBatch)
+	for {
+		var tx *TxMsg
+		select {
+		case tx = <-q.txs:
+		case <-q.closing:
+			select {
+			case tx = <-q.txs:
+			default:
+				return
+			}
+		}
+
+		// take whatever else is ready, up to MaxBatch
+		batch = append(batch[:0], tx)
+	gather:
+		for len(batch) < q.opts.MaxBatch {
+			select {
+			case tx = <-q.txs:
+				batch = append(batch, tx)
+			default:
+				break gather
+			}
+		}
+		if len(batch) < q.opts.MaxBatch {
+			if window := q.Stats().Window; window >= minLinger {
+				batch = q.linger(batch, window)
+			}
+		}
+
+		if q.Err() == nil {
+			start := time.Now()
+			if err := SendTxBatch(q.via, batch); err != nil {
+				q.mu.Lock()
+				q.err = err
+				q.mu.Unlock()
+			} else {
+				q.observeSend(batch, time.Since(start))
+			}
+		}
+		for i, tx := range batch {
+			tx.ReleaseRef()
+			batch[i] = nil
+		}
+	}
+}
+
+// linger adds txs to the given batch as they arrive until the window elapses, the batch is full, or the queue is closing.
+func (q *TxSendQueue) linger(batch []*TxMsg, window time.Duration) []*TxMsg {
+	timer := time.NewTimer(window)
+	defer timer.Stop()
+	for len(batch) < q.opts.MaxBatch {
+		select {
+		case tx := <-q.txs:
+			batch = append(batch, tx)
+		case <-timer.C:
+			return batch
+		case <-q.closing:
+			return batch
+		}
+	}
+	return batch
+}
@@ -0,0 +1,177 @@
+package amp
+
+import (
+	"sync"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+// NewUndoManager returns an UndoManager retaining up to maxDepth undoable mutations (default 100 if maxDepth <= 0).
+func NewUndoManager(maxDepth int) UndoManager {
+	if maxDepth <= 0 {
+		maxDepth = 100
+	}
+	return &undoManager{
+		maxDepth: maxDepth,
+	}
+}
+
+// CommitUndoable commits a client tx to an app via commit(), recording it with the session's UndoManager if the app is Undoable.
+// If the session has no UndoManager or the app cannot invert the tx, the tx is committed without being recorded.
+func CommitUndoable(sess Session, app AppInstance, label string, tx *TxMsg, commit func(tx *TxMsg) error) error {
+	undo := ServicesOf(sess).Undo
+	undoable, _ := app.(Undoable)
+	if undo == nil || undoable == nil {
+		return commit(tx)
+	}
+
+	inverse, err := undoable.InverseTx(tx)
+	if err != nil {
+		return err
+	}
+	if err = commit(tx); err != nil {
+		if inverse != nil {
+			inverse.ReleaseRef()
+		}
+		return err
+	}
+	if inverse != nil {
+		undo.Record(undoable, label, tx, inverse)
+		inverse.ReleaseRef()
+		SendMetaAttr(sess, tag.ID{}, OpStatus_Synced, (&UndoState{}).TagSpec().ID, undo.State())
+	}
+	return nil
+}
+
+// HandleUndoRequest performs an UndoRequest sent by the client and replies with the resulting UndoState via the session controller.
+func HandleUndoRequest(sess Session, contextID tag.ID, req *UndoRequest) error {
+	undo := ServicesOf(sess).Undo
+	if undo == nil {
+		return ErrCode_UnsupportedOp.Error("undo not enabled")
+	}
+
+	var err error
+	for i := int32(0); i < max(req.Steps, 1) && err == nil; i++ {
+		if req.Redo {
+			err = undo.Redo()
+		} else {
+			err = undo.Undo()
+		}
+	}
+
+	if sendErr := SendMetaAttr(sess, contextID, OpStatus_Synced, (&UndoState{}).TagSpec().ID, undo.State()); err == nil {
+		err = sendErr
+	}
+	return err
+}
+
+// Implements UndoManager
+type undoManager struct {
+	mu       sync.Mutex
+	maxDepth int
+	undos    []undoEntry
+	redos    []undoEntry
+}
+
+type undoEntry struct {
+	app     Undoable
+	label   string
+	tx      *TxMsg // original tx (applied on redo)
+	inverse *TxMsg // applied on undo
+}
+
+func (entry *undoEntry) release() {
+	entry.tx.ReleaseRef()
+	entry.inverse.ReleaseRef()
+}
+
+func (mgr *undoManager) Record(app Undoable, label string, tx, inverse *TxMsg) {
+	tx.AddRef()
+	inverse.AddRef()
+
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	for i := range mgr.redos {
+		mgr.redos[i].release()
+	}
+	mgr.redos = mgr.redos[:0]
+
+	mgr.undos = append(mgr.undos, undoEntry{
+		app:     app,
+		label:   label,
+		tx:      tx,
+		inverse: inverse,
+	})
+	if over := len(mgr.undos) - mgr.maxDepth; over > 0 {
+		for i := 0; i < over; i++ {
+			mgr.undos[i].release()
+		}
+		mgr.undos = append(mgr.undos[:0], mgr.undos[over:]...)
+	}
+}
+
+// Undo and Redo apply a tx without holding mgr.mu, so an app's ApplyTx() may itself commit via CommitUndoable() or read State().
+// The entry is popped while it is applied and restored if applying it fails.
+func (mgr *undoManager) Undo() error {
+	mgr.mu.Lock()
+	n := len(mgr.undos)
+	if n == 0 {
+		mgr.mu.Unlock()
+		return ErrNothingToUndo
+	}
+	entry := mgr.undos[n-1]
+	mgr.undos = mgr.undos[:n-1]
+	mgr.mu.Unlock()
+
+	err := entry.app.ApplyTx(entry.inverse)
+
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+	if err != nil {
+		mgr.undos = append(mgr.undos, entry)
+		return err
+	}
+	mgr.redos = append(mgr.redos, entry)
+	return nil
+}
+
+func (mgr *undoManager) Redo() error {
+	mgr.mu.Lock()
+	n := len(mgr.redos)
+	if n == 0 {
+		mgr.mu.Unlock()
+		return ErrNothingToRedo
+	}
+	entry := mgr.redos[n-1]
+	mgr.redos = mgr.redos[:n-1]
+	mgr.mu.Unlock()
+
+	err := entry.app.ApplyTx(entry.tx)
+
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+	if err != nil {
+		mgr.redos = append(mgr.redos, entry)
+		return err
+	}
+	mgr.undos = append(mgr.undos, entry)
+	return nil
+}
+
+func (mgr *undoManager) State() *UndoState {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	state := &UndoState{
+		UndoDepth: int32(len(mgr.undos)),
+		RedoDepth: int32(len(mgr.redos)),
+	}
+	if n := len(mgr.undos); n > 0 {
+		state.UndoLabel = mgr.undos[n-1].label
+	}
+	if n := len(mgr.redos); n > 0 {
+		state.RedoLabel = mgr.redos[n-1].label
+	}
+	return state
+}
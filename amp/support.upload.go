@@ -0,0 +1,329 @@
+package amp
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/blob"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+// UploadOpts specifies an UploadManager.
+type UploadOpts struct {
+	Store    blob.Store // completed uploads are stored here by content ID (see blob.PutContent)
+	StageDir string     // partially received uploads are staged here, persisting across host restarts
+	Refs     AssetRefs  // optional: completed uploads are tracked so they are collected unless a cell references them
+	MaxSize  int64      // uploads larger than this are rejected, including those of undeclared size; if <= 0, DefaultMaxUploadSize
+
+	// Optional: called once when an upload completes, typically DeliverUpload() bound to the session.
+	OnComplete func(req *UploadRequest, status *UploadStatus)
+}
+
+// DefaultMaxUploadSize is the UploadOpts.MaxSize used when none is specified.
+const DefaultMaxUploadSize = int64(4) << 30
+
+// NewUploadManager returns an UploadManager that stages uploads in opts.StageDir until complete.
+func NewUploadManager(opts UploadOpts) (UploadManager, error) {
+	if err := os.MkdirAll(opts.StageDir, 0700); err != nil {
+		return nil, err
+	}
+	if opts.MaxSize <= 0 {
+		opts.MaxSize = DefaultMaxUploadSize
+	}
+	return &uploadManager{
+		opts:    opts,
+		uploads: make(map[string]*upload),
+	}, nil
+}
+
+// HandleUploadMsg performs an UploadRequest or UploadChunk sent by the client and replies with the resulting UploadStatus via the session controller.
+func HandleUploadMsg(sess Session, contextID tag.ID, msg tag.Value) error {
+	uploads := ServicesOf(sess).Uploads
+	if uploads == nil {
+		return ErrCode_UnsupportedOp.Error("uploads not enabled")
+	}
+
+	var status *UploadStatus
+	var err error
+	var uploadID string
+	switch msg := msg.(type) {
+	case *UploadRequest:
+		uploadID = msg.UploadID
+		status, err = uploads.Begin(msg)
+	case *UploadChunk:
+		uploadID = msg.UploadID
+		status, err = uploads.WriteChunk(msg)
+	default:
+		return ErrCode_BadRequest.Error("not an upload msg")
+	}
+	if err != nil {
+		status = &UploadStatus{
+			UploadID: uploadID,
+			Err:      ErrorToValue(err).(*Err),
+		}
+	}
+
+	if sendErr := SendMetaAttr(sess, contextID, OpStatus_Synced, (&UploadStatus{}).TagSpec().ID, status); err == nil {
+		err = sendErr
+	}
+	return err
+}
+
+// DeliverUpload passes a completed upload to the app it is addressed to, if any, and if that app is an UploadReceiver.
+func DeliverUpload(sess Session, req *UploadRequest, status *UploadStatus) error {
+	if req.AppID == nil {
+		return nil
+	}
+	appID := *req.AppID
+	app, err := sess.GetAppInstance(appID.AsID(), true)
+	if err != nil {
+		return err
+	}
+	receiver, ok := app.(UploadReceiver)
+	if !ok {
+		return ErrCode_UnsupportedOp.Error("app does not receive uploads")
+	}
+	return receiver.OnUploadComplete(req, status)
+}
+
+// Implements UploadManager
+type uploadManager struct {
+	opts    UploadOpts
+	mu      sync.Mutex
+	uploads map[string]*upload
+}
+
+type upload struct {
+	mu       sync.Mutex
+	req      *UploadRequest
+	basePath string // staged as basePath + ".req" and basePath + ".part"
+	status   UploadStatus
+}
+
+func (mgr *uploadManager) Begin(req *UploadRequest) (*UploadStatus, error) {
+	if req.UploadID == "" {
+		return nil, ErrCode_BadRequest.Error("missing UploadID")
+	}
+	if req.TotalSize > mgr.opts.MaxSize {
+		return nil, ErrCode_QuotaExceeded.Errorf("upload size %d exceeds max %d", req.TotalSize, mgr.opts.MaxSize)
+	}
+
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	up := mgr.uploads[req.UploadID]
+	if up == nil {
+		up = mgr.loadStaged(req.UploadID)
+	}
+	if up != nil {
+		up.mu.Lock()
+		defer up.mu.Unlock()
+
+		// resume unless the client is now describing different content
+		if up.status.Complete || req.TotalSize == 0 || up.req.TotalSize == 0 || req.TotalSize == up.req.TotalSize {
+			if req.TotalSize > 0 {
+				up.req.TotalSize = req.TotalSize
+			}
+			status := up.status
+			return &status, nil
+		}
+		up.discard()
+	}
+
+	up = &upload{
+		req:      req,
+		basePath: mgr.basePath(req.UploadID),
+	}
+	up.status.UploadID = req.UploadID
+	buf, err := req.MarshalToStore(nil)
+	if err == nil {
+		err = os.WriteFile(up.basePath+".req", buf, 0600)
+	}
+	if err == nil {
+		err = os.WriteFile(up.basePath+".part", nil, 0600)
+	}
+	if err != nil {
+		return nil, ErrCode_StorageFailure.Wrap(err)
+	}
+	mgr.uploads[req.UploadID] = up
+
+	status := up.status
+	return &status, nil
+}
+
+func (mgr *uploadManager) WriteChunk(chunk *UploadChunk) (*UploadStatus, error) {
+	mgr.mu.Lock()
+	up := mgr.uploads[chunk.UploadID]
+	if up == nil {
+		up = mgr.loadStaged(chunk.UploadID)
+	}
+	mgr.mu.Unlock()
+	if up == nil {
+		return nil, ErrNoUpload
+	}
+
+	up.mu.Lock()
+	defer up.mu.Unlock()
+
+	if up.status.Complete || chunk.Offset != up.status.Offset {
+		status := up.status
+		return &status, nil
+	}
+	size := up.req.TotalSize
+	end := chunk.Offset + int64(len(chunk.Data))
+	if size > 0 && end > size {
+		return nil, ErrCode_BadRequest.Errorf("upload exceeds declared size %d", size)
+	}
+	if end > mgr.opts.MaxSize {
+		return nil, ErrCode_QuotaExceeded.Errorf("upload exceeds max size %d", mgr.opts.MaxSize)
+	}
+
+	if len(chunk.Data) > 0 {
+		if err := up.appendData(chunk.Data); err != nil {
+			return nil, ErrCode_StorageFailure.Wrap(err)
+		}
+	}
+
+	if chunk.Final || (size > 0 && up.status.Offset == size) {
+		if err := mgr.complete(up); err != nil {
+			return nil, err
+		}
+	}
+	status := up.status
+	return &status, nil
+}
+
+func (mgr *uploadManager) Status(uploadID string) (*UploadStatus, error) {
+	mgr.mu.Lock()
+	up := mgr.uploads[uploadID]
+	if up == nil {
+		up = mgr.loadStaged(uploadID)
+	}
+	mgr.mu.Unlock()
+	if up == nil {
+		return nil, ErrNoUpload
+	}
+
+	up.mu.Lock()
+	defer up.mu.Unlock()
+	status := up.status
+	return &status, nil
+}
+
+func (mgr *uploadManager) Cancel(uploadID string) error {
+	mgr.mu.Lock()
+	up := mgr.uploads[uploadID]
+	if up == nil {
+		up = mgr.loadStaged(uploadID)
+	}
+	delete(mgr.uploads, uploadID)
+	mgr.mu.Unlock()
+	if up == nil {
+		return ErrNoUpload
+	}
+
+	up.mu.Lock()
+	up.discard()
+	up.mu.Unlock()
+	return nil
+}
+
+// Upload IDs are client-chosen, so staged file names are derived from a hash of the ID.
+func (mgr *uploadManager) basePath(uploadID string) string {
+	hash := sha256.Sum256([]byte(uploadID))
+	return filepath.Join(mgr.opts.StageDir, hex.EncodeToString(hash[:16]))
+}
+
+// loadStaged resumes an upload staged before a host restart -- caller holds mgr.mu
+func (mgr *uploadManager) loadStaged(uploadID string) *upload {
+	basePath := mgr.basePath(uploadID)
+	buf, err := os.ReadFile(basePath + ".req")
+	if err != nil {
+		return nil
+	}
+	req := &UploadRequest{}
+	if err = req.Unmarshal(buf); err != nil || req.UploadID != uploadID {
+		return nil
+	}
+	stat, err := os.Stat(basePath + ".part")
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	up := &upload{
+		req:      req,
+		basePath: basePath,
+	}
+	up.status.UploadID = uploadID
+	if stat != nil {
+		up.status.Offset = stat.Size()
+	}
+	mgr.uploads[uploadID] = up
+	return up
+}
+
+// appendData durably appends data to the staged content -- caller holds up.mu
+func (up *upload) appendData(data []byte) error {
+	file, err := os.OpenFile(up.basePath+".part", os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(data)
+	if err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		// drop any partial write so the offset stays consistent with what was acknowledged
+		os.Truncate(up.basePath+".part", up.status.Offset)
+		return err
+	}
+	up.status.Offset += int64(len(data))
+	return nil
+}
+
+// complete moves staged content into the blob store -- caller holds up.mu
+func (mgr *uploadManager) complete(up *upload) error {
+	file, err := os.Open(up.basePath + ".part")
+	if err != nil {
+		return ErrCode_StorageFailure.Wrap(err)
+	}
+	info := blob.Info{
+		ContentType: up.req.ContentType,
+	}
+	cid, err := blob.PutContent(context.Background(), mgr.opts.Store, file, info)
+	file.Close()
+	if err != nil {
+		return ErrCode_StorageFailure.Wrap(err)
+	}
+
+	if mgr.opts.Refs != nil {
+		if err = mgr.opts.Refs.Track(cid.Key()); err != nil {
+			return ErrCode_StorageFailure.Wrap(err)
+		}
+	}
+
+	up.status.Complete = true
+	up.status.CID = string(cid)
+	up.discard()
+
+	if mgr.opts.OnComplete != nil {
+		status := up.status
+		mgr.opts.OnComplete(up.req, &status)
+	}
+	return nil
+}
+
+// discard removes staged files -- caller holds up.mu
+func (up *upload) discard() {
+	os.Remove(up.basePath + ".req")
+	os.Remove(up.basePath + ".part")
+}
@@ -2,12 +2,36 @@ package amp
 
 import (
 	"bytes"
+	"context"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
 	fmt "fmt"
 	io "io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"reflect"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
+	"unsafe"
 
+	"github.com/art-media-platform/amp-sdk-go/stdlib/blob"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/media"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/symbol/memory_table"
 	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
 )
 
 func TestTxSerialize(t *testing.T) {
@@ -141,3 +165,1752 @@ func TestRegistry(t *testing.T) {
 		t.Fatalf("MakeValue returned wrong type: %v", reflect.TypeOf(elem))
 	}
 }
+
+func TestResolveAttrSpec(t *testing.T) {
+	reg := NewRegistry()
+	expr := "amp.attr.av.World.Hello.Tag"
+	if _, err := reg.ResolveAttrSpec(expr); err == nil {
+		t.Fatal("expected unregistered attr not to resolve")
+	}
+	if _, err := reg.ResolveAttrSpec(expr); err == nil {
+		t.Fatal("expected cached resolution to fail")
+	}
+
+	// registering a def invalidates cached resolutions
+	spec := reg.RegisterPrototype(AttrSpec.With("av.Hello.World"), &Tag{}, "")
+	def, err := reg.ResolveAttrSpec(expr)
+	if err != nil || def.ID != spec.ID || def.Prototype == nil {
+		t.Fatalf("ResolveAttrSpec failed: %v", err)
+	}
+	if again, _ := reg.ResolveAttrSpec(expr); again.ID != spec.ID {
+		t.Fatal("cached resolution mismatch")
+	}
+	if tag.ParseSpec(expr) != (tag.Spec{}.With(expr)) {
+		t.Fatal("ParseSpec mismatch")
+	}
+}
+
+func TestStringInterner(t *testing.T) {
+	table, err := memory_table.DefaultOpts().CreateTable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer table.Close()
+	in := NewStringInterner(table, 0, 2)
+
+	tx := NewTxMsg(true)
+	for i := 0; i < 2; i++ {
+		tx.Upsert(tag.ID{0, 0, 1}, (&Tag{}).TagSpec().ID, tag.ID{0, 0, uint64(i)}, &Tag{URL: "amp://photos/2024/summer", Text: "IMG_0042.jpg"})
+	}
+
+	var decoded [2]Tag
+	for i := range decoded {
+		if err := tx.UnmarshalOpValueInterned(i, &decoded[i], in); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if decoded[0].URL != "amp://photos/2024/summer" || decoded[1].Text != "IMG_0042.jpg" {
+		t.Fatalf("unexpected values: %+v", decoded)
+	}
+	if unsafe.StringData(decoded[0].URL) != unsafe.StringData(decoded[1].URL) {
+		t.Fatal("expected equal strings to share memory")
+	}
+	if unsafe.StringData(decoded[0].Text) == unsafe.StringData(decoded[1].Text) {
+		t.Fatal("expected per-item Text not to be interned")
+	}
+
+	// once full, only strings already interned are shared
+	if in.Intern("video/mp4") == "" {
+		t.Fatal("expected interned string")
+	}
+	overflow := strings.Clone("image/png")
+	if unsafe.StringData(in.Intern(overflow)) != unsafe.StringData(overflow) {
+		t.Fatal("expected full interner to return str itself")
+	}
+	if unsafe.StringData(in.Intern(strings.Clone("amp://photos/2024/summer"))) != unsafe.StringData(decoded[0].URL) {
+		t.Fatal("expected full interner to share interned strings")
+	}
+}
+
+func TestAttrMask(t *testing.T) {
+	label := AttrSpec.With("label.Tag")
+	media := AttrSpec.With("media.Tag")
+
+	all := NewAttrMask(nil)
+	if !all.Selects(label.ID) || !all.Selects(media.ID) {
+		t.Fatal("empty AttrMask should select all attrs")
+	}
+	if all.Includes(media.ID) {
+		t.Fatal("empty AttrMask should not explicitly include any attr")
+	}
+
+	req := Request{}
+	req.PinAttrs = []*Tag{
+		{URL: label.Canonic},
+	}
+	mask := req.AttrMask()
+	if !mask.Selects(label.ID) {
+		t.Fatal("AttrMask should select pinned attr")
+	}
+	if mask.Selects(media.ID) {
+		t.Fatal("AttrMask should not select unpinned attr")
+	}
+
+	var sel Tag
+	sel.SetID(media.ID)
+	widened := NewAttrMask(append(req.PinAttrs, &sel))
+	if !widened.Selects(label.ID) || !widened.Selects(media.ID) {
+		t.Fatal("widened AttrMask should select both attrs")
+	}
+	if !widened.Includes(media.ID) {
+		t.Fatal("widened AttrMask should explicitly include deferred attr")
+	}
+}
+
+func TestAliasTable(t *testing.T) {
+	aliases := NewAliasTable()
+	appA := AppSpec.With("alias-test.a").ID
+	appB := AppSpec.With("alias-test.b").ID
+
+	cellID := tag.Now()
+	if err := aliases.ClaimAlias(appA, "/music/Favorites/", cellID); err != nil {
+		t.Fatal(err)
+	}
+	if err := aliases.ClaimAlias(appB, "music/favorites", tag.Now()); err != ErrAliasClaimed {
+		t.Fatalf("expected ErrAliasClaimed, got %v", err)
+	}
+
+	// re-index: the same app reclaims the alias for a new cell
+	reindexed := tag.Now()
+	if err := aliases.ClaimAlias(appA, "music/favorites", reindexed); err != nil {
+		t.Fatal(err)
+	}
+
+	req := PinRequest{
+		PinTarget: &Tag{URL: "music/favorites"},
+	}
+	target, err := req.ResolveTarget(aliases)
+	if err != nil || target != reindexed {
+		t.Fatalf("ResolveTarget failed: %v", err)
+	}
+
+	if err := aliases.ReleaseAlias(appA, "music/favorites"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = req.ResolveTarget(aliases); err != ErrAliasNotFound {
+		t.Fatalf("expected ErrAliasNotFound, got %v", err)
+	}
+}
+
+func TestCellACL(t *testing.T) {
+	acl := NewCellACL()
+	cellID, alice, bob := tag.Now(), tag.Now(), tag.Now()
+	acl.Grant(cellID, alice)
+	acl.BindToken("alice-token", alice)
+	acl.BindToken("bob-token", bob)
+
+	if err := acl.CanReadCell(bob, cellID); err != ErrAccessDenied {
+		t.Fatalf("expected ErrAccessDenied, got %v", err)
+	}
+
+	// assets scoped to the cell inherit its ACL
+	access := NewAssetAccess(acl)
+	req := httptest.NewRequest("GET", "/asset/x", nil)
+	if _, err := access.Requester(req); err != media.ErrNotAuthenticated {
+		t.Fatalf("expected ErrNotAuthenticated, got %v", err)
+	}
+	req.AddCookie(&http.Cookie{Name: AssetTokenCookie, Value: "alice-token"})
+	subject, err := access.Requester(req)
+	if err != nil || access.CanRead(subject, cellID.Base32()) != nil {
+		t.Fatalf("alice should read the cell's assets: %v", err)
+	}
+	if err = access.CanRead(bob.Base32(), cellID.Base32()); err != media.ErrAccessDenied {
+		t.Fatalf("expected ErrAccessDenied, got %v", err)
+	}
+
+	acl.SetPublic(cellID, true)
+	if err = acl.CanReadCell(bob, cellID); err != nil {
+		t.Fatal(err)
+	}
+	acl.SetPublic(cellID, false)
+	acl.Revoke(cellID, alice)
+	acl.UnbindToken("alice-token")
+	if err = acl.CanReadCell(alice, cellID); err != ErrAccessDenied {
+		t.Fatalf("expected ErrAccessDenied after revoke, got %v", err)
+	}
+	if _, err = acl.UserForToken("alice-token"); err != ErrNoAuthToken {
+		t.Fatalf("expected ErrNoAuthToken, got %v", err)
+	}
+}
+
+func TestLeaseTable(t *testing.T) {
+	leases := NewLeaseTable()
+	sessA, _ := task.Start(&task.Task{Info: task.Info{Label: "session a"}})
+	sessB, _ := task.Start(&task.Task{Info: task.Info{Label: "session b"}})
+	defer sessB.Close()
+
+	changes := make(chan *CellLease, 8)
+	leases.WatchLeases(sessB, func(cellID tag.ID, lease *CellLease) {
+		changes <- lease
+	})
+
+	cellID := tag.Now()
+	if err := leases.AcquireLease(sessA, cellID, &CellLease{Purpose: "editing"}); err != nil {
+		t.Fatal(err)
+	}
+	if lease := <-changes; lease == nil || lease.Purpose != "editing" {
+		t.Fatalf("expected lease change, got %v", lease)
+	}
+	if err := leases.AcquireLease(sessB, cellID, &CellLease{}); err != ErrLeaseHeld {
+		t.Fatalf("expected ErrLeaseHeld, got %v", err)
+	}
+	if err := leases.ReleaseLease(sessB, cellID); err != ErrLeaseNotHeld {
+		t.Fatalf("expected ErrLeaseNotHeld, got %v", err)
+	}
+
+	// closing the holder releases its leases
+	sessA.Close()
+	select {
+	case lease := <-changes:
+		if lease != nil {
+			t.Fatalf("expected release, got %v", lease)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("lease not released when holder closed")
+	}
+	if leases.GetLease(cellID) != nil {
+		t.Fatal("lease still held")
+	}
+
+	// expiring lease
+	expires := &CellLease{ExpiresAt: time.Now().Unix() + 1}
+	if err := leases.AcquireLease(sessB, cellID, expires); err != nil {
+		t.Fatal(err)
+	}
+	<-changes
+	select {
+	case lease := <-changes:
+		if lease != nil {
+			t.Fatalf("expected expiry, got %v", lease)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("lease did not expire")
+	}
+}
+
+func TestLeaseWatcherSlow(t *testing.T) {
+	leases := NewLeaseTable()
+	holder, _ := task.Start(&task.Task{Info: task.Info{Label: "holder"}})
+	watcher, _ := task.Start(&task.Task{Info: task.Info{Label: "watcher"}})
+	defer holder.Close()
+	defer watcher.Close()
+
+	// a watcher blocked delivering (e.g. to a slow client) doesn't stall lease operations
+	unblock := make(chan struct{})
+	delivered := make(chan string, 8)
+	leases.WatchLeases(watcher, func(cellID tag.ID, lease *CellLease) {
+		<-unblock
+		if lease != nil {
+			delivered <- lease.Purpose
+		}
+	})
+
+	cellID := tag.Now()
+	done := make(chan struct{})
+	go func() {
+		for _, purpose := range []string{"a", "b", "c", "d"} {
+			leases.AcquireLease(holder, cellID, &CellLease{Purpose: purpose})
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("lease operations blocked by a slow watcher")
+	}
+
+	// changes queued while the watcher was busy are coalesced to the latest
+	close(unblock)
+	var seen []string
+	for len(seen) == 0 || seen[len(seen)-1] != "d" {
+		select {
+		case purpose := <-delivered:
+			seen = append(seen, purpose)
+		case <-time.After(time.Second):
+			t.Fatalf("latest lease not delivered, got %v", seen)
+		}
+	}
+	if len(seen) > 2 {
+		t.Fatalf("expected coalesced changes, got %v", seen)
+	}
+}
+
+func TestMergeSearchHits(t *testing.T) {
+	a, b, c := tag.Now(), tag.Now(), tag.Now()
+	merged := MergeSearchHits(2,
+		[]SearchHit{{CellID: a, Score: 0.5}, {CellID: b, Score: 0.2}},
+		[]SearchHit{{CellID: c, Score: 0.9}, {CellID: b, Score: 0.7}},
+	)
+	if len(merged) != 2 {
+		t.Fatalf("expected 2 hits, got %d", len(merged))
+	}
+	if merged[0].CellID != c || merged[1].CellID != b || merged[1].Score != 0.7 {
+		t.Fatalf("unexpected merge order: %v", merged)
+	}
+}
+
+func TestDropEphemeral(t *testing.T) {
+	tx := NewTxMsg(true)
+	cellID := tag.Now()
+	tx.Upsert(cellID, AttrSpec.With("Tag").ID, tag.ID{}, &Tag{Text: "durable"})
+
+	op := TxOp{}
+	op.OpCode = TxOpCode_EphemeralElement
+	op.CellID = cellID
+	op.AttrID = AttrSpec.With("ephemeral.Tag.typing").ID
+	tx.MarshalOp(&op, &Tag{Text: "..."})
+
+	if dropped := tx.DropEphemeral(); dropped != 1 || len(tx.Ops) != 1 || tx.OpCount != 1 {
+		t.Fatalf("expected 1 ephemeral op dropped, got %d", dropped)
+	}
+	val := &Tag{}
+	if err := tx.LoadItem(AttrSpec.With("Tag").ID, tag.ID{}, val); err != nil || val.Text != "durable" {
+		t.Fatalf("durable op lost: %v", err)
+	}
+}
+
+func TestPresenceTable(t *testing.T) {
+	presence := NewPresenceTable()
+	watcher, _ := task.Start(&task.Task{Info: task.Info{Label: "watcher"}})
+	defer watcher.Close()
+
+	cellID := tag.Now()
+	changes := make(chan []*Presence, 8)
+	presence.WatchPresence(watcher, cellID, func(present []*Presence) {
+		changes <- present
+	})
+
+	alice := &Tag{UID: "alice"}
+	presence.UpdatePresence(cellID, alice, +1)
+	presence.UpdatePresence(cellID, alice, +1) // second device -- not a change
+	presence.UpdatePresence(cellID, &Tag{UID: "bob"}, +1)
+	if present := <-changes; len(present) != 1 {
+		t.Fatalf("expected alice, got %v", present)
+	}
+	if present := <-changes; len(present) != 2 || present[0].UserID.UID != "alice" || present[0].PinCount != 2 {
+		t.Fatalf("expected alice and bob, got %v", present)
+	}
+	if alice.ID_0 != 0 || alice.ID_1 != 0 {
+		t.Fatal("caller's tag was modified")
+	}
+
+	presence.UpdatePresence(cellID, alice, -1)
+	presence.UpdatePresence(cellID, alice, -1)
+	if present := <-changes; len(present) != 1 || present[0].UserID.UID != "bob" {
+		t.Fatalf("expected bob, got %v", present)
+	}
+	if len(changes) != 0 {
+		t.Fatal("unexpected presence change")
+	}
+}
+
+func TestNotificationService(t *testing.T) {
+	svc := NewNotificationService(3)
+	sess, _ := task.Start(&task.Task{Info: task.Info{Label: "session"}})
+	defer sess.Close()
+
+	userID := tag.Now()
+	user := &Tag{}
+	user.SetID(userID)
+	appA, appB := &Tag{UID: "app.a"}, &Tag{UID: "app.b"}
+
+	// queued while offline
+	svc.Notify(&Notification{UserID: user, AppID: appA, Title: "one"})
+	svc.Notify(&Notification{UserID: user, AppID: appB, Title: "two"})
+
+	delivered := make(chan *Notification, 8)
+	svc.Subscribe(sess, userID, func(n *Notification) error {
+		delivered <- n
+		return nil
+	})
+	if n := <-delivered; n.Title != "one" {
+		t.Fatalf("expected pending notification, got %v", n)
+	}
+	<-delivered
+
+	badges := make(chan *Badge, 8)
+	svc.WatchBadges(sess, userID, func(badge *Badge) {
+		badges <- badge
+	})
+	svc.Notify(&Notification{UserID: user, AppID: appA, Title: "three"})
+	if n := <-delivered; n.Title != "three" {
+		t.Fatalf("expected live notification, got %v", n)
+	}
+	if badge := <-badges; badge.Count != 2 {
+		t.Fatalf("expected app.a badge of 2, got %v", badge)
+	}
+
+	// oldest is dropped when full
+	svc.Notify(&Notification{UserID: user, AppID: appB, Title: "four"})
+	<-delivered
+	if pending := svc.Pending(userID); len(pending) != 3 || pending[0].Title != "two" {
+		t.Fatalf("expected oldest dropped, got %v", pending)
+	}
+	for len(badges) > 0 {
+		<-badges
+	}
+
+	svc.Ack(userID, &NotificationAck{AppID: &Tag{UID: "app.b"}})
+	if badge := <-badges; badge.Count != 0 {
+		t.Fatalf("expected app.b badge cleared, got %v", badge)
+	}
+	if got := svc.Badges(userID); len(got) != 1 || got[0].Count != 1 {
+		t.Fatalf("unexpected badges: %v", got)
+	}
+}
+
+func TestAssetGC(t *testing.T) {
+	ctx := context.Background()
+	blobs, _ := blob.NewDirStore(t.TempDir())
+	for _, key := range []string{"a.jpg", "b.jpg", "upload.bin"} {
+		blobs.Put(ctx, key, bytes.NewReader([]byte(key)), blob.Info{})
+	}
+	store, err := NewFileAssetRefStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := AssetGCOpts{
+		Blobs: blobs,
+		Store: store,
+		Grace: 20 * time.Millisecond,
+	}
+
+	host, _ := task.Start(&task.Task{Info: task.Info{Label: "host"}})
+	refs, err := StartAssetGC(host, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cell1, cell2 := tag.Now(), tag.Now()
+	refs.Track("upload.bin")
+	refs.SetRefs(cell1, []string{"a.jpg", "b.jpg", "a.jpg"})
+	refs.SetRefs(cell2, []string{"b.jpg"})
+	refs.SetRefs(cell1, []string{"a.jpg"})
+	if cells := refs.Referrers("b.jpg"); len(cells) != 1 || cells[0] != cell2 {
+		t.Fatalf("unexpected referrers: %v", cells)
+	}
+	refs.SetRefs(cell2, nil)
+
+	// nothing is collected within the grace period
+	if collected, _ := refs.Collect(ctx); len(collected) != 0 {
+		t.Fatalf("collected within grace period: %v", collected)
+	}
+	host.Close()
+	<-host.Done()
+
+	// references survive a restart
+	host, _ = task.Start(&task.Task{Info: task.Info{Label: "host"}})
+	defer host.Close()
+	refs, err = StartAssetGC(host, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(opts.Grace)
+	collected, err := refs.Collect(ctx)
+	if err != nil || !reflect.DeepEqual(collected, []string{"b.jpg", "upload.bin"}) {
+		t.Fatalf("Collect: %v, %v", collected, err)
+	}
+	if _, err = blobs.Stat(ctx, "a.jpg"); err != nil {
+		t.Fatalf("referenced asset was collected: %v", err)
+	}
+	if _, err = blobs.Stat(ctx, "b.jpg"); err != blob.ErrNotFound {
+		t.Fatalf("expected b.jpg to be collected, got %v", err)
+	}
+}
+
+// testRequester collects the txs pushed to a request.
+type testRequester struct {
+	req *Request
+	txs chan *TxMsg
+}
+
+func (r *testRequester) Request() *Request      { return r.req }
+func (r *testRequester) PushTx(tx *TxMsg) error { r.txs <- tx; return nil }
+func (r *testRequester) OnComplete(err error)   {}
+
+func TestPrefetcher(t *testing.T) {
+	host, _ := task.Start(&task.Task{Info: task.Info{Label: "host"}})
+	defer host.Close()
+
+	backend, _ := blob.NewDirStore(t.TempDir())
+	backend.Put(host, "thumb.txt", bytes.NewReader([]byte("tiny")), blob.Info{})
+	backend.Put(host, "cover.bin", bytes.NewReader(make([]byte, 200)), blob.Info{})
+	cache, _ := blob.NewCache(backend, blob.CacheOpts{MemBudget: 1000, MemMaxItem: 500})
+
+	pf, err := StartPrefetcher(host, PrefetchOpts{
+		Blobs:   cache,
+		PushMax: 100,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	op := &testRequester{
+		req: &Request{ID: tag.Now()},
+		txs: make(chan *TxMsg, 4),
+	}
+	pf.Prefetch(host, op,
+		&PrefetchHint{URL: "http://host/thumb", BlobKey: "thumb.txt", Priority: 2},
+		&PrefetchHint{URL: "http://host/cover", BlobKey: "cover.bin", Priority: 1},
+		&PrefetchHint{URL: "https://elsewhere/clip.mp4"},
+	)
+
+	var pushed AssetPush
+	var forwarded PrefetchHint
+	for i := 0; i < 2; i++ {
+		tx := <-op.txs
+		if tx.ContextID() != op.req.ID {
+			t.Fatal("tx not routed to the pinned request")
+		}
+		switch tx.Ops[0].AttrID {
+		case pushed.TagSpec().ID:
+			tx.UnmarshalOpValue(0, &pushed)
+		case forwarded.TagSpec().ID:
+			tx.UnmarshalOpValue(0, &forwarded)
+		}
+	}
+	if string(pushed.Content) != "tiny" || pushed.URL != "http://host/thumb" {
+		t.Fatalf("unexpected AssetPush: %+v", pushed)
+	}
+	if forwarded.URL != "https://elsewhere/clip.mp4" {
+		t.Fatalf("unexpected forwarded hint: %+v", forwarded)
+	}
+
+	// the larger asset is warmed in the cache rather than pushed
+	for deadline := time.Now().Add(time.Second); cache.Metrics().MemBytes != 204; {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected cover.bin to be warmed: %+v", cache.Metrics())
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestScheduler(t *testing.T) {
+	store, err := NewFileScheduleStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	appID := AppSpec.With("schedule-test").ID
+	cellID := tag.Now()
+
+	// schedule then "restart" before anything fires
+	host, _ := task.Start(&task.Task{Info: task.Info{Label: "host"}})
+	sch, err := StartScheduler(host, SchedulerOpts{
+		Store: store,
+		Fire: func(st *ScheduledTx, tx *TxMsg) error {
+			t.Error("fired before restart")
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	host.Close()
+	<-host.Done()
+
+	publish, _ := MarshalAttr(cellID, AttrSpec.With("Tag").ID, &Tag{Text: "published"})
+	expire, _ := MarshalAttr(cellID, AttrSpec.With("Tag").ID, &Tag{Text: "expired"})
+	sch.Schedule(appID, time.Now().Add(-time.Second), "publish", publish)
+	expireID, _ := sch.Schedule(appID, time.Now().Add(time.Hour), "expire", expire)
+
+	host, _ = task.Start(&task.Task{Info: task.Info{Label: "host"}})
+	defer host.Close()
+	fired := make(chan string, 4)
+	sch, err = StartScheduler(host, SchedulerOpts{
+		Store: store,
+		Fire: func(st *ScheduledTx, tx *TxMsg) error {
+			val := &Tag{}
+			if err := tx.LoadItem(AttrSpec.With("Tag").ID, tag.ID{}, val); err != nil {
+				return err
+			}
+			fired <- val.Text
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case text := <-fired:
+		if text != "published" {
+			t.Fatalf("unexpected tx fired: %q", text)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("persisted tx did not fire after restart")
+	}
+
+	if list := sch.ListScheduled(appID); len(list) != 1 || list[0].Label != "expire" {
+		t.Fatalf("expected expire to remain scheduled, got %v", list)
+	}
+	if err = sch.Cancel(expireID); err != nil {
+		t.Fatal(err)
+	}
+	if err = sch.Cancel(expireID); err != ErrNotScheduled {
+		t.Fatalf("expected ErrNotScheduled, got %v", err)
+	}
+	if loaded, _ := store.LoadScheduled(); len(loaded) != 0 {
+		t.Fatalf("expected empty store, got %d", len(loaded))
+	}
+}
+
+// textDoc is an Undoable holding a single text value.
+type textDoc struct {
+	text    string
+	onApply func() error // optional: called as each tx is applied
+}
+
+func (doc *textDoc) InverseTx(tx *TxMsg) (*TxMsg, error) {
+	return MarshalAttr(tag.ID{}, AttrSpec.With("Tag").ID, &Tag{Text: doc.text})
+}
+
+func (doc *textDoc) ApplyTx(tx *TxMsg) error {
+	if doc.onApply != nil {
+		if err := doc.onApply(); err != nil {
+			return err
+		}
+	}
+	val := &Tag{}
+	if err := tx.LoadItem(AttrSpec.With("Tag").ID, tag.ID{}, val); err != nil {
+		return err
+	}
+	doc.text = val.Text
+	return nil
+}
+
+func TestUndoManager(t *testing.T) {
+	doc := &textDoc{}
+	undo := NewUndoManager(2)
+
+	edit := func(text string) {
+		tx, _ := MarshalAttr(tag.ID{}, AttrSpec.With("Tag").ID, &Tag{Text: text})
+		inverse, _ := doc.InverseTx(tx)
+		doc.ApplyTx(tx)
+		undo.Record(doc, "set "+text, tx, inverse)
+	}
+	edit("a")
+	edit("b")
+	edit("c") // evicts "set a"
+
+	if state := undo.State(); state.UndoDepth != 2 || state.UndoLabel != "set c" {
+		t.Fatalf("unexpected state: %v", state)
+	}
+	undo.Undo()
+	undo.Undo()
+	if doc.text != "a" {
+		t.Fatalf("expected %q, got %q", "a", doc.text)
+	}
+	if err := undo.Undo(); err != ErrNothingToUndo {
+		t.Fatalf("expected ErrNothingToUndo, got %v", err)
+	}
+
+	undo.Redo()
+	if state := undo.State(); doc.text != "b" || state.RedoDepth != 1 || state.RedoLabel != "set c" {
+		t.Fatalf("unexpected redo: %q %v", doc.text, state)
+	}
+
+	// a new edit clears the redo stack
+	edit("d")
+	if err := undo.Redo(); err != ErrNothingToRedo {
+		t.Fatalf("expected ErrNothingToRedo, got %v", err)
+	}
+
+	// an app may use the undo manager while applying, and an entry failing to apply stays put
+	var applyErr error
+	doc.onApply = func() error {
+		undo.State()
+		return applyErr
+	}
+	if err := undo.Undo(); err != nil || doc.text != "b" {
+		t.Fatalf("undo while applying: %q, %v", doc.text, err)
+	}
+	applyErr = ErrUnimplemented
+	if err := undo.Redo(); err != applyErr {
+		t.Fatalf("expected apply error, got %v", err)
+	}
+	if state := undo.State(); state.RedoDepth != 1 || state.RedoLabel != "set d" {
+		t.Fatalf("expected failed redo to remain, got %v", state)
+	}
+}
+
+func TestUploadManager(t *testing.T) {
+	store, _ := blob.NewDirStore(t.TempDir())
+	stageDir := t.TempDir()
+
+	var completed []*UploadStatus
+	opts := UploadOpts{
+		Store:    store,
+		StageDir: stageDir,
+		OnComplete: func(req *UploadRequest, status *UploadStatus) {
+			completed = append(completed, status)
+		},
+	}
+	uploads, _ := NewUploadManager(opts)
+
+	content := []byte("0123456789")
+	req := &UploadRequest{UploadID: "capture-1", ContentType: "video/mp4", TotalSize: int64(len(content))}
+	if status, err := uploads.Begin(req); err != nil || status.Offset != 0 {
+		t.Fatalf("Begin: %v, %v", status, err)
+	}
+	uploads.WriteChunk(&UploadChunk{UploadID: "capture-1", Offset: 0, Data: content[:4]})
+
+	// a chunk at the wrong offset is ignored and the current offset is returned
+	if status, _ := uploads.WriteChunk(&UploadChunk{UploadID: "capture-1", Offset: 8, Data: content[8:]}); status.Offset != 4 {
+		t.Fatalf("expected offset 4, got %d", status.Offset)
+	}
+
+	// simulate a host restart: a new manager resumes from staged content
+	uploads, _ = NewUploadManager(opts)
+	status, err := uploads.Begin(req)
+	if err != nil || status.Offset != 4 {
+		t.Fatalf("resume: %v, %v", status, err)
+	}
+	status, err = uploads.WriteChunk(&UploadChunk{UploadID: "capture-1", Offset: 4, Data: content[4:]})
+	if err != nil || !status.Complete || status.CID != string(blob.CIDOf(content)) {
+		t.Fatalf("complete: %v, %v", status, err)
+	}
+	if len(completed) != 1 {
+		t.Fatalf("expected 1 completion, got %d", len(completed))
+	}
+	if _, err = store.Stat(context.Background(), blob.CID(status.CID).Key()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = uploads.WriteChunk(&UploadChunk{UploadID: "unknown"}); err != ErrNoUpload {
+		t.Fatalf("expected ErrNoUpload, got %v", err)
+	}
+
+	// uploads over MaxSize are rejected whether or not their size was declared
+	opts.MaxSize = 8
+	uploads, _ = NewUploadManager(opts)
+	if _, err = uploads.Begin(&UploadRequest{UploadID: "too-big", TotalSize: 9}); err == nil {
+		t.Fatal("expected Begin over MaxSize to fail")
+	}
+	uploads.Begin(&UploadRequest{UploadID: "open-ended"})
+	if status, err = uploads.WriteChunk(&UploadChunk{UploadID: "open-ended", Offset: 0, Data: content[:8]}); err != nil || status.Offset != 8 {
+		t.Fatalf("chunk within MaxSize: %v, %v", status, err)
+	}
+	if _, err = uploads.WriteChunk(&UploadChunk{UploadID: "open-ended", Offset: 8, Data: content[8:]}); err == nil {
+		t.Fatal("expected chunk over MaxSize to fail")
+	}
+}
+
+func TestCapabilities(t *testing.T) {
+	caps := NewHMACCapabilities([]byte("0123456789abcdef0123456789abcdef"))
+	cellID := tag.ID{0, 7, 37}
+	titleID := tag.ID{0, 1, 1}
+	issuer := tag.ID{0, 3, 3}
+
+	token, err := caps.Mint(Capability{CellID: cellID, Attrs: []tag.ID{titleID}, Issuer: issuer}, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pinReq := func(target tag.ID, attrs ...tag.ID) *Request {
+		req := &Request{Values: url.Values{CapabilityParam: {token}}}
+		req.PinTarget = &Tag{}
+		req.PinTarget.SetID(target)
+		for _, attrID := range attrs {
+			attr := &Tag{}
+			attr.SetID(attrID)
+			req.PinAttrs = append(req.PinAttrs, attr)
+		}
+		return req
+	}
+
+	capability, err := RequestCapability(caps, pinReq(cellID, titleID))
+	if err != nil || capability.Issuer != issuer {
+		t.Fatalf("redeem: %v, %v", capability, err)
+	}
+	if _, err = RequestCapability(caps, pinReq(tag.ID{0, 7, 38}, titleID)); err != ErrAccessDenied {
+		t.Fatalf("expected ErrAccessDenied for another cell, got %v", err)
+	}
+	if _, err = RequestCapability(caps, pinReq(cellID)); err != ErrAccessDenied {
+		t.Fatalf("expected ErrAccessDenied for all attrs, got %v", err)
+	}
+	if capability, err = RequestCapability(caps, &Request{}); capability != nil || err != nil {
+		t.Fatalf("expected no capability, got %v, %v", capability, err)
+	}
+
+	if _, err = caps.Redeem(token[:len(token)-2] + "xx"); err != ErrBadCapability {
+		t.Fatalf("expected ErrBadCapability, got %v", err)
+	}
+	caps.RevokeIssuer(issuer)
+	if _, err = caps.Redeem(token); err != ErrBadCapability {
+		t.Fatalf("expected revoked token to fail, got %v", err)
+	}
+
+	// ttl is clamped to MaxTTL
+	caps.MaxTTL = time.Hour
+	token, _ = caps.Mint(Capability{CellID: cellID, Issuer: issuer}, 1000*time.Hour)
+	if capability, err = caps.Redeem(token); err != nil || capability.ExpiresAt > time.Now().Add(time.Hour).Unix() {
+		t.Fatalf("expected ttl clamped to MaxTTL: %v, %v", capability, err)
+	}
+}
+
+func TestImpersonateLogin(t *testing.T) {
+	acl := NewCellACL()
+	audit := &ImpersonationLog{}
+	adminID, userID := tag.ID{0, 1, 1}, tag.ID{0, 2, 2}
+	admin := Login{UserID: &Tag{}}
+	admin.UserID.SetID(adminID)
+
+	if _, err := ImpersonateLogin(acl, audit, admin, userID, "ticket 42"); err != ErrAccessDenied {
+		t.Fatalf("expected ErrAccessDenied without role, got %v", err)
+	}
+	acl.GrantRole(RoleImpersonate, adminID)
+	if _, err := ImpersonateLogin(acl, audit, admin, userID, ""); err == nil {
+		t.Fatal("expected a reason to be required")
+	}
+
+	login, err := ImpersonateLogin(acl, audit, admin, userID, "ticket 42")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if login.UserID.AsID() != userID || !login.IsImpersonated() || login.ImpersonatorID.AsID() != adminID {
+		t.Fatalf("unexpected login: %v", login)
+	}
+	if records := audit.Records(); len(records) != 1 || records[0].Reason != "ticket 42" || records[0].UserID != userID {
+		t.Fatalf("unexpected audit: %v", records)
+	}
+
+	// an impersonated session can't impersonate further
+	if _, err = ImpersonateLogin(acl, audit, login, adminID, "chain"); err != ErrAccessDenied {
+		t.Fatalf("expected ErrAccessDenied for chained impersonation, got %v", err)
+	}
+}
+
+func TestRefreshLogin(t *testing.T) {
+	acl := NewCellACL()
+	userID, otherID := tag.ID{0, 1, 1}, tag.ID{0, 2, 2}
+	acl.BindToken("fresh", userID)
+	acl.BindToken("other", otherID)
+
+	current := Login{
+		UserID:     &Tag{},
+		Checkpoint: &LoginCheckpoint{AccessToken: "stale"},
+	}
+	current.UserID.SetID(userID)
+
+	expiry := time.Now().Add(time.Hour).Unix()
+	login, err := RefreshLogin(acl, current, &LoginCheckpoint{AccessToken: "fresh", Expiry: expiry})
+	if err != nil || login.Checkpoint.AccessToken != "fresh" || login.UserID.AsID() != userID {
+		t.Fatalf("refresh: %v, %v", login, err)
+	}
+	if _, err = RefreshLogin(acl, current, &LoginCheckpoint{AccessToken: "other"}); err == nil {
+		t.Fatal("expected a token for another user to be refused")
+	}
+	if _, err = RefreshLogin(acl, current, &LoginCheckpoint{AccessToken: "fresh", Expiry: time.Now().Unix() - 1}); err == nil {
+		t.Fatal("expected an expired token to be refused")
+	}
+}
+
+func TestDeviceRegistry(t *testing.T) {
+	registry := NewDeviceRegistry()
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	userID, deviceID := tag.ID{0, 1, 1}, tag.ID{0, 5, 5}
+
+	device := &Device{DeviceID: &Tag{}, UserID: &Tag{}, PublicKey: pub, Label: "phone"}
+	device.DeviceID.SetID(deviceID)
+	device.UserID.SetID(userID)
+
+	root, _ := task.Start(&task.Task{})
+	defer root.Close()
+	var seen []*Device
+	registry.WatchDevices(root, tag.ID{}, func(device *Device) {
+		seen = append(seen, device)
+	})
+
+	if err := registry.Enroll(device); err != nil {
+		t.Fatal(err)
+	}
+	challenge := NewLoginChallenge()
+	if _, err := registry.Authenticate(deviceID, challenge, SignChallenge(priv, challenge)); err != nil {
+		t.Fatal(err)
+	}
+	_, otherKey, _ := ed25519.GenerateKey(nil)
+	if _, err := registry.Authenticate(deviceID, challenge, SignChallenge(otherKey, challenge)); err == nil {
+		t.Fatal("expected a signature from another key to fail")
+	}
+	if devices := registry.ListDevices(userID); len(devices) != 1 || devices[0].Label != "phone" {
+		t.Fatalf("unexpected devices: %v", devices)
+	}
+
+	if err := registry.RevokeDevice(tag.ID{0, 2, 2}, deviceID); err != ErrDeviceNotFound {
+		t.Fatalf("expected ErrDeviceNotFound for another user, got %v", err)
+	}
+	if err := registry.RevokeDevice(userID, deviceID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := registry.Authenticate(deviceID, challenge, SignChallenge(priv, challenge)); err != ErrDeviceRevoked {
+		t.Fatalf("expected ErrDeviceRevoked, got %v", err)
+	}
+	if len(seen) != 2 || seen[1].RevokedAt == 0 {
+		t.Fatalf("expected enroll and revoke to be watched, got %v", seen)
+	}
+}
+
+func TestAPIKeys(t *testing.T) {
+	keys := NewAPIKeyStore()
+	userID, appID := tag.ID{0, 1, 1}, tag.ID{0, 7, 7}
+
+	secret, err := keys.Issue(APIKey{UserID: userID, Label: "importer", Apps: []tag.ID{appID}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	login, key, err := APIKeyLogin(keys, Login{APIKey: secret})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if login.UserID.AsID() != userID || login.APIKey != "" || key.LastUsedAt == 0 {
+		t.Fatalf("unexpected login %v for key %v", login, key)
+	}
+	if _, _, err = APIKeyLogin(keys, Login{APIKey: secret + "x"}); err != ErrBadAPIKey {
+		t.Fatalf("expected ErrBadAPIKey, got %v", err)
+	}
+
+	read, write := &Request{}, &Request{CommitTx: &TxMsg{}}
+	if err = key.Permits(appID, read); err != nil {
+		t.Fatal(err)
+	}
+	if key.Permits(appID, write) != ErrAccessDenied || key.Permits(tag.ID{0, 8, 8}, read) != ErrAccessDenied {
+		t.Fatal("expected the key's scope to be enforced")
+	}
+
+	if err = keys.RevokeKey(userID, key.KeyID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = keys.Authenticate(secret); err != ErrBadAPIKey {
+		t.Fatalf("expected a revoked key to fail, got %v", err)
+	}
+
+	secret, _ = keys.Issue(APIKey{UserID: userID, ExpiresAt: time.Now().Unix() - 1})
+	if _, err = keys.Authenticate(secret); err != ErrAPIKeyExpired {
+		t.Fatalf("expected ErrAPIKeyExpired, got %v", err)
+	}
+}
+
+func TestPasskeyAuth(t *testing.T) {
+	pa := NewPasskeyAuth("example.com", "https://example.com")
+	userID := tag.ID{0, 1, 1}
+	priv, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	pub, _ := x509.MarshalPKIXPublicKey(&priv.PublicKey)
+
+	// mimics what a browser authenticator produces
+	clientData := func(ceremony string, challenge *LoginChallenge) []byte {
+		return []byte(fmt.Sprintf(`{"type":%q,"challenge":%q,"origin":"https://example.com"}`,
+			ceremony, base64.RawURLEncoding.EncodeToString(challenge.Hash)))
+	}
+	authData := func(signCount byte) []byte {
+		rpHash := sha256.Sum256([]byte("example.com"))
+		return append(rpHash[:], authDataUserPresent, 0, 0, 0, signCount)
+	}
+
+	challenge := pa.BeginRegistration(userID)
+	_, err := pa.FinishRegistration(userID, challenge, &LoginResponse{
+		NewPasskey: &PasskeyCredential{
+			CredentialID:      []byte("cred-1"),
+			PublicKey:         pub,
+			AuthenticatorData: authData(0),
+			ClientDataJSON:    clientData("webauthn.create", challenge),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	login := &Login{Tags: "passkey", UserID: &Tag{}}
+	login.UserID.SetID(userID)
+	if SelectAuthProvider([]AuthProvider{pa}, login) != pa {
+		t.Fatal("expected passkey login to select PasskeyAuth")
+	}
+	sign := func(challenge *LoginChallenge, signCount byte) *LoginResponse {
+		assertion := &PasskeyAssertion{
+			CredentialID:      []byte("cred-1"),
+			AuthenticatorData: authData(signCount),
+			ClientDataJSON:    clientData("webauthn.get", challenge),
+		}
+		clientHash := sha256.Sum256(assertion.ClientDataJSON)
+		digest := sha256.Sum256(append(append([]byte(nil), assertion.AuthenticatorData...), clientHash[:]...))
+		assertion.Signature, _ = ecdsa.SignASN1(rand.Reader, priv, digest[:])
+		return &LoginResponse{Passkey: assertion}
+	}
+
+	challenge, err = pa.Challenge(login)
+	if err != nil || len(challenge.CredentialIDs) != 1 {
+		t.Fatalf("unexpected challenge %v: %v", challenge, err)
+	}
+	signedIn, err := pa.Verify(login, challenge, sign(challenge, 1))
+	if err != nil || signedIn != userID {
+		t.Fatalf("expected sign in as %v, got %v: %v", userID, signedIn, err)
+	}
+	if _, err = pa.Verify(login, challenge, sign(challenge, 1)); err != ErrBadPasskey {
+		t.Fatalf("expected a repeated sign counter to fail, got %v", err)
+	}
+	if _, err = pa.Verify(login, challenge, sign(NewLoginChallenge(), 2)); err != ErrBadPasskey {
+		t.Fatalf("expected another challenge to fail, got %v", err)
+	}
+}
+
+func TestKeyService(t *testing.T) {
+	keys := NewKeyService()
+	alice, bob, carol := tag.ID{0, 1, 1}, tag.ID{0, 2, 2}, tag.ID{0, 3, 3}
+
+	if _, err := keys.Provision(alice); err != nil {
+		t.Fatal(err)
+	}
+	sig, err := keys.Sign(alice, []byte("album"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = keys.Verify(alice, []byte("album"), sig); err != nil {
+		t.Fatal(err)
+	}
+	if keys.Verify(alice, []byte("albums"), sig) == nil {
+		t.Fatal("expected a signature of another msg to fail")
+	}
+
+	// bob holds his own keys
+	bobSealing, _ := ecdh.X25519().GenerateKey(rand.Reader)
+	bobSigning, _, _ := ed25519.GenerateKey(nil)
+	bobKey := &UserKey{UserID: &Tag{}, SigningKey: bobSigning, SealingKey: bobSealing.PublicKey().Bytes()}
+	bobKey.UserID.SetID(bob)
+	if err = keys.RegisterClientKey(bobKey); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = keys.Sign(bob, []byte("album")); err != ErrClientHeldKey {
+		t.Fatalf("expected ErrClientHeldKey, got %v", err)
+	}
+
+	sealed, err := keys.Seal([]tag.ID{alice, bob}, []byte("shared album key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opened, err := keys.Open(alice, sealed); err != nil || string(opened) != "shared album key" {
+		t.Fatalf("alice failed to open: %q %v", opened, err)
+	}
+	if opened, err := OpenSealed(bob, bobSealing, sealed); err != nil || string(opened) != "shared album key" {
+		t.Fatalf("bob failed to open: %q %v", opened, err)
+	}
+	if _, err = OpenSealed(carol, bobSealing, sealed); err != ErrNotSealedTo {
+		t.Fatalf("expected ErrNotSealedTo, got %v", err)
+	}
+	sealed[len(sealed)-1] ^= 1
+	if _, err = keys.Open(alice, sealed); err != ErrNotSealedTo {
+		t.Fatalf("expected tampered content to fail, got %v", err)
+	}
+}
+
+func TestSecurityLog(t *testing.T) {
+	audit := NewSecurityLog(3)
+	userID := tag.ID{0, 1, 1}
+	login := &Login{UserID: &Tag{}}
+	login.UserID.SetID(userID)
+
+	root, _ := task.Start(&task.Task{})
+	defer root.Close()
+	var streamed bytes.Buffer
+	var mu sync.Mutex
+	audit.WatchEvents(root, func(ev SecurityEvent) {
+		mu.Lock()
+		WriteSecurityJSONL(&streamed, []SecurityEvent{ev})
+		mu.Unlock()
+	})
+
+	RecordLogin(audit, login, "10.0.0.1", ErrCode_AuthFailed.Error("bad password"))
+	RecordLogin(audit, login, "10.0.0.1", nil)
+	RecordAccessDenied(audit, userID, tag.ID{0, 9, 9}, ErrAccessDenied)
+	audit.RecordImpersonation(ImpersonationRecord{AdminID: tag.ID{0, 2, 2}, UserID: userID, Reason: "ticket 42", At: time.Now().Unix()})
+
+	// capacity 3 drops the oldest
+	events := audit.QueryEvents(SecurityQuery{})
+	if len(events) != 3 || events[0].Kind != EventLoginSucceeded || events[2].Kind != EventImpersonationOpened {
+		t.Fatalf("unexpected events: %v", events)
+	}
+	denied := audit.QueryEvents(SecurityQuery{Kinds: []string{EventAccessDenied}, UserID: userID})
+	if len(denied) != 1 || denied[0].Target != (tag.ID{0, 9, 9}) {
+		t.Fatalf("unexpected denials: %v", denied)
+	}
+	if last := audit.QueryEvents(SecurityQuery{Limit: 1}); len(last) != 1 || last[0].Detail != "ticket 42" {
+		t.Fatalf("unexpected limited query: %v", last)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	lines := bytes.Split(bytes.TrimSpace(streamed.Bytes()), []byte("\n"))
+	if len(lines) != 4 || !bytes.Contains(lines[0], []byte(`"kind":"login.failed"`)) || !bytes.Contains(lines[0], []byte(`"remote":"10.0.0.1"`)) {
+		t.Fatalf("unexpected JSONL stream:\n%s", streamed.String())
+	}
+}
+
+// memCellStore is a CellStore for tests.
+type memCellStore struct {
+	mu    sync.Mutex
+	elems map[ElementID][]byte
+}
+
+func (ms *memCellStore) GetElement(elemID ElementID, dst tag.Value) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	buf, exists := ms.elems[elemID]
+	if !exists {
+		return ErrCellNotFound
+	}
+	return dst.Unmarshal(buf)
+}
+
+func (ms *memCellStore) PutElement(elemID ElementID, src tag.Value) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	if ms.elems == nil {
+		ms.elems = make(map[ElementID][]byte)
+	}
+	if src == nil {
+		delete(ms.elems, elemID)
+		return nil
+	}
+	buf, err := src.MarshalToStore(nil)
+	ms.elems[elemID] = buf
+	return err
+}
+
+func (ms *memCellStore) ForEachElement(cellID tag.ID, fn func(elemID ElementID, data []byte) error) error {
+	return ErrUnimplemented
+}
+
+func TestOAuthService(t *testing.T) {
+	var grants []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		grants = append(grants, r.PostForm)
+		switch r.PostForm.Get("grant_type") {
+		case "authorization_code":
+			fmt.Fprint(w, `{"access_token":"a1","token_type":"Bearer","refresh_token":"r1","expires_in":30}`)
+		case "refresh_token":
+			fmt.Fprint(w, `{"access_token":"a2","token_type":"Bearer","expires_in":3600}`)
+		}
+	}))
+	defer server.Close()
+
+	svc := NewOAuthService(&memCellStore{}, server.Client())
+	svc.RegisterProvider(OAuthProvider{
+		Name:        "photos",
+		ClientID:    "amp",
+		AuthURL:     server.URL + "/auth",
+		TokenURL:    server.URL + "/token",
+		RedirectURL: "https://host.example/oauth",
+	})
+	userID := tag.ID{0, 1, 1}
+
+	if _, err := svc.TokenSource(userID, "photos"); err != ErrOAuthNotGranted {
+		t.Fatalf("expected ErrOAuthNotGranted, got %v", err)
+	}
+	authURL, err := svc.AuthCodeURL(userID, "photos")
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, _ := url.Parse(authURL)
+	state := parsed.Query().Get("state")
+	if state == "" || parsed.Query().Get("code_challenge_method") != "S256" {
+		t.Fatalf("unexpected auth URL: %s", authURL)
+	}
+
+	ctx := context.Background()
+	if _, err = svc.Exchange(ctx, tag.ID{0, 2, 2}, "https://host.example/oauth?code=c1&state="+state); err != ErrOAuthBadState {
+		t.Fatalf("expected another user's redirect to fail, got %v", err)
+	}
+	authURL, _ = svc.AuthCodeURL(userID, "photos")
+	parsed, _ = url.Parse(authURL)
+	provider, err := svc.Exchange(ctx, userID, "https://host.example/oauth?code=c1&state="+parsed.Query().Get("state"))
+	if err != nil || provider != "photos" {
+		t.Fatalf("exchange failed: %v", err)
+	}
+	if grants[0].Get("code_verifier") == "" {
+		t.Fatal("expected a PKCE code verifier")
+	}
+
+	// the access token expires within the skew, so it is refreshed and the refresh token kept
+	src, err := svc.TokenSource(userID, "photos")
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := src.Token(ctx)
+	if err != nil || token.AccessToken != "a2" || token.RefreshToken != "r1" {
+		t.Fatalf("unexpected token %v: %v", token, err)
+	}
+	if token, _ = src.Token(ctx); token.AccessToken != "a2" || len(grants) != 2 {
+		t.Fatalf("expected the refreshed token to be reused, got %v after %d grants", token, len(grants))
+	}
+}
+
+func TestErrTaxonomy(t *testing.T) {
+	err := ErrCode_ProviderErr.Errorf("upstream %d", 503)
+	if !IsRetryable(err) || AsErr(err).Category != ErrCategory_Unavailable {
+		t.Fatalf("expected a retryable provider error: %+v", err)
+	}
+	if IsRetryable(ErrAccessDenied) || UserMessage(ErrAccessDenied) != "You don't have permission to do that." {
+		t.Fatalf("unexpected classification of %v", ErrAccessDenied)
+	}
+
+	// errors not made via an ErrCode are classified
+	wrapped := fmt.Errorf("loading: %w", ErrCellNotFound)
+	if GetErrCode(wrapped) != ErrCode_CellNotFound || !errors.Is(wrapped, ErrCellNotFound) {
+		t.Fatalf("expected a wrapped ErrCellNotFound: %v", wrapped)
+	}
+	if GetErrCode(context.DeadlineExceeded) != ErrCode_Timeout || GetErrCode(io.EOF) != ErrCode_UnnamedErr {
+		t.Fatal("unexpected classification of a non-amp error")
+	}
+
+	// category, retryability, and user message survive the wire
+	sent := ErrCode_QuotaExceeded.Error("uploads: 10GB limit").(*Err).WithUserMsg("Your storage is full.").WithRetryAfter(90 * time.Second)
+	tx, err := MarshalAttr(MetaNodeID, sent.TagSpec().ID, ErrorToValue(sent))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf []byte
+	tx.MarshalToBuffer(&buf)
+	recv, err := ReadTxMsg(bytes.NewReader(buf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := &Err{}
+	if err = recv.UnmarshalOpValue(0, got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Category != ErrCategory_Quota || !got.Retryable || got.RetryAfter() != 90*time.Second {
+		t.Fatalf("unexpected err: %+v", got)
+	}
+	if UserMessage(got) != "Your storage is full." || got.Error() != "uploads: 10GB limit" || !errors.Is(got, sent) {
+		t.Fatalf("unexpected err: %+v", got)
+	}
+
+	// an error from a peer predating categories is classified by its code
+	legacy := &Err{Code: ErrCode_SessionExpired}
+	if AsErr(legacy).Category != ErrCategory_Auth || legacy.Category != ErrCategory_Unknown {
+		t.Fatal("expected a legacy error to be classified without modifying it")
+	}
+}
+
+// loopTransport delivers each sent tx back to RecvTx.
+type loopTransport struct {
+	txs chan *TxMsg
+}
+
+func (t *loopTransport) Label() string { return "loop" }
+func (t *loopTransport) Close() error  { return nil }
+
+func (t *loopTransport) SendTx(tx *TxMsg) error {
+	t.txs <- tx
+	return nil
+}
+
+func (t *loopTransport) RecvTx() (*TxMsg, error) {
+	return <-t.txs, nil
+}
+
+func TestFlightRecorder(t *testing.T) {
+	fr := NewFlightRecorder(FlightRecorderOpts{
+		Capacity:    3,
+		MaxOps:      1,
+		SampleEvery: 2,
+	})
+	via := fr.Wrap(&loopTransport{txs: make(chan *TxMsg, 1)})
+
+	cellID := tag.ID{0, 0, 42}
+	for i := 0; i < 4; i++ {
+		tx := NewTxMsg(true)
+		tx.Upsert(cellID, (&Tag{}).TagSpec().ID, tag.ID{0, 0, uint64(i)}, &Tag{Text: "a"})
+		tx.Upsert(cellID, (&Tag{}).TagSpec().ID, tag.ID{0, 0, 99}, &Tag{Text: "b"})
+		if err := via.SendTx(tx); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := via.RecvTx(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// only the most recent 3 of 8 txs are retained, with sampled payloads and truncated op headers
+	logs := fr.Dump()
+	if len(logs) != 1 || logs[0].Recorded != 8 || len(logs[0].Entries) != 3 || !logs[0].Closed.IsZero() {
+		t.Fatalf("unexpected logs: %+v", logs)
+	}
+	entries := logs[0].Entries
+	if entries[0].Seq != 5 || entries[0].Sent || !entries[1].Sent || entries[1].OpCount != 2 || len(entries[1].Ops) != 1 {
+		t.Fatalf("unexpected entries: %+v", entries)
+	}
+	if entries[1].Ops[0].CellID != cellID || entries[1].Payload == nil || entries[0].Payload != nil {
+		t.Fatalf("unexpected entry: %+v", entries[1])
+	}
+	recv, err := ReadTxMsg(bytes.NewReader(entries[1].Payload))
+	if err != nil || len(recv.Ops) != 2 {
+		t.Fatalf("sampled payload should be a serialized tx: %v", err)
+	}
+
+	// a closed session's log is retained and served as JSON
+	via.Close()
+	rec := httptest.NewRecorder()
+	fr.ServeHTTP(rec, httptest.NewRequest("GET", "/debug/txs?session=loop", nil))
+	var dumped []struct {
+		Session string `json:"session"`
+		Closed  string `json:"closed"`
+		Entries []struct {
+			Dir string   `json:"dir"`
+			Ops []string `json:"ops"`
+		} `json:"entries"`
+	}
+	if err = json.Unmarshal(rec.Body.Bytes(), &dumped); err != nil {
+		t.Fatal(err)
+	}
+	if len(dumped) != 1 || dumped[0].Closed == "" || dumped[0].Entries[1].Dir != "send" || len(dumped[0].Entries[1].Ops) != 1 {
+		t.Fatalf("unexpected dump: %s", rec.Body.String())
+	}
+}
+
+func TestHealthChecks(t *testing.T) {
+	store, err := blob.NewDirStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	acceptLoop := &Heartbeat{}
+	checks := &HealthChecks{Timeout: 50 * time.Millisecond}
+	checks.Add(
+		CheckStorageReachable("storage", store),
+		CheckHeartbeat("accept", acceptLoop, time.Minute),
+		HealthCheck{
+			Name: "stuck",
+			Check: func(ctx context.Context) error {
+				<-ctx.Done()
+				return nil
+			},
+		},
+	)
+
+	// a stalled liveness check fails both probes, while a slow readiness check times out
+	report := checks.Run(context.Background())
+	if report.Live || report.Ready || report.Results[0].Err != nil {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+	if GetErrCode(report.Results[2].Err) != ErrCode_Timeout || !report.Results[1].Liveness {
+		t.Fatalf("unexpected results: %+v", report.Results)
+	}
+
+	acceptLoop.Beat()
+	report = checks.Run(context.Background())
+	if !report.Live || report.Ready {
+		t.Fatalf("expected live but not ready: %+v", report)
+	}
+}
+
+type nopInstance struct {
+	AppInstance
+}
+
+func (inst *nopInstance) ServeRequest(req Requester) (Pin, error) {
+	return nil, nil
+}
+
+func TestChaos(t *testing.T) {
+	run := func(seed int64) []Fault {
+		chaos := NewChaos(ChaosOpts{
+			Seed:      seed,
+			DropRate:  0.1,
+			DelayRate: 0.2,
+			MaxDelay:  time.Millisecond,
+			PanicRate: 0.3,
+		})
+		via := chaos.Wrap(&loopTransport{txs: make(chan *TxMsg, 1)})
+		for i := 0; i < 40; i++ {
+			if err := via.SendTx(NewTxMsg(true)); err != nil {
+				if err != ErrStreamClosed {
+					t.Fatal(err)
+				}
+				continue
+			}
+			if _, err := via.RecvTx(); err != nil && err != ErrStreamClosed {
+				t.Fatal(err)
+			}
+		}
+
+		app := chaos.WrapApp(&App{
+			AppSpec: AppSpec.With("chaos-test"),
+			NewAppInstance: func(ctx AppContext) (AppInstance, error) {
+				return &nopInstance{}, nil
+			},
+		})
+		inst, _ := app.NewAppInstance(nil)
+		for i := 0; i < 20; i++ {
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						if _, ok := r.(ChaosPanic); !ok {
+							t.Fatalf("unexpected panic %v", r)
+						}
+					}
+				}()
+				inst.ServeRequest(nil)
+			}()
+		}
+		return chaos.Faults()
+	}
+
+	faults := run(7)
+	counts := make(map[FaultKind]int)
+	for _, fault := range faults {
+		counts[fault.Kind]++
+	}
+	if counts[FaultDrop] == 0 || counts[FaultDelay] == 0 || counts[FaultPanic] == 0 {
+		t.Fatalf("expected each kind of fault, got %v", counts)
+	}
+	if !reflect.DeepEqual(faults, run(7)) {
+		t.Fatal("expected the same seed to inject the same faults")
+	}
+	if reflect.DeepEqual(faults, run(8)) {
+		t.Fatal("expected a different seed to inject different faults")
+	}
+}
+
+// rwMutexApps is the locking scheme the registry used before lookups read immutable snapshots, kept for comparison.
+type rwMutexApps struct {
+	mu           sync.RWMutex
+	appsByInvoke map[string]*App
+}
+
+func (reg *rwMutexApps) RegisterApp(app *App) error {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	reg.appsByInvoke[app.Invocations[0]] = app
+	return nil
+}
+
+func (reg *rwMutexApps) GetAppForInvocation(invocation string) (*App, error) {
+	reg.mu.RLock()
+	defer reg.mu.RUnlock()
+	if app := reg.appsByInvoke[invocation]; app != nil {
+		return app, nil
+	}
+	return nil, ErrCode_AppNotFound.Error(invocation)
+}
+
+// BenchmarkRegistryLookup measures GetAppForInvocation across cores while another goroutine keeps registering apps.
+func BenchmarkRegistryLookup(b *testing.B) {
+	type lookupRegistry interface {
+		RegisterApp(app *App) error
+		GetAppForInvocation(invocation string) (*App, error)
+	}
+	impls := []struct {
+		name string
+		reg  lookupRegistry
+	}{
+		{"snapshot", NewRegistry()},
+		{"rwmutex", &rwMutexApps{appsByInvoke: make(map[string]*App)}},
+	}
+	for _, impl := range impls {
+		b.Run(impl.name, func(b *testing.B) {
+			invocations := make([]string, 64)
+			for i := range invocations {
+				invocations[i] = fmt.Sprintf("app%d", i)
+				impl.reg.RegisterApp(&App{AppSpec: AppSpec.With(invocations[i]), Invocations: invocations[i : i+1]})
+			}
+
+			stop := make(chan struct{})
+			defer close(stop)
+			go func() {
+				for i := 0; ; i++ {
+					select {
+					case <-stop:
+						return
+					default:
+					}
+					name := fmt.Sprintf("churn%d", i%64)
+					impl.reg.RegisterApp(&App{AppSpec: AppSpec.With(name), Invocations: []string{name}})
+					time.Sleep(time.Millisecond)
+				}
+			}()
+
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				for i := 0; pb.Next(); i++ {
+					if _, err := impl.reg.GetAppForInvocation(invocations[i&63]); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		})
+	}
+}
+
+// batchTransport is a BatchSender that writes each batch via WriteTxBatch, blocking until released.
+type batchTransport struct {
+	loopTransport
+	release chan struct{}
+	buf     bytes.Buffer
+	scrap   []byte
+	batches []int
+}
+
+func (t *batchTransport) SendTxs(txs []*TxMsg) error {
+	<-t.release
+	t.batches = append(t.batches, len(txs))
+	_, err := WriteTxBatch(&t.buf, txs, &t.scrap)
+	return err
+}
+
+func TestTxSendQueue(t *testing.T) {
+	via := &batchTransport{release: make(chan struct{})}
+	q := NewTxSendQueue(via, TxSendQueueOpts{MaxBatch: 8})
+
+	cellID := tag.ID{0, 0, 42}
+	for i := 0; i < 10; i++ {
+		tx := NewTxMsg(true)
+		tx.Upsert(cellID, (&Tag{}).TagSpec().ID, tag.ID{0, 0, uint64(i)}, &Tag{Text: strconv.Itoa(i)})
+		if err := q.Send(tx); err != nil {
+			t.Fatal(err)
+		}
+		tx.ReleaseRef()
+	}
+
+	// while a batch is being sent, the rest accumulate and are sent as batches of at most MaxBatch
+	close(via.release)
+	q.Close()
+	total := 0
+	for _, n := range via.batches {
+		if n > 8 {
+			t.Fatalf("batch exceeds MaxBatch: %v", via.batches)
+		}
+		total += n
+	}
+	if total != 10 || len(via.batches) >= 10 {
+		t.Fatalf("expected 10 txs in batches, got %v", via.batches)
+	}
+
+	for i := 0; i < 10; i++ {
+		tx, err := ReadTxMsg(&via.buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		val := &Tag{}
+		if err = tx.UnmarshalOpValue(0, val); err != nil || val.Text != strconv.Itoa(i) {
+			t.Fatalf("tx %d: unexpected value %q: %v", i, val.Text, err)
+		}
+	}
+}
+
+func TestTxSendQueueWindow(t *testing.T) {
+	via := &batchTransport{release: make(chan struct{})}
+	close(via.release)
+	q := NewTxSendQueue(via, TxSendQueueOpts{MaxLinger: time.Second})
+
+	send := func(n int) {
+		for i := 0; i < n; i++ {
+			tx := NewTxMsg(true)
+			tx.Upsert(tag.ID{0, 0, 42}, (&Tag{}).TagSpec().ID, tag.ID{0, 0, uint64(i)}, &Tag{Text: strconv.Itoa(i)})
+			if err := q.Send(tx); err != nil {
+				t.Fatal(err)
+			}
+			tx.ReleaseRef()
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	// a fast link drains quickly, so txs are not held back
+	send(4)
+	if stats := q.Stats(); stats.DrainRate <= 0 || stats.Window >= time.Millisecond {
+		t.Fatalf("expected a negligible window, got %+v", stats)
+	}
+
+	// a slow link (long RTT) holds batches open to send fewer, larger txs
+	q.ObserveRTT(400 * time.Millisecond)
+	if window := q.Stats().Window; window < 50*time.Millisecond {
+		t.Fatalf("expected window to widen, got %v", window)
+	}
+	send(5)
+	q.Close()
+	if last := via.batches[len(via.batches)-1]; last != 5 {
+		t.Fatalf("expected the last 5 txs in 1 batch, got %v", via.batches)
+	}
+}
+
+func TestTxSendQueueClosed(t *testing.T) {
+	via := &batchTransport{release: make(chan struct{})}
+	close(via.release)
+	q := NewTxSendQueue(via, TxSendQueueOpts{})
+	q.Close()
+
+	// a tx sent after Close is refused rather than queued and never sent
+	tx := NewTxMsg(true)
+	if err := q.Send(tx); err != ErrStreamClosed {
+		t.Fatalf("expected ErrStreamClosed, got %v", err)
+	}
+	if tx.refCount != 1 || len(via.batches) != 0 {
+		t.Fatalf("expected tx not retained or sent: refs %d, batches %v", tx.refCount, via.batches)
+	}
+	tx.ReleaseRef()
+}
+
+// refAppendOps and refReadOps are the original (append-based) op codec, against which appendOps and readOps are checked.
+func refAppendOps(dst []byte, ops []TxOp) []byte {
+	var (
+		op_prv [TxField_MaxFields]uint64
+		op_cur [TxField_MaxFields]uint64
+	)
+	for _, op := range ops {
+		dst = binary.AppendUvarint(dst, 0)
+		dst = binary.AppendUvarint(dst, uint64(op.OpCode))
+		dst = binary.AppendUvarint(dst, op.DataLen)
+		dst = binary.AppendUvarint(dst, op.DataOfs)
+
+		op_cur[TxField_CellID_0], op_cur[TxField_CellID_1], op_cur[TxField_CellID_2] = op.CellID[0], op.CellID[1], op.CellID[2]
+		op_cur[TxField_AttrID_0], op_cur[TxField_AttrID_1], op_cur[TxField_AttrID_2] = op.AttrID[0], op.AttrID[1], op.AttrID[2]
+		op_cur[TxField_ItemID_0], op_cur[TxField_ItemID_1], op_cur[TxField_ItemID_2] = op.ItemID[0], op.ItemID[1], op.ItemID[2]
+		op_cur[TxField_EditID_0], op_cur[TxField_EditID_1], op_cur[TxField_EditID_2] = op.EditID[0], op.EditID[1], op.EditID[2]
+
+		hasFields := uint64(0)
+		for i, fi := range op_cur {
+			if fi != op_prv[i] {
+				hasFields |= (1 << i)
+			}
+		}
+		dst = binary.AppendUvarint(dst, hasFields)
+		for i, fi := range op_cur {
+			if hasFields&(1<<i) != 0 {
+				dst = binary.LittleEndian.AppendUint64(dst, fi)
+			}
+		}
+		op_prv = op_cur
+	}
+	return dst
+}
+
+func refReadOps(src []byte, opCount uint64) (ops []TxOp, err error) {
+	defer func() {
+		if recover() != nil { // the original could index out of range on malformed input
+			ops, err = nil, ErrMalformedTx
+		}
+	}()
+
+	var op_cur [TxField_MaxFields]uint64
+	p := 0
+	for i := uint64(0); i < opCount; i++ {
+		var op TxOp
+		var vals [5]uint64
+		for j := range vals {
+			v, n := binary.Uvarint(src[p:])
+			if n <= 0 {
+				return ops, ErrMalformedTx
+			}
+			p += n
+			if j == 0 {
+				if v > uint64(len(src)-p) { // the original wrapped p (rather than fail) given a huge skip
+					return ops, ErrMalformedTx
+				}
+				p += int(v)
+			}
+			vals[j] = v
+		}
+		op.OpCode, op.DataLen, op.DataOfs = TxOpCode(vals[1]), vals[2], vals[3]
+		for i := 0; i < int(TxField_MaxFields); i++ {
+			if vals[4]&(1<<i) != 0 {
+				if p+8 > len(src) {
+					return ops, ErrMalformedTx
+				}
+				op_cur[i] = binary.LittleEndian.Uint64(src[p:])
+				p += 8
+			}
+		}
+		op.CellID = tag.ID{op_cur[TxField_CellID_0], op_cur[TxField_CellID_1], op_cur[TxField_CellID_2]}
+		op.AttrID = tag.ID{op_cur[TxField_AttrID_0], op_cur[TxField_AttrID_1], op_cur[TxField_AttrID_2]}
+		op.ItemID = tag.ID{op_cur[TxField_ItemID_0], op_cur[TxField_ItemID_1], op_cur[TxField_ItemID_2]}
+		op.EditID = tag.ID{op_cur[TxField_EditID_0], op_cur[TxField_EditID_1], op_cur[TxField_EditID_2]}
+		ops = append(ops, op)
+	}
+	return ops, nil
+}
+
+// opsFromBytes derives ops from fuzz input, drawing ID words from a small set so that consecutive ops share fields.
+func opsFromBytes(data []byte) []TxOp {
+	words := []uint64{0, 1, 0x7F, 0x80, 1 << 35, ^uint64(0)}
+	var ops []TxOp
+	for len(data) >= 4 {
+		op := TxOp{}
+		op.OpCode = TxOpCode(data[0] % 8)
+		op.DataLen = uint64(data[1]) << (data[2] % 57)
+		op.DataOfs = uint64(data[2]) * uint64(data[3])
+		ids := opIDWordsOf(&op.TxOpID)
+		for w := range ids {
+			ids[w] = words[int(data[3]>>(w%6)+data[w%4])%len(words)]
+		}
+		ops = append(ops, op)
+		data = data[4:]
+	}
+	return ops
+}
+
+func FuzzTxOpCodec(f *testing.F) {
+	f.Add([]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12})
+	f.Add(refAppendOps(nil, opsFromBytes([]byte("the quick brown fox jumps over the lazy dog"))))
+	f.Add([]byte{0, 1, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x02})
+	f.Fuzz(func(t *testing.T, data []byte) {
+
+		// encoding is byte-for-byte compatible and round trips
+		ops := opsFromBytes(data)
+		enc := appendOps(nil, ops)
+		if !bytes.Equal(enc, refAppendOps(nil, ops)) {
+			t.Fatalf("encoding mismatch for %d ops", len(ops))
+		}
+		dec, err := readOps(nil, enc, uint64(len(ops)))
+		if err != nil || (len(ops) > 0 && !reflect.DeepEqual(dec, ops)) {
+			t.Fatalf("round trip failed: %v", err)
+		}
+
+		// arbitrary input decodes as the original did (or fails where it did)
+		opCount := uint64(len(data) % 7)
+		want, wantErr := refReadOps(data, opCount)
+		got, gotErr := readOps(nil, data, opCount)
+		if (wantErr == nil) != (gotErr == nil) || (wantErr == nil && len(want) > 0 && !reflect.DeepEqual(got, want)) {
+			t.Fatalf("decode mismatch: %v vs %v", gotErr, wantErr)
+		}
+	})
+}
+
+func BenchmarkTxOpCodec(b *testing.B) {
+	ops := make([]TxOp, 1000)
+	cellID, attrID := tag.Now(), tag.Now()
+	for i := range ops {
+		ops[i].OpCode = TxOpCode_UpsertElement
+		ops[i].CellID, ops[i].AttrID = cellID, attrID
+		ops[i].ItemID = tag.ID{0, 0, uint64(i)}
+		ops[i].DataOfs, ops[i].DataLen = uint64(40*i), 40
+	}
+	enc := appendOps(nil, ops)
+	buf := make([]byte, 0, len(enc))
+	dec := make([]TxOp, 0, len(ops))
+
+	b.Run("marshal/ref", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			buf = refAppendOps(buf[:0], ops)
+		}
+	})
+	b.Run("marshal", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			buf = appendOps(buf[:0], ops)
+		}
+	})
+	b.Run("unmarshal/ref", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			refReadOps(enc, uint64(len(ops)))
+		}
+	})
+	b.Run("unmarshal", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			dec, _ = readOps(dec[:0], enc, uint64(len(ops)))
+		}
+	})
+}
@@ -0,0 +1,104 @@
+// Package badges implements the "badges:" sys app, which exposes the session user's pending notification count per app, updated live.
+package badges
+
+import (
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/amp/std"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+var AppSpec = amp.AppSpec.With("sys.badges")
+
+// RegisterApp registers the badges app, invoked via "badges:".
+// The pinned cell has a child cell per app with pending notifications, each with a CellLabel and CellBadge property.
+func RegisterApp(reg amp.Registry) error {
+	return reg.RegisterApp(&amp.App{
+		AppSpec:     AppSpec,
+		Desc:        "pending notification counts per app",
+		Version:     "v1.0.0",
+		Invocations: []string{"badges"},
+		NewAppInstance: func(ctx amp.AppContext) (amp.AppInstance, error) {
+			app := &appInst{}
+			app.AppContext = ctx
+			app.Instance = app
+			return app, nil
+		},
+	})
+}
+
+type appInst struct {
+	std.App[*appInst]
+}
+
+func (app *appInst) ServeRequest(op amp.Requester) (amp.Pin, error) {
+	sess := app.Session()
+	svc := amp.ServicesOf(sess).Notifications
+	login := sess.Login()
+	if svc == nil || login.UserID == nil {
+		return nil, amp.ErrUnimplemented
+	}
+	user := *login.UserID
+
+	cell := &badgesCell{
+		app:    app,
+		svc:    svc,
+		userID: user.AsID(),
+	}
+	cell.Inputs = []*std.Signal{&cell.changed}
+	cell.Compute = cell.computeBadges
+	return app.PinAndServe(cell, op)
+}
+
+// badgesCell has a child cell for each app with pending notifications.
+type badgesCell struct {
+	std.ComputedCell[*appInst]
+	app     *appInst
+	svc     amp.NotificationService
+	userID  tag.ID
+	changed std.Signal
+}
+
+func (cell *badgesCell) PinInto(pin *std.Pin[*appInst]) error {
+	if pin.Sync == amp.StateSync_Maintain {
+		cell.svc.WatchBadges(pin.Context(), cell.userID, func(*amp.Badge) {
+			cell.changed.Notify()
+		})
+	}
+	return cell.ComputedCell.PinInto(pin)
+}
+
+// Each child's cell ID is derived from its app ID so it is stable as counts change.
+func (cell *badgesCell) computeBadges() ([]std.Cell[*appInst], error) {
+	badges := cell.svc.Badges(cell.userID)
+
+	children := make([]std.Cell[*appInst], len(badges))
+	for i, badge := range badges {
+		appID := badge.AppID.AsID()
+		child := &badgeCell{
+			badge: badge,
+			label: appID.Base32Suffix(),
+		}
+		if app, err := cell.app.Session().GetAppByTag(appID); err == nil {
+			child.label = app.Desc
+		}
+		child.ID = AppSpec.ID.With(appID)
+		children[i] = child
+	}
+	return children, nil
+}
+
+// badgeCell presents the pending notification count of a single app.
+type badgeCell struct {
+	std.CellNode[*appInst]
+	badge *amp.Badge
+	label string
+}
+
+func (cell *badgeCell) PinInto(pin *std.Pin[*appInst]) error {
+	return nil
+}
+
+func (cell *badgeCell) MarshalAttrs(w std.CellWriter) {
+	w.PutText(std.CellLabel, cell.label)
+	w.PutItem(std.CellBadge, cell.badge)
+}
@@ -0,0 +1,382 @@
+// Package dav implements the "dav:" sys app, which serves the calendars and address books of CalDAV and CardDAV servers as cells of std.CalendarEvent and std.Contact,
+// writing edits back to the server so that planner-style client UIs work against existing servers.
+package dav
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/amp/std"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+var AppSpec = amp.AppSpec.With("sys.dav")
+
+// CollectionParam is the pin URL query parameter naming the pinned collection (see Opts.Collections).
+const CollectionParam = "collection"
+
+// Kind is the kind of a DAV collection.
+type Kind int32
+
+const (
+	Calendar    Kind = iota // CalDAV calendar of VEVENTs, served as std.CalendarEvent
+	AddressBook             // CardDAV address book of vCards, served as std.Contact
+)
+
+// Collection is a CalDAV calendar or CardDAV address book.
+type Collection struct {
+	Kind     Kind
+	URL      string        // collection URL, e.g. "https://dav.example.com/calendars/alice/work/"
+	Username string        // if set, sent via HTTP basic auth
+	Password string        // typically an app-specific password
+	Refresh  time.Duration // if > 0, the collection is re-read at this interval while pinned with StateSync_Maintain
+}
+
+// Opts specifies the collections the dav app serves.
+type Opts struct {
+	Client      *http.Client          // if nil, a client with a 30s timeout
+	Collections map[string]Collection // by name
+}
+
+// RegisterApp registers the dav app, invoked via "dav:?collection={name}".
+// The pinned cell has a child cell per calendar event or contact, each with a CellLabel and a CellEvent or CellContact property.
+//
+// A request committing CellEvent or CellContact properties writes them back to the server:
+//   - a property upserted to an existing item's cell replaces the mapped fields of that item, retaining fields that std types do not map
+//   - a property upserted to any other cell creates a new item, whose cell ID is derived from its server URL once read back
+//   - a deleted property deletes the item
+//
+// Writes are conditional on the item's ETag, so an item changed on the server since it was last read is not overwritten.
+func RegisterApp(reg amp.Registry, opts Opts) error {
+	if opts.Client == nil {
+		opts.Client = &http.Client{Timeout: 30 * time.Second}
+	}
+	collections := make(map[string]*collectionState, len(opts.Collections))
+	for name, coll := range opts.Collections {
+		base, err := url.Parse(coll.URL)
+		if err != nil || base.Scheme == "" {
+			return amp.ErrCode_BadRequest.Errorf("dav: bad URL for collection %q", name)
+		}
+		if !strings.HasSuffix(base.Path, "/") {
+			base.Path += "/"
+		}
+		coll.URL = base.String()
+		collections[name] = &collectionState{
+			client: client{
+				Collection: &coll,
+				http:       opts.Client,
+				base:       base,
+			},
+			cellID: AppSpec.ID.WithToken(coll.URL),
+		}
+	}
+
+	return reg.RegisterApp(&amp.App{
+		AppSpec:     AppSpec,
+		Desc:        "CalDAV calendars and CardDAV address books",
+		Version:     "v1.0.0",
+		Invocations: []string{"dav"},
+		NewAppInstance: func(ctx amp.AppContext) (amp.AppInstance, error) {
+			app := &appInst{
+				collections: collections,
+			}
+			app.AppContext = ctx
+			app.Instance = app
+			return app, nil
+		},
+	})
+}
+
+type appInst struct {
+	std.App[*appInst]
+	collections map[string]*collectionState
+}
+
+func (app *appInst) ServeRequest(op amp.Requester) (amp.Pin, error) {
+	req := op.Request()
+	var name string
+	if req.Values != nil {
+		name = req.Values.Get(CollectionParam)
+	}
+	coll := app.collections[name]
+	if coll == nil {
+		return nil, amp.ErrCode_BadRequest.Errorf("dav: unknown collection %q", name)
+	}
+
+	if tx := req.CommitTx; tx != nil {
+		if err := coll.commit(app, tx); err != nil {
+			return nil, err
+		}
+	}
+
+	cell := &collectionCell{
+		coll: coll,
+	}
+	cell.ID = coll.cellID
+	cell.Inputs = []*std.Signal{&coll.changed}
+	cell.Compute = cell.computeItems
+	return app.PinAndServe(cell, op)
+}
+
+// collectionState is the most recently read items of a Collection, shared by all app instances so that a write back refreshes every session's pins.
+type collectionState struct {
+	client
+	cellID  tag.ID
+	changed std.Signal // notified to re-read the collection
+
+	mu    sync.Mutex
+	items map[tag.ID]*item // by cell ID
+}
+
+// item is a calendar object or vCard.
+type item struct {
+	resource
+	cellID  tag.ID
+	event   *std.CalendarEvent // if Calendar
+	contact *std.Contact       // if AddressBook
+}
+
+// propertyID returns the cell property that items of this collection are presented as.
+func (coll *collectionState) propertyID() tag.ID {
+	if coll.Kind == AddressBook {
+		return std.CellContact
+	}
+	return std.CellEvent
+}
+
+// read reads every item in the collection, returning them in server order.
+func (coll *collectionState) read(ctx context.Context) ([]*item, error) {
+	resources, err := coll.query(ctx)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*item, 0, len(resources))
+	byID := make(map[tag.ID]*item, len(resources))
+	for _, res := range resources {
+		obj, err := parseComponent(res.Data)
+		if err != nil {
+			continue // skip objects we cannot parse rather than failing the collection
+		}
+		it := &item{
+			resource: res,
+			cellID:   coll.cellID.Then(tag.FromToken(res.Href)),
+		}
+		if coll.Kind == AddressBook {
+			if obj.Name != "VCARD" {
+				continue
+			}
+			it.contact = toContact(obj)
+		} else {
+			vevent := masterEvent(obj)
+			if vevent == nil {
+				continue
+			}
+			it.event = toEvent(vevent)
+		}
+		items = append(items, it)
+		byID[it.cellID] = it
+	}
+
+	coll.mu.Lock()
+	coll.items = byID
+	coll.mu.Unlock()
+	return items, nil
+}
+
+// commit writes back each CellEvent or CellContact property in tx and then notifies the collection's pins.
+func (coll *collectionState) commit(ctx context.Context, tx *amp.TxMsg) error {
+	coll.mu.Lock()
+	loaded := coll.items != nil
+	coll.mu.Unlock()
+	if !loaded {
+		if _, err := coll.read(ctx); err != nil {
+			return err
+		}
+	}
+
+	propertyID := coll.propertyID()
+	wrote := false
+	defer func() {
+		if wrote {
+			coll.changed.Notify()
+		}
+	}()
+	for i, op := range tx.Ops {
+		if op.AttrID != std.CellProperties.ID || op.ItemID != propertyID {
+			continue
+		}
+		coll.mu.Lock()
+		existing := coll.items[op.CellID]
+		coll.mu.Unlock()
+
+		var err error
+		switch op.OpCode {
+		case amp.TxOpCode_DeleteElement:
+			if existing != nil {
+				err = coll.remove(ctx, existing.Href, existing.ETag)
+			}
+		case amp.TxOpCode_UpsertElement:
+			err = coll.write(ctx, tx, i, existing)
+		default:
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		wrote = true
+	}
+	return nil
+}
+
+// write creates or updates an item from the std.CalendarEvent or std.Contact value of the given op.
+func (coll *collectionState) write(ctx context.Context, tx *amp.TxMsg, idx int, existing *item) error {
+	var obj *component
+	var href, etag string
+	if existing != nil {
+		var err error
+		if obj, err = parseComponent(existing.Data); err != nil {
+			return err
+		}
+		href, etag = existing.Href, existing.ETag
+	}
+
+	uid := ""
+	if coll.Kind == AddressBook {
+		contact := &std.Contact{}
+		if err := tx.UnmarshalOpValue(idx, contact); err != nil {
+			return err
+		}
+		if obj == nil {
+			obj = &component{
+				Name:  "VCARD",
+				Props: []prop{{Name: "VERSION", Value: "3.0"}},
+			}
+		}
+		if contact.UID == "" {
+			contact.UID = obj.text("UID")
+		}
+		if contact.UID == "" {
+			contact.UID = tag.Now().Base32()
+		}
+		uid = contact.UID
+		applyContact(obj, contact)
+	} else {
+		event := &std.CalendarEvent{}
+		if err := tx.UnmarshalOpValue(idx, event); err != nil {
+			return err
+		}
+		if obj == nil {
+			obj = &component{
+				Name: "VCALENDAR",
+				Props: []prop{
+					{Name: "VERSION", Value: "2.0"},
+					{Name: "PRODID", Value: "-//art.media.platform//amp dav//EN"},
+				},
+				Children: []*component{{Name: "VEVENT"}},
+			}
+		}
+		vevent := masterEvent(obj)
+		if vevent == nil {
+			return amp.ErrCode_ProviderErr.Error("dav: calendar object has no VEVENT")
+		}
+		if event.UID == "" {
+			event.UID = vevent.text("UID")
+		}
+		if event.UID == "" {
+			event.UID = tag.Now().Base32()
+		}
+		uid = event.UID
+		applyEvent(vevent, event, time.Now())
+	}
+
+	if href == "" {
+		ext := ".ics"
+		if coll.Kind == AddressBook {
+			ext = ".vcf"
+		}
+		ref, err := coll.base.Parse(url.PathEscape(uid) + ext)
+		if err != nil {
+			return amp.ErrCode_BadRequest.Errorf("dav: bad UID %q", uid)
+		}
+		href = ref.String()
+	}
+	_, err := coll.put(ctx, href, etag, obj.String())
+	return err
+}
+
+// collectionCell presents a collection's items as child cells.
+type collectionCell struct {
+	std.ComputedCell[*appInst]
+	coll *collectionState
+}
+
+func (cell *collectionCell) PinInto(pin *std.Pin[*appInst]) error {
+	if err := cell.ComputedCell.PinInto(pin); err != nil {
+		return err
+	}
+	refresh := cell.coll.Refresh
+	if pin.Sync != amp.StateSync_Maintain || refresh <= 0 {
+		return nil
+	}
+	_, err := pin.Context().StartChild(&task.Task{
+		Info: task.Info{
+			Label: "refresh: " + cell.coll.URL,
+		},
+		OnRun: func(ctx task.Context) {
+			for {
+				timer := ctx.Clock().NewTimer(refresh)
+				select {
+				case <-timer.C():
+					cell.coll.changed.Notify()
+				case <-ctx.Closing():
+					timer.Stop()
+					return
+				}
+			}
+		},
+	})
+	return err
+}
+
+func (cell *collectionCell) computeItems() ([]std.Cell[*appInst], error) {
+	items, err := cell.coll.read(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	children := make([]std.Cell[*appInst], len(items))
+	for i, it := range items {
+		child := &itemCell{
+			item: it,
+		}
+		child.ID = it.cellID
+		children[i] = child
+	}
+	return children, nil
+}
+
+// itemCell presents a single event or contact.
+type itemCell struct {
+	std.CellNode[*appInst]
+	item *item
+}
+
+func (cell *itemCell) PinInto(pin *std.Pin[*appInst]) error {
+	return nil
+}
+
+func (cell *itemCell) MarshalAttrs(w std.CellWriter) {
+	if event := cell.item.event; event != nil {
+		w.PutText(std.CellLabel, event.Summary)
+		w.PutItem(std.CellEvent, event)
+	}
+	if contact := cell.item.contact; contact != nil {
+		w.PutText(std.CellLabel, contact.FullName)
+		w.PutItem(std.CellContact, contact)
+	}
+}
@@ -0,0 +1,179 @@
+package dav
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+)
+
+// resource is a calendar object or vCard stored in a collection.
+type resource struct {
+	Href string // absolute URL
+	ETag string
+	Data string // iCalendar or vCard
+}
+
+const (
+	calendarQuery = `<?xml version="1.0" encoding="utf-8"?>
+<C:calendar-query xmlns:D="DAV:" xmlns:C="urn:ietf:params:xml:ns:caldav">
+  <D:prop><D:getetag/><C:calendar-data/></D:prop>
+  <C:filter><C:comp-filter name="VCALENDAR"><C:comp-filter name="VEVENT"/></C:comp-filter></C:filter>
+</C:calendar-query>`
+
+	addressBookQuery = `<?xml version="1.0" encoding="utf-8"?>
+<C:addressbook-query xmlns:D="DAV:" xmlns:C="urn:ietf:params:xml:ns:carddav">
+  <D:prop><D:getetag/><C:address-data/></D:prop>
+</C:addressbook-query>`
+)
+
+type multistatus struct {
+	Responses []struct {
+		Href      string `xml:"DAV: href"`
+		Propstats []struct {
+			Status string `xml:"DAV: status"`
+			Prop   struct {
+				ETag         string `xml:"DAV: getetag"`
+				CalendarData string `xml:"urn:ietf:params:xml:ns:caldav calendar-data"`
+				AddressData  string `xml:"urn:ietf:params:xml:ns:carddav address-data"`
+			} `xml:"DAV: prop"`
+		} `xml:"DAV: propstat"`
+	} `xml:"DAV: response"`
+}
+
+// client makes CalDAV and CardDAV requests against a single collection.
+type client struct {
+	*Collection
+	http *http.Client
+	base *url.URL
+}
+
+func (c *client) newRequest(ctx context.Context, method, href string, body string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, href, strings.NewReader(body))
+	if err != nil {
+		return nil, amp.ErrCode_BadRequest.Errorf("dav: %v", err)
+	}
+	if c.Username != "" || c.Password != "" {
+		req.SetBasicAuth(c.Username, c.Password)
+	}
+	return req, nil
+}
+
+func (c *client) do(req *http.Request) (*http.Response, error) {
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, amp.ErrCode_ProviderErr.Errorf("dav: %v", err)
+	}
+	return resp, nil
+}
+
+// query returns every resource in the collection.
+func (c *client) query(ctx context.Context) ([]resource, error) {
+	body := calendarQuery
+	if c.Kind == AddressBook {
+		body = addressBookQuery
+	}
+	req, err := c.newRequest(ctx, "REPORT", c.URL, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", `application/xml; charset="utf-8"`)
+	req.Header.Set("Depth", "1")
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusMultiStatus {
+		return nil, statusErr(resp)
+	}
+
+	var ms multistatus
+	if err = xml.NewDecoder(resp.Body).Decode(&ms); err != nil {
+		return nil, amp.ErrCode_ProviderErr.Errorf("dav: malformed multistatus: %v", err)
+	}
+	var resources []resource
+	for _, r := range ms.Responses {
+		href, err := c.base.Parse(r.Href)
+		if err != nil {
+			continue
+		}
+		for _, ps := range r.Propstats {
+			data := ps.Prop.CalendarData + ps.Prop.AddressData
+			if data == "" || (ps.Status != "" && !strings.Contains(ps.Status, " 200 ")) {
+				continue
+			}
+			resources = append(resources, resource{
+				Href: href.String(),
+				ETag: ps.Prop.ETag,
+				Data: data,
+			})
+		}
+	}
+	return resources, nil
+}
+
+// put writes a resource, failing if it was changed since etag (or if etag is empty, if it already exists), and returns its new ETag (if reported).
+func (c *client) put(ctx context.Context, href, etag, data string) (string, error) {
+	req, err := c.newRequest(ctx, http.MethodPut, href, data)
+	if err != nil {
+		return "", err
+	}
+	if c.Kind == AddressBook {
+		req.Header.Set("Content-Type", "text/vcard; charset=utf-8")
+	} else {
+		req.Header.Set("Content-Type", "text/calendar; charset=utf-8")
+	}
+	if etag != "" {
+		req.Header.Set("If-Match", etag)
+	} else {
+		req.Header.Set("If-None-Match", "*")
+	}
+	resp, err := c.do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", statusErr(resp)
+	}
+	return resp.Header.Get("ETag"), nil
+}
+
+// remove deletes a resource, failing if it was changed since etag.
+func (c *client) remove(ctx context.Context, href, etag string) error {
+	req, err := c.newRequest(ctx, http.MethodDelete, href, "")
+	if err != nil {
+		return err
+	}
+	if etag != "" {
+		req.Header.Set("If-Match", etag)
+	}
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound && (resp.StatusCode < 200 || resp.StatusCode >= 300) {
+		return statusErr(resp)
+	}
+	return nil
+}
+
+func statusErr(resp *http.Response) error {
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	msg = bytes.TrimSpace(msg)
+	switch resp.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return amp.ErrCode_AuthFailed.Errorf("dav: %s", resp.Status)
+	case http.StatusPreconditionFailed:
+		return amp.ErrCode_ProviderErr.Error("dav: changed on the server since last read")
+	default:
+		return amp.ErrCode_ProviderErr.Errorf("dav: %s %s", resp.Status, msg)
+	}
+}
@@ -0,0 +1,245 @@
+package dav
+
+import (
+	"strings"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp/std"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+// Properties written from a std.CalendarEvent, replacing any previous values -- all others are retained.
+var eventProps = []string{"UID", "SUMMARY", "DESCRIPTION", "LOCATION", "DTSTART", "DTEND", "DURATION", "RRULE", "STATUS", "ORGANIZER", "ATTENDEE", "DTSTAMP"}
+
+// Properties written from a std.Contact, replacing any previous values -- all others are retained.
+var contactProps = []string{"UID", "FN", "N", "ORG", "TITLE", "EMAIL", "TEL", "ADR", "BDAY", "NOTE"}
+
+// masterEvent returns the VEVENT of a VCALENDAR that is not a recurrence override.
+func masterEvent(cal *component) *component {
+	return cal.child("VEVENT", func(vevent *component) bool {
+		return vevent.get("RECURRENCE-ID") == nil
+	})
+}
+
+func toEvent(vevent *component) *std.CalendarEvent {
+	ev := &std.CalendarEvent{
+		UID:         vevent.text("UID"),
+		Summary:     vevent.text("SUMMARY"),
+		Description: vevent.text("DESCRIPTION"),
+		Location:    vevent.text("LOCATION"),
+		Status:      strings.ToUpper(vevent.text("STATUS")),
+	}
+	if p := vevent.get("RRULE"); p != nil {
+		ev.Recurrence = p.Value
+	}
+	if p := vevent.get("DTSTART"); p != nil {
+		var start time.Time
+		start, ev.AllDay = parseDateTime(p)
+		ev.TimeZone = p.Params["TZID"]
+		ev.StartAt = timeToInt(start)
+	}
+	if p := vevent.get("DTEND"); p != nil {
+		end, _ := parseDateTime(p)
+		ev.EndAt = timeToInt(end)
+	}
+	if p := vevent.get("ORGANIZER"); p != nil {
+		ev.Organizer = toAttendee(p)
+	}
+	for _, p := range vevent.all("ATTENDEE") {
+		ev.Attendees = append(ev.Attendees, toAttendee(&p))
+	}
+	return ev
+}
+
+func toAttendee(p *prop) *std.Attendee {
+	return &std.Attendee{
+		Email:  strings.TrimPrefix(strings.TrimPrefix(p.Value, "mailto:"), "MAILTO:"),
+		Name:   p.Params["CN"],
+		Role:   p.Params["ROLE"],
+		Status: p.Params["PARTSTAT"],
+	}
+}
+
+// applyEvent writes the given event into vevent, retaining properties that std.CalendarEvent does not map.
+func applyEvent(vevent *component, ev *std.CalendarEvent, now time.Time) {
+	var props []prop
+	props = append(props, prop{Name: "UID", Value: ev.UID})
+	props = append(props, prop{Name: "DTSTAMP", Value: now.UTC().Format(utcFormat)})
+	props = append(props, textProp("SUMMARY", ev.Summary)...)
+	props = append(props, textProp("DESCRIPTION", ev.Description)...)
+	props = append(props, textProp("LOCATION", ev.Location)...)
+	if ev.StartAt != 0 {
+		props = append(props, dateTimeProp("DTSTART", intToTime(ev.StartAt), ev.AllDay, ev.TimeZone))
+	}
+	if ev.EndAt != 0 {
+		props = append(props, dateTimeProp("DTEND", intToTime(ev.EndAt), ev.AllDay, ev.TimeZone))
+	} else if p := vevent.get("DURATION"); p != nil {
+		props = append(props, *p)
+	}
+	if ev.Recurrence != "" {
+		props = append(props, prop{Name: "RRULE", Value: ev.Recurrence})
+	}
+	if ev.Status != "" {
+		props = append(props, prop{Name: "STATUS", Value: ev.Status})
+	}
+	if ev.Organizer != nil {
+		props = append(props, attendeeProp("ORGANIZER", ev.Organizer))
+	}
+	for _, attendee := range ev.Attendees {
+		props = append(props, attendeeProp("ATTENDEE", attendee))
+	}
+	vevent.replace(eventProps, props)
+}
+
+func attendeeProp(name string, attendee *std.Attendee) prop {
+	p := prop{
+		Name:   name,
+		Value:  "mailto:" + attendee.Email,
+		Params: make(map[string]string),
+	}
+	for param, val := range map[string]string{"CN": attendee.Name, "ROLE": attendee.Role, "PARTSTAT": attendee.Status} {
+		if val != "" {
+			p.Params[param] = val
+		}
+	}
+	return p
+}
+
+const (
+	utcFormat   = "20060102T150405Z"
+	localFormat = "20060102T150405"
+	dateFormat  = "20060102"
+)
+
+// parseDateTime parses a DATE or DATE-TIME property, returning whether it is a DATE.
+func parseDateTime(p *prop) (time.Time, bool) {
+	if p.Params["VALUE"] == "DATE" || len(p.Value) == len(dateFormat) {
+		t, _ := time.Parse(dateFormat, p.Value)
+		return t, true
+	}
+	if t, err := time.Parse(utcFormat, p.Value); err == nil {
+		return t, false
+	}
+	loc := time.UTC
+	if tzid := p.Params["TZID"]; tzid != "" {
+		if tz, err := time.LoadLocation(tzid); err == nil {
+			loc = tz
+		}
+	}
+	t, _ := time.ParseInLocation(localFormat, p.Value, loc)
+	return t, false
+}
+
+// dateTimeProp returns a DATE property if allDay, otherwise a DATE-TIME in the given IANA time zone (or UTC).
+func dateTimeProp(name string, t time.Time, allDay bool, timeZone string) prop {
+	if allDay {
+		return prop{Name: name, Value: t.UTC().Format(dateFormat), Params: map[string]string{"VALUE": "DATE"}}
+	}
+	if timeZone != "" {
+		if loc, err := time.LoadLocation(timeZone); err == nil {
+			return prop{Name: name, Value: t.In(loc).Format(localFormat), Params: map[string]string{"TZID": timeZone}}
+		}
+	}
+	return prop{Name: name, Value: t.UTC().Format(utcFormat)}
+}
+
+// timeToInt returns t as UTC << 16, the time form of std types (see tag.FromTime).
+func timeToInt(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return int64(tag.FromTime(t, false)[0])
+}
+
+func intToTime(utc16 int64) time.Time {
+	return time.UnixMilli(tag.ID{uint64(utc16)}.UnixMilli()).UTC()
+}
+
+func toContact(vcard *component) *std.Contact {
+	c := &std.Contact{
+		UID:      vcard.text("UID"),
+		FullName: vcard.text("FN"),
+		Org:      strings.TrimSuffix(vcard.text("ORG"), ";"),
+		Title:    vcard.text("TITLE"),
+		Birthday: normalizeBirthday(vcard.text("BDAY")),
+		Note:     vcard.text("NOTE"),
+	}
+	if p := vcard.get("N"); p != nil {
+		parts := strings.Split(p.Value, ";")
+		c.FamilyName = unescapeText(parts[0])
+		if len(parts) > 1 {
+			c.GivenName = unescapeText(parts[1])
+		}
+	}
+	for _, p := range vcard.all("EMAIL") {
+		c.Emails = append(c.Emails, contactValue(p, unescapeText(p.Value)))
+	}
+	for _, p := range vcard.all("TEL") {
+		c.Phones = append(c.Phones, contactValue(p, strings.TrimPrefix(p.Value, "tel:")))
+	}
+	for _, p := range vcard.all("ADR") {
+		c.Addresses = append(c.Addresses, contactValue(p, p.Value))
+	}
+	return c
+}
+
+func contactValue(p prop, val string) *std.ContactValue {
+	return &std.ContactValue{
+		Type:  strings.ToLower(strings.Split(p.Params["TYPE"], ",")[0]),
+		Value: val,
+	}
+}
+
+// normalizeBirthday returns a BDAY in "YYYY-MM-DD" (or "--MM-DD") form.
+func normalizeBirthday(bday string) string {
+	if len(bday) == len(dateFormat) {
+		if t, err := time.Parse(dateFormat, bday); err == nil {
+			return t.Format("2006-01-02")
+		}
+	}
+	if len(bday) == len("--0102") && strings.HasPrefix(bday, "--") {
+		return bday[:4] + "-" + bday[4:]
+	}
+	return bday
+}
+
+// applyContact writes the given contact into vcard, retaining properties that std.Contact does not map.
+func applyContact(vcard *component, c *std.Contact) {
+	n := []string{"", "", "", "", ""}
+	if p := vcard.get("N"); p != nil {
+		copy(n, strings.Split(p.Value, ";")) // retain additional names, prefixes, and suffixes
+	}
+	n[0], n[1] = escapeText(c.FamilyName), escapeText(c.GivenName)
+
+	var props []prop
+	props = append(props, prop{Name: "UID", Value: c.UID})
+	props = append(props, prop{Name: "FN", Value: escapeText(c.FullName)})
+	props = append(props, prop{Name: "N", Value: strings.Join(n, ";")})
+	props = append(props, textProp("ORG", c.Org)...)
+	props = append(props, textProp("TITLE", c.Title)...)
+	for _, email := range c.Emails {
+		props = append(props, typedProp("EMAIL", email.Type, escapeText(email.Value)))
+	}
+	for _, phone := range c.Phones {
+		props = append(props, typedProp("TEL", phone.Type, phone.Value))
+	}
+	for _, adr := range c.Addresses {
+		props = append(props, typedProp("ADR", adr.Type, adr.Value))
+	}
+	if c.Birthday != "" {
+		props = append(props, prop{Name: "BDAY", Value: strings.ReplaceAll(c.Birthday, "-", "")})
+		if strings.HasPrefix(c.Birthday, "--") {
+			props[len(props)-1].Value = "--" + props[len(props)-1].Value
+		}
+	}
+	props = append(props, textProp("NOTE", c.Note)...)
+	vcard.replace(contactProps, props)
+}
+
+func typedProp(name, typ, val string) prop {
+	p := prop{Name: name, Value: val}
+	if typ != "" {
+		p.Params = map[string]string{"TYPE": typ}
+	}
+	return p
+}
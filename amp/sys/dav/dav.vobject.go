@@ -0,0 +1,258 @@
+package dav
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+)
+
+// component is a parsed iCalendar or vCard object (e.g. VCALENDAR, VEVENT, or VCARD), which share the same content line format (RFC 5545, RFC 6350).
+// Properties not mapped to a std type are retained as-is, so that writing back an edit does not lose them.
+type component struct {
+	Name     string
+	Props    []prop
+	Children []*component
+}
+
+// prop is a content line: NAME;PARAM=VALUE:VALUE
+type prop struct {
+	Name   string            // upper case
+	Params map[string]string // by upper case name
+	Value  string            // as encoded
+}
+
+// parseComponent parses a single top-level component.
+func parseComponent(data string) (*component, error) {
+	var stack []*component
+	var root *component
+	for _, line := range unfold(data) {
+		p, ok := parseProp(line)
+		if !ok {
+			continue
+		}
+		switch p.Name {
+		case "BEGIN":
+			comp := &component{Name: strings.ToUpper(p.Value)}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.Children = append(parent.Children, comp)
+			} else if root == nil {
+				root = comp
+			}
+			stack = append(stack, comp)
+		case "END":
+			if len(stack) == 0 || stack[len(stack)-1].Name != strings.ToUpper(p.Value) {
+				return nil, amp.ErrCode_ProviderErr.Errorf("dav: unbalanced END:%s", p.Value)
+			}
+			stack = stack[:len(stack)-1]
+		default:
+			if len(stack) > 0 {
+				comp := stack[len(stack)-1]
+				comp.Props = append(comp.Props, p)
+			}
+		}
+	}
+	if root == nil || len(stack) > 0 {
+		return nil, amp.ErrCode_ProviderErr.Error("dav: malformed object")
+	}
+	return root, nil
+}
+
+// unfold joins continuation lines (those beginning with a space or tab) to the preceding line.
+func unfold(data string) []string {
+	var lines []string
+	for _, line := range strings.Split(strings.ReplaceAll(data, "\r\n", "\n"), "\n") {
+		if len(line) > 0 && (line[0] == ' ' || line[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+		} else if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+func parseProp(line string) (prop, bool) {
+	// The value begins at the first colon not within a quoted param value
+	quoted := false
+	colon := -1
+	for i := 0; i < len(line) && colon < 0; i++ {
+		switch line[i] {
+		case '"':
+			quoted = !quoted
+		case ':':
+			if !quoted {
+				colon = i
+			}
+		}
+	}
+	if colon < 0 {
+		return prop{}, false
+	}
+
+	p := prop{
+		Value: line[colon+1:],
+	}
+	parts := splitUnquoted(line[:colon], ';')
+	p.Name = strings.ToUpper(parts[0])
+	if i := strings.LastIndexByte(p.Name, '.'); i >= 0 {
+		p.Name = p.Name[i+1:] // drop vCard group, e.g. "item1.EMAIL"
+	}
+	for _, param := range parts[1:] {
+		name, val, _ := strings.Cut(param, "=")
+		if p.Params == nil {
+			p.Params = make(map[string]string)
+		}
+		p.Params[strings.ToUpper(name)] = strings.Trim(val, `"`)
+	}
+	return p, true
+}
+
+func splitUnquoted(str string, sep byte) []string {
+	var parts []string
+	quoted := false
+	start := 0
+	for i := 0; i < len(str); i++ {
+		switch str[i] {
+		case '"':
+			quoted = !quoted
+		case sep:
+			if !quoted {
+				parts = append(parts, str[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, str[start:])
+}
+
+// encode writes this component in content line format, folding lines longer than 75 octets.
+func (comp *component) encode(sb *strings.Builder) {
+	writeLine(sb, "BEGIN:"+comp.Name)
+	for _, p := range comp.Props {
+		var line strings.Builder
+		line.WriteString(p.Name)
+		for _, name := range sortedKeys(p.Params) {
+			val := p.Params[name]
+			if strings.ContainsAny(val, ":;,") {
+				val = `"` + val + `"`
+			}
+			line.WriteString(";" + name + "=" + val)
+		}
+		line.WriteString(":" + p.Value)
+		writeLine(sb, line.String())
+	}
+	for _, child := range comp.Children {
+		child.encode(sb)
+	}
+	writeLine(sb, "END:"+comp.Name)
+}
+
+func (comp *component) String() string {
+	var sb strings.Builder
+	comp.encode(&sb)
+	return sb.String()
+}
+
+func writeLine(sb *strings.Builder, line string) {
+	const maxLine = 75
+	for len(line) > maxLine {
+		cut := maxLine
+		for cut > 1 && !isRuneStart(line[cut]) { // don't split a UTF-8 sequence
+			cut--
+		}
+		sb.WriteString(line[:cut])
+		sb.WriteString("\r\n ")
+		line = line[cut:]
+	}
+	sb.WriteString(line)
+	sb.WriteString("\r\n")
+}
+
+func isRuneStart(b byte) bool {
+	return b&0xC0 != 0x80
+}
+
+func sortedKeys(params map[string]string) []string {
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// child returns the first child component with the given name that satisfies accept (if given).
+func (comp *component) child(name string, accept func(*component) bool) *component {
+	for _, child := range comp.Children {
+		if child.Name == name && (accept == nil || accept(child)) {
+			return child
+		}
+	}
+	return nil
+}
+
+func (comp *component) get(name string) *prop {
+	for i := range comp.Props {
+		if comp.Props[i].Name == name {
+			return &comp.Props[i]
+		}
+	}
+	return nil
+}
+
+func (comp *component) text(name string) string {
+	if p := comp.get(name); p != nil {
+		return unescapeText(p.Value)
+	}
+	return ""
+}
+
+func (comp *component) all(name string) []prop {
+	var props []prop
+	for _, p := range comp.Props {
+		if p.Name == name {
+			props = append(props, p)
+		}
+	}
+	return props
+}
+
+// replace removes all properties with the given names and then appends the given properties.
+func (comp *component) replace(names []string, props []prop) {
+	kept := comp.Props[:0]
+	for _, p := range comp.Props {
+		managed := false
+		for _, name := range names {
+			if p.Name == name {
+				managed = true
+				break
+			}
+		}
+		if !managed {
+			kept = append(kept, p)
+		}
+	}
+	comp.Props = append(kept, props...)
+}
+
+// textProp returns a TEXT property with the given value, or nothing if empty.
+func textProp(name, val string) []prop {
+	if val == "" {
+		return nil
+	}
+	return []prop{{Name: name, Value: escapeText(val)}}
+}
+
+var (
+	textEscaper   = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`)
+	textUnescaper = strings.NewReplacer(`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n", `\N`, "\n")
+)
+
+func escapeText(str string) string {
+	return textEscaper.Replace(str)
+}
+
+func unescapeText(str string) string {
+	return textUnescaper.Replace(str)
+}
@@ -0,0 +1,131 @@
+package dav
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/amp/std"
+)
+
+const testCalendar = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"PRODID:-//test//EN\r\n" +
+	"BEGIN:VTIMEZONE\r\nTZID:Europe/Berlin\r\nEND:VTIMEZONE\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:ev1\r\n" +
+	"SUMMARY:Planning\\, Q3\r\n" +
+	"DTSTART;TZID=Europe/Berlin:20240506T090000\r\n" +
+	"DTEND;TZID=Europe/Berlin:20240506T100000\r\n" +
+	"ATTENDEE;CN=Bo;ROLE=REQ-PARTICIPANT;PARTSTAT=ACCEPTED:mailto:bo@example.com\r\n" +
+	"X-CUSTOM:keep me\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+const testCard = "BEGIN:VCARD\r\n" +
+	"VERSION:3.0\r\n" +
+	"UID:c1\r\n" +
+	"FN:Ada Lovelace\r\n" +
+	"N:Lovelace;Ada;;;\r\n" +
+	"item1.EMAIL;TYPE=work:ada@example.com\r\n" +
+	"BDAY:1815-12-10\r\n" +
+	"END:VCARD\r\n"
+
+func TestDAV(t *testing.T) {
+	var puts []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "REPORT":
+			w.WriteHeader(http.StatusMultiStatus)
+			fmt.Fprintf(w, `<?xml version="1.0"?><D:multistatus xmlns:D="DAV:" xmlns:C="urn:ietf:params:xml:ns:caldav">
+<D:response><D:href>/cal/ev1.ics</D:href><D:propstat><D:prop><D:getetag>"1"</D:getetag><C:calendar-data>%s</C:calendar-data></D:prop><D:status>HTTP/1.1 200 OK</D:status></D:propstat></D:response>
+</D:multistatus>`, testCalendar)
+		case http.MethodPut:
+			if r.Header.Get("If-Match") != `"1"` {
+				w.WriteHeader(http.StatusPreconditionFailed)
+				return
+			}
+			body, _ := io.ReadAll(r.Body)
+			puts = append(puts, string(body))
+			w.Header().Set("ETag", `"2"`)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer srv.Close()
+
+	base, _ := url.Parse(srv.URL + "/cal/")
+	coll := &collectionState{
+		client: client{
+			Collection: &Collection{Kind: Calendar, URL: base.String()},
+			http:       srv.Client(),
+			base:       base,
+		},
+	}
+	ctx := context.Background()
+	items, err := coll.read(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %d", len(items))
+	}
+	ev := items[0].event
+	if ev.UID != "ev1" || ev.Summary != "Planning, Q3" || ev.TimeZone != "Europe/Berlin" || len(ev.Attendees) != 1 || ev.Attendees[0].Email != "bo@example.com" {
+		t.Fatalf("unexpected event: %+v", ev)
+	}
+	if start := intToTime(ev.StartAt); start.UTC().Hour() != 7 {
+		t.Fatalf("unexpected start: %v", start)
+	}
+
+	// edit the summary and write it back
+	ev.Summary = "Planning; Q4"
+	tx := amp.NewTxMsg(true)
+	op := amp.TxOp{}
+	op.OpCode = amp.TxOpCode_UpsertElement
+	op.CellID = items[0].cellID
+	op.AttrID = std.CellProperties.ID
+	op.ItemID = std.CellEvent
+	if err = tx.MarshalOp(&op, ev); err != nil {
+		t.Fatal(err)
+	}
+	rev := coll.changed.Revision()
+	if err = coll.commit(ctx, tx); err != nil {
+		t.Fatal(err)
+	}
+	if len(puts) != 1 {
+		t.Fatalf("expected 1 PUT, got %d", len(puts))
+	}
+	for _, want := range []string{`SUMMARY:Planning\; Q4`, "X-CUSTOM:keep me", "BEGIN:VTIMEZONE", "UID:ev1"} {
+		if !strings.Contains(puts[0], want) {
+			t.Fatalf("PUT missing %q:\n%s", want, puts[0])
+		}
+	}
+	if coll.changed.Revision() == rev {
+		t.Fatal("commit should notify the collection's pins")
+	}
+
+	// contacts round trip, retaining unmapped fields
+	vcard, err := parseComponent(testCard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	contact := toContact(vcard)
+	if contact.FullName != "Ada Lovelace" || contact.GivenName != "Ada" || len(contact.Emails) != 1 || contact.Emails[0].Value != "ada@example.com" || contact.Birthday != "1815-12-10" {
+		t.Fatalf("unexpected contact: %+v", contact)
+	}
+	contact.Org = "Analytical Engines"
+	applyContact(vcard, contact)
+	again, err := parseComponent(vcard.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := toContact(again); got.Org != "Analytical Engines" || got.FullName != "Ada Lovelace" || got.Emails[0].Type != "work" {
+		t.Fatalf("unexpected round trip: %+v", got)
+	}
+}
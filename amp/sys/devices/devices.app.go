@@ -0,0 +1,141 @@
+// Package devices implements the "devices:" sys app, which lists the session user's enrolled devices, updated live, and allows a lost device to be revoked.
+package devices
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/amp/std"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+var AppSpec = amp.AppSpec.With("sys.devices")
+
+const (
+	RevokeParam = "revoke" // pin URL query parameter holding the base32 ID of a device to revoke before the list is served
+)
+
+// RegisterApp registers the devices app, invoked via "devices:" or "devices:?revoke={DeviceID}".
+// The pinned cell has a child cell per device, each with a CellLabel, CellCaption (enrollment, last login, and revocation), and CellDevice property.
+// A user may only revoke their own devices, and the current session's device may not be revoked from itself.
+func RegisterApp(reg amp.Registry) error {
+	return reg.RegisterApp(&amp.App{
+		AppSpec:     AppSpec,
+		Desc:        "enrolled devices",
+		Version:     "v1.0.0",
+		Invocations: []string{"devices"},
+		NewAppInstance: func(ctx amp.AppContext) (amp.AppInstance, error) {
+			app := &appInst{}
+			app.AppContext = ctx
+			app.Instance = app
+			return app, nil
+		},
+	})
+}
+
+type appInst struct {
+	std.App[*appInst]
+}
+
+func (app *appInst) ServeRequest(op amp.Requester) (amp.Pin, error) {
+	sess := app.Session()
+	registry := amp.ServicesOf(sess).Devices
+	login := sess.Login()
+	if registry == nil || login.UserID == nil {
+		return nil, amp.ErrUnimplemented
+	}
+	user := *login.UserID
+	userID := user.AsID()
+
+	req := op.Request()
+	if req.Values != nil {
+		if str := req.Values.Get(RevokeParam); str != "" {
+			deviceID, err := tag.FromBase32(str)
+			if err != nil {
+				return nil, amp.ErrCode_BadRequest.Errorf("devices: bad %q param", RevokeParam)
+			}
+			if login.DeviceID != nil {
+				current := *login.DeviceID
+				if current.AsID() == deviceID {
+					return nil, amp.ErrCode_BadRequest.Error("devices: can't revoke the current device")
+				}
+			}
+			if err = registry.RevokeDevice(userID, deviceID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	cell := &devicesCell{
+		registry: registry,
+		userID:   userID,
+	}
+	cell.Inputs = []*std.Signal{&cell.changed}
+	cell.Compute = cell.computeDevices
+	return app.PinAndServe(cell, op)
+}
+
+// devicesCell has a child cell for each of the user's devices.
+type devicesCell struct {
+	std.ComputedCell[*appInst]
+	registry amp.DeviceRegistry
+	userID   tag.ID
+	changed  std.Signal
+}
+
+func (cell *devicesCell) PinInto(pin *std.Pin[*appInst]) error {
+	if pin.Sync == amp.StateSync_Maintain {
+		cell.registry.WatchDevices(pin.Context(), cell.userID, func(*amp.Device) {
+			cell.changed.Notify()
+		})
+	}
+	return cell.ComputedCell.PinInto(pin)
+}
+
+// Each child's cell ID is derived from its device ID so it is stable across changes.
+func (cell *devicesCell) computeDevices() ([]std.Cell[*appInst], error) {
+	devices := cell.registry.ListDevices(cell.userID)
+
+	children := make([]std.Cell[*appInst], len(devices))
+	for i, device := range devices {
+		child := &deviceCell{
+			device: device,
+		}
+		child.ID = AppSpec.ID.With(device.DeviceID.AsID())
+		children[i] = child
+	}
+	return children, nil
+}
+
+// deviceCell presents a single device.
+type deviceCell struct {
+	std.CellNode[*appInst]
+	device *amp.Device
+}
+
+func (cell *deviceCell) PinInto(pin *std.Pin[*appInst]) error {
+	return nil
+}
+
+func (cell *deviceCell) MarshalAttrs(w std.CellWriter) {
+	label := cell.device.Label
+	if label == "" {
+		label = cell.device.DeviceID.AsID().Base32Suffix()
+	}
+	w.PutText(std.CellLabel, label)
+	w.PutText(std.CellCaption, caption(cell.device))
+	w.PutItem(std.CellDevice, cell.device)
+}
+
+// caption summarizes a device, e.g. "enrolled 2024-03-01, last login 2024-05-17 09:12"
+func caption(device *amp.Device) string {
+	const layout = "2006-01-02 15:04"
+	str := fmt.Sprintf("enrolled %s, last login %s",
+		time.Unix(device.EnrolledAt, 0).UTC().Format(time.DateOnly),
+		time.Unix(device.LastSeenAt, 0).UTC().Format(layout))
+	if device.RevokedAt != 0 {
+		str += ", revoked " + time.Unix(device.RevokedAt, 0).UTC().Format(layout)
+	}
+	return str
+}
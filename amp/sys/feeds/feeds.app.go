@@ -0,0 +1,349 @@
+// Package feeds implements the "feeds:" sys app, which pins RSS, Atom, and JSON Feed URLs as cell trees refreshed via the host's Scheduler.
+// It also serves as a reference for the poll-and-diff pattern: a source is re-fetched periodically while pinned, and pins are only notified when its items change.
+package feeds
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/amp/std"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+var AppSpec = amp.AppSpec.With("sys.feeds")
+
+// URLParam is the pin URL query parameter holding the URL of the pinned feed.
+const URLParam = "url"
+
+// MaxFeedSize limits how much of a feed document is read.
+const MaxFeedSize = 8 << 20
+
+// Opts specifies how feeds are fetched.
+type Opts struct {
+	Client   *http.Client  // if nil, a client with a 30s timeout
+	Interval time.Duration // how often a pinned feed is refreshed; if <= 0, 15m
+	MaxItems int           // items kept per feed, newest first; if <= 0, 200
+}
+
+// RegisterApp registers the feeds app, invoked via "feeds:?url={feedURL}".
+// The pinned cell has a CellLabel (the feed's title) and a child cell per item, each with a CellLabel, CellSynopsis, CellLink, and OrderByTimeID property.
+// While a feed is pinned, a refresh is scheduled every Opts.Interval via the session's Scheduler, and its pins are pushed only if its items change.
+func RegisterApp(reg amp.Registry, opts Opts) error {
+	if opts.Client == nil {
+		opts.Client = &http.Client{Timeout: 30 * time.Second}
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = 15 * time.Minute
+	}
+	if opts.MaxItems <= 0 {
+		opts.MaxItems = 200
+	}
+
+	// Feed state is shared by all app instances so a feed is fetched once regardless of how many sessions pin it
+	feeds := &feedTable{
+		opts:  opts,
+		byURL: make(map[string]*feed),
+		byID:  make(map[tag.ID]*feed),
+	}
+	return reg.RegisterApp(&amp.App{
+		AppSpec:     AppSpec,
+		Desc:        "RSS, Atom, and JSON Feed reader",
+		Version:     "v1.0.0",
+		Invocations: []string{"feeds"},
+		NewAppInstance: func(ctx amp.AppContext) (amp.AppInstance, error) {
+			app := &appInst{
+				feeds:     feeds,
+				scheduled: make(map[tag.ID]tag.ID),
+			}
+			app.AppContext = ctx
+			app.Instance = app
+			return app, nil
+		},
+	})
+}
+
+type appInst struct {
+	std.App[*appInst]
+	feeds *feedTable
+
+	mu        sync.Mutex
+	scheduled map[tag.ID]tag.ID // feed cell ID => schedule ID of its next refresh
+}
+
+func (app *appInst) ServeRequest(op amp.Requester) (amp.Pin, error) {
+	req := op.Request()
+	var feedURL string
+	if req.Values != nil {
+		feedURL = req.Values.Get(URLParam)
+	}
+	if feedURL == "" {
+		return nil, amp.ErrCode_BadRequest.Errorf("feeds: missing %q param", URLParam)
+	}
+	feed := app.feeds.get(feedURL)
+
+	cell := &feedCell{
+		app:  app,
+		feed: feed,
+	}
+	cell.ID = feed.cellID
+	cell.Inputs = []*std.Signal{&feed.changed}
+	cell.Compute = cell.computeItems
+	cell.Attrs = cell.marshalFeed
+	return app.PinAndServe(cell, op)
+}
+
+// OnFirstPin schedules a feed's next refresh once it is pinned.
+func (app *appInst) OnFirstPin(cellID tag.ID) {
+	if feed := app.feeds.byCell(cellID); feed != nil {
+		app.scheduleRefresh(feed)
+	}
+}
+
+// OnLastUnpin cancels a feed's next refresh once it is no longer pinned.
+func (app *appInst) OnLastUnpin(cellID tag.ID) {
+	app.mu.Lock()
+	scheduleID, scheduled := app.scheduled[cellID]
+	delete(app.scheduled, cellID)
+	app.mu.Unlock()
+
+	if scheduler := amp.ServicesOf(app.Session()).Scheduler; scheduled && scheduler != nil {
+		scheduler.Cancel(scheduleID)
+	}
+}
+
+// scheduleRefresh schedules a tx bearing the feed's URL to be delivered to OnScheduledTx after the refresh interval.
+// If the session has no Scheduler, a feed is only refreshed as it is pinned.
+func (app *appInst) scheduleRefresh(feed *feed) {
+	scheduler := amp.ServicesOf(app.Session()).Scheduler
+	if scheduler == nil {
+		return
+	}
+	tx, err := amp.MarshalAttr(feed.cellID, (&amp.Tag{}).TagSpec().ID, &amp.Tag{URL: feed.url})
+	if err != nil {
+		return
+	}
+	scheduleID, err := scheduler.Schedule(AppSpec.ID, time.Now().Add(app.feeds.opts.Interval), "refresh "+feed.url, tx)
+	if err != nil {
+		app.Log().Warnf("feeds: schedule refresh failed: %v", err)
+		return
+	}
+	app.mu.Lock()
+	app.scheduled[feed.cellID] = scheduleID
+	app.mu.Unlock()
+}
+
+// OnScheduledTx implements amp.ScheduledTxHandler, refreshing a pinned feed and scheduling its next refresh.
+func (app *appInst) OnScheduledTx(scheduleID tag.ID, tx *amp.TxMsg) error {
+	if len(tx.Ops) == 0 {
+		return nil
+	}
+	feedURL := amp.Tag{}
+	if err := tx.UnmarshalOpValue(0, &feedURL); err != nil {
+		return nil // malformed, so retrying is futile
+	}
+	feed := app.feeds.get(feedURL.URL)
+
+	app.mu.Lock()
+	current := app.scheduled[feed.cellID] == scheduleID
+	app.mu.Unlock()
+	if !current {
+		return nil // no longer pinned
+	}
+
+	if err := app.feeds.refresh(app, feed); err != nil {
+		app.Log().Warnf("feeds: refresh %q failed: %v", feed.url, err)
+	}
+	app.scheduleRefresh(feed)
+	return nil
+}
+
+// feedTable holds the state of each feed pinned via the app.
+type feedTable struct {
+	opts  Opts
+	mu    sync.Mutex
+	byURL map[string]*feed
+	byID  map[tag.ID]*feed
+}
+
+func (table *feedTable) get(feedURL string) *feed {
+	table.mu.Lock()
+	defer table.mu.Unlock()
+	f := table.byURL[feedURL]
+	if f == nil {
+		f = &feed{
+			url:    feedURL,
+			cellID: AppSpec.ID.WithToken(feedURL),
+		}
+		table.byURL[feedURL] = f
+		table.byID[f.cellID] = f
+	}
+	return f
+}
+
+func (table *feedTable) byCell(cellID tag.ID) *feed {
+	table.mu.Lock()
+	defer table.mu.Unlock()
+	return table.byID[cellID]
+}
+
+// feed is the most recently fetched state of a feed URL.
+type feed struct {
+	url     string
+	cellID  tag.ID
+	changed std.Signal // notified when title or items change
+
+	mu           sync.Mutex // serializes fetches
+	fetched      time.Time
+	etag         string
+	lastModified string
+	title        string
+	items        []*item // newest first
+}
+
+// refresh fetches the feed (conditionally, if previously fetched) and notifies its pins if its title or items changed.
+func (table *feedTable) refresh(ctx context.Context, f *feed) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, f.url, nil)
+	if err != nil {
+		return amp.ErrCode_BadRequest.Errorf("feeds: bad URL %q", f.url)
+	}
+	req.Header.Set("Accept", "application/atom+xml, application/rss+xml, application/feed+json, application/xml;q=0.9, */*;q=0.8")
+	if f.etag != "" {
+		req.Header.Set("If-None-Match", f.etag)
+	}
+	if f.lastModified != "" {
+		req.Header.Set("If-Modified-Since", f.lastModified)
+	}
+	resp, err := table.opts.Client.Do(req)
+	if err != nil {
+		return amp.ErrCode_ProviderErr.Errorf("feeds: %v", err)
+	}
+	defer resp.Body.Close()
+
+	f.fetched = time.Now()
+	switch {
+	case resp.StatusCode == http.StatusNotModified:
+		return nil
+	case resp.StatusCode != http.StatusOK:
+		return amp.ErrCode_ProviderErr.Errorf("feeds: %q returned %s", f.url, resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, MaxFeedSize))
+	if err != nil {
+		return amp.ErrCode_ProviderErr.Errorf("feeds: %v", err)
+	}
+	doc, err := parseFeed(body)
+	if err != nil {
+		return err
+	}
+	f.etag = resp.Header.Get("ETag")
+	f.lastModified = resp.Header.Get("Last-Modified")
+
+	if len(doc.Items) > table.opts.MaxItems {
+		doc.Items = doc.Items[:table.opts.MaxItems]
+	}
+	if f.diff(doc) {
+		f.changed.Notify()
+	}
+	return nil
+}
+
+// diff replaces this feed's title and items with those of doc, keeping the previous item of any unchanged item, and returns whether anything changed.
+func (f *feed) diff(doc *feedDoc) bool {
+	prev := make(map[string]*item, len(f.items))
+	for _, it := range f.items {
+		prev[it.ID] = it
+	}
+
+	changed := doc.Title != f.title || len(doc.Items) != len(f.items)
+	items := make([]*item, len(doc.Items))
+	for i, it := range doc.Items {
+		it.cellID = f.cellID.Then(tag.FromToken(it.ID))
+		if existing := prev[it.ID]; existing != nil && *existing == *it {
+			it = existing
+		}
+		if i < len(f.items) && f.items[i] != it {
+			changed = true
+		}
+		items[i] = it
+	}
+	f.title = doc.Title
+	f.items = items
+	return changed
+}
+
+// feedCell presents a feed's items as child cells.
+type feedCell struct {
+	std.ComputedCell[*appInst]
+	app  *appInst
+	feed *feed
+}
+
+func (cell *feedCell) computeItems() ([]std.Cell[*appInst], error) {
+	f := cell.feed
+	f.mu.Lock()
+	stale := time.Since(f.fetched) >= cell.app.feeds.opts.Interval
+	f.mu.Unlock()
+	var err error
+	if stale {
+		err = cell.app.feeds.refresh(cell.app, f)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err != nil {
+		if len(f.items) == 0 {
+			return nil, err
+		}
+		cell.app.Log().Warnf("feeds: refresh %q failed: %v", f.url, err) // serve the previous items
+	}
+	children := make([]std.Cell[*appInst], len(f.items))
+	for i, it := range f.items {
+		child := &itemCell{
+			item: it,
+		}
+		child.ID = it.cellID
+		children[i] = child
+	}
+	return children, nil
+}
+
+func (cell *feedCell) marshalFeed(w std.CellWriter) {
+	f := cell.feed
+	f.mu.Lock()
+	title := f.title
+	f.mu.Unlock()
+	w.PutText(std.CellLabel, title)
+	w.PutItem(std.CellLink, &amp.Tag{URL: f.url})
+}
+
+// itemCell presents a single feed item, linking to its web page.
+type itemCell struct {
+	std.CellNode[*appInst]
+	item *item
+}
+
+func (cell *itemCell) PinInto(pin *std.Pin[*appInst]) error {
+	return nil
+}
+
+func (cell *itemCell) MarshalAttrs(w std.CellWriter) {
+	it := cell.item
+	w.PutText(std.CellLabel, it.Title)
+	if it.Summary != "" {
+		w.PutText(std.CellSynopsis, it.Summary)
+	}
+	if it.Link != "" {
+		w.PutItem(std.CellLink, &amp.Tag{URL: it.Link})
+	}
+	if !it.Published.IsZero() {
+		published := &amp.Tag{}
+		published.SetFromTime(it.Published)
+		w.PutItem(std.OrderByTimeID, published)
+	}
+}
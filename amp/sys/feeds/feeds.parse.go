@@ -0,0 +1,230 @@
+package feeds
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+var errUnknownFormat = amp.ErrCode_ProviderErr.Error("feeds: not an RSS, Atom, or JSON feed")
+
+// feedDoc is a parsed feed in any supported format.
+type feedDoc struct {
+	Title string
+	Items []*item
+}
+
+type item struct {
+	ID        string // guid or id, otherwise the link or title
+	Title     string
+	Link      string
+	Summary   string
+	Published time.Time
+	cellID    tag.ID
+}
+
+// parseFeed parses an RSS 2.0, Atom, or JSON Feed document.
+func parseFeed(body []byte) (*feedDoc, error) {
+	body = bytes.TrimSpace(body)
+	if len(body) > 0 && body[0] == '{' {
+		return parseJSONFeed(body)
+	}
+
+	// Peek at the root element to tell RSS from Atom
+	dec := newXMLDecoder(body)
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, errUnknownFormat
+		}
+		if start, isStart := tok.(xml.StartElement); isStart {
+			switch start.Name.Local {
+			case "rss":
+				return parseRSS(body)
+			case "feed":
+				return parseAtom(body)
+			default:
+				return nil, errUnknownFormat
+			}
+		}
+	}
+}
+
+func parseRSS(body []byte) (*feedDoc, error) {
+	var rss struct {
+		Channel struct {
+			Title string `xml:"title"`
+			Items []struct {
+				GUID        string `xml:"guid"`
+				Title       string `xml:"title"`
+				Link        string `xml:"link"`
+				Description string `xml:"description"`
+				PubDate     string `xml:"pubDate"`
+			} `xml:"item"`
+		} `xml:"channel"`
+	}
+	if err := unmarshalXML(body, &rss); err != nil {
+		return nil, err
+	}
+	doc := &feedDoc{
+		Title: strings.TrimSpace(rss.Channel.Title),
+	}
+	for _, it := range rss.Channel.Items {
+		doc.add(&item{
+			ID:        it.GUID,
+			Title:     strings.TrimSpace(it.Title),
+			Link:      strings.TrimSpace(it.Link),
+			Summary:   strings.TrimSpace(it.Description),
+			Published: parseTime(it.PubDate),
+		})
+	}
+	return doc, nil
+}
+
+func parseAtom(body []byte) (*feedDoc, error) {
+	var atom struct {
+		Title   string `xml:"title"`
+		Entries []struct {
+			ID    string `xml:"id"`
+			Title string `xml:"title"`
+			Links []struct {
+				Href string `xml:"href,attr"`
+				Rel  string `xml:"rel,attr"`
+			} `xml:"link"`
+			Summary   string `xml:"summary"`
+			Content   string `xml:"content"`
+			Published string `xml:"published"`
+			Updated   string `xml:"updated"`
+		} `xml:"entry"`
+	}
+	if err := unmarshalXML(body, &atom); err != nil {
+		return nil, err
+	}
+	doc := &feedDoc{
+		Title: strings.TrimSpace(atom.Title),
+	}
+	for _, entry := range atom.Entries {
+		it := &item{
+			ID:        entry.ID,
+			Title:     strings.TrimSpace(entry.Title),
+			Summary:   strings.TrimSpace(entry.Summary),
+			Published: parseTime(entry.Published),
+		}
+		if it.Summary == "" {
+			it.Summary = strings.TrimSpace(entry.Content)
+		}
+		if it.Published.IsZero() {
+			it.Published = parseTime(entry.Updated)
+		}
+		for _, link := range entry.Links {
+			if link.Rel == "" || link.Rel == "alternate" {
+				it.Link = link.Href
+				break
+			}
+		}
+		doc.add(it)
+	}
+	return doc, nil
+}
+
+func parseJSONFeed(body []byte) (*feedDoc, error) {
+	var jsonFeed struct {
+		Version string `json:"version"`
+		Title   string `json:"title"`
+		Items   []struct {
+			ID            string `json:"id"`
+			URL           string `json:"url"`
+			Title         string `json:"title"`
+			Summary       string `json:"summary"`
+			ContentText   string `json:"content_text"`
+			DatePublished string `json:"date_published"`
+			DateModified  string `json:"date_modified"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal(body, &jsonFeed); err != nil || !strings.HasPrefix(jsonFeed.Version, "https://jsonfeed.org/") {
+		return nil, errUnknownFormat
+	}
+	doc := &feedDoc{
+		Title: jsonFeed.Title,
+	}
+	for _, it := range jsonFeed.Items {
+		summary := it.Summary
+		if summary == "" {
+			summary = it.ContentText
+		}
+		published := parseTime(it.DatePublished)
+		if published.IsZero() {
+			published = parseTime(it.DateModified)
+		}
+		doc.add(&item{
+			ID:        it.ID,
+			Title:     it.Title,
+			Link:      it.URL,
+			Summary:   summary,
+			Published: published,
+		})
+	}
+	return doc, nil
+}
+
+// add appends an item, identifying it by its link or title if it has no ID, and dropping it if it duplicates an earlier item.
+func (doc *feedDoc) add(it *item) {
+	it.ID = strings.TrimSpace(it.ID)
+	if it.ID == "" {
+		it.ID = it.Link
+	}
+	if it.ID == "" {
+		it.ID = it.Title
+	}
+	for _, prev := range doc.Items {
+		if prev.ID == it.ID {
+			return
+		}
+	}
+	doc.Items = append(doc.Items, it)
+}
+
+// newXMLDecoder returns a lenient decoder, since feeds in the wild are often malformed or declare legacy charsets.
+func newXMLDecoder(body []byte) *xml.Decoder {
+	dec := xml.NewDecoder(bytes.NewReader(body))
+	dec.Strict = false
+	dec.CharsetReader = func(charset string, input io.Reader) (io.Reader, error) {
+		return input, nil // read as UTF-8, which is exact for ASCII content
+	}
+	return dec
+}
+
+func unmarshalXML(body []byte, dst any) error {
+	if err := newXMLDecoder(body).Decode(dst); err != nil {
+		return amp.ErrCode_ProviderErr.Errorf("feeds: %v", err)
+	}
+	return nil
+}
+
+// Date formats seen in feeds, where RSS uses RFC 822 (with variations) and Atom and JSON Feed use RFC 3339.
+var timeFormats = []string{
+	time.RFC3339,
+	time.RFC1123Z,
+	time.RFC1123,
+	"Mon, 2 Jan 2006 15:04:05 -0700",
+	"Mon, 2 Jan 2006 15:04:05 MST",
+	time.RFC822Z,
+	time.RFC822,
+	"2006-01-02",
+}
+
+func parseTime(str string) time.Time {
+	str = strings.TrimSpace(str)
+	for _, format := range timeFormats {
+		if t, err := time.Parse(format, str); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
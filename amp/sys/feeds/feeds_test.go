@@ -0,0 +1,86 @@
+package feeds
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+const testRSS = `<?xml version="1.0" encoding="ISO-8859-1"?>
+<rss version="2.0"><channel><title>News</title>
+<item><guid>a</guid><title>First</title><link>https://example.com/a</link><pubDate>Mon, 02 Jan 2006 15:04:05 -0700</pubDate></item>
+<item><title>Second</title><link>https://example.com/b</link></item>
+</channel></rss>`
+
+const testAtom = `<feed xmlns="http://www.w3.org/2005/Atom"><title>Blog</title>
+<entry><id>urn:1</id><title>Post</title><link rel="alternate" href="https://example.com/post"/><updated>2024-05-01T10:00:00Z</updated><content>Body</content></entry>
+</feed>`
+
+const testJSONFeed = `{"version": "https://jsonfeed.org/version/1.1", "title": "JSON", "items": [{"id": "1", "url": "https://example.com/1", "title": "One", "content_text": "Text"}]}`
+
+func TestFeeds(t *testing.T) {
+	rss, err := parseFeed([]byte(testRSS))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rss.Title != "News" || len(rss.Items) != 2 || rss.Items[1].ID != "https://example.com/b" || rss.Items[0].Published.Year() != 2006 {
+		t.Fatalf("unexpected RSS: %+v", rss)
+	}
+	atom, err := parseFeed([]byte(testAtom))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if atom.Title != "Blog" || atom.Items[0].Link != "https://example.com/post" || atom.Items[0].Summary != "Body" || atom.Items[0].Published.IsZero() {
+		t.Fatalf("unexpected Atom: %+v", atom.Items[0])
+	}
+	jsonFeed, err := parseFeed([]byte(testJSONFeed))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if jsonFeed.Title != "JSON" || jsonFeed.Items[0].Summary != "Text" {
+		t.Fatalf("unexpected JSON Feed: %+v", jsonFeed.Items[0])
+	}
+	if _, err = parseFeed([]byte("<html></html>")); err != errUnknownFormat {
+		t.Fatal("expected errUnknownFormat")
+	}
+
+	// refresh is conditional and only notifies pins when items change
+	body := testRSS
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		etag := `"` + time.Duration(len(body)).String() + `"`
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	table := &feedTable{
+		opts:  Opts{Client: server.Client(), MaxItems: 10},
+		byURL: make(map[string]*feed),
+		byID:  make(map[tag.ID]*feed),
+	}
+	f := table.get(server.URL)
+	ctx := context.Background()
+	if err = table.refresh(ctx, f); err != nil || f.changed.Revision() != 1 || len(f.items) != 2 {
+		t.Fatalf("first refresh: %v", err)
+	}
+	first := f.items[0]
+	if err = table.refresh(ctx, f); err != nil || f.changed.Revision() != 1 {
+		t.Fatalf("unchanged refresh should not notify: %v", err)
+	}
+	body = strings.Replace(testRSS, "Second", "Second (updated)", 1)
+	if err = table.refresh(ctx, f); err != nil || f.changed.Revision() != 2 {
+		t.Fatalf("changed refresh should notify: %v", err)
+	}
+	if f.items[0] != first || f.items[1].Title != "Second (updated)" {
+		t.Fatal("expected unchanged item to be kept")
+	}
+}
@@ -0,0 +1,336 @@
+// Package files implements the "files:" sys app, which pins local directory trees as cells, updated live as their files change.
+// It also serves as a reference for pinning a tree whose children are computed and diffed from external state.
+package files
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"io/fs"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/amp/std"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/blob"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/media"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+var AppSpec = amp.AppSpec.With("sys.files")
+
+// Pin URL query parameters naming the pinned directory, where path is slash-separated and relative to the named root.
+const (
+	RootParam = "root"
+	PathParam = "path"
+)
+
+// Watcher reports changes to the entries of a local directory.
+// PollWatcher is used by default, while a Watcher backed by OS notifications (e.g. github.com/fsnotify/fsnotify) is a drop-in replacement.
+type Watcher interface {
+
+	// Calls onChange each time the entries of the given directory change, until ctx closes.
+	Watch(ctx task.Context, dir string, onChange func()) error
+}
+
+// Opts specifies which local directories the files app serves.
+type Opts struct {
+	Roots      map[string]string // root name => local directory
+	Watcher    Watcher           // if nil, a PollWatcher with a 2s interval
+	ShowHidden bool              // if set, entries whose name begins with '.' are listed
+}
+
+// RegisterApp registers the files app, invoked via "files:?root={name}[&path={dir}]".
+// The pinned cell has a child cell per directory entry, each with a CellLabel (its name) and a CellFileInfo property (std.FSInfo).
+// Pinning a subdirectory's cell lists it in turn, and pinning a file's cell adds its content as a CellMedia property (see std.PrepareAsset).
+// While pinned with StateSync_Maintain, a directory's children are recomputed and pushed as its entries change.
+func RegisterApp(reg amp.Registry, opts Opts) error {
+	if opts.Watcher == nil {
+		opts.Watcher = PollWatcher{Interval: 2 * time.Second}
+	}
+	roots := make(map[string]*fileRoot, len(opts.Roots))
+	for name, dir := range opts.Roots {
+		dir, err := filepath.Abs(dir)
+		if err != nil {
+			return err
+		}
+		store, err := blob.NewDirStore(dir)
+		if err != nil {
+			return err
+		}
+		roots[name] = &fileRoot{
+			Opts:   &opts,
+			dir:    dir,
+			store:  store,
+			cellID: AppSpec.ID.WithToken(name),
+		}
+	}
+
+	return reg.RegisterApp(&amp.App{
+		AppSpec:     AppSpec,
+		Desc:        "local directory trees, updated live",
+		Version:     "v1.0.0",
+		Invocations: []string{"files"},
+		NewAppInstance: func(ctx amp.AppContext) (amp.AppInstance, error) {
+			app := &appInst{
+				roots: roots,
+			}
+			app.AppContext = ctx
+			app.Instance = app
+			return app, nil
+		},
+	})
+}
+
+type appInst struct {
+	std.App[*appInst]
+	roots map[string]*fileRoot
+}
+
+func (app *appInst) ServeRequest(op amp.Requester) (amp.Pin, error) {
+	req := op.Request()
+	var rootName, relPath string
+	if req.Values != nil {
+		rootName = req.Values.Get(RootParam)
+		relPath = req.Values.Get(PathParam)
+	}
+	root := app.roots[rootName]
+	if root == nil {
+		return nil, amp.ErrCode_BadRequest.Errorf("files: unknown root %q", rootName)
+	}
+	relPath = path.Clean("/" + relPath)[1:]
+	if relPath == "" {
+		relPath = "."
+	}
+	if !fs.ValidPath(relPath) {
+		return nil, amp.ErrCode_BadRequest.Errorf("files: bad %q param", PathParam)
+	}
+
+	stat, err := os.Stat(root.pathname(relPath))
+	if err != nil {
+		return nil, amp.ErrCellNotFound
+	}
+	if !stat.IsDir() {
+		return app.PinAndServe(root.newFileCell(relPath, stat), op)
+	}
+	return app.PinAndServe(root.newDirCell(relPath, stat), op)
+}
+
+// fileRoot is a local directory served by the app.
+type fileRoot struct {
+	*Opts
+	dir    string
+	store  blob.Store // reads file content as assets
+	cellID tag.ID
+}
+
+func (root *fileRoot) pathname(relPath string) string {
+	return filepath.Join(root.dir, filepath.FromSlash(relPath))
+}
+
+// cellIDOf returns the cell ID of the given path, so a path's cell is stable across listings.
+func (root *fileRoot) cellIDOf(relPath string) tag.ID {
+	if relPath == "." {
+		return root.cellID
+	}
+	return root.cellID.Then(tag.FromToken(relPath))
+}
+
+func newFileInfo(stat fs.FileInfo) *std.FSInfo {
+	name := stat.Name()
+	info := &std.FSInfo{
+		Mode:     stat.Mode().String(),
+		IsDir:    stat.IsDir(),
+		Name:     name,
+		NameLen:  int32(len(name) - len(path.Ext(name))),
+		ByteSize: stat.Size(),
+	}
+	if !info.IsDir {
+		info.ContentType = mime.TypeByExtension(path.Ext(name))
+	}
+	info.SetModifiedAt(stat.ModTime())
+	return info
+}
+
+func (root *fileRoot) newDirCell(relPath string, stat fs.FileInfo) *dirCell {
+	cell := &dirCell{
+		root:    root,
+		relPath: relPath,
+		info:    newFileInfo(stat),
+	}
+	cell.ID = root.cellIDOf(relPath)
+	cell.Inputs = []*std.Signal{&cell.changed}
+	cell.Compute = cell.computeEntries
+	cell.Attrs = cell.marshalInfo
+	return cell
+}
+
+func (root *fileRoot) newFileCell(relPath string, stat fs.FileInfo) *fileCell {
+	cell := &fileCell{
+		root:    root,
+		relPath: relPath,
+		info:    newFileInfo(stat),
+	}
+	cell.ID = root.cellIDOf(relPath)
+	return cell
+}
+
+// dirCell has a child cell per directory entry.
+type dirCell struct {
+	std.ComputedCell[*appInst]
+	root    *fileRoot
+	relPath string
+	info    *std.FSInfo
+	changed std.Signal // notified by the root's Watcher
+
+	// children by name, as of the last computeEntries(), so that unchanged entries keep their cells
+	entries map[string]std.Cell[*appInst]
+}
+
+func (cell *dirCell) PinInto(pin *std.Pin[*appInst]) error {
+	if pin.Sync == amp.StateSync_Maintain {
+		if err := cell.root.Watcher.Watch(pin.Context(), cell.root.pathname(cell.relPath), cell.changed.Notify); err != nil {
+			return err
+		}
+	}
+	return cell.ComputedCell.PinInto(pin)
+}
+
+func (cell *dirCell) marshalInfo(w std.CellWriter) {
+	w.PutText(std.CellLabel, cell.info.Name)
+	w.PutItem(std.CellFileInfo, cell.info)
+}
+
+// computeEntries lists the directory, reusing the cell of each entry whose info is unchanged.
+func (cell *dirCell) computeEntries() ([]std.Cell[*appInst], error) {
+	dirEntries, err := os.ReadDir(cell.root.pathname(cell.relPath))
+	if err != nil {
+		return nil, amp.ErrCode_ProviderErr.Errorf("files: %v", err)
+	}
+
+	entries := make(map[string]std.Cell[*appInst], len(dirEntries))
+	children := make([]std.Cell[*appInst], 0, len(dirEntries))
+	for _, entry := range dirEntries {
+		name := entry.Name()
+		if !cell.root.ShowHidden && strings.HasPrefix(name, ".") {
+			continue
+		}
+		stat, err := entry.Info()
+		if err != nil {
+			continue // removed since listed
+		}
+		info := newFileInfo(stat)
+
+		child := cell.entries[name]
+		if child == nil || !infoOf(child).Equal(info) {
+			relPath := path.Join(cell.relPath, name)
+			if stat.IsDir() {
+				child = cell.root.newDirCell(relPath, stat)
+			} else {
+				child = cell.root.newFileCell(relPath, stat)
+			}
+		}
+		entries[name] = child
+		children = append(children, child)
+	}
+	cell.entries = entries
+	return children, nil
+}
+
+func infoOf(cell std.Cell[*appInst]) *std.FSInfo {
+	switch cell := cell.(type) {
+	case *dirCell:
+		return cell.info
+	case *fileCell:
+		return cell.info
+	}
+	return nil
+}
+
+// fileCell presents a file's info and, once pinned, its content.
+type fileCell struct {
+	std.CellNode[*appInst]
+	root    *fileRoot
+	relPath string
+	info    *std.FSInfo
+	content atomic.Pointer[std.AssetRef] // set once pinned
+}
+
+func (cell *fileCell) PinInto(pin *std.Pin[*appInst]) error {
+	if cell.content.Load() != nil {
+		return nil
+	}
+	asset := media.NewBlobAsset(cell.root.store, cell.relPath, cell.info.ContentType)
+	ref, err := std.PrepareAsset(pin.App.Session(), asset, std.AssetOpts{})
+	if err != nil {
+		return err
+	}
+	cell.content.Store(&ref)
+	return nil
+}
+
+func (cell *fileCell) MarshalAttrs(w std.CellWriter) {
+	w.PutText(std.CellLabel, cell.info.Name)
+	w.PutItem(std.CellFileInfo, cell.info)
+	if ref := cell.content.Load(); ref != nil {
+		ref.PutAs(w, std.CellMedia)
+	}
+}
+
+// PollWatcher is a Watcher that re-lists a directory at a fixed interval, suiting volumes lacking change notification (e.g. network shares).
+type PollWatcher struct {
+	Interval time.Duration
+}
+
+func (pw PollWatcher) Watch(ctx task.Context, dir string, onChange func()) error {
+	prev, err := dirSignature(dir)
+	if err != nil {
+		return amp.ErrCode_ProviderErr.Errorf("files: %v", err)
+	}
+	_, err = ctx.StartChild(&task.Task{
+		Info: task.Info{
+			Label: "watch: " + dir,
+		},
+		OnRun: func(ctx task.Context) {
+			for {
+				timer := ctx.Clock().NewTimer(pw.Interval)
+				select {
+				case <-timer.C():
+				case <-ctx.Closing():
+					timer.Stop()
+					return
+				}
+				if sig, err := dirSignature(dir); err == nil && sig != prev {
+					prev = sig
+					onChange()
+				}
+			}
+		},
+	})
+	return err
+}
+
+// dirSignature returns a hash of the name, mode, modification time, and size of each entry in dir (which os.ReadDir sorts by name).
+func dirSignature(dir string) (uint64, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return 0, err
+	}
+	hasher := fnv.New64a()
+	for _, entry := range entries {
+		stat, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		hasher.Write([]byte(stat.Name()))
+		hasher.Write([]byte(stat.Mode().String()))
+		hasher.Write(binary.LittleEndian.AppendUint64(nil, uint64(stat.ModTime().UnixNano())))
+		hasher.Write(binary.LittleEndian.AppendUint64(nil, uint64(stat.Size())))
+	}
+	return hasher.Sum64(), nil
+}
@@ -0,0 +1,57 @@
+package files
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+func TestDirCell(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "a.txt"), []byte("a"), 0600)
+	os.WriteFile(filepath.Join(dir, ".hidden"), []byte("h"), 0600)
+	os.Mkdir(filepath.Join(dir, "sub"), 0700)
+
+	root := &fileRoot{
+		Opts: &Opts{},
+		dir:  dir,
+	}
+	stat, _ := os.Stat(dir)
+	cell := root.newDirCell(".", stat)
+	children, err := cell.computeEntries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(children) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(children))
+	}
+	file, isFile := children[0].(*fileCell)
+	if !isFile || file.info.Name != "a.txt" || file.info.ContentType != "text/plain; charset=utf-8" || file.ID != root.cellIDOf("a.txt") {
+		t.Fatalf("unexpected file entry: %+v", children[0])
+	}
+	if sub, isDir := children[1].(*dirCell); !isDir || !sub.info.IsDir {
+		t.Fatal("expected sub to be a directory")
+	}
+
+	// the watcher notices a new file, and relisting reuses unchanged cells
+	root.Watcher = PollWatcher{Interval: time.Millisecond}
+	ctx, _ := task.Start(&task.Task{})
+	defer ctx.Close()
+	changed := make(chan struct{}, 1)
+	if err = root.Watcher.Watch(ctx, dir, func() { changed <- struct{}{} }); err != nil {
+		t.Fatal(err)
+	}
+	os.WriteFile(filepath.Join(dir, "b.txt"), []byte("bb"), 0600)
+	select {
+	case <-changed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("change not detected")
+	}
+	children, _ = cell.computeEntries()
+	if len(children) != 3 || children[0] != file {
+		t.Fatal("expected unchanged entry to keep its cell")
+	}
+}
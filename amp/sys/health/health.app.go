@@ -0,0 +1,191 @@
+// Package health implements the "health:" sys app, which presents the host's health checks as cells so clients and admin tools can see why a host is not ready.
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/amp/std"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+var AppSpec = amp.AppSpec.With("sys.health")
+
+// Opts specifies the checks the app presents.
+type Opts struct {
+	Checks   *amp.HealthChecks
+	Interval time.Duration // a pin with StateSync_Maintain re-runs the checks at this interval; if <= 0, 10s
+}
+
+// RegisterApp registers the health app, invoked via "health:".
+// The pinned cell has a CellLabel ("ready", "not ready", or "not live") and a child cell per check, each with a CellLabel and CellHealth property.
+func RegisterApp(reg amp.Registry, opts Opts) error {
+	if opts.Checks == nil {
+		return amp.ErrCode_BadRequest.Error("health: missing Checks")
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = 10 * time.Second
+	}
+	return reg.RegisterApp(&amp.App{
+		AppSpec:     AppSpec,
+		Desc:        "host health and readiness",
+		Version:     "v1.0.0",
+		Invocations: []string{"health"},
+		NewAppInstance: func(ctx amp.AppContext) (amp.AppInstance, error) {
+			app := &appInst{
+				Opts: &opts,
+			}
+			app.AppContext = ctx
+			app.Instance = app
+			return app, nil
+		},
+	})
+}
+
+type appInst struct {
+	std.App[*appInst]
+	*Opts
+}
+
+func (app *appInst) ServeRequest(op amp.Requester) (amp.Pin, error) {
+	return app.PinAndServe(newHealthCell(app, app.Opts), op)
+}
+
+func newHealthCell(ctx context.Context, opts *Opts) *healthCell {
+	cell := &healthCell{
+		ctx:  ctx,
+		opts: opts,
+	}
+	cell.ID = AppSpec.ID
+	cell.Inputs = []*std.Signal{&cell.changed}
+	cell.Compute = cell.computeChecks
+	cell.Attrs = cell.marshalHealth
+	return cell
+}
+
+// healthCell has a child cell per health check, re-run while pinned with StateSync_Maintain.
+type healthCell struct {
+	std.ComputedCell[*appInst]
+	ctx     context.Context
+	opts    *Opts
+	changed std.Signal // notified when a check's outcome changes
+
+	mu     sync.Mutex
+	report amp.HealthReport
+}
+
+func (cell *healthCell) PinInto(pin *std.Pin[*appInst]) error {
+	if err := cell.ComputedCell.PinInto(pin); err != nil {
+		return err
+	}
+	if pin.Sync != amp.StateSync_Maintain {
+		return nil
+	}
+	_, err := pin.Context().StartChild(&task.Task{
+		Info: task.Info{
+			Label: "health checks",
+		},
+		OnRun: func(ctx task.Context) {
+			for {
+				timer := ctx.Clock().NewTimer(cell.opts.Interval)
+				select {
+				case <-timer.C():
+					if cell.run(ctx) {
+						cell.changed.Notify()
+					}
+				case <-ctx.Closing():
+					timer.Stop()
+					return
+				}
+			}
+		},
+	})
+	return err
+}
+
+// run runs the checks, returning true if any outcome differs from the previous run.
+func (cell *healthCell) run(ctx context.Context) bool {
+	report := cell.opts.Checks.Run(ctx)
+
+	cell.mu.Lock()
+	defer cell.mu.Unlock()
+	prev := cell.report
+	cell.report = report
+	if len(prev.Results) != len(report.Results) {
+		return true
+	}
+	for i, result := range report.Results {
+		was := prev.Results[i]
+		if result.Name != was.Name || (result.Err == nil) != (was.Err == nil) {
+			return true
+		}
+		if result.Err != nil && result.Err.Error() != was.Err.Error() {
+			return true
+		}
+	}
+	return false
+}
+
+func (cell *healthCell) computeChecks() ([]std.Cell[*appInst], error) {
+	cell.mu.Lock()
+	report := cell.report
+	cell.mu.Unlock()
+	if report.At.IsZero() {
+		cell.run(cell.ctx)
+		cell.mu.Lock()
+		report = cell.report
+		cell.mu.Unlock()
+	}
+
+	children := make([]std.Cell[*appInst], len(report.Results))
+	for i, result := range report.Results {
+		status := &std.HealthStatus{
+			Name:      result.Name,
+			OK:        result.Err == nil,
+			Liveness:  result.Liveness,
+			LatencyMs: result.Latency.Milliseconds(),
+			CheckedAt: report.At.Unix(),
+		}
+		if result.Err != nil {
+			status.Detail = result.Err.Error()
+		}
+		child := &checkCell{
+			status: status,
+		}
+		child.ID = AppSpec.ID.Then(tag.FromToken(result.Name))
+		children[i] = child
+	}
+	return children, nil
+}
+
+func (cell *healthCell) marshalHealth(w std.CellWriter) {
+	cell.mu.Lock()
+	report := cell.report
+	cell.mu.Unlock()
+	switch {
+	case !report.Live:
+		w.PutText(std.CellLabel, "not live")
+	case !report.Ready:
+		w.PutText(std.CellLabel, "not ready")
+	default:
+		w.PutText(std.CellLabel, "ready")
+	}
+}
+
+// checkCell presents the outcome of a health check.
+type checkCell struct {
+	std.CellNode[*appInst]
+	status *std.HealthStatus
+}
+
+func (cell *checkCell) PinInto(pin *std.Pin[*appInst]) error {
+	return nil
+}
+
+func (cell *checkCell) MarshalAttrs(w std.CellWriter) {
+	w.PutText(std.CellLabel, cell.status.Name)
+	w.PutItem(std.CellHealth, cell.status)
+}
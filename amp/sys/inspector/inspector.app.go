@@ -0,0 +1,341 @@
+// Package inspector implements the "inspector:" sys app, which presents a live host's sessions, pins, task tree, and recent txs as cells, so any amp client doubles as an admin console.
+// Only users holding amp.RoleInspect may pin it.
+package inspector
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/amp/std"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+var AppSpec = amp.AppSpec.With("sys.inspector")
+
+// Opts specifies what the inspector presents.
+type Opts struct {
+	Host    task.Context        // root of the inspected task tree; if nil, the session's Services.HostContext
+	Flight  *amp.FlightRecorder // if set, recent txs per session are presented
+	Refresh time.Duration       // a pin with StateSync_Maintain is refreshed at this interval; if <= 0, 2s
+	MaxTxs  int                 // recent txs presented per session; if <= 0, 50
+}
+
+// RegisterApp registers the inspector app, invoked via "inspector:".
+//
+// The pinned cell has a child cell per section:
+//   - "sessions": a child cell per session (CellLabel, CellCaption of its user, pin count, and age), each with a child cell per pin
+//   - "tasks": the host's task tree, a cell per task (CellLabel, CellCaption of its state and age)
+//   - "metrics": a child cell per task label class (see task.ReadMetrics)
+//   - "apps": a child cell per app presenting its approximate resource usage, busiest first (see std.AppUsage)
+//   - "txs": if Opts.Flight is set, a child cell per session's flight log, each with a child cell per recent tx
+func RegisterApp(reg amp.Registry, opts Opts) error {
+	if opts.Refresh <= 0 {
+		opts.Refresh = 2 * time.Second
+	}
+	if opts.MaxTxs <= 0 {
+		opts.MaxTxs = 50
+	}
+	return reg.RegisterApp(&amp.App{
+		AppSpec:     AppSpec,
+		Desc:        "live sessions, pins, tasks, and txs",
+		Version:     "v1.0.0",
+		Invocations: []string{"inspector"},
+		NewAppInstance: func(ctx amp.AppContext) (amp.AppInstance, error) {
+			app := &appInst{
+				Opts: &opts,
+			}
+			app.AppContext = ctx
+			app.Instance = app
+			return app, nil
+		},
+	})
+}
+
+type appInst struct {
+	std.App[*appInst]
+	*Opts
+}
+
+func (app *appInst) ServeRequest(op amp.Requester) (amp.Pin, error) {
+	sess := app.Session()
+	services := amp.ServicesOf(sess)
+	login := sess.Login()
+	if login.UserID == nil || services.AccessControl == nil || !services.AccessControl.HasRole(login.UserID.AsID(), amp.RoleInspect) {
+		return nil, amp.ErrAccessDenied
+	}
+	opts := *app.Opts
+	if opts.Host == nil {
+		opts.Host = services.HostContext
+		if opts.Host == nil {
+			return nil, amp.ErrUnimplemented
+		}
+	}
+	return app.PinAndServe(newRootCell(&opts), op)
+}
+
+// cellIDOf returns a stable cell ID for the given kind and key (e.g. a task ID).
+func cellIDOf(kind, key string) tag.ID {
+	return AppSpec.ID.WithToken(kind + ":" + key)
+}
+
+func newRootCell(opts *Opts) std.Cell[*appInst] {
+	cell := &std.ComputedCell[*appInst]{}
+	cell.ID = AppSpec.ID
+	cell.Compute = func() ([]std.Cell[*appInst], error) {
+		children := []std.Cell[*appInst]{
+			newLiveCell(cellIDOf("section", "sessions"), "sessions", opts, func(c *liveCell) ([]std.Cell[*appInst], error) {
+				return computeSessions(opts, c), nil
+			}),
+			newLiveCell(cellIDOf("section", "tasks"), "tasks", opts, func(c *liveCell) ([]std.Cell[*appInst], error) {
+				root := task.Inspect(opts.Host)
+				c.setCaption(fmt.Sprintf("%d tasks", countTasks(root)))
+				return []std.Cell[*appInst]{newTaskCell(root)}, nil
+			}),
+			newLiveCell(cellIDOf("section", "metrics"), "metrics", opts, func(c *liveCell) ([]std.Cell[*appInst], error) {
+				return computeMetrics(c), nil
+			}),
+			newLiveCell(cellIDOf("section", "apps"), "apps", opts, func(c *liveCell) ([]std.Cell[*appInst], error) {
+				return computeAppUsage(opts, c), nil
+			}),
+		}
+		if opts.Flight != nil {
+			children = append(children, newLiveCell(cellIDOf("section", "txs"), "txs", opts, func(c *liveCell) ([]std.Cell[*appInst], error) {
+				return computeFlightLogs(opts, c), nil
+			}))
+		}
+		return children, nil
+	}
+	cell.Attrs = func(w std.CellWriter) {
+		w.PutText(std.CellLabel, "inspector")
+	}
+	return cell
+}
+
+// liveCell is a section recomputed at Opts.Refresh while pinned with StateSync_Maintain.
+type liveCell struct {
+	std.ComputedCell[*appInst]
+	label   string
+	refresh time.Duration
+	tick    std.Signal
+
+	mu      sync.Mutex
+	caption string
+}
+
+func newLiveCell(cellID tag.ID, label string, opts *Opts, compute func(c *liveCell) ([]std.Cell[*appInst], error)) *liveCell {
+	cell := &liveCell{
+		label:   label,
+		refresh: opts.Refresh,
+	}
+	cell.ID = cellID
+	cell.Inputs = []*std.Signal{&cell.tick}
+	cell.Compute = func() ([]std.Cell[*appInst], error) {
+		return compute(cell)
+	}
+	cell.Attrs = cell.marshalSection
+	return cell
+}
+
+func (cell *liveCell) PinInto(pin *std.Pin[*appInst]) error {
+	if err := cell.ComputedCell.PinInto(pin); err != nil {
+		return err
+	}
+	if pin.Sync != amp.StateSync_Maintain {
+		return nil
+	}
+	_, err := pin.Context().StartChild(&task.Task{
+		Info: task.Info{
+			Label: "refresh: " + cell.label,
+		},
+		OnRun: func(ctx task.Context) {
+			for {
+				timer := ctx.Clock().NewTimer(cell.refresh)
+				select {
+				case <-timer.C():
+					cell.tick.Notify()
+				case <-ctx.Closing():
+					timer.Stop()
+					return
+				}
+			}
+		},
+	})
+	return err
+}
+
+func (cell *liveCell) setCaption(caption string) {
+	cell.mu.Lock()
+	cell.caption = caption
+	cell.mu.Unlock()
+}
+
+func (cell *liveCell) marshalSection(w std.CellWriter) {
+	cell.mu.Lock()
+	caption := cell.caption
+	cell.mu.Unlock()
+	w.PutText(std.CellLabel, cell.label)
+	if caption != "" {
+		w.PutText(std.CellCaption, caption)
+	}
+}
+
+// infoCell is a leaf (or fixed subtree) presenting a label and caption.
+type infoCell struct {
+	std.ComputedCell[*appInst]
+	label   string
+	caption string
+}
+
+func newInfoCell(cellID tag.ID, label, caption string, children []std.Cell[*appInst]) *infoCell {
+	cell := &infoCell{
+		label:   label,
+		caption: caption,
+	}
+	cell.ID = cellID
+	cell.Compute = func() ([]std.Cell[*appInst], error) {
+		return children, nil
+	}
+	cell.Attrs = func(w std.CellWriter) {
+		w.PutText(std.CellLabel, cell.label)
+		if cell.caption != "" {
+			w.PutText(std.CellCaption, cell.caption)
+		}
+	}
+	return cell
+}
+
+// computeSessions finds each session in the task tree (a task with an amp.LogFieldSession field) and the pins within it (tasks with an amp.LogFieldCell field).
+func computeSessions(opts *Opts, section *liveCell) []std.Cell[*appInst] {
+	var sessions []std.Cell[*appInst]
+	var visit func(ts *task.TaskState)
+	visit = func(ts *task.TaskState) {
+		sessID, isSession := ts.LogFields[amp.LogFieldSession]
+		if !isSession {
+			for _, child := range ts.Children {
+				visit(child)
+			}
+			return
+		}
+
+		var pins []std.Cell[*appInst]
+		var visitPins func(ts *task.TaskState)
+		visitPins = func(ts *task.TaskState) {
+			if cellID, isPin := ts.LogFields[amp.LogFieldCell]; isPin {
+				caption := fmt.Sprintf("cell %v, %s, %s", cellID, ts.State, ts.Age.Round(time.Second))
+				pins = append(pins, newInfoCell(cellIDOf("pin", strconv.FormatInt(ts.TID, 10)), ts.Label, caption, nil))
+			}
+			for _, child := range ts.Children {
+				visitPins(child)
+			}
+		}
+		visitPins(ts)
+
+		caption := fmt.Sprintf("%d pins, %s", len(pins), ts.Age.Round(time.Second))
+		if userID, hasUser := ts.LogFields[amp.LogFieldUser]; hasUser {
+			caption = fmt.Sprintf("user %v, %s", userID, caption)
+		}
+		sessions = append(sessions, newInfoCell(cellIDOf("session", fmt.Sprint(sessID)), ts.Label, caption, pins))
+	}
+	visit(task.Inspect(opts.Host))
+
+	section.setCaption(fmt.Sprintf("%d sessions", len(sessions)))
+	return sessions
+}
+
+// newTaskCell presents a task and, as child cells, its children.
+func newTaskCell(ts *task.TaskState) std.Cell[*appInst] {
+	children := make([]std.Cell[*appInst], len(ts.Children))
+	for i, child := range ts.Children {
+		children[i] = newTaskCell(child)
+	}
+	caption := fmt.Sprintf("%s, %s, %d children", ts.State, ts.Age.Round(time.Second), len(ts.Children))
+	return newInfoCell(cellIDOf("task", strconv.FormatInt(ts.TID, 10)), ts.Label, caption, children)
+}
+
+func countTasks(ts *task.TaskState) int {
+	n := 1
+	for _, child := range ts.Children {
+		n += countTasks(child)
+	}
+	return n
+}
+
+// computeMetrics presents the task subsystem's counters per label class, most live first.
+func computeMetrics(section *liveCell) []std.Cell[*appInst] {
+	m := task.ReadMetrics()
+	classes := make([]string, 0, len(m.ByLabel))
+	for class := range m.ByLabel {
+		classes = append(classes, class)
+	}
+	sort.Slice(classes, func(i, j int) bool {
+		li, lj := m.ByLabel[classes[i]].Live, m.ByLabel[classes[j]].Live
+		if li != lj {
+			return li > lj
+		}
+		return classes[i] < classes[j]
+	})
+
+	children := make([]std.Cell[*appInst], len(classes))
+	for i, class := range classes {
+		lm := m.ByLabel[class]
+		caption := fmt.Sprintf("%d live, %d started, mean lifetime %s", lm.Live, lm.Started, lm.MeanLifetime().Round(time.Millisecond))
+		children[i] = newInfoCell(cellIDOf("class", class), class, caption, nil)
+	}
+	section.setCaption(fmt.Sprintf("%d live of %d started", m.Live, m.Started))
+	return children
+}
+
+// computeAppUsage presents each app's approximate resource usage, busiest first.
+func computeAppUsage(opts *Opts, section *liveCell) []std.Cell[*appInst] {
+	usages := std.ReadAppUsage(opts.Host)
+	sort.SliceStable(usages, func(i, j int) bool {
+		return usages[i].Busy > usages[j].Busy
+	})
+
+	children := make([]std.Cell[*appInst], len(usages))
+	for i, u := range usages {
+		caption := fmt.Sprintf("busy %s, %d KB allocated, %d tasks, %d txs (%d KB) pushed", u.Busy.Round(time.Millisecond), u.AllocBytes>>10, u.Tasks, u.TxsPushed, u.BytesPushed>>10)
+		children[i] = newInfoCell(cellIDOf("app", u.App), u.App, caption, nil)
+	}
+	section.setCaption(fmt.Sprintf("%d apps", len(usages)))
+	return children
+}
+
+// computeFlightLogs presents each session's recent txs, newest first.
+func computeFlightLogs(opts *Opts, section *liveCell) []std.Cell[*appInst] {
+	logs := opts.Flight.Dump()
+	children := make([]std.Cell[*appInst], len(logs))
+	total := uint64(0)
+	for i, log := range logs {
+		total += log.Recorded
+		entries := log.Entries[max(0, len(log.Entries)-opts.MaxTxs):]
+		txs := make([]std.Cell[*appInst], len(entries))
+		for j := range entries {
+			entry := &entries[len(entries)-1-j]
+			dir := "recv"
+			if entry.Sent {
+				dir = "send"
+			}
+			label := fmt.Sprintf("#%d %s %v, %d ops, %d bytes", entry.Seq, dir, entry.Status, entry.OpCount, entry.DataLen)
+			caption := entry.At.UTC().Format(time.RFC3339Nano)
+			if !entry.ContextID.IsNil() {
+				caption += ", context " + entry.ContextID.Base32Suffix()
+			}
+			key := log.Session + "#" + log.Opened.String() + "#" + strconv.FormatUint(entry.Seq, 10)
+			txs[j] = newInfoCell(cellIDOf("tx", key), label, caption, nil)
+		}
+
+		caption := fmt.Sprintf("%d txs recorded, opened %s", log.Recorded, log.Opened.UTC().Format(time.RFC3339))
+		if !log.Closed.IsZero() {
+			caption += ", closed"
+		}
+		children[i] = newInfoCell(cellIDOf("flight", log.Session+"#"+log.Opened.String()), log.Session, caption, txs)
+	}
+	section.setCaption(fmt.Sprintf("%d sessions, %d txs recorded", len(logs), total))
+	return children
+}
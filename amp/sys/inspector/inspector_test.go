@@ -0,0 +1,55 @@
+package inspector
+
+import (
+	"testing"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/log"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+func TestSessions(t *testing.T) {
+	host, err := task.Start(&task.Task{
+		Info: task.Info{Label: "host"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer host.Close()
+
+	sess, _ := host.StartChild(&task.Task{
+		Info: task.Info{
+			Label:     "session: alice",
+			LogFields: log.Fields{amp.LogFieldSession: "s1", amp.LogFieldUser: "alice"},
+		},
+	})
+	for _, cellID := range []string{"c1", "c2"} {
+		sess.StartChild(&task.Task{
+			Info: task.Info{
+				Label:     "pin: " + cellID,
+				LogFields: log.Fields{amp.LogFieldCell: cellID},
+			},
+		})
+	}
+	host.StartChild(&task.Task{
+		Info: task.Info{Label: "gateway"},
+	})
+
+	opts := &Opts{Host: host}
+	section := newLiveCell(cellIDOf("section", "sessions"), "sessions", opts, nil)
+	sessions := computeSessions(opts, section)
+	if len(sessions) != 1 {
+		t.Fatalf("expected 1 session, got %d", len(sessions))
+	}
+	cell := sessions[0].(*infoCell)
+	if cell.label != "session: alice" || cell.caption[:len("user alice, 2 pins")] != "user alice, 2 pins" {
+		t.Fatalf("unexpected session cell: %q, %q", cell.label, cell.caption)
+	}
+	pins, _ := cell.Compute()
+	if len(pins) != 2 {
+		t.Fatalf("expected 2 pins, got %d", len(pins))
+	}
+	if section.caption != "1 sessions" {
+		t.Fatalf("unexpected section caption %q", section.caption)
+	}
+}
@@ -0,0 +1,433 @@
+// Package mail implements the "mail:" sys app, which pins the mailboxes, threads, and messages of mail accounts as cells.
+// Mailboxes are presented a window of threads at a time (see std.CollectionWindow), while message bodies and attachments are only loaded once a client pins them.
+package mail
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/amp/std"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/blob"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/media"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+var AppSpec = amp.AppSpec.With("sys.mail")
+
+// Pin URL query parameters naming the pinned account and (optionally) mailbox -- see also std.WindowOffsetParam and std.WindowLimitParam.
+const (
+	AccountParam = "account"
+	MailboxParam = "mailbox"
+)
+
+// Mailstore is a mail account's mailboxes and messages.
+// JMAPStore is provided, while an adapter for another protocol (e.g. IMAP) is a drop-in replacement.
+type Mailstore interface {
+
+	// Returns the account's mailboxes, in display order.
+	Mailboxes(ctx context.Context) ([]*Mailbox, error)
+
+	// Returns a window of a mailbox's threads (as the latest email of each), newest first, and the mailbox's total number of threads.
+	Threads(ctx context.Context, mailboxID string, offset, limit int) (threads []*Email, total int, err error)
+
+	// Returns the emails of a thread, oldest first and with their attachments listed.
+	Thread(ctx context.Context, threadID string) ([]*Email, error)
+
+	// Returns the plain text body of an email.
+	Body(ctx context.Context, emailID string) (string, error)
+
+	// Opens the content of an attachment.
+	Download(ctx context.Context, att *Attachment) (io.ReadCloser, error)
+}
+
+// Mailbox is a folder or label of a mail account.
+type Mailbox struct {
+	ID            string
+	Name          string
+	Role          string // e.g. "inbox", "sent", "trash"; "" if none
+	ParentID      string
+	TotalThreads  int
+	UnreadThreads int
+}
+
+// Email is the summary of an email message.
+type Email struct {
+	ID          string
+	ThreadID    string
+	Subject     string
+	From        string // sender's name, or address if unnamed
+	Preview     string // plain text excerpt of the body
+	ReceivedAt  time.Time
+	Unread      bool
+	Attachments []Attachment // only set by Mailstore.Thread()
+}
+
+// Attachment is a file attached to an email.
+type Attachment struct {
+	BlobID      string // immutable ID of the attachment's content
+	Name        string
+	ContentType string
+	Size        int64
+}
+
+// Opts specifies the mail accounts the app serves.
+type Opts struct {
+	Accounts    map[string]Mailstore // by name
+	PageSize    int                  // threads per window if the pin request does not specify; if <= 0, 50
+	MaxPageSize int                  // if <= 0, 500
+	Refresh     time.Duration        // if > 0, a mailbox window pinned with StateSync_Maintain is re-queried at this interval
+}
+
+// RegisterApp registers the mail app, invoked via "mail:?account={name}[&mailbox={id}[&offset={n}][&limit={n}]]".
+//
+// The pinned cell is an account, mailbox, thread, email, or attachment (where a child cell is pinned via its parent's pin):
+//   - an account has a child cell per mailbox
+//   - a mailbox has a CellLabel, CellCaption (its unread count), and CellWindow property, and a child cell per thread in the window
+//   - a thread has a CellLabel (its subject), CellAuthor, CellSynopsis, and OrderByTimeID property, and a child cell per email
+//   - an email has the same properties as a thread plus a deferred CellBody property, and a child cell per attachment
+//   - an attachment has a CellLabel and CellFileInfo property and once pinned, its content as a CellMedia property (see std.PrepareAsset)
+//
+// Bodies and attachments are loaded from the server only as they are pinned, and attachments are cached in the session's blob store.
+func RegisterApp(reg amp.Registry, opts Opts) error {
+	if opts.PageSize <= 0 {
+		opts.PageSize = 50
+	}
+	if opts.MaxPageSize <= 0 {
+		opts.MaxPageSize = 500
+	}
+	accounts := make(map[string]*account, len(opts.Accounts))
+	for name, store := range opts.Accounts {
+		accounts[name] = &account{
+			Opts:   &opts,
+			name:   name,
+			store:  store,
+			cellID: AppSpec.ID.WithToken(name),
+		}
+	}
+
+	return reg.RegisterApp(&amp.App{
+		AppSpec:     AppSpec,
+		Desc:        "mailboxes, threads, and messages",
+		Version:     "v1.0.0",
+		Invocations: []string{"mail"},
+		NewAppInstance: func(ctx amp.AppContext) (amp.AppInstance, error) {
+			app := &appInst{
+				accounts: accounts,
+			}
+			app.AppContext = ctx
+			app.Instance = app
+			return app, nil
+		},
+	})
+}
+
+type appInst struct {
+	std.App[*appInst]
+	accounts map[string]*account
+}
+
+func (app *appInst) ServeRequest(op amp.Requester) (amp.Pin, error) {
+	req := op.Request()
+	var accountName, mailboxID string
+	if req.Values != nil {
+		accountName = req.Values.Get(AccountParam)
+		mailboxID = req.Values.Get(MailboxParam)
+	}
+	acct := app.accounts[accountName]
+	if acct == nil {
+		return nil, amp.ErrCode_BadRequest.Errorf("mail: unknown account %q", accountName)
+	}
+	if mailboxID == "" {
+		return app.PinAndServe(acct.newAccountCell(app), op)
+	}
+
+	mailboxes, err := acct.store.Mailboxes(app)
+	if err != nil {
+		return nil, err
+	}
+	for _, mb := range mailboxes {
+		if mb.ID == mailboxID {
+			offset, limit := std.ParseWindow(req.Values, acct.PageSize, acct.MaxPageSize)
+			return app.PinAndServe(acct.newMailboxCell(app, mb, offset, limit), op)
+		}
+	}
+	return nil, amp.ErrCellNotFound
+}
+
+// account is a Mailstore served by the app.
+type account struct {
+	*Opts
+	name   string
+	store  Mailstore
+	cellID tag.ID
+}
+
+// cellIDOf returns the cell ID of a mailbox, thread, email, or attachment from its kind and ID, so its cell is stable across pins.
+func (acct *account) cellIDOf(kind, id string) tag.ID {
+	return acct.cellID.Then(tag.FromToken(kind + ":" + id))
+}
+
+func (acct *account) newAccountCell(ctx context.Context) *std.ComputedCell[*appInst] {
+	cell := &std.ComputedCell[*appInst]{}
+	cell.ID = acct.cellID
+	cell.Compute = func() ([]std.Cell[*appInst], error) {
+		mailboxes, err := acct.store.Mailboxes(ctx)
+		if err != nil {
+			return nil, err
+		}
+		children := make([]std.Cell[*appInst], len(mailboxes))
+		for i, mb := range mailboxes {
+			children[i] = acct.newMailboxCell(ctx, mb, 0, acct.PageSize)
+		}
+		return children, nil
+	}
+	cell.Attrs = func(w std.CellWriter) {
+		w.PutText(std.CellLabel, acct.name)
+	}
+	return cell
+}
+
+func (acct *account) newMailboxCell(ctx context.Context, mb *Mailbox, offset, limit int) *mailboxCell {
+	cell := &mailboxCell{
+		ctx:     ctx,
+		acct:    acct,
+		mailbox: mb,
+		window: std.CollectionWindow{
+			Offset: int64(offset),
+			Total:  int64(mb.TotalThreads),
+		},
+		limit: limit,
+	}
+	cell.ID = acct.cellIDOf("mailbox", mb.ID)
+	cell.Inputs = []*std.Signal{&cell.changed}
+	cell.Compute = cell.computeThreads
+	cell.Attrs = cell.marshalMailbox
+	return cell
+}
+
+// mailboxCell presents a window of a mailbox's threads.
+type mailboxCell struct {
+	std.ComputedCell[*appInst]
+	ctx     context.Context // the app instance
+	acct    *account
+	mailbox *Mailbox
+	limit   int
+	changed std.Signal // notified to re-query the window
+
+	mu     sync.Mutex
+	window std.CollectionWindow // as of the last query
+}
+
+func (cell *mailboxCell) PinInto(pin *std.Pin[*appInst]) error {
+	if err := cell.ComputedCell.PinInto(pin); err != nil {
+		return err
+	}
+	refresh := cell.acct.Refresh
+	if pin.Sync != amp.StateSync_Maintain || refresh <= 0 {
+		return nil
+	}
+	_, err := pin.Context().StartChild(&task.Task{
+		Info: task.Info{
+			Label: "refresh: " + cell.mailbox.Name,
+		},
+		OnRun: func(ctx task.Context) {
+			for {
+				timer := ctx.Clock().NewTimer(refresh)
+				select {
+				case <-timer.C():
+					cell.changed.Notify()
+				case <-ctx.Closing():
+					timer.Stop()
+					return
+				}
+			}
+		},
+	})
+	return err
+}
+
+func (cell *mailboxCell) computeThreads() ([]std.Cell[*appInst], error) {
+	cell.mu.Lock()
+	offset := int(cell.window.Offset)
+	cell.mu.Unlock()
+
+	threads, total, err := cell.acct.store.Threads(cell.ctx, cell.mailbox.ID, offset, cell.limit)
+	if err != nil {
+		return nil, err
+	}
+	cell.mu.Lock()
+	cell.window.Count = int64(len(threads))
+	cell.window.Total = int64(total)
+	cell.mu.Unlock()
+
+	children := make([]std.Cell[*appInst], len(threads))
+	for i, latest := range threads {
+		child := &threadCell{
+			ctx:    cell.ctx,
+			acct:   cell.acct,
+			latest: latest,
+		}
+		child.ID = cell.acct.cellIDOf("thread", latest.ThreadID)
+		child.Compute = child.computeEmails
+		child.Attrs = child.marshalThread
+		children[i] = child
+	}
+	return children, nil
+}
+
+func (cell *mailboxCell) marshalMailbox(w std.CellWriter) {
+	cell.mu.Lock()
+	window := cell.window
+	cell.mu.Unlock()
+
+	w.PutText(std.CellLabel, cell.mailbox.Name)
+	if cell.mailbox.UnreadThreads > 0 {
+		w.PutText(std.CellCaption, fmt.Sprintf("%d unread", cell.mailbox.UnreadThreads))
+	}
+	w.PutItem(std.CellWindow, &window)
+}
+
+// threadCell presents a thread (via its latest email) and once pinned, its emails.
+type threadCell struct {
+	std.ComputedCell[*appInst]
+	ctx    context.Context // the app instance
+	acct   *account
+	latest *Email
+}
+
+func (cell *threadCell) computeEmails() ([]std.Cell[*appInst], error) {
+	emails, err := cell.acct.store.Thread(cell.ctx, cell.latest.ThreadID)
+	if err != nil {
+		return nil, err
+	}
+	children := make([]std.Cell[*appInst], len(emails))
+	for i, email := range emails {
+		children[i] = cell.acct.newEmailCell(cell.ctx, email)
+	}
+	return children, nil
+}
+
+func (cell *threadCell) marshalThread(w std.CellWriter) {
+	putSummary(w, cell.latest)
+}
+
+func putSummary(w std.CellWriter, email *Email) {
+	w.PutText(std.CellLabel, email.Subject)
+	w.PutText(std.CellAuthor, email.From)
+	if email.Preview != "" {
+		w.PutText(std.CellSynopsis, email.Preview)
+	}
+	received := &amp.Tag{}
+	received.SetFromTime(email.ReceivedAt)
+	w.PutItem(std.OrderByTimeID, received)
+}
+
+func (acct *account) newEmailCell(ctx context.Context, email *Email) *emailCell {
+	cell := &emailCell{
+		email: email,
+		body: &std.LazyValue{
+			Prototype: &amp.Tag{},
+			Load: func() (tag.Value, error) {
+				body, err := acct.store.Body(ctx, email.ID)
+				if err != nil {
+					return nil, err
+				}
+				return &amp.Tag{
+					ContentType: "text/plain",
+					Text:        body,
+				}, nil
+			},
+		},
+	}
+	cell.ID = acct.cellIDOf("email", email.ID)
+	cell.Compute = func() ([]std.Cell[*appInst], error) {
+		children := make([]std.Cell[*appInst], len(email.Attachments))
+		for i := range email.Attachments {
+			att := &attachmentCell{
+				acct: acct,
+				att:  &email.Attachments[i],
+			}
+			att.ID = acct.cellIDOf("blob", att.att.BlobID)
+			children[i] = att
+		}
+		return children, nil
+	}
+	cell.Attrs = cell.marshalEmail
+	return cell
+}
+
+// emailCell presents an email, loading its body only once the client pins CellBody.
+type emailCell struct {
+	std.ComputedCell[*appInst]
+	email *Email
+	body  *std.LazyValue // retained so the body is fetched at most once
+}
+
+func (cell *emailCell) marshalEmail(w std.CellWriter) {
+	putSummary(w, cell.email)
+	w.PutItemDeferred(std.CellBody, cell.body)
+}
+
+// attachmentCell presents an attachment and once pinned, its content.
+type attachmentCell struct {
+	std.CellNode[*appInst]
+	acct *account
+	att  *Attachment
+
+	mu      sync.Mutex
+	content std.AssetRef // set once pinned
+}
+
+// PinInto copies the attachment into the session's blob store (unless already present) and prepares it as an asset.
+func (cell *attachmentCell) PinInto(pin *std.Pin[*appInst]) error {
+	cell.mu.Lock()
+	defer cell.mu.Unlock()
+	if cell.content.Tag != nil {
+		return nil
+	}
+
+	sess := pin.App.Session()
+	store := amp.ServicesOf(sess).BlobStore
+	if store == nil {
+		return amp.ErrCode_Unimplemented.Error("mail: session has no blob store")
+	}
+	ctx := pin.Context()
+	key := "mail/" + cell.acct.name + "/" + cell.att.BlobID
+	if _, err := store.Stat(ctx, key); err != nil {
+		src, err := cell.acct.store.Download(ctx, cell.att)
+		if err != nil {
+			return err
+		}
+		defer src.Close()
+		if err = store.Put(ctx, key, src, blob.Info{ContentType: cell.att.ContentType}); err != nil {
+			return err
+		}
+	}
+
+	ref, err := std.PrepareAsset(sess, media.NewBlobAsset(store, key, cell.att.ContentType), std.AssetOpts{})
+	if err != nil {
+		return err
+	}
+	cell.content = ref
+	return nil
+}
+
+func (cell *attachmentCell) MarshalAttrs(w std.CellWriter) {
+	w.PutText(std.CellLabel, cell.att.Name)
+	w.PutItem(std.CellFileInfo, &std.FSInfo{
+		Name:        cell.att.Name,
+		ContentType: cell.att.ContentType,
+		ByteSize:    cell.att.Size,
+	})
+	cell.mu.Lock()
+	content := cell.content
+	cell.mu.Unlock()
+	if content.Tag != nil {
+		content.PutAs(w, std.CellMedia)
+	}
+}
@@ -0,0 +1,369 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+)
+
+// JMAPOpts specifies a JMAP (RFC 8620, RFC 8621) mail account.
+type JMAPOpts struct {
+	SessionURL string       // e.g. "https://api.fastmail.com/jmap/session"
+	Token      string       // if set, sent as a bearer token
+	Username   string       // otherwise, sent via HTTP basic auth
+	Password   string       // typically an app-specific password
+	Client     *http.Client // if nil, a client with a 60s timeout
+}
+
+// JMAPStore is a Mailstore backed by a JMAP server.
+type JMAPStore struct {
+	opts JMAPOpts
+
+	mu      sync.Mutex
+	session *jmapSession // fetched on first use
+}
+
+// NewJMAPStore returns a Mailstore for the given JMAP account.
+func NewJMAPStore(opts JMAPOpts) *JMAPStore {
+	if opts.Client == nil {
+		opts.Client = &http.Client{Timeout: 60 * time.Second}
+	}
+	return &JMAPStore{
+		opts: opts,
+	}
+}
+
+const jmapMail = "urn:ietf:params:jmap:mail"
+
+type jmapSession struct {
+	APIURL          string            `json:"apiUrl"`
+	DownloadURL     string            `json:"downloadUrl"`
+	PrimaryAccounts map[string]string `json:"primaryAccounts"`
+}
+
+type jmapEmail struct {
+	ID         string          `json:"id"`
+	ThreadID   string          `json:"threadId"`
+	Subject    string          `json:"subject"`
+	Preview    string          `json:"preview"`
+	ReceivedAt time.Time       `json:"receivedAt"`
+	Keywords   map[string]bool `json:"keywords"`
+	From       []struct {
+		Name  string `json:"name"`
+		Email string `json:"email"`
+	} `json:"from"`
+	Attachments []struct {
+		BlobID string `json:"blobId"`
+		Name   string `json:"name"`
+		Type   string `json:"type"`
+		Size   int64  `json:"size"`
+	} `json:"attachments"`
+}
+
+var (
+	jmapSummaryProps = []string{"id", "threadId", "subject", "preview", "receivedAt", "keywords", "from"}
+	jmapDetailProps  = append(jmapSummaryProps[:len(jmapSummaryProps):len(jmapSummaryProps)], "attachments")
+)
+
+func (js *JMAPStore) newRequest(ctx context.Context, method, href string, body []byte) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, href, bytes.NewReader(body))
+	if err != nil {
+		return nil, amp.ErrCode_BadRequest.Errorf("mail: %v", err)
+	}
+	if js.opts.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+js.opts.Token)
+	} else if js.opts.Username != "" {
+		req.SetBasicAuth(js.opts.Username, js.opts.Password)
+	}
+	return req, nil
+}
+
+func (js *JMAPStore) do(req *http.Request) (*http.Response, error) {
+	resp, err := js.opts.Client.Do(req)
+	if err != nil {
+		return nil, amp.ErrCode_ProviderErr.Errorf("mail: %v", err)
+	}
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		resp.Body.Close()
+		return nil, amp.ErrCode_AuthFailed.Errorf("mail: %s", resp.Status)
+	case resp.StatusCode != http.StatusOK:
+		resp.Body.Close()
+		return nil, amp.ErrCode_ProviderErr.Errorf("mail: %s", resp.Status)
+	}
+	return resp, nil
+}
+
+// getSession returns the JMAP session and the ID of the primary mail account.
+func (js *JMAPStore) getSession(ctx context.Context) (*jmapSession, string, error) {
+	js.mu.Lock()
+	defer js.mu.Unlock()
+	if js.session == nil {
+		req, err := js.newRequest(ctx, http.MethodGet, js.opts.SessionURL, nil)
+		if err != nil {
+			return nil, "", err
+		}
+		resp, err := js.do(req)
+		if err != nil {
+			return nil, "", err
+		}
+		defer resp.Body.Close()
+		session := &jmapSession{}
+		if err = json.NewDecoder(resp.Body).Decode(session); err != nil {
+			return nil, "", amp.ErrCode_ProviderErr.Errorf("mail: malformed JMAP session: %v", err)
+		}
+		if session.APIURL == "" || session.PrimaryAccounts[jmapMail] == "" {
+			return nil, "", amp.ErrCode_ProviderErr.Error("mail: JMAP session lacks a mail account")
+		}
+		js.session = session
+	}
+	return js.session, js.session.PrimaryAccounts[jmapMail], nil
+}
+
+// jmapCall is a JMAP method call, whose args are given the account ID when invoked.
+type jmapCall struct {
+	method string
+	args   map[string]any
+}
+
+func callID(index int) string {
+	return "c" + strconv.Itoa(index)
+}
+
+// invoke makes a JMAP API request of the given method calls, decoding each call's response into the corresponding element of results.
+// A call may refer to the result of a previous call -- see resultOf().
+func (js *JMAPStore) invoke(ctx context.Context, calls []jmapCall, results ...any) error {
+	session, accountID, err := js.getSession(ctx)
+	if err != nil {
+		return err
+	}
+	methodCalls := make([]any, len(calls))
+	for i, call := range calls {
+		call.args["accountId"] = accountID
+		methodCalls[i] = []any{call.method, call.args, callID(i)}
+	}
+	body, err := json.Marshal(map[string]any{
+		"using":       []string{"urn:ietf:params:jmap:core", jmapMail},
+		"methodCalls": methodCalls,
+	})
+	if err != nil {
+		return amp.ErrCode_BadValue.Errorf("mail: %v", err)
+	}
+	req, err := js.newRequest(ctx, http.MethodPost, session.APIURL, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := js.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var reply struct {
+		MethodResponses [][3]json.RawMessage `json:"methodResponses"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&reply); err != nil {
+		return amp.ErrCode_ProviderErr.Errorf("mail: malformed JMAP response: %v", err)
+	}
+	if len(reply.MethodResponses) < len(results) {
+		return amp.ErrCode_ProviderErr.Error("mail: JMAP response is missing method responses")
+	}
+	for i, result := range results {
+		var name string
+		json.Unmarshal(reply.MethodResponses[i][0], &name)
+		if name == "error" {
+			var jmapErr struct {
+				Type        string `json:"type"`
+				Description string `json:"description"`
+			}
+			json.Unmarshal(reply.MethodResponses[i][1], &jmapErr)
+			return amp.ErrCode_ProviderErr.Errorf("mail: JMAP %s: %s %s", calls[i].method, jmapErr.Type, jmapErr.Description)
+		}
+		if err = json.Unmarshal(reply.MethodResponses[i][1], result); err != nil {
+			return amp.ErrCode_ProviderErr.Errorf("mail: malformed %s response: %v", name, err)
+		}
+	}
+	return nil
+}
+
+// resultOf returns a back-reference (RFC 8620, section 3.7) to the result of an earlier call, given in place of an arg prefixed with '#'.
+func resultOf(callIndex int, name, path string) map[string]any {
+	return map[string]any{
+		"resultOf": callID(callIndex),
+		"name":     name,
+		"path":     path,
+	}
+}
+
+func (js *JMAPStore) Mailboxes(ctx context.Context) ([]*Mailbox, error) {
+	var got struct {
+		List []struct {
+			ID            string `json:"id"`
+			Name          string `json:"name"`
+			Role          string `json:"role"`
+			ParentID      string `json:"parentId"`
+			SortOrder     int    `json:"sortOrder"`
+			TotalThreads  int    `json:"totalThreads"`
+			UnreadThreads int    `json:"unreadThreads"`
+		} `json:"list"`
+	}
+	err := js.invoke(ctx, []jmapCall{
+		{"Mailbox/get", map[string]any{"ids": nil}},
+	}, &got)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(got.List, func(i, j int) bool {
+		return got.List[i].SortOrder < got.List[j].SortOrder
+	})
+	mailboxes := make([]*Mailbox, len(got.List))
+	for i, mb := range got.List {
+		mailboxes[i] = &Mailbox{
+			ID:            mb.ID,
+			Name:          mb.Name,
+			Role:          mb.Role,
+			ParentID:      mb.ParentID,
+			TotalThreads:  mb.TotalThreads,
+			UnreadThreads: mb.UnreadThreads,
+		}
+	}
+	return mailboxes, nil
+}
+
+func (js *JMAPStore) Threads(ctx context.Context, mailboxID string, offset, limit int) ([]*Email, int, error) {
+	var query struct {
+		Total int `json:"total"`
+	}
+	var got struct {
+		List []jmapEmail `json:"list"`
+	}
+	err := js.invoke(ctx, []jmapCall{
+		{"Email/query", map[string]any{
+			"filter":          map[string]any{"inMailbox": mailboxID},
+			"sort":            []any{map[string]any{"property": "receivedAt", "isAscending": false}},
+			"collapseThreads": true,
+			"position":        offset,
+			"limit":           limit,
+			"calculateTotal":  true,
+		}},
+		{"Email/get", map[string]any{
+			"#ids":       resultOf(0, "Email/query", "/ids"),
+			"properties": jmapSummaryProps,
+		}},
+	}, &query, &got)
+	if err != nil {
+		return nil, 0, err
+	}
+	return toEmails(got.List), query.Total, nil
+}
+
+func (js *JMAPStore) Thread(ctx context.Context, threadID string) ([]*Email, error) {
+	var threads, got struct {
+		List []jmapEmail `json:"list"`
+	}
+	err := js.invoke(ctx, []jmapCall{
+		{"Thread/get", map[string]any{"ids": []string{threadID}}},
+		{"Email/get", map[string]any{
+			"#ids":       resultOf(0, "Thread/get", "/list/*/emailIds"),
+			"properties": jmapDetailProps,
+		}},
+	}, &threads, &got)
+	if err != nil {
+		return nil, err
+	}
+	return toEmails(got.List), nil
+}
+
+func (js *JMAPStore) Body(ctx context.Context, emailID string) (string, error) {
+	var got struct {
+		List []struct {
+			TextBody []struct {
+				PartID string `json:"partId"`
+			} `json:"textBody"`
+			BodyValues map[string]struct {
+				Value string `json:"value"`
+			} `json:"bodyValues"`
+		} `json:"list"`
+	}
+	err := js.invoke(ctx, []jmapCall{
+		{"Email/get", map[string]any{
+			"ids":                 []string{emailID},
+			"properties":          []string{"textBody", "bodyValues"},
+			"fetchTextBodyValues": true,
+		}},
+	}, &got)
+	if err != nil {
+		return "", err
+	}
+	if len(got.List) == 0 {
+		return "", amp.ErrCellNotFound
+	}
+	var body strings.Builder
+	for _, part := range got.List[0].TextBody {
+		body.WriteString(got.List[0].BodyValues[part.PartID].Value)
+	}
+	return body.String(), nil
+}
+
+func (js *JMAPStore) Download(ctx context.Context, att *Attachment) (io.ReadCloser, error) {
+	session, accountID, err := js.getSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	href := strings.NewReplacer(
+		"{accountId}", url.PathEscape(accountID),
+		"{blobId}", url.PathEscape(att.BlobID),
+		"{name}", url.PathEscape(att.Name),
+		"{type}", url.QueryEscape(att.ContentType),
+	).Replace(session.DownloadURL)
+	req, err := js.newRequest(ctx, http.MethodGet, href, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := js.do(req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+func toEmails(list []jmapEmail) []*Email {
+	emails := make([]*Email, len(list))
+	for i, je := range list {
+		email := &Email{
+			ID:         je.ID,
+			ThreadID:   je.ThreadID,
+			Subject:    je.Subject,
+			Preview:    je.Preview,
+			ReceivedAt: je.ReceivedAt,
+			Unread:     !je.Keywords["$seen"],
+		}
+		if len(je.From) > 0 {
+			email.From = je.From[0].Name
+			if email.From == "" {
+				email.From = je.From[0].Email
+			}
+		}
+		for _, att := range je.Attachments {
+			email.Attachments = append(email.Attachments, Attachment{
+				BlobID:      att.BlobID,
+				Name:        att.Name,
+				ContentType: att.Type,
+				Size:        att.Size,
+			})
+		}
+		emails[i] = email
+	}
+	return emails
+}
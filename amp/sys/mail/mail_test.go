@@ -0,0 +1,145 @@
+package mail
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+)
+
+func TestMail(t *testing.T) {
+	var srv *httptest.Server
+	bodyFetches := 0
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/session":
+			fmt.Fprintf(w, `{"apiUrl": %q, "downloadUrl": %q, "primaryAccounts": {"urn:ietf:params:jmap:mail": "A1"}}`,
+				srv.URL+"/api", srv.URL+"/download/{accountId}/{blobId}/{name}?type={type}")
+		case "/download/A1/B1/notes.txt":
+			fmt.Fprint(w, "attached")
+		case "/api":
+			var req struct {
+				MethodCalls [][3]json.RawMessage `json:"methodCalls"`
+			}
+			json.NewDecoder(r.Body).Decode(&req)
+			var method string
+			var args map[string]any
+			json.Unmarshal(req.MethodCalls[0][0], &method)
+			json.Unmarshal(req.MethodCalls[0][1], &args)
+			if args["accountId"] != "A1" {
+				t.Errorf("missing account ID: %v", args)
+			}
+			switch method {
+			case "Mailbox/get":
+				fmt.Fprint(w, `{"methodResponses": [["Mailbox/get", {"list": [
+					{"id": "M2", "name": "Archive", "sortOrder": 2},
+					{"id": "M1", "name": "Inbox", "role": "inbox", "sortOrder": 1, "totalThreads": 120, "unreadThreads": 3}
+				]}, "c0"]]}`)
+			case "Email/query":
+				if args["position"] != 50.0 || args["limit"] != 2.0 || args["collapseThreads"] != true {
+					t.Errorf("unexpected query: %v", args)
+				}
+				fmt.Fprint(w, `{"methodResponses": [
+					["Email/query", {"ids": ["E1", "E3"], "total": 120}, "c0"],
+					["Email/get", {"list": [
+						{"id": "E1", "threadId": "T1", "subject": "Hello", "from": [{"name": "Ada", "email": "ada@example.com"}], "receivedAt": "2024-05-01T10:00:00Z", "keywords": {"$seen": true}},
+						{"id": "E3", "threadId": "T2", "subject": "Re: Plans", "from": [{"email": "bo@example.com"}], "receivedAt": "2024-04-30T10:00:00Z"}
+					]}, "c1"]]}`)
+			case "Thread/get":
+				fmt.Fprint(w, `{"methodResponses": [
+					["Thread/get", {"list": [{"id": "T1", "emailIds": ["E1"]}]}, "c0"],
+					["Email/get", {"list": [
+						{"id": "E1", "threadId": "T1", "subject": "Hello", "receivedAt": "2024-05-01T10:00:00Z", "attachments": [{"blobId": "B1", "name": "notes.txt", "type": "text/plain", "size": 8}]}
+					]}, "c1"]]}`)
+			case "Email/get":
+				bodyFetches++
+				fmt.Fprint(w, `{"methodResponses": [["Email/get", {"list": [
+					{"textBody": [{"partId": "1"}], "bodyValues": {"1": {"value": "Hi there"}}}
+				]}, "c0"]]}`)
+			default:
+				fmt.Fprintf(w, `{"methodResponses": [["error", {"type": "unknownMethod"}, "c0"]]}`)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	store := NewJMAPStore(JMAPOpts{
+		SessionURL: srv.URL + "/session",
+		Token:      "secret",
+		Client:     srv.Client(),
+	})
+	ctx := context.Background()
+	mailboxes, err := store.Mailboxes(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mailboxes) != 2 || mailboxes[0].Name != "Inbox" || mailboxes[0].UnreadThreads != 3 {
+		t.Fatalf("unexpected mailboxes: %+v", mailboxes)
+	}
+
+	// a window of the inbox
+	acct := &account{
+		Opts:  &Opts{PageSize: 50, MaxPageSize: 500},
+		name:  "test",
+		store: store,
+	}
+	inbox := acct.newMailboxCell(ctx, mailboxes[0], 50, 2)
+	threads, err := inbox.computeThreads()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(threads) != 2 || inbox.window.Offset != 50 || inbox.window.Count != 2 || inbox.window.Total != 120 {
+		t.Fatalf("unexpected window: %+v", inbox.window)
+	}
+	if latest := threads[1].(*threadCell).latest; latest.From != "bo@example.com" || !latest.Unread {
+		t.Fatalf("unexpected thread: %+v", latest)
+	}
+
+	// a thread's emails and their attachments
+	emails, err := store.Thread(ctx, "T1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(emails) != 1 || len(emails[0].Attachments) != 1 || emails[0].Attachments[0].Name != "notes.txt" {
+		t.Fatalf("unexpected thread: %+v", emails)
+	}
+	rc, err := store.Download(ctx, &emails[0].Attachments[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, _ := io.ReadAll(rc)
+	rc.Close()
+	if string(content) != "attached" {
+		t.Fatalf("unexpected attachment: %q", content)
+	}
+
+	// an email's body is only fetched once marshalled, and at most once
+	email := acct.newEmailCell(ctx, emails[0])
+	if bodyFetches != 0 {
+		t.Fatal("body should not be fetched until marshalled")
+	}
+	for i := 0; i < 2; i++ {
+		buf, err := email.body.MarshalToStore(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body := amp.Tag{}
+		if err = body.Unmarshal(buf); err != nil || body.Text != "Hi there" {
+			t.Fatalf("unexpected body: %q %v", body.Text, err)
+		}
+	}
+	if bodyFetches != 1 {
+		t.Fatalf("expected 1 body fetch, got %d", bodyFetches)
+	}
+}
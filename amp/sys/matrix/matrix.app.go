@@ -0,0 +1,678 @@
+// Package matrix implements the "matrix:" sys app, which bridges the rooms of a Matrix account to cells:
+// a room's messages are child cells, while its members and typing participants are ephemeral attrs (see std.CellMembers and std.CellTyping).
+// Messages committed to a room are sent (or edited or redacted) on the account's behalf.
+package matrix
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/amp/std"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+var AppSpec = amp.AppSpec.With("sys.matrix")
+
+// RoomParam is the pin URL query parameter holding the ID (e.g. "!abc:example.org") or alias (e.g. "#lobby:example.org") of the pinned room.
+const RoomParam = "room"
+
+// Opts specifies the Matrix account the app bridges.
+type Opts struct {
+	Homeserver  string        // e.g. "https://matrix.example.org"
+	AccessToken string        // the account's access token
+	UserID      string        // the account's user ID, e.g. "@amp:example.org"
+	Client      *http.Client  // if nil, a client with a timeout of SyncTimeout plus 30s
+	SyncTimeout time.Duration // long-poll duration of each sync; if <= 0, 30s
+	MaxMessages int           // messages kept per room, oldest dropped first; if <= 0, 200
+}
+
+// RegisterApp registers the matrix app, invoked via "matrix:?room={roomID or alias}".
+// The pinned cell has a CellLabel (the room's name) and a child cell per message, each with a CellLabel (its body), CellAuthor, and OrderByTimeID property.
+// Each member and typing participant is an ephemeral element of the room cell whose ItemID is derived from the participant's user ID and whose value is a Tag with the user ID (UID) and display name (Text).
+// Elements present when a room is pinned are included in its state, and changes are broadcast to maintained pins; an empty Tag denotes a participant no longer present.
+//
+// A request committing to a room:
+//   - a CellLabel property upserted to a new child cell sends it as a text message, whose cell ID is the committed cell ID
+//   - a CellLabel property upserted to an existing message of the account edits it
+//   - a deleted CellLabel property of an existing message redacts it
+//   - a non-empty CellTyping element upserted to the room cell marks the account as typing (and an empty or deleted one as not)
+//
+// While a room is pinned with StateSync_Maintain, the account is synced continuously; all pinned rooms share a single sync.
+func RegisterApp(reg amp.Registry, opts Opts) error {
+	if opts.Homeserver == "" || opts.AccessToken == "" || opts.UserID == "" {
+		return amp.ErrCode_BadRequest.Error("matrix: Homeserver, AccessToken, and UserID are required")
+	}
+	if opts.SyncTimeout <= 0 {
+		opts.SyncTimeout = 30 * time.Second
+	}
+	if opts.Client == nil {
+		opts.Client = &http.Client{Timeout: opts.SyncTimeout + 30*time.Second}
+	}
+	if opts.MaxMessages <= 0 {
+		opts.MaxMessages = 200
+	}
+
+	// Account state is shared by all app instances so the account is synced once regardless of how many sessions pin its rooms
+	acct := &account{
+		client: client{
+			http:  opts.Client,
+			base:  strings.TrimRight(opts.Homeserver, "/"),
+			token: opts.AccessToken,
+		},
+		opts:  opts,
+		rooms: make(map[string]*room),
+	}
+	return reg.RegisterApp(&amp.App{
+		AppSpec:     AppSpec,
+		Desc:        "Matrix rooms",
+		Version:     "v1.0.0",
+		Invocations: []string{"matrix"},
+		NewAppInstance: func(ctx amp.AppContext) (amp.AppInstance, error) {
+			app := &appInst{
+				acct: acct,
+			}
+			app.AppContext = ctx
+			app.Instance = app
+			return app, nil
+		},
+	})
+}
+
+type appInst struct {
+	std.App[*appInst]
+	acct *account
+}
+
+func (app *appInst) ServeRequest(op amp.Requester) (amp.Pin, error) {
+	req := op.Request()
+	var roomID string
+	if req.Values != nil {
+		roomID = req.Values.Get(RoomParam)
+	}
+	if roomID == "" {
+		return nil, amp.ErrCode_BadRequest.Errorf("matrix: missing %q param", RoomParam)
+	}
+	acct := app.acct
+	if err := acct.syncNext(app, true); err != nil {
+		return nil, err
+	}
+	if strings.HasPrefix(roomID, "#") {
+		var err error
+		if roomID, err = acct.resolveAlias(app, roomID); err != nil {
+			return nil, err
+		}
+	}
+	rm := acct.room(roomID)
+	if rm == nil {
+		return nil, amp.ErrCode_CellNotFound.Errorf("matrix: %q is not a joined room", roomID)
+	}
+
+	if tx := req.CommitTx; tx != nil {
+		if err := acct.commit(app, rm, tx); err != nil {
+			return nil, err
+		}
+	}
+
+	cell := &roomCell{
+		app:  app,
+		room: rm,
+	}
+	cell.ID = rm.cellID
+	cell.Inputs = []*std.Signal{&rm.changed}
+	cell.Compute = cell.computeMessages
+	cell.Attrs = cell.marshalRoom
+	return app.PinAndServe(cell, op)
+}
+
+// account is the synced state of the Matrix account.
+type account struct {
+	client
+	opts Opts
+
+	syncMu sync.Mutex // serializes syncs
+
+	mu    sync.Mutex
+	since string           // batch token of the most recent sync
+	rooms map[string]*room // joined rooms, by room ID
+	refs  int              // maintained pins -- see acquire()
+	stop  context.CancelFunc
+}
+
+// room is the most recently synced state of a joined room.
+type room struct {
+	id      string
+	cellID  tag.ID
+	changed std.Signal // notified when the room's name or messages change
+
+	mu       sync.Mutex
+	name     string
+	messages []*message          // oldest first
+	members  map[string]*amp.Tag // joined members, by user ID
+	typing   map[string]bool     // typing members, by user ID
+	pending  map[string]tag.ID   // transaction ID => cell ID of a sent message not yet synced
+	watchers map[*appInst]int    // app instances maintaining a pin of this room -- see App.Broadcast()
+}
+
+// message is an immutable snapshot of a message event.
+type message struct {
+	cellID  tag.ID
+	eventID string
+	sender  string
+	body    string
+	sent    time.Time
+}
+
+// ephemeral is a change to a room's members or typing participants.
+type ephemeral struct {
+	attrID tag.ID
+	userID string
+	val    *amp.Tag // empty if no longer present
+}
+
+func (acct *account) room(roomID string) *room {
+	acct.mu.Lock()
+	defer acct.mu.Unlock()
+	return acct.rooms[roomID]
+}
+
+// acquire starts syncing continuously if not already, until a matching number of calls to release().
+func (acct *account) acquire() {
+	acct.mu.Lock()
+	defer acct.mu.Unlock()
+	acct.refs++
+	if acct.refs == 1 {
+		ctx, stop := context.WithCancel(context.Background())
+		acct.stop = stop
+		go acct.syncLoop(ctx)
+	}
+}
+
+func (acct *account) release() {
+	acct.mu.Lock()
+	defer acct.mu.Unlock()
+	acct.refs--
+	if acct.refs == 0 {
+		acct.stop()
+		acct.stop = nil
+	}
+}
+
+func (acct *account) syncLoop(ctx context.Context) {
+	backoff := time.Second
+	for ctx.Err() == nil {
+		if err := acct.syncNext(ctx, false); err == nil {
+			backoff = time.Second
+			continue
+		}
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		}
+		backoff = min(2*backoff, time.Minute)
+	}
+}
+
+// syncNext syncs the account, waiting up to Opts.SyncTimeout for new events.
+// If initialOnly is set, the account is only synced if it never has been (and then without waiting).
+func (acct *account) syncNext(ctx context.Context, initialOnly bool) error {
+	acct.mu.Lock()
+	synced := acct.since != ""
+	acct.mu.Unlock()
+	if synced && initialOnly {
+		return nil
+	}
+
+	acct.syncMu.Lock()
+	defer acct.syncMu.Unlock()
+
+	acct.mu.Lock()
+	since := acct.since
+	acct.mu.Unlock()
+	if since != "" && initialOnly {
+		return nil // synced while waiting
+	}
+	timeout := acct.opts.SyncTimeout
+	if since == "" {
+		timeout = 0
+	}
+	resp, err := acct.sync(ctx, since, timeout, acct.opts.MaxMessages)
+	if err != nil {
+		return err
+	}
+	acct.apply(resp)
+	return nil
+}
+
+// apply applies a sync response, notifying each room's pins of changed messages and broadcasting changed members and typing participants.
+func (acct *account) apply(resp *syncResponse) {
+	for roomID, joined := range resp.Rooms.Join {
+		acct.mu.Lock()
+		rm := acct.rooms[roomID]
+		if rm == nil {
+			rm = &room{
+				id:       roomID,
+				cellID:   AppSpec.ID.WithToken(roomID),
+				members:  make(map[string]*amp.Tag),
+				typing:   make(map[string]bool),
+				pending:  make(map[string]tag.ID),
+				watchers: make(map[*appInst]int),
+			}
+			acct.rooms[roomID] = rm
+		}
+		acct.mu.Unlock()
+
+		var changes []ephemeral
+		changed := false
+
+		rm.mu.Lock()
+		for i := range joined.State.Events {
+			changed = rm.applyState(&joined.State.Events[i], &changes) || changed
+		}
+		for i := range joined.Timeline.Events {
+			ev := &joined.Timeline.Events[i]
+			if ev.isState() {
+				changed = rm.applyState(ev, &changes) || changed
+			} else {
+				changed = rm.applyTimeline(ev, acct.opts.MaxMessages) || changed
+			}
+		}
+		for _, ev := range joined.Ephemeral.Events {
+			if ev.Type == "m.typing" {
+				rm.applyTyping(ev.Content.UserIDs, &changes)
+			}
+		}
+		rm.mu.Unlock()
+
+		if changed {
+			rm.changed.Notify()
+		}
+		rm.broadcast(changes)
+	}
+
+	acct.mu.Lock()
+	acct.since = resp.NextBatch
+	acct.mu.Unlock()
+}
+
+// applyState applies a state event, returning true if the room's name changed.
+func (rm *room) applyState(ev *event, changes *[]ephemeral) bool {
+	if !ev.isState() {
+		return false
+	}
+	switch ev.Type {
+	case "m.room.name":
+		changed := rm.name != ev.Content.Name
+		rm.name = ev.Content.Name
+		return changed
+	case "m.room.member":
+		userID := *ev.StateKey
+		prev := rm.members[userID]
+		if ev.Content.Membership == "join" {
+			member := &amp.Tag{
+				UID:  userID,
+				Text: ev.Content.DisplayName,
+			}
+			if prev == nil || prev.Text != member.Text {
+				rm.members[userID] = member
+				*changes = append(*changes, ephemeral{std.CellMembers, userID, member})
+			}
+		} else if prev != nil {
+			delete(rm.members, userID)
+			*changes = append(*changes, ephemeral{std.CellMembers, userID, &amp.Tag{}})
+		}
+	}
+	return false
+}
+
+// applyTimeline applies a message, edit, or redaction event, returning true if the room's messages changed.
+func (rm *room) applyTimeline(ev *event, maxMessages int) bool {
+	switch ev.Type {
+	case "m.room.redaction":
+		return rm.removeMessage(ev.redacts())
+	case "m.room.message":
+		if target := ev.replaces(); target != "" {
+			return rm.editMessage(target, ev.Content.NewContent.Body)
+		}
+		cellID, sent := rm.pending[ev.Unsigned.TransactionID]
+		if sent {
+			delete(rm.pending, ev.Unsigned.TransactionID)
+		} else {
+			cellID = rm.cellID.Then(tag.FromToken(ev.EventID))
+		}
+		return rm.addMessage(&message{
+			cellID:  cellID,
+			eventID: ev.EventID,
+			sender:  ev.Sender,
+			body:    ev.Content.Body,
+			sent:    time.UnixMilli(ev.OriginTS),
+		}, maxMessages)
+	}
+	return false
+}
+
+func (rm *room) applyTyping(userIDs []string, changes *[]ephemeral) {
+	typing := make(map[string]bool, len(userIDs))
+	for _, userID := range userIDs {
+		typing[userID] = true
+		if !rm.typing[userID] {
+			*changes = append(*changes, ephemeral{std.CellTyping, userID, rm.participant(userID)})
+		}
+	}
+	for userID := range rm.typing {
+		if !typing[userID] {
+			*changes = append(*changes, ephemeral{std.CellTyping, userID, &amp.Tag{}})
+		}
+	}
+	rm.typing = typing
+}
+
+// participant returns the Tag presenting the given user.
+func (rm *room) participant(userID string) *amp.Tag {
+	if member := rm.members[userID]; member != nil {
+		return member
+	}
+	return &amp.Tag{
+		UID: userID,
+	}
+}
+
+func participantID(userID string) tag.ID {
+	return tag.FromToken(userID)
+}
+
+func (rm *room) broadcast(changes []ephemeral) {
+	if len(changes) == 0 {
+		return
+	}
+	rm.mu.Lock()
+	watchers := make([]*appInst, 0, len(rm.watchers))
+	for app := range rm.watchers {
+		watchers = append(watchers, app)
+	}
+	rm.mu.Unlock()
+
+	for _, app := range watchers {
+		for _, change := range changes {
+			app.Broadcast(rm.cellID, change.attrID, participantID(change.userID), change.val)
+		}
+	}
+}
+
+func (rm *room) watch(app *appInst, delta int) {
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+	count := rm.watchers[app] + delta
+	if count > 0 {
+		rm.watchers[app] = count
+	} else {
+		delete(rm.watchers, app)
+	}
+}
+
+func (rm *room) indexOf(eventID string) int {
+	for i, msg := range rm.messages {
+		if msg.eventID == eventID {
+			return i
+		}
+	}
+	return -1
+}
+
+// addMessage appends a message (unless already present), dropping the oldest past maxMessages.
+func (rm *room) addMessage(msg *message, maxMessages int) bool {
+	if rm.indexOf(msg.eventID) >= 0 {
+		return false
+	}
+	rm.messages = append(rm.messages, msg)
+	if over := len(rm.messages) - maxMessages; over > 0 {
+		rm.messages = append(rm.messages[:0:0], rm.messages[over:]...)
+	}
+	return true
+}
+
+func (rm *room) editMessage(eventID, body string) bool {
+	i := rm.indexOf(eventID)
+	if i < 0 || rm.messages[i].body == body {
+		return false
+	}
+	edited := *rm.messages[i]
+	edited.body = body
+	rm.messages[i] = &edited
+	return true
+}
+
+func (rm *room) removeMessage(eventID string) bool {
+	i := rm.indexOf(eventID)
+	if i < 0 {
+		return false
+	}
+	rm.messages = append(rm.messages[:i:i], rm.messages[i+1:]...)
+	return true
+}
+
+func (rm *room) messageByCell(cellID tag.ID) *message {
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+	for _, msg := range rm.messages {
+		if msg.cellID == cellID {
+			return msg
+		}
+	}
+	return nil
+}
+
+// commit sends, edits, or redacts each message committed in tx (and updates the account's typing state), then notifies the room's pins.
+func (acct *account) commit(ctx context.Context, rm *room, tx *amp.TxMsg) error {
+	changed := false
+	defer func() {
+		if changed {
+			rm.changed.Notify()
+		}
+	}()
+
+	for i, op := range tx.Ops {
+		switch {
+		case op.CellID == rm.cellID && op.AttrID == std.CellTyping:
+			val := amp.Tag{}
+			if op.OpCode != amp.TxOpCode_DeleteElement {
+				if err := tx.UnmarshalOpValue(i, &val); err != nil {
+					return err
+				}
+			}
+			if err := acct.setTyping(ctx, rm.id, acct.opts.UserID, val.UID != "" || val.Text != ""); err != nil {
+				return err
+			}
+
+		case op.CellID != rm.cellID && op.AttrID == std.CellProperties.ID && op.ItemID == std.CellLabel:
+			existing := rm.messageByCell(op.CellID)
+			if existing != nil && existing.sender != acct.opts.UserID {
+				return amp.ErrCode_InsufficientPermissions.Error("matrix: only the account's own messages can be changed")
+			}
+			switch op.OpCode {
+			case amp.TxOpCode_UpsertElement:
+				text := amp.Tag{}
+				if err := tx.UnmarshalOpValue(i, &text); err != nil {
+					return err
+				}
+				if existing != nil {
+					if err := acct.edit(ctx, rm, existing, text.Text); err != nil {
+						return err
+					}
+				} else if err := acct.sendText(ctx, rm, op.CellID, text.Text); err != nil {
+					return err
+				}
+				changed = true
+			case amp.TxOpCode_DeleteElement:
+				if existing == nil {
+					continue
+				}
+				if err := acct.redact(ctx, rm.id, existing.eventID, tag.Now().Base32()); err != nil {
+					return err
+				}
+				rm.mu.Lock()
+				rm.removeMessage(existing.eventID)
+				rm.mu.Unlock()
+				changed = true
+			}
+		}
+	}
+	return nil
+}
+
+// sendText sends a text message, adding it to the room as soon as the homeserver accepts it (rather than when it is next synced).
+// The transaction ID is derived from the cell ID, so a retried commit of the same cell is only sent once.
+func (acct *account) sendText(ctx context.Context, rm *room, cellID tag.ID, text string) error {
+	txnID := cellID.Base32()
+	rm.mu.Lock()
+	rm.pending[txnID] = cellID
+	rm.mu.Unlock()
+
+	eventID, err := acct.send(ctx, rm.id, "m.room.message", txnID, map[string]any{
+		"msgtype": "m.text",
+		"body":    text,
+	})
+
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+	delete(rm.pending, txnID)
+	if err != nil {
+		return err
+	}
+	rm.addMessage(&message{
+		cellID:  cellID,
+		eventID: eventID,
+		sender:  acct.opts.UserID,
+		body:    text,
+		sent:    time.Now(),
+	}, acct.opts.MaxMessages)
+	return nil
+}
+
+// edit replaces the body of one of the account's messages.
+func (acct *account) edit(ctx context.Context, rm *room, msg *message, text string) error {
+	_, err := acct.send(ctx, rm.id, "m.room.message", tag.Now().Base32(), map[string]any{
+		"msgtype": "m.text",
+		"body":    "* " + text, // fallback for clients without edit support
+		"m.new_content": map[string]any{
+			"msgtype": "m.text",
+			"body":    text,
+		},
+		"m.relates_to": map[string]any{
+			"rel_type": "m.replace",
+			"event_id": msg.eventID,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	rm.mu.Lock()
+	rm.editMessage(msg.eventID, text)
+	rm.mu.Unlock()
+	return nil
+}
+
+// roomCell presents a room's messages as child cells.
+type roomCell struct {
+	std.ComputedCell[*appInst]
+	app  *appInst
+	room *room
+}
+
+func (cell *roomCell) PinInto(pin *std.Pin[*appInst]) error {
+	if err := cell.ComputedCell.PinInto(pin); err != nil {
+		return err
+	}
+	if pin.Sync != amp.StateSync_Maintain {
+		return nil
+	}
+	rm, acct := cell.room, cell.app.acct
+	_, err := pin.Context().StartChild(&task.Task{
+		Info: task.Info{
+			Label: "sync: " + rm.id,
+		},
+		OnRun: func(ctx task.Context) {
+			rm.watch(cell.app, +1)
+			acct.acquire()
+			<-ctx.Closing()
+			acct.release()
+			rm.watch(cell.app, -1)
+		},
+	})
+	return err
+}
+
+func (cell *roomCell) computeMessages() ([]std.Cell[*appInst], error) {
+	rm := cell.room
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+
+	children := make([]std.Cell[*appInst], len(rm.messages))
+	for i, msg := range rm.messages {
+		author := rm.participant(msg.sender)
+		child := &messageCell{
+			msg:    msg,
+			author: author.Text,
+		}
+		if child.author == "" {
+			child.author = msg.sender
+		}
+		child.ID = msg.cellID
+		children[i] = child
+	}
+	return children, nil
+}
+
+// marshalRoom writes the room's name and its current members and typing participants as ephemeral elements.
+func (cell *roomCell) marshalRoom(w std.CellWriter) {
+	rm := cell.room
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+
+	name := rm.name
+	if name == "" {
+		name = rm.id
+	}
+	w.PutText(std.CellLabel, name)
+
+	op := amp.TxOp{}
+	op.OpCode = amp.TxOpCode_EphemeralElement
+	op.CellID = rm.cellID
+	op.AttrID = std.CellMembers
+	for userID, member := range rm.members {
+		op.ItemID = participantID(userID)
+		w.Upsert(&op, member)
+	}
+	op.AttrID = std.CellTyping
+	for userID := range rm.typing {
+		op.ItemID = participantID(userID)
+		w.Upsert(&op, rm.participant(userID))
+	}
+}
+
+// messageCell presents a single message.
+type messageCell struct {
+	std.CellNode[*appInst]
+	msg    *message
+	author string
+}
+
+func (cell *messageCell) PinInto(pin *std.Pin[*appInst]) error {
+	return nil
+}
+
+func (cell *messageCell) MarshalAttrs(w std.CellWriter) {
+	msg := cell.msg
+	w.PutText(std.CellLabel, msg.body)
+	w.PutText(std.CellAuthor, cell.author)
+	sent := &amp.Tag{}
+	sent.SetFromTime(msg.sent)
+	w.PutItem(std.OrderByTimeID, sent)
+}
@@ -0,0 +1,200 @@
+package matrix
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+)
+
+// client makes Matrix client-server API requests on behalf of a single account.
+type client struct {
+	http  *http.Client
+	base  string // homeserver URL, without a trailing slash
+	token string
+}
+
+// event is a Matrix room event, with the content fields this app uses.
+type event struct {
+	EventID  string  `json:"event_id"`
+	Type     string  `json:"type"`
+	Sender   string  `json:"sender"`
+	StateKey *string `json:"state_key"`
+	OriginTS int64   `json:"origin_server_ts"`
+	Redacts  string  `json:"redacts"` // room versions < 11
+	Content  struct {
+		MsgType     string   `json:"msgtype"`
+		Body        string   `json:"body"`
+		Membership  string   `json:"membership"`
+		DisplayName string   `json:"displayname"`
+		Name        string   `json:"name"`
+		UserIDs     []string `json:"user_ids"`
+		Redacts     string   `json:"redacts"` // room versions >= 11
+		NewContent  *struct {
+			Body string `json:"body"`
+		} `json:"m.new_content"`
+		RelatesTo *struct {
+			RelType string `json:"rel_type"`
+			EventID string `json:"event_id"`
+		} `json:"m.relates_to"`
+	} `json:"content"`
+	Unsigned struct {
+		TransactionID string `json:"transaction_id"`
+	} `json:"unsigned"`
+}
+
+type syncResponse struct {
+	NextBatch string `json:"next_batch"`
+	Rooms     struct {
+		Join map[string]struct {
+			State     struct{ Events []event } `json:"state"`
+			Timeline  struct{ Events []event } `json:"timeline"`
+			Ephemeral struct{ Events []event } `json:"ephemeral"`
+		} `json:"join"`
+	} `json:"rooms"`
+}
+
+type errorResponse struct {
+	ErrCode string `json:"errcode"`
+	Error   string `json:"error"`
+}
+
+// call makes a client-server API request, decoding the JSON response into out (if non-nil).
+func (c *client) call(ctx context.Context, method, path string, query url.Values, body, out any) error {
+	href := c.base + "/_matrix/client/v3" + path
+	if len(query) > 0 {
+		href += "?" + query.Encode()
+	}
+	var reqBody io.Reader
+	if body != nil {
+		buf, err := json.Marshal(body)
+		if err != nil {
+			return amp.ErrCode_BadValue.Errorf("matrix: %v", err)
+		}
+		reqBody = bytes.NewReader(buf)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, href, reqBody)
+	if err != nil {
+		return amp.ErrCode_BadRequest.Errorf("matrix: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return amp.ErrCode_ProviderErr.Errorf("matrix: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var errResp errorResponse
+		json.NewDecoder(io.LimitReader(resp.Body, 4096)).Decode(&errResp)
+		switch {
+		case resp.StatusCode == http.StatusUnauthorized:
+			return amp.ErrCode_AuthFailed.Errorf("matrix: %s %s", errResp.ErrCode, errResp.Error)
+		case resp.StatusCode == http.StatusForbidden:
+			return amp.ErrCode_InsufficientPermissions.Errorf("matrix: %s %s", errResp.ErrCode, errResp.Error)
+		case resp.StatusCode == http.StatusNotFound:
+			return amp.ErrCode_CellNotFound.Errorf("matrix: %s %s", errResp.ErrCode, errResp.Error)
+		default:
+			return amp.ErrCode_ProviderErr.Errorf("matrix: %s %s %s", resp.Status, errResp.ErrCode, errResp.Error)
+		}
+	}
+	if out == nil {
+		return nil
+	}
+	if err = json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return amp.ErrCode_ProviderErr.Errorf("matrix: malformed response: %v", err)
+	}
+	return nil
+}
+
+func roomPath(roomID string, parts ...string) string {
+	path := "/rooms/" + url.PathEscape(roomID)
+	for _, part := range parts {
+		path += "/" + url.PathEscape(part)
+	}
+	return path
+}
+
+// sync returns the events since the given batch token (or the latest of each joined room if empty), waiting up to timeout for new events.
+func (c *client) sync(ctx context.Context, since string, timeout time.Duration, timelineLimit int) (*syncResponse, error) {
+	query := url.Values{}
+	query.Set("timeout", strconv.FormatInt(timeout.Milliseconds(), 10))
+	query.Set("filter", `{"room":{"timeline":{"limit":`+strconv.Itoa(timelineLimit)+`}}}`)
+	if since != "" {
+		query.Set("since", since)
+	}
+	resp := &syncResponse{}
+	if err := c.call(ctx, http.MethodGet, "/sync", query, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// resolveAlias returns the room ID of a room alias (e.g. "#lobby:example.org").
+func (c *client) resolveAlias(ctx context.Context, alias string) (string, error) {
+	var resp struct {
+		RoomID string `json:"room_id"`
+	}
+	if err := c.call(ctx, http.MethodGet, "/directory/room/"+url.PathEscape(alias), nil, nil, &resp); err != nil {
+		return "", err
+	}
+	return resp.RoomID, nil
+}
+
+// send sends a room event, returning its event ID.
+// The transaction ID makes a retried send idempotent and is echoed back to this account via event.Unsigned.
+func (c *client) send(ctx context.Context, roomID, eventType, txnID string, content any) (string, error) {
+	var resp struct {
+		EventID string `json:"event_id"`
+	}
+	if err := c.call(ctx, http.MethodPut, roomPath(roomID, "send", eventType, txnID), nil, content, &resp); err != nil {
+		return "", err
+	}
+	return resp.EventID, nil
+}
+
+// redact redacts (deletes) a room event.
+func (c *client) redact(ctx context.Context, roomID, eventID, txnID string) error {
+	return c.call(ctx, http.MethodPut, roomPath(roomID, "redact", eventID, txnID), nil, map[string]any{}, nil)
+}
+
+// setTyping sets whether the given user is typing in a room.
+func (c *client) setTyping(ctx context.Context, roomID, userID string, typing bool) error {
+	body := map[string]any{
+		"typing": typing,
+	}
+	if typing {
+		body["timeout"] = 30000
+	}
+	return c.call(ctx, http.MethodPut, roomPath(roomID, "typing", userID), nil, body, nil)
+}
+
+// isState returns true if this is a state event.
+func (ev *event) isState() bool {
+	return ev.StateKey != nil
+}
+
+// redacts returns the ID of the event this redaction event redacts.
+func (ev *event) redacts() string {
+	if ev.Content.Redacts != "" {
+		return ev.Content.Redacts
+	}
+	return ev.Redacts
+}
+
+// replaces returns the ID of the message this event edits, if any.
+func (ev *event) replaces() string {
+	if rel := ev.Content.RelatesTo; rel != nil && rel.RelType == "m.replace" && ev.Content.NewContent != nil {
+		return rel.EventID
+	}
+	return ""
+}
@@ -0,0 +1,144 @@
+package matrix
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/amp/std"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+const testInitialSync = `{"next_batch": "s1", "rooms": {"join": {"!r:hs": {
+	"state": {"events": [
+		{"type": "m.room.name", "state_key": "", "content": {"name": "Lobby"}},
+		{"type": "m.room.member", "state_key": "@amp:hs", "content": {"membership": "join", "displayname": "Amp"}},
+		{"type": "m.room.member", "state_key": "@bo:hs", "content": {"membership": "join", "displayname": "Bo"}}
+	]},
+	"timeline": {"events": [
+		{"type": "m.room.message", "event_id": "$1", "sender": "@bo:hs", "origin_server_ts": 1700000000000, "content": {"msgtype": "m.text", "body": "hello"}}
+	]},
+	"ephemeral": {"events": [{"type": "m.typing", "content": {"user_ids": ["@bo:hs"]}}]}
+}}}}`
+
+func TestMatrix(t *testing.T) {
+	var (
+		mu        sync.Mutex
+		puts      = make(map[string]map[string]any)
+		sentTxnID string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		path := r.URL.EscapedPath()
+		switch {
+		case strings.HasSuffix(path, "/sync"):
+			if r.URL.Query().Get("since") == "" {
+				fmt.Fprint(w, testInitialSync)
+				return
+			}
+			// echo the sent message, then bo leaves and stops typing
+			fmt.Fprintf(w, `{"next_batch": "s2", "rooms": {"join": {"!r:hs": {
+				"timeline": {"events": [
+					{"type": "m.room.message", "event_id": "$2", "sender": "@amp:hs", "origin_server_ts": 1700000001000, "content": {"msgtype": "m.text", "body": "hi bo"}, "unsigned": {"transaction_id": %q}},
+					{"type": "m.room.member", "state_key": "@bo:hs", "content": {"membership": "leave"}},
+					{"type": "m.room.redaction", "event_id": "$3", "sender": "@bo:hs", "redacts": "$1", "content": {}}
+				]},
+				"ephemeral": {"events": [{"type": "m.typing", "content": {"user_ids": []}}]}
+			}}}}`, sentTxnID)
+		case r.Method == http.MethodPut:
+			body := make(map[string]any)
+			json.NewDecoder(r.Body).Decode(&body)
+			puts[path] = body
+			if strings.Contains(path, "/send/") {
+				sentTxnID = path[strings.LastIndex(path, "/")+1:]
+				fmt.Fprint(w, `{"event_id": "$2"}`)
+				return
+			}
+			fmt.Fprint(w, `{}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	acct := &account{
+		client: client{
+			http:  srv.Client(),
+			base:  srv.URL,
+			token: "secret",
+		},
+		opts: Opts{
+			UserID:      "@amp:hs",
+			MaxMessages: 10,
+		},
+		rooms: make(map[string]*room),
+	}
+	ctx := context.Background()
+	if err := acct.syncNext(ctx, true); err != nil {
+		t.Fatal(err)
+	}
+	rm := acct.room("!r:hs")
+	if rm == nil || rm.name != "Lobby" || len(rm.members) != 2 || !rm.typing["@bo:hs"] {
+		t.Fatalf("unexpected room: %+v", rm)
+	}
+	if len(rm.messages) != 1 || rm.messages[0].body != "hello" || rm.messages[0].cellID != rm.cellID.Then(tag.FromToken("$1")) {
+		t.Fatalf("unexpected messages: %+v", rm.messages)
+	}
+
+	// send a message and start typing
+	cellID := tag.Now()
+	tx := amp.NewTxMsg(true)
+	op := amp.TxOp{}
+	op.OpCode = amp.TxOpCode_UpsertElement
+	op.CellID = cellID
+	op.AttrID = std.CellProperties.ID
+	op.ItemID = std.CellLabel
+	if err := tx.MarshalOp(&op, &amp.Tag{Text: "hi bo"}); err != nil {
+		t.Fatal(err)
+	}
+	op.OpCode = amp.TxOpCode_EphemeralElement
+	op.CellID = rm.cellID
+	op.AttrID = std.CellTyping
+	op.ItemID = participantID("@amp:hs")
+	if err := tx.MarshalOp(&op, &amp.Tag{UID: "@amp:hs"}); err != nil {
+		t.Fatal(err)
+	}
+	rev := rm.changed.Revision()
+	if err := acct.commit(ctx, rm, tx); err != nil {
+		t.Fatal(err)
+	}
+	if rm.changed.Revision() == rev {
+		t.Fatal("commit should notify the room's pins")
+	}
+	if sentTxnID != cellID.Base32() || puts["/_matrix/client/v3/rooms/%21r:hs/send/m.room.message/"+sentTxnID]["body"] != "hi bo" {
+		t.Fatalf("unexpected send: %v %v", sentTxnID, puts)
+	}
+	if puts["/_matrix/client/v3/rooms/%21r:hs/typing/@amp:hs"]["typing"] != true {
+		t.Fatalf("unexpected typing: %v", puts)
+	}
+	if msg := rm.messageByCell(cellID); msg == nil || msg.eventID != "$2" {
+		t.Fatal("sent message should be added with the committed cell ID")
+	}
+
+	// the echo of the sent message is not duplicated
+	if err := acct.syncNext(ctx, false); err != nil {
+		t.Fatal(err)
+	}
+	if len(rm.messages) != 1 || rm.messages[0].cellID != cellID {
+		t.Fatalf("unexpected messages after sync: %+v", rm.messages)
+	}
+	if len(rm.members) != 1 || len(rm.typing) != 0 || acct.since != "s2" {
+		t.Fatalf("unexpected room after sync: %+v", rm)
+	}
+}
@@ -0,0 +1,118 @@
+// Package presence implements the "presence:" sys app, which exposes the users currently pinning a given cell, updated live as pins open and close.
+package presence
+
+import (
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/amp/std"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+var AppSpec = amp.AppSpec.With("sys.presence")
+
+// CellParam is the pin URL query parameter holding the base32 ID of the cell whose presence is pinned.
+const CellParam = "cell"
+
+// RegisterApp registers the presence app, invoked via "presence:?cell={cellID}", where the session's user must be able to read the cell.
+// The pinned cell has a child cell per present user, each with a CellLabel and CellPresence property.
+func RegisterApp(reg amp.Registry) error {
+	return reg.RegisterApp(&amp.App{
+		AppSpec:     AppSpec,
+		Desc:        "users currently viewing a cell",
+		Version:     "v1.0.0",
+		Invocations: []string{"presence"},
+		NewAppInstance: func(ctx amp.AppContext) (amp.AppInstance, error) {
+			app := &appInst{}
+			app.AppContext = ctx
+			app.Instance = app
+			return app, nil
+		},
+	})
+}
+
+type appInst struct {
+	std.App[*appInst]
+}
+
+func (app *appInst) ServeRequest(op amp.Requester) (amp.Pin, error) {
+	req := op.Request()
+
+	var cellID tag.ID
+	if req.Values != nil {
+		cellID, _ = tag.FromBase32(req.Values.Get(CellParam))
+	}
+	if cellID.IsNil() {
+		return nil, amp.ErrCode_BadRequest.Errorf("presence: missing %q param", CellParam)
+	}
+	sess := app.Session()
+	services := amp.ServicesOf(sess)
+	table := services.Presence
+	if table == nil {
+		return nil, amp.ErrUnimplemented
+	}
+
+	// who is viewing a cell is only visible to those who may read it
+	login := sess.Login()
+	access := services.AccessControl
+	if login.UserID == nil || access == nil {
+		return nil, amp.ErrAccessDenied
+	}
+	if err := access.CanReadCell(login.UserID.AsID(), cellID); err != nil {
+		return nil, err
+	}
+
+	cell := &presenceCell{
+		table:  table,
+		cellID: cellID,
+	}
+	cell.Inputs = []*std.Signal{&cell.changed}
+	cell.Compute = cell.computeUsers
+	return app.PinAndServe(cell, op)
+}
+
+// presenceCell has a child cell for each user pinning cellID.
+type presenceCell struct {
+	std.ComputedCell[*appInst]
+	table   amp.PresenceTable
+	cellID  tag.ID
+	changed std.Signal
+}
+
+func (cell *presenceCell) PinInto(pin *std.Pin[*appInst]) error {
+	if pin.Sync == amp.StateSync_Maintain {
+		cell.table.WatchPresence(pin.Context(), cell.cellID, func([]*amp.Presence) {
+			cell.changed.Notify()
+		})
+	}
+	return cell.ComputedCell.PinInto(pin)
+}
+
+// Each user's cell ID is derived from the user's ID so it is stable as others come and go.
+func (cell *presenceCell) computeUsers() ([]std.Cell[*appInst], error) {
+	present := cell.table.GetPresence(cell.cellID)
+
+	children := make([]std.Cell[*appInst], len(present))
+	for i, pi := range present {
+		child := &userCell{
+			presence: pi,
+		}
+		userID := *pi.UserID
+		child.ID = cell.cellID.With(userID.AsID())
+		children[i] = child
+	}
+	return children, nil
+}
+
+// userCell presents a single user pinning the cell.
+type userCell struct {
+	std.CellNode[*appInst]
+	presence *amp.Presence
+}
+
+func (cell *userCell) PinInto(pin *std.Pin[*appInst]) error {
+	return nil
+}
+
+func (cell *userCell) MarshalAttrs(w std.CellWriter) {
+	w.PutText(std.CellLabel, cell.presence.UserID.AsLiteral())
+	w.PutItem(std.CellPresence, cell.presence)
+}
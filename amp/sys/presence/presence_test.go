@@ -0,0 +1,151 @@
+package presence
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/amp/std"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/media"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// testSession implements only what serving a presence pin uses.
+type testSession struct {
+	amp.Session
+	userID   tag.ID
+	presence amp.PresenceTable
+	readable tag.ID // the only cell the user may read
+}
+
+func (sess *testSession) Login() amp.Login {
+	userID := &amp.Tag{}
+	userID.SetID(sess.userID)
+	return amp.Login{UserID: userID}
+}
+
+func (sess *testSession) Services() *amp.Services {
+	return &amp.Services{
+		Presence:      sess.presence,
+		AccessControl: testAccess{sess},
+	}
+}
+
+// testAccess allows the session's user to read only the session's readable cell.
+type testAccess struct {
+	*testSession
+}
+
+func (ac testAccess) CanReadCell(userID, cellID tag.ID) error {
+	if userID != ac.userID || cellID != ac.readable {
+		return amp.ErrAccessDenied
+	}
+	return nil
+}
+
+func (ac testAccess) UserForToken(accessToken string) (tag.ID, error) {
+	return tag.ID{}, amp.ErrNoAuthToken
+}
+func (ac testAccess) HasRole(userID tag.ID, role string) bool { return false }
+
+// testContext is an amp.AppContext running within a task tree.
+type testContext struct {
+	task.Context
+	media.Publisher
+	sess *testSession
+}
+
+func (ctx *testContext) Session() amp.Session                          { return ctx.sess }
+func (ctx *testContext) LocalDataPath() string                         { return "" }
+func (ctx *testContext) GetAppAttr(attrID tag.ID, dst tag.Value) error { return amp.ErrCellNotFound }
+func (ctx *testContext) PutAppAttr(attrID tag.ID, src tag.Value) error { return nil }
+func (ctx *testContext) CellStore() amp.CellStore                      { return nil }
+
+// testRequester records the txs pushed to it.
+type testRequester struct {
+	req amp.Request
+	txs chan *amp.TxMsg
+}
+
+func (op *testRequester) Request() *amp.Request { return &op.req }
+func (op *testRequester) OnComplete(err error)  {}
+
+func (op *testRequester) PushTx(tx *amp.TxMsg) error {
+	tx.AddRef()
+	op.txs <- tx
+	return nil
+}
+
+func (op *testRequester) nextTx(t *testing.T) *amp.TxMsg {
+	t.Helper()
+	select {
+	case tx := <-op.txs:
+		return tx
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for a tx")
+		return nil
+	}
+}
+
+func TestPresence(t *testing.T) {
+	ctx, _ := task.Start(&task.Task{Info: task.Info{Label: "presence"}})
+	defer ctx.Close()
+
+	viewedID := tag.Now()
+	sess := &testSession{
+		userID:   tag.Now(),
+		presence: amp.NewPresenceTable(),
+		readable: viewedID,
+	}
+	app := &appInst{}
+	app.AppContext = &testContext{Context: ctx, sess: sess}
+	app.Instance = app
+
+	pinPresence := func(cellID tag.ID) (*testRequester, error) {
+		op := &testRequester{txs: make(chan *amp.TxMsg, 16)}
+		op.req.StateSync = amp.StateSync_Maintain
+		op.req.Values = url.Values{CellParam: {cellID.Base32()}}
+		_, err := app.ServeRequest(op)
+		return op, err
+	}
+
+	// presence of a cell the user can't read is not exposed
+	if _, err := pinPresence(tag.Now()); err != amp.ErrAccessDenied {
+		t.Fatalf("expected ErrAccessDenied, got %v", err)
+	}
+
+	alice, bob := &amp.Tag{}, &amp.Tag{}
+	alice.SetID(tag.Now())
+	bob.SetID(tag.Now())
+	sess.presence.UpdatePresence(viewedID, alice, +1)
+	sess.presence.UpdatePresence(viewedID, bob, +1)
+
+	op, err := pinPresence(viewedID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	children := func(tx *amp.TxMsg, opCode amp.TxOpCode) (childIDs []tag.ID) {
+		for _, op := range tx.Ops {
+			if op.AttrID == std.CellChildren.ID && op.OpCode == opCode && op.CellID != amp.MetaNodeID {
+				childIDs = append(childIDs, op.ItemID)
+			}
+		}
+		return childIDs
+	}
+	if present := children(op.nextTx(t), amp.TxOpCode_UpsertElement); len(present) != 2 {
+		t.Fatalf("expected 2 present users, got %d", len(present))
+	}
+
+	// a user leaving is deleted from the client
+	sess.presence.UpdatePresence(viewedID, bob, -1)
+	tx := op.nextTx(t)
+	left := children(tx, amp.TxOpCode_DeleteElement)
+	if len(left) != 1 || left[0] != viewedID.With(bob.AsID()) {
+		t.Fatalf("expected bob's cell to be deleted, got %v", left)
+	}
+	if present := children(tx, amp.TxOpCode_UpsertElement); len(present) != 1 || present[0] != viewedID.With(alice.AsID()) {
+		t.Fatalf("expected alice to remain, got %v", present)
+	}
+}
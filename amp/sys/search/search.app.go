@@ -0,0 +1,170 @@
+// Package search implements the "search:" sys app, which fans out a query to every app offering federated search and streams merged, ranked hits as child cells.
+package search
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/amp/std"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+var AppSpec = amp.AppSpec.With("sys.search")
+
+// RegisterApp registers the federated search app, invoked via "search:?q={text}[&limit={n}][&attrs={spec},...]".
+// If given, attrs restricts the search to the listed attr specs; the pin's own attrs select what is returned for each hit.
+func RegisterApp(reg amp.Registry) error {
+	return reg.RegisterApp(&amp.App{
+		AppSpec:     AppSpec,
+		Desc:        "federated search across apps offering App.SearchAttrs",
+		Version:     "v1.0.0",
+		Invocations: []string{"search"},
+		NewAppInstance: func(ctx amp.AppContext) (amp.AppInstance, error) {
+			app := &appInst{}
+			app.AppContext = ctx
+			app.Instance = app
+			return app, nil
+		},
+	})
+}
+
+type appInst struct {
+	std.App[*appInst]
+}
+
+func (app *appInst) ServeRequest(op amp.Requester) (amp.Pin, error) {
+	req := op.Request()
+	query := amp.SearchQuery{
+		Text: std.TextQuery(req),
+	}
+	if req.Values != nil {
+		query.Limit, _ = strconv.Atoi(req.Values.Get("limit"))
+	}
+	if query.Text == "" {
+		return nil, amp.ErrCode_BadRequest.Error("search: missing query")
+	}
+	if req.Values != nil {
+		for _, spec := range strings.Split(req.Values.Get("attrs"), ",") {
+			if spec = strings.TrimSpace(spec); spec != "" {
+				query.Attrs = append(query.Attrs, tag.ParseSpec(spec).ID)
+			}
+		}
+	}
+
+	cell := &resultsCell{
+		app:   app,
+		query: query,
+	}
+	cell.Inputs = []*std.Signal{&cell.changed}
+	cell.Compute = cell.computeHits
+	return app.PinAndServe(cell, op)
+}
+
+// resultsCell merges hits as each searcher responds, exporting a child cell per hit.
+type resultsCell struct {
+	std.ComputedCell[*appInst]
+	app     *appInst
+	query   amp.SearchQuery
+	changed std.Signal
+
+	mu   sync.Mutex
+	hits [][]amp.SearchHit // hits from each searcher that has responded
+}
+
+func (cell *resultsCell) PinInto(pin *std.Pin[*appInst]) error {
+	searchers := cell.searchers()
+
+	var wg sync.WaitGroup
+	wg.Add(len(searchers))
+	for _, si := range searchers {
+		searcher := si
+		_, err := pin.Context().Go("searcher", func(ctx task.Context) {
+			defer wg.Done()
+			hits, err := searcher.Search(ctx, cell.query)
+			if err != nil {
+				ctx.Log().Warnf("search failed: %v", err)
+				return
+			}
+			cell.mu.Lock()
+			cell.hits = append(cell.hits, hits)
+			cell.mu.Unlock()
+			cell.changed.Notify()
+		})
+		if err != nil {
+			wg.Done()
+		}
+	}
+
+	// A snapshot pin waits for all searchers; a maintained pin streams hits as they arrive.
+	if pin.Sync != amp.StateSync_Maintain {
+		wg.Wait()
+	}
+	return cell.ComputedCell.PinInto(pin)
+}
+
+// Returns each app registered with App.SearchAttrs that implements amp.Searcher, starting the app if needed.
+func (cell *resultsCell) searchers() []amp.Searcher {
+	sess := cell.app.Session()
+
+	var searchers []amp.Searcher
+	for _, app := range sess.ListApps() {
+		if len(app.SearchAttrs) == 0 || app.AppSpec.ID == AppSpec.ID {
+			continue
+		}
+		inst, err := sess.GetAppInstance(app.AppSpec.ID, true)
+		if err != nil {
+			cell.app.Log().Warnf("search: %v", err)
+			continue
+		}
+		if searcher, ok := inst.(amp.Searcher); ok {
+			searchers = append(searchers, searcher)
+		}
+	}
+	return searchers
+}
+
+// Each hit's child ID is derived from its app and matching cell so a recompute updates (rather than replaces) hits already sent.
+func (cell *resultsCell) computeHits() ([]std.Cell[*appInst], error) {
+	cell.mu.Lock()
+	merged := amp.MergeSearchHits(cell.query.Limit, cell.hits...)
+	cell.mu.Unlock()
+
+	children := make([]std.Cell[*appInst], len(merged))
+	for i, hit := range merged {
+		child := &hitCell{
+			hit:  hit,
+			rank: i,
+		}
+		child.ID = cell.ID.With(hit.AppID).With(hit.CellID)
+		children[i] = child
+	}
+	return children, nil
+}
+
+// hitCell presents a single search hit, linking to the matching cell.
+type hitCell struct {
+	std.CellNode[*appInst]
+	hit  amp.SearchHit
+	rank int // position in the merged hits
+}
+
+func (cell *hitCell) PinInto(pin *std.Pin[*appInst]) error {
+	return nil
+}
+
+func (cell *hitCell) MarshalAttrs(w std.CellWriter) {
+	w.PutText(std.CellLabel, cell.hit.Label)
+
+	link := &amp.Tag{
+		URL: cell.hit.URL,
+	}
+	link.SetID(cell.hit.CellID)
+	w.PutItem(std.CellLink, link)
+
+	rank := &amp.Tag{}
+	rank.SetID(tag.ID{0, 0, uint64(cell.rank)})
+	w.PutItem(std.OrderByRankID, rank)
+}
@@ -0,0 +1,177 @@
+// Package tasks implements the "tasks:" sys app, which exposes the host's task.Context tree, refreshed live,
+// so that a stuck task (e.g. one holding up host shutdown) can be found by inspection.
+package tasks
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/amp/std"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+var AppSpec = amp.AppSpec.With("sys.tasks")
+
+const (
+	TIDParam     = "tid"     // pin URL query parameter holding the TID of the task to pin; if absent, the host's root task
+	RefreshParam = "refresh" // pin URL query parameter holding how often a maintained pin is refreshed (e.g. "500ms"); if absent, 1s
+)
+
+// RegisterApp registers the tasks app, invoked via "tasks:?tid={TID}".
+// The pinned cell has a child cell per child task, each with a CellLabel (the task's label) and CellCaption (its state, age, and child count).
+// A child task is pinned in turn by its TID, so the tree can be navigated from the host's root task down to a stuck task.
+func RegisterApp(reg amp.Registry) error {
+	return reg.RegisterApp(&amp.App{
+		AppSpec:     AppSpec,
+		Desc:        "host task tree",
+		Version:     "v1.0.0",
+		Invocations: []string{"tasks"},
+		NewAppInstance: func(ctx amp.AppContext) (amp.AppInstance, error) {
+			app := &appInst{}
+			app.AppContext = ctx
+			app.Instance = app
+			return app, nil
+		},
+	})
+}
+
+type appInst struct {
+	std.App[*appInst]
+}
+
+func (app *appInst) ServeRequest(op amp.Requester) (amp.Pin, error) {
+	req := op.Request()
+	host := amp.ServicesOf(app.Session()).HostContext
+	if host == nil {
+		return nil, amp.ErrUnimplemented
+	}
+
+	cell := &tasksCell{
+		host:    host,
+		refresh: time.Second,
+	}
+	if req.Values != nil {
+		if str := req.Values.Get(TIDParam); str != "" {
+			tid, err := strconv.ParseInt(str, 10, 64)
+			if err != nil {
+				return nil, amp.ErrCode_BadRequest.Errorf("tasks: bad %q param", TIDParam)
+			}
+			cell.tid = tid
+		}
+		if str := req.Values.Get(RefreshParam); str != "" {
+			refresh, err := time.ParseDuration(str)
+			if err != nil || refresh <= 0 {
+				return nil, amp.ErrCode_BadRequest.Errorf("tasks: bad %q param", RefreshParam)
+			}
+			cell.refresh = refresh
+		}
+	}
+	if cell.inspect() == nil {
+		return nil, amp.ErrCellNotFound
+	}
+
+	cell.ID = taskCellID(cell.tid)
+	cell.Inputs = []*std.Signal{&cell.changed}
+	cell.Compute = cell.computeChildren
+	cell.Attrs = cell.marshalAttrs
+	return app.PinAndServe(cell, op)
+}
+
+// tasksCell presents a task and has a child cell for each of its child tasks.
+type tasksCell struct {
+	std.ComputedCell[*appInst]
+	host    task.Context
+	tid     int64 // if 0, the host's root task
+	refresh time.Duration
+	changed std.Signal
+	state   *task.TaskState // as of the last inspect()
+}
+
+// inspect snapshots the pinned task's tree, returning nil if the task has since completed.
+func (cell *tasksCell) inspect() *task.TaskState {
+	state := task.Inspect(cell.host)
+	if cell.tid != 0 {
+		state = state.Find(cell.tid)
+	}
+	cell.state = state
+	return state
+}
+
+func (cell *tasksCell) PinInto(pin *std.Pin[*appInst]) error {
+	if pin.Sync == amp.StateSync_Maintain {
+		pin.Context().Go("tasks refresh", func(ctx task.Context) {
+			ticker := time.NewTicker(cell.refresh)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ticker.C:
+					cell.changed.Notify()
+				case <-ctx.Closing():
+					return
+				}
+			}
+		})
+	}
+	return cell.ComputedCell.PinInto(pin)
+}
+
+// Each child's cell ID is derived from its TID so it is stable across refreshes.
+func (cell *tasksCell) computeChildren() ([]std.Cell[*appInst], error) {
+	state := cell.inspect()
+	if state == nil {
+		return nil, nil
+	}
+	children := make([]std.Cell[*appInst], len(state.Children))
+	for i, ci := range state.Children {
+		child := &taskCell{
+			state: ci,
+		}
+		child.ID = taskCellID(ci.TID)
+		children[i] = child
+	}
+	return children, nil
+}
+
+func (cell *tasksCell) marshalAttrs(w std.CellWriter) {
+	state := cell.state
+	if state == nil {
+		w.PutText(std.CellCaption, "completed")
+		return
+	}
+	w.PutText(std.CellLabel, state.Label)
+	w.PutText(std.CellCaption, caption(state))
+	if stuck := state.Stuck(); len(stuck) > 0 {
+		synopsis := "stuck closing:"
+		for _, ti := range stuck {
+			synopsis += fmt.Sprintf("\n  %s (tid %d, %s)", ti.Label, ti.TID, ti.Age.Round(time.Millisecond))
+		}
+		w.PutText(std.CellSynopsis, synopsis)
+	}
+}
+
+// taskCell presents a single child task.
+type taskCell struct {
+	std.CellNode[*appInst]
+	state *task.TaskState
+}
+
+func (cell *taskCell) PinInto(pin *std.Pin[*appInst]) error {
+	return nil
+}
+
+func (cell *taskCell) MarshalAttrs(w std.CellWriter) {
+	w.PutText(std.CellLabel, cell.state.Label)
+	w.PutText(std.CellCaption, caption(cell.state))
+}
+
+func taskCellID(tid int64) tag.ID {
+	return AppSpec.ID.With(tag.IntsToID(0, 0, uint64(tid)))
+}
+
+// caption summarizes a task, e.g. "closing, 3m12s, 2 children"
+func caption(state *task.TaskState) string {
+	return fmt.Sprintf("%s, %s, %d children", state.State, state.Age.Round(time.Second), len(state.Children))
+}
@@ -0,0 +1,141 @@
+// Package user implements the "user:" sys app, which serves the session user's profile and settings (std.UserProfile) at a well-known URL, so every client and app reads and writes them the same way.
+package user
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/amp/std"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+var AppSpec = amp.AppSpec.With("sys.user")
+
+// RegisterApp registers the user app, invoked via "user:".
+// The pinned cell has a CellLabel (the display name) and a CellUserProfile property, updated live as the profile changes.
+// A request committing a tx bearing a CellUserProfile property is merged into the stored profile (see std.UserProfile.Merge) before the profile is served.
+func RegisterApp(reg amp.Registry) error {
+	return reg.RegisterApp(&amp.App{
+		AppSpec:     AppSpec,
+		Desc:        "user profile and settings",
+		Version:     "v1.0.0",
+		Invocations: []string{"user"},
+		NewAppInstance: func(ctx amp.AppContext) (amp.AppInstance, error) {
+			app := &appInst{}
+			app.AppContext = ctx
+			app.Instance = app
+			return app, nil
+		},
+	})
+}
+
+// userState is shared by all of a user's sessions, so that profile writes are serialized and each of the user's pins stays current.
+type userState struct {
+	mu      sync.Mutex // serializes profile read-merge-write
+	changed std.Signal // notified as the profile is written
+}
+
+var users struct {
+	sync.Mutex
+	byID map[tag.ID]*userState
+}
+
+func userStateOf(userID tag.ID) *userState {
+	users.Lock()
+	defer users.Unlock()
+
+	if users.byID == nil {
+		users.byID = make(map[tag.ID]*userState)
+	}
+	state := users.byID[userID]
+	if state == nil {
+		state = &userState{}
+		users.byID[userID] = state
+	}
+	return state
+}
+
+type appInst struct {
+	std.App[*appInst]
+}
+
+func (app *appInst) ServeRequest(op amp.Requester) (amp.Pin, error) {
+	login := app.Session().Login()
+	if login.UserID == nil {
+		return nil, amp.ErrAccessDenied
+	}
+	user := *login.UserID
+	userID := user.AsID()
+
+	cell := &profileCell{
+		app:    app,
+		elemID: amp.ElementID{AppSpec.ID.With(userID), std.CellProperties.ID, std.CellUserProfile},
+		user:   userStateOf(userID),
+	}
+	cell.ID = cell.elemID[0]
+
+	if tx := op.Request().CommitTx; tx != nil {
+		update := &std.UserProfile{}
+		if err := tx.LoadItem(std.CellProperties.ID, std.CellUserProfile, update); err != nil {
+			return nil, err
+		}
+		if err := cell.merge(update); err != nil {
+			return nil, err
+		}
+	}
+
+	cell.Inputs = []*std.Signal{&cell.user.changed}
+	cell.Compute = cell.loadProfile
+	cell.Attrs = cell.marshalProfile
+	return app.PinAndServe(cell, op)
+}
+
+// profileCell presents the user's profile as its own attrs and has no children.
+type profileCell struct {
+	std.ComputedCell[*appInst]
+	app     *appInst
+	elemID  amp.ElementID
+	user    *userState
+	profile atomic.Pointer[std.UserProfile] // as of the last loadProfile()
+}
+
+// merge applies the given update to the stored profile and notifies the user's pins.
+func (cell *profileCell) merge(update *std.UserProfile) error {
+	store := cell.app.CellStore()
+
+	cell.user.mu.Lock()
+	profile := &std.UserProfile{}
+	err := store.GetElement(cell.elemID, profile)
+	if err == nil || errors.Is(err, amp.ErrCellNotFound) {
+		profile.Merge(update)
+		err = store.PutElement(cell.elemID, profile)
+	}
+	cell.user.mu.Unlock()
+
+	if err != nil {
+		return err
+	}
+	cell.user.changed.Notify()
+	return nil
+}
+
+func (cell *profileCell) loadProfile() ([]std.Cell[*appInst], error) {
+	profile := &std.UserProfile{}
+	err := cell.app.CellStore().GetElement(cell.elemID, profile)
+	if err != nil && !errors.Is(err, amp.ErrCellNotFound) {
+		return nil, err
+	}
+	cell.profile.Store(profile)
+	return nil, nil
+}
+
+func (cell *profileCell) marshalProfile(w std.CellWriter) {
+	profile := cell.profile.Load()
+	if profile == nil {
+		return
+	}
+	w.PutText(std.CellLabel, profile.DisplayName)
+	w.PutItem(std.CellUserProfile, profile)
+}
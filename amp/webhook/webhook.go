@@ -0,0 +1,385 @@
+// Package webhook implements a HostService that POSTs a JSON payload to registered URLs when matching cells change,
+// so external systems can react to amp state without holding a session.
+//
+// Each delivery is signed with the hook's secret in the Amp-Webhook-Signature header:
+//
+//	t={unix seconds},v1={hex HMAC-SHA256 of "{t}.{body}"}
+//
+// which a receiver checks with Verify.  A delivery failing with a network error, 408, 429, or 5xx is retried with exponential backoff.
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/amp/gateway"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+const (
+	SignatureHeader = "Amp-Webhook-Signature"
+	DeliveryHeader  = "Amp-Webhook-Delivery"
+)
+
+var (
+	ErrNotStarted   = amp.ErrCode_NotReady.Error("webhook service not started")
+	ErrHookNotFound = amp.ErrCode_BadRequest.Error("webhook not found")
+	ErrBadSignature = amp.ErrCode_AuthFailed.Error("webhook signature mismatch")
+)
+
+// Source reports committed txs, typically implemented by the host's cell store (see replica.Replica).
+type Source interface {
+
+	// Calls fn each time a tx affecting the subtree rooted at rootID is committed, until ctx closes.
+	// fn must not retain tx after returning.
+	WatchTxs(ctx task.Context, rootID tag.ID, fn func(tx *amp.TxMsg))
+}
+
+// Hook is a registered webhook.
+type Hook struct {
+	ID     tag.ID   // assigned by Register
+	URL    string   // receives a POST for each matching tx
+	Root   tag.ID   // cells in the subtree rooted at this cell are matched
+	Cells  []tag.ID // if set, only these cells (within Root's subtree) are matched
+	Attrs  []tag.ID // if set, only ops of these attrs are delivered
+	Secret []byte   // signs each delivery
+}
+
+// Payload is the JSON body POSTed to a hook for each matching tx, where ops are in the gateway's JSON form.
+type Payload struct {
+	Hook     string       `json:"hook"`     // base32 Hook.ID
+	Delivery string       `json:"delivery"` // base32 delivery ID, also sent in the Amp-Webhook-Delivery header and unchanged across retries
+	Tx       string       `json:"tx"`       // base32 GenesisID of the tx
+	Ops      []gateway.Op `json:"ops"`
+}
+
+// Opts specifies a webhook Service.
+type Opts struct {
+	Source      Source
+	Client      *http.Client  // if nil, a client with a 10s timeout
+	MaxAttempts int           // deliveries are dropped after this many attempts; if <= 0, 8
+	Backoff     time.Duration // delay before the first retry, doubling for each retry after; if <= 0, 1s
+	MaxBackoff  time.Duration // if <= 0, 10m
+	QueueSize   int           // deliveries buffered per hook before new ones are dropped; if <= 0, 1024
+}
+
+// Service is an amp.HostService delivering webhooks.
+type Service struct {
+	task.Context
+	opts  Opts
+	reg   amp.Registry
+	mu    sync.Mutex
+	hooks map[tag.ID]*hookRunner
+}
+
+var _ amp.HostService = (*Service)(nil)
+
+func NewService(opts Opts) *Service {
+	if opts.Client == nil {
+		opts.Client = &http.Client{Timeout: 10 * time.Second}
+	}
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = 8
+	}
+	if opts.Backoff <= 0 {
+		opts.Backoff = time.Second
+	}
+	if opts.MaxBackoff <= 0 {
+		opts.MaxBackoff = 10 * time.Minute
+	}
+	if opts.QueueSize <= 0 {
+		opts.QueueSize = 1024
+	}
+	return &Service{
+		opts:  opts,
+		hooks: make(map[tag.ID]*hookRunner),
+	}
+}
+
+func (svc *Service) StartService(on amp.Host) error {
+	ctx, err := on.StartChild(&task.Task{
+		Info: task.Info{
+			Label: "webhook",
+		},
+	})
+	if err != nil {
+		return err
+	}
+	svc.Context = ctx
+	svc.reg = on.HostRegistry()
+	return nil
+}
+
+func (svc *Service) GracefulStop() {
+}
+
+// Register starts delivering matching changes to the given hook, returning its assigned ID.
+func (svc *Service) Register(hook Hook) (tag.ID, error) {
+	if svc.Context == nil {
+		return tag.ID{}, ErrNotStarted
+	}
+	if !strings.HasPrefix(hook.URL, "https://") && !strings.HasPrefix(hook.URL, "http://") {
+		return tag.ID{}, amp.ErrCode_BadRequest.Errorf("bad webhook URL %q", hook.URL)
+	}
+	hook.ID = tag.Now()
+
+	runner := &hookRunner{
+		Hook:  hook,
+		svc:   svc,
+		queue: make(chan delivery, svc.opts.QueueSize),
+	}
+	var err error
+	runner.ctx, err = svc.Context.StartChild(&task.Task{
+		Info: task.Info{
+			Label: "hook " + hook.URL,
+		},
+		OnRun: runner.run,
+		OnClosed: func() {
+			svc.mu.Lock()
+			delete(svc.hooks, hook.ID)
+			svc.mu.Unlock()
+		},
+	})
+	if err != nil {
+		return tag.ID{}, err
+	}
+
+	svc.mu.Lock()
+	svc.hooks[hook.ID] = runner
+	svc.mu.Unlock()
+
+	svc.opts.Source.WatchTxs(runner.ctx, hook.Root, runner.onTx)
+	return hook.ID, nil
+}
+
+// Unregister stops deliveries to the given hook, dropping any pending.
+func (svc *Service) Unregister(hookID tag.ID) error {
+	svc.mu.Lock()
+	runner := svc.hooks[hookID]
+	svc.mu.Unlock()
+	if runner == nil {
+		return ErrHookNotFound
+	}
+	return runner.ctx.Close()
+}
+
+// Hooks returns the registered hooks.
+func (svc *Service) Hooks() []Hook {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	hooks := make([]Hook, 0, len(svc.hooks))
+	for _, runner := range svc.hooks {
+		hooks = append(hooks, runner.Hook)
+	}
+	return hooks
+}
+
+// Sign returns the Amp-Webhook-Signature header value for the given body.
+func Sign(secret, body []byte, at time.Time) string {
+	t := strconv.FormatInt(at.Unix(), 10)
+	return "t=" + t + ",v1=" + hex.EncodeToString(signature(secret, t, body))
+}
+
+// Verify checks an Amp-Webhook-Signature header for the given body, rejecting signatures older than maxAge (if > 0) to limit replays.
+func Verify(secret []byte, header string, body []byte, maxAge time.Duration) error {
+	var t, v1 string
+	for _, part := range strings.Split(header, ",") {
+		if val, found := strings.CutPrefix(part, "t="); found {
+			t = val
+		} else if val, found := strings.CutPrefix(part, "v1="); found {
+			v1 = val
+		}
+	}
+	sig, err := hex.DecodeString(v1)
+	if err != nil || t == "" || !hmac.Equal(sig, signature(secret, t, body)) {
+		return ErrBadSignature
+	}
+	if maxAge > 0 {
+		unix, err := strconv.ParseInt(t, 10, 64)
+		if err != nil || time.Since(time.Unix(unix, 0)) > maxAge {
+			return ErrBadSignature
+		}
+	}
+	return nil
+}
+
+func signature(secret []byte, t string, body []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(t))
+	mac.Write([]byte{'.'})
+	mac.Write(body)
+	return mac.Sum(nil)
+}
+
+// hookRunner queues and delivers payloads for a single Hook.
+type hookRunner struct {
+	Hook
+	svc   *Service
+	ctx   task.Context
+	queue chan delivery
+}
+
+// delivery is a serialized Payload.
+type delivery struct {
+	ID   string
+	Body []byte
+}
+
+func (runner *hookRunner) matches(op *amp.TxOp) bool {
+	if len(runner.Cells) > 0 && !contains(runner.Cells, op.CellID) {
+		return false
+	}
+	if len(runner.Attrs) > 0 && !contains(runner.Attrs, op.AttrID) {
+		return false
+	}
+	return true
+}
+
+func contains(IDs []tag.ID, ID tag.ID) bool {
+	for _, id := range IDs {
+		if id == ID {
+			return true
+		}
+	}
+	return false
+}
+
+// onTx queues a payload of the ops in tx matching this hook, without blocking the Source.
+func (runner *hookRunner) onTx(tx *amp.TxMsg) {
+	payload := Payload{
+		Hook:     runner.ID.Base32(),
+		Delivery: tag.Now().Base32(),
+		Tx:       tx.GenesisID().Base32(),
+	}
+	for i := range tx.Ops {
+		op := &tx.Ops[i]
+		if op.CellID == amp.MetaNodeID || !runner.matches(op) {
+			continue
+		}
+		opJSON := gateway.Op{
+			Op:   opName(op.OpCode),
+			Cell: op.CellID.Base32(),
+			Attr: op.AttrID.Base32(),
+		}
+		if op.ItemID.IsSet() {
+			opJSON.Item = op.ItemID.Base32()
+		}
+		if op.DataLen > 0 {
+			opJSON.Value = runner.svc.valueJSON(tx, i)
+		}
+		payload.Ops = append(payload.Ops, opJSON)
+	}
+	if len(payload.Ops) == 0 {
+		return
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return
+	}
+	select {
+	case runner.queue <- delivery{ID: payload.Delivery, Body: body}:
+	default:
+		runner.ctx.Log().Warnf("webhook queue full; dropped delivery %s", payload.Delivery)
+	}
+}
+
+func opName(opCode amp.TxOpCode) string {
+	switch opCode {
+	case amp.TxOpCode_DeleteElement:
+		return "delete"
+	case amp.TxOpCode_DeferElement:
+		return "defer"
+	case amp.TxOpCode_EphemeralElement:
+		return "ephemeral"
+	default:
+		return "upsert"
+	}
+}
+
+// valueJSON returns the JSON form of an op value via the host registry, or base64 if its attr is not registered.
+func (svc *Service) valueJSON(tx *amp.TxMsg, idx int) json.RawMessage {
+	if val, err := svc.reg.MakeValue(tx.Ops[idx].AttrID); err == nil {
+		if err = tx.UnmarshalOpValue(idx, val); err == nil {
+			if buf, err := json.Marshal(val); err == nil {
+				return buf
+			}
+		}
+	}
+	op := tx.Ops[idx]
+	buf, _ := json.Marshal(base64.StdEncoding.EncodeToString(tx.DataStore[op.DataOfs : op.DataOfs+op.DataLen]))
+	return buf
+}
+
+func (runner *hookRunner) run(ctx task.Context) {
+	for {
+		select {
+		case <-ctx.Closing():
+			return
+		case next := <-runner.queue:
+			runner.deliver(ctx, next)
+		}
+	}
+}
+
+// deliver POSTs a payload, retrying with backoff until it succeeds, fails permanently, or MaxAttempts is reached.
+func (runner *hookRunner) deliver(ctx task.Context, next delivery) {
+	opts := &runner.svc.opts
+	backoff := opts.Backoff
+	for attempt := 1; ; attempt++ {
+		retry, err := runner.post(ctx, next)
+		if err == nil {
+			return
+		}
+		if !retry || attempt >= opts.MaxAttempts {
+			ctx.Log().Warnf("webhook delivery %s dropped after %d attempt(s): %v", next.ID, attempt, err)
+			return
+		}
+
+		timer := ctx.Clock().NewTimer(backoff)
+		select {
+		case <-ctx.Closing():
+			timer.Stop()
+			return
+		case <-timer.C():
+		}
+		backoff = min(2*backoff, opts.MaxBackoff)
+	}
+}
+
+// post makes a single delivery attempt, returning whether a failure is worth retrying.
+func (runner *hookRunner) post(ctx task.Context, next delivery) (retry bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, runner.URL, bytes.NewReader(next.Body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(DeliveryHeader, next.ID)
+	req.Header.Set(SignatureHeader, Sign(runner.Secret, next.Body, ctx.Clock().Now()))
+
+	resp, err := runner.svc.opts.Client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	resp.Body.Close()
+
+	switch code := resp.StatusCode; {
+	case code >= 200 && code < 300:
+		return false, nil
+	case code == http.StatusRequestTimeout, code == http.StatusTooManyRequests, code >= 500:
+		return true, amp.ErrCode_ProviderErr.Errorf("webhook returned %s", resp.Status)
+	default:
+		return false, amp.ErrCode_ProviderErr.Errorf("webhook returned %s", resp.Status)
+	}
+}
@@ -0,0 +1,119 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+type testHost struct {
+	amp.Host
+	ctx task.Context
+	reg amp.Registry
+}
+
+func (host *testHost) StartChild(task *task.Task) (task.Context, error) {
+	return host.ctx.StartChild(task)
+}
+
+func (host *testHost) HostRegistry() amp.Registry {
+	return host.reg
+}
+
+// testSource calls each watcher directly from commit().
+type testSource struct {
+	mu       sync.Mutex
+	watchers []func(tx *amp.TxMsg)
+}
+
+func (src *testSource) WatchTxs(ctx task.Context, rootID tag.ID, fn func(tx *amp.TxMsg)) {
+	src.mu.Lock()
+	src.watchers = append(src.watchers, fn)
+	src.mu.Unlock()
+}
+
+func (src *testSource) commit(tx *amp.TxMsg) {
+	src.mu.Lock()
+	defer src.mu.Unlock()
+	for _, fn := range src.watchers {
+		fn(tx)
+	}
+}
+
+func TestWebhook(t *testing.T) {
+	root, _ := task.Start(&task.Task{})
+	defer root.Close()
+
+	secret := []byte("shh")
+	received := make(chan Payload, 4)
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if err := Verify(secret, r.Header.Get(SignatureHeader), body, time.Minute); err != nil {
+			t.Errorf("bad signature: %v", err)
+		}
+		if attempts++; attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable) // retried
+			return
+		}
+		var payload Payload
+		json.Unmarshal(body, &payload)
+		if payload.Delivery != r.Header.Get(DeliveryHeader) {
+			t.Errorf("delivery header mismatch")
+		}
+		received <- payload
+	}))
+	defer server.Close()
+
+	reg := amp.NewRegistry()
+	amp.RegisterBuiltinTypes(reg)
+	src := &testSource{}
+	svc := NewService(Opts{Source: src, Backoff: time.Millisecond})
+	if err := svc.StartService(&testHost{ctx: root, reg: reg}); err != nil {
+		t.Fatal(err)
+	}
+
+	tagAttr := (&amp.Tag{}).TagSpec().ID
+	cellID := tag.ID{0, 0, 5}
+	hookID, err := svc.Register(Hook{
+		URL:    server.URL,
+		Root:   cellID,
+		Attrs:  []tag.ID{tagAttr},
+		Secret: secret,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	other, _ := amp.MarshalAttr(cellID, (&amp.Badge{}).TagSpec().ID, &amp.Badge{})
+	src.commit(other) // filtered out by Attrs
+	tx, _ := amp.MarshalAttr(cellID, tagAttr, &amp.Tag{Text: "changed"})
+	src.commit(tx)
+
+	select {
+	case payload := <-received:
+		if payload.Hook != hookID.Base32() || len(payload.Ops) != 1 || payload.Ops[0].Cell != cellID.Base32() || string(payload.Ops[0].Value) != `{"Text":"changed"}` {
+			t.Fatalf("unexpected payload: %+v", payload)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("webhook not delivered")
+	}
+	if attempts != 2 {
+		t.Fatalf("expected 2 attempts, got %d", attempts)
+	}
+
+	if err = svc.Unregister(hookID); err != nil {
+		t.Fatal(err)
+	}
+	if Verify(secret, Sign(secret, []byte("a"), time.Now()), []byte("b"), 0) != ErrBadSignature {
+		t.Fatal("expected signature mismatch")
+	}
+}
@@ -0,0 +1,295 @@
+// Package loadgen simulates many concurrent client sessions pinning cells against an amp.Host, either in-process or over a transport.
+//
+// Run reports latency percentiles for connecting, first updates, and synced states, so performance regressions are measurable from release to release:
+//
+//	report, err := loadgen.Run(ctx, loadgen.Opts{
+//		Sessions: 200,
+//		Dial:     loadgen.InProcess(host),
+//		Workload: loadgen.Workload{URLs: []string{"photos:", "music:"}, Pins: 20},
+//	})
+package loadgen
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/amp/client"
+	"github.com/art-media-platform/amp-sdk-go/amp/rpc"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// Dialer opens the transport of a new client session.
+type Dialer func(ctx context.Context) (amp.Transport, error)
+
+// Remote returns a Dialer connecting to a host serving rpc.Service at the given URL (e.g. "https://host:5192").
+func Remote(httpClient *http.Client, url string) Dialer {
+	return func(ctx context.Context) (amp.Transport, error) {
+		return rpc.Connect(ctx, httpClient, url)
+	}
+}
+
+// Workload specifies what each simulated session does once connected.
+type Workload struct {
+	URLs     []string                     // pinned in turn, each session starting at a different offset
+	Pins     int                          // pins each session performs; if <= 0, 10
+	Attrs    []tag.ID                     // attrs to pin; if empty, all attrs
+	Maintain time.Duration                // if > 0, each pin is held open this long (counting updates) before it is closed
+	Think    time.Duration                // pause between a session's pins
+	Login    func(session int) *amp.Login // if set, each session signs in before pinning
+}
+
+// Opts specifies a load run.
+type Opts struct {
+	Sessions   int           // concurrent sessions; if <= 0, 1
+	Ramp       time.Duration // sessions are started evenly over this interval
+	PinTimeout time.Duration // max time for a pin to sync; if <= 0, 30s
+	Dial       Dialer
+	Workload   Workload
+	Client     client.Opts // passed to client.Start for each session
+}
+
+// Latencies summarizes a set of latency samples.
+type Latencies struct {
+	Count int
+	P50   time.Duration
+	P90   time.Duration
+	P99   time.Duration
+	Max   time.Duration
+}
+
+func (lat Latencies) String() string {
+	return fmt.Sprintf("n=%d, p50=%v, p90=%v, p99=%v, max=%v", lat.Count, lat.P50, lat.P90, lat.P99, lat.Max)
+}
+
+// Report is the outcome of a load run.
+type Report struct {
+	Sessions    int
+	Pins        int           // pins that synced
+	Updates     int           // updates received by maintained pins after syncing
+	Errors      int           // failed dials, logins, and pins
+	FirstErr    error         // first of Errors
+	Elapsed     time.Duration // wall time of the run
+	Connect     Latencies     // dial (and login) until a session is ready to pin
+	FirstUpdate Latencies     // pin request until its first update
+	Synced      Latencies     // pin request until its state is synced
+}
+
+// PinsPerSec returns the rate pins synced over the run.
+func (r *Report) PinsPerSec() float64 {
+	if r.Elapsed <= 0 {
+		return 0
+	}
+	return float64(r.Pins) / r.Elapsed.Seconds()
+}
+
+func (r *Report) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "sessions: %d, pins: %d (%.1f/s), updates: %d, errors: %d, elapsed: %v\n", r.Sessions, r.Pins, r.PinsPerSec(), r.Updates, r.Errors, r.Elapsed)
+	fmt.Fprintf(&b, "connect:      %v\n", r.Connect)
+	fmt.Fprintf(&b, "first update: %v\n", r.FirstUpdate)
+	fmt.Fprintf(&b, "synced:       %v\n", r.Synced)
+	if r.FirstErr != nil {
+		fmt.Fprintf(&b, "first error:  %v\n", r.FirstErr)
+	}
+	return b.String()
+}
+
+// Run starts opts.Sessions sessions (as children of the given context), runs the workload on each, and returns once all have completed.
+func Run(ctx task.Context, opts Opts) (*Report, error) {
+	if opts.Dial == nil {
+		return nil, amp.ErrCode_BadRequest.Error("loadgen: Opts.Dial is required")
+	}
+	if len(opts.Workload.URLs) == 0 {
+		return nil, amp.ErrCode_BadRequest.Error("loadgen: Workload.URLs is empty")
+	}
+	if opts.Sessions <= 0 {
+		opts.Sessions = 1
+	}
+	if opts.Workload.Pins <= 0 {
+		opts.Workload.Pins = 10
+	}
+	if opts.PinTimeout <= 0 {
+		opts.PinTimeout = 30 * time.Second
+	}
+
+	rec := &recorder{}
+	start := time.Now()
+	wg := sync.WaitGroup{}
+ramp:
+	for i := 0; i < opts.Sessions; i++ {
+		if opts.Ramp > 0 && i > 0 {
+			select {
+			case <-time.After(opts.Ramp / time.Duration(opts.Sessions)):
+			case <-ctx.Closing():
+				break ramp
+			}
+		}
+		wg.Add(1)
+		go func(session int) {
+			defer wg.Done()
+			runSession(ctx, session, &opts, rec)
+		}(i)
+	}
+	wg.Wait()
+
+	report := rec.report()
+	report.Sessions = opts.Sessions
+	report.Elapsed = time.Since(start)
+	return report, nil
+}
+
+func runSession(ctx task.Context, session int, opts *Opts, rec *recorder) {
+	work := &opts.Workload
+
+	t0 := time.Now()
+	via, err := opts.Dial(ctx)
+	if err != nil {
+		rec.fail(err)
+		return
+	}
+	sess, err := client.Start(ctx, via, opts.Client)
+	if err != nil {
+		via.Close()
+		rec.fail(err)
+		return
+	}
+	defer sess.Close()
+
+	if work.Login != nil {
+		loginCtx, cancel := context.WithTimeout(ctx, opts.PinTimeout)
+		_, err = sess.Login(loginCtx, work.Login(session))
+		cancel()
+		if err != nil {
+			rec.fail(err)
+			return
+		}
+	}
+	rec.add(&rec.connect, time.Since(t0))
+
+	for i := 0; i < work.Pins; i++ {
+		if i > 0 && work.Think > 0 {
+			select {
+			case <-time.After(work.Think):
+			case <-ctx.Closing():
+				return
+			}
+		}
+		url := work.URLs[(session+i)%len(work.URLs)]
+		if err := runPin(ctx, sess, url, opts, rec); err != nil {
+			rec.fail(err)
+		}
+	}
+}
+
+// runPin pins the given URL, recording when its first update arrives and when it syncs.
+func runPin(ctx task.Context, sess *client.Session, url string, opts *Opts, rec *recorder) error {
+	work := &opts.Workload
+	t0 := time.Now()
+	pin, err := sess.Pin(url, client.PinOpts{
+		Attrs:    work.Attrs,
+		Maintain: work.Maintain > 0,
+	})
+	if err != nil {
+		return err
+	}
+	defer pin.Close()
+
+	pinCtx, cancel := context.WithTimeout(ctx, opts.PinTimeout)
+	defer cancel()
+	for first := true; ; first = false {
+		update, err := pin.Next(pinCtx)
+		if err != nil {
+			return err
+		}
+		if first {
+			rec.add(&rec.firstUpdate, time.Since(t0))
+		}
+		if update.Synced() {
+			rec.add(&rec.synced, time.Since(t0))
+			break
+		}
+	}
+
+	if work.Maintain > 0 {
+		holdCtx, cancel := context.WithTimeout(ctx, work.Maintain)
+		defer cancel()
+		for {
+			if _, err := pin.Next(holdCtx); err != nil {
+				break
+			}
+			rec.addUpdate()
+		}
+	}
+	return nil
+}
+
+// recorder accumulates the samples of a run -- concurrency safe.
+type recorder struct {
+	mu          sync.Mutex
+	connect     []time.Duration
+	firstUpdate []time.Duration
+	synced      []time.Duration
+	updates     int
+	errors      int
+	firstErr    error
+}
+
+func (rec *recorder) add(samples *[]time.Duration, lat time.Duration) {
+	rec.mu.Lock()
+	*samples = append(*samples, lat)
+	rec.mu.Unlock()
+}
+
+func (rec *recorder) addUpdate() {
+	rec.mu.Lock()
+	rec.updates++
+	rec.mu.Unlock()
+}
+
+func (rec *recorder) fail(err error) {
+	rec.mu.Lock()
+	rec.errors++
+	if rec.firstErr == nil {
+		rec.firstErr = err
+	}
+	rec.mu.Unlock()
+}
+
+func (rec *recorder) report() *Report {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	return &Report{
+		Pins:        len(rec.synced),
+		Updates:     rec.updates,
+		Errors:      rec.errors,
+		FirstErr:    rec.firstErr,
+		Connect:     summarize(rec.connect),
+		FirstUpdate: summarize(rec.firstUpdate),
+		Synced:      summarize(rec.synced),
+	}
+}
+
+func summarize(samples []time.Duration) Latencies {
+	lat := Latencies{
+		Count: len(samples),
+	}
+	if len(samples) == 0 {
+		return lat
+	}
+	sort.Slice(samples, func(i, j int) bool {
+		return samples[i] < samples[j]
+	})
+	at := func(p float64) time.Duration {
+		return samples[int(p*float64(len(samples)-1))]
+	}
+	lat.P50, lat.P90, lat.P99 = at(0.50), at(0.90), at(0.99)
+	lat.Max = samples[len(samples)-1]
+	return lat
+}
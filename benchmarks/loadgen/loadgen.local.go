@@ -0,0 +1,101 @@
+package loadgen
+
+import (
+	"context"
+	"sync"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// InProcess returns a Dialer opening sessions with the given host over in-process pipes, so a run measures the host rather than a network.
+func InProcess(host amp.Host) Dialer {
+	svc := &localService{}
+	return func(ctx context.Context) (amp.Transport, error) {
+		svc.once.Do(func() {
+			svc.err = svc.StartService(host)
+		})
+		if svc.err != nil {
+			return nil, svc.err
+		}
+		clientEnd, hostEnd := NewPipe(64)
+		if _, err := host.StartNewSession(svc, hostEnd); err != nil {
+			clientEnd.Close()
+			return nil, err
+		}
+		return clientEnd, nil
+	}
+}
+
+// localService is the amp.HostService through which InProcess sessions are opened.
+type localService struct {
+	task.Context
+	once sync.Once
+	err  error
+}
+
+func (svc *localService) StartService(on amp.Host) error {
+	ctx, err := on.StartChild(&task.Task{
+		Info: task.Info{
+			Label: "loadgen",
+		},
+	})
+	if err != nil {
+		return err
+	}
+	svc.Context = ctx
+	return nil
+}
+
+func (svc *localService) GracefulStop() {
+}
+
+// Pipe is one end of an in-process transport pair -- see NewPipe.
+type Pipe struct {
+	in, out   chan *amp.TxMsg
+	closing   chan struct{}
+	closeOnce *sync.Once
+}
+
+// NewPipe returns both ends of an in-process transport, each buffering up to the given number of txs.
+func NewPipe(depth int) (a, b *Pipe) {
+	ab, ba := make(chan *amp.TxMsg, depth), make(chan *amp.TxMsg, depth)
+	closing := make(chan struct{})
+	closeOnce := &sync.Once{}
+	a = &Pipe{in: ba, out: ab, closing: closing, closeOnce: closeOnce}
+	b = &Pipe{in: ab, out: ba, closing: closing, closeOnce: closeOnce}
+	return a, b
+}
+
+func (p *Pipe) Label() string {
+	return "pipe"
+}
+
+// Close closes both ends of this pipe.
+func (p *Pipe) Close() error {
+	p.closeOnce.Do(func() {
+		close(p.closing)
+	})
+	return nil
+}
+
+// SendTx queues the given tx for the other end (which releases it), so the caller retains its reference.
+func (p *Pipe) SendTx(tx *amp.TxMsg) error {
+	tx.AddRef()
+	select {
+	case p.out <- tx:
+		return nil
+	case <-p.closing:
+		tx.ReleaseRef()
+		return amp.ErrStreamClosed
+	}
+}
+
+func (p *Pipe) RecvTx() (*amp.TxMsg, error) {
+	select {
+	case tx := <-p.in:
+		return tx, nil
+	case <-p.closing:
+		return nil, amp.ErrStreamClosed
+	}
+}
@@ -0,0 +1,69 @@
+package loadgen
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// serveLabels answers each pin with a label element (as a Syncing then a Synced tx) until the pipe closes.
+func serveLabels(via *Pipe, reg amp.Registry) {
+	reply := func(contextID tag.ID, status amp.OpStatus, text string) {
+		tx, _ := amp.MarshalAttr(tag.ID{0, 0, 7}, (&amp.Tag{}).TagSpec().ID, &amp.Tag{Text: text})
+		tx.SetContextID(contextID)
+		tx.Status = status
+		via.SendTx(tx)
+		tx.ReleaseRef()
+	}
+	for {
+		tx, err := via.RecvTx()
+		if err != nil {
+			return
+		}
+		if val, _ := tx.CheckMetaAttr(reg); val != nil {
+			if req, ok := val.(*amp.PinRequest); ok {
+				reply(tx.ContextID(), amp.OpStatus_Syncing, req.PinTarget.URL)
+				reply(tx.ContextID(), amp.OpStatus_Synced, req.PinTarget.URL)
+			}
+		}
+		tx.ReleaseRef()
+	}
+}
+
+func TestRun(t *testing.T) {
+	root, _ := task.Start(&task.Task{Info: task.Info{Label: "loadgen"}})
+	defer root.Close()
+
+	reg := amp.NewRegistry()
+	amp.RegisterBuiltinTypes(reg)
+	report, err := Run(root, Opts{
+		Sessions: 8,
+		Dial: func(ctx context.Context) (amp.Transport, error) {
+			clientEnd, hostEnd := NewPipe(16)
+			go serveLabels(hostEnd, reg)
+			return clientEnd, nil
+		},
+		Workload: Workload{
+			URLs: []string{"photos:", "music:"},
+			Pins: 5,
+		},
+		PinTimeout: 5 * time.Second,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Errors != 0 || report.Pins != 40 || report.Synced.Count != 40 || report.FirstUpdate.Count != 40 || report.Connect.Count != 8 {
+		t.Fatalf("unexpected report:\n%v", report)
+	}
+	if lat := report.Synced; lat.P50 <= 0 || lat.P50 > lat.P90 || lat.P90 > lat.P99 || lat.P99 > lat.Max {
+		t.Fatalf("percentiles out of order: %v", lat)
+	}
+	if !strings.Contains(report.String(), "synced:") {
+		t.Fatalf("unexpected report text:\n%v", report)
+	}
+}
@@ -0,0 +1,54 @@
+// amp.codegen writes client code for the builtin amp attr types, e.g.
+//
+//	go run ./cmd/amp.codegen -lang ts -o amp.gen.ts
+//	go run ./cmd/amp.codegen -lang cs -o Assets/Plugins/AMP/Amp.gen.cs
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/art-media-platform/amp-sdk-go/amp"
+	"github.com/art-media-platform/amp-sdk-go/amp/codegen"
+)
+
+func main() {
+	lang := flag.String("lang", "ts", "output language: ts, cs")
+	outPath := flag.String("o", "", "output file (default stdout)")
+	flag.Parse()
+
+	if err := run(*lang, *outPath); err != nil {
+		fmt.Fprintln(os.Stderr, "amp.codegen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(lang, outPath string) error {
+	reg := amp.NewRegistry()
+	amp.RegisterBuiltinTypes(reg)
+	schema, err := codegen.NewSchema(reg)
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer = os.Stdout
+	if outPath != "" {
+		file, err := os.Create(outPath)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		out = file
+	}
+
+	switch lang {
+	case "ts":
+		return codegen.WriteTypeScript(out, schema)
+	case "cs":
+		return codegen.WriteCSharp(out, schema)
+	default:
+		return fmt.Errorf("unsupported language %q", lang)
+	}
+}
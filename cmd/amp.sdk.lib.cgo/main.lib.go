@@ -1,3 +1,116 @@
+// amp.sdk.lib.cgo exports the amp C API (see package cabi), for embedding in engines (e.g. Unity, Unreal) and native apps:
+//
+//	go build -buildmode=c-shared -o libamp.so ./cmd/amp.sdk.lib.cgo    # also emits libamp.h
+//
+// Functions return a handle (> 0), a byte count (>= 0), or 0 on success -- or the negated amp.ErrCode on failure (see amp_last_error).
+// A panic within a call is recovered and returned as -ErrCode_InternalErr rather than unwinding into the caller.
+// Txs are flat buffers: a serialized TxMsg (see amp.TxMsg.MarshalToBuffer).
+//
+// To embed a host, link a host implementation that calls cabi.SetHostStarter() from an init().
 package main
 
-// TODO -- export C wrappers for package tags and other items in amp.sdk
+/*
+#include <stdint.h>
+*/
+import "C"
+
+import (
+	"time"
+	"unsafe"
+
+	"github.com/art-media-platform/amp-sdk-go/amp/cabi"
+)
+
+func main() {} // required by -buildmode=c-shared
+
+// recoverCode sets *ret to the error code of a panic in an export, as a panic must not unwind into the embedding app -- deferred by each export.
+func recoverCode[T ~int32 | ~int64](ret *T) {
+	if r := recover(); r != nil {
+		*ret = T(cabi.Code(cabi.Recovered(r)))
+	}
+}
+
+func handleOrCode(h cabi.Handle, err error) C.int64_t {
+	if err != nil {
+		return C.int64_t(cabi.Code(err))
+	}
+	return C.int64_t(h)
+}
+
+// amp_host_start starts an embedded host from an implementation-defined config string, returning its handle.
+//
+//export amp_host_start
+func amp_host_start(config *C.char) (ret C.int64_t) {
+	defer recoverCode(&ret)
+	return handleOrCode(cabi.HostStart(C.GoString(config)))
+}
+
+// amp_session_open opens an in-process session with an embedded host, returning its handle.
+//
+//export amp_session_open
+func amp_session_open(host C.int64_t) (ret C.int64_t) {
+	defer recoverCode(&ret)
+	return handleOrCode(cabi.SessionOpen(cabi.Handle(host)))
+}
+
+// amp_session_connect opens a session with a remote host (e.g. "https://host:5192"), returning its handle.
+//
+//export amp_session_connect
+func amp_session_connect(url *C.char) (ret C.int64_t) {
+	defer recoverCode(&ret)
+	return handleOrCode(cabi.SessionConnect(C.GoString(url)))
+}
+
+// amp_tx_send sends the serialized tx in buf[0:len] to the session's host.
+//
+//export amp_tx_send
+func amp_tx_send(sess C.int64_t, buf *C.uint8_t, length C.int32_t) (ret C.int32_t) {
+	defer recoverCode(&ret)
+	src := unsafe.Slice((*byte)(unsafe.Pointer(buf)), int(length))
+	return C.int32_t(cabi.Code(cabi.TxSend(cabi.Handle(sess), src)))
+}
+
+// amp_tx_recv copies the next serialized tx from the session's host into buf[0:capacity], waiting up to timeout_ms (or indefinitely if < 0).
+// On success, the tx's length is returned.  If the tx does not fit, -ErrCode_BadRequest is returned, *needed is set to its length, and the tx remains next.
+//
+//export amp_tx_recv
+func amp_tx_recv(sess C.int64_t, buf *C.uint8_t, capacity C.int32_t, needed *C.int32_t, timeout_ms C.int32_t) (ret C.int32_t) {
+	defer recoverCode(&ret)
+	if needed != nil {
+		*needed = 0
+	}
+	var dst []byte
+	if buf != nil && capacity > 0 {
+		dst = unsafe.Slice((*byte)(unsafe.Pointer(buf)), int(capacity))
+	}
+	n, err := cabi.TxRecv(cabi.Handle(sess), dst, time.Duration(timeout_ms)*time.Millisecond)
+	if err == cabi.ErrShortBuffer && needed != nil {
+		*needed = C.int32_t(n)
+	}
+	if err != nil {
+		return C.int32_t(cabi.Code(err))
+	}
+	return C.int32_t(n)
+}
+
+// amp_close closes a host or session handle.
+//
+//export amp_close
+func amp_close(handle C.int64_t) (ret C.int32_t) {
+	defer recoverCode(&ret)
+	return C.int32_t(cabi.Code(cabi.Close(cabi.Handle(handle))))
+}
+
+// amp_last_error copies the message of the most recent error (NUL-terminated and truncated to fit) into buf[0:capacity], returning its full length.
+//
+//export amp_last_error
+func amp_last_error(buf *C.char, capacity C.int32_t) (ret C.int32_t) {
+	defer recoverCode(&ret)
+	msg := cabi.LastError()
+	if buf != nil && capacity > 0 {
+		dst := unsafe.Slice((*byte)(unsafe.Pointer(buf)), int(capacity))
+		n := copy(dst[:capacity-1], msg)
+		dst[n] = 0
+	}
+	return C.int32_t(len(msg))
+}
@@ -0,0 +1,80 @@
+// Package blob abstracts storage of asset content (blobs) so a host can serve published assets from local disk or an object store.
+//
+// Shipped backends:
+//
+//	NewDirStore   -- files in a local directory, optionally served via sendfile or mmap (see DirOpts)
+//	NewS3Store    -- any S3-compatible object store (AWS S3, MinIO, R2, etc.)
+//	NewGCSStore   -- Google Cloud Storage, via its S3-compatible XML API and HMAC keys
+//	NewIPFSStore  -- an IPFS node (e.g. Kubo), so hosts can share immutable media without a central object store
+//
+// PutContent stores a blob under its content identifier (CID) so identical content is stored once.
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"time"
+)
+
+// Store is a flat namespace of blobs keyed by a slash-separated path (e.g. "media/2024/clip.mp4") -- concurrency safe.
+type Store interface {
+
+	// Describes this store for logging and debugging.
+	Label() string
+
+	// Writes a blob from the given reader, replacing any existing blob with the same key.
+	// If info.Size > 0, it is the number of bytes r will yield, allowing the content to be streamed.
+	Put(ctx context.Context, key string, r io.Reader, info Info) error
+
+	// Opens the given blob for reading, returning ErrNotFound if not present.
+	// Seeking is supported so that a blob can be served in byte ranges without reading it in full.
+	Open(ctx context.Context, key string) (Reader, error)
+
+	// Returns info about the given blob, returning ErrNotFound if not present.
+	Stat(ctx context.Context, key string) (Info, error)
+
+	// Removes the given blob; removing a blob that is not present is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// Reader reads a blob opened via Store.Open().
+type Reader interface {
+	io.ReadSeekCloser
+
+	// Returns info about the blob being read.
+	Info() Info
+}
+
+// FileReader is optionally implemented by a Reader of a local file, allowing a server to copy the file to a socket via sendfile(2) rather than through user-space buffers.
+type FileReader interface {
+	File() *os.File
+}
+
+// Warmer is optionally implemented by a Store that caches blobs (see Cache), allowing a blob likely to be opened soon to be loaded ahead of time.
+type Warmer interface {
+	Warm(ctx context.Context, key string) error
+}
+
+// Info describes a stored blob.
+type Info struct {
+	Size        int64     // byte length of the blob
+	ContentType string    // media (MIME) type
+	ModTime     time.Time // when the blob was last written
+	ETag        string    // opaque tag that changes whenever the blob's content changes
+}
+
+// ServeMode selects how a local-disk backend reads the blobs it serves.
+type ServeMode int
+
+const (
+	ServeBuffered ServeMode = iota // blobs are read through user-space buffers
+	ServeSendfile                  // readers expose their file (see FileReader) so the kernel copies it to the socket
+	ServeMmap                      // large blobs are memory-mapped and read in place, sharing the page cache rather than heap buffers
+)
+
+var (
+	ErrNotFound   = errors.New("blob not found")
+	ErrInvalidKey = errors.New("invalid blob key")
+)
@@ -0,0 +1,404 @@
+package blob_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/blob"
+)
+
+func TestDirStore(t *testing.T) {
+	st, err := blob.NewDirStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	testStore(t, st)
+
+	if err := st.Put(context.Background(), "../escape", strings.NewReader("x"), blob.Info{}); err != blob.ErrInvalidKey {
+		t.Fatalf("expected ErrInvalidKey, got %v", err)
+	}
+}
+
+func TestDirStoreServeModes(t *testing.T) {
+	for _, mode := range []blob.ServeMode{blob.ServeSendfile, blob.ServeMmap, blob.ServeBuffered} {
+		st, err := blob.NewDirStoreOpts(t.TempDir(), blob.DirOpts{Serve: mode, MmapMinSize: 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		testStore(t, st)
+
+		ctx := context.Background()
+		st.Put(ctx, "clip.mp4", strings.NewReader("video"), blob.Info{})
+		r, err := st.Open(ctx, "clip.mp4")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, isFile := r.(blob.FileReader); isFile != (mode == blob.ServeSendfile) {
+			t.Fatalf("mode %v: FileReader implemented = %v", mode, isFile)
+		}
+
+		// a blob replaced while being read is still read in full
+		st.Put(ctx, "clip.mp4", strings.NewReader("replaced"), blob.Info{})
+		if data, err := io.ReadAll(r); err != nil || string(data) != "video" {
+			t.Fatalf("mode %v: read %q, %v", mode, data, err)
+		}
+		r.Close()
+	}
+}
+
+func TestDirStoreMmapClose(t *testing.T) {
+	st, _ := blob.NewDirStoreOpts(t.TempDir(), blob.DirOpts{Serve: blob.ServeMmap, MmapMinSize: 1})
+	ctx := context.Background()
+	st.Put(ctx, "clip.mp4", strings.NewReader("video"), blob.Info{})
+	r, err := st.Open(ctx, "clip.mp4")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// reads racing Close either complete or fail cleanly
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			buf := make([]byte, 5)
+			for {
+				if _, err := r.(io.ReaderAt).ReadAt(buf, 0); err != nil {
+					return
+				}
+				if string(buf) != "video" {
+					t.Errorf("read %q", buf)
+					return
+				}
+			}
+		}()
+	}
+	if err = r.Close(); err != nil {
+		t.Fatal(err)
+	}
+	wg.Wait()
+
+	if _, err = r.Read(make([]byte, 1)); err == nil {
+		t.Fatal("expected read after Close to fail")
+	}
+	if err = r.Close(); err != nil {
+		t.Fatalf("expected second Close to return nil, got %v", err)
+	}
+}
+
+func TestS3Store(t *testing.T) {
+	bucket := newFakeBucket()
+	server := httptest.NewServer(bucket)
+	defer server.Close()
+
+	testStore(t, blob.NewS3Store(blob.S3Opts{
+		Endpoint:  server.URL,
+		Bucket:    "assets",
+		AccessKey: "AKID",
+		SecretKey: "secret",
+	}))
+}
+
+func testStore(t *testing.T, st blob.Store) {
+	ctx := context.Background()
+	const key = "clips/intro.txt"
+	const content = "0123456789abcdef"
+
+	if _, err := st.Stat(ctx, key); err != blob.ErrNotFound {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+	if err := st.Put(ctx, key, strings.NewReader(content), blob.Info{ContentType: "text/plain"}); err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := st.Stat(ctx, key)
+	if err != nil || info.Size != int64(len(content)) || info.ETag == "" {
+		t.Fatalf("Stat: %+v, %v", info, err)
+	}
+
+	r, err := st.Open(ctx, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	buf := make([]byte, 4)
+	if _, err = r.Seek(10, io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = io.ReadFull(r, buf); err != nil || string(buf) != "abcd" {
+		t.Fatalf("read after seek: %q, %v", buf, err)
+	}
+	if _, err = r.Seek(-16, io.SeekCurrent); err == nil {
+		t.Fatal("expected negative seek to fail")
+	}
+	r.Seek(2, io.SeekStart)
+	rest, err := io.ReadAll(r)
+	if err != nil || string(rest) != content[2:] {
+		t.Fatalf("read rest: %q, %v", rest, err)
+	}
+
+	if err = st.Delete(ctx, key); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = st.Open(ctx, key); err != blob.ErrNotFound {
+		t.Fatalf("expected ErrNotFound after delete, got %v", err)
+	}
+	if err = st.Delete(ctx, key); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// fakeBucket is a minimal S3 stand-in supporting PUT, HEAD, ranged GET, and DELETE.
+type fakeBucket struct {
+	mu   sync.Mutex
+	objs map[string]string
+}
+
+func newFakeBucket() *fakeBucket {
+	return &fakeBucket{
+		objs: make(map[string]string),
+	}
+}
+
+func (b *fakeBucket) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKID/") {
+		http.Error(w, "unsigned", http.StatusForbidden)
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	obj, exists := b.objs[r.URL.Path]
+	switch r.Method {
+	case http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		b.objs[r.URL.Path] = string(body)
+		return
+	case http.MethodDelete:
+		if !exists {
+			http.NotFound(w, r)
+		}
+		delete(b.objs, r.URL.Path)
+		return
+	}
+	if !exists {
+		http.NotFound(w, r)
+		return
+	}
+
+	status := http.StatusOK
+	w.Header().Set("ETag", `"`+strconv.Itoa(len(obj))+`"`)
+	if rng := r.Header.Get("Range"); rng != "" {
+		from, _ := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(rng, "bytes="), "-"))
+		obj = obj[from:]
+		status = http.StatusPartialContent
+	}
+	w.Header().Set("Content-Length", strconv.Itoa(len(obj)))
+	w.WriteHeader(status)
+	if r.Method == http.MethodGet {
+		io.WriteString(w, obj)
+	}
+}
+
+func TestIPFSStore(t *testing.T) {
+	node := newFakeKubo()
+	server := httptest.NewServer(node)
+	defer server.Close()
+
+	st := blob.NewIPFSStore(blob.IPFSOpts{
+		APIAddr: server.URL,
+		Gateway: "https://gw.example/",
+	})
+	testStore(t, st)
+
+	// content published by another host is linked in by its IPFS CID
+	ctx := context.Background()
+	node.mu.Lock()
+	node.blocks["bafyremote"] = "shared media"
+	node.mu.Unlock()
+	if err := st.Import(ctx, "shared/clip.txt", "bafyremote"); err != nil {
+		t.Fatal(err)
+	}
+	info, err := st.Stat(ctx, "shared/clip.txt")
+	if err != nil || info.ETag != "bafyremote" || info.Size != 12 {
+		t.Fatalf("Stat after import: %+v, %v", info, err)
+	}
+	if url := st.GatewayURL(info.ETag); url != "https://gw.example/ipfs/bafyremote" {
+		t.Fatalf("unexpected gateway URL %q", url)
+	}
+}
+
+// fakeKubo is a minimal stand-in for the MFS commands of a Kubo node's RPC API.
+type fakeKubo struct {
+	mu     sync.Mutex
+	files  map[string]string // MFS path => CID
+	blocks map[string]string // CID => content
+}
+
+func newFakeKubo() *fakeKubo {
+	return &fakeKubo{
+		files:  make(map[string]string),
+		blocks: make(map[string]string),
+	}
+}
+
+func (n *fakeKubo) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	args := r.URL.Query()["arg"]
+	cid, exists := n.files[args[0]]
+	notFound := func() {
+		w.WriteHeader(http.StatusInternalServerError)
+		io.WriteString(w, `{"Message":"file does not exist","Code":0,"Type":"error"}`)
+	}
+
+	switch strings.TrimPrefix(r.URL.Path, "/api/v0/") {
+	case "files/write":
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		body, _ := io.ReadAll(file)
+		cid = "bafy" + strconv.Itoa(len(n.blocks))
+		n.blocks[cid] = string(body)
+		n.files[args[0]] = cid
+	case "files/cp":
+		n.files[args[1]] = strings.TrimPrefix(args[0], "/ipfs/")
+	case "files/rm":
+		if !exists {
+			notFound()
+			return
+		}
+		delete(n.files, args[0])
+	case "files/stat":
+		if !exists {
+			notFound()
+			return
+		}
+		fmt.Fprintf(w, `{"Hash":%q,"Size":%d,"Type":"file"}`, cid, len(n.blocks[cid]))
+	case "files/read":
+		if !exists {
+			notFound()
+			return
+		}
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		io.WriteString(w, n.blocks[cid][offset:])
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestContentAddressing(t *testing.T) {
+	if cid := blob.CIDOf(nil); cid != "bafkreihdwdcefgh4dqkjv67uzcmw7ojee6xedzdetojuzjevtenxquvyku" {
+		t.Fatalf("unexpected CID for empty content: %s", cid)
+	}
+
+	ctx := context.Background()
+	st, _ := blob.NewDirStore(t.TempDir())
+	content := "the same bytes"
+	cid, err := blob.PutContent(ctx, st, strings.NewReader(content), blob.Info{ContentType: "text/plain"})
+	if err != nil || cid != blob.CIDOf([]byte(content)) {
+		t.Fatalf("PutContent: %s, %v", cid, err)
+	}
+	info1, _ := st.Stat(ctx, cid.Key())
+	cid2, err := blob.PutContent(ctx, st, strings.NewReader(content), blob.Info{})
+	info2, _ := st.Stat(ctx, cid.Key())
+	if err != nil || cid2 != cid || info1.ModTime != info2.ModTime {
+		t.Fatal("identical content should be stored once")
+	}
+
+	if _, err = blob.ParseCID("bafy-not-a-cid"); err != blob.ErrBadCID {
+		t.Fatalf("expected ErrBadCID, got %v", err)
+	}
+	if _, err = io.ReadAll(blob.VerifyReader(strings.NewReader(content), cid)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = io.ReadAll(blob.VerifyReader(strings.NewReader("tampered"), cid)); err != blob.ErrIntegrity {
+		t.Fatalf("expected ErrIntegrity, got %v", err)
+	}
+}
+
+func TestCache(t *testing.T) {
+	ctx := context.Background()
+	backend, _ := blob.NewDirStore(t.TempDir())
+	cache, err := blob.NewCache(backend, blob.CacheOpts{
+		MemBudget:  100,
+		MemMaxItem: 40,
+		SpillDir:   filepath.Join(t.TempDir(), "spill"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	read := func(key string) string {
+		r, err := cache.Open(ctx, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer r.Close()
+		data, _ := io.ReadAll(r)
+		return string(data)
+	}
+
+	for i := 0; i < 8; i++ {
+		key := "art/" + strconv.Itoa(i)
+		cache.Put(ctx, key, strings.NewReader(strings.Repeat(strconv.Itoa(i), 30)), blob.Info{})
+	}
+
+	// make art/0 hot, then stream cold blobs through the cache
+	read("art/0")
+	read("art/0")
+	for i := 1; i < 8; i++ {
+		read("art/" + strconv.Itoa(i))
+	}
+
+	before := cache.Metrics()
+	if got := read("art/0"); got != strings.Repeat("0", 30) {
+		t.Fatalf("unexpected content %q", got)
+	}
+	after := cache.Metrics()
+	if after.MemHits != before.MemHits+1 {
+		t.Fatalf("expected hot blob to remain in memory: %+v", after)
+	}
+	if after.MemBytes > 100 || after.Spills == 0 {
+		t.Fatalf("expected cold blobs to spill to disk: %+v", after)
+	}
+
+	// a spilled blob is served from disk
+	read("art/1")
+	if m := cache.Metrics(); m.DiskHits != 1 || m.Misses != 8 {
+		t.Fatalf("expected a disk hit: %+v", m)
+	}
+
+	// writes invalidate cached content
+	cache.Put(ctx, "art/0", strings.NewReader("new"), blob.Info{})
+	if got := read("art/0"); got != "new" {
+		t.Fatalf("expected updated content, got %q", got)
+	}
+
+	// a warmed blob is then served locally
+	backend.Put(ctx, "art/9", strings.NewReader("warm"), blob.Info{})
+	if err := cache.Warm(ctx, "art/9"); err != nil {
+		t.Fatal(err)
+	}
+	before = cache.Metrics()
+	if got := read("art/9"); got != "warm" || cache.Metrics().Misses != before.Misses {
+		t.Fatalf("expected warmed blob to be cached: %q %+v", got, cache.Metrics())
+	}
+}
@@ -0,0 +1,407 @@
+package blob
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// CacheOpts specifies a Cache.
+type CacheOpts struct {
+	MemBudget  int64  // max bytes held in memory; if <= 0, 64 MB
+	MemMaxItem int64  // blobs larger than this are never held in memory; if <= 0, MemBudget / 16
+	SpillDir   string // directory for the disk tier; if empty, there is no disk tier
+	DiskBudget int64  // max bytes held in SpillDir; if <= 0, 1 GB
+}
+
+// CacheMetrics is a snapshot of a Cache's activity.
+type CacheMetrics struct {
+	MemHits   int64 // opens served from memory
+	DiskHits  int64 // opens served from the disk tier
+	Misses    int64 // opens served from the backend
+	MemBytes  int64 // bytes currently held in memory
+	DiskBytes int64 // bytes currently held in the disk tier
+	Spills    int64 // blobs moved from memory to disk
+	Evictions int64 // blobs dropped from the cache entirely
+}
+
+// Cache is a Store that caches a backend Store's blobs in a memory tier, spilling to a disk tier as memory fills.
+//
+// The memory tier is a segmented LRU: a blob enters on probation and is only protected once opened again.
+// This way a burst of cold, one-off blobs cannot push out hot blobs (e.g. album art and thumbnails).
+//
+// Writes and deletes pass through to the backend, so a Cache assumes blobs are only modified through it (or are immutable, e.g. CIDs).
+type Cache struct {
+	backend Store
+	opts    CacheOpts
+
+	mu        sync.Mutex
+	mem       map[string]*list.Element // => *memEntry
+	probation *list.List               // most recent first
+	protected *list.List               // most recent first
+	protBytes int64                    // bytes held in the protected segment
+	disk      map[string]*list.Element // => *diskEntry
+	diskLRU   *list.List               // most recent first
+	metrics   CacheMetrics
+}
+
+type memEntry struct {
+	key       string
+	data      []byte
+	info      Info
+	protected bool
+}
+
+type diskEntry struct {
+	key  string
+	path string
+	info Info
+}
+
+// NewCache returns a Cache in front of the given backend.
+func NewCache(backend Store, opts CacheOpts) (*Cache, error) {
+	if opts.MemBudget <= 0 {
+		opts.MemBudget = 64 << 20
+	}
+	if opts.MemMaxItem <= 0 {
+		opts.MemMaxItem = opts.MemBudget / 16
+	}
+	if opts.DiskBudget <= 0 {
+		opts.DiskBudget = 1 << 30
+	}
+	if opts.SpillDir != "" {
+		// the disk index is not persisted, so start empty
+		if err := os.RemoveAll(opts.SpillDir); err != nil {
+			return nil, err
+		}
+		if err := os.MkdirAll(opts.SpillDir, 0700); err != nil {
+			return nil, err
+		}
+	}
+	return &Cache{
+		backend:   backend,
+		opts:      opts,
+		mem:       make(map[string]*list.Element),
+		probation: list.New(),
+		protected: list.New(),
+		disk:      make(map[string]*list.Element),
+		diskLRU:   list.New(),
+	}, nil
+}
+
+func (c *Cache) Label() string {
+	return "cache:" + c.backend.Label()
+}
+
+// Metrics returns a snapshot of this cache's activity.
+func (c *Cache) Metrics() CacheMetrics {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.metrics
+}
+
+func (c *Cache) Put(ctx context.Context, key string, r io.Reader, info Info) error {
+	c.invalidate(key)
+	return c.backend.Put(ctx, key, r, info)
+}
+
+func (c *Cache) Delete(ctx context.Context, key string) error {
+	c.invalidate(key)
+	return c.backend.Delete(ctx, key)
+}
+
+func (c *Cache) Stat(ctx context.Context, key string) (Info, error) {
+	c.mu.Lock()
+	if elem := c.mem[key]; elem != nil {
+		info := elem.Value.(*memEntry).info
+		c.mu.Unlock()
+		return info, nil
+	}
+	if elem := c.disk[key]; elem != nil {
+		info := elem.Value.(*diskEntry).info
+		c.mu.Unlock()
+		return info, nil
+	}
+	c.mu.Unlock()
+	return c.backend.Stat(ctx, key)
+}
+
+func (c *Cache) Open(ctx context.Context, key string) (Reader, error) {
+	if r := c.openCached(key); r != nil {
+		return r, nil
+	}
+
+	r, err := c.backend.Open(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	c.metrics.Misses++
+	c.mu.Unlock()
+
+	info := r.Info()
+	switch {
+	case info.Size <= c.opts.MemMaxItem:
+		data, err := io.ReadAll(r)
+		r.Close()
+		if err != nil {
+			return nil, err
+		}
+		c.mu.Lock()
+		c.insertMem(key, data, info)
+		c.mu.Unlock()
+		return newBytesReader(data, info), nil
+
+	case c.opts.SpillDir != "" && info.Size <= c.opts.DiskBudget/4:
+		defer r.Close()
+		if err = c.writeDisk(key, r, info); err != nil {
+			return nil, err
+		}
+		if cached := c.openCached(key); cached != nil {
+			return cached, nil
+		}
+		return c.backend.Open(ctx, key)
+
+	default:
+		return r, nil
+	}
+}
+
+// Warm loads the given blob into the cache if not already cached, so that a subsequent Open() is served locally.
+// A blob too large for either tier is not read.
+func (c *Cache) Warm(ctx context.Context, key string) error {
+	c.mu.Lock()
+	cached := c.mem[key] != nil || c.disk[key] != nil
+	c.mu.Unlock()
+	if cached {
+		return nil
+	}
+
+	info, err := c.backend.Stat(ctx, key)
+	if err != nil {
+		return err
+	}
+	if info.Size > c.opts.MemMaxItem && (c.opts.SpillDir == "" || info.Size > c.opts.DiskBudget/4) {
+		return nil
+	}
+	r, err := c.Open(ctx, key)
+	if err != nil {
+		return err
+	}
+	return r.Close()
+}
+
+// openCached returns a reader of the given blob if cached, otherwise nil.
+func (c *Cache) openCached(key string) Reader {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem := c.mem[key]; elem != nil {
+		c.metrics.MemHits++
+		entry := elem.Value.(*memEntry)
+		c.touchMem(elem)
+		return newBytesReader(entry.data, entry.info)
+	}
+
+	elem := c.disk[key]
+	if elem == nil {
+		return nil
+	}
+	entry := elem.Value.(*diskEntry)
+	file, err := os.Open(entry.path)
+	if err != nil {
+		c.removeDisk(elem)
+		return nil
+	}
+	c.metrics.DiskHits++
+
+	// promote blobs small enough to be held in memory back into the memory tier
+	if entry.info.Size <= c.opts.MemMaxItem {
+		data, err := io.ReadAll(file)
+		file.Close()
+		if err != nil {
+			return nil
+		}
+		c.removeDisk(elem)
+		c.insertMem(key, data, entry.info)
+		if elem := c.mem[key]; elem != nil {
+			c.touchMem(elem) // a blob returning from disk has been opened more than once
+		}
+		return newBytesReader(data, entry.info)
+	}
+	c.diskLRU.MoveToFront(elem)
+	return &fileReader{file, entry.info}
+}
+
+// caller holds c.mu
+func (c *Cache) insertMem(key string, data []byte, info Info) {
+	if elem := c.mem[key]; elem != nil {
+		c.removeMem(elem)
+	}
+	entry := &memEntry{
+		key:  key,
+		data: data,
+		info: info,
+	}
+	c.mem[key] = c.probation.PushFront(entry)
+	c.metrics.MemBytes += int64(len(data))
+	c.evictMem()
+}
+
+// touchMem marks a memory entry as recently used, promoting it from probation -- caller holds c.mu
+func (c *Cache) touchMem(elem *list.Element) {
+	entry := elem.Value.(*memEntry)
+	if entry.protected {
+		c.protected.MoveToFront(elem)
+		return
+	}
+	c.probation.Remove(elem)
+	entry.protected = true
+	c.mem[entry.key] = c.protected.PushFront(entry)
+	c.protBytes += int64(len(entry.data))
+	c.evictMem()
+}
+
+// evictMem spills least recently used entries to disk until within budget, taking from probation first -- caller holds c.mu
+func (c *Cache) evictMem() {
+	// the protected segment is limited to 80% of the budget, demoting its oldest entries to probation
+	protectedLimit := c.opts.MemBudget * 4 / 5
+	for c.protBytes > protectedLimit {
+		elem := c.protected.Back()
+		entry := elem.Value.(*memEntry)
+		c.protected.Remove(elem)
+		c.protBytes -= int64(len(entry.data))
+		entry.protected = false
+		c.mem[entry.key] = c.probation.PushFront(entry)
+	}
+
+	for c.metrics.MemBytes > c.opts.MemBudget {
+		elem := c.probation.Back()
+		if elem == nil {
+			elem = c.protected.Back()
+		}
+		entry := elem.Value.(*memEntry)
+		c.removeMem(elem)
+		if c.opts.SpillDir != "" && c.spill(entry) == nil {
+			c.metrics.Spills++
+		} else {
+			c.metrics.Evictions++
+		}
+	}
+}
+
+// caller holds c.mu
+func (c *Cache) removeMem(elem *list.Element) {
+	entry := elem.Value.(*memEntry)
+	if entry.protected {
+		c.protected.Remove(elem)
+		c.protBytes -= int64(len(entry.data))
+	} else {
+		c.probation.Remove(elem)
+	}
+	delete(c.mem, entry.key)
+	c.metrics.MemBytes -= int64(len(entry.data))
+}
+
+// spill writes a memory entry to the disk tier -- caller holds c.mu
+func (c *Cache) spill(entry *memEntry) error {
+	path := c.diskPath(entry.key)
+	if err := os.WriteFile(path, entry.data, 0600); err != nil {
+		return err
+	}
+	c.insertDisk(entry.key, path, entry.info)
+	return nil
+}
+
+// writeDisk copies a blob into the disk tier.
+func (c *Cache) writeDisk(key string, r io.Reader, info Info) error {
+	path := c.diskPath(key)
+	tmp, err := os.CreateTemp(c.opts.SpillDir, "fill-*")
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(tmp, r)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	c.mu.Lock()
+	c.insertDisk(key, path, info)
+	c.mu.Unlock()
+	return nil
+}
+
+// caller holds c.mu
+func (c *Cache) insertDisk(key, path string, info Info) {
+	if elem := c.disk[key]; elem != nil {
+		c.diskLRU.Remove(elem)
+		c.metrics.DiskBytes -= elem.Value.(*diskEntry).info.Size
+	}
+	c.disk[key] = c.diskLRU.PushFront(&diskEntry{
+		key:  key,
+		path: path,
+		info: info,
+	})
+	c.metrics.DiskBytes += info.Size
+
+	for c.metrics.DiskBytes > c.opts.DiskBudget {
+		c.removeDisk(c.diskLRU.Back())
+		c.metrics.Evictions++
+	}
+}
+
+// caller holds c.mu
+func (c *Cache) removeDisk(elem *list.Element) {
+	entry := elem.Value.(*diskEntry)
+	c.diskLRU.Remove(elem)
+	delete(c.disk, entry.key)
+	c.metrics.DiskBytes -= entry.info.Size
+	os.Remove(entry.path) // an open reader continues to read the unlinked file on unix
+}
+
+func (c *Cache) invalidate(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem := c.mem[key]; elem != nil {
+		c.removeMem(elem)
+	}
+	if elem := c.disk[key]; elem != nil {
+		c.removeDisk(elem)
+	}
+}
+
+func (c *Cache) diskPath(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return filepath.Join(c.opts.SpillDir, hex.EncodeToString(hash[:16]))
+}
+
+type bytesBlobReader struct {
+	*bytes.Reader
+	info Info
+}
+
+func newBytesReader(data []byte, info Info) Reader {
+	return &bytesBlobReader{bytes.NewReader(data), info}
+}
+
+func (r *bytesBlobReader) Info() Info {
+	return r.info
+}
+
+func (r *bytesBlobReader) Close() error {
+	return nil
+}
@@ -0,0 +1,131 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base32"
+	"errors"
+	"hash"
+	"io"
+	"os"
+	"strings"
+)
+
+// CID is a content identifier: a CIDv1 (raw codec, sha2-256 multihash) in base32 multibase form, e.g. "bafkrei...".
+// Identical content always has the same CID, so a CID names immutable content and can be cached indefinitely.
+type CID string
+
+// CASPrefix is the key prefix under which PutContent stores content-addressed blobs.
+const CASPrefix = "cid/"
+
+var (
+	ErrBadCID    = errors.New("malformed CID")
+	ErrIntegrity = errors.New("blob content does not match its CID")
+)
+
+// cidv1 | raw | sha2-256 | 32 byte digest
+var cidHeader = []byte{0x01, 0x55, 0x12, 0x20}
+
+var b32 = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// CIDOf returns the CID of the given content.
+func CIDOf(data []byte) CID {
+	digest := sha256.Sum256(data)
+	return cidFromDigest(digest[:])
+}
+
+// ComputeCID reads r to EOF, returning the CID of its content and its byte length.
+func ComputeCID(r io.Reader) (CID, int64, error) {
+	h := sha256.New()
+	n, err := io.Copy(h, r)
+	if err != nil {
+		return "", n, err
+	}
+	return cidFromDigest(h.Sum(nil)), n, nil
+}
+
+// ParseCID validates the given string as a CID produced by this package.
+func ParseCID(str string) (CID, error) {
+	cid := CID(str)
+	if cid.Digest() == nil {
+		return "", ErrBadCID
+	}
+	return cid, nil
+}
+
+// Digest returns the sha2-256 digest named by this CID, or nil if malformed.
+func (cid CID) Digest() []byte {
+	if len(cid) < 2 || cid[0] != 'b' {
+		return nil
+	}
+	raw, err := b32.DecodeString(strings.ToUpper(string(cid[1:])))
+	if err != nil || len(raw) != len(cidHeader)+sha256.Size || !bytes.HasPrefix(raw, cidHeader) {
+		return nil
+	}
+	return raw[len(cidHeader):]
+}
+
+// Key returns the Store key that PutContent stores this CID's content under.
+func (cid CID) Key() string {
+	return CASPrefix + string(cid)
+}
+
+func cidFromDigest(digest []byte) CID {
+	raw := append(append([]byte(nil), cidHeader...), digest...)
+	return CID("b" + strings.ToLower(b32.EncodeToString(raw)))
+}
+
+// PutContent stores the content read from r under its CID, returning the CID.
+// If content with the same CID is already stored, it is not written again.
+func PutContent(ctx context.Context, st Store, r io.Reader, info Info) (CID, error) {
+	tmp, err := os.CreateTemp("", "blob-*")
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		tmp.Close()
+		os.Remove(tmp.Name())
+	}()
+
+	cid, size, err := ComputeCID(io.TeeReader(r, tmp))
+	if err != nil {
+		return "", err
+	}
+	if _, err = st.Stat(ctx, cid.Key()); err == nil {
+		return cid, nil // already stored
+	} else if err != ErrNotFound {
+		return "", err
+	}
+
+	if _, err = tmp.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	info.Size = size
+	info.ETag = string(cid)
+	return cid, st.Put(ctx, cid.Key(), tmp, info)
+}
+
+// VerifyReader wraps r, returning ErrIntegrity in place of io.EOF if the content read does not match the given CID.
+func VerifyReader(r io.Reader, cid CID) io.Reader {
+	return &verifyReader{
+		r:      r,
+		h:      sha256.New(),
+		digest: cid.Digest(),
+	}
+}
+
+type verifyReader struct {
+	r      io.Reader
+	h      hash.Hash
+	digest []byte
+}
+
+func (vr *verifyReader) Read(buf []byte) (int, error) {
+	n, err := vr.r.Read(buf)
+	vr.h.Write(buf[:n])
+	if err == io.EOF && !bytes.Equal(vr.h.Sum(nil), vr.digest) {
+		err = ErrIntegrity
+	}
+	return n, err
+}
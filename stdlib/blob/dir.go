@@ -0,0 +1,175 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// DirOpts specifies how a directory Store serves its blobs.
+type DirOpts struct {
+	Serve       ServeMode // if zero, ServeBuffered
+	MmapMinSize int64     // with ServeMmap, smaller blobs are served via sendfile; if <= 0, 1 MiB
+}
+
+// NewDirStore returns a Store keeping each blob as a file under the given directory, read through user-space buffers.
+// A blob's content type is inferred from its key's file extension.
+func NewDirStore(dir string) (Store, error) {
+	return NewDirStoreOpts(dir, DirOpts{})
+}
+
+// NewDirStoreOpts is NewDirStore with the given options, e.g. to memory-map large video files.
+func NewDirStoreOpts(dir string, opts DirOpts) (Store, error) {
+	if opts.MmapMinSize <= 0 {
+		opts.MmapMinSize = 1 << 20
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &dirStore{
+		dir:  dir,
+		opts: opts,
+	}, nil
+}
+
+type dirStore struct {
+	dir  string
+	opts DirOpts
+}
+
+func (st *dirStore) Label() string {
+	return "dir:" + st.dir
+}
+
+// Returns the file path for the given key, rejecting keys that would escape the store's directory.
+func (st *dirStore) pathFor(key string) (string, error) {
+	clean := path.Clean("/" + key)
+	if key == "" || clean == "/" || clean[1:] != key || strings.HasSuffix(key, ".tmp") {
+		return "", ErrInvalidKey
+	}
+	return filepath.Join(st.dir, filepath.FromSlash(key)), nil
+}
+
+func (st *dirStore) Put(ctx context.Context, key string, r io.Reader, info Info) error {
+	pathname, err := st.pathFor(key)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(pathname), 0700); err != nil {
+		return err
+	}
+
+	// write to a temp file and rename so a reader never sees a partial blob
+	tmp := pathname + ".tmp"
+	file, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(file, r)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp, pathname)
+	}
+	if err != nil {
+		os.Remove(tmp)
+	}
+	return err
+}
+
+func (st *dirStore) Open(ctx context.Context, key string) (Reader, error) {
+	pathname, err := st.pathFor(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(pathname)
+	if err != nil {
+		return nil, fsErr(err)
+	}
+	stat, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	reader := fileReader{
+		File: file,
+		info: fileInfo(key, stat),
+	}
+	switch st.opts.Serve {
+	case ServeSendfile:
+		return &sendfileReader{reader}, nil
+	case ServeMmap:
+		if stat.Size() >= st.opts.MmapMinSize {
+			return mmapFile(reader)
+		}
+		return &sendfileReader{reader}, nil
+	default:
+		return &reader, nil
+	}
+}
+
+func (st *dirStore) Stat(ctx context.Context, key string) (Info, error) {
+	pathname, err := st.pathFor(key)
+	if err != nil {
+		return Info{}, err
+	}
+	stat, err := os.Stat(pathname)
+	if err != nil {
+		return Info{}, fsErr(err)
+	}
+	return fileInfo(key, stat), nil
+}
+
+func (st *dirStore) Delete(ctx context.Context, key string) error {
+	pathname, err := st.pathFor(key)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(pathname)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+type fileReader struct {
+	*os.File
+	info Info
+}
+
+func (r *fileReader) Info() Info {
+	return r.info
+}
+
+// sendfileReader exposes its file so it can be served via sendfile(2) (see FileReader).
+type sendfileReader struct {
+	fileReader
+}
+
+func (r *sendfileReader) File() *os.File {
+	return r.fileReader.File
+}
+
+func fileInfo(key string, stat fs.FileInfo) Info {
+	return Info{
+		Size:        stat.Size(),
+		ContentType: mime.TypeByExtension(path.Ext(key)),
+		ModTime:     stat.ModTime(),
+		ETag:        fmt.Sprintf("%x-%x", stat.ModTime().UnixNano(), stat.Size()),
+	}
+}
+
+func fsErr(err error) error {
+	if errors.Is(err, fs.ErrNotExist) {
+		return ErrNotFound
+	}
+	return err
+}
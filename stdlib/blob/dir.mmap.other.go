@@ -0,0 +1,8 @@
+//go:build !unix
+
+package blob
+
+// mmapFile falls back to sendfile where memory-mapping is not supported.
+func mmapFile(file fileReader) (Reader, error) {
+	return &sendfileReader{file}, nil
+}
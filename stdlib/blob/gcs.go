@@ -0,0 +1,25 @@
+package blob
+
+import "net/http"
+
+// GCSOpts specifies a Google Cloud Storage bucket accessed via HMAC keys (Cloud Storage > Settings > Interoperability).
+type GCSOpts struct {
+	Bucket    string       // bucket name
+	Prefix    string       // optional: prepended to every key
+	AccessKey string       // HMAC access ID
+	SecretKey string       // HMAC secret
+	Client    *http.Client // if nil, http.DefaultClient
+}
+
+// NewGCSStore returns a Store backed by a GCS bucket, using GCS's S3-compatible XML API.
+func NewGCSStore(opts GCSOpts) Store {
+	return NewS3Store(S3Opts{
+		Endpoint:  "https://storage.googleapis.com",
+		Region:    "auto",
+		Bucket:    opts.Bucket,
+		Prefix:    opts.Prefix,
+		AccessKey: opts.AccessKey,
+		SecretKey: opts.SecretKey,
+		Client:    opts.Client,
+	})
+}
@@ -0,0 +1,222 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// IPFSOpts specifies an IPFS node accessed via its RPC API (e.g. Kubo).
+type IPFSOpts struct {
+	APIAddr string       // RPC API address; if empty, "http://127.0.0.1:5001"
+	Root    string       // MFS directory blobs are kept in; if empty, "/amp"
+	Gateway string       // optional: gateway used by GatewayURL (e.g. "https://ipfs.io")
+	Client  *http.Client // if nil, http.DefaultClient
+}
+
+// IPFSStore is a Store keeping blobs in an IPFS node's mutable file system (MFS), which also keeps them pinned.
+//
+// Each blob's ETag is its IPFS CID, so content published by one host can be imported by another via Import().
+// Note an IPFS CID generally differs from the blob's CID (see CIDOf) since IPFS chunks large content into a DAG.
+type IPFSStore struct {
+	opts IPFSOpts
+}
+
+var _ Store = (*IPFSStore)(nil)
+
+func NewIPFSStore(opts IPFSOpts) *IPFSStore {
+	if opts.APIAddr == "" {
+		opts.APIAddr = "http://127.0.0.1:5001"
+	}
+	if opts.Root == "" {
+		opts.Root = "/amp"
+	}
+	if opts.Client == nil {
+		opts.Client = http.DefaultClient
+	}
+	opts.APIAddr = strings.TrimSuffix(opts.APIAddr, "/")
+	opts.Gateway = strings.TrimSuffix(opts.Gateway, "/")
+	return &IPFSStore{
+		opts: opts,
+	}
+}
+
+func (st *IPFSStore) Label() string {
+	return "ipfs:" + st.opts.APIAddr + st.opts.Root
+}
+
+func (st *IPFSStore) mfsPath(key string) (string, error) {
+	clean := path.Clean("/" + key)
+	if key == "" || clean[1:] != key {
+		return "", ErrInvalidKey
+	}
+	return path.Join(st.opts.Root, key), nil
+}
+
+func (st *IPFSStore) Put(ctx context.Context, key string, r io.Reader, info Info) error {
+	mfsPath, err := st.mfsPath(key)
+	if err != nil {
+		return err
+	}
+
+	body, w := io.Pipe()
+	form := multipart.NewWriter(w)
+	go func() {
+		part, err := form.CreateFormFile("file", path.Base(key))
+		if err == nil {
+			_, err = io.Copy(part, r)
+		}
+		if err == nil {
+			err = form.Close()
+		}
+		w.CloseWithError(err)
+	}()
+
+	args := url.Values{
+		"arg":         {mfsPath},
+		"create":      {"true"},
+		"parents":     {"true"},
+		"truncate":    {"true"},
+		"raw-leaves":  {"true"},
+		"cid-version": {"1"},
+	}
+	resp, err := st.call(ctx, "files/write", args, body, form.FormDataContentType())
+	if err != nil {
+		body.CloseWithError(err)
+		return err
+	}
+	resp.Close()
+	return nil
+}
+
+func (st *IPFSStore) Open(ctx context.Context, key string) (Reader, error) {
+	info, err := st.Stat(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	mfsPath, _ := st.mfsPath(key)
+	return &rangeReader{
+		info: info,
+		fetch: func(offset int64) (io.ReadCloser, error) {
+			args := url.Values{
+				"arg":    {mfsPath},
+				"offset": {strconv.FormatInt(offset, 10)},
+			}
+			return st.call(ctx, "files/read", args, nil, "")
+		},
+	}, nil
+}
+
+func (st *IPFSStore) Stat(ctx context.Context, key string) (Info, error) {
+	mfsPath, err := st.mfsPath(key)
+	if err != nil {
+		return Info{}, err
+	}
+	resp, err := st.call(ctx, "files/stat", url.Values{"arg": {mfsPath}}, nil, "")
+	if err != nil {
+		return Info{}, err
+	}
+	defer resp.Close()
+
+	var stat struct {
+		Hash string
+		Size int64
+		Type string
+	}
+	if err = json.NewDecoder(resp).Decode(&stat); err != nil {
+		return Info{}, err
+	}
+	if stat.Type != "file" {
+		return Info{}, ErrNotFound
+	}
+	return Info{
+		Size:        stat.Size,
+		ContentType: mime.TypeByExtension(path.Ext(key)),
+		ETag:        stat.Hash,
+	}, nil
+}
+
+func (st *IPFSStore) Delete(ctx context.Context, key string) error {
+	mfsPath, err := st.mfsPath(key)
+	if err != nil {
+		return err
+	}
+	resp, err := st.call(ctx, "files/rm", url.Values{"arg": {mfsPath}, "force": {"true"}}, nil, "")
+	if err == ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	resp.Close()
+	return nil
+}
+
+// Import links content already on the IPFS network (e.g. published by another host) into this store under the given key.
+// The content is fetched from the network as it is read and pinned by this node.
+func (st *IPFSStore) Import(ctx context.Context, key string, ipfsCID string) error {
+	mfsPath, err := st.mfsPath(key)
+	if err != nil {
+		return err
+	}
+	st.Delete(ctx, key) // files/cp does not overwrite
+
+	args := url.Values{
+		"arg":     {"/ipfs/" + ipfsCID, mfsPath},
+		"parents": {"true"},
+	}
+	resp, err := st.call(ctx, "files/cp", args, nil, "")
+	if err != nil {
+		return err
+	}
+	resp.Close()
+	return nil
+}
+
+// GatewayURL returns the public gateway URL of the given IPFS CID (e.g. a blob's ETag), or "" if IPFSOpts.Gateway is not set.
+func (st *IPFSStore) GatewayURL(ipfsCID string) string {
+	if st.opts.Gateway == "" {
+		return ""
+	}
+	return st.opts.Gateway + "/ipfs/" + ipfsCID
+}
+
+// call invokes an RPC API command, returning the response body.
+func (st *IPFSStore) call(ctx context.Context, cmd string, args url.Values, body io.Reader, contentType string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, st.opts.APIAddr+"/api/v0/"+cmd+"?"+args.Encode(), body)
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	resp, err := st.opts.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusOK {
+		return resp.Body, nil
+	}
+
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	resp.Body.Close()
+	var rpcErr struct {
+		Message string
+	}
+	if json.Unmarshal(msg, &rpcErr) == nil && rpcErr.Message != "" {
+		if strings.Contains(rpcErr.Message, "does not exist") {
+			return nil, ErrNotFound
+		}
+		msg = []byte(rpcErr.Message)
+	}
+	return nil, fmt.Errorf("ipfs %s: %s %s", cmd, resp.Status, bytes.TrimSpace(msg))
+}
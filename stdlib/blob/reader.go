@@ -0,0 +1,82 @@
+package blob
+
+import (
+	"errors"
+	"io"
+	"sync"
+)
+
+// rangeReader reads a remote blob from a given offset onward, fetching a new range after each Seek().
+// This allows a blob to be served in byte ranges without reading it in full.
+type rangeReader struct {
+	info  Info
+	fetch func(offset int64) (io.ReadCloser, error) // returns the blob's content starting at offset
+
+	mu     sync.Mutex
+	pos    int64
+	body   io.ReadCloser // nil until the next Read() after a Seek()
+	closed bool
+}
+
+func (r *rangeReader) Info() Info {
+	return r.info
+}
+
+func (r *rangeReader) Read(buf []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.closed {
+		return 0, io.ErrClosedPipe
+	}
+	if r.pos >= r.info.Size {
+		return 0, io.EOF
+	}
+	if r.body == nil {
+		body, err := r.fetch(r.pos)
+		if err != nil {
+			return 0, err
+		}
+		r.body = body
+	}
+	n, err := r.body.Read(buf)
+	r.pos += int64(n)
+	if err == io.EOF && r.pos < r.info.Size {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+func (r *rangeReader) Seek(offset int64, whence int) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	pos := offset
+	switch whence {
+	case io.SeekCurrent:
+		pos += r.pos
+	case io.SeekEnd:
+		pos += r.info.Size
+	}
+	if pos < 0 {
+		return r.pos, errors.New("blob: negative seek position")
+	}
+	if pos != r.pos && r.body != nil {
+		r.body.Close()
+		r.body = nil
+	}
+	r.pos = pos
+	return pos, nil
+}
+
+func (r *rangeReader) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.closed = true
+	if r.body != nil {
+		r.body.Close()
+		r.body = nil
+	}
+	return nil
+}
@@ -0,0 +1,227 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// S3Opts specifies an S3-compatible bucket.
+type S3Opts struct {
+	Endpoint  string       // e.g. "https://s3.us-east-1.amazonaws.com" or "http://localhost:9000"
+	Region    string       // signing region; if empty, "us-east-1"
+	Bucket    string       // bucket name, addressed path-style ({Endpoint}/{Bucket}/{key})
+	Prefix    string       // optional: prepended to every key (e.g. "assets/")
+	AccessKey string       // access key ID
+	SecretKey string       // secret access key
+	Client    *http.Client // if nil, http.DefaultClient
+}
+
+// NewS3Store returns a Store backed by an S3-compatible bucket, signing requests with AWS Signature Version 4.
+func NewS3Store(opts S3Opts) Store {
+	if opts.Region == "" {
+		opts.Region = "us-east-1"
+	}
+	if opts.Client == nil {
+		opts.Client = http.DefaultClient
+	}
+	opts.Endpoint = strings.TrimSuffix(opts.Endpoint, "/")
+	return &s3Store{
+		opts: opts,
+	}
+}
+
+type s3Store struct {
+	opts S3Opts
+}
+
+func (st *s3Store) Label() string {
+	return fmt.Sprintf("s3:%s/%s", st.opts.Endpoint, st.opts.Bucket)
+}
+
+func (st *s3Store) Put(ctx context.Context, key string, r io.Reader, info Info) error {
+	if info.Size <= 0 {
+		buf, err := io.ReadAll(r) // S3 requires a Content-Length
+		if err != nil {
+			return err
+		}
+		r = bytes.NewReader(buf)
+		info.Size = int64(len(buf))
+	}
+	req, err := st.newRequest(ctx, http.MethodPut, key, r)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = info.Size
+	if info.ContentType != "" {
+		req.Header.Set("Content-Type", info.ContentType)
+	}
+	resp, err := st.do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (st *s3Store) Open(ctx context.Context, key string) (Reader, error) {
+	info, err := st.Stat(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return &rangeReader{
+		info: info,
+		fetch: func(offset int64) (io.ReadCloser, error) {
+			req, err := st.newRequest(ctx, http.MethodGet, key, nil)
+			if err != nil {
+				return nil, err
+			}
+			req.Header.Set("Range", "bytes="+strconv.FormatInt(offset, 10)+"-")
+			resp, err := st.do(req)
+			if err != nil {
+				return nil, err
+			}
+			return resp.Body, nil
+		},
+	}, nil
+}
+
+func (st *s3Store) Stat(ctx context.Context, key string) (Info, error) {
+	req, err := st.newRequest(ctx, http.MethodHead, key, nil)
+	if err != nil {
+		return Info{}, err
+	}
+	resp, err := st.do(req)
+	if err != nil {
+		return Info{}, err
+	}
+	resp.Body.Close()
+
+	info := Info{
+		Size:        resp.ContentLength,
+		ContentType: resp.Header.Get("Content-Type"),
+		ETag:        strings.Trim(resp.Header.Get("ETag"), `"`),
+	}
+	info.ModTime, _ = http.ParseTime(resp.Header.Get("Last-Modified"))
+	return info, nil
+}
+
+func (st *s3Store) Delete(ctx context.Context, key string) error {
+	req, err := st.newRequest(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := st.do(req)
+	if err == ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (st *s3Store) newRequest(ctx context.Context, method, key string, body io.Reader) (*http.Request, error) {
+	if key == "" {
+		return nil, ErrInvalidKey
+	}
+	uri := "/" + awsEscape(st.opts.Bucket, false) + "/" + awsEscape(st.opts.Prefix+key, true)
+	return http.NewRequestWithContext(ctx, method, st.opts.Endpoint+uri, body)
+}
+
+// do signs and sends the given request, mapping a non-2xx response to an error.
+func (st *s3Store) do(req *http.Request) (*http.Response, error) {
+	st.sign(req, time.Now().UTC())
+	resp, err := st.opts.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode/100 == 2 {
+		return resp, nil
+	}
+
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+	return nil, fmt.Errorf("%s %s: %s %s", req.Method, req.URL.Path, resp.Status, bytes.TrimSpace(msg))
+}
+
+const unsignedPayload = "UNSIGNED-PAYLOAD"
+
+// sign adds AWS Signature Version 4 headers to the given request.
+func (st *s3Store) sign(req *http.Request, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := amzDate[:8]
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", unsignedPayload)
+
+	signed := []string{"host", "x-amz-content-sha256", "x-amz-date"}
+	if req.Header.Get("Range") != "" {
+		signed = []string{"host", "range", "x-amz-content-sha256", "x-amz-date"} // sorted
+	}
+	var canonHeaders strings.Builder
+	for _, name := range signed {
+		val := req.Header.Get(name)
+		if name == "host" {
+			val = req.URL.Host
+		}
+		fmt.Fprintf(&canonHeaders, "%s:%s\n", name, strings.TrimSpace(val))
+	}
+	signedHeaders := strings.Join(signed, ";")
+
+	canonReq := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonHeaders.String(),
+		signedHeaders,
+		unsignedPayload,
+	}, "\n")
+
+	scope := date + "/" + st.opts.Region + "/s3/aws4_request"
+	canonHash := sha256.Sum256([]byte(canonReq))
+	toSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(canonHash[:])
+
+	key := hmacSHA256([]byte("AWS4"+st.opts.SecretKey), date)
+	key = hmacSHA256(key, st.opts.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, toSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		st.opts.AccessKey, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// awsEscape percent-encodes all but unreserved chars (and optionally '/'), as SigV4 requires.
+func awsEscape(str string, keepSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~', c == '/' && keepSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,141 @@
+// Package geo offers an R-tree spatial index of geographic points and areas, enabling bounding box and radius queries.
+package geo
+
+import (
+	"math"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+// Index is an in-memory R-tree of items bounded by lat/lng rectangles -- concurrency safe.
+type Index interface {
+
+	// Inserts or replaces the bounds of the given item.  A point item has a zero area Rect (see PointRect).
+	Put(itemID tag.ID, bounds Rect)
+
+	// Removes the given item from this index.
+	Remove(itemID tag.ID)
+
+	// Returns items intersecting the given bounds.  If bounds.Min.Lng > bounds.Max.Lng, bounds spans the antimeridian.
+	// If limit > 0, at most limit items are returned.
+	Search(bounds Rect, limit int) []tag.ID
+
+	// Returns items within the given distance of center, ranked by ascending distance.
+	// If limit > 0, at most limit hits are returned.
+	Within(center Point, meters float64, limit int) []Hit
+
+	// Returns the number of items in this index.
+	Len() int
+}
+
+// Point is a lat/lng point in degrees.
+type Point struct {
+	Lat float64
+	Lng float64
+}
+
+// Rect is a lat/lng rectangle in degrees.
+type Rect struct {
+	Min Point // south-west corner
+	Max Point // north-east corner
+}
+
+// Hit is an item near a query point.
+type Hit struct {
+	ItemID tag.ID
+	Meters float64 // distance from the query point to the nearest point of the item's bounds
+}
+
+// EarthRadius is the mean radius of the earth in meters.
+const EarthRadius = 6371008.8
+
+// PointRect returns the zero area Rect containing only the given point.
+func PointRect(pt Point) Rect {
+	return Rect{Min: pt, Max: pt}
+}
+
+// Bounds returns the smallest Rect containing the given points.
+func Bounds(pts ...Point) Rect {
+	if len(pts) == 0 {
+		return Rect{}
+	}
+	r := PointRect(pts[0])
+	for _, pt := range pts[1:] {
+		r = r.Union(PointRect(pt))
+	}
+	return r
+}
+
+// Contains returns true if the given point is within this Rect.
+func (r Rect) Contains(pt Point) bool {
+	return pt.Lat >= r.Min.Lat && pt.Lat <= r.Max.Lat && pt.Lng >= r.Min.Lng && pt.Lng <= r.Max.Lng
+}
+
+// Intersects returns true if this Rect and the given Rect overlap.
+func (r Rect) Intersects(o Rect) bool {
+	return r.Min.Lat <= o.Max.Lat && o.Min.Lat <= r.Max.Lat && r.Min.Lng <= o.Max.Lng && o.Min.Lng <= r.Max.Lng
+}
+
+// Union returns the smallest Rect containing both this Rect and the given Rect.
+func (r Rect) Union(o Rect) Rect {
+	return Rect{
+		Min: Point{min(r.Min.Lat, o.Min.Lat), min(r.Min.Lng, o.Min.Lng)},
+		Max: Point{max(r.Max.Lat, o.Max.Lat), max(r.Max.Lng, o.Max.Lng)},
+	}
+}
+
+// Area returns the area of this Rect in square degrees.
+func (r Rect) Area() float64 {
+	return (r.Max.Lat - r.Min.Lat) * (r.Max.Lng - r.Min.Lng)
+}
+
+// Clamp returns the point within this Rect nearest to the given point (in lat/lng space).
+func (r Rect) Clamp(pt Point) Point {
+	return Point{
+		Lat: min(max(pt.Lat, r.Min.Lat), r.Max.Lat),
+		Lng: min(max(pt.Lng, r.Min.Lng), r.Max.Lng),
+	}
+}
+
+// RectAround returns a Rect containing all points within the given distance of center.
+// The returned Rect spans the antimeridian (Min.Lng > Max.Lng) when needed and spans all longitudes near the poles.
+func RectAround(center Point, meters float64) Rect {
+	dLat := meters / EarthRadius * 180 / math.Pi
+	r := Rect{
+		Min: Point{Lat: max(center.Lat-dLat, -90)},
+		Max: Point{Lat: min(center.Lat+dLat, 90)},
+	}
+
+	cosLat := math.Cos(math.Max(math.Abs(r.Min.Lat), math.Abs(r.Max.Lat)) * math.Pi / 180)
+	dLng := 180.0
+	if cosLat > 1e-9 {
+		dLng = dLat / cosLat
+	}
+	if dLng >= 180 {
+		r.Min.Lng, r.Max.Lng = -180, 180
+		return r
+	}
+	r.Min.Lng = wrapLng(center.Lng - dLng)
+	r.Max.Lng = wrapLng(center.Lng + dLng)
+	return r
+}
+
+// Distance returns the great circle distance in meters between two points (haversine).
+func Distance(a, b Point) float64 {
+	const toRad = math.Pi / 180
+	dLat := (b.Lat - a.Lat) * toRad
+	dLng := (b.Lng - a.Lng) * toRad
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(a.Lat*toRad)*math.Cos(b.Lat*toRad)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * EarthRadius * math.Asin(math.Sqrt(min(h, 1)))
+}
+
+func wrapLng(lng float64) float64 {
+	if lng < -180 {
+		return lng + 360
+	}
+	if lng > 180 {
+		return lng - 360
+	}
+	return lng
+}
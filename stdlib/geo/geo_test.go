@@ -0,0 +1,88 @@
+package geo_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/geo"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+func TestIndex(t *testing.T) {
+	rng := rand.New(rand.NewSource(3773))
+	idx := geo.NewIndex()
+
+	points := make(map[tag.ID]geo.Point)
+	for i := 0; i < 2000; i++ {
+		pt := geo.Point{
+			Lat: rng.Float64()*170 - 85,
+			Lng: rng.Float64()*360 - 180,
+		}
+		itemID := tag.Now()
+		points[itemID] = pt
+		idx.Put(itemID, geo.PointRect(pt))
+	}
+
+	check := func(bounds geo.Rect) {
+		found := make(map[tag.ID]struct{})
+		for _, itemID := range idx.Search(bounds, 0) {
+			found[itemID] = struct{}{}
+		}
+		expect := 0
+		for itemID, pt := range points {
+			inside := pt.Lat >= bounds.Min.Lat && pt.Lat <= bounds.Max.Lat
+			if bounds.Min.Lng <= bounds.Max.Lng {
+				inside = inside && pt.Lng >= bounds.Min.Lng && pt.Lng <= bounds.Max.Lng
+			} else {
+				inside = inside && (pt.Lng >= bounds.Min.Lng || pt.Lng <= bounds.Max.Lng)
+			}
+			if inside {
+				expect++
+				if _, ok := found[itemID]; !ok {
+					t.Fatalf("item missing from search %v", bounds)
+				}
+			}
+		}
+		if expect != len(found) {
+			t.Fatalf("expected %d items, got %d", expect, len(found))
+		}
+	}
+
+	viewport := geo.Rect{Min: geo.Point{Lat: 10, Lng: -40}, Max: geo.Point{Lat: 50, Lng: 20}}
+	antimeridian := geo.Rect{Min: geo.Point{Lat: -30, Lng: 160}, Max: geo.Point{Lat: 30, Lng: -170}}
+	check(viewport)
+	check(antimeridian)
+
+	// remove half
+	i := 0
+	for itemID := range points {
+		if i++; i%2 == 0 {
+			idx.Remove(itemID)
+			delete(points, itemID)
+		}
+	}
+	if idx.Len() != len(points) {
+		t.Fatalf("expected %d items, got %d", len(points), idx.Len())
+	}
+	check(viewport)
+	check(antimeridian)
+
+	// radius search
+	center := geo.Point{Lat: 37.77, Lng: -122.42}
+	const meters = 2_000_000
+	hits := idx.Within(center, meters, 0)
+	expect := 0
+	for _, pt := range points {
+		if geo.Distance(center, pt) <= meters {
+			expect++
+		}
+	}
+	if len(hits) != expect {
+		t.Fatalf("expected %d hits within radius, got %d", expect, len(hits))
+	}
+	for i := 1; i < len(hits); i++ {
+		if hits[i].Meters < hits[i-1].Meters {
+			t.Fatal("hits not ranked by distance")
+		}
+	}
+}
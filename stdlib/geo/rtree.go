@@ -0,0 +1,308 @@
+package geo
+
+import (
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+const (
+	maxEntries = 16
+	minEntries = maxEntries * 2 / 5
+)
+
+// NewIndex returns a new empty Index.
+func NewIndex() Index {
+	return &rtree{
+		root:  &node{leaf: true},
+		items: make(map[tag.ID]Rect),
+	}
+}
+
+// Implements Index
+type rtree struct {
+	mu     sync.RWMutex
+	root   *node
+	height int // number of levels above the leaves
+	items  map[tag.ID]Rect
+}
+
+type node struct {
+	leaf    bool
+	entries []entry
+}
+
+type entry struct {
+	bounds Rect
+	child  *node  // non-leaf nodes
+	itemID tag.ID // leaf nodes
+}
+
+func (n *node) bounds() Rect {
+	r := n.entries[0].bounds
+	for _, ei := range n.entries[1:] {
+		r = r.Union(ei.bounds)
+	}
+	return r
+}
+
+func (tr *rtree) Len() int {
+	tr.mu.RLock()
+	defer tr.mu.RUnlock()
+	return len(tr.items)
+}
+
+func (tr *rtree) Put(itemID tag.ID, bounds Rect) {
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+
+	tr.remove(itemID)
+	tr.items[itemID] = bounds
+	tr.insert(entry{bounds: bounds, itemID: itemID}, 0)
+}
+
+func (tr *rtree) Remove(itemID tag.ID) {
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	tr.remove(itemID)
+}
+
+// Inserts the given entry at the given level (0 == leaf) -- caller holds tr.mu
+func (tr *rtree) insert(e entry, level int) {
+	if sibling := tr.root.insert(e, tr.height, level); sibling != nil {
+		tr.root = &node{
+			entries: []entry{
+				{bounds: tr.root.bounds(), child: tr.root},
+				{bounds: sibling.bounds(), child: sibling},
+			},
+		}
+		tr.height++
+	}
+}
+
+// Inserts the given entry into this subtree, returning a new sibling node if this node was split.
+func (n *node) insert(e entry, height, level int) *node {
+	if height > level {
+		i := n.chooseSubtree(e.bounds)
+		sub := &n.entries[i]
+		sibling := sub.child.insert(e, height-1, level)
+		sub.bounds = sub.child.bounds()
+		if sibling == nil {
+			return nil
+		}
+		e = entry{bounds: sibling.bounds(), child: sibling}
+	}
+
+	n.entries = append(n.entries, e)
+	if len(n.entries) <= maxEntries {
+		return nil
+	}
+	return n.split()
+}
+
+// Returns the entry needing the least enlargement to include the given bounds, breaking ties by smallest area.
+func (n *node) chooseSubtree(bounds Rect) int {
+	best := 0
+	bestGrowth, bestArea := math.Inf(1), math.Inf(1)
+	for i, ei := range n.entries {
+		area := ei.bounds.Area()
+		growth := ei.bounds.Union(bounds).Area() - area
+		if growth < bestGrowth || (growth == bestGrowth && area < bestArea) {
+			best, bestGrowth, bestArea = i, growth, area
+		}
+	}
+	return best
+}
+
+// Splits this node's entries in two (quadratic split), returning the new sibling.
+func (n *node) split() *node {
+	entries := n.entries
+
+	// Seed each group with the pair of entries that would waste the most area together
+	seedA, seedB := 0, 1
+	worst := math.Inf(-1)
+	for i := range entries {
+		for j := i + 1; j < len(entries); j++ {
+			waste := entries[i].bounds.Union(entries[j].bounds).Area() - entries[i].bounds.Area() - entries[j].bounds.Area()
+			if waste > worst {
+				seedA, seedB, worst = i, j, waste
+			}
+		}
+	}
+
+	a := &node{leaf: n.leaf, entries: []entry{entries[seedA]}}
+	b := &node{leaf: n.leaf, entries: []entry{entries[seedB]}}
+	boundsA, boundsB := entries[seedA].bounds, entries[seedB].bounds
+
+	remain := make([]entry, 0, len(entries)-2)
+	for i, ei := range entries {
+		if i != seedA && i != seedB {
+			remain = append(remain, ei)
+		}
+	}
+
+	for len(remain) > 0 {
+		// Ensure each group ends up with at least minEntries
+		if len(a.entries)+len(remain) <= minEntries {
+			a.entries = append(a.entries, remain...)
+			break
+		}
+		if len(b.entries)+len(remain) <= minEntries {
+			b.entries = append(b.entries, remain...)
+			break
+		}
+
+		// Assign the entry with the greatest preference for one group
+		pick, pickDiff := 0, math.Inf(-1)
+		for i, ei := range remain {
+			dA := boundsA.Union(ei.bounds).Area() - boundsA.Area()
+			dB := boundsB.Union(ei.bounds).Area() - boundsB.Area()
+			if diff := math.Abs(dA - dB); diff > pickDiff {
+				pick, pickDiff = i, diff
+			}
+		}
+		e := remain[pick]
+		remain[pick] = remain[len(remain)-1]
+		remain = remain[:len(remain)-1]
+
+		dA := boundsA.Union(e.bounds).Area() - boundsA.Area()
+		dB := boundsB.Union(e.bounds).Area() - boundsB.Area()
+		if dA < dB || (dA == dB && len(a.entries) <= len(b.entries)) {
+			a.entries = append(a.entries, e)
+			boundsA = boundsA.Union(e.bounds)
+		} else {
+			b.entries = append(b.entries, e)
+			boundsB = boundsB.Union(e.bounds)
+		}
+	}
+
+	n.entries = a.entries
+	return b
+}
+
+// caller holds tr.mu
+func (tr *rtree) remove(itemID tag.ID) {
+	bounds, exists := tr.items[itemID]
+	if !exists {
+		return
+	}
+	delete(tr.items, itemID)
+
+	var orphans []entry
+	tr.root.remove(itemID, bounds, &orphans)
+
+	// Shorten the tree while the root has a single child
+	for !tr.root.leaf && len(tr.root.entries) == 1 {
+		tr.root = tr.root.entries[0].child
+		tr.height--
+	}
+	if !tr.root.leaf && len(tr.root.entries) == 0 {
+		tr.root = &node{leaf: true}
+		tr.height = 0
+	}
+
+	// Reinsert items from underfull nodes
+	for _, ei := range orphans {
+		tr.insert(ei, 0)
+	}
+}
+
+// Removes the given item from this subtree, collecting the items of nodes left underfull into orphans.
+func (n *node) remove(itemID tag.ID, bounds Rect, orphans *[]entry) bool {
+	if n.leaf {
+		for i, ei := range n.entries {
+			if ei.itemID == itemID {
+				n.entries = append(n.entries[:i], n.entries[i+1:]...)
+				return true
+			}
+		}
+		return false
+	}
+
+	for i := range n.entries {
+		sub := &n.entries[i]
+		if !sub.bounds.Intersects(bounds) || !sub.child.remove(itemID, bounds, orphans) {
+			continue
+		}
+		if len(sub.child.entries) < minEntries {
+			sub.child.collectItems(orphans)
+			n.entries = append(n.entries[:i], n.entries[i+1:]...)
+		} else {
+			sub.bounds = sub.child.bounds()
+		}
+		return true
+	}
+	return false
+}
+
+func (n *node) collectItems(dst *[]entry) {
+	if n.leaf {
+		*dst = append(*dst, n.entries...)
+		return
+	}
+	for _, ei := range n.entries {
+		ei.child.collectItems(dst)
+	}
+}
+
+func (tr *rtree) Search(bounds Rect, limit int) []tag.ID {
+	var found []tag.ID
+	tr.search(bounds, func(itemID tag.ID, _ Rect) bool {
+		found = append(found, itemID)
+		return limit <= 0 || len(found) < limit
+	})
+	return found
+}
+
+// Calls fn for each item intersecting the given bounds (splitting bounds that span the antimeridian) until fn returns false.
+func (tr *rtree) search(bounds Rect, fn func(itemID tag.ID, itemBounds Rect) bool) {
+	tr.mu.RLock()
+	defer tr.mu.RUnlock()
+
+	if bounds.Min.Lng > bounds.Max.Lng {
+		east, west := bounds, bounds
+		east.Max.Lng = 180
+		west.Min.Lng = -180
+		if tr.root.search(east, fn) {
+			tr.root.search(west, fn)
+		}
+		return
+	}
+	tr.root.search(bounds, fn)
+}
+
+func (n *node) search(bounds Rect, fn func(itemID tag.ID, itemBounds Rect) bool) bool {
+	for _, ei := range n.entries {
+		if !ei.bounds.Intersects(bounds) {
+			continue
+		}
+		if n.leaf {
+			if !fn(ei.itemID, ei.bounds) {
+				return false
+			}
+		} else if !ei.child.search(bounds, fn) {
+			return false
+		}
+	}
+	return true
+}
+
+func (tr *rtree) Within(center Point, meters float64, limit int) []Hit {
+	var hits []Hit
+	tr.search(RectAround(center, meters), func(itemID tag.ID, itemBounds Rect) bool {
+		if dist := Distance(center, itemBounds.Clamp(center)); dist <= meters {
+			hits = append(hits, Hit{ItemID: itemID, Meters: dist})
+		}
+		return true
+	})
+
+	sort.Slice(hits, func(i, j int) bool {
+		return hits[i].Meters < hits[j].Meters
+	})
+	if limit > 0 && len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits
+}
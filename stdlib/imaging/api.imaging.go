@@ -0,0 +1,35 @@
+// Package imaging produces resized and re-encoded variants of image assets on demand (e.g. thumbnails), caching each variant once produced.
+//
+// JPEG, PNG, and GIF sources are decoded; JPEG and PNG variants are encoded.
+// Other output formats (e.g. "webp") become available once registered via RegisterFormat().
+package imaging
+
+import (
+	"errors"
+	"image"
+	"io"
+)
+
+// Variant specifies a transformation of a source image.
+// The result fits within Width x Height while preserving aspect ratio, and a source is never enlarged.
+type Variant struct {
+	Width   int    // max width in pixels, or 0 if unconstrained
+	Height  int    // max height in pixels, or 0 if unconstrained
+	Format  string // output format name (e.g. "jpeg", "png", "webp"), or "" to retain the source format
+	Quality int    // encoder quality 1..100, or 0 for the format's default
+}
+
+// Format is an output image format.
+type Format struct {
+	Name        string // e.g. "webp"
+	ContentType string // e.g. "image/webp"
+
+	// Encodes the given image, where quality is 1..100 (or 0 for a default).
+	Encode func(w io.Writer, img image.Image, quality int) error
+}
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported image format")
+	ErrBadVariant        = errors.New("invalid image variant")
+	ErrTooLarge          = errors.New("image exceeds max pixel count")
+)
@@ -0,0 +1,226 @@
+package imaging
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/draw"
+	_ "image/gif" // registers the gif decoder
+	"image/jpeg"
+	"image/png"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var (
+	formatsMu sync.RWMutex
+	formats   = map[string]Format{
+		"jpeg": {
+			Name:        "jpeg",
+			ContentType: "image/jpeg",
+			Encode: func(w io.Writer, img image.Image, quality int) error {
+				if quality <= 0 {
+					quality = 85
+				}
+				return jpeg.Encode(w, img, &jpeg.Options{Quality: quality})
+			},
+		},
+		"png": {
+			Name:        "png",
+			ContentType: "image/png",
+			Encode: func(w io.Writer, img image.Image, quality int) error {
+				return png.Encode(w, img)
+			},
+		},
+	}
+)
+
+// RegisterFormat adds or replaces an output format (e.g. a "webp" encoder backed by a native library).
+func RegisterFormat(format Format) {
+	formatsMu.Lock()
+	formats[format.Name] = format
+	formatsMu.Unlock()
+}
+
+// LookupFormat returns the output format having the given name ("jpg" is an alias for "jpeg").
+func LookupFormat(name string) (Format, bool) {
+	name = strings.ToLower(name)
+	if name == "jpg" {
+		name = "jpeg"
+	}
+	formatsMu.RLock()
+	defer formatsMu.RUnlock()
+	format, ok := formats[name]
+	return format, ok
+}
+
+// ParseVariant reads a Variant from URL query params "w", "h", "format", and "q" (e.g. "?w=320&format=webp").
+// Returns false if no variant params are present.
+func ParseVariant(query url.Values) (Variant, bool, error) {
+	var v Variant
+	if !query.Has("w") && !query.Has("h") && !query.Has("format") && !query.Has("q") {
+		return v, false, nil
+	}
+
+	var err error
+	parseInt := func(key string) int {
+		str := query.Get(key)
+		if str == "" || err != nil {
+			return 0
+		}
+		n, parseErr := strconv.Atoi(str)
+		if parseErr != nil || n < 0 {
+			err = fmt.Errorf("%w: %s=%q", ErrBadVariant, key, str)
+		}
+		return n
+	}
+	v.Width = parseInt("w")
+	v.Height = parseInt("h")
+	v.Quality = parseInt("q")
+	if err != nil {
+		return v, true, err
+	}
+	if v.Quality > 100 {
+		return v, true, fmt.Errorf("%w: q must be 1..100", ErrBadVariant)
+	}
+	if name := query.Get("format"); name != "" {
+		format, ok := LookupFormat(name)
+		if !ok {
+			return v, true, fmt.Errorf("%w: %q", ErrUnsupportedFormat, name)
+		}
+		v.Format = format.Name
+	}
+	return v, true, nil
+}
+
+// String returns a canonical description of this variant, suitable as a cache key.
+func (v Variant) String() string {
+	format := v.Format
+	if format == "" {
+		format = "src"
+	}
+	return fmt.Sprintf("w%d-h%d-q%d.%s", v.Width, v.Height, v.Quality, format)
+}
+
+// Transform decodes the given source image and returns the requested variant, encoded, along with its format.
+// Sources having more than maxPixels pixels are rejected (if maxPixels > 0).
+func Transform(src []byte, v Variant, maxPixels int) ([]byte, Format, error) {
+	cfg, srcFormat, err := image.DecodeConfig(bytes.NewReader(src))
+	if err != nil {
+		return nil, Format{}, err
+	}
+	if maxPixels > 0 && cfg.Width*cfg.Height > maxPixels {
+		return nil, Format{}, ErrTooLarge
+	}
+
+	name := v.Format
+	if name == "" {
+		name = srcFormat
+	}
+	format, ok := LookupFormat(name)
+	if !ok {
+		format, _ = LookupFormat("png") // e.g. a gif source
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(src))
+	if err != nil {
+		return nil, Format{}, err
+	}
+	if w, h := fitWithin(cfg.Width, cfg.Height, v.Width, v.Height); w != cfg.Width || h != cfg.Height {
+		img = Resize(img, w, h)
+	}
+
+	var out bytes.Buffer
+	if err = format.Encode(&out, img, v.Quality); err != nil {
+		return nil, Format{}, err
+	}
+	return out.Bytes(), format, nil
+}
+
+// fitWithin returns the size of a srcW x srcH image scaled to fit within maxW x maxH (0 is unconstrained), never enlarging it.
+func fitWithin(srcW, srcH, maxW, maxH int) (int, int) {
+	scale := 1.0
+	if maxW > 0 && maxW < srcW {
+		scale = float64(maxW) / float64(srcW)
+	}
+	if maxH > 0 && maxH < srcH {
+		scale = min(scale, float64(maxH)/float64(srcH))
+	}
+	if scale >= 1 {
+		return srcW, srcH
+	}
+	return max(1, int(float64(srcW)*scale+0.5)), max(1, int(float64(srcH)*scale+0.5))
+}
+
+// Resize returns the given image scaled to w x h using area averaging, which is well suited to downscaling (e.g. thumbnails).
+func Resize(src image.Image, w, h int) *image.RGBA {
+	bounds := src.Bounds()
+	rgba, ok := src.(*image.RGBA)
+	if !ok || bounds.Min != (image.Point{}) {
+		rgba = image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+		draw.Draw(rgba, rgba.Bounds(), src, bounds.Min, draw.Src)
+	}
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+
+	// horizontal pass: srcW x srcH -> w x srcH
+	cols := contributions(srcW, w)
+	tmp := make([]float32, w*srcH*4)
+	for y := 0; y < srcH; y++ {
+		row := rgba.Pix[y*rgba.Stride:]
+		for x, contribs := range cols {
+			var acc [4]float32
+			for _, c := range contribs {
+				px := row[c.index*4:]
+				for i := range acc {
+					acc[i] += float32(px[i]) * c.weight
+				}
+			}
+			copy(tmp[(y*w+x)*4:], acc[:])
+		}
+	}
+
+	// vertical pass: w x srcH -> w x h
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	rows := contributions(srcH, h)
+	for y, contribs := range rows {
+		for x := 0; x < w; x++ {
+			var acc [4]float32
+			for _, c := range contribs {
+				px := tmp[(c.index*w+x)*4:]
+				for i := range acc {
+					acc[i] += px[i] * c.weight
+				}
+			}
+			out := dst.Pix[y*dst.Stride+x*4:]
+			for i := range acc {
+				out[i] = uint8(min(255, acc[i]+0.5))
+			}
+		}
+	}
+	return dst
+}
+
+type contribution struct {
+	index  int
+	weight float32
+}
+
+// contributions returns, for each destination pixel, the source pixels it covers and their normalized coverage.
+func contributions(srcN, dstN int) [][]contribution {
+	scale := float64(srcN) / float64(dstN)
+	all := make([][]contribution, dstN)
+	for d := range all {
+		start := float64(d) * scale
+		end := start + scale
+		for s := int(start); s < srcN && float64(s) < end; s++ {
+			coverage := min(end, float64(s+1)) - max(start, float64(s))
+			if coverage > 0 {
+				all[d] = append(all[d], contribution{s, float32(coverage / scale)})
+			}
+		}
+	}
+	return all
+}
@@ -0,0 +1,93 @@
+package imaging_test
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"net/url"
+	"testing"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/blob"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/imaging"
+)
+
+func testPNG(w, h int) []byte {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, color.NRGBA{R: 200, G: uint8(x), B: 40, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	png.Encode(&buf, img)
+	return buf.Bytes()
+}
+
+func TestTransform(t *testing.T) {
+	src := testPNG(100, 50)
+
+	out, format, err := imaging.Transform(src, imaging.Variant{Width: 20, Format: "jpeg"}, 0)
+	if err != nil || format.ContentType != "image/jpeg" {
+		t.Fatalf("Transform: %v, %v", format, err)
+	}
+	cfg, name, err := image.DecodeConfig(bytes.NewReader(out))
+	if err != nil || name != "jpeg" || cfg.Width != 20 || cfg.Height != 10 {
+		t.Fatalf("unexpected variant: %s %dx%d, %v", name, cfg.Width, cfg.Height, err)
+	}
+
+	// never enlarged, format retained
+	out, _, _ = imaging.Transform(src, imaging.Variant{Width: 400, Height: 400}, 0)
+	if cfg, name, _ = image.DecodeConfig(bytes.NewReader(out)); name != "png" || cfg.Width != 100 {
+		t.Fatalf("unexpected variant: %s %dx%d", name, cfg.Width, cfg.Height)
+	}
+
+	if _, _, err = imaging.Transform(src, imaging.Variant{Width: 10}, 1000); err != imaging.ErrTooLarge {
+		t.Fatalf("expected ErrTooLarge, got %v", err)
+	}
+}
+
+func TestParseVariant(t *testing.T) {
+	query, _ := url.ParseQuery("w=320&h=240&format=jpg&q=70")
+	v, ok, err := imaging.ParseVariant(query)
+	if err != nil || !ok || v != (imaging.Variant{Width: 320, Height: 240, Format: "jpeg", Quality: 70}) {
+		t.Fatalf("ParseVariant: %+v, %v", v, err)
+	}
+	if _, ok, _ = imaging.ParseVariant(url.Values{}); ok {
+		t.Fatal("expected no variant")
+	}
+	query, _ = url.ParseQuery("format=bmp")
+	if _, _, err = imaging.ParseVariant(query); err == nil {
+		t.Fatal("expected unsupported format")
+	}
+}
+
+func TestPipelineCache(t *testing.T) {
+	cache, _ := blob.NewDirStore(t.TempDir())
+	pipeline := imaging.NewPipeline(imaging.PipelineOpts{Cache: cache})
+	src := testPNG(64, 64)
+
+	opens := 0
+	open := func() (io.ReadCloser, error) {
+		opens++
+		return io.NopCloser(bytes.NewReader(src)), nil
+	}
+
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		r, err := pipeline.Variant(ctx, "src-1", open, imaging.Variant{Width: 16})
+		if err != nil {
+			t.Fatal(err)
+		}
+		out, _ := io.ReadAll(r)
+		r.Close()
+		if cfg, _, _ := image.DecodeConfig(bytes.NewReader(out)); cfg.Width != 16 || r.Info().ContentType != "image/png" {
+			t.Fatalf("unexpected variant: %dx%d", cfg.Width, cfg.Height)
+		}
+	}
+	if opens != 1 {
+		t.Fatalf("expected the source to be read once, got %d", opens)
+	}
+}
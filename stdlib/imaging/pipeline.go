@@ -0,0 +1,175 @@
+package imaging
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/blob"
+)
+
+// PipelineOpts specifies a Pipeline.
+type PipelineOpts struct {
+	Cache     blob.Store // variants are cached here (e.g. blob.NewDirStore); if nil, each request produces its variant anew
+	MaxPixels int        // sources having more pixels are rejected; if <= 0, 64 megapixels
+	MaxDim    int        // max variant Width or Height; if <= 0, 4096
+}
+
+// Pipeline produces image variants on demand, caching each so it is only produced once -- concurrency safe.
+type Pipeline struct {
+	opts     PipelineOpts
+	mu       sync.Mutex
+	inflight map[string]*variantCall // concurrent requests for the same variant share one transform
+}
+
+type variantCall struct {
+	done   chan struct{}
+	output []byte
+	info   blob.Info
+	err    error
+}
+
+func NewPipeline(opts PipelineOpts) *Pipeline {
+	if opts.MaxPixels <= 0 {
+		opts.MaxPixels = 64 << 20
+	}
+	if opts.MaxDim <= 0 {
+		opts.MaxDim = 4096
+	}
+	return &Pipeline{
+		opts:     opts,
+		inflight: make(map[string]*variantCall),
+	}
+}
+
+// Variant returns a reader of the requested variant of a source image.
+//
+// srcID identifies the source's content and must change whenever the content changes (e.g. a CID, or a key and its ETag).
+// open is only called if the variant is not already cached.
+func (p *Pipeline) Variant(ctx context.Context, srcID string, open func() (io.ReadCloser, error), v Variant) (blob.Reader, error) {
+	if v.Width > p.opts.MaxDim || v.Height > p.opts.MaxDim {
+		return nil, ErrBadVariant
+	}
+
+	hash := sha256.Sum256([]byte(srcID))
+	key := "variants/" + hex.EncodeToString(hash[:16]) + "/" + v.String()
+
+	if p.opts.Cache != nil {
+		r, err := p.opts.Cache.Open(ctx, key)
+		if err == nil {
+			return withContentType(r)
+		}
+		if err != blob.ErrNotFound {
+			return nil, err
+		}
+	}
+
+	p.mu.Lock()
+	call := p.inflight[key]
+	if call == nil {
+		call = &variantCall{
+			done: make(chan struct{}),
+		}
+		p.inflight[key] = call
+		p.mu.Unlock()
+
+		call.output, call.info, call.err = p.produce(ctx, key, open, v)
+		close(call.done)
+
+		p.mu.Lock()
+		delete(p.inflight, key)
+		p.mu.Unlock()
+	} else {
+		p.mu.Unlock()
+		select {
+		case <-call.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if call.err != nil {
+		return nil, call.err
+	}
+	return &memReader{
+		Reader: bytes.NewReader(call.output),
+		info:   call.info,
+	}, nil
+}
+
+func (p *Pipeline) produce(ctx context.Context, key string, open func() (io.ReadCloser, error), v Variant) ([]byte, blob.Info, error) {
+	r, err := open()
+	if err != nil {
+		return nil, blob.Info{}, err
+	}
+	src, err := io.ReadAll(r)
+	r.Close()
+	if err != nil {
+		return nil, blob.Info{}, err
+	}
+
+	output, format, err := Transform(src, v, p.opts.MaxPixels)
+	if err != nil {
+		return nil, blob.Info{}, err
+	}
+	info := blob.Info{
+		Size:        int64(len(output)),
+		ContentType: format.ContentType,
+		ModTime:     time.Now(),
+		ETag:        string(blob.CIDOf(output)),
+	}
+
+	if p.opts.Cache != nil {
+		if err = p.opts.Cache.Put(ctx, key, bytes.NewReader(output), info); err != nil {
+			return nil, blob.Info{}, err
+		}
+	}
+	return output, info, nil
+}
+
+// withContentType ensures a cached variant's content type is known, since not every store retains it (e.g. blob.NewDirStore).
+func withContentType(r blob.Reader) (blob.Reader, error) {
+	info := r.Info()
+	if strings.HasPrefix(info.ContentType, "image/") {
+		return r, nil
+	}
+	head := make([]byte, 512)
+	n, err := io.ReadFull(r, head)
+	if err == nil || err == io.ErrUnexpectedEOF {
+		_, err = r.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+	info.ContentType = http.DetectContentType(head[:n])
+	return &typedReader{r, info}, nil
+}
+
+type typedReader struct {
+	blob.Reader
+	info blob.Info
+}
+
+func (r *typedReader) Info() blob.Info {
+	return r.info
+}
+
+type memReader struct {
+	*bytes.Reader
+	info blob.Info
+}
+
+func (r *memReader) Info() blob.Info {
+	return r.info
+}
+
+func (r *memReader) Close() error {
+	return nil
+}
@@ -0,0 +1,72 @@
+package log
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLevels(t *testing.T) {
+	levels := NewLevels(LevelWarn, "app", FieldSubsystem)
+	levels.Set("transport", LevelDebug)
+	levels.Set("amp.app.noisy", LevelError)
+
+	entries := []struct {
+		entry   Entry
+		enabled bool
+	}{
+		{Entry{Level: LevelInfo, Label: "pin"}, false},
+		{Entry{Level: LevelWarn, Label: "pin"}, true},
+		{Entry{Level: LevelDebug, Fields: Fields{FieldSubsystem: "transport"}}, true},
+		{Entry{Level: LevelWarn, Fields: Fields{"app": "amp.app.noisy", FieldSubsystem: "transport"}}, false},
+		{Entry{Level: LevelDebug, Label: "transport: conn 3"}, true},
+	}
+	for i, tc := range entries {
+		if got := levels.Enabled(&tc.entry); got != tc.enabled {
+			t.Errorf("entry %d: expected enabled=%v", i, tc.enabled)
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	levels.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/loglevels?subsystem=registry&level=debug", nil))
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `"registry":"debug"`) {
+		t.Fatalf("unexpected response %d: %s", rec.Code, rec.Body.String())
+	}
+	if !levels.Enabled(&Entry{Level: LevelDebug, Fields: Fields{FieldSubsystem: "registry"}}) {
+		t.Fatal("expected level set via ServeHTTP to apply")
+	}
+}
+
+func TestRotatingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs", "host.log")
+	rf, err := NewRotatingFile(RotateOpts{
+		Path:       path,
+		MaxSize:    200,
+		MaxBackups: 2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 20; i++ {
+		rf.Log(&Entry{Time: time.Now(), Level: LevelInfo, Label: "test", Msg: "a message of modest length"})
+	}
+	if err := rf.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := rf.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	matches, _ := filepath.Glob(path + ".*")
+	if len(matches) != 2 {
+		t.Fatalf("expected 2 rotated files, got %d", len(matches))
+	}
+	info, err := os.Stat(path)
+	if err != nil || info.Size() > 200 {
+		t.Fatalf("expected current file within MaxSize: %v", err)
+	}
+}
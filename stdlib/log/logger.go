@@ -40,6 +40,12 @@ type Logger interface {
 	Errorf(inFormat string, args ...interface{})
 	Errorw(inFormat string, fields Fields)
 	Fatalf(inFormat string, args ...interface{})
+
+	// Returns the fields included with every entry this Logger emits (e.g. a session ID or app UID).
+	Fields() Fields
+
+	// Returns a Logger having the same label and Sink as this Logger and the given fields merged with this Logger's fields.
+	With(fields Fields) Logger
 }
 
 func InitFlags(flagset *flag.FlagSet) {
@@ -66,6 +72,8 @@ type logger struct {
 	hasPrefix bool
 	logPrefix string
 	logLabel  string
+	fields    Fields // included with every entry
+	sink      Sink   // if nil, the Sink set via SetSink() (or klog if none)
 }
 
 var (
@@ -89,6 +97,56 @@ func NewLogger(label string) Logger {
 	return l
 }
 
+// NewLoggerFrom creates a Logger with the given label that inherits the Sink and fields of the given parent (if non-nil), adding the given fields.
+// This is how a task.Context's Logger inherits from its parent's.
+func NewLoggerFrom(parent Logger, label string, fields Fields) Logger {
+	l := &logger{
+		fields: fields,
+	}
+	if parent != nil {
+		if len(fields) == 0 {
+			l.fields = parent.Fields()
+		} else {
+			l.fields = parent.Fields().Merge(fields)
+		}
+		if pl, ok := parent.(*logger); ok {
+			l.sink = pl.sink
+		}
+	}
+	l.SetLogLabel(label)
+	return l
+}
+
+// NewSinkLogger creates a Logger with the given label that emits to the given Sink rather than the Sink set via SetSink().
+func NewSinkLogger(sink Sink, label string, fields Fields) Logger {
+	l := &logger{
+		fields: fields,
+		sink:   sink,
+	}
+	l.SetLogLabel(label)
+	return l
+}
+
+func (l *logger) Fields() Fields {
+	return l.fields
+}
+
+func (l *logger) With(fields Fields) Logger {
+	derived := *l
+	derived.fields = l.fields.Merge(fields)
+	return &derived
+}
+
+func (l *logger) currentSink() Sink {
+	if l.sink != nil {
+		return l.sink
+	}
+	if holder := gSink.Load(); holder != nil {
+		return holder.sink
+	}
+	return nil
+}
+
 // Fatalf -- see Fatalf (above)
 func Fatalf(inFormat string, args ...interface{}) {
 	gLogger.Fatalf(inFormat, args...)
@@ -140,51 +198,27 @@ func (l *logger) LogV(inVerboseLevel int32) bool {
 }
 
 func (l *logger) Debug(args ...interface{}) {
-	if l.hasPrefix {
-		klog.DebugDepth(1, l.logPrefix, l.Padding(), fmt.Sprint(args...))
-	} else {
-		klog.DebugDepth(1, args...)
-	}
+	l.emit(LevelDebug, 0, fmt.Sprint(args...), nil)
 }
 
 func (l *logger) Debugf(inFormat string, args ...interface{}) {
-	if l.hasPrefix {
-		klog.DebugDepth(1, l.logPrefix, l.Padding(), fmt.Sprintf(inFormat, args...))
-	} else {
-		klog.DebugDepth(1, fmt.Sprintf(inFormat, args...))
-	}
+	l.emit(LevelDebug, 0, fmt.Sprintf(inFormat, args...), nil)
 }
 
 func (l *logger) Debugw(msg string, fields Fields) {
-	if l.hasPrefix {
-		klog.DebugDepth(1, l.logPrefix, l.Padding(), fmt.Sprintf(msg+" %v", fields))
-	} else {
-		klog.DebugDepth(1, fmt.Sprintf(msg+" %v", fields))
-	}
+	l.emit(LevelDebug, 0, msg, fields)
 }
 
 func (l *logger) Success(args ...interface{}) {
-	if l.hasPrefix {
-		klog.SuccessDepth(1, l.logPrefix, l.Padding(), fmt.Sprint(args...))
-	} else {
-		klog.SuccessDepth(1, args...)
-	}
+	l.emit(LevelSuccess, 0, fmt.Sprint(args...), nil)
 }
 
 func (l *logger) Successf(inFormat string, args ...interface{}) {
-	if l.hasPrefix {
-		klog.SuccessDepth(1, l.logPrefix, l.Padding(), fmt.Sprintf(inFormat, args...))
-	} else {
-		klog.SuccessDepth(1, fmt.Sprintf(inFormat, args...))
-	}
+	l.emit(LevelSuccess, 0, fmt.Sprintf(inFormat, args...), nil)
 }
 
 func (l *logger) Successw(msg string, fields Fields) {
-	if l.hasPrefix {
-		klog.SuccessDepth(1, l.logPrefix, l.Padding(), fmt.Sprintf(msg+" %v", fields))
-	} else {
-		klog.SuccessDepth(1, fmt.Sprintf(msg+" %v", fields))
-	}
+	l.emit(LevelSuccess, 0, msg, fields)
 }
 
 // Info logs to the INFO log.
@@ -195,17 +229,8 @@ func (l *logger) Successw(msg string, fields Fields) {
 //  1. Enabled during testing and development. Use for high-level changes in state, mode, or connection.
 //  2. Enabled during low-level debugging and troubleshooting.
 func (l *logger) Info(inVerboseLevel int32, args ...interface{}) {
-	logIt := true
-	if inVerboseLevel > 0 {
-		logIt = bool(klog.V(klog.Level(inVerboseLevel)))
-	}
-
-	if logIt {
-		if l.hasPrefix {
-			klog.InfoDepth(1, l.logPrefix, l.Padding(), fmt.Sprint(args...))
-		} else {
-			klog.InfoDepth(1, args...)
-		}
+	if inVerboseLevel <= 0 || l.LogV(inVerboseLevel) {
+		l.emit(LevelInfo, inVerboseLevel, fmt.Sprint(args...), nil)
 	}
 }
 
@@ -214,26 +239,13 @@ func (l *logger) Info(inVerboseLevel int32, args ...interface{}) {
 //
 // See comments above for Info() for guidelines for inVerboseLevel.
 func (l *logger) Infof(inVerboseLevel int32, inFormat string, args ...interface{}) {
-	logIt := true
-	if inVerboseLevel > 0 {
-		logIt = bool(klog.V(klog.Level(inVerboseLevel)))
-	}
-
-	if logIt {
-		if l.hasPrefix {
-			klog.InfoDepth(1, l.logPrefix, l.Padding(), fmt.Sprintf(inFormat, args...))
-		} else {
-			klog.InfoDepth(1, fmt.Sprintf(inFormat, args...))
-		}
+	if inVerboseLevel <= 0 || l.LogV(inVerboseLevel) {
+		l.emit(LevelInfo, inVerboseLevel, fmt.Sprintf(inFormat, args...), nil)
 	}
 }
 
 func (l *logger) Infow(msg string, fields Fields) {
-	if l.hasPrefix {
-		klog.InfoDepth(1, l.logPrefix, l.Padding(), fmt.Sprintf(msg+" %v", fields))
-	} else {
-		klog.InfoDepth(1, fmt.Sprintf(msg+" %v", fields))
-	}
+	l.emit(LevelInfo, 0, msg, fields)
 }
 
 // Warn logs to the WARNING and INFO logs.
@@ -242,11 +254,7 @@ func (l *logger) Infow(msg string, fields Fields) {
 // Warnings are reserved for situations that indicate an inconsistency or an error that
 // won't result in a departure of specifications, correctness, or expected behavior.
 func (l *logger) Warn(args ...interface{}) {
-	if l.hasPrefix {
-		klog.WarningDepth(1, l.logPrefix, l.Padding(), fmt.Sprint(args...))
-	} else {
-		klog.WarningDepth(1, args...)
-	}
+	l.emit(LevelWarn, 0, fmt.Sprint(args...), nil)
 }
 
 // Warnf logs to the WARNING and INFO logs.
@@ -254,19 +262,11 @@ func (l *logger) Warn(args ...interface{}) {
 //
 // See comments above for Warn() for guidelines on errors vs warnings.
 func (l *logger) Warnf(inFormat string, args ...interface{}) {
-	if l.hasPrefix {
-		klog.WarningDepth(1, l.logPrefix, l.Padding(), fmt.Sprintf(inFormat, args...))
-	} else {
-		klog.WarningDepth(1, fmt.Sprintf(inFormat, args...))
-	}
+	l.emit(LevelWarn, 0, fmt.Sprintf(inFormat, args...), nil)
 }
 
 func (l *logger) Warnw(msg string, fields Fields) {
-	if l.hasPrefix {
-		klog.WarningDepth(1, l.logPrefix, l.Padding(), fmt.Sprintf(msg+" %v", fields))
-	} else {
-		klog.WarningDepth(1, fmt.Sprintf(msg+" %v", fields))
-	}
+	l.emit(LevelWarn, 0, msg, fields)
 }
 
 // Error logs to the ERROR, WARNING, and INFO logs.
@@ -276,13 +276,7 @@ func (l *logger) Warnw(msg string, fields Fields) {
 // corruption of data or resources, or an issue that if not addressed could spiral into deeper issues.
 // Logging an error reflects that correctness or expected behavior is either broken or under threat.
 func (l *logger) Error(args ...interface{}) {
-	{
-		if l.hasPrefix {
-			klog.ErrorDepth(1, l.logPrefix, l.Padding(), fmt.Sprint(args...))
-		} else {
-			klog.ErrorDepth(1, args...)
-		}
-	}
+	l.emit(LevelError, 0, fmt.Sprint(args...), nil)
 }
 
 // Errorf logs to the ERROR, WARNING, and INFO logs.
@@ -290,33 +284,68 @@ func (l *logger) Error(args ...interface{}) {
 //
 // See comments above for Error() for guidelines on errors vs warnings.
 func (l *logger) Errorf(inFormat string, args ...interface{}) {
-	{
-		if l.hasPrefix {
-			klog.ErrorDepth(1, l.logPrefix, l.Padding(), fmt.Sprintf(inFormat, args...))
-		} else {
-			klog.ErrorDepth(1, fmt.Sprintf(inFormat, args...))
-		}
-	}
+	l.emit(LevelError, 0, fmt.Sprintf(inFormat, args...), nil)
 }
 
 func (l *logger) Errorw(msg string, fields Fields) {
-	if l.hasPrefix {
-		klog.ErrorDepth(1, l.logPrefix, l.Padding(), fmt.Sprintf(msg+" %v", fields))
-	} else {
-		klog.ErrorDepth(1, fmt.Sprintf(msg+" %v", fields))
-	}
+	l.emit(LevelError, 0, msg, fields)
 }
 
 // Fatalf logs to the FATAL, ERROR, WARNING, and INFO logs,
 // Arguments are handled like fmt.Printf(); a newline is appended if missing.
 func (l *logger) Fatalf(inFormat string, args ...interface{}) {
-	{
-		if l.hasPrefix {
-			klog.FatalDepth(1, l.logPrefix, l.Padding(), fmt.Sprintf(inFormat, args...))
+	l.emit(LevelFatal, 0, fmt.Sprintf(inFormat, args...), nil)
+}
+
+// emit sends an entry to this logger's Sink, or if none, to klog with this logger's fields appended.
+func (l *logger) emit(level Level, verbose int32, msg string, fields Fields) {
+	if len(l.fields) > 0 {
+		if len(fields) > 0 {
+			fields = l.fields.Merge(fields)
 		} else {
-			klog.FatalDepth(1, fmt.Sprintf(inFormat, args...))
+			fields = l.fields
 		}
 	}
+
+	if sink := l.currentSink(); sink != nil {
+		sink.Log(&Entry{
+			Time:    time.Now(),
+			Level:   level,
+			Verbose: verbose,
+			Label:   l.logLabel,
+			Msg:     msg,
+			Fields:  fields,
+		})
+		if level == LevelFatal {
+			os.Exit(255)
+		}
+		return
+	}
+
+	if len(fields) > 0 {
+		msg = fmt.Sprintf("%s %v", msg, fields)
+	}
+	const depth = 2 // caller of the Logger method
+	var args []interface{}
+	if l.hasPrefix {
+		args = []interface{}{l.logPrefix, l.Padding(), msg}
+	} else {
+		args = []interface{}{msg}
+	}
+	switch level {
+	case LevelDebug:
+		klog.DebugDepth(depth, args...)
+	case LevelSuccess:
+		klog.SuccessDepth(depth, args...)
+	case LevelInfo:
+		klog.InfoDepth(depth, args...)
+	case LevelWarn:
+		klog.WarningDepth(depth, args...)
+	case LevelError:
+		klog.ErrorDepth(depth, args...)
+	case LevelFatal:
+		klog.FatalDepth(depth, args...)
+	}
 }
 
 func AwaitInterrupt() (
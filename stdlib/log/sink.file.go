@@ -0,0 +1,227 @@
+package log
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// AppendText appends an entry as a single line of text, e.g.
+//
+//	2024-05-01T12:00:00.000Z warn  [rpc] msg key=val
+func AppendText(buf []byte, entry *Entry) []byte {
+	buf = entry.Time.UTC().AppendFormat(buf, "2006-01-02T15:04:05.000Z")
+	buf = append(buf, ' ')
+	level := entry.Level.String()
+	buf = append(buf, level...)
+	for i := len(level); i < 7; i++ {
+		buf = append(buf, ' ')
+	}
+	if entry.Label != "" {
+		buf = append(buf, '[')
+		buf = append(buf, entry.Label...)
+		buf = append(buf, "] "...)
+	}
+	buf = append(buf, strings.TrimRight(entry.Msg, "\n")...)
+	for _, key := range sortedKeys(entry.Fields) {
+		buf = fmt.Appendf(buf, " %s=%v", key, entry.Fields[key])
+	}
+	return append(buf, '\n')
+}
+
+// NewWriterSink returns a Sink writing each entry as a line of text (see AppendText) to the given Writer, e.g. os.Stderr.
+func NewWriterSink(w io.Writer) Sink {
+	return &writerSink{w: w}
+}
+
+type writerSink struct {
+	mu  sync.Mutex
+	w   io.Writer
+	buf []byte
+}
+
+func (sink *writerSink) Log(entry *Entry) {
+	sink.mu.Lock()
+	sink.buf = AppendText(sink.buf[:0], entry)
+	sink.w.Write(sink.buf)
+	sink.mu.Unlock()
+}
+
+// RotateOpts specifies a RotatingFile.
+type RotateOpts struct {
+	Path       string        // file entries are written to; rotated files are named Path + "." + a timestamp
+	MaxSize    int64         // rotates once the file exceeds this many bytes; if <= 0, 64MB
+	MaxAge     time.Duration // if > 0, rotates once the file is this old
+	MaxBackups int           // rotated files retained, oldest removed first; if <= 0, 8
+}
+
+// RotatingFile is a Sink writing entries as text (see AppendText) to a file it rotates by size and age.
+type RotatingFile struct {
+	opts RotateOpts
+
+	mu      sync.Mutex
+	file    *os.File
+	size    int64
+	opened  time.Time
+	buf     []byte
+	lastErr error
+}
+
+// NewRotatingFile opens (or appends to) the file at opts.Path, creating its directory if needed.
+func NewRotatingFile(opts RotateOpts) (*RotatingFile, error) {
+	if opts.MaxSize <= 0 {
+		opts.MaxSize = 64 << 20
+	}
+	if opts.MaxBackups <= 0 {
+		opts.MaxBackups = 8
+	}
+	rf := &RotatingFile{
+		opts: opts,
+	}
+	if err := os.MkdirAll(filepath.Dir(opts.Path), 0o755); err != nil {
+		return nil, err
+	}
+	if err := rf.open(); err != nil {
+		return nil, err
+	}
+	return rf, nil
+}
+
+func (rf *RotatingFile) open() error {
+	file, err := os.OpenFile(rf.opts.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	rf.file = file
+	rf.size = info.Size()
+	rf.opened = time.Now()
+	return nil
+}
+
+func (rf *RotatingFile) Log(entry *Entry) {
+	rf.mu.Lock()
+	defer rf.mu.Unlock()
+
+	if rf.file == nil {
+		return // closed, or reopening failed
+	}
+	rf.buf = AppendText(rf.buf[:0], entry)
+	if rf.size > 0 && (rf.size+int64(len(rf.buf)) > rf.opts.MaxSize || (rf.opts.MaxAge > 0 && time.Since(rf.opened) >= rf.opts.MaxAge)) {
+		rf.lastErr = rf.rotate()
+		if rf.file == nil {
+			return
+		}
+	}
+	n, err := rf.file.Write(rf.buf)
+	rf.size += int64(n)
+	if err != nil {
+		rf.lastErr = err
+	}
+}
+
+// Err returns the most recent error writing or rotating, if any.
+func (rf *RotatingFile) Err() error {
+	rf.mu.Lock()
+	defer rf.mu.Unlock()
+	return rf.lastErr
+}
+
+// Rotate closes the current file, renames it with a timestamp suffix, removes the oldest rotated files beyond MaxBackups, and opens a new file.
+func (rf *RotatingFile) Rotate() error {
+	rf.mu.Lock()
+	defer rf.mu.Unlock()
+	if rf.file == nil {
+		return os.ErrClosed
+	}
+	return rf.rotate()
+}
+
+func (rf *RotatingFile) rotate() error {
+	rf.file.Close()
+	rf.file = nil
+
+	path := rf.opts.Path
+	stamp := time.Now().UTC().Format("20060102T150405.000")
+	rotated := path + "." + stamp
+	for i := 2; ; i++ {
+		if _, err := os.Stat(rotated); os.IsNotExist(err) {
+			break
+		}
+		rotated = fmt.Sprintf("%s.%s-%d", path, stamp, i)
+	}
+	if err := os.Rename(path, rotated); err != nil {
+		rf.open()
+		return err
+	}
+	if err := rf.open(); err != nil {
+		return err
+	}
+	return rf.prune()
+}
+
+// prune removes the oldest rotated files beyond MaxBackups.
+func (rf *RotatingFile) prune() error {
+	dir, base := filepath.Split(rf.opts.Path)
+	if dir == "" {
+		dir = "."
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	var rotated []string
+	for _, entry := range entries {
+		if name := entry.Name(); strings.HasPrefix(name, base+".") && !entry.IsDir() {
+			rotated = append(rotated, name)
+		}
+	}
+	if len(rotated) <= rf.opts.MaxBackups {
+		return nil
+	}
+	sort.Strings(rotated) // timestamp suffixes sort chronologically
+	var firstErr error
+	for _, name := range rotated[:len(rotated)-rf.opts.MaxBackups] {
+		if err := os.Remove(filepath.Join(dir, name)); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// Close closes the current file; subsequent entries are dropped.
+func (rf *RotatingFile) Close() error {
+	rf.mu.Lock()
+	defer rf.mu.Unlock()
+	if rf.file == nil {
+		return nil
+	}
+	err := rf.file.Close()
+	rf.file = nil
+	return err
+}
+
+// textOf returns an entry formatted as text without a timestamp or trailing newline, for sinks that timestamp entries themselves (e.g. syslog).
+func textOf(entry *Entry) string {
+	var buf bytes.Buffer
+	if entry.Label != "" {
+		buf.WriteByte('[')
+		buf.WriteString(entry.Label)
+		buf.WriteString("] ")
+	}
+	buf.WriteString(strings.TrimRight(entry.Msg, "\n"))
+	for _, key := range sortedKeys(entry.Fields) {
+		fmt.Fprintf(&buf, " %s=%v", key, entry.Fields[key])
+	}
+	return buf.String()
+}
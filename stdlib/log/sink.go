@@ -0,0 +1,86 @@
+package log
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+	"time"
+)
+
+// Level is the severity of a log Entry.
+type Level int8
+
+const (
+	LevelDebug Level = iota
+	LevelInfo
+	LevelSuccess
+	LevelWarn
+	LevelError
+	LevelFatal
+)
+
+// Entry is a single structured log entry -- see Sink.
+type Entry struct {
+	Time    time.Time
+	Level   Level
+	Verbose int32  // verbose level of an info entry -- see Logger.Info()
+	Label   string // label of the emitting Logger (e.g. its task.Context's label)
+	Msg     string
+	Fields  Fields // fields of the emitting Logger merged with those given for the entry
+}
+
+// Sink receives the entries emitted by Loggers, allowing a host to route all SDK logging to its own logging (e.g. zap or slog).
+// Log() must be concurrency safe and must not retain the Entry.
+type Sink interface {
+	Log(entry *Entry)
+}
+
+type sinkHolder struct {
+	sink Sink
+}
+
+var gSink atomic.Pointer[sinkHolder]
+
+// SetSink routes entries emitted by all Loggers not created via NewSinkLogger() to the given Sink, or to klog if nil (the default).
+func SetSink(sink Sink) {
+	if sink == nil {
+		gSink.Store(nil)
+	} else {
+		gSink.Store(&sinkHolder{sink})
+	}
+}
+
+// NewSlogSink returns a Sink that emits entries to the given slog.Logger, with each entry's label and fields as attrs.
+func NewSlogSink(logger *slog.Logger) Sink {
+	return &slogSink{logger}
+}
+
+type slogSink struct {
+	logger *slog.Logger
+}
+
+func (sink *slogSink) Log(entry *Entry) {
+	var level slog.Level
+	switch entry.Level {
+	case LevelDebug:
+		level = slog.LevelDebug
+	case LevelInfo, LevelSuccess:
+		level = slog.LevelInfo
+	case LevelWarn:
+		level = slog.LevelWarn
+	default:
+		level = slog.LevelError
+	}
+
+	attrs := make([]slog.Attr, 0, 2+len(entry.Fields))
+	if entry.Label != "" {
+		attrs = append(attrs, slog.String("label", entry.Label))
+	}
+	if entry.Verbose > 0 {
+		attrs = append(attrs, slog.Int("v", int(entry.Verbose)))
+	}
+	for key, val := range entry.Fields {
+		attrs = append(attrs, slog.Any(key, val))
+	}
+	sink.logger.LogAttrs(context.Background(), level, entry.Msg, attrs...)
+}
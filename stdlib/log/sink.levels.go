@@ -0,0 +1,220 @@
+package log
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// FieldSubsystem is the log field naming the subsystem an entry pertains to (e.g. "transport"), set via task.Info.LogFields on a subsystem's root context.
+const FieldSubsystem = "subsystem"
+
+var levelNames = [...]string{
+	LevelDebug:   "debug",
+	LevelInfo:    "info",
+	LevelSuccess: "success",
+	LevelWarn:    "warn",
+	LevelError:   "error",
+	LevelFatal:   "fatal",
+}
+
+func (level Level) String() string {
+	if level >= 0 && int(level) < len(levelNames) {
+		return levelNames[level]
+	}
+	return fmt.Sprintf("Level(%d)", int8(level))
+}
+
+// ParseLevel returns the Level named by str (e.g. "warn" or "WARNING"), case insensitive.
+func ParseLevel(str string) (Level, error) {
+	str = strings.ToLower(strings.TrimSpace(str))
+	if str == "warning" {
+		return LevelWarn, nil
+	}
+	for level, name := range levelNames {
+		if name == str {
+			return Level(level), nil
+		}
+	}
+	return 0, fmt.Errorf("log: unknown level %q", str)
+}
+
+// Levels is a minimum Level per subsystem, changeable at runtime (e.g. via its ServeHTTP on an admin endpoint).
+//
+// An entry's subsystem is the value of the first of its key fields having a level set (e.g. amp.LogFieldApp, then FieldSubsystem),
+// otherwise its label's class (the label up to the first ':', '#', or ' '), otherwise the default level applies.
+type Levels struct {
+	keyFields []string
+
+	mu   sync.RWMutex
+	def  Level
+	byID map[string]Level
+}
+
+// NewLevels returns Levels with the given default level, keying subsystems by the given fields in order of precedence.
+// If no key fields are given, FieldSubsystem is used.
+func NewLevels(def Level, keyFields ...string) *Levels {
+	if len(keyFields) == 0 {
+		keyFields = []string{FieldSubsystem}
+	}
+	return &Levels{
+		keyFields: keyFields,
+		def:       def,
+		byID:      make(map[string]Level),
+	}
+}
+
+// SetDefault sets the minimum level of entries from subsystems with no level of their own.
+func (lv *Levels) SetDefault(level Level) {
+	lv.mu.Lock()
+	lv.def = level
+	lv.mu.Unlock()
+}
+
+// Set sets the minimum level of entries from the given subsystem (e.g. "transport", an app UID, or a label class).
+func (lv *Levels) Set(subsystem string, level Level) {
+	lv.mu.Lock()
+	lv.byID[subsystem] = level
+	lv.mu.Unlock()
+}
+
+// Clear reverts the given subsystem to the default level.
+func (lv *Levels) Clear(subsystem string) {
+	lv.mu.Lock()
+	delete(lv.byID, subsystem)
+	lv.mu.Unlock()
+}
+
+// Snapshot returns the default level and the level of each subsystem having its own.
+func (lv *Levels) Snapshot() (def Level, bySubsystem map[string]Level) {
+	lv.mu.RLock()
+	defer lv.mu.RUnlock()
+	bySubsystem = make(map[string]Level, len(lv.byID))
+	for id, level := range lv.byID {
+		bySubsystem[id] = level
+	}
+	return lv.def, bySubsystem
+}
+
+// Enabled returns true if the given entry meets the minimum level of its subsystem.
+func (lv *Levels) Enabled(entry *Entry) bool {
+	lv.mu.RLock()
+	defer lv.mu.RUnlock()
+	min := lv.def
+	if len(lv.byID) > 0 {
+		min = lv.levelOf(entry)
+	}
+	return entry.Level >= min
+}
+
+func (lv *Levels) levelOf(entry *Entry) Level {
+	for _, key := range lv.keyFields {
+		if val, exists := entry.Fields[key]; exists {
+			id, isStr := val.(string)
+			if !isStr {
+				id = fmt.Sprint(val)
+			}
+			if level, exists := lv.byID[id]; exists {
+				return level
+			}
+		}
+	}
+	class := entry.Label
+	if i := strings.IndexAny(class, ":# "); i >= 0 {
+		class = class[:i]
+	}
+	if level, exists := lv.byID[class]; exists {
+		return level
+	}
+	return lv.def
+}
+
+// ServeHTTP is an admin endpoint for viewing and changing levels:
+//
+//	GET                                       the default and per-subsystem levels as JSON
+//	PUT or POST ?level={level}                sets the default level
+//	PUT or POST ?subsystem={id}&level={level} sets a subsystem's level
+//	DELETE ?subsystem={id}                    reverts a subsystem to the default level
+func (lv *Levels) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	subsystem := r.URL.Query().Get("subsystem")
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+	case http.MethodPut, http.MethodPost:
+		level, err := ParseLevel(r.URL.Query().Get("level"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if subsystem == "" {
+			lv.SetDefault(level)
+		} else {
+			lv.Set(subsystem, level)
+		}
+	case http.MethodDelete:
+		if subsystem == "" {
+			http.Error(w, "missing subsystem", http.StatusBadRequest)
+			return
+		}
+		lv.Clear(subsystem)
+	default:
+		w.Header().Set("Allow", "GET, HEAD, PUT, POST, DELETE")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	def, bySubsystem := lv.Snapshot()
+	report := struct {
+		Default    string            `json:"default"`
+		Subsystems map[string]string `json:"subsystems"`
+	}{
+		Default:    def.String(),
+		Subsystems: make(map[string]string, len(bySubsystem)),
+	}
+	for id, level := range bySubsystem {
+		report.Subsystems[id] = level.String()
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(report)
+}
+
+// FilterSink returns a Sink passing entries to the given Sink only if enabled by the given Levels.
+func FilterSink(sink Sink, levels *Levels) Sink {
+	return &filterSink{sink, levels}
+}
+
+type filterSink struct {
+	sink   Sink
+	levels *Levels
+}
+
+func (sink *filterSink) Log(entry *Entry) {
+	if sink.levels.Enabled(entry) {
+		sink.sink.Log(entry)
+	}
+}
+
+// Tee returns a Sink passing each entry to each of the given Sinks in order.
+func Tee(sinks ...Sink) Sink {
+	return teeSink(sinks)
+}
+
+type teeSink []Sink
+
+func (sinks teeSink) Log(entry *Entry) {
+	for _, sink := range sinks {
+		sink.Log(entry)
+	}
+}
+
+// sortedKeys returns the keys of the given Fields in order, so entries format deterministically.
+func sortedKeys(fields Fields) []string {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,276 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// OTLPOpts specifies an OTLPSink.
+type OTLPOpts struct {
+	Endpoint      string            // OTLP/HTTP logs endpoint, e.g. "http://collector:4318/v1/logs"
+	Headers       map[string]string // added to each export request (e.g. an API key)
+	ServiceName   string            // the "service.name" resource attribute
+	Client        *http.Client      // if nil, http.DefaultClient
+	BatchSize     int               // entries exported per request; if <= 0, 512
+	FlushInterval time.Duration     // longest an entry waits to be exported; if <= 0, 2s
+	MaxQueued     int               // entries queued before further entries are dropped; if <= 0, 8192
+	Timeout       time.Duration     // timeout of each export request; if <= 0, 10s
+}
+
+// OTLPSink is a Sink exporting entries as OpenTelemetry log records (OTLP/HTTP with JSON encoding), batched in the background.
+type OTLPSink struct {
+	opts    OTLPOpts
+	dropped atomic.Uint64
+	flushes chan chan struct{}
+	closing chan struct{}
+	done    chan struct{}
+
+	mu     sync.Mutex
+	queue  []otlpRecord
+	closed bool
+}
+
+// NewOTLPSink returns an OTLPSink exporting to opts.Endpoint until closed.
+func NewOTLPSink(opts OTLPOpts) *OTLPSink {
+	if opts.Client == nil {
+		opts.Client = http.DefaultClient
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 512
+	}
+	if opts.FlushInterval <= 0 {
+		opts.FlushInterval = 2 * time.Second
+	}
+	if opts.MaxQueued <= 0 {
+		opts.MaxQueued = 8192
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = 10 * time.Second
+	}
+	sink := &OTLPSink{
+		opts:    opts,
+		flushes: make(chan chan struct{}),
+		closing: make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	go sink.run()
+	return sink
+}
+
+// Dropped returns how many entries were dropped, either since the queue was full or their export failed.
+func (sink *OTLPSink) Dropped() uint64 {
+	return sink.dropped.Load()
+}
+
+func (sink *OTLPSink) Log(entry *Entry) {
+	msg := entry.Msg
+	rec := otlpRecord{
+		TimeUnixNano:   strconv.FormatInt(entry.Time.UnixNano(), 10),
+		SeverityNumber: otlpSeverity[min(int(entry.Level), len(otlpSeverity)-1)],
+		SeverityText:   entry.Level.String(),
+		Body:           otlpValue{StringValue: &msg},
+		Attributes:     make([]otlpAttr, 0, 1+len(entry.Fields)),
+	}
+	if entry.Label != "" {
+		label := entry.Label
+		rec.Attributes = append(rec.Attributes, otlpAttr{"label", otlpValue{StringValue: &label}})
+	}
+	for _, key := range sortedKeys(entry.Fields) {
+		rec.Attributes = append(rec.Attributes, otlpAttr{key, otlpValueOf(entry.Fields[key])})
+	}
+
+	sink.mu.Lock()
+	full := sink.closed || len(sink.queue) >= sink.opts.MaxQueued
+	if !full {
+		sink.queue = append(sink.queue, rec)
+	}
+	sink.mu.Unlock()
+	if full {
+		sink.dropped.Add(1)
+	}
+}
+
+// Flush exports all queued entries, returning once done.
+func (sink *OTLPSink) Flush() {
+	flushed := make(chan struct{})
+	select {
+	case sink.flushes <- flushed:
+		<-flushed
+	case <-sink.done:
+	}
+}
+
+// Close exports all queued entries and stops the sink; subsequent entries are dropped.
+func (sink *OTLPSink) Close() error {
+	sink.mu.Lock()
+	already := sink.closed
+	sink.closed = true
+	sink.mu.Unlock()
+	if !already {
+		close(sink.closing)
+	}
+	<-sink.done
+	return nil
+}
+
+func (sink *OTLPSink) run() {
+	defer close(sink.done)
+	ticker := time.NewTicker(sink.opts.FlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			sink.export()
+		case flushed := <-sink.flushes:
+			sink.export()
+			close(flushed)
+		case <-sink.closing:
+			sink.export()
+			return
+		}
+	}
+}
+
+// export sends all queued records, BatchSize per request.
+func (sink *OTLPSink) export() {
+	sink.mu.Lock()
+	queue := sink.queue
+	sink.queue = nil
+	sink.mu.Unlock()
+
+	for len(queue) > 0 {
+		n := min(len(queue), sink.opts.BatchSize)
+		if err := sink.post(queue[:n]); err != nil {
+			sink.dropped.Add(uint64(n))
+		}
+		queue = queue[n:]
+	}
+}
+
+func (sink *OTLPSink) post(records []otlpRecord) error {
+	serviceName := sink.opts.ServiceName
+	req := otlpRequest{
+		ResourceLogs: []otlpResourceLogs{{
+			Resource: otlpResource{
+				Attributes: []otlpAttr{{"service.name", otlpValue{StringValue: &serviceName}}},
+			},
+			ScopeLogs: []otlpScopeLogs{{
+				Scope:      otlpScope{Name: "amp"},
+				LogRecords: records,
+			}},
+		}},
+	}
+	body, err := json.Marshal(&req)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), sink.opts.Timeout)
+	defer cancel()
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, sink.opts.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	for key, val := range sink.opts.Headers {
+		httpReq.Header.Set(key, val)
+	}
+	resp, err := sink.opts.Client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("otlp export: %s", resp.Status)
+	}
+	return nil
+}
+
+// otlpSeverity maps each Level to an OTLP SeverityNumber.
+var otlpSeverity = [...]int{
+	LevelDebug:   5,  // DEBUG
+	LevelInfo:    9,  // INFO
+	LevelSuccess: 10, // INFO2
+	LevelWarn:    13, // WARN
+	LevelError:   17, // ERROR
+	LevelFatal:   21, // FATAL
+}
+
+// The following mirror the OTLP/JSON encoding of ExportLogsServiceRequest.
+type otlpRequest struct {
+	ResourceLogs []otlpResourceLogs `json:"resourceLogs"`
+}
+
+type otlpResourceLogs struct {
+	Resource  otlpResource    `json:"resource"`
+	ScopeLogs []otlpScopeLogs `json:"scopeLogs"`
+}
+
+type otlpResource struct {
+	Attributes []otlpAttr `json:"attributes"`
+}
+
+type otlpScopeLogs struct {
+	Scope      otlpScope    `json:"scope"`
+	LogRecords []otlpRecord `json:"logRecords"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpRecord struct {
+	TimeUnixNano   string     `json:"timeUnixNano"`
+	SeverityNumber int        `json:"severityNumber"`
+	SeverityText   string     `json:"severityText"`
+	Body           otlpValue  `json:"body"`
+	Attributes     []otlpAttr `json:"attributes,omitempty"`
+}
+
+type otlpAttr struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+	IntValue    *string  `json:"intValue,omitempty"` // int64 as a decimal string, per the OTLP/JSON encoding
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+}
+
+func otlpValueOf(val any) otlpValue {
+	switch v := val.(type) {
+	case string:
+		return otlpValue{StringValue: &v}
+	case bool:
+		return otlpValue{BoolValue: &v}
+	case int:
+		str := strconv.FormatInt(int64(v), 10)
+		return otlpValue{IntValue: &str}
+	case int32:
+		str := strconv.FormatInt(int64(v), 10)
+		return otlpValue{IntValue: &str}
+	case int64:
+		str := strconv.FormatInt(v, 10)
+		return otlpValue{IntValue: &str}
+	case uint32:
+		str := strconv.FormatUint(uint64(v), 10)
+		return otlpValue{IntValue: &str}
+	case float32:
+		f := float64(v)
+		return otlpValue{DoubleValue: &f}
+	case float64:
+		return otlpValue{DoubleValue: &v}
+	default:
+		str := fmt.Sprint(val)
+		return otlpValue{StringValue: &str}
+	}
+}
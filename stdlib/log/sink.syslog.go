@@ -0,0 +1,43 @@
+//go:build !windows && !plan9
+
+package log
+
+import (
+	"log/syslog"
+)
+
+// NewSyslogSink returns a Sink writing entries to the syslog server at the given address, or the local syslog daemon if network and raddr are empty.
+// Each entry's message is prefixed with its label and suffixed with its fields; tag identifies the program (e.g. "amp-host").
+func NewSyslogSink(network, raddr, tag string) (Sink, error) {
+	w, err := syslog.Dial(network, raddr, syslog.LOG_INFO|syslog.LOG_DAEMON, tag)
+	if err != nil {
+		return nil, err
+	}
+	return &syslogSink{w}, nil
+}
+
+type syslogSink struct {
+	w *syslog.Writer
+}
+
+func (sink *syslogSink) Log(entry *Entry) {
+	msg := textOf(entry)
+	switch entry.Level {
+	case LevelDebug:
+		sink.w.Debug(msg)
+	case LevelInfo:
+		sink.w.Info(msg)
+	case LevelSuccess:
+		sink.w.Notice(msg)
+	case LevelWarn:
+		sink.w.Warning(msg)
+	case LevelError:
+		sink.w.Err(msg)
+	default:
+		sink.w.Crit(msg)
+	}
+}
+
+func (sink *syslogSink) Close() error {
+	return sink.w.Close()
+}
@@ -1,9 +1,13 @@
 package media
 
 import (
+	"errors"
+	"fmt"
 	"io"
+	"net/http"
 	"time"
 
+	"github.com/art-media-platform/amp-sdk-go/stdlib/blob"
 	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
 )
 
@@ -12,8 +16,86 @@ type PublishOpts struct {
 	Expiry    time.Duration // If <= 0, the publisher chooses the expiration period
 	HostAddr  string        // Domain or IP address used in the generated URL; if empty -> "localhost"
 	OnExpired func()        // Called when the asset expires
+
+	// If set, the URL is HMAC-signed and stops working once Expiry has elapsed, even if the asset remains published.
+	// This allows private media to be handed to a native player without exposing a long-lived link.
+	Private bool
+
+	// Optional: binds a Private URL to a session or user ID so that revoking the subject invalidates all its URLs.
+	Subject string
+
+	// Optional: identifies what references this asset (e.g. a cell ID) so that it inherits its access rules.
+	// If set, each request is checked via PublisherOpts.Access, so holding the URL alone is not enough to fetch the asset.
+	Scope string
+
+	// Optional: who bandwidth spent serving this asset is accounted to (see PublisherOpts.Meter).
+	// If Account.User is empty and the asset is scoped, the identified requester is accounted as the user.
+	Account Account
+}
+
+// Account identifies who bandwidth is accounted to, where an empty field is not accounted.
+type Account struct {
+	Session string
+	User    string
+	App     string
+}
+
+// AccountKind identifies a field of an Account.
+type AccountKind string
+
+const (
+	AccountSession AccountKind = "session"
+	AccountUser    AccountKind = "user"
+	AccountApp     AccountKind = "app"
+)
+
+// Meter accounts for bytes served by a Publisher and enforces quotas -- concurrency safe.
+// Quotas are checked as each request is admitted, so a response in progress is never cut off and an account may exceed its quota by one response.
+type Meter interface {
+
+	// Returns an *OverQuotaError if any field of the given account has exhausted its quota, otherwise nil.
+	Admit(acct Account) error
+
+	// Adds the given number of bytes served to each field of the given account.
+	Record(acct Account, bytes int64)
+}
+
+// OverQuotaError is returned by Meter.Admit() when an account has used its bandwidth quota.
+// errors.Is(err, ErrOverQuota) reports true for an *OverQuotaError.
+type OverQuotaError struct {
+	Kind    AccountKind // which field of the account is over quota
+	ID      string      // the field's value (e.g. a session ID)
+	Used    int64       // bytes served during the current window
+	Quota   int64       // bytes allowed per window
+	ResetAt time.Time   // when the current window ends and usage resets
 }
 
+func (err *OverQuotaError) Error() string {
+	return fmt.Sprintf("%s %q over bandwidth quota (%d of %d bytes used)", err.Kind, err.ID, err.Used, err.Quota)
+}
+
+func (err *OverQuotaError) Is(target error) bool {
+	return target == ErrOverQuota
+}
+
+// AccessPolicy decides who may fetch a scoped asset (see PublishOpts.Scope) -- concurrency safe.
+type AccessPolicy interface {
+
+	// Identifies the requester of an asset (e.g. via a bearer token or cookie), returning ErrNotAuthenticated if not possible.
+	// This is not called for a Private URL bound to a Subject since the signature already identifies the requester.
+	Requester(r *http.Request) (subject string, err error)
+
+	// Returns nil if the given subject may read assets in the given scope, otherwise ErrAccessDenied.
+	CanRead(subject, scope string) error
+}
+
+var (
+	ErrNotAuthenticated = errors.New("requester not authenticated")
+	ErrAccessDenied     = errors.New("access denied")
+	ErrNoAccessPolicy   = errors.New("scoped asset requires an AccessPolicy")
+	ErrOverQuota        = errors.New("bandwidth quota exceeded")
+)
+
 // Publishes a media.Asset to a randomly generated URL until the idle expiration is reached.
 // If idleExpiry == 0, the publisher will choose an expiration period.
 type Publisher interface {
@@ -37,6 +119,22 @@ type Asset interface {
 	NewAssetReader() (AssetReader, error)
 }
 
+// AssetStat is optionally implemented by an Asset to support conditional requests and caching.
+type AssetStat interface {
+
+	// Returns when the asset's content last changed, or zero if unknown.
+	ModTime() time.Time
+
+	// Returns an opaque tag that changes whenever the asset's content changes (e.g. a content hash), or "" if unknown.
+	ETag() string
+}
+
+// ContentAddressed is optionally implemented by an Asset whose content is named by a CID and so never changes.
+// Such an asset is served as immutable, allowing clients and proxies to cache it indefinitely.
+type ContentAddressed interface {
+	CID() blob.CID
+}
+
 // AssetReader provides read and seek access to its parent MediaAsset.
 //
 // Close() is called when:
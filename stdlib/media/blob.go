@@ -0,0 +1,89 @@
+package media
+
+import (
+	"context"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/blob"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// NewBlobAsset returns an Asset whose content is read from the given blob store, so a Publisher can serve assets from any backend.
+// If contentType is empty, the blob's stored content type is used.
+func NewBlobAsset(store blob.Store, key string, contentType string) Asset {
+	return &blobAsset{
+		store:       store,
+		key:         key,
+		contentType: contentType,
+	}
+}
+
+type blobAsset struct {
+	store       blob.Store
+	key         string
+	contentType string
+
+	mu   sync.Mutex
+	ctx  context.Context
+	info blob.Info
+}
+
+func (asset *blobAsset) Label() string {
+	return path.Base(asset.key)
+}
+
+func (asset *blobAsset) ContentType() string {
+	asset.mu.Lock()
+	defer asset.mu.Unlock()
+	if asset.contentType != "" {
+		return asset.contentType
+	}
+	return asset.info.ContentType
+}
+
+func (asset *blobAsset) OnStart(ctx task.Context) error {
+	info, err := asset.store.Stat(ctx, asset.key)
+	if err != nil {
+		return err
+	}
+	asset.mu.Lock()
+	asset.ctx = ctx
+	asset.info = info
+	asset.mu.Unlock()
+	return nil
+}
+
+func (asset *blobAsset) NewAssetReader() (AssetReader, error) {
+	asset.mu.Lock()
+	ctx := asset.ctx
+	asset.mu.Unlock()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return asset.store.Open(ctx, asset.key)
+}
+
+func (asset *blobAsset) ModTime() time.Time {
+	asset.mu.Lock()
+	defer asset.mu.Unlock()
+	return asset.info.ModTime
+}
+
+// CID returns the asset's CID if it is stored under its CID (see blob.PutContent), otherwise "".
+func (asset *blobAsset) CID() blob.CID {
+	str, found := strings.CutPrefix(asset.key, blob.CASPrefix)
+	if !found {
+		return ""
+	}
+	cid, _ := blob.ParseCID(str)
+	return cid
+}
+
+func (asset *blobAsset) ETag() string {
+	asset.mu.Lock()
+	defer asset.mu.Unlock()
+	return asset.info.ETag
+}
@@ -0,0 +1,182 @@
+package media
+
+import (
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MeterOpts configures NewBandwidthMeter().
+type MeterOpts struct {
+	Window time.Duration         // period over which quotas apply, after which usage resets; if <= 0, 24 hours
+	Quotas map[AccountKind]int64 // default bytes allowed per window for each kind of account; if absent or <= 0, unlimited
+}
+
+// MeterUsage is a snapshot of the bandwidth used by one field of an Account.
+type MeterUsage struct {
+	Kind   AccountKind
+	ID     string
+	Window int64 // bytes served during the current window
+	Total  int64 // bytes served since the meter was created (e.g. for billing)
+	Quota  int64 // bytes allowed per window, or 0 if unlimited
+}
+
+// BandwidthMeter is a Meter keeping usage in memory, where quotas apply over fixed windows aligned to the window length (e.g. UTC days).
+type BandwidthMeter struct {
+	opts        MeterOpts
+	mu          sync.Mutex
+	windowStart time.Time
+	usage       map[meterKey]*MeterUsage
+	quotas      map[meterKey]int64 // per-account overrides of opts.Quotas
+}
+
+type meterKey struct {
+	kind AccountKind
+	id   string
+}
+
+// NewBandwidthMeter returns a BandwidthMeter for use as PublisherOpts.Meter.
+func NewBandwidthMeter(opts MeterOpts) *BandwidthMeter {
+	if opts.Window <= 0 {
+		opts.Window = 24 * time.Hour
+	}
+	return &BandwidthMeter{
+		opts:   opts,
+		usage:  make(map[meterKey]*MeterUsage),
+		quotas: make(map[meterKey]int64),
+	}
+}
+
+// SetQuota overrides the default quota of the given account field, where quota < 0 means unlimited and 0 restores the default.
+func (m *BandwidthMeter) SetQuota(kind AccountKind, id string, quota int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := meterKey{kind, id}
+	if quota == 0 {
+		delete(m.quotas, key)
+	} else {
+		m.quotas[key] = quota
+	}
+}
+
+// quota returns the bytes allowed per window for the given key, or 0 if unlimited -- caller holds m.mu
+func (m *BandwidthMeter) quota(key meterKey) int64 {
+	quota, exists := m.quotas[key]
+	if !exists {
+		quota = m.opts.Quotas[key.kind]
+	}
+	if quota < 0 {
+		quota = 0
+	}
+	return quota
+}
+
+// roll resets window usage if the current window has ended -- caller holds m.mu
+func (m *BandwidthMeter) roll(now time.Time) {
+	start := now.Truncate(m.opts.Window)
+	if start.Equal(m.windowStart) {
+		return
+	}
+	m.windowStart = start
+	for _, usage := range m.usage {
+		usage.Window = 0
+	}
+}
+
+func (m *BandwidthMeter) Admit(acct Account) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.roll(time.Now())
+	for _, key := range acct.keys() {
+		quota := m.quota(key)
+		if quota == 0 {
+			continue
+		}
+		var used int64
+		if usage := m.usage[key]; usage != nil {
+			used = usage.Window
+		}
+		if used >= quota {
+			return &OverQuotaError{
+				Kind:    key.kind,
+				ID:      key.id,
+				Used:    used,
+				Quota:   quota,
+				ResetAt: m.windowStart.Add(m.opts.Window),
+			}
+		}
+	}
+	return nil
+}
+
+func (m *BandwidthMeter) Record(acct Account, bytes int64) {
+	if bytes <= 0 {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.roll(time.Now())
+	for _, key := range acct.keys() {
+		usage := m.usage[key]
+		if usage == nil {
+			usage = &MeterUsage{
+				Kind: key.kind,
+				ID:   key.id,
+			}
+			m.usage[key] = usage
+		}
+		usage.Window += bytes
+		usage.Total += bytes
+	}
+}
+
+// Usage returns the usage of every account field served so far, sorted by kind then ID.
+func (m *BandwidthMeter) Usage() []MeterUsage {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.roll(time.Now())
+	usage := make([]MeterUsage, 0, len(m.usage))
+	for key, ui := range m.usage {
+		u := *ui
+		u.Quota = m.quota(key)
+		usage = append(usage, u)
+	}
+	sort.Slice(usage, func(i, j int) bool {
+		if usage[i].Kind != usage[j].Kind {
+			return usage[i].Kind < usage[j].Kind
+		}
+		return usage[i].ID < usage[j].ID
+	})
+	return usage
+}
+
+func (acct Account) keys() []meterKey {
+	keys := make([]meterKey, 0, 3)
+	if acct.Session != "" {
+		keys = append(keys, meterKey{AccountSession, acct.Session})
+	}
+	if acct.User != "" {
+		keys = append(keys, meterKey{AccountUser, acct.User})
+	}
+	if acct.App != "" {
+		keys = append(keys, meterKey{AccountApp, acct.App})
+	}
+	return keys
+}
+
+// meteredWriter counts the bytes of a response body.
+type meteredWriter struct {
+	http.ResponseWriter
+	bytes int64
+}
+
+func (w *meteredWriter) Write(buf []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(buf)
+	w.bytes += int64(n)
+	return n, err
+}
@@ -0,0 +1,426 @@
+package media
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/blob"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/imaging"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/streaming"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+// PublisherOpts specifies an HTTPPublisher.
+type PublisherOpts struct {
+	ListenAddr    string             // address to serve on; if empty, ":0" (any available port)
+	PathPrefix    string             // URL path assets are published under; if empty, "/asset/"
+	DefaultExpiry time.Duration      // idle expiry used when PublishOpts.Expiry <= 0; if <= 0, 10 minutes
+	Store         blob.Store         // optional: content-addressed blobs in this store are served at permanent URLs (see ContentURL)
+	SigningKey    []byte             // signs private URLs; if nil, a random key is generated (so signed URLs do not survive a restart)
+	PrivateStore  bool               // if set, content URLs must be signed (see SignedContentURL)
+	Images        *imaging.Pipeline  // optional: serves image variants requested via URL params (e.g. "?w=320&format=webp")
+	Streams       *streaming.Service // optional: serves audio and video assets as HLS or DASH (see StreamURL)
+	Access        AccessPolicy       // checks requests for scoped assets (see PublishOpts.Scope)
+	Meter         Meter              // optional: accounts for bytes served and enforces quotas (see PublishOpts.Account)
+}
+
+// cidPath is the URL path (following PathPrefix) under which content-addressed blobs are served.
+const cidPath = "cid/"
+
+// HTTPPublisher is a Publisher serving each published asset over HTTP at a random URL.
+//
+// Assets are served via ServeAsset, so clients can scrub and resume using byte-range and conditional requests.
+type HTTPPublisher struct {
+	ctx    task.Context
+	opts   PublisherOpts
+	port   int
+	mu     sync.Mutex
+	assets map[string]*publishedAsset
+	gens   map[string]uint64 // subject => revocation generation
+}
+
+type publishedAsset struct {
+	asset   Asset
+	ctx     task.Context
+	expiry  time.Duration
+	timer   *time.Timer
+	private bool
+	scope   string
+	account Account
+}
+
+// StartPublisher starts an HTTPPublisher as a child of the given Context, serving until it closes.
+func StartPublisher(parent task.Context, opts PublisherOpts) (*HTTPPublisher, error) {
+	if opts.ListenAddr == "" {
+		opts.ListenAddr = ":0"
+	}
+	if opts.PathPrefix == "" {
+		opts.PathPrefix = "/asset/"
+	}
+	if opts.DefaultExpiry <= 0 {
+		opts.DefaultExpiry = 10 * time.Minute
+	}
+	if len(opts.SigningKey) == 0 {
+		opts.SigningKey = make([]byte, 32)
+		if _, err := rand.Read(opts.SigningKey); err != nil {
+			return nil, err
+		}
+	}
+
+	listener, err := net.Listen("tcp", opts.ListenAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	pub := &HTTPPublisher{
+		opts:   opts,
+		port:   listener.Addr().(*net.TCPAddr).Port,
+		assets: make(map[string]*publishedAsset),
+		gens:   make(map[string]uint64),
+	}
+	server := &http.Server{
+		Handler: pub,
+	}
+
+	pub.ctx, err = parent.StartChild(&task.Task{
+		Info: task.Info{
+			Label: fmt.Sprintf("media.Publisher :%d", pub.port),
+		},
+		OnRun: func(ctx task.Context) {
+			if err := server.Serve(listener); err != http.ErrServerClosed {
+				ctx.Log().Warnf("serve: %v", err)
+			}
+		},
+		OnClosing: func() {
+			server.Close()
+		},
+	})
+	if err != nil {
+		listener.Close()
+		return nil, err
+	}
+	return pub, nil
+}
+
+func (pub *HTTPPublisher) PublishAsset(asset Asset, opts PublishOpts) (string, error) {
+	if opts.Scope != "" && pub.opts.Access == nil {
+		return "", ErrNoAccessPolicy
+	}
+	var buf [18]byte
+	if _, err := rand.Read(buf[:]); err != nil {
+		return "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(buf[:])
+
+	pa := &publishedAsset{
+		asset:   asset,
+		expiry:  opts.Expiry,
+		private: opts.Private,
+		scope:   opts.Scope,
+		account: opts.Account,
+	}
+	if pa.expiry <= 0 {
+		pa.expiry = pub.opts.DefaultExpiry
+	}
+
+	var err error
+	pa.ctx, err = pub.ctx.StartChild(&task.Task{
+		Info: task.Info{
+			Label: "asset: " + asset.Label(),
+		},
+		OnStart: asset.OnStart,
+		OnClosing: func() {
+			pub.mu.Lock()
+			delete(pub.assets, token)
+			if pa.timer != nil {
+				pa.timer.Stop()
+			}
+			pub.mu.Unlock()
+			if opts.OnExpired != nil {
+				opts.OnExpired()
+			}
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	pub.mu.Lock()
+	pa.timer = time.AfterFunc(pa.expiry, func() { pa.ctx.Close() })
+	pub.assets[token] = pa
+	pub.mu.Unlock()
+
+	path := pub.opts.PathPrefix + token
+	if opts.Private {
+		path = pub.signPath(path, opts.Subject, pa.expiry)
+	}
+	return pub.urlFor(opts.HostAddr, path), nil
+}
+
+func (pub *HTTPPublisher) urlFor(hostAddr, path string) string {
+	if hostAddr == "" {
+		hostAddr = "localhost"
+	}
+	return fmt.Sprintf("http://%s:%d%s", hostAddr, pub.port, path)
+}
+
+// ContentURL returns the permanent URL of a blob stored in PublisherOpts.Store via blob.PutContent.
+// Since the URL names immutable content, it never expires and may be cached indefinitely.
+func (pub *HTTPPublisher) ContentURL(cid blob.CID, hostAddr string) string {
+	return pub.urlFor(hostAddr, pub.opts.PathPrefix+cidPath+string(cid))
+}
+
+// SignedContentURL is like ContentURL but returns a signed URL that expires after ttl and is bound to the given subject (optional).
+// Signed URLs are required for content when PublisherOpts.PrivateStore is set.
+func (pub *HTTPPublisher) SignedContentURL(cid blob.CID, hostAddr, subject string, ttl time.Duration) string {
+	return pub.urlFor(hostAddr, pub.signPath(pub.opts.PathPrefix+cidPath+string(cid), subject, ttl))
+}
+
+// StreamURL returns the URL of the given format's manifest for an asset published at assetURL (see PublisherOpts.Streams).
+func StreamURL(assetURL string, format streaming.Format) string {
+	base, query, _ := strings.Cut(assetURL, "?")
+	streamURL := base + "/" + string(format) + "/" + format.Manifest()
+	if query != "" {
+		streamURL += "?" + query
+	}
+	return streamURL
+}
+
+func (pub *HTTPPublisher) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	token, found := strings.CutPrefix(r.URL.Path, pub.opts.PathPrefix)
+	if !found {
+		http.NotFound(w, r)
+		return
+	}
+	if str, isCID := strings.CutPrefix(token, cidPath); isCID && pub.opts.Store != nil {
+		str, subPath, _ := strings.Cut(str, "/")
+		if pub.opts.PrivateStore && !pub.verifySigned(r, pub.opts.PathPrefix+cidPath+str) {
+			http.Error(w, "invalid or expired signature", http.StatusForbidden)
+			return
+		}
+		var acct Account
+		if pub.opts.PrivateStore {
+			acct.User = r.URL.Query().Get("sub") // already verified
+			setSigned(w.Header())
+		}
+		w, done := pub.meter(w, acct)
+		if w == nil {
+			return
+		}
+		defer done()
+		pub.serveContent(w, r, str, subPath)
+		return
+	}
+
+	// signatures cover the asset's base path, so the same query also authorizes its stream sub-paths
+	token, subPath, _ := strings.Cut(token, "/")
+
+	pub.mu.Lock()
+	pa := pub.assets[token]
+	pub.mu.Unlock()
+
+	if pa == nil {
+		http.NotFound(w, r)
+		return
+	}
+	if pa.private {
+		if !pub.verifySigned(r, pub.opts.PathPrefix+token) {
+			http.Error(w, "invalid or expired signature", http.StatusForbidden)
+			return
+		}
+		setSigned(w.Header())
+	}
+	acct := pa.account
+	if pa.scope != "" {
+		subject, err := pub.checkAccess(r, pa)
+		if err != nil {
+			status := http.StatusForbidden
+			if err == ErrNotAuthenticated {
+				status = http.StatusUnauthorized
+			}
+			http.Error(w, err.Error(), status)
+			return
+		}
+		// the response depends on the requester, so shared caches must not retain it
+		w.Header().Set("Cache-Control", "private")
+		if acct.User == "" {
+			acct.User = subject
+		}
+	}
+	w, done := pub.meter(w, acct)
+	if w == nil {
+		return
+	}
+	defer done()
+
+	pub.mu.Lock()
+	pa.timer.Reset(pa.expiry)
+	pub.mu.Unlock()
+
+	pub.serveAsset(w, r, pa.asset, token, subPath)
+}
+
+// checkAccess identifies the requester of a scoped asset and checks if they may read it.
+func (pub *HTTPPublisher) checkAccess(r *http.Request, pa *publishedAsset) (subject string, err error) {
+	if pa.private {
+		subject = r.URL.Query().Get("sub") // already verified
+	}
+	if subject == "" {
+		if subject, err = pub.opts.Access.Requester(r); err != nil {
+			return "", err
+		}
+	}
+	return subject, pub.opts.Access.CanRead(subject, pa.scope)
+}
+
+// meter admits a request for the given account, returning a writer that counts the bytes served and a func recording them once served.
+// If the account is over quota, a 429 is written and a nil writer is returned.
+func (pub *HTTPPublisher) meter(w http.ResponseWriter, acct Account) (http.ResponseWriter, func()) {
+	meter := pub.opts.Meter
+	if meter == nil || acct == (Account{}) {
+		return w, func() {}
+	}
+	if err := meter.Admit(acct); err != nil {
+		if overQuota, ok := err.(*OverQuotaError); ok {
+			retryAfter := time.Until(overQuota.ResetAt).Round(time.Second)
+			w.Header().Set("Retry-After", strconv.Itoa(int(retryAfter.Seconds())))
+		}
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return nil, nil
+	}
+	mw := &meteredWriter{ResponseWriter: w}
+	return mw, func() {
+		meter.Record(acct, mw.bytes)
+	}
+}
+
+func (pub *HTTPPublisher) serveContent(w http.ResponseWriter, r *http.Request, str, subPath string) {
+	cid, err := blob.ParseCID(str)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	asset := NewBlobAsset(pub.opts.Store, cid.Key(), "")
+	if err = asset.OnStart(pub.ctx); err == blob.ErrNotFound {
+		http.NotFound(w, r)
+		return
+	} else if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	pub.serveAsset(w, r, asset, string(cid), subPath)
+}
+
+// serveAsset serves the given asset, an image variant of it (see PublisherOpts.Images), or a file of its stream package (see PublisherOpts.Streams).
+func (pub *HTTPPublisher) serveAsset(w http.ResponseWriter, r *http.Request, asset Asset, srcID, subPath string) {
+	// the variant and stream caches are keyed by source content, so a source whose content can change must offer an ETag
+	if stat, ok := asset.(AssetStat); ok {
+		srcID += "@" + stat.ETag()
+	}
+	open := func() (io.ReadCloser, error) {
+		return asset.NewAssetReader()
+	}
+
+	if subPath != "" {
+		if pub.opts.Streams == nil {
+			http.NotFound(w, r)
+			return
+		}
+		pub.opts.Streams.Serve(w, r, srcID, asset.ContentType(), open, subPath)
+		return
+	}
+
+	images := pub.opts.Images
+	if images == nil || !strings.HasPrefix(asset.ContentType(), "image/") {
+		ServeAsset(w, r, asset)
+		return
+	}
+	variant, requested, err := imaging.ParseVariant(r.URL.Query())
+	if !requested {
+		ServeAsset(w, r, asset)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	reader, err := images.Variant(r.Context(), srcID, open, variant)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	defer reader.Close()
+
+	info := reader.Info()
+	if ca, ok := asset.(ContentAddressed); ok && ca.CID() != "" {
+		setImmutable(w.Header())
+	}
+	w.Header().Set("ETag", `"`+info.ETag+`"`)
+	w.Header().Set("Content-Type", info.ContentType)
+	http.ServeContent(w, r, "", info.ModTime, reader)
+}
+
+// ServeAsset serves the given asset in response to an HTTP GET or HEAD request.
+//
+// Byte-range requests (Range, If-Range) are supported for any asset, allowing audio / video scrubbing and resumed downloads.
+// Conditional requests (If-None-Match, If-Modified-Since) are supported if the asset implements AssetStat.
+// An asset implementing ContentAddressed is served as immutable, with its CID as its ETag.
+// An asset reader implementing blob.FileReader is copied to the connection via sendfile(2) rather than buffered reads.
+func ServeAsset(w http.ResponseWriter, r *http.Request, asset Asset) {
+	reader, err := asset.NewAssetReader()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	defer reader.Close()
+
+	var modTime time.Time
+	var etag string
+	if stat, ok := asset.(AssetStat); ok {
+		modTime = stat.ModTime()
+		etag = stat.ETag()
+	}
+	if ca, ok := asset.(ContentAddressed); ok {
+		if cid := ca.CID(); cid != "" {
+			etag = string(cid)
+			setImmutable(w.Header())
+		}
+	}
+	if etag != "" {
+		if !strings.HasPrefix(etag, `"`) && !strings.HasPrefix(etag, `W/"`) {
+			etag = `"` + etag + `"`
+		}
+		w.Header().Set("ETag", etag)
+	}
+	if contentType := asset.ContentType(); contentType != "" {
+		w.Header().Set("Content-Type", contentType)
+	}
+	content := io.ReadSeeker(reader)
+	if file, ok := reader.(blob.FileReader); ok {
+		content = file.File() // the connection only takes the sendfile path given an *os.File
+	}
+	http.ServeContent(w, r, asset.Label(), modTime, content)
+}
+
+// setSigned keeps shared caches from retaining a response authorized by a signature, which must not outlive the signature or reach other subjects.
+func setSigned(header http.Header) {
+	header.Set("Cache-Control", "private")
+}
+
+// setImmutable allows a response to be cached indefinitely, retaining a "private" directive set for a scoped asset.
+func setImmutable(header http.Header) {
+	visibility := "public"
+	if strings.HasPrefix(header.Get("Cache-Control"), "private") {
+		visibility = "private"
+	}
+	header.Set("Cache-Control", visibility+", max-age=31536000, immutable")
+}
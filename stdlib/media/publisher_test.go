@@ -0,0 +1,298 @@
+package media_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/blob"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/media"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
+)
+
+type bytesAsset struct {
+	data    []byte
+	modTime time.Time
+}
+
+type bytesReader struct {
+	*bytes.Reader
+}
+
+func (r bytesReader) Close() error                   { return nil }
+func (a *bytesAsset) Label() string                  { return "test.bin" }
+func (a *bytesAsset) ContentType() string            { return "application/octet-stream" }
+func (a *bytesAsset) OnStart(ctx task.Context) error { return nil }
+func (a *bytesAsset) ModTime() time.Time             { return a.modTime }
+func (a *bytesAsset) ETag() string                   { return "v1" }
+func (a *bytesAsset) NewAssetReader() (media.AssetReader, error) {
+	return bytesReader{bytes.NewReader(a.data)}, nil
+}
+
+func TestPublisherRanges(t *testing.T) {
+	root, _ := task.Start(&task.Task{
+		Info: task.Info{Label: "root"},
+	})
+	defer root.Close()
+
+	pub, err := media.StartPublisher(root, media.PublisherOpts{ListenAddr: "localhost:0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	asset := &bytesAsset{
+		data:    []byte("0123456789abcdef"),
+		modTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	url, err := pub.PublishAsset(asset, media.PublishOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	get := func(header, value string) (*http.Response, string) {
+		req, _ := http.NewRequest("GET", url, nil)
+		if header != "" {
+			req.Header.Set(header, value)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return resp, string(body)
+	}
+
+	if resp, body := get("", ""); resp.StatusCode != http.StatusOK || body != string(asset.data) {
+		t.Fatalf("full GET: %d %q", resp.StatusCode, body)
+	}
+	if resp, body := get("Range", "bytes=4-7"); resp.StatusCode != http.StatusPartialContent || body != "4567" {
+		t.Fatalf("range GET: %d %q", resp.StatusCode, body)
+	}
+	if resp, _ := get("If-None-Match", `"v1"`); resp.StatusCode != http.StatusNotModified {
+		t.Fatalf("If-None-Match: %d", resp.StatusCode)
+	}
+	if resp, _ := get("If-Modified-Since", asset.modTime.Format(http.TimeFormat)); resp.StatusCode != http.StatusNotModified {
+		t.Fatalf("If-Modified-Since: %d", resp.StatusCode)
+	}
+	if resp, _ := get("Range", "bytes=100-"); resp.StatusCode != http.StatusRequestedRangeNotSatisfiable {
+		t.Fatalf("bad range: %d", resp.StatusCode)
+	}
+}
+
+func TestPublisherContentURL(t *testing.T) {
+	root, _ := task.Start(&task.Task{
+		Info: task.Info{Label: "root"},
+	})
+	defer root.Close()
+
+	store, _ := blob.NewDirStore(t.TempDir())
+	pub, err := media.StartPublisher(root, media.PublisherOpts{ListenAddr: "localhost:0", Store: store})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cid, err := blob.PutContent(root, store, strings.NewReader("immutable"), blob.Info{ContentType: "text/plain"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := http.Get(pub.ContentURL(cid, ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if string(body) != "immutable" || resp.Header.Get("ETag") != `"`+string(cid)+`"` || !strings.Contains(resp.Header.Get("Cache-Control"), "immutable") {
+		t.Fatalf("content GET: %q %v", body, resp.Header)
+	}
+
+	resp, _ = http.Get(pub.ContentURL(blob.CIDOf([]byte("missing")), ""))
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", resp.StatusCode)
+	}
+}
+
+func TestPublisherSignedURLs(t *testing.T) {
+	root, _ := task.Start(&task.Task{
+		Info: task.Info{Label: "root"},
+	})
+	defer root.Close()
+
+	pub, err := media.StartPublisher(root, media.PublisherOpts{ListenAddr: "localhost:0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	asset := &bytesAsset{data: []byte("private")}
+	signedURL, err := pub.PublishAsset(asset, media.PublishOpts{Private: true, Subject: "user-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	status := func(url string) int {
+		resp, err := http.Get(url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	resp, err := http.Get(signedURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || !strings.HasPrefix(resp.Header.Get("Cache-Control"), "private") {
+		t.Fatalf("signed GET: %d %v", resp.StatusCode, resp.Header)
+	}
+	if code := status(strings.Replace(signedURL, "sub=user-1", "sub=user-2", 1)); code != http.StatusForbidden {
+		t.Fatalf("tampered GET: %d", code)
+	}
+	if code := status(signedURL[:strings.IndexByte(signedURL, '?')]); code != http.StatusForbidden {
+		t.Fatalf("unsigned GET: %d", code)
+	}
+
+	pub.Revoke("user-1")
+	if code := status(signedURL); code != http.StatusForbidden {
+		t.Fatalf("revoked GET: %d", code)
+	}
+}
+
+// tokenPolicy identifies requesters by bearer token and allows each to read the scopes listed for it.
+type tokenPolicy map[string][]string
+
+func (p tokenPolicy) Requester(r *http.Request) (string, error) {
+	token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !found {
+		return "", media.ErrNotAuthenticated
+	}
+	return token, nil
+}
+
+func (p tokenPolicy) CanRead(subject, scope string) error {
+	for _, allowed := range p[subject] {
+		if allowed == scope {
+			return nil
+		}
+	}
+	return media.ErrAccessDenied
+}
+
+func TestPublisherAccess(t *testing.T) {
+	root, _ := task.Start(&task.Task{
+		Info: task.Info{Label: "root"},
+	})
+	defer root.Close()
+
+	asset := &bytesAsset{data: []byte("members only")}
+	unchecked, _ := media.StartPublisher(root, media.PublisherOpts{ListenAddr: "localhost:0"})
+	if _, err := unchecked.PublishAsset(asset, media.PublishOpts{Scope: "cell-1"}); err != media.ErrNoAccessPolicy {
+		t.Fatalf("expected ErrNoAccessPolicy, got %v", err)
+	}
+
+	pub, err := media.StartPublisher(root, media.PublisherOpts{
+		ListenAddr: "localhost:0",
+		Access:     tokenPolicy{"alice": {"cell-1"}, "bob": {"cell-2"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	url, _ := pub.PublishAsset(asset, media.PublishOpts{Scope: "cell-1"})
+
+	get := func(url, token string) *http.Response {
+		req, _ := http.NewRequest("GET", url, nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp
+	}
+	if resp := get(url, ""); resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("anonymous: %d", resp.StatusCode)
+	}
+	if resp := get(url, "bob"); resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("bob: %d", resp.StatusCode)
+	}
+	if resp := get(url, "alice"); resp.StatusCode != http.StatusOK || resp.Header.Get("Cache-Control") != "private" {
+		t.Fatalf("alice: %d %q", resp.StatusCode, resp.Header.Get("Cache-Control"))
+	}
+
+	// a private URL bound to a subject identifies the requester without a token
+	signed, _ := pub.PublishAsset(asset, media.PublishOpts{Scope: "cell-1", Private: true, Subject: "alice"})
+	if resp := get(signed, ""); resp.StatusCode != http.StatusOK {
+		t.Fatalf("signed for alice: %d", resp.StatusCode)
+	}
+	signed, _ = pub.PublishAsset(asset, media.PublishOpts{Scope: "cell-1", Private: true, Subject: "bob"})
+	if resp := get(signed, ""); resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("signed for bob: %d", resp.StatusCode)
+	}
+}
+
+func TestPublisherQuota(t *testing.T) {
+	root, _ := task.Start(&task.Task{
+		Info: task.Info{Label: "root"},
+	})
+	defer root.Close()
+
+	meter := media.NewBandwidthMeter(media.MeterOpts{
+		Quotas: map[media.AccountKind]int64{
+			media.AccountUser: 20,
+		},
+	})
+	pub, err := media.StartPublisher(root, media.PublisherOpts{
+		ListenAddr: "localhost:0",
+		Meter:      meter,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	asset := &bytesAsset{data: []byte("0123456789abcdef")}
+	url, _ := pub.PublishAsset(asset, media.PublishOpts{
+		Account: media.Account{User: "alice", App: "gallery"},
+	})
+	get := func() *http.Response {
+		resp, err := http.Get(url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		return resp
+	}
+
+	// the second request is admitted since usage (16) is still under quota (20), overshooting it
+	for i := 0; i < 2; i++ {
+		if resp := get(); resp.StatusCode != http.StatusOK {
+			t.Fatalf("request %d: %d", i, resp.StatusCode)
+		}
+	}
+	if resp := get(); resp.StatusCode != http.StatusTooManyRequests || resp.Header.Get("Retry-After") == "" {
+		t.Fatalf("expected 429 with Retry-After, got %d", resp.StatusCode)
+	}
+
+	var overQuota *media.OverQuotaError
+	err = meter.Admit(media.Account{User: "alice"})
+	if !errors.Is(err, media.ErrOverQuota) || !errors.As(err, &overQuota) || overQuota.Kind != media.AccountUser || overQuota.Used != 32 {
+		t.Fatalf("unexpected over quota error: %v", err)
+	}
+
+	usage := meter.Usage()
+	if len(usage) != 2 || usage[0].Kind != media.AccountApp || usage[0].Total != 32 || usage[1].Quota != 20 {
+		t.Fatalf("unexpected usage: %+v", usage)
+	}
+
+	// lifting the user's quota admits further requests
+	meter.SetQuota(media.AccountUser, "alice", -1)
+	if resp := get(); resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected quota lifted, got %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,50 @@
+// Package metadata extracts descriptive metadata from media content -- dimensions, duration, EXIF, and ID3 tags -- so apps need not each embed their own tag readers.
+//
+// Shipped extractors:
+//
+//	image/jpeg              -- dimensions and EXIF (camera, orientation, capture time, GPS location)
+//	image/png, image/gif    -- dimensions
+//	audio/mpeg              -- ID3v2 / ID3v1 tags and duration (Xing header or constant bitrate)
+//	audio/flac              -- duration and Vorbis comments
+//	audio/wav               -- duration
+//	video/mp4, audio/mp4    -- duration, dimensions, and creation time (also QuickTime)
+//
+// Other formats become available once registered via RegisterExtractor().
+package metadata
+
+import (
+	"errors"
+	"io"
+	"time"
+)
+
+// Info is metadata extracted from media content, where zero values denote unknown fields.
+type Info struct {
+	ContentType string        // media (MIME) type, sniffed if not given
+	Width       int           // pixels
+	Height      int           // pixels
+	Duration    time.Duration // play time of audio or video
+	Orientation int           // EXIF orientation (1..8)
+	TakenAt     time.Time     // when a photo or video was captured
+	CameraMake  string
+	CameraModel string
+
+	HasLocation bool    // set if Lat and Lng are known
+	Lat         float64 // degrees
+	Lng         float64 // degrees
+
+	Title  string
+	Artist string
+	Album  string
+	Genre  string
+	Year   int
+	Track  int
+}
+
+// ExtractFunc reads metadata from r into info, where r is positioned at the start of the content and info.ContentType is set.
+// Fields already set in info should be retained unless the content offers a better value.
+type ExtractFunc func(r io.ReadSeeker, info *Info) error
+
+var (
+	ErrMalformed = errors.New("malformed metadata")
+)
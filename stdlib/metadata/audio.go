@@ -0,0 +1,353 @@
+package metadata
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+// extractMP3 reads an MP3's ID3v2 and ID3v1 tags and computes its duration.
+func extractMP3(r io.ReadSeeker, info *Info) error {
+	fileSize, err := size(r)
+	if err != nil {
+		return err
+	}
+
+	audioStart := int64(0)
+	if hdr, err := readAt(r, 0, 10); err == nil && string(hdr[:3]) == "ID3" {
+		tagSize := int64(syncsafe(hdr[6:10])) + 10
+		if hdr[5]&0x10 != 0 {
+			tagSize += 10 // footer
+		}
+		if body, err := readAt(r, 10, int(tagSize)-10); err == nil {
+			parseID3v2(hdr[3], body, info)
+		}
+		audioStart = tagSize
+	}
+
+	audioEnd := fileSize
+	if tail, err := readAt(r, fileSize-128, 128); err == nil && string(tail[:3]) == "TAG" {
+		parseID3v1(tail, info)
+		audioEnd -= 128
+	}
+
+	if info.Duration == 0 {
+		frame, err := readAt(r, audioStart, 4+32+12)
+		if err != nil {
+			return err
+		}
+		info.Duration = mp3Duration(frame, audioEnd-audioStart)
+	}
+	return nil
+}
+
+// syncsafe decodes a 28 bit ID3 integer stored in 7 bits per byte.
+func syncsafe(b []byte) uint32 {
+	return uint32(b[0]&0x7F)<<21 | uint32(b[1]&0x7F)<<14 | uint32(b[2]&0x7F)<<7 | uint32(b[3]&0x7F)
+}
+
+func parseID3v2(version byte, body []byte, info *Info) {
+	idLen, hdrLen := 4, 10
+	if version == 2 {
+		idLen, hdrLen = 3, 6
+	}
+
+	for len(body) >= hdrLen && body[0] != 0 {
+		id := string(body[:idLen])
+		var frameLen int
+		switch version {
+		case 2:
+			frameLen = int(body[3])<<16 | int(body[4])<<8 | int(body[5])
+		case 3:
+			frameLen = int(binary.BigEndian.Uint32(body[4:]))
+		default:
+			frameLen = int(syncsafe(body[4:]))
+		}
+		if frameLen < 0 || hdrLen+frameLen > len(body) {
+			return
+		}
+		frame := body[hdrLen : hdrLen+frameLen]
+		body = body[hdrLen+frameLen:]
+
+		if !strings.HasPrefix(id, "T") {
+			continue
+		}
+		text := id3Text(frame)
+		switch id {
+		case "TIT2", "TT2":
+			info.Title = text
+		case "TPE1", "TP1":
+			info.Artist = text
+		case "TALB", "TAL":
+			info.Album = text
+		case "TCON", "TCO":
+			info.Genre = id3Genre(text)
+		case "TYER", "TYE", "TDRC":
+			info.Year = leadingInt(text)
+		case "TRCK", "TRK":
+			info.Track = leadingInt(text)
+		case "TLEN", "TLE":
+			if ms := leadingInt(text); ms > 0 {
+				info.Duration = time.Duration(ms) * time.Millisecond
+			}
+		}
+	}
+}
+
+// id3Text decodes a text frame, returning its first value.
+func id3Text(frame []byte) string {
+	if len(frame) < 1 {
+		return ""
+	}
+	encoding, data := frame[0], frame[1:]
+
+	var text string
+	switch encoding {
+	case 1, 2: // UTF-16 with BOM, UTF-16BE
+		order := binary.ByteOrder(binary.BigEndian)
+		if encoding == 1 && len(data) >= 2 {
+			if data[0] == 0xFF && data[1] == 0xFE {
+				order = binary.LittleEndian
+			}
+			if (data[0] == 0xFF && data[1] == 0xFE) || (data[0] == 0xFE && data[1] == 0xFF) {
+				data = data[2:]
+			}
+		}
+		units := make([]uint16, 0, len(data)/2)
+		for i := 0; i+1 < len(data); i += 2 {
+			units = append(units, order.Uint16(data[i:]))
+		}
+		text = string(utf16.Decode(units))
+	case 3: // UTF-8
+		text = string(data)
+	default: // ISO-8859-1
+		runes := make([]rune, len(data))
+		for i, b := range data {
+			runes[i] = rune(b)
+		}
+		text = string(runes)
+	}
+	text, _, _ = strings.Cut(text, "\x00")
+	return strings.TrimSpace(text)
+}
+
+// id3Genre strips a numeric ID3v1 genre reference such as "(17)" or "(17)Rock", which most taggers write alongside the name.
+func id3Genre(text string) string {
+	if strings.HasPrefix(text, "(") {
+		if end := strings.IndexByte(text, ')'); end > 0 && end+1 < len(text) {
+			return text[end+1:]
+		}
+	}
+	return text
+}
+
+func parseID3v1(tag []byte, info *Info) {
+	field := func(b []byte) string {
+		str, _, _ := strings.Cut(string(b), "\x00")
+		return strings.TrimSpace(str)
+	}
+	if info.Title == "" {
+		info.Title = field(tag[3:33])
+	}
+	if info.Artist == "" {
+		info.Artist = field(tag[33:63])
+	}
+	if info.Album == "" {
+		info.Album = field(tag[63:93])
+	}
+	if info.Year == 0 {
+		info.Year = leadingInt(field(tag[93:97]))
+	}
+	if info.Track == 0 && tag[125] == 0 && tag[126] != 0 { // ID3v1.1
+		info.Track = int(tag[126])
+	}
+}
+
+// MPEG layer III bitrates (kbps) by bitrate index
+var (
+	mp3BitratesV1 = [16]int{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 0}
+	mp3BitratesV2 = [16]int{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160, 0}
+	mp3Rates      = [3]int{44100, 48000, 32000}
+)
+
+// mp3Duration computes the duration of MPEG layer III audio from its first frame (given with its following bytes) and audio size.
+// A Xing / Info header gives the exact frame count; otherwise the audio is assumed to have a constant bitrate.
+func mp3Duration(frame []byte, audioSize int64) time.Duration {
+	if len(frame) < 4 || frame[0] != 0xFF || frame[1]&0xE0 != 0xE0 || frame[1]&0x06 != 0x02 {
+		return 0
+	}
+	version := (frame[1] >> 3) & 0x03 // 3: MPEG1, 2: MPEG2, 0: MPEG2.5
+	bitrateIdx := frame[2] >> 4
+	rateIdx := (frame[2] >> 2) & 0x03
+	mono := frame[3]>>6 == 3
+	if version == 1 || rateIdx == 3 {
+		return 0
+	}
+
+	sampleRate := mp3Rates[rateIdx]
+	bitrate := mp3BitratesV1[bitrateIdx]
+	samplesPerFrame := 1152
+	xingOfs := 4 + 32
+	if mono {
+		xingOfs = 4 + 17
+	}
+	if version != 3 {
+		sampleRate /= 2
+		if version == 0 {
+			sampleRate /= 2
+		}
+		bitrate = mp3BitratesV2[bitrateIdx]
+		samplesPerFrame = 576
+		xingOfs = 4 + 17
+		if mono {
+			xingOfs = 4 + 9
+		}
+	}
+
+	if xing := frame[min(xingOfs, len(frame)):]; len(xing) >= 12 && (bytes.HasPrefix(xing, []byte("Xing")) || bytes.HasPrefix(xing, []byte("Info"))) {
+		if flags := binary.BigEndian.Uint32(xing[4:]); flags&1 != 0 {
+			frames := int64(binary.BigEndian.Uint32(xing[8:]))
+			return time.Duration(frames * int64(samplesPerFrame) * int64(time.Second) / int64(sampleRate))
+		}
+	}
+	if bitrate == 0 {
+		return 0
+	}
+	return time.Duration(audioSize * 8 * int64(time.Millisecond) / int64(bitrate))
+}
+
+// extractWAV reads the format and data chunks of a RIFF WAVE file.
+func extractWAV(r io.ReadSeeker, info *Info) error {
+	hdr, err := readAt(r, 0, 12)
+	if err != nil {
+		return err
+	}
+	if string(hdr[:4]) != "RIFF" || string(hdr[8:12]) != "WAVE" {
+		return ErrMalformed
+	}
+
+	var byteRate uint32
+	for ofs := int64(12); ; {
+		chunk, err := readAt(r, ofs, 8)
+		if err != nil {
+			return err
+		}
+		chunkLen := binary.LittleEndian.Uint32(chunk[4:])
+		switch string(chunk[:4]) {
+		case "fmt ":
+			fmtChunk, err := readAt(r, ofs+8, 16)
+			if err != nil {
+				return err
+			}
+			byteRate = binary.LittleEndian.Uint32(fmtChunk[8:])
+		case "data":
+			if byteRate == 0 {
+				return ErrMalformed
+			}
+			info.Duration = time.Duration(int64(chunkLen) * int64(time.Second) / int64(byteRate))
+			return nil
+		}
+		ofs += 8 + int64(chunkLen) + int64(chunkLen&1) // chunks are word aligned
+	}
+}
+
+// extractFLAC reads a FLAC's STREAMINFO and VORBIS_COMMENT metadata blocks.
+func extractFLAC(r io.ReadSeeker, info *Info) error {
+	magic, err := readAt(r, 0, 4)
+	if err != nil {
+		return err
+	}
+	if string(magic) != "fLaC" {
+		return ErrMalformed
+	}
+
+	for ofs, last := int64(4), false; !last; {
+		hdr, err := readAt(r, ofs, 4)
+		if err != nil {
+			return err
+		}
+		last = hdr[0]&0x80 != 0
+		blockType := hdr[0] & 0x7F
+		blockLen := int(hdr[1])<<16 | int(hdr[2])<<8 | int(hdr[3])
+
+		switch blockType {
+		case 0: // STREAMINFO
+			block, err := readAt(r, ofs+4, blockLen)
+			if err != nil || len(block) < 18 {
+				return ErrMalformed
+			}
+			sampleRate := int64(block[10])<<12 | int64(block[11])<<4 | int64(block[12])>>4
+			samples := int64(block[13]&0x0F)<<32 | int64(binary.BigEndian.Uint32(block[14:]))
+			if sampleRate > 0 {
+				info.Duration = time.Duration(samples * int64(time.Second) / sampleRate)
+			}
+		case 4: // VORBIS_COMMENT
+			block, err := readAt(r, ofs+4, blockLen)
+			if err != nil {
+				return err
+			}
+			parseVorbisComments(block, info)
+		}
+		ofs += 4 + int64(blockLen)
+	}
+	return nil
+}
+
+func parseVorbisComments(block []byte, info *Info) {
+	next := func() (string, bool) {
+		if len(block) < 4 {
+			return "", false
+		}
+		n := binary.LittleEndian.Uint32(block)
+		if uint64(n)+4 > uint64(len(block)) {
+			return "", false
+		}
+		str := string(block[4 : 4+n])
+		block = block[4+n:]
+		return str, true
+	}
+	if _, ok := next(); !ok { // vendor
+		return
+	}
+	if len(block) < 4 {
+		return
+	}
+	count := binary.LittleEndian.Uint32(block)
+	block = block[4:]
+
+	for i := uint32(0); i < count; i++ {
+		comment, ok := next()
+		if !ok {
+			return
+		}
+		key, value, _ := strings.Cut(comment, "=")
+		switch strings.ToUpper(key) {
+		case "TITLE":
+			info.Title = value
+		case "ARTIST":
+			info.Artist = value
+		case "ALBUM":
+			info.Album = value
+		case "GENRE":
+			info.Genre = value
+		case "DATE":
+			info.Year = leadingInt(value)
+		case "TRACKNUMBER":
+			info.Track = leadingInt(value)
+		}
+	}
+}
+
+// leadingInt parses the leading digits of a string such as "3/12" or "2021-04-01".
+func leadingInt(str string) int {
+	end := 0
+	for end < len(str) && str[end] >= '0' && str[end] <= '9' {
+		end++
+	}
+	n, _ := strconv.Atoi(str[:end])
+	return n
+}
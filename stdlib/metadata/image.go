@@ -0,0 +1,209 @@
+package metadata
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"image"
+	_ "image/gif"
+	_ "image/png"
+	"io"
+	"strings"
+	"time"
+)
+
+func extractImageConfig(r io.ReadSeeker, info *Info) error {
+	cfg, _, err := image.DecodeConfig(bufio.NewReader(r))
+	if err != nil {
+		return ErrMalformed
+	}
+	info.Width, info.Height = cfg.Width, cfg.Height
+	return nil
+}
+
+// extractJPEG walks a JPEG's marker segments up to the image data, reading its dimensions and EXIF.
+func extractJPEG(r io.ReadSeeker, info *Info) error {
+	br := bufio.NewReader(r)
+	var hdr [4]byte
+	if _, err := io.ReadFull(br, hdr[:2]); err != nil {
+		return err
+	}
+	if hdr[0] != 0xFF || hdr[1] != 0xD8 {
+		return ErrMalformed
+	}
+
+	for {
+		if _, err := io.ReadFull(br, hdr[:]); err != nil {
+			return err
+		}
+		if hdr[0] != 0xFF {
+			return ErrMalformed
+		}
+		marker := hdr[1]
+		segLen := int(binary.BigEndian.Uint16(hdr[2:])) - 2
+		if marker == 0xDA || segLen < 0 { // start of scan
+			return nil
+		}
+		seg := make([]byte, segLen)
+		if _, err := io.ReadFull(br, seg); err != nil {
+			return err
+		}
+
+		switch {
+		case marker == 0xE1 && bytes.HasPrefix(seg, []byte("Exif\x00\x00")):
+			parseEXIF(seg[6:], info)
+
+		// SOFn, excluding DHT (C4), JPG (C8), and DAC (CC)
+		case marker >= 0xC0 && marker <= 0xCF && marker != 0xC4 && marker != 0xC8 && marker != 0xCC:
+			if len(seg) >= 5 {
+				info.Height = int(binary.BigEndian.Uint16(seg[1:]))
+				info.Width = int(binary.BigEndian.Uint16(seg[3:]))
+			}
+		}
+	}
+}
+
+// EXIF tags of interest
+const (
+	tagMake             = 0x010F
+	tagModel            = 0x0110
+	tagOrientation      = 0x0112
+	tagDateTime         = 0x0132
+	tagExifIFD          = 0x8769
+	tagGPSIFD           = 0x8825
+	tagDateTimeOriginal = 0x9003
+	tagGPSLatRef        = 0x0001
+	tagGPSLat           = 0x0002
+	tagGPSLngRef        = 0x0003
+	tagGPSLng           = 0x0004
+)
+
+// tiff reads the TIFF structure that holds EXIF data.
+type tiff struct {
+	data  []byte
+	order binary.ByteOrder
+}
+
+type ifdEntry struct {
+	typ   uint16
+	count uint32
+	value []byte
+}
+
+// bytes per component of each TIFF field type
+var tiffTypeSize = [...]int{0, 1, 1, 2, 4, 8, 1, 1, 2, 4, 8, 4, 8}
+
+func parseEXIF(data []byte, info *Info) {
+	if len(data) < 8 {
+		return
+	}
+	t := tiff{data: data}
+	switch string(data[:2]) {
+	case "II":
+		t.order = binary.LittleEndian
+	case "MM":
+		t.order = binary.BigEndian
+	default:
+		return
+	}
+	ifd0 := t.ifd(t.order.Uint32(data[4:]))
+
+	info.CameraMake = t.ascii(ifd0[tagMake])
+	info.CameraModel = t.ascii(ifd0[tagModel])
+	if orient := t.uint(ifd0[tagOrientation], 0); orient >= 1 && orient <= 8 {
+		info.Orientation = int(orient)
+	}
+
+	dateTime := t.ascii(ifd0[tagDateTime])
+	if entry, ok := ifd0[tagExifIFD]; ok {
+		exif := t.ifd(t.uint(entry, 0))
+		if original := t.ascii(exif[tagDateTimeOriginal]); original != "" {
+			dateTime = original
+		}
+	}
+	if when, err := time.Parse("2006:01:02 15:04:05", dateTime); err == nil {
+		info.TakenAt = when
+	}
+
+	if entry, ok := ifd0[tagGPSIFD]; ok {
+		gps := t.ifd(t.uint(entry, 0))
+		lat, latOK := t.degrees(gps[tagGPSLat])
+		lng, lngOK := t.degrees(gps[tagGPSLng])
+		if latOK && lngOK {
+			if t.ascii(gps[tagGPSLatRef]) == "S" {
+				lat = -lat
+			}
+			if t.ascii(gps[tagGPSLngRef]) == "W" {
+				lng = -lng
+			}
+			info.Lat, info.Lng, info.HasLocation = lat, lng, true
+		}
+	}
+}
+
+// ifd returns the entries of the IFD at the given offset, or nil if malformed.
+func (t *tiff) ifd(offset uint32) map[uint16]ifdEntry {
+	if uint64(offset)+2 > uint64(len(t.data)) {
+		return nil
+	}
+	n := int(t.order.Uint16(t.data[offset:]))
+	entries := make(map[uint16]ifdEntry, n)
+	pos := int(offset) + 2
+	for i := 0; i < n && pos+12 <= len(t.data); i, pos = i+1, pos+12 {
+		entry := ifdEntry{
+			typ:   t.order.Uint16(t.data[pos+2:]),
+			count: t.order.Uint32(t.data[pos+4:]),
+		}
+		if int(entry.typ) >= len(tiffTypeSize) || entry.typ == 0 {
+			continue
+		}
+		size := uint64(tiffTypeSize[entry.typ]) * uint64(entry.count)
+		if size <= 4 {
+			entry.value = t.data[pos+8 : pos+8+int(size)]
+		} else {
+			valOfs := uint64(t.order.Uint32(t.data[pos+8:]))
+			if valOfs+size > uint64(len(t.data)) {
+				continue
+			}
+			entry.value = t.data[valOfs : valOfs+size]
+		}
+		entries[t.order.Uint16(t.data[pos:])] = entry
+	}
+	return entries
+}
+
+func (t *tiff) ascii(entry ifdEntry) string {
+	if entry.typ != 2 {
+		return ""
+	}
+	str, _, _ := strings.Cut(string(entry.value), "\x00")
+	return strings.TrimSpace(str)
+}
+
+// uint returns the i-th component of a SHORT or LONG entry.
+func (t *tiff) uint(entry ifdEntry, i int) uint32 {
+	switch {
+	case entry.typ == 3 && len(entry.value) >= 2*(i+1):
+		return uint32(t.order.Uint16(entry.value[2*i:]))
+	case entry.typ == 4 && len(entry.value) >= 4*(i+1):
+		return t.order.Uint32(entry.value[4*i:])
+	}
+	return 0
+}
+
+// degrees converts a GPS coordinate stored as three RATIONALs (degrees, minutes, seconds).
+func (t *tiff) degrees(entry ifdEntry) (float64, bool) {
+	if entry.typ != 5 || len(entry.value) < 24 {
+		return 0, false
+	}
+	var dms [3]float64
+	for i := range dms {
+		num := t.order.Uint32(entry.value[8*i:])
+		den := t.order.Uint32(entry.value[8*i+4:])
+		if den == 0 {
+			return 0, false
+		}
+		dms[i] = float64(num) / float64(den)
+	}
+	return dms[0] + dms[1]/60 + dms[2]/3600, true
+}
@@ -0,0 +1,125 @@
+package metadata
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+var (
+	extractorsMu sync.RWMutex
+	extractors   = map[string]ExtractFunc{
+		"image/jpeg":      extractJPEG,
+		"image/png":       extractImageConfig,
+		"image/gif":       extractImageConfig,
+		"audio/mpeg":      extractMP3,
+		"audio/flac":      extractFLAC,
+		"audio/wav":       extractWAV,
+		"audio/wave":      extractWAV,
+		"audio/x-wav":     extractWAV,
+		"video/mp4":       extractMP4,
+		"audio/mp4":       extractMP4,
+		"audio/x-m4a":     extractMP4,
+		"video/quicktime": extractMP4,
+	}
+)
+
+// RegisterExtractor registers an ExtractFunc for the given media type, replacing any existing extractor for the type.
+func RegisterExtractor(contentType string, fn ExtractFunc) {
+	extractorsMu.Lock()
+	extractors[contentType] = fn
+	extractorsMu.Unlock()
+}
+
+// Extract reads the metadata of the given content, sniffing its media type if contentType is empty or generic.
+//
+// Extraction is best effort: malformed or truncated metadata is skipped, so an error is only returned if reading r fails.
+// Only the headers and tags of the content are read, so extraction is inexpensive even for large media.
+func Extract(r io.ReadSeeker, contentType string) (*Info, error) {
+	info := &Info{}
+	if mediaType, _, err := mime.ParseMediaType(contentType); err == nil {
+		contentType = mediaType
+	}
+	if contentType == "" || contentType == "application/octet-stream" {
+		var err error
+		if contentType, err = Sniff(r); err != nil {
+			return nil, err
+		}
+	}
+	info.ContentType = contentType
+
+	extractorsMu.RLock()
+	fn := extractors[contentType]
+	extractorsMu.RUnlock()
+	if fn == nil {
+		return info, nil
+	}
+
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	err := fn(r, info)
+	if err == ErrMalformed || err == io.EOF || err == io.ErrUnexpectedEOF {
+		err = nil
+	}
+	return info, err
+}
+
+// Sniff returns the media type of the given content based on its leading bytes, leaving r positioned at the start.
+func Sniff(r io.ReadSeeker) (string, error) {
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	var buf [512]byte
+	n, err := io.ReadFull(r, buf[:])
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", err
+	}
+	if _, err = r.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	head := buf[:n]
+
+	// formats net/http does not sniff (or sniffs too generally)
+	switch {
+	case bytes.HasPrefix(head, []byte("fLaC")):
+		return "audio/flac", nil
+	case len(head) >= 4 && head[0] == 0xFF && head[1]&0xE0 == 0xE0 && head[1]&0x06 == 0x02:
+		return "audio/mpeg", nil // MPEG layer III frame without an ID3 tag
+	case len(head) >= 12 && string(head[4:8]) == "ftyp":
+		switch string(head[8:12]) {
+		case "M4A ", "M4B ":
+			return "audio/mp4", nil
+		case "qt  ":
+			return "video/quicktime", nil
+		}
+		return "video/mp4", nil
+	}
+
+	contentType := http.DetectContentType(head)
+	contentType, _, _ = strings.Cut(contentType, ";")
+	return contentType, nil
+}
+
+// readAt reads exactly n bytes at the given offset.
+func readAt(r io.ReadSeeker, offset int64, n int) ([]byte, error) {
+	if n < 0 || n > 16<<20 {
+		return nil, ErrMalformed
+	}
+	if _, err := r.Seek(offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, n)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// size returns the total size of the given content.
+func size(r io.ReadSeeker) (int64, error) {
+	return r.Seek(0, io.SeekEnd)
+}
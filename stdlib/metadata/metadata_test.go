@@ -0,0 +1,168 @@
+package metadata_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/jpeg"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/metadata"
+)
+
+func TestJPEG(t *testing.T) {
+	var img bytes.Buffer
+	jpeg.Encode(&img, image.NewGray(image.Rect(0, 0, 40, 30)), nil)
+
+	// splice an EXIF segment in after SOI
+	exif := append([]byte("Exif\x00\x00"), testTIFF()...)
+	app1 := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(app1[2:], uint16(len(exif)+2))
+	content := append(append(append([]byte{}, img.Bytes()[:2]...), app1...), exif...)
+	content = append(content, img.Bytes()[2:]...)
+
+	info, err := metadata.Extract(bytes.NewReader(content), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.ContentType != "image/jpeg" || info.Width != 40 || info.Height != 30 {
+		t.Fatalf("unexpected image info: %+v", info)
+	}
+	if info.CameraMake != "Canon" || info.Orientation != 6 || !info.TakenAt.Equal(time.Date(2021, 6, 5, 14, 30, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected EXIF: %+v", info)
+	}
+	if !info.HasLocation || math.Abs(info.Lat-37.5) > 1e-9 || math.Abs(info.Lng+122.25) > 1e-9 {
+		t.Fatalf("unexpected location: %v, %v", info.Lat, info.Lng)
+	}
+}
+
+// testTIFF returns a little-endian TIFF having IFD0 at 8, the EXIF IFD at 62, the GPS IFD at 80, and values from 134.
+func testTIFF() []byte {
+	buf := make([]byte, 208)
+	le := binary.LittleEndian
+	copy(buf, "II")
+	le.PutUint16(buf[2:], 42)
+	le.PutUint32(buf[4:], 8)
+
+	entry := func(pos int, tag, typ uint16, count, value uint32) int {
+		le.PutUint16(buf[pos:], tag)
+		le.PutUint16(buf[pos+2:], typ)
+		le.PutUint32(buf[pos+4:], count)
+		le.PutUint32(buf[pos+8:], value)
+		return pos + 12
+	}
+	rational := func(pos int, vals ...uint32) {
+		for i := 0; i < len(vals); i += 2 {
+			le.PutUint32(buf[pos+4*i:], vals[i])
+			le.PutUint32(buf[pos+4*i+4:], vals[i+1])
+		}
+	}
+
+	le.PutUint16(buf[8:], 4)
+	pos := entry(10, 0x010F, 2, 6, 134) // Make
+	pos = entry(pos, 0x0112, 3, 1, 6)   // Orientation
+	pos = entry(pos, 0x8769, 4, 1, 62)  // EXIF IFD
+	entry(pos, 0x8825, 4, 1, 80)        // GPS IFD
+
+	le.PutUint16(buf[62:], 1)
+	entry(64, 0x9003, 2, 20, 140) // DateTimeOriginal
+
+	le.PutUint16(buf[80:], 4)
+	pos = entry(82, 0x0001, 2, 2, 'N')
+	pos = entry(pos, 0x0002, 5, 3, 160)
+	pos = entry(pos, 0x0003, 2, 2, 'W')
+	entry(pos, 0x0004, 5, 3, 184)
+
+	copy(buf[134:], "Canon\x00")
+	copy(buf[140:], "2021:06:05 14:30:00\x00")
+	rational(160, 37, 1, 30, 1, 0, 1)  // 37° 30'
+	rational(184, 122, 1, 15, 1, 0, 1) // 122° 15'
+	return buf
+}
+
+func TestMP3(t *testing.T) {
+	frame := func(id string, text []byte) []byte {
+		hdr := make([]byte, 10)
+		copy(hdr, id)
+		binary.BigEndian.PutUint32(hdr[4:], uint32(len(text)))
+		return append(hdr, text...)
+	}
+	var frames []byte
+	frames = append(frames, frame("TIT2", []byte("\x00Song"))...)
+	frames = append(frames, frame("TPE1", []byte{1, 0xFF, 0xFE, 'A', 0, 'r', 0, 't', 0})...) // UTF-16LE with BOM
+	frames = append(frames, frame("TCON", []byte("\x00(17)Rock"))...)
+	frames = append(frames, frame("TRCK", []byte("\x003/12"))...)
+
+	id3 := []byte{'I', 'D', '3', 3, 0, 0, 0, 0, byte(len(frames) >> 7), byte(len(frames) & 0x7F)}
+	content := append(id3, frames...)
+
+	// 16000 bytes of 128 kbps MPEG1 layer III audio is one second
+	audio := make([]byte, 16000)
+	copy(audio, []byte{0xFF, 0xFB, 0x90, 0x00})
+	content = append(content, audio...)
+
+	info, err := metadata.Extract(bytes.NewReader(content), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.ContentType != "audio/mpeg" || info.Title != "Song" || info.Artist != "Art" || info.Genre != "Rock" || info.Track != 3 {
+		t.Fatalf("unexpected tags: %+v", info)
+	}
+	if info.Duration != time.Second {
+		t.Fatalf("unexpected duration %v", info.Duration)
+	}
+}
+
+func TestWAV(t *testing.T) {
+	wav := make([]byte, 44)
+	copy(wav, "RIFF")
+	copy(wav[8:], "WAVEfmt ")
+	binary.LittleEndian.PutUint32(wav[16:], 16)
+	binary.LittleEndian.PutUint32(wav[28:], 16000) // byte rate
+	copy(wav[36:], "data")
+	binary.LittleEndian.PutUint32(wav[40:], 32000)
+
+	info, err := metadata.Extract(bytes.NewReader(wav), "audio/wav")
+	if err != nil || info.Duration != 2*time.Second {
+		t.Fatalf("unexpected WAV info: %+v, %v", info, err)
+	}
+}
+
+func TestMP4(t *testing.T) {
+	box := func(boxType string, content ...[]byte) []byte {
+		body := bytes.Join(content, nil)
+		hdr := make([]byte, 8)
+		binary.BigEndian.PutUint32(hdr, uint32(len(body)+8))
+		copy(hdr[4:], boxType)
+		return append(hdr, body...)
+	}
+	mvhd := make([]byte, 100)
+	binary.BigEndian.PutUint32(mvhd[12:], 1000)  // timescale
+	binary.BigEndian.PutUint32(mvhd[16:], 90500) // duration
+	tkhd := make([]byte, 84)
+	binary.BigEndian.PutUint32(tkhd[76:], 1920<<16)
+	binary.BigEndian.PutUint32(tkhd[80:], 1080<<16)
+
+	content := bytes.Join([][]byte{
+		box("ftyp", []byte("isom\x00\x00\x02\x00")),
+		box("mdat", make([]byte, 4096)),
+		box("moov", box("mvhd", mvhd), box("trak", box("tkhd", tkhd))),
+	}, nil)
+
+	info, err := metadata.Extract(bytes.NewReader(content), "application/octet-stream")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.ContentType != "video/mp4" || info.Duration != 90500*time.Millisecond || info.Width != 1920 || info.Height != 1080 {
+		t.Fatalf("unexpected MP4 info: %+v", info)
+	}
+}
+
+func TestMalformed(t *testing.T) {
+	info, err := metadata.Extract(bytes.NewReader([]byte("ID3\x03\x00\x00\x7F\x7F\x7F\x7Ftruncated")), "audio/mpeg")
+	if err != nil || info.ContentType != "audio/mpeg" {
+		t.Fatalf("truncated content should not fail: %+v, %v", info, err)
+	}
+}
@@ -0,0 +1,111 @@
+package metadata
+
+import (
+	"encoding/binary"
+	"io"
+	"time"
+)
+
+// Seconds from the MP4 epoch (1904-01-01) to the Unix epoch
+const mp4EpochOffset = 2082844800
+
+// extractMP4 walks an ISO base media (MP4 / QuickTime) box tree, reading the movie header and the first visual track header.
+// Only box headers are read while seeking, so a large mdat box preceding moov is skipped over.
+func extractMP4(r io.ReadSeeker, info *Info) error {
+	end, err := size(r)
+	if err != nil {
+		return err
+	}
+	return walkBoxes(r, 0, end, func(boxType string, ofs, boxLen int64) error {
+		if boxType != "moov" {
+			return nil
+		}
+		return walkBoxes(r, ofs, ofs+boxLen, func(boxType string, ofs, boxLen int64) error {
+			switch boxType {
+			case "mvhd":
+				return readMVHD(r, ofs, boxLen, info)
+			case "trak":
+				return walkBoxes(r, ofs, ofs+boxLen, func(boxType string, ofs, boxLen int64) error {
+					if boxType == "tkhd" && info.Width == 0 {
+						return readTKHD(r, ofs, boxLen, info)
+					}
+					return nil
+				})
+			}
+			return nil
+		})
+	})
+}
+
+// walkBoxes calls fn with the type and content range of each box within [ofs, end).
+func walkBoxes(r io.ReadSeeker, ofs, end int64, fn func(boxType string, ofs, boxLen int64) error) error {
+	for ofs+8 <= end {
+		hdr, err := readAt(r, ofs, 8)
+		if err != nil {
+			return err
+		}
+		hdrLen := int64(8)
+		boxLen := int64(binary.BigEndian.Uint32(hdr))
+		switch boxLen {
+		case 0: // extends to end
+			boxLen = end - ofs
+		case 1: // 64 bit size follows
+			ext, err := readAt(r, ofs+8, 8)
+			if err != nil {
+				return err
+			}
+			boxLen = int64(binary.BigEndian.Uint64(ext))
+			hdrLen = 16
+		}
+		if boxLen < hdrLen || ofs+boxLen > end {
+			return ErrMalformed
+		}
+		if err = fn(string(hdr[4:8]), ofs+hdrLen, boxLen-hdrLen); err != nil {
+			return err
+		}
+		ofs += boxLen
+	}
+	return nil
+}
+
+func readMVHD(r io.ReadSeeker, ofs, boxLen int64, info *Info) error {
+	box, err := readAt(r, ofs, int(min(boxLen, 32)))
+	if err != nil {
+		return err
+	}
+	var created, timescale, duration uint64
+	switch {
+	case box[0] == 1 && len(box) >= 32:
+		created = binary.BigEndian.Uint64(box[4:])
+		timescale = uint64(binary.BigEndian.Uint32(box[20:]))
+		duration = binary.BigEndian.Uint64(box[24:])
+	case box[0] == 0 && len(box) >= 20:
+		created = uint64(binary.BigEndian.Uint32(box[4:]))
+		timescale = uint64(binary.BigEndian.Uint32(box[12:]))
+		duration = uint64(binary.BigEndian.Uint32(box[16:]))
+	default:
+		return ErrMalformed
+	}
+	if timescale > 0 {
+		info.Duration = time.Duration(duration * uint64(time.Second) / timescale)
+	}
+	if created > mp4EpochOffset {
+		info.TakenAt = time.Unix(int64(created-mp4EpochOffset), 0).UTC()
+	}
+	return nil
+}
+
+func readTKHD(r io.ReadSeeker, ofs, boxLen int64, info *Info) error {
+	box, err := readAt(r, ofs, int(boxLen))
+	if err != nil {
+		return err
+	}
+	// width and height are the last two fields, in 16.16 fixed point (zero for audio tracks)
+	if len(box) < 84 {
+		return ErrMalformed
+	}
+	dims := box[len(box)-8:]
+	info.Width = int(binary.BigEndian.Uint32(dims) >> 16)
+	info.Height = int(binary.BigEndian.Uint32(dims[4:]) >> 16)
+	return nil
+}
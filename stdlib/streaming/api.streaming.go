@@ -0,0 +1,76 @@
+// Package streaming packages audio and video assets for adaptive bitrate streaming (HLS and DASH),
+// so players on any platform can stream a source asset rather than download it in full.
+//
+// Packaging is performed by a Packager (typically FFmpeg) and each packaged asset is retained in a work directory:
+//
+//	{WorkDir}/{packageID}/hls/master.m3u8   -- HLS master playlist, with a variant playlist and segments per rendition
+//	{WorkDir}/{packageID}/dash/manifest.mpd -- DASH manifest and segments
+package streaming
+
+import (
+	"context"
+	"errors"
+)
+
+// Format is an adaptive streaming format.
+type Format string
+
+const (
+	HLS  Format = "hls"
+	DASH Format = "dash"
+)
+
+// Manifest returns the file name of this format's top-level manifest.
+func (f Format) Manifest() string {
+	switch f {
+	case DASH:
+		return "manifest.mpd"
+	default:
+		return "master.m3u8"
+	}
+}
+
+// Rendition is a single quality level offered to players.
+type Rendition struct {
+	Name         string // e.g. "720p"
+	Height       int    // video height in pixels (width follows the source aspect ratio); ignored for audio-only sources
+	VideoBitrate int    // kbps; ignored for audio-only sources
+	AudioBitrate int    // kbps
+}
+
+// DefaultLadder is a typical rendition ladder for video sources.
+var DefaultLadder = []Rendition{
+	{Name: "1080p", Height: 1080, VideoBitrate: 5000, AudioBitrate: 160},
+	{Name: "720p", Height: 720, VideoBitrate: 2800, AudioBitrate: 128},
+	{Name: "480p", Height: 480, VideoBitrate: 1400, AudioBitrate: 96},
+	{Name: "240p", Height: 240, VideoBitrate: 400, AudioBitrate: 64},
+}
+
+// AudioLadder is a typical rendition ladder for audio-only sources.
+var AudioLadder = []Rendition{
+	{Name: "high", AudioBitrate: 256},
+	{Name: "mid", AudioBitrate: 128},
+	{Name: "low", AudioBitrate: 64},
+}
+
+// Job describes a packaging task.
+type Job struct {
+	Input          string      // path of the source file
+	OutDir         string      // directory to write the manifest and segments to
+	Format         Format      // output format
+	AudioOnly      bool        // set if the source has no video
+	Ladder         []Rendition // renditions to produce
+	SegmentSeconds int         // target segment duration
+}
+
+// Packager produces adaptive streaming output for a Job.
+type Packager interface {
+
+	// Writes Job.Format's manifest and segments into Job.OutDir, blocking until complete or ctx is cancelled.
+	Package(ctx context.Context, job Job) error
+}
+
+var (
+	ErrNotStreamable = errors.New("asset is not audio or video")
+	ErrBadPath       = errors.New("invalid stream path")
+)
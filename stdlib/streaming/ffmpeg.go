@@ -0,0 +1,111 @@
+package streaming
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// FFmpeg is a Packager that runs the ffmpeg command line tool.
+type FFmpeg struct {
+	Path string // path of the ffmpeg executable; if empty, "ffmpeg" is found via $PATH
+}
+
+func (ff FFmpeg) Package(ctx context.Context, job Job) error {
+	path := ff.Path
+	if path == "" {
+		path = "ffmpeg"
+	}
+	cmd := exec.CommandContext(ctx, path, job.FFmpegArgs()...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		msg := stderr.Bytes()
+		if len(msg) > 512 {
+			msg = msg[len(msg)-512:]
+		}
+		return fmt.Errorf("ffmpeg: %w: %s", err, bytes.TrimSpace(msg))
+	}
+	return nil
+}
+
+// FFmpegArgs returns the ffmpeg arguments that perform this job.
+func (job Job) FFmpegArgs() []string {
+	segSecs := job.SegmentSeconds
+	if segSecs <= 0 {
+		segSecs = 6
+	}
+	args := []string{"-hide_banner", "-loglevel", "error", "-y", "-i", job.Input}
+
+	n := len(job.Ladder)
+	if !job.AudioOnly {
+		var filter strings.Builder
+		fmt.Fprintf(&filter, "[0:v]split=%d", n)
+		for i := range job.Ladder {
+			fmt.Fprintf(&filter, "[v%d]", i)
+		}
+		for i, ri := range job.Ladder {
+			fmt.Fprintf(&filter, ";[v%d]scale=-2:%d[v%dout]", i, ri.Height, i)
+		}
+		args = append(args, "-filter_complex", filter.String())
+	}
+
+	for i, ri := range job.Ladder {
+		if !job.AudioOnly {
+			args = append(args,
+				"-map", fmt.Sprintf("[v%dout]", i),
+				fmt.Sprintf("-c:v:%d", i), "libx264",
+				fmt.Sprintf("-b:v:%d", i), fmt.Sprintf("%dk", ri.VideoBitrate),
+				fmt.Sprintf("-maxrate:v:%d", i), fmt.Sprintf("%dk", ri.VideoBitrate*107/100),
+				fmt.Sprintf("-bufsize:v:%d", i), fmt.Sprintf("%dk", ri.VideoBitrate*3/2),
+			)
+		}
+		args = append(args,
+			"-map", "0:a:0?",
+			fmt.Sprintf("-c:a:%d", i), "aac",
+			fmt.Sprintf("-b:a:%d", i), fmt.Sprintf("%dk", ri.AudioBitrate),
+		)
+	}
+	if !job.AudioOnly {
+		// align keyframes to segment boundaries so renditions can be switched between
+		args = append(args, "-force_key_frames", fmt.Sprintf("expr:gte(t,n_forced*%d)", segSecs), "-sc_threshold", "0")
+	}
+
+	switch job.Format {
+	case DASH:
+		sets := "id=0,streams=a"
+		if !job.AudioOnly {
+			sets = "id=0,streams=v id=1,streams=a"
+		}
+		args = append(args,
+			"-f", "dash",
+			"-seg_duration", fmt.Sprint(segSecs),
+			"-use_template", "1",
+			"-use_timeline", "1",
+			"-adaptation_sets", sets,
+			filepath.Join(job.OutDir, DASH.Manifest()),
+		)
+	default:
+		streamMap := make([]string, n)
+		for i := range streamMap {
+			if job.AudioOnly {
+				streamMap[i] = fmt.Sprintf("a:%d,name:%s", i, job.Ladder[i].Name)
+			} else {
+				streamMap[i] = fmt.Sprintf("v:%d,a:%d,name:%s", i, i, job.Ladder[i].Name)
+			}
+		}
+		args = append(args,
+			"-f", "hls",
+			"-hls_time", fmt.Sprint(segSecs),
+			"-hls_playlist_type", "vod",
+			"-hls_segment_filename", filepath.Join(job.OutDir, "%v", "seg%05d.ts"),
+			"-master_pl_name", HLS.Manifest(),
+			"-var_stream_map", strings.Join(streamMap, " "),
+			filepath.Join(job.OutDir, "%v", "index.m3u8"),
+		)
+	}
+	return args
+}
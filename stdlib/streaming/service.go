@@ -0,0 +1,208 @@
+package streaming
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// ServiceOpts specifies a Service.
+type ServiceOpts struct {
+	Packager       Packager    // if nil, FFmpeg{}
+	WorkDir        string      // packaged output is retained here
+	Ladder         []Rendition // renditions for video sources; if nil, DefaultLadder
+	AudioLadder    []Rendition // renditions for audio-only sources; if nil, AudioLadder
+	SegmentSeconds int         // target segment duration; if <= 0, 6
+}
+
+// Service packages source assets on first request and serves the resulting manifests and segments -- concurrency safe.
+type Service struct {
+	opts ServiceOpts
+	mu   sync.Mutex
+	jobs map[string]*packageJob // by package dir
+}
+
+type packageJob struct {
+	done chan struct{}
+	err  error
+}
+
+// Marks a completed package so it is not repackaged after a restart.
+const doneMarker = ".done"
+
+func NewService(opts ServiceOpts) (*Service, error) {
+	if opts.Packager == nil {
+		opts.Packager = FFmpeg{}
+	}
+	if opts.Ladder == nil {
+		opts.Ladder = DefaultLadder
+	}
+	if opts.AudioLadder == nil {
+		opts.AudioLadder = AudioLadder
+	}
+	if err := os.MkdirAll(opts.WorkDir, 0700); err != nil {
+		return nil, err
+	}
+	return &Service{
+		opts: opts,
+		jobs: make(map[string]*packageJob),
+	}, nil
+}
+
+// IsStreamable reports if the given content type can be packaged for streaming.
+func IsStreamable(contentType string) bool {
+	return strings.HasPrefix(contentType, "video/") || strings.HasPrefix(contentType, "audio/")
+}
+
+// Prepare packages the given source in the given format, blocking until complete, and returns the package's output directory.
+//
+// srcID identifies the source's content and must change whenever the content changes (e.g. a CID, or a key and its ETag).
+// A source is only packaged once per format, so open is not called if the package already exists.
+func (svc *Service) Prepare(ctx context.Context, srcID, contentType string, open func() (io.ReadCloser, error), format Format) (string, error) {
+	if !IsStreamable(contentType) {
+		return "", ErrNotStreamable
+	}
+	hash := sha256.Sum256([]byte(srcID))
+	pkgDir := filepath.Join(svc.opts.WorkDir, hex.EncodeToString(hash[:16]))
+	outDir := filepath.Join(pkgDir, string(format))
+	if _, err := os.Stat(filepath.Join(outDir, doneMarker)); err == nil {
+		return outDir, nil
+	}
+
+	svc.mu.Lock()
+	job := svc.jobs[outDir]
+	if job == nil {
+		job = &packageJob{
+			done: make(chan struct{}),
+		}
+		svc.jobs[outDir] = job
+		svc.mu.Unlock()
+
+		// packaging continues even if this requester goes away, as others may be waiting on it
+		job.err = svc.packageSource(context.WithoutCancel(ctx), pkgDir, outDir, contentType, open, format)
+		close(job.done)
+
+		svc.mu.Lock()
+		delete(svc.jobs, outDir)
+		svc.mu.Unlock()
+	} else {
+		svc.mu.Unlock()
+		select {
+		case <-job.done:
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+	return outDir, job.err
+}
+
+func (svc *Service) packageSource(ctx context.Context, pkgDir, outDir, contentType string, open func() (io.ReadCloser, error), format Format) error {
+	input := filepath.Join(pkgDir, "source")
+	if _, err := os.Stat(input); err != nil {
+		if err = os.MkdirAll(pkgDir, 0700); err != nil {
+			return err
+		}
+		if err = copySource(input, open); err != nil {
+			return err
+		}
+	}
+
+	os.RemoveAll(outDir) // discard any partial output from an interrupted run
+	if err := os.MkdirAll(outDir, 0700); err != nil {
+		return err
+	}
+
+	job := Job{
+		Input:          input,
+		OutDir:         outDir,
+		Format:         format,
+		AudioOnly:      strings.HasPrefix(contentType, "audio/"),
+		Ladder:         svc.opts.Ladder,
+		SegmentSeconds: svc.opts.SegmentSeconds,
+	}
+	if job.AudioOnly {
+		job.Ladder = svc.opts.AudioLadder
+	}
+	for _, ri := range job.Ladder {
+		os.MkdirAll(filepath.Join(outDir, ri.Name), 0700)
+	}
+	if err := svc.opts.Packager.Package(ctx, job); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(outDir, doneMarker), nil, 0600)
+}
+
+func copySource(dst string, open func() (io.ReadCloser, error)) error {
+	r, err := open()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	tmp := dst + ".tmp"
+	file, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(file, r)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp, dst)
+	}
+	if err != nil {
+		os.Remove(tmp)
+	}
+	return err
+}
+
+// Serve serves a file of the given source's package, where subPath is relative to the package (e.g. "hls/master.m3u8").
+// The source is packaged on first request for a given format.
+func (svc *Service) Serve(w http.ResponseWriter, r *http.Request, srcID, contentType string, open func() (io.ReadCloser, error), subPath string) {
+	clean := path.Clean("/" + subPath)[1:]
+	formatName, filePath, _ := strings.Cut(clean, "/")
+	format := Format(formatName)
+	if (format != HLS && format != DASH) || filePath == "" || strings.HasPrefix(path.Base(filePath), ".") {
+		http.Error(w, ErrBadPath.Error(), http.StatusNotFound)
+		return
+	}
+
+	outDir, err := svc.Prepare(r.Context(), srcID, contentType, open, format)
+	if err == ErrNotStreamable {
+		http.Error(w, err.Error(), http.StatusUnsupportedMediaType)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if contentType := segmentContentType(filePath); contentType != "" {
+		w.Header().Set("Content-Type", contentType)
+	}
+	http.ServeFile(w, r, filepath.Join(outDir, filepath.FromSlash(filePath)))
+}
+
+func segmentContentType(filePath string) string {
+	switch path.Ext(filePath) {
+	case ".m3u8":
+		return "application/vnd.apple.mpegurl"
+	case ".ts":
+		return "video/mp2t"
+	case ".mpd":
+		return "application/dash+xml"
+	case ".m4s":
+		return "video/iso.segment"
+	case ".mp4":
+		return "video/mp4"
+	}
+	return ""
+}
@@ -0,0 +1,97 @@
+package streaming_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/streaming"
+)
+
+// fakePackager writes a master playlist and one segment per rendition.
+type fakePackager struct {
+	runs atomic.Int32
+}
+
+func (p *fakePackager) Package(ctx context.Context, job streaming.Job) error {
+	p.runs.Add(1)
+	src, err := os.ReadFile(job.Input)
+	if err != nil {
+		return err
+	}
+	var master strings.Builder
+	for _, ri := range job.Ladder {
+		master.WriteString(ri.Name + "/index.m3u8\n")
+		os.WriteFile(filepath.Join(job.OutDir, ri.Name, "seg00000.ts"), src, 0600)
+	}
+	return os.WriteFile(filepath.Join(job.OutDir, job.Format.Manifest()), []byte(master.String()), 0600)
+}
+
+func TestService(t *testing.T) {
+	packager := &fakePackager{}
+	svc, err := streaming.NewService(streaming.ServiceOpts{
+		Packager: packager,
+		WorkDir:  t.TempDir(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	open := func() (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader("segment-bytes")), nil
+	}
+	get := func(contentType, subPath string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		svc.Serve(w, httptest.NewRequest("GET", "/", nil), "src-1", contentType, open, subPath)
+		return w
+	}
+
+	w := get("video/mp4", "hls/master.m3u8")
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), "720p/index.m3u8") {
+		t.Fatalf("master: %d %q", w.Code, w.Body.String())
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "application/vnd.apple.mpegurl" {
+		t.Fatalf("unexpected content type %q", ct)
+	}
+	if w = get("video/mp4", "hls/720p/seg00000.ts"); w.Body.String() != "segment-bytes" {
+		t.Fatalf("segment: %d %q", w.Code, w.Body.String())
+	}
+	if packager.runs.Load() != 1 {
+		t.Fatalf("expected 1 packaging run, got %d", packager.runs.Load())
+	}
+
+	if w = get("video/mp4", "hls/../../source"); w.Code != http.StatusNotFound {
+		t.Fatalf("expected path escape to fail, got %d", w.Code)
+	}
+	if w = get("image/png", "hls/master.m3u8"); w.Code != http.StatusUnsupportedMediaType {
+		t.Fatalf("expected unstreamable, got %d", w.Code)
+	}
+}
+
+func TestFFmpegArgs(t *testing.T) {
+	job := streaming.Job{
+		Input:  "in.mp4",
+		OutDir: "out",
+		Format: streaming.HLS,
+		Ladder: streaming.DefaultLadder[:2],
+	}
+	args := strings.Join(job.FFmpegArgs(), " ")
+	for _, want := range []string{"split=2[v0][v1]", "scale=-2:720", "-b:v:0 5000k", "v:0,a:0,name:1080p v:1,a:1,name:720p", "-master_pl_name master.m3u8"} {
+		if !strings.Contains(args, want) {
+			t.Errorf("missing %q in: %s", want, args)
+		}
+	}
+
+	job.Format = streaming.DASH
+	job.AudioOnly = true
+	args = strings.Join(job.FFmpegArgs(), " ")
+	if strings.Contains(args, "-filter_complex") || !strings.Contains(args, "-adaptation_sets id=0,streams=a") {
+		t.Errorf("unexpected audio-only DASH args: %s", args)
+	}
+}
@@ -5,6 +5,7 @@ import "github.com/art-media-platform/amp-sdk-go/stdlib/symbol"
 // CreateTable creates a new memory-based symbol.Table intended to handle extreme loading.
 //
 // Value allocations are pooled, so TableOpts.PoolSz of 16k means thousands of small value entries could be stored within a single allocation.
+// The table is sharded so that many concurrent sessions scale across cores, and resolving an already bound value or ID takes no lock.
 func (opts TableOpts) CreateTable() (symbol.Table, error) {
 	return createTable(opts)
 }
@@ -14,6 +15,7 @@ type TableOpts struct {
 	IssuerInitsAt   symbol.ID // The floor ID to start issuing from if initializing a new Issuer.
 	WorkingSizeHint int       // anticipated number of entries in working set
 	PoolSz          int32     // Value backing buffer allocation pool sz
+	Shards          int       // number of independently locked shards (rounded up to a power of 2); if <= 0, scaled to GOMAXPROCS
 }
 
 // DefaultOpts is a suggested set of options.
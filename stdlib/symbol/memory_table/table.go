@@ -2,7 +2,8 @@ package memory_table
 
 import (
 	"bytes"
-	"sync"
+	"math/bits"
+	"runtime"
 	"sync/atomic"
 
 	"github.com/art-media-platform/amp-sdk-go/stdlib/bufs"
@@ -15,12 +16,16 @@ func createTable(opts TableOpts) (symbol.Table, error) {
 	} else {
 		opts.Issuer.AddRef()
 	}
+	if opts.Shards <= 0 {
+		opts.Shards = min(max(8, 4*runtime.GOMAXPROCS(0)), 256)
+	}
+	shardBits := bits.Len(uint(opts.Shards - 1)) // round up to a power of 2
 
 	st := &symbolTable{
-		opts:          opts,
-		curBufPoolIdx: -1,
-		valueCache:    make(map[uint64]kvEntry, opts.WorkingSizeHint),
-		tokenCache:    make(map[symbol.ID]kvEntry, opts.WorkingSizeHint),
+		opts:      opts,
+		shardBits: uint(shardBits),
+		values:    make([]valueShard, 1<<shardBits),
+		tokens:    make([]readMostly[symbol.ID, []byte], 1<<shardBits),
 	}
 
 	st.refCount.Store(1)
@@ -46,123 +51,83 @@ func (st *symbolTable) close() error {
 	err := st.opts.Issuer.Close()
 	st.opts.Issuer = nil
 
-	st.valueCache = nil
-	st.tokenCache = nil
-	st.bufPools = nil
+	st.values = nil
+	st.tokens = nil
 	return err
 }
 
 type kvEntry struct {
-	symID   symbol.ID
-	poolIdx int32
-	poolOfs int32
-	len     int32
-}
-
-func (st *symbolTable) equals(kv *kvEntry, buf []byte) bool {
-	sz := int32(len(buf))
-	if sz != kv.len {
-		return false
-	}
-	return bytes.Equal(st.bufPools[kv.poolIdx][kv.poolOfs:kv.poolOfs+sz], buf)
-}
-
-func (st *symbolTable) bufForEntry(kv kvEntry) []byte {
-	if kv.symID == 0 {
-		return nil
-	}
-	return st.bufPools[kv.poolIdx][kv.poolOfs : kv.poolOfs+kv.len]
+	symID symbol.ID
+	value []byte // backed by its shard's pool
 }
 
 // symbolTable implements symbol.Table
+//
+// Values and IDs are each sharded (by value hash and by ID) so that concurrent sessions resolving symbols contend only within a shard,
+// and a resolution of an already bound value or ID takes no lock at all -- see readMostly.
 type symbolTable struct {
-	opts          TableOpts
-	refCount      atomic.Int32
-	valueCacheMu  sync.RWMutex          // Protects valueCache
-	valueCache    map[uint64]kvEntry    // Maps a entry value hash to a kvEntry
-	tokenCacheMu  sync.RWMutex          // Protects tokenCache
-	tokenCache    map[symbol.ID]kvEntry // Maps an ID ("token") to an entry
-	curBufPool    []byte
-	curBufPoolSz  int32
-	curBufPoolIdx int32
-	bufPools      [][]byte
+	opts      TableOpts
+	refCount  atomic.Int32
+	shardBits uint
+	values    []valueShard                    // value hash => entries
+	tokens    []readMostly[symbol.ID, []byte] // ID ("token") => value
 }
 
-func (st *symbolTable) getIDFromCache(buf []byte) symbol.ID {
-	hash := bufs.HashBuf(buf)
+func (st *symbolTable) valueShard(hash uint64) *valueShard {
+	return &st.values[hash>>(64-st.shardBits)&(uint64(len(st.values))-1)]
+}
 
-	st.valueCacheMu.RLock()
-	defer st.valueCacheMu.RUnlock()
+func (st *symbolTable) tokenShard(symID symbol.ID) *readMostly[symbol.ID, []byte] {
+	return &st.tokens[uint32(symID)&uint32(len(st.tokens)-1)]
+}
 
-	kv, found := st.valueCache[hash]
-	for found {
-		if st.equals(&kv, buf) {
-			return kv.symID
+func lookup(chain []kvEntry, buf []byte) symbol.ID {
+	for i := range chain {
+		if bytes.Equal(chain[i].value, buf) {
+			return chain[i].symID
 		}
-		hash++
-		kv, found = st.valueCache[hash]
 	}
-
 	return 0
 }
 
-func (st *symbolTable) allocAndBindToID(buf []byte, bindID symbol.ID) kvEntry {
+func (st *symbolTable) getIDFromCache(buf []byte) symbol.ID {
 	hash := bufs.HashBuf(buf)
-
-	st.valueCacheMu.Lock()
-	defer st.valueCacheMu.Unlock()
-
-	kv, found := st.valueCache[hash]
-	for found {
-		if st.equals(&kv, buf) {
-			break
+	chain, _ := st.valueShard(hash).load(hash)
+	return lookup(chain, buf)
+}
+
+// bindLocked binds the given value to the given ID (and the ID to the value), where shard.mu is held.
+func (st *symbolTable) bindLocked(shard *valueShard, hash uint64, chain []kvEntry, buf []byte, bindID symbol.ID) {
+	var backed []byte
+	next := make([]kvEntry, 0, len(chain)+1)
+	for _, kv := range chain {
+		if bytes.Equal(kv.value, buf) {
+			if kv.symID == bindID {
+				return // no-op if already present
+			}
+			backed = kv.value
+		} else {
+			next = append(next, kv)
 		}
-		hash++
-		kv, found = st.valueCache[hash]
 	}
-
-	// No-op if already present
-	if found && kv.symID == bindID {
-		return kv
-	}
-
-	// At this point we know [hash] will be the destination element
-	// Add a copy of the buf in our backing buf (in the heap).
-	// If we run out of space in our pool, we start a new pool
-	kv.symID = bindID
-	{
-		kv.len = int32(len(buf))
-		if int(st.curBufPoolSz+kv.len) > cap(st.curBufPool) {
-			allocSz := max(st.opts.PoolSz, kv.len)
-			st.curBufPool = make([]byte, allocSz)
-			st.curBufPoolSz = 0
-			st.curBufPoolIdx++
-			st.bufPools = append(st.bufPools, st.curBufPool)
-		}
-		kv.poolIdx = st.curBufPoolIdx
-		kv.poolOfs = st.curBufPoolSz
-		copy(st.curBufPool[kv.poolOfs:kv.poolOfs+kv.len], buf)
-		st.curBufPoolSz += kv.len
+	if backed == nil {
+		backed = shard.alloc(buf, st.opts.PoolSz)
 	}
+	shard.storeLocked(hash, append(next, kvEntry{symID: bindID, value: backed}))
 
-	// Place the now-backed copy at the open hash spot and return the alloced value
-	st.valueCache[hash] = kv
-
-	st.tokenCacheMu.Lock()
-	st.tokenCache[kv.symID] = kv
-	st.tokenCacheMu.Unlock()
-
-	return kv
+	tokens := st.tokenShard(bindID)
+	tokens.mu.Lock()
+	tokens.storeLocked(bindID, backed)
+	tokens.mu.Unlock()
 }
 
 func (st *symbolTable) GetSymbolID(val []byte, autoIssue bool) (symbol.ID, bool) {
 	symID := st.getIDFromCache(val)
-	if symID != 0 {
+	if symID != 0 || !autoIssue {
 		return symID, false
 	}
 
-	symID, _ = st.getsetValueIDPair(val, 0, autoIssue)
-	return symID, symID != 0
+	return st.getsetValueIDPair(val, 0, autoIssue)
 }
 
 func (st *symbolTable) SetSymbolID(val []byte, symID symbol.ID) (symbol.ID, bool) {
@@ -170,37 +135,45 @@ func (st *symbolTable) SetSymbolID(val []byte, symID symbol.ID) (symbol.ID, bool
 	return st.getsetValueIDPair(val, symID, symID == 0)
 }
 
-// getsetValueIDPair loads and returns the ID for the given value, and/or writes the ID and value assignment to the db,
-// also updating the cache in the process.
+// getsetValueIDPair loads and returns the ID for the given value, and/or writes the ID and value assignment,
+// where the value's shard is locked throughout so that concurrent callers issue at most one ID per value.
 //
 //	if symID == 0:
 //	  if the given value has an existing value-ID association:
-//	      the existing ID is cached and returned (autoIssue is ignored).
+//	      the existing ID is returned (autoIssue is ignored).
 //	  if the given value does NOT have an existing value-ID association:
 //	      if autoIssue == false, the call has no effect and 0 is returned.
 //	      if autoIssue == true, a new ID is issued and new value-to-ID and ID-to-value assignments are written,
 //
 //	if symID != 0:
-//	    if autoIssue == false, a new value-to-ID assignment is (over)written and any existing ID-to-value assignment remains.
-//	    if autoIssue == true, both value-to-ID and ID-to-value assignments are (over)written.
-func (st *symbolTable) getsetValueIDPair(val []byte, symID symbol.ID, autoIssue bool) (symbol.ID, bool){
+//	    value-to-ID and ID-to-value assignments are (over)written.
+func (st *symbolTable) getsetValueIDPair(val []byte, symID symbol.ID, autoIssue bool) (symbol.ID, bool) {
 
 	// The empty string is always mapped to ID 0
 	if len(val) == 0 {
 		return 0, false
 	}
 
-	issued := false
-	if symID == 0 && autoIssue {
-		issued = true
-		symID, _ = st.opts.Issuer.IssueNextID()
-	}
+	hash := bufs.HashBuf(val)
+	shard := st.valueShard(hash)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
 
-	// Update the cache
-	if symID != 0 {
-		st.allocAndBindToID(val, symID)
+	chain, _ := shard.loadLocked(hash)
+	if symID == 0 {
+		if existing := lookup(chain, val); existing != 0 || !autoIssue {
+			return existing, false
+		}
+		var err error
+		if symID, err = st.opts.Issuer.IssueNextID(); err != nil {
+			return 0, false
+		}
+		st.bindLocked(shard, hash, chain, val, symID)
+		return symID, true
 	}
-	return symID, issued
+
+	st.bindLocked(shard, hash, chain, val, symID)
+	return symID, false
 }
 
 func (st *symbolTable) GetSymbol(symID symbol.ID, io []byte) []byte {
@@ -208,22 +181,10 @@ func (st *symbolTable) GetSymbol(symID symbol.ID, io []byte) []byte {
 		return nil
 	}
 
-	st.tokenCacheMu.RLock()
-	kv := st.tokenCache[symID]
-	st.tokenCacheMu.RUnlock()
-
-	// At this point, if symID wasn't found, kv will be zero and causing nil to be returned
-	symBuf := st.bufForEntry(kv)
+	// At this point, if symID wasn't found, nil is returned
+	symBuf, _ := st.tokenShard(symID).load(symID)
 	if symBuf == nil {
 		return nil
 	}
 	return append(io, symBuf...)
 }
-
-func max(a, b int32) int32 {
-	if a > b {
-		return a
-	} else {
-		return b
-	}
-}
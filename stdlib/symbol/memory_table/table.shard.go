@@ -0,0 +1,107 @@
+package memory_table
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// readMostly is a map for keys written once and read many times (like sync.Map, but typed), where reading a settled key takes no lock.
+//
+// Reads are served from an immutable view swapped in atomically.  Writes go to a dirty map (a superset of the view) under mu,
+// which replaces the view once enough reads have missed it to pay for the copy.
+type readMostly[K comparable, V any] struct {
+	read   atomic.Pointer[readView[K, V]]
+	mu     sync.Mutex
+	dirty  map[K]V // if non-nil, a superset of read's map
+	misses int     // reads that fell through to dirty since it was last promoted
+}
+
+type readView[K comparable, V any] struct {
+	m       map[K]V
+	amended bool // set if dirty has keys not in m
+}
+
+// load returns the value for the given key, taking mu only if the key is not (yet) in the read view.
+func (rm *readMostly[K, V]) load(key K) (V, bool) {
+	if view := rm.read.Load(); view != nil {
+		if val, ok := view.m[key]; ok || !view.amended {
+			return val, ok
+		}
+	}
+	rm.mu.Lock()
+	val, ok := rm.loadLocked(key)
+	rm.mu.Unlock()
+	return val, ok
+}
+
+func (rm *readMostly[K, V]) loadLocked(key K) (V, bool) {
+	view := rm.read.Load()
+	if view != nil {
+		if val, ok := view.m[key]; ok || !view.amended {
+			return val, ok
+		}
+	}
+	val, ok := rm.dirty[key]
+	rm.misses++
+	if rm.misses >= len(rm.dirty) {
+		rm.promoteLocked()
+	}
+	return val, ok
+}
+
+// storeLocked sets the value for the given key, where mu is held.
+func (rm *readMostly[K, V]) storeLocked(key K, val V) {
+	view := rm.read.Load()
+	if rm.dirty == nil {
+		size := 1
+		if view != nil {
+			size += len(view.m)
+		}
+		rm.dirty = make(map[K]V, size)
+		if view != nil {
+			for k, v := range view.m {
+				rm.dirty[k] = v
+			}
+		}
+	}
+	rm.dirty[key] = val
+
+	if view != nil {
+		if _, overwrite := view.m[key]; overwrite {
+			rm.promoteLocked() // the view is immutable, so an overwritten key is visible only once promoted
+			return
+		}
+	}
+	if view == nil || !view.amended {
+		next := &readView[K, V]{amended: true}
+		if view != nil {
+			next.m = view.m
+		}
+		rm.read.Store(next)
+	}
+}
+
+// promoteLocked replaces the read view with the dirty map.
+func (rm *readMostly[K, V]) promoteLocked() {
+	rm.read.Store(&readView[K, V]{m: rm.dirty})
+	rm.dirty = nil
+	rm.misses = 0
+}
+
+// valueShard maps value hashes to entries for the values falling in its shard, and backs those values.
+type valueShard struct {
+	readMostly[uint64, []kvEntry]        // chains of entries with the same hash; a chain is copied rather than modified
+	pool                          []byte // unused remainder of the current backing buffer; guarded by mu
+}
+
+// alloc returns a copy of the given value backed by the shard's pool, where mu is held.
+func (shard *valueShard) alloc(val []byte, poolSz int32) []byte {
+	sz := len(val)
+	if sz > len(shard.pool) {
+		shard.pool = make([]byte, max(poolSz, int32(sz)))
+	}
+	backed := shard.pool[:sz:sz]
+	copy(backed, val)
+	shard.pool = shard.pool[sz:]
+	return backed
+}
@@ -1,6 +1,7 @@
 package memory_table_test
 
 import (
+	"strconv"
 	"testing"
 
 	"github.com/art-media-platform/amp-sdk-go/stdlib/symbol"
@@ -13,7 +14,7 @@ var gMemTable symbol.Table
 func Test_memory_table(t *testing.T) {
 	open_table := func() (symbol.Table, error) {
 		if gMemTable == nil {
-			opts :=  memory_table.DefaultOpts()
+			opts := memory_table.DefaultOpts()
 			gMemTable, _ = opts.CreateTable()
 			gMemTable.AddRef() // add ref to get past first close in DoTableTest
 		}
@@ -22,3 +23,24 @@ func Test_memory_table(t *testing.T) {
 
 	tests.DoTableTest(t, 0, open_table)
 }
+
+func Benchmark_memory_table_parallel(b *testing.B) {
+	opts := memory_table.DefaultOpts()
+	table, _ := opts.CreateTable()
+	defer table.Close()
+
+	vals := make([][]byte, 4096)
+	for i := range vals {
+		vals[i] = []byte(strconv.Itoa(i))
+		table.GetSymbolID(vals[i], true)
+	}
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		var buf [32]byte
+		for i := 0; pb.Next(); i++ {
+			symID, _ := table.GetSymbolID(vals[i&4095], false)
+			table.GetSymbol(symID, buf[:0])
+		}
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"crypto/sha1"
 	"encoding/binary"
 	"encoding/hex"
+	"errors"
 	"regexp"
 	"strings"
 	"time"
@@ -340,6 +341,26 @@ func (tag ID) Base32() string {
 	return "0"
 }
 
+// FromBase32 parses a tag.ID in the canonic Base32 form produced by ID.Base32()
+func FromBase32(str string) (ID, error) {
+	const encodedLen = 40 // (25 * 8) / 5
+	if len(str) == 0 || len(str) > encodedLen {
+		return ID{}, ErrBadBase32
+	}
+	var padded [encodedLen]byte
+	pad := encodedLen - len(str)
+	for i := 0; i < pad; i++ {
+		padded[i] = '0'
+	}
+	copy(padded[pad:], str)
+
+	var buf [25]byte
+	if _, err := bufs.Base32Encoding.Decode(buf[:], padded[:]); err != nil || buf[0] != 0 {
+		return ID{}, ErrBadBase32
+	}
+	return FromBytes(buf[1:])
+}
+
 func (tag ID) Base16() string {
 	buf := make([]byte, 0, 48)
 	tagBytes := tag.AppendTo(buf)
@@ -404,6 +425,8 @@ var gTagSeed = uint64(0x3773000000003773)
 
 var (
 	Nil = ID{}
+
+	ErrBadBase32 = errors.New("bad tag.ID base32 encoding")
 )
 
 func FromBytes(in []byte) (tag ID, err error) {
@@ -0,0 +1,37 @@
+package tag
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// MaxParsedSpecs bounds how many distinct spec strings ParseSpec() retains, since spec strings may originate from clients.
+const MaxParsedSpecs = 1 << 14
+
+var (
+	gParsedSpecs    atomic.Pointer[sync.Map] // spec string => Spec
+	gParsedSpecsLen atomic.Int32
+)
+
+func init() {
+	gParsedSpecs.Store(&sync.Map{})
+}
+
+// ParseSpec returns Spec{}.With(expr), caching the result process-wide so that a recurring spec string (e.g. an attr selector sent with each pin request) is only parsed once.
+func ParseSpec(expr string) Spec {
+	parsed := gParsedSpecs.Load()
+	if spec, ok := parsed.Load(expr); ok {
+		return spec.(Spec)
+	}
+	spec := Spec{}.With(expr)
+
+	// When full, start over rather than track recency -- the working set of a host is typically far smaller.
+	if gParsedSpecsLen.Add(1) > MaxParsedSpecs {
+		parsed = &sync.Map{}
+		gParsedSpecs.Store(parsed)
+		gParsedSpecsLen.Store(1)
+	}
+	parsed.Store(strings.Clone(expr), spec)
+	return spec
+}
@@ -45,6 +45,11 @@ func TestTag(t *testing.T) {
 	if tid.Base16Suffix() != "abcdef0" {
 		t.Errorf("tag.ID.Base16Suffix() failed")
 	}
+	for _, id := range []tag.ID{tid, {}, tag.Now()} {
+		if parsed, err := tag.FromBase32(id.Base32()); err != nil || parsed != id {
+			t.Errorf("tag.FromBase32() failed: %v", err)
+		}
+	}
 
 	//fmt.Print(tid.FormAsciiBadge())
 
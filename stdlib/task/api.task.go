@@ -26,13 +26,57 @@ func Go(parent Context, label string, fn func(ctx Context)) (Context, error) {
 	})
 }
 
+// WithTimeout starts a child Context that is automatically closed once the given timeout elapses -- see Info.Timeout.
+// Like a context.CancelFunc, the caller should Close() the returned Context once its work is complete.
+func WithTimeout(parent Context, label string, timeout time.Duration) (Context, error) {
+	return parent.StartChild(&Task{
+		Info: Info{
+			Label:   label,
+			Timeout: timeout,
+		},
+	})
+}
+
+// WithDeadline starts a child Context that is automatically closed once the given deadline is reached -- see Info.Deadline.
+// Like a context.CancelFunc, the caller should Close() the returned Context once its work is complete.
+func WithDeadline(parent Context, label string, deadline time.Time) (Context, error) {
+	return parent.StartChild(&Task{
+		Info: Info{
+			Label:    label,
+			Deadline: deadline,
+		},
+	})
+}
+
 type Info struct {
-	TID       int64    // globally unique atomically incremented instance ID -- assigned OnStart()
-	TagID     tag.ID   // optional user-defined tag.ID
-	Other     any      // optional user-defined value
-	Headers   []string // cookies, auth, or task references
-	Label     string   // logging and debugging label
-	DebugMode bool     // when set, a context logs more verbosely and can perform (or log) expensive diagnostics
+	TID       int64      // globally unique atomically incremented instance ID -- assigned OnStart()
+	TagID     tag.ID     // optional user-defined tag.ID
+	Other     any        // optional user-defined value
+	Headers   []string   // cookies, auth, or task references
+	Label     string     // logging and debugging label
+	Name      string     // if set, registers this Context under this name with its parent, unique among its running siblings -- see Find()
+	StartedAt time.Time  // when the Context was started -- assigned in StartChild()
+	LogFields log.Fields // fields included in every entry logged by this Context and its children (e.g. a session ID or app UID)
+	DebugMode bool       // when set, a context logs more verbosely and can perform (or log) expensive diagnostics
+
+	// If set, the Context is automatically closed once this time is reached, after which Err() returns context.DeadlineExceeded.
+	// A child started with no deadline (or a later one) inherits its parent's deadline.
+	Deadline time.Time
+
+	// If > 0, Deadline is set to this duration from when the Context starts (or left as is if earlier).
+	Timeout time.Duration
+
+	// If > 0, at most this many children of this Context run at once, so a burst of requests can't spawn an unbounded number of goroutines.
+	// Once the limit is reached, StartChild() blocks until a child fully closes, admitting waiting children by Priority.
+	// A waiting StartChild() returns ErrNotStarted if this Context closes or context.DeadlineExceeded if the child's deadline passes.
+	MaxChildren int
+
+	// If set, this Context is a "weak" child: it is closed when its parent closes but its parent does not wait for it to complete (nor is kept from idle-closing by it).
+	// This suits best-effort work (e.g. an analytics flush) that must never delay the shutdown of its parent (e.g. a session).
+	Weak bool
+
+	// Orders this Context among children waiting to start under its parent's MaxChildren limit.
+	Priority Priority
 
 	// If > 0, Context.CloseWhenIdle() will automatically called when the last remaining child is closed or when OnRun() completes, whichever occurs later.
 	//
@@ -44,9 +88,20 @@ type Info struct {
 type Task struct {
 	Info Info
 
+	// Optional: the Clock driving this Context's timers and those of its children, rather than its parent's (e.g. a VirtualClock in tests).
+	Clock Clock
+
+	// Optional: the Tracer receiving spans for this Context and its children, rather than its parent's -- if neither is set, the Context is not traced.
+	Tracer Tracer
+
+	// Optional: the Logger this Context's Logger derives its Sink and fields from, rather than from its parent's (e.g. via log.NewSinkLogger).
+	Logger log.Logger
+
+	signals any // chan S given to StartNamed()
+
 	OnStart        func(ctx Context) error // Blocking fn called in StartChild(). If err, ctx.Close() is called and Go() returns the err and OnRun is never called.
 	OnRun          func(ctx Context)       // Async work body. If non-nil, ctx.Close() will be automatically called after OnRun() completes
-	OnClosing      func()                  // Called immediately after Close() is first called while self & children are still closing
+	OnClosing      func()                  // Called immediately after Close() is first called while self & children are still closing -- see Context.CloseReason()
 	OnChildClosing func(child Context)     // Called immediately after the child's OnClosing() is called
 	OnClosed       func()                  // Called after Close() and all children have completed Close() (but immediately before Done() is released)
 }
@@ -66,6 +121,10 @@ type Context interface {
 	// Returns a snapshot of this Context's Info.
 	Info() Info
 
+	// Returns the Clock driving this Context's timers -- see Task.Clock.
+	// Code measuring time within a task tree (e.g. TTLs or backoff) should use this rather than package time so that it follows a VirtualClock in tests.
+	Clock() Clock
+
 	// Creates a new child Context with for given Task.
 	// If OnStart() returns an error error is encountered, then child.Close() is immediately called and the error is returned.
 	StartChild(task *Task) (Context, error)
@@ -92,6 +151,14 @@ type Context interface {
 	// After all children are done closing, OnClosing(), then OnClosed() are executed.
 	Close() error
 
+	// Like Close() but records why this Context is closing (e.g. "transport error" vs "operator kill"), which is logged and propagated to its children.
+	// Only the first call to Close() or CloseWithReason() has effect.
+	CloseWithReason(reason error) error
+
+	// Returns the reason given to CloseWithReason() on this Context or the nearest closing ancestor (e.g. context.DeadlineExceeded once a deadline is reached).
+	// Returns nil while this Context is running or if it was closed without a reason -- e.g. OnClosing hooks can call this to learn why they are closing.
+	CloseReason() error
+
 	// Inserts a pending Close() on this Context once it is idle after the given delay.
 	// Subsequent calls will update the delay but the previously pending delay must run out first.
 	// If at the end of the period Task.OnRun() is complete, there are no children, PreventIdleClose() is not in effect, then Close() is called.
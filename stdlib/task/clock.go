@@ -0,0 +1,172 @@
+package task
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Clock is the source of time for a Context's timers (deadlines, idle close, restart backoff), inherited by its children -- see Task.Clock.
+// SystemClock is used by default, while a VirtualClock makes tests covering timeouts and TTLs deterministic.
+type Clock interface {
+	Now() time.Time
+	NewTimer(d time.Duration) Timer
+}
+
+// Timer is a time.Timer issued by a Clock.
+type Timer interface {
+	C() <-chan time.Time
+	Stop() bool
+	Reset(d time.Duration) bool
+}
+
+// SystemClock is the Clock backed by package time.
+var SystemClock Clock = systemClock{}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) NewTimer(d time.Duration) Timer {
+	return systemTimer{time.NewTimer(d)}
+}
+
+type systemTimer struct {
+	*time.Timer
+}
+
+func (t systemTimer) C() <-chan time.Time {
+	return t.Timer.C
+}
+
+// VirtualClock is a Clock whose time only moves when Advance() is called, firing due timers in order of expiry.
+//
+// A test starts its root Context with Task.Clock set to a VirtualClock, so all timers in the tree follow it.
+// Since timers are created on other goroutines, a test calls WaitForTimers() before Advance() to ensure the timers it expects are pending.
+type VirtualClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	timers  []*virtualTimer // pending timers
+	changed chan struct{}   // closed and replaced whenever a timer is added
+}
+
+// NewVirtualClock returns a VirtualClock starting at the given time.
+func NewVirtualClock(start time.Time) *VirtualClock {
+	return &VirtualClock{
+		now:     start,
+		changed: make(chan struct{}),
+	}
+}
+
+func (vc *VirtualClock) Now() time.Time {
+	vc.mu.Lock()
+	defer vc.mu.Unlock()
+	return vc.now
+}
+
+func (vc *VirtualClock) NewTimer(d time.Duration) Timer {
+	t := &virtualTimer{
+		clock: vc,
+		ch:    make(chan time.Time, 1),
+	}
+	t.Reset(d)
+	return t
+}
+
+// Advance moves the clock forward by d, firing each timer that comes due (in order of expiry).
+func (vc *VirtualClock) Advance(d time.Duration) {
+	vc.mu.Lock()
+	end := vc.now.Add(d)
+	for {
+		sort.SliceStable(vc.timers, func(i, j int) bool { return vc.timers[i].when.Before(vc.timers[j].when) })
+		if len(vc.timers) == 0 || vc.timers[0].when.After(end) {
+			break
+		}
+		t := vc.timers[0]
+		vc.timers = vc.timers[1:]
+		if t.when.After(vc.now) {
+			vc.now = t.when
+		}
+		select {
+		case t.ch <- vc.now:
+		default:
+		}
+	}
+	vc.now = end
+	vc.mu.Unlock()
+}
+
+// Pending returns the number of timers that have not yet fired or been stopped.
+func (vc *VirtualClock) Pending() int {
+	vc.mu.Lock()
+	defer vc.mu.Unlock()
+	return len(vc.timers)
+}
+
+// WaitForTimers blocks until at least n timers are pending or the given timeout (in real time) elapses, returning false in the latter case.
+func (vc *VirtualClock) WaitForTimers(n int, timeout time.Duration) bool {
+	expired := time.After(timeout)
+	for {
+		vc.mu.Lock()
+		pending, changed := len(vc.timers), vc.changed
+		vc.mu.Unlock()
+		if pending >= n {
+			return true
+		}
+		select {
+		case <-changed:
+		case <-expired:
+			return false
+		}
+	}
+}
+
+type virtualTimer struct {
+	clock *VirtualClock
+	ch    chan time.Time
+	when  time.Time
+}
+
+func (t *virtualTimer) C() <-chan time.Time {
+	return t.ch
+}
+
+func (t *virtualTimer) Stop() bool {
+	vc := t.clock
+	vc.mu.Lock()
+	defer vc.mu.Unlock()
+	return vc.remove(t)
+}
+
+func (t *virtualTimer) Reset(d time.Duration) bool {
+	vc := t.clock
+	vc.mu.Lock()
+	defer vc.mu.Unlock()
+
+	wasPending := vc.remove(t)
+	t.when = vc.now.Add(d)
+	if d <= 0 {
+		select {
+		case t.ch <- vc.now:
+		default:
+		}
+		return wasPending
+	}
+	vc.timers = append(vc.timers, t)
+	close(vc.changed)
+	vc.changed = make(chan struct{})
+	return wasPending
+}
+
+// remove removes the given timer from the pending timers, returning true if it was pending -- caller holds vc.mu
+func (vc *VirtualClock) remove(t *virtualTimer) bool {
+	for i, ti := range vc.timers {
+		if ti == t {
+			vc.timers = append(vc.timers[:i], vc.timers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
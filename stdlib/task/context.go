@@ -0,0 +1,72 @@
+package task
+
+import (
+	"context"
+	"time"
+)
+
+// AsStdContext returns a context.Context that is done as soon as the given Context begins closing.
+//
+// A Context is itself a context.Context, but its Done() is only released once it and all its children have completed,
+// so passing it to a blocking call (e.g. an HTTP request or database query) would hold up the very close meant to cancel that call.
+// The returned context.Context is canceled upon Close() and so is the one to pass to such calls.
+func AsStdContext(t Context) context.Context {
+	return stdContext{t}
+}
+
+// stdContext implements context.Context, canceled when its Context begins closing.
+type stdContext struct {
+	t Context
+}
+
+func (c stdContext) Deadline() (time.Time, bool) {
+	return c.t.Deadline()
+}
+
+func (c stdContext) Done() <-chan struct{} {
+	return c.t.Closing()
+}
+
+func (c stdContext) Err() error {
+	select {
+	case <-c.t.Closing():
+	default:
+		return nil
+	}
+	if reason := c.t.CloseReason(); reason != nil {
+		return reason
+	}
+	if deadline, ok := c.t.Deadline(); ok && !c.t.Clock().Now().Before(deadline) {
+		return context.DeadlineExceeded
+	}
+	return context.Canceled
+}
+
+func (c stdContext) Value(key any) any {
+	return c.t.Value(key)
+}
+
+// FromStdContext starts a new Context with no parent that is closed when the given context.Context is done, inheriting its deadline.
+// Err() of the returned Context then reports stdCtx.Err() (e.g. context.DeadlineExceeded).
+//
+// This allows a task tree to be started from code driven by a context.Context (e.g. an HTTP handler using its request's context).
+func FromStdContext(stdCtx context.Context, label string) (Context, error) {
+	info := Info{
+		Label: label,
+	}
+	info.Deadline, _ = stdCtx.Deadline()
+
+	return Start(&Task{
+		Info: info,
+		OnStart: func(t Context) error {
+			go func() {
+				select {
+				case <-stdCtx.Done():
+					t.CloseWithReason(stdCtx.Err())
+				case <-t.Closing():
+				}
+			}()
+			return nil
+		},
+	})
+}
@@ -0,0 +1,148 @@
+package task
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Metrics is a snapshot of the task subsystem's counters -- see ReadMetrics().
+// Counters are cumulative since process start, so start and close rates are the change in Started and Closed over an interval.
+type Metrics struct {
+	Started int64                   // Contexts started
+	Closed  int64                   // Contexts fully closed
+	Live    int64                   // Contexts started but not yet fully closed
+	ByLabel map[string]LabelMetrics // keyed by label class -- see LabelClass()
+}
+
+// LabelMetrics are the counters of Contexts sharing a label class.
+type LabelMetrics struct {
+	Started       int64
+	Closed        int64
+	Live          int64
+	TotalLifetime time.Duration // sum of the lifetimes of closed Contexts
+	MaxLifetime   time.Duration // longest lifetime of a closed Context
+}
+
+// MeanLifetime returns the mean lifetime of closed Contexts, or 0 if none have closed.
+func (lm LabelMetrics) MeanLifetime() time.Duration {
+	if lm.Closed == 0 {
+		return 0
+	}
+	return lm.TotalLifetime / time.Duration(lm.Closed)
+}
+
+type labelCounters struct {
+	started       atomic.Int64
+	closed        atomic.Int64
+	totalLifetime atomic.Int64 // time.Duration
+	maxLifetime   atomic.Int64 // time.Duration
+}
+
+var gLabelCounters sync.Map // label class => *labelCounters
+
+// countersFor returns the counters of the given label's class.
+func countersFor(label string) *labelCounters {
+	class := LabelClass(label)
+	if lc, ok := gLabelCounters.Load(class); ok {
+		return lc.(*labelCounters)
+	}
+	lc, _ := gLabelCounters.LoadOrStore(class, &labelCounters{})
+	return lc.(*labelCounters)
+}
+
+func (lc *labelCounters) onClosed(lifetime time.Duration) {
+	lc.closed.Add(1)
+	lc.totalLifetime.Add(int64(lifetime))
+	for max := lc.maxLifetime.Load(); int64(lifetime) > max; max = lc.maxLifetime.Load() {
+		if lc.maxLifetime.CompareAndSwap(max, int64(lifetime)) {
+			break
+		}
+	}
+}
+
+// LabelClass returns the portion of a label that identifies its kind of task, so that metrics are not split by per-instance IDs.
+// This is the label up to its first ':' or '#' (e.g. "pin: 3XK9W" and "watcher #2" are classed as "pin" and "watcher").
+func LabelClass(label string) string {
+	if i := strings.IndexAny(label, ":#"); i >= 0 {
+		label = label[:i]
+	}
+	return strings.TrimSpace(label)
+}
+
+// ReadMetrics returns a snapshot of the task subsystem's counters.
+func ReadMetrics() Metrics {
+	m := Metrics{
+		ByLabel: make(map[string]LabelMetrics),
+	}
+	gLabelCounters.Range(func(key, val any) bool {
+		lc := val.(*labelCounters)
+		lm := LabelMetrics{
+			Closed:        lc.closed.Load(),
+			Started:       lc.started.Load(),
+			TotalLifetime: time.Duration(lc.totalLifetime.Load()),
+			MaxLifetime:   time.Duration(lc.maxLifetime.Load()),
+		}
+		lm.Live = lm.Started - lm.Closed
+		m.ByLabel[key.(string)] = lm
+		m.Started += lm.Started
+		m.Closed += lm.Closed
+		return true
+	})
+	m.Live = m.Started - m.Closed
+	return m
+}
+
+// LeakOpts configures FindLeaks().
+type LeakOpts struct {
+	Factor      float64       // a task is suspect if alive this many times longer than the median of its siblings; if <= 1, 10
+	MinAge      time.Duration // tasks younger than this are never suspect; if <= 0, 1 minute
+	MinSiblings int           // min number of siblings (including the task) needed for a meaningful median; if <= 1, 3
+}
+
+// FindLeaks returns the tasks in the given tree that have been alive far longer than their siblings, where siblings are the children of the same parent sharing a label class.
+// For example, a pin open for hours while the other pins of its session last seconds likely leaked (e.g. a Close() was missed).
+func FindLeaks(root Context, opts LeakOpts) []*TaskState {
+	if opts.Factor <= 1 {
+		opts.Factor = 10
+	}
+	if opts.MinAge <= 0 {
+		opts.MinAge = time.Minute
+	}
+	if opts.MinSiblings <= 1 {
+		opts.MinSiblings = 3
+	}
+
+	var leaks []*TaskState
+	var visit func(ts *TaskState)
+	visit = func(ts *TaskState) {
+		siblings := make(map[string][]*TaskState)
+		for _, child := range ts.Children {
+			class := LabelClass(child.Label)
+			siblings[class] = append(siblings[class], child)
+			visit(child)
+		}
+		for _, group := range siblings {
+			if len(group) < opts.MinSiblings {
+				continue
+			}
+			ages := make([]time.Duration, len(group))
+			for i, ti := range group {
+				ages[i] = ti.Age
+			}
+			sort.Slice(ages, func(i, j int) bool { return ages[i] < ages[j] })
+			median := ages[len(ages)/2]
+			for _, ti := range group {
+				if ti.Age >= opts.MinAge && float64(ti.Age) > opts.Factor*float64(median) {
+					leaks = append(leaks, ti)
+				}
+			}
+		}
+	}
+	visit(Inspect(root))
+
+	sort.Slice(leaks, func(i, j int) bool { return leaks[i].Age > leaks[j].Age })
+	return leaks
+}
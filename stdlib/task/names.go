@@ -0,0 +1,127 @@
+package task
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+)
+
+var (
+	ErrNameTaken = errors.New("task name already taken")
+	ErrNotFound  = errors.New("task not found")
+)
+
+// Find returns the running Context registered under the given name (see Info.Name) among the children of from, or else among the children of its nearest ancestor having one.
+// This allows a task to reach a sibling (e.g. an app's "indexer") without resorting to package-level singletons.
+// Returns nil if no such Context is found.
+func Find(from Context, name string) Context {
+	for p := asCtx(from); p != nil; p = p.parent {
+		if found := p.named(name); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// named returns the running child registered under the given name, or nil if not found.
+func (p *ctx) named(name string) *ctx {
+	p.subsMu.Lock()
+	defer p.subsMu.Unlock()
+
+	child := p.names[name]
+	if child == nil || atomic.LoadInt32(&child.state) != Running {
+		return nil
+	}
+	return child
+}
+
+// asCtx returns the ctx implementing the given Context, or nil if not implemented by this package.
+func asCtx(t Context) *ctx {
+	switch t := t.(type) {
+	case *ctx:
+		return t
+	case *Pool:
+		return asCtx(t.Context)
+	}
+	return nil
+}
+
+// Handle is a typed handle to a named Context, used to send it signals of type S.
+type Handle[S any] struct {
+	Context
+	signals chan S
+}
+
+// StartNamed starts a child Context registered under the given name (see Info.Name) that receives signals of type S, buffering up to the given number.
+// The child receives its signals via Signals().
+func StartNamed[S any](parent Context, name string, buffer int, task *Task) (*Handle[S], error) {
+	signals := make(chan S, buffer)
+	task.Info.Name = name
+	task.signals = signals
+
+	child, err := parent.StartChild(task)
+	if err != nil {
+		return nil, err
+	}
+	return &Handle[S]{
+		Context: child,
+		signals: signals,
+	}, nil
+}
+
+// Lookup is like Find() but returns a Handle to the found Context, which must have been started by StartNamed() with the same signal type.
+func Lookup[S any](from Context, name string) (*Handle[S], error) {
+	found := Find(from, name)
+	if found == nil {
+		return nil, fmt.Errorf("%w: %q", ErrNotFound, name)
+	}
+	signals, ok := asCtx(found).task.signals.(chan S)
+	if !ok {
+		var sig S
+		return nil, fmt.Errorf("task %q does not accept signals of type %T", name, sig)
+	}
+	return &Handle[S]{
+		Context: found,
+		signals: signals,
+	}, nil
+}
+
+// Signals returns the channel of signals sent to the given Context, or nil if it was not started by StartNamed() with signal type S.
+func Signals[S any](t Context) <-chan S {
+	if p := asCtx(t); p != nil {
+		signals, _ := p.task.signals.(chan S)
+		return signals
+	}
+	return nil
+}
+
+// Signal sends the given signal, blocking while the buffer is full.
+// Returns ErrClosed if the receiving Context is closing.
+func (h *Handle[S]) Signal(sig S) error {
+	select {
+	case <-h.Closing():
+		return ErrClosed
+	default:
+	}
+	select {
+	case h.signals <- sig:
+		return nil
+	case <-h.Closing():
+		return ErrClosed
+	}
+}
+
+// TrySignal is like Signal but returns false rather than blocking if the buffer is full or the receiving Context is closing.
+func (h *Handle[S]) TrySignal(sig S) bool {
+	select {
+	case <-h.Closing():
+		return false
+	default:
+	}
+	select {
+	case h.signals <- sig:
+		return true
+	default:
+		return false
+	}
+}
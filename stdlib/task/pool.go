@@ -0,0 +1,163 @@
+package task
+
+import (
+	"runtime"
+	"sync"
+	"time"
+)
+
+// PoolOpts specifies a Pool.
+type PoolOpts struct {
+	Label    string // logging and debugging label; if empty, "pool"
+	Workers  int    // max jobs running at once; if <= 0, runtime.NumCPU()
+	QueueMax int    // max jobs waiting for a worker, beyond which Submit() blocks; if <= 0, 4 * Workers
+}
+
+// Pool is a bounded worker pool that is itself a child Context, so its jobs stop when its parent (e.g. a host) shuts down.
+//
+// Each job runs in its own child Context of the pool, which closes when the job returns.
+// Close() drains the pool: no further jobs are accepted, but queued and running jobs complete before the pool closes.
+// In contrast, if the pool's parent closes (or CloseWithReason() is called), running jobs see their Context closing and queued jobs are discarded.
+type Pool struct {
+	Context
+	jobs       chan poolJob
+	mu         sync.RWMutex   // guards draining
+	draining   bool           // set once Close() is called
+	drain      chan struct{}  // closed once Close() is called
+	submitting sync.WaitGroup // Submit() calls in progress
+}
+
+type poolJob struct {
+	label string
+	fn    func(ctx Context)
+}
+
+// StartPool starts a Pool as a child of the given Context.
+func StartPool(parent Context, opts PoolOpts) (*Pool, error) {
+	if opts.Label == "" {
+		opts.Label = "pool"
+	}
+	if opts.Workers <= 0 {
+		opts.Workers = runtime.NumCPU()
+	}
+	if opts.QueueMax <= 0 {
+		opts.QueueMax = 4 * opts.Workers
+	}
+
+	pool := &Pool{
+		jobs:  make(chan poolJob, opts.QueueMax),
+		drain: make(chan struct{}),
+	}
+
+	var err error
+	pool.Context, err = parent.StartChild(&Task{
+		Info: Info{
+			Label: opts.Label,
+		},
+		OnRun: func(ctx Context) {
+			var wg sync.WaitGroup
+			for i := 0; i < opts.Workers; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					pool.work(ctx)
+				}()
+			}
+			wg.Wait()
+			ctx.Close()
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pool, nil
+}
+
+// Submit queues a job to run in its own child Context, blocking while the queue is full.
+// Returns ErrClosed if the pool is draining or closed.
+func (pool *Pool) Submit(label string, job func(ctx Context)) error {
+	_, err := pool.submit(poolJob{label, job}, true)
+	return err
+}
+
+// TrySubmit is like Submit but returns false rather than blocking if the queue is full.
+func (pool *Pool) TrySubmit(label string, job func(ctx Context)) (bool, error) {
+	return pool.submit(poolJob{label, job}, false)
+}
+
+func (pool *Pool) submit(job poolJob, block bool) (bool, error) {
+	pool.mu.RLock()
+	if pool.draining {
+		pool.mu.RUnlock()
+		return false, ErrClosed
+	}
+	pool.submitting.Add(1)
+	pool.mu.RUnlock()
+	defer pool.submitting.Done()
+
+	if !block {
+		select {
+		case pool.jobs <- job:
+			return true, nil
+		default:
+			return false, nil
+		}
+	}
+	select {
+	case pool.jobs <- job:
+		return true, nil
+	case <-pool.drain:
+		return false, ErrClosed
+	case <-pool.Closing():
+		return false, ErrClosed
+	}
+}
+
+// Close stops the pool from accepting jobs and closes it once queued and running jobs complete -- non-blocking.
+// Use Done() to wait until the pool has drained.
+func (pool *Pool) Close() error {
+	pool.mu.Lock()
+	if !pool.draining {
+		pool.draining = true
+		close(pool.drain)
+	}
+	pool.mu.Unlock()
+	return nil
+}
+
+func (pool *Pool) work(ctx Context) {
+	for {
+		select {
+		case job := <-pool.jobs:
+			pool.run(ctx, job)
+		case <-pool.drain:
+			pool.submitting.Wait() // no job is queued after this
+			for {
+				select {
+				case job := <-pool.jobs:
+					pool.run(ctx, job)
+				case <-ctx.Closing():
+					return
+				default:
+					return
+				}
+			}
+		case <-ctx.Closing():
+			return
+		}
+	}
+}
+
+// run runs the given job in a child Context and waits for it to complete.
+func (pool *Pool) run(ctx Context, job poolJob) {
+	jobCtx, err := ctx.StartChild(&Task{
+		Info: Info{
+			Label:     job.label,
+			IdleClose: time.Nanosecond,
+		},
+		OnRun: job.fn,
+	})
+	if err == nil {
+		<-jobCtx.Done()
+	}
+}
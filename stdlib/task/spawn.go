@@ -0,0 +1,100 @@
+package task
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Priority orders children waiting to start under a parent's Info.MaxChildren limit.
+type Priority int8
+
+const (
+	PriorityLow    Priority = -1 // e.g. prefetches and background refreshes
+	PriorityNormal Priority = 0
+	PriorityHigh   Priority = 1 // e.g. pins the user is waiting on
+)
+
+// spawnGate limits how many children of a Context run at once, admitting waiting children by priority then arrival order.
+type spawnGate struct {
+	mu      sync.Mutex
+	max     int
+	running int
+	waiting []*spawnWaiter // sorted by descending priority then arrival
+}
+
+type spawnWaiter struct {
+	priority Priority
+	ready    chan struct{} // closed once a slot is handed to this waiter
+}
+
+// acquire blocks until a slot is available, returning ErrNotStarted if closing is signaled or context.DeadlineExceeded if deadline passes first.
+func (g *spawnGate) acquire(priority Priority, closing <-chan struct{}, clock Clock, deadline time.Time) error {
+	g.mu.Lock()
+	if g.running < g.max && len(g.waiting) == 0 {
+		g.running++
+		g.mu.Unlock()
+		return nil
+	}
+	waiter := &spawnWaiter{
+		priority: priority,
+		ready:    make(chan struct{}),
+	}
+	i := len(g.waiting)
+	for i > 0 && g.waiting[i-1].priority < priority {
+		i--
+	}
+	g.waiting = append(g.waiting, nil)
+	copy(g.waiting[i+1:], g.waiting[i:])
+	g.waiting[i] = waiter
+	g.mu.Unlock()
+
+	var expired <-chan time.Time
+	if !deadline.IsZero() {
+		timer := clock.NewTimer(deadline.Sub(clock.Now()))
+		defer timer.Stop()
+		expired = timer.C()
+	}
+
+	var err error
+	select {
+	case <-waiter.ready:
+		return nil
+	case <-closing:
+		err = ErrNotStarted
+	case <-expired:
+		err = context.DeadlineExceeded
+	}
+
+	g.mu.Lock()
+	admitted := true
+	for j, wi := range g.waiting {
+		if wi == waiter {
+			g.waiting = append(g.waiting[:j], g.waiting[j+1:]...)
+			admitted = false
+			break
+		}
+	}
+	g.mu.Unlock()
+
+	// If a slot was handed over as we gave up, pass it on.
+	if admitted {
+		g.release()
+	}
+	return err
+}
+
+// release frees a slot, handing it to the next waiter (if any).
+func (g *spawnGate) release() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if len(g.waiting) > 0 {
+		next := g.waiting[0]
+		g.waiting[0] = nil
+		g.waiting = g.waiting[1:]
+		close(next.ready)
+	} else {
+		g.running--
+	}
+}
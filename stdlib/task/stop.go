@@ -0,0 +1,70 @@
+package task
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"time"
+)
+
+var ErrStopTimeout = errors.New("stop timed out")
+
+// StopTimeoutError is returned by GracefulStop() when a Context failed to complete even after being closed.
+type StopTimeoutError struct {
+	Stragglers []*TaskState // tasks holding up the close -- see TaskState.Stuck()
+	Stacks     []byte       // goroutine stacks of the process when the stop timed out
+}
+
+func (err *StopTimeoutError) Error() string {
+	labels := make([]string, len(err.Stragglers))
+	for i, ti := range err.Stragglers {
+		labels[i] = ti.Label
+	}
+	return fmt.Sprintf("%v: %d stragglers: %s", ErrStopTimeout, len(labels), strings.Join(labels, ", "))
+}
+
+func (err *StopTimeoutError) Is(target error) bool {
+	return target == ErrStopTimeout
+}
+
+// GracefulStop stops the given Context (e.g. a host or an app instance) in phases, so that a hung task can't wedge shutdown forever:
+//
+//  1. The Context is closed once idle (see CloseWhenIdle()), giving in-progress work up to softDeadline to complete on its own.
+//  2. If still running, the Context is closed, signaling all its children to stop, which are given up to hardDeadline to complete.
+//  3. If tasks still remain, the goroutine stacks of the process are logged and a *StopTimeoutError naming the stragglers is returned.
+//
+// Returns nil once the Context is done, and otherwise returns without waiting further for stragglers.
+func GracefulStop(t Context, softDeadline, hardDeadline time.Duration) error {
+	clock := t.Clock()
+
+	if softDeadline > 0 {
+		t.CloseWhenIdle(time.Nanosecond)
+		timer := clock.NewTimer(softDeadline)
+		select {
+		case <-t.Done():
+			timer.Stop()
+			return nil
+		case <-timer.C():
+		}
+		t.Log().Warnf("not idle after %v, closing", softDeadline)
+	}
+
+	t.Close()
+	timer := clock.NewTimer(hardDeadline)
+	select {
+	case <-t.Done():
+		timer.Stop()
+		return nil
+	case <-timer.C():
+	}
+
+	stacks := make([]byte, 1<<20)
+	stacks = stacks[:runtime.Stack(stacks, true)]
+	err := &StopTimeoutError{
+		Stragglers: Inspect(t).Stuck(),
+		Stacks:     stacks,
+	}
+	t.Log().Errorf("%v\n%s", err, stacks)
+	return err
+}
@@ -0,0 +1,150 @@
+package task
+
+import (
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"time"
+)
+
+// RestartMode specifies when a supervised run is restarted -- see RestartPolicy.
+type RestartMode int
+
+const (
+	RestartOnPanic   RestartMode = iota // restart only if the run panics
+	RestartOnFailure                    // restart if the run panics or returns an error
+	RestartAlways                       // restart whenever the run returns, even with a nil error
+)
+
+// RestartPolicy specifies how Supervise() restarts a run.
+type RestartPolicy struct {
+	Mode RestartMode
+
+	// Delays before each successive restart, where the last delay repeats; if empty, restarts occur after 1s.
+	// For example, {100ms, 1s, 10s, 1m} backs off to restarting once per minute.
+	Backoff []time.Duration
+
+	// If > 0, the supervisor gives up after this many consecutive restarts, closing with ErrRestartsExhausted.
+	MaxRetries int
+
+	// A run lasting at least this long is considered healthy, resetting the backoff schedule and retry count; if <= 0, 1 minute.
+	ResetAfter time.Duration
+}
+
+// PanicError is the failure of a supervised run that panicked.
+type PanicError struct {
+	Value any    // value passed to panic()
+	Stack []byte // stack trace of the panicking goroutine
+}
+
+func (err *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", err.Value)
+}
+
+var ErrRestartsExhausted = errors.New("restarts exhausted")
+
+// Supervise starts a child Context that calls run, restarting it according to the given policy -- e.g. a long-running watcher that should recover from failures.
+//
+// Each run is given its own child Context of the supervisor, which closes (along with any children it started) before a restart.
+// The supervisor closes once a run completes without requiring a restart or once its restarts are exhausted, after which Err() reports the final failure (if any).
+// Closing the supervisor (or its parent) closes the current run and stops further restarts.
+func Supervise(parent Context, label string, policy RestartPolicy, run func(ctx Context) error) (Context, error) {
+	if len(policy.Backoff) == 0 {
+		policy.Backoff = []time.Duration{time.Second}
+	}
+	if policy.ResetAfter <= 0 {
+		policy.ResetAfter = time.Minute
+	}
+
+	return parent.StartChild(&Task{
+		Info: Info{
+			Label: label,
+		},
+		OnRun: func(sup Context) {
+			sup.CloseWithReason(supervise(sup, label, policy, run))
+		},
+	})
+}
+
+// supervise runs and restarts run until a restart is not warranted, returning the final failure (if any).
+func supervise(sup Context, label string, policy RestartPolicy, run func(ctx Context) error) error {
+	retries := 0
+	for attempt := 1; ; attempt++ {
+		started := sup.Clock().Now()
+		err := runOnce(sup, fmt.Sprintf("%s #%d", label, attempt), run)
+
+		select {
+		case <-sup.Closing():
+			return nil
+		default:
+		}
+
+		var panicErr *PanicError
+		restart := false
+		switch policy.Mode {
+		case RestartAlways:
+			restart = true
+		case RestartOnFailure:
+			restart = err != nil
+		case RestartOnPanic:
+			restart = errors.As(err, &panicErr)
+		}
+		if !restart {
+			return err
+		}
+
+		if sup.Clock().Now().Sub(started) >= policy.ResetAfter {
+			retries = 0
+		}
+		if policy.MaxRetries > 0 && retries >= policy.MaxRetries {
+			sup.Log().Warnf("giving up after %d restarts: %v", retries, err)
+			return fmt.Errorf("%w: %v", ErrRestartsExhausted, err)
+		}
+
+		delay := policy.Backoff[min(retries, len(policy.Backoff)-1)]
+		retries++
+		if err != nil {
+			sup.Log().Warnf("run #%d failed (restarting in %v): %v", attempt, delay, err)
+		}
+
+		timer := sup.Clock().NewTimer(delay)
+		select {
+		case <-timer.C():
+		case <-sup.Closing():
+			timer.Stop()
+			return nil
+		}
+	}
+}
+
+// runOnce calls run within a child Context, recovering a panic as a *PanicError, and waits for the child to fully close.
+func runOnce(sup Context, label string, run func(ctx Context) error) (err error) {
+	result := make(chan error, 1)
+	runCtx, startErr := sup.StartChild(&Task{
+		Info: Info{
+			Label: label,
+		},
+		OnRun: func(ctx Context) {
+			defer func() {
+				if r := recover(); r != nil {
+					result <- &PanicError{
+						Value: r,
+						Stack: debug.Stack(),
+					}
+				}
+			}()
+			result <- run(ctx)
+		},
+	})
+	if startErr != nil {
+		return startErr
+	}
+
+	select {
+	case err = <-result:
+	case <-sup.Closing():
+	}
+	runCtx.Close()
+	<-runCtx.Done()
+	return err
+}
@@ -5,6 +5,8 @@ import (
 	"io"
 	"strings"
 	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/log"
 )
 
 // Periodically calls PrintContextTree() to the context's logger.
@@ -70,3 +72,97 @@ func PrintContextTree(ctx Context, out io.Writer, verboseLevel int32) {
 		ctx.Log().Info(verboseLevel, outStr)
 	}
 }
+
+// State is the lifecycle stage of a Context.
+type State string
+
+const (
+	StateRunning State = "running" // Close() has not been called
+	StateClosing State = "closing" // Close() has been called but the Context or its children are still completing
+	StateClosed  State = "closed"  // the Context and all its children have completed
+)
+
+// StateOf returns the lifecycle stage of the given Context.
+func StateOf(ctx Context) State {
+	select {
+	case <-ctx.Done():
+		return StateClosed
+	default:
+	}
+	select {
+	case <-ctx.Closing():
+		return StateClosing
+	default:
+		return StateRunning
+	}
+}
+
+// TaskState is a snapshot of a Context and its children -- see Inspect().
+type TaskState struct {
+	TID       int64
+	Label     string
+	State     State
+	Age       time.Duration // time since the Context started
+	LogFields log.Fields    // fields set on the Context itself (see Info.LogFields), e.g. identifying a session or pinned cell
+	Children  []*TaskState
+}
+
+// Inspect returns a snapshot of the given Context's tree (e.g. a host's), reporting each task's label, state, age, and children.
+func Inspect(ctx Context) *TaskState {
+	return inspect(ctx, ctx.Clock().Now())
+}
+
+func inspect(ctx Context, now time.Time) *TaskState {
+	info := ctx.Info()
+	ts := &TaskState{
+		TID:       info.TID,
+		Label:     ctx.Log().GetLogLabel(),
+		State:     StateOf(ctx),
+		Age:       now.Sub(info.StartedAt),
+		LogFields: info.LogFields,
+	}
+	var subBuf [20]Context
+	for _, child := range ctx.GetChildren(subBuf[:0]) {
+		ts.Children = append(ts.Children, inspect(child, now))
+	}
+	return ts
+}
+
+// Stuck returns the closing tasks that are holding up their parent from closing:
+// those that are closing yet have no closing children, meaning their own work (e.g. OnRun or OnClosing) has not returned.
+// This answers "why won't my host shut down" once Close() has been called.
+func (ts *TaskState) Stuck() []*TaskState {
+	var stuck []*TaskState
+	ts.appendStuck(&stuck)
+	return stuck
+}
+
+// appendStuck appends the stuck tasks of this tree, returning true if any were found.
+func (ts *TaskState) appendStuck(stuck *[]*TaskState) bool {
+	if ts.State != StateClosing {
+		return false
+	}
+	found := false
+	for _, child := range ts.Children {
+		if child.appendStuck(stuck) {
+			found = true
+		}
+	}
+	if !found {
+		*stuck = append(*stuck, ts)
+	}
+	return true
+}
+
+// Find returns the task in this tree having the given TID, or nil if not present.
+func (ts *TaskState) Find(tid int64) *TaskState {
+	if ts.TID == tid {
+		return ts
+	}
+	for _, child := range ts.Children {
+		if found := child.Find(tid); found != nil {
+			return found
+		}
+	}
+	return nil
+}
@@ -14,19 +14,30 @@ import (
 
 // ctx implements Context
 type ctx struct {
+	parent         *ctx
 	log            log.Logger
 	task           Task
 	state          int32
 	idle           bool
 	idleCloseRetry atomic.Int64 // time.Duration
 	idleCloseMin   time.Time
+	deadline       time.Time // see Info.Deadline
+	counters       *labelCounters
+	gate           *spawnGate // limits running children -- see Info.MaxChildren
+	clock          Clock
+	tracer         Tracer
+	span           Span // nil if not traced
 
 	chClosing chan struct{}  // signals Close() has been called and close execution has begun.
 	chClosed  chan struct{}  // signals Close() has been called and all close execution is done.
-	err       error          // See context.Err() for spec
+	err       error          // close reason -- see CloseReason() and context.Err()
 	busy      sync.WaitGroup // blocks until all execution is complete
 	subsMu    sync.Mutex     // Locked when .subs is being accessed
 	subs      []Context
+	weakSubs  int             // number of .subs that are weak -- see Info.Weak
+	names     map[string]*ctx // named children -- see Info.Name
+	valuesMu  sync.Mutex
+	values    map[any]any // see Key.Set()
 }
 
 // Errors
@@ -39,16 +50,39 @@ var (
 var gInstanceCount = int64(0)
 
 func (p *ctx) Close() error {
-	first := atomic.CompareAndSwapInt32(&p.state, Running, Closing)
-	if first {
-		close(p.chClosing)
+	p.closeWith(nil)
+	return nil
+}
+
+func (p *ctx) CloseWithReason(reason error) error {
+	if p.closeWith(reason) && reason != nil {
+		p.log.Infof(1, "closing: %v", reason)
 	}
 	return nil
 }
 
+func (p *ctx) CloseReason() error {
+	select {
+	case <-p.chClosing:
+		return p.err // written before chClosing is released
+	default:
+		return nil
+	}
+}
+
+// closeWith closes this Context, recording the given reason if this is the first close, in which case true is returned.
+func (p *ctx) closeWith(reason error) bool {
+	if atomic.CompareAndSwapInt32(&p.state, Running, Closing) {
+		p.err = reason
+		close(p.chClosing)
+		return true
+	}
+	return false
+}
+
 func (p *ctx) PreventIdleClose(delay time.Duration) bool {
 	p.subsMu.Lock()
-	p.idleCloseMin = time.Now().Add(delay)
+	p.idleCloseMin = p.clock.Now().Add(delay)
 	p.idle = false
 	p.subsMu.Unlock()
 
@@ -74,7 +108,7 @@ func (p *ctx) CloseWhenIdle(delay time.Duration) {
 	}
 
 	go func() {
-		var timer *time.Timer
+		var timer Timer
 
 		for idleClose := true; idleClose; {
 			p.idle = true
@@ -90,7 +124,7 @@ func (p *ctx) CloseWhenIdle(delay time.Duration) {
 				idleClose = false
 			} else {
 				if !p.idleCloseMin.IsZero() {
-					minDelay := time.Until(p.idleCloseMin)
+					minDelay := p.idleCloseMin.Sub(p.clock.Now())
 					if minDelay <= 0 {
 						p.idleCloseMin = time.Time{}
 					}
@@ -108,12 +142,12 @@ func (p *ctx) CloseWhenIdle(delay time.Duration) {
 
 			if delay > 0 {
 				if timer == nil {
-					timer = time.NewTimer(delay)
+					timer = p.clock.NewTimer(delay)
 				} else {
 					timer.Reset(delay)
 				}
 				select {
-				case <-timer.C:
+				case <-timer.C():
 				case <-p.Closing():
 					idleClose = false
 				}
@@ -134,7 +168,7 @@ func (p *ctx) CloseWhenIdle(delay time.Duration) {
 }
 
 func (p *ctx) Deadline() (deadline time.Time, ok bool) {
-	return time.Time{}, false
+	return p.deadline, !p.deadline.IsZero()
 }
 
 func (p *ctx) Err() error {
@@ -149,8 +183,8 @@ func (p *ctx) Err() error {
 	}
 }
 
-func (p *ctx) Value(key interface{}) interface{} {
-	return nil
+func (p *ctx) Clock() Clock {
+	return p.clock
 }
 
 func (p *ctx) Info() Info {
@@ -171,7 +205,11 @@ func printContextTree(ctx Context, out *strings.Builder, depth int, prefix []run
 	}
 	taskInfo := ctx.Info()
 	prefix = append(prefix, icon, ' ')
-	out.WriteString(fmt.Sprintf("%04d%s%s\n", taskInfo.TID, string(prefix), ctx.Log().GetLogLabel()))
+	out.WriteString(fmt.Sprintf("%04d%s%s", taskInfo.TID, string(prefix), ctx.Log().GetLogLabel()))
+	if state := StateOf(ctx); state != StateRunning {
+		out.WriteString(fmt.Sprintf(" (%s)", state))
+	}
+	out.WriteByte('\n')
 	icon = '┃'
 	if lastChild {
 		icon = ' '
@@ -201,47 +239,130 @@ func (p *ctx) ForEachChild(fn func(child Context)) {
 
 // StartChild starts the given child Context as a "sub" task.
 func (p *ctx) StartChild(task *Task) (Context, error) {
+	clock := task.Clock
+	if clock == nil {
+		clock = SystemClock
+		if p != nil {
+			clock = p.clock
+		}
+	}
 	info := task.Info
 	task.Info.TID = atomic.AddInt64(&gInstanceCount, 1)
+	task.Info.StartedAt = clock.Now()
+	if info.Label == "" {
+		info.Label = info.Name
+	}
 	if info.Label == "" {
 		info.Label = fmt.Sprintf("ctx_%d", task.Info.TID)
 	}
+	if info.Timeout > 0 {
+		if deadline := clock.Now().Add(info.Timeout); info.Deadline.IsZero() || deadline.Before(info.Deadline) {
+			task.Info.Deadline = deadline
+		}
+	}
+	if p != nil {
+		if deadline := p.deadline; !deadline.IsZero() && (task.Info.Deadline.IsZero() || deadline.Before(task.Info.Deadline)) {
+			task.Info.Deadline = deadline
+		}
+	}
+	parentLog := task.Logger
+	if parentLog == nil && p != nil {
+		parentLog = p.log
+	}
+	tracer := task.Tracer
+	if tracer == nil && p != nil {
+		tracer = p.tracer
+	}
 	child := &ctx{
-		log:       log.NewLogger(info.Label),
+		parent:    p,
+		tracer:    tracer,
+		log:       log.NewLoggerFrom(parentLog, info.Label, info.LogFields),
 		state:     Running,
 		task:      *task,
+		deadline:  task.Info.Deadline,
+		clock:     clock,
+		counters:  countersFor(info.Label),
 		chClosing: make(chan struct{}),
 		chClosed:  make(chan struct{}),
 	}
+	if info.MaxChildren > 0 {
+		child.gate = &spawnGate{
+			max: info.MaxChildren,
+		}
+	}
 
 	// If a parent is given, add the child to the parent's list of children.
 	if p != nil {
+		if p.gate != nil {
+			if err := p.gate.acquire(info.Priority, p.Closing(), clock, child.deadline); err != nil {
+				return nil, err
+			}
+		}
+
 		var err error
 		p.subsMu.Lock()
-		if p.state == Running {
-			p.busy.Add(1)
-			p.idle = false
-			p.subs = append(p.subs, child)
-		} else {
+		if atomic.LoadInt32(&p.state) != Running {
 			err = ErrNotStarted
+		} else if prev := p.names[info.Name]; info.Name != "" && prev != nil && atomic.LoadInt32(&prev.state) == Running {
+			err = fmt.Errorf("%w: %q", ErrNameTaken, info.Name)
+		} else {
+			if info.Name != "" {
+				if p.names == nil {
+					p.names = make(map[string]*ctx)
+				}
+				p.names[info.Name] = child
+			}
+			if info.Weak {
+				p.weakSubs++
+			} else {
+				p.busy.Add(1)
+				p.idle = false
+			}
+			p.subs = append(p.subs, child)
 		}
 		p.subsMu.Unlock()
 
 		if err != nil {
+			if p.gate != nil {
+				p.gate.release()
+			}
 			return nil, err
 		}
 	}
+	child.counters.started.Add(1)
+	if child.tracer != nil {
+		var parentSpan Span
+		if p != nil {
+			parentSpan = p.span
+		}
+		attrs := make(log.Fields, len(info.LogFields)+1)
+		for k, v := range info.LogFields {
+			attrs[k] = v
+		}
+		attrs[SpanAttrTID] = task.Info.TID
+		child.span = child.tracer.Start(parentSpan, info.Label, task.Info.StartedAt, attrs)
+	}
 
 	go func() {
 
-		// If there is a parent, wait until child.Close() *or* p.Close()
+		// If there is a parent, wait until child.Close() *or* p.Close() *or* the child's deadline
 		// TODO: merge CloseWhenIdle() into this block?
+		var parentClosing <-chan struct{}
 		if p != nil {
-			select {
-			case <-p.Closing():
-				child.Close()
-			case <-child.Closing():
-			}
+			parentClosing = p.Closing()
+		}
+		var expired <-chan time.Time
+		if deadline := child.deadline; !deadline.IsZero() {
+			timer := clock.NewTimer(deadline.Sub(clock.Now()))
+			defer timer.Stop()
+			expired = timer.C()
+		}
+		select {
+		case <-parentClosing:
+			child.closeWith(p.err) // a parent's close reason (e.g. its deadline) is also the child's
+		case <-expired:
+			child.CloseWithReason(context.DeadlineExceeded)
+		case <-child.Closing():
 		}
 
 		// Wait for child to begin closing phase
@@ -257,6 +378,9 @@ func (p *ctx) StartChild(task *Task) (Context, error) {
 		}
 
 		// Once all child's children are closed, proceed with completion.
+		// Cycling subsMu ensures any StartChild() that saw the child running has called busy.Add() before busy.Wait() is called.
+		child.subsMu.Lock()
+		child.subsMu.Unlock()
 		child.busy.Wait()
 
 		var idleClose time.Duration
@@ -276,9 +400,15 @@ func (p *ctx) StartChild(task *Task) (Context, error) {
 						break
 					}
 				}
+				if info.Weak {
+					p.weakSubs--
+				}
+				if info.Name != "" && p.names[info.Name] == child {
+					delete(p.names, info.Name)
+				}
 
-				// If removing the last child and in IdleClose mode, queue the parent to be closed
-				if N == 0 {
+				// If removing the last (non-weak) child and in IdleClose mode, queue the parent to be closed
+				if N == p.weakSubs && !info.Weak {
 					idleClose = p.task.Info.IdleClose
 				}
 			}
@@ -286,15 +416,27 @@ func (p *ctx) StartChild(task *Task) (Context, error) {
 		}
 
 		// Move to Closed state now that all all that remains is the OnClosed callback and release of the chClosed chan.
-		child.state = Closed
+		atomic.StoreInt32(&child.state, Closed)
 		if child.task.OnClosed != nil {
 			child.task.OnClosed()
 		}
+		child.counters.onClosed(clock.Now().Sub(child.task.Info.StartedAt))
+		if child.span != nil {
+			if child.err != nil {
+				child.span.RecordError(child.err)
+			}
+			child.span.End(clock.Now())
+		}
 		close(child.chClosed)
 
 		// With the child now fully closed, the parent is no longer waiting on this child
 		if p != nil {
-			p.busy.Done()
+			if p.gate != nil {
+				p.gate.release()
+			}
+			if !info.Weak {
+				p.busy.Done()
+			}
 		}
 
 		if idleClose > 0 {
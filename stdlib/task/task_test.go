@@ -1,12 +1,16 @@
 package task_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/art-media-platform/amp-sdk-go/stdlib/log"
 	"github.com/art-media-platform/amp-sdk-go/stdlib/task"
 )
 
@@ -208,3 +212,580 @@ func (a Awaiter) NeverHappenedOrFail(t testing.TB, params ...interface{}) {
 	case <-time.After(duration):
 	}
 }
+
+func TestPool(t *testing.T) {
+	root, _ := task.Start(&task.Task{
+		Info: task.Info{Label: "root"},
+	})
+	defer root.Close()
+
+	t.Run("drain", func(t *testing.T) {
+		pool, err := task.StartPool(root, task.PoolOpts{Workers: 2, QueueMax: 1})
+		require.NoError(t, err)
+
+		var running, maxRunning, completed atomic.Int32
+		for i := 0; i < 10; i++ {
+			err := pool.Submit(fmt.Sprintf("job %d", i), func(ctx task.Context) {
+				n := running.Add(1)
+				for m := maxRunning.Load(); n > m && !maxRunning.CompareAndSwap(m, n); m = maxRunning.Load() {
+				}
+				time.Sleep(time.Millisecond)
+				running.Add(-1)
+				completed.Add(1)
+			})
+			require.NoError(t, err)
+		}
+		pool.Close()
+		require.ErrorIs(t, pool.Submit("late", func(ctx task.Context) {}), task.ErrClosed)
+
+		<-pool.Done()
+		require.EqualValues(t, 10, completed.Load())
+		require.LessOrEqual(t, maxRunning.Load(), int32(2))
+	})
+
+	t.Run("parent close", func(t *testing.T) {
+		parent, _ := root.StartChild(&task.Task{
+			Info: task.Info{Label: "parent"},
+		})
+		pool, err := task.StartPool(parent, task.PoolOpts{Workers: 1})
+		require.NoError(t, err)
+
+		started := make(chan struct{})
+		pool.Submit("blocking", func(ctx task.Context) {
+			close(started)
+			<-ctx.Closing()
+		})
+		<-started
+		parent.Close()
+
+		select {
+		case <-pool.Done():
+		case <-time.After(5 * time.Second):
+			t.Fatal("pool did not close with its parent")
+		}
+	})
+}
+
+func TestDeadline(t *testing.T) {
+	root, _ := task.Start(&task.Task{
+		Info: task.Info{Label: "root"},
+	})
+	defer root.Close()
+
+	call, err := task.WithTimeout(root, "outbound call", 20*time.Millisecond)
+	require.NoError(t, err)
+	sub, _ := call.StartChild(&task.Task{
+		Info: task.Info{Label: "sub", Timeout: time.Hour},
+	})
+	deadline, ok := sub.Deadline()
+	require.True(t, ok)
+	require.WithinDuration(t, time.Now().Add(20*time.Millisecond), deadline, 20*time.Millisecond)
+
+	select {
+	case <-sub.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("deadline did not close the context")
+	}
+	<-call.Done()
+	require.ErrorIs(t, call.Err(), context.DeadlineExceeded)
+	require.ErrorIs(t, sub.Err(), context.DeadlineExceeded)
+
+	// closed before its deadline
+	early, _ := task.WithDeadline(root, "early", time.Now().Add(time.Hour))
+	early.Close()
+	<-early.Done()
+	require.ErrorIs(t, early.Err(), context.Canceled)
+}
+
+func TestInspect(t *testing.T) {
+	root, _ := task.Start(&task.Task{
+		Info: task.Info{Label: "host"},
+	})
+	svc, _ := root.StartChild(&task.Task{
+		Info: task.Info{Label: "service"},
+	})
+	release := make(chan struct{})
+	svc.StartChild(&task.Task{
+		Info: task.Info{Label: "stubborn"},
+		OnRun: func(ctx task.Context) {
+			<-release // ignores Closing()
+		},
+	})
+
+	state := task.Inspect(root)
+	require.Equal(t, "host", state.Label)
+	require.Equal(t, task.StateRunning, state.State)
+	require.Len(t, state.Children, 1)
+	require.Equal(t, "stubborn", state.Children[0].Children[0].Label)
+	require.Empty(t, state.Stuck())
+
+	root.Close()
+	time.Sleep(10 * time.Millisecond)
+	state = task.Inspect(root)
+	require.Equal(t, task.StateClosing, state.State)
+	stuck := state.Stuck()
+	require.Len(t, stuck, 1)
+	require.Equal(t, "stubborn", stuck[0].Label)
+	require.Same(t, stuck[0], state.Find(stuck[0].TID))
+
+	close(release)
+	<-root.Done()
+	require.Equal(t, task.StateClosed, task.StateOf(root))
+}
+
+func TestSupervise(t *testing.T) {
+	root, _ := task.Start(&task.Task{
+		Info: task.Info{Label: "root"},
+	})
+	defer root.Close()
+
+	// recovers from panics until a run completes
+	var runs atomic.Int32
+	sup, err := task.Supervise(root, "watcher", task.RestartPolicy{
+		Mode:    task.RestartOnPanic,
+		Backoff: []time.Duration{time.Millisecond},
+	}, func(ctx task.Context) error {
+		if runs.Add(1) < 3 {
+			panic("lost connection")
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	<-sup.Done()
+	require.EqualValues(t, 3, runs.Load())
+	require.ErrorIs(t, sup.Err(), context.Canceled)
+
+	// gives up after MaxRetries consecutive failures
+	runs.Store(0)
+	failure := errors.New("unreachable")
+	sup, _ = task.Supervise(root, "poller", task.RestartPolicy{
+		Mode:       task.RestartOnFailure,
+		Backoff:    []time.Duration{time.Millisecond},
+		MaxRetries: 2,
+	}, func(ctx task.Context) error {
+		runs.Add(1)
+		return failure
+	})
+	<-sup.Done()
+	require.EqualValues(t, 3, runs.Load())
+	require.ErrorIs(t, sup.Err(), task.ErrRestartsExhausted)
+
+	// closing the supervisor stops the current run
+	sup, _ = task.Supervise(root, "listener", task.RestartPolicy{
+		Mode: task.RestartAlways,
+	}, func(ctx task.Context) error {
+		<-ctx.Closing()
+		return nil
+	})
+	sup.Close()
+	select {
+	case <-sup.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("supervisor did not close")
+	}
+}
+
+func TestStdContext(t *testing.T) {
+	root, _ := task.Start(&task.Task{
+		Info: task.Info{Label: "root"},
+	})
+	defer root.Close()
+
+	// a std context is canceled as soon as its task begins closing, even while a child holds up Done()
+	release := make(chan struct{})
+	call, _ := root.StartChild(&task.Task{
+		Info: task.Info{Label: "call"},
+		OnRun: func(ctx task.Context) {
+			<-release
+		},
+	})
+	stdCtx := task.AsStdContext(call)
+	require.NoError(t, stdCtx.Err())
+	call.Close()
+	<-stdCtx.Done()
+	require.ErrorIs(t, stdCtx.Err(), context.Canceled)
+	close(release)
+
+	// a task started from a std context closes with its reason
+	parent, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	handler, err := task.FromStdContext(parent, "handler")
+	require.NoError(t, err)
+	_, hasDeadline := handler.Deadline()
+	require.True(t, hasDeadline)
+	<-handler.Done()
+	require.ErrorIs(t, handler.Err(), context.DeadlineExceeded)
+}
+
+func TestCloseReason(t *testing.T) {
+	errKill := errors.New("operator kill")
+
+	var child task.Context
+	observed := make(chan error, 1)
+	root, _ := task.Start(&task.Task{
+		Info: task.Info{Label: "session"},
+	})
+	child, _ = root.StartChild(&task.Task{
+		Info: task.Info{Label: "pin"},
+		OnClosing: func() {
+			observed <- child.CloseReason()
+		},
+	})
+	require.NoError(t, child.CloseReason())
+
+	root.CloseWithReason(errKill)
+	require.ErrorIs(t, <-observed, errKill)
+	<-root.Done()
+	require.ErrorIs(t, root.Err(), errKill)
+
+	// only the first close has effect
+	root.CloseWithReason(errors.New("transport error"))
+	require.ErrorIs(t, child.CloseReason(), errKill)
+}
+
+type captureSink struct {
+	entries chan log.Entry
+}
+
+func (sink *captureSink) Log(entry *log.Entry) {
+	sink.entries <- *entry
+}
+
+func TestLogFields(t *testing.T) {
+	sink := &captureSink{entries: make(chan log.Entry, 4)}
+	root, _ := task.Start(&task.Task{
+		Info:   task.Info{Label: "session"},
+		Logger: log.NewSinkLogger(sink, "host", log.Fields{"session": "s1"}),
+	})
+	defer root.Close()
+
+	app, _ := root.StartChild(&task.Task{
+		Info: task.Info{
+			Label:     "app",
+			LogFields: log.Fields{"app": "amp.app.chat"},
+		},
+	})
+	pin, _ := app.StartChild(&task.Task{
+		Info: task.Info{Label: "pin"},
+	})
+	pin.Log().Warnw("slow consumer", log.Fields{"queued": 12})
+
+	entry := <-sink.entries
+	require.Equal(t, log.LevelWarn, entry.Level)
+	require.Equal(t, "pin", entry.Label)
+	require.Equal(t, log.Fields{"session": "s1", "app": "amp.app.chat", "queued": 12}, entry.Fields)
+
+	pin.Log().With(log.Fields{"attempt": 2}).Infof(0, "retrying")
+	entry = <-sink.entries
+	require.Equal(t, "retrying", entry.Msg)
+	require.Equal(t, 2, entry.Fields["attempt"])
+}
+
+func TestMetrics(t *testing.T) {
+	before := task.ReadMetrics().ByLabel["metrics-pin"]
+
+	root, _ := task.Start(&task.Task{
+		Info: task.Info{Label: "metrics-session"},
+	})
+	leaked, _ := root.StartChild(&task.Task{
+		Info: task.Info{Label: "metrics-pin: leaked"},
+	})
+	time.Sleep(50 * time.Millisecond)
+	for i := 0; i < 3; i++ {
+		root.StartChild(&task.Task{
+			Info: task.Info{Label: fmt.Sprintf("metrics-pin: %d", i)},
+		})
+	}
+
+	lm := task.ReadMetrics().ByLabel["metrics-pin"]
+	require.Equal(t, before.Started+4, lm.Started)
+	require.Equal(t, before.Live+4, lm.Live)
+
+	leaks := task.FindLeaks(root, task.LeakOpts{MinAge: 10 * time.Millisecond})
+	require.Len(t, leaks, 1)
+	require.Equal(t, leaked.Info().TID, leaks[0].TID)
+
+	root.Close()
+	<-root.Done()
+	lm = task.ReadMetrics().ByLabel["metrics-pin"]
+	require.Equal(t, before.Closed+4, lm.Closed)
+	require.Equal(t, before.Live, lm.Live)
+	require.GreaterOrEqual(t, lm.MaxLifetime, 50*time.Millisecond)
+}
+
+func TestMaxChildren(t *testing.T) {
+	session, _ := task.Start(&task.Task{
+		Info: task.Info{
+			Label:       "session",
+			MaxChildren: 1,
+		},
+	})
+	defer session.Close()
+
+	first, _ := session.StartChild(&task.Task{
+		Info: task.Info{Label: "pin: first"},
+	})
+
+	started := make(chan string, 2)
+	for _, pi := range []struct {
+		label    string
+		priority task.Priority
+	}{
+		{"low", task.PriorityLow},
+		{"high", task.PriorityHigh},
+	} {
+		go session.StartChild(&task.Task{
+			Info: task.Info{
+				Label:     pi.label,
+				Priority:  pi.priority,
+				IdleClose: time.Nanosecond,
+			},
+			OnRun: func(ctx task.Context) {
+				started <- ctx.Info().Label
+			},
+		})
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.Empty(t, started)
+
+	// a waiting child gives up at its deadline
+	_, err := session.StartChild(&task.Task{
+		Info: task.Info{
+			Label:   "impatient",
+			Timeout: 10 * time.Millisecond,
+		},
+	})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	first.Close()
+	require.Equal(t, "high", <-started)
+	require.Equal(t, "low", <-started)
+}
+
+func TestVirtualClock(t *testing.T) {
+	clock := task.NewVirtualClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	root, _ := task.Start(&task.Task{
+		Info:  task.Info{Label: "root"},
+		Clock: clock,
+	})
+	defer root.Close()
+
+	lease, _ := task.WithTimeout(root, "lease", time.Hour)
+	require.Same(t, clock, lease.Clock())
+	require.True(t, clock.WaitForTimers(1, time.Second))
+
+	clock.Advance(59 * time.Minute)
+	time.Sleep(10 * time.Millisecond)
+	require.Equal(t, task.StateRunning, task.StateOf(lease))
+
+	clock.Advance(time.Minute)
+	<-lease.Done()
+	require.ErrorIs(t, lease.Err(), context.DeadlineExceeded)
+	require.Equal(t, time.Hour, task.Inspect(root).Age)
+
+	// supervisor backoff follows the clock
+	var runs atomic.Int32
+	sup, _ := task.Supervise(root, "watcher", task.RestartPolicy{
+		Mode:    task.RestartOnFailure,
+		Backoff: []time.Duration{time.Minute},
+	}, func(ctx task.Context) error {
+		if runs.Add(1) < 3 {
+			return errors.New("flaky")
+		}
+		return nil
+	})
+	for i := 0; i < 2; i++ {
+		require.True(t, clock.WaitForTimers(1, time.Second))
+		clock.Advance(time.Minute)
+	}
+	<-sup.Done()
+	require.EqualValues(t, 3, runs.Load())
+	require.NoError(t, sup.CloseReason())
+}
+
+func TestWeakChild(t *testing.T) {
+	session, _ := task.Start(&task.Task{
+		Info: task.Info{
+			Label:     "session",
+			IdleClose: time.Nanosecond,
+		},
+	})
+	release := make(chan struct{})
+	flush, _ := session.StartChild(&task.Task{
+		Info: task.Info{
+			Label: "analytics flush",
+			Weak:  true,
+		},
+		OnRun: func(ctx task.Context) {
+			<-release // ignores Closing()
+		},
+	})
+	session.Go("pin", func(ctx task.Context) {})
+
+	// the session idle-closes once its last strong child completes, not waiting on the weak one
+	select {
+	case <-session.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("weak child blocked close")
+	}
+	<-flush.Closing()
+	require.Equal(t, task.StateClosing, task.StateOf(flush))
+
+	close(release)
+	<-flush.Done()
+}
+
+func TestGracefulStop(t *testing.T) {
+	clock := task.NewVirtualClock(time.Now())
+	host, _ := task.Start(&task.Task{
+		Info:  task.Info{Label: "host"},
+		Clock: clock,
+	})
+	release := make(chan struct{})
+	app, _ := host.Go("hung app", func(ctx task.Context) {
+		<-release // ignores Closing()
+	})
+
+	stopped := make(chan error, 1)
+	go func() {
+		stopped <- task.GracefulStop(host, time.Minute, 10*time.Second)
+	}()
+	require.True(t, clock.WaitForTimers(1, time.Second))
+	clock.Advance(time.Minute)
+	require.True(t, clock.WaitForTimers(1, time.Second))
+	<-app.Closing()
+	clock.Advance(10 * time.Second)
+
+	err := <-stopped
+	require.ErrorIs(t, err, task.ErrStopTimeout)
+	var stopErr *task.StopTimeoutError
+	require.ErrorAs(t, err, &stopErr)
+	require.Len(t, stopErr.Stragglers, 1)
+	require.Equal(t, "hung app", stopErr.Stragglers[0].Label)
+	require.NotEmpty(t, stopErr.Stacks)
+
+	close(release)
+	<-host.Done()
+
+	// an idle Context stops without escalation
+	idle, _ := task.Start(&task.Task{
+		Info: task.Info{Label: "idle"},
+	})
+	require.NoError(t, task.GracefulStop(idle, time.Minute, time.Minute))
+}
+
+func TestNamedTasks(t *testing.T) {
+	app, _ := task.Start(&task.Task{
+		Info: task.Info{Label: "app"},
+	})
+	defer app.Close()
+
+	type reindex struct{ path string }
+	indexed := make(chan string, 1)
+	_, err := task.StartNamed[reindex](app, "indexer", 4, &task.Task{
+		OnRun: func(ctx task.Context) {
+			signals := task.Signals[reindex](ctx)
+			for {
+				select {
+				case sig := <-signals:
+					indexed <- sig.path
+				case <-ctx.Closing():
+					return
+				}
+			}
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = app.StartChild(&task.Task{
+		Info: task.Info{Name: "indexer"},
+	})
+	require.ErrorIs(t, err, task.ErrNameTaken)
+
+	// a sibling finds the indexer and signals it
+	worker, _ := app.StartChild(&task.Task{
+		Info: task.Info{Label: "worker"},
+	})
+	indexer, err := task.Lookup[reindex](worker, "indexer")
+	require.NoError(t, err)
+	require.Equal(t, "indexer", indexer.Info().Name)
+	require.NoError(t, indexer.Signal(reindex{"/photos"}))
+	require.Equal(t, "/photos", <-indexed)
+
+	_, err = task.Lookup[string](worker, "indexer")
+	require.Error(t, err)
+	_, err = task.Lookup[reindex](worker, "crawler")
+	require.ErrorIs(t, err, task.ErrNotFound)
+
+	indexer.Close()
+	require.ErrorIs(t, indexer.Signal(reindex{"/music"}), task.ErrClosed)
+	require.Nil(t, task.Find(worker, "indexer"))
+	<-indexer.Done()
+}
+
+func TestKeyValues(t *testing.T) {
+	tenantKey := task.NewKey[string]("tenant")
+	localeKey := task.NewKey[string]("locale")
+
+	session, _ := task.Start(&task.Task{
+		Info: task.Info{Label: "session"},
+	})
+	defer session.Close()
+	tenantKey.Set(session, "acme")
+	localeKey.Set(session, "en-US")
+
+	pin, _ := session.StartChild(&task.Task{
+		Info: task.Info{Label: "pin"},
+	})
+	localeKey.Set(pin, "fr-FR")
+
+	tenant, ok := tenantKey.Get(pin)
+	require.True(t, ok)
+	require.Equal(t, "acme", tenant)
+	locale, _ := localeKey.Get(pin)
+	require.Equal(t, "fr-FR", locale)
+	locale, _ = localeKey.Get(session)
+	require.Equal(t, "en-US", locale)
+
+	// values flow through to a context.Context
+	require.Equal(t, "acme", task.AsStdContext(pin).Value(tenantKey))
+	_, ok = task.NewKey[string]("tenant").Get(pin)
+	require.False(t, ok)
+}
+
+func TestTracing(t *testing.T) {
+	rec := &task.SpanRecorder{}
+	session, _ := task.Start(&task.Task{
+		Info: task.Info{
+			Label:     "session",
+			LogFields: log.Fields{"session": "s1"},
+		},
+		Tracer: rec,
+	})
+	pin, _ := session.StartChild(&task.Task{
+		Info: task.Info{Label: "pin"},
+	})
+	task.SpanOf(pin).SetAttrs(log.Fields{"pin.sync": "Maintain"})
+	pin.CloseWithReason(errors.New("transport lost"))
+	<-pin.Done()
+	session.Close()
+	<-session.Done()
+
+	spans := rec.Spans()
+	require.Len(t, spans, 2)
+	require.Equal(t, "session", spans[0].Name)
+	require.Equal(t, -1, spans[0].Parent)
+	require.Equal(t, "s1", spans[0].Attrs["session"])
+	require.Equal(t, "pin", spans[1].Name)
+	require.Equal(t, 0, spans[1].Parent)
+	require.Equal(t, pin.Info().TID, spans[1].Attrs[task.SpanAttrTID])
+	require.Equal(t, "Maintain", spans[1].Attrs["pin.sync"])
+	require.EqualError(t, spans[1].Err, "transport lost")
+	require.False(t, spans[1].EndedAt.Before(spans[1].StartedAt))
+	require.NoError(t, spans[0].Err)
+
+	// untraced contexts have a no-op span
+	untraced, _ := task.Start(&task.Task{})
+	defer untraced.Close()
+	task.SpanOf(untraced).RecordError(errors.New("ignored"))
+}
@@ -0,0 +1,129 @@
+package task
+
+import (
+	"sync"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/log"
+)
+
+// Tracer receives a span for each Context it traces, where spans nest like the task tree -- see Task.Tracer.
+//
+// This mirrors the OpenTelemetry trace API so that an adapter exporting via the OTel SDK is a thin wrapper:
+// Start() maps to trace.Tracer.Start() (with trace.WithTimestamp and the parent span placed in the context.Context) and Span maps to trace.Span.
+type Tracer interface {
+
+	// Start begins a span for a Context, where parent is the span of its parent Context (or nil if it has none or its parent is not traced).
+	// attrs are the Context's Info.LogFields along with its TID.
+	Start(parent Span, name string, start time.Time, attrs log.Fields) Span
+}
+
+// Span is an in-progress span issued by a Tracer.
+type Span interface {
+	SetAttrs(attrs log.Fields)
+	RecordError(err error)
+
+	// End completes this span, called when its Context is fully closed.
+	End(end time.Time)
+}
+
+// Span attribute keys set on every task span.
+const (
+	SpanAttrTID = "task.tid"
+)
+
+// SpanOf returns the span of the given Context, or a no-op Span if it is not traced.
+// For example, a pin's OnRun can call SpanOf(ctx).RecordError(err) when its request fails.
+func SpanOf(t Context) Span {
+	if p := asCtx(t); p != nil && p.span != nil {
+		return p.span
+	}
+	return noopSpan{}
+}
+
+type noopSpan struct{}
+
+func (noopSpan) SetAttrs(attrs log.Fields) {}
+func (noopSpan) RecordError(err error)     {}
+func (noopSpan) End(end time.Time)         {}
+
+// SpanRecorder is a Tracer that keeps spans in memory, for use in tests or a debug endpoint.
+type SpanRecorder struct {
+	mu    sync.Mutex
+	spans []RecordedSpan
+}
+
+// RecordedSpan is a snapshot of a span kept by a SpanRecorder.
+type RecordedSpan struct {
+	Parent    int // index of the parent span, or -1 if a root span
+	Name      string
+	StartedAt time.Time
+	EndedAt   time.Time // zero while in progress
+	Attrs     log.Fields
+	Err       error
+}
+
+// Spans returns a snapshot of the spans started so far, in the order they were started.
+func (rec *SpanRecorder) Spans() []RecordedSpan {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	spans := make([]RecordedSpan, len(rec.spans))
+	for i, span := range rec.spans {
+		spans[i] = span
+		spans[i].Attrs = make(log.Fields, len(span.Attrs))
+		for k, v := range span.Attrs {
+			spans[i].Attrs[k] = v
+		}
+	}
+	return spans
+}
+
+func (rec *SpanRecorder) Start(parent Span, name string, start time.Time, attrs log.Fields) Span {
+	span := RecordedSpan{
+		Parent:    -1,
+		Name:      name,
+		StartedAt: start,
+		Attrs:     make(log.Fields, len(attrs)),
+	}
+	if parent, ok := parent.(*recordedSpan); ok && parent.rec == rec {
+		span.Parent = parent.index
+	}
+	for k, v := range attrs {
+		span.Attrs[k] = v
+	}
+
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	rec.spans = append(rec.spans, span)
+	return &recordedSpan{
+		rec:   rec,
+		index: len(rec.spans) - 1,
+	}
+}
+
+// recordedSpan implements Span for a SpanRecorder.
+type recordedSpan struct {
+	rec   *SpanRecorder
+	index int
+}
+
+func (span *recordedSpan) SetAttrs(attrs log.Fields) {
+	span.rec.mu.Lock()
+	defer span.rec.mu.Unlock()
+	for k, v := range attrs {
+		span.rec.spans[span.index].Attrs[k] = v
+	}
+}
+
+func (span *recordedSpan) RecordError(err error) {
+	span.rec.mu.Lock()
+	defer span.rec.mu.Unlock()
+	span.rec.spans[span.index].Err = err
+}
+
+func (span *recordedSpan) End(end time.Time) {
+	span.rec.mu.Lock()
+	defer span.rec.mu.Unlock()
+	span.rec.spans[span.index].EndedAt = end
+}
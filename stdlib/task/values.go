@@ -0,0 +1,56 @@
+package task
+
+import "fmt"
+
+// Key is a typed key for values stored on a Context and inherited by its children (e.g. a trace ID, tenant, or locale).
+// Each key is distinct, so keys declared by different packages never collide.
+//
+//	var TenantKey = task.NewKey[string]("tenant")
+//
+//	TenantKey.Set(session, "acme")
+//	tenant, _ := TenantKey.Get(pin) // "acme" for any descendant of session
+type Key[T any] struct {
+	name string
+}
+
+// NewKey returns a new Key, where name is used only for debugging.
+func NewKey[T any](name string) *Key[T] {
+	return &Key[T]{name: name}
+}
+
+func (k *Key[T]) String() string {
+	return fmt.Sprintf("task.Key(%s)", k.name)
+}
+
+// Set stores the given value on the given Context, where it is visible to it and its descendants (unless set again on a descendant).
+func (k *Key[T]) Set(t Context, val T) {
+	p := asCtx(t)
+	if p == nil {
+		return
+	}
+	p.valuesMu.Lock()
+	if p.values == nil {
+		p.values = make(map[any]any)
+	}
+	p.values[k] = val
+	p.valuesMu.Unlock()
+}
+
+// Get returns the value set on the given Context or its nearest ancestor having one.
+func (k *Key[T]) Get(t Context) (val T, ok bool) {
+	val, ok = t.Value(k).(T)
+	return
+}
+
+// Value implements context.Context, returning a value set by Key.Set() on this Context or its nearest ancestor having one.
+func (p *ctx) Value(key any) any {
+	for ; p != nil; p = p.parent {
+		p.valuesMu.Lock()
+		val, exists := p.values[key]
+		p.valuesMu.Unlock()
+		if exists {
+			return val
+		}
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+// Package textindex is an embedded inverted index allowing apps to make cell attr text searchable.
+package textindex
+
+import (
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+// Index is an in-memory inverted index of text fields keyed by document (typically a CellID) -- concurrency safe.
+type Index interface {
+
+	// Indexes the given text as a field of a document, replacing any text previously put for that field.
+	// Empty text removes the field.
+	Put(docID, fieldID tag.ID, text string)
+
+	// Removes a document and all its fields from this index.
+	Remove(docID tag.ID)
+
+	// Returns documents matching the given query ranked by descending score.
+	// Every query term must match a document term, where the last query term also matches as a prefix (search-as-you-type).
+	// If fields are given, only those fields of each document are searched.
+	// If limit > 0, at most limit hits are returned.
+	Search(query string, limit int, fields ...tag.ID) []Hit
+
+	// Returns the number of documents in this index.
+	Len() int
+}
+
+// Hit is a document matching a query.
+type Hit struct {
+	DocID tag.ID
+	Score float64
+}
@@ -0,0 +1,235 @@
+package textindex
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+// PrefixWeight scales the score of a term matched only by prefix, ranking exact matches higher.
+const PrefixWeight = 0.5
+
+// NewIndex returns a new empty Index.
+func NewIndex() Index {
+	return &index{
+		postings: make(map[string]map[tag.ID]int),
+		docs:     make(map[tag.ID]*docEntry),
+	}
+}
+
+// Implements Index
+type index struct {
+	mu       sync.RWMutex
+	postings map[string]map[tag.ID]int // term => docID => term frequency
+	docs     map[tag.ID]*docEntry
+	terms    []string // sorted terms, rebuilt lazily for prefix matching
+	dirty    bool     // set when terms needs rebuilding
+}
+
+type docEntry struct {
+	fields map[tag.ID][]string // fieldID => field terms
+	length int                 // total number of terms across fields
+}
+
+// Returns how often term occurs in the given fields of this doc and the number of terms in those fields.
+func (doc *docEntry) fieldFreq(term string, fields []tag.ID) (freq, length int) {
+	for _, fieldID := range fields {
+		terms := doc.fields[fieldID]
+		length += len(terms)
+		for _, ti := range terms {
+			if ti == term {
+				freq++
+			}
+		}
+	}
+	return freq, length
+}
+
+// Tokenize splits text into lowercase terms, separating on anything that is not a letter or digit.
+func Tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+func (idx *index) Put(docID, fieldID tag.ID, text string) {
+	terms := Tokenize(text)
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	doc := idx.docs[docID]
+	if doc == nil {
+		if len(terms) == 0 {
+			return
+		}
+		doc = &docEntry{
+			fields: make(map[tag.ID][]string),
+		}
+		idx.docs[docID] = doc
+	}
+
+	idx.unpostField(docID, doc, fieldID)
+	if len(terms) > 0 {
+		doc.fields[fieldID] = terms
+		doc.length += len(terms)
+		for _, term := range terms {
+			posting := idx.postings[term]
+			if posting == nil {
+				posting = make(map[tag.ID]int)
+				idx.postings[term] = posting
+				idx.dirty = true
+			}
+			posting[docID]++
+		}
+	}
+	if len(doc.fields) == 0 {
+		delete(idx.docs, docID)
+	}
+}
+
+func (idx *index) Remove(docID tag.ID) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	doc := idx.docs[docID]
+	if doc == nil {
+		return
+	}
+	for fieldID := range doc.fields {
+		idx.unpostField(docID, doc, fieldID)
+	}
+	delete(idx.docs, docID)
+}
+
+// Removes the postings of a doc field -- caller holds idx.mu
+func (idx *index) unpostField(docID tag.ID, doc *docEntry, fieldID tag.ID) {
+	terms, exists := doc.fields[fieldID]
+	if !exists {
+		return
+	}
+	for _, term := range terms {
+		posting := idx.postings[term]
+		if posting[docID]--; posting[docID] <= 0 {
+			delete(posting, docID)
+		}
+		if len(posting) == 0 {
+			delete(idx.postings, term)
+			idx.dirty = true
+		}
+	}
+	doc.length -= len(terms)
+	delete(doc.fields, fieldID)
+}
+
+func (idx *index) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return len(idx.docs)
+}
+
+func (idx *index) Search(query string, limit int, fields ...tag.ID) []Hit {
+	terms := Tokenize(query)
+	if len(terms) == 0 {
+		return nil
+	}
+
+	// a Put() or Remove() may land between rebuilding terms and reacquiring the read lock
+	idx.mu.RLock()
+	for idx.dirty {
+		idx.mu.RUnlock()
+		idx.rebuildTerms()
+		idx.mu.RLock()
+	}
+	defer idx.mu.RUnlock()
+
+	numDocs := float64(len(idx.docs))
+	scores := make(map[tag.ID]float64)
+	for i, term := range terms {
+
+		// The last term also matches as a prefix
+		matching := []string{term}
+		if i == len(terms)-1 {
+			matching = idx.prefixTerms(term)
+		}
+
+		termScores := make(map[tag.ID]float64)
+		for _, mi := range matching {
+			posting := idx.postings[mi]
+			weight := math.Log(1 + numDocs/float64(len(posting)))
+			if mi != term {
+				weight *= PrefixWeight
+			}
+			for docID, freq := range posting {
+				length := idx.docs[docID].length
+				if len(fields) > 0 {
+					if freq, length = idx.docs[docID].fieldFreq(mi, fields); freq == 0 {
+						continue
+					}
+				}
+				tf := float64(freq) / float64(length)
+				termScores[docID] = max(termScores[docID], tf*weight)
+			}
+		}
+
+		// Every term must match
+		if i == 0 {
+			scores = termScores
+		} else {
+			for docID, score := range scores {
+				if termScore, matched := termScores[docID]; matched {
+					scores[docID] = score + termScore
+				} else {
+					delete(scores, docID)
+				}
+			}
+		}
+	}
+
+	hits := make([]Hit, 0, len(scores))
+	for docID, score := range scores {
+		hits = append(hits, Hit{
+			DocID: docID,
+			Score: score,
+		})
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].DocID.CompareTo(hits[j].DocID) < 0
+	})
+	if limit > 0 && len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits
+}
+
+// Rebuilds the sorted list of indexed terms if stale.
+func (idx *index) rebuildTerms() {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	if !idx.dirty {
+		return
+	}
+	idx.terms = idx.terms[:0]
+	for term := range idx.postings {
+		idx.terms = append(idx.terms, term)
+	}
+	sort.Strings(idx.terms)
+	idx.dirty = false
+}
+
+// Returns all indexed terms having the given prefix -- caller holds idx.mu
+func (idx *index) prefixTerms(prefix string) []string {
+	start := sort.SearchStrings(idx.terms, prefix)
+	end := start
+	for end < len(idx.terms) && strings.HasPrefix(idx.terms[end], prefix) {
+		end++
+	}
+	return idx.terms[start:end]
+}
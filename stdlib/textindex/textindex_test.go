@@ -0,0 +1,50 @@
+package textindex_test
+
+import (
+	"testing"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/textindex"
+)
+
+func TestIndex(t *testing.T) {
+	idx := textindex.NewIndex()
+	title := tag.FromToken("title")
+	desc := tag.FromToken("desc")
+
+	beach, forest, city := tag.Now(), tag.Now(), tag.Now()
+	idx.Put(beach, title, "Sunset at the Beach")
+	idx.Put(beach, desc, "waves, sand, and a red sunset")
+	idx.Put(forest, title, "Forest Trail")
+	idx.Put(forest, desc, "a sunny walk under redwoods")
+	idx.Put(city, title, "City Lights")
+
+	if hits := idx.Search("sunset", 0); len(hits) != 1 || hits[0].DocID != beach {
+		t.Fatalf("expected beach for 'sunset', got %v", hits)
+	}
+	if hits := idx.Search("red", 0); len(hits) != 2 || hits[0].DocID != beach {
+		t.Fatalf("expected prefix match on last term, got %v", hits)
+	}
+	if hits := idx.Search("sunny red", 0); len(hits) != 1 || hits[0].DocID != forest {
+		t.Fatalf("expected all terms to match, got %v", hits)
+	}
+	if hits := idx.Search("sunset", 0, desc); len(hits) != 1 || hits[0].DocID != beach {
+		t.Fatalf("expected beach for 'sunset' in desc, got %v", hits)
+	}
+	if hits := idx.Search("red", 0, title); len(hits) != 0 {
+		t.Fatalf("expected no title matches for 'red', got %v", hits)
+	}
+	if hits := idx.Search("trail", 0, desc, title); len(hits) != 1 || hits[0].DocID != forest {
+		t.Fatalf("expected forest for 'trail' in either field, got %v", hits)
+	}
+
+	idx.Put(beach, title, "")
+	idx.Put(beach, desc, "")
+	if idx.Len() != 2 {
+		t.Fatalf("expected doc removed once all fields are empty, got %d docs", idx.Len())
+	}
+	idx.Remove(forest)
+	if hits := idx.Search("red", 0); len(hits) != 0 {
+		t.Fatalf("expected no hits after removal, got %v", hits)
+	}
+}
@@ -0,0 +1,73 @@
+// Package timeseries stores samples over time with per-tier retention and automatic downsampling, for telemetry and analytics style apps.
+package timeseries
+
+import (
+	"time"
+)
+
+// Series is an in-memory time series of float samples -- concurrency safe.
+//
+// Each sample is aggregated into every Tier, so a coarse tier can retain a long history cheaply
+// while a fine tier retains only recent history.
+type Series interface {
+
+	// Adds a sample to this series.  Samples older than the retention of every tier are dropped.
+	Add(at time.Time, value float64)
+
+	// Returns consecutive buckets spanning [from, to) summarizing samples at the given resolution.
+	// Of the tiers retaining samples back to from, the coarsest no coarser than resolution is used (or if none is that fine, the finest),
+	// so the returned resolution may be coarser than requested.
+	// The resolution is also coarsened as needed so that at most MaxQueryBuckets are returned.
+	Query(from, to time.Time, resolution time.Duration) (buckets []Bucket, actual time.Duration)
+
+	// Returns the tiers of this series, finest first.
+	Tiers() []Tier
+}
+
+// MaxQueryBuckets bounds the buckets returned by Series.Query(), so a wide range at a fine resolution can't exhaust memory.
+const MaxQueryBuckets = 10000
+
+// Tier specifies a resolution at which samples are aggregated and for how long aggregates are retained.
+type Tier struct {
+	Resolution time.Duration // bucket width
+	Retention  time.Duration // buckets older than this (relative to the latest sample) are dropped
+}
+
+// DefaultTiers retains 1s buckets for an hour, 1m buckets for a day, and 1h buckets for a year.
+var DefaultTiers = []Tier{
+	{Resolution: time.Second, Retention: time.Hour},
+	{Resolution: time.Minute, Retention: 24 * time.Hour},
+	{Resolution: time.Hour, Retention: 365 * 24 * time.Hour},
+}
+
+// Bucket summarizes the samples within a time interval.
+type Bucket struct {
+	Start int64 // UTC (unix nanoseconds)
+	Count uint32
+	Sum   float64
+	Min   float64
+	Max   float64
+}
+
+// Mean returns the mean value of the samples in this bucket (or 0 if empty).
+func (b *Bucket) Mean() float64 {
+	if b.Count == 0 {
+		return 0
+	}
+	return b.Sum / float64(b.Count)
+}
+
+// Merge aggregates the given bucket into this bucket.
+func (b *Bucket) Merge(src Bucket) {
+	if src.Count == 0 {
+		return
+	}
+	if b.Count == 0 {
+		b.Min, b.Max = src.Min, src.Max
+	} else {
+		b.Min = min(b.Min, src.Min)
+		b.Max = max(b.Max, src.Max)
+	}
+	b.Count += src.Count
+	b.Sum += src.Sum
+}
@@ -0,0 +1,184 @@
+package timeseries
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// NewSeries returns a new empty Series using the given tiers (or DefaultTiers if none are given).
+func NewSeries(tiers ...Tier) Series {
+	if len(tiers) == 0 {
+		tiers = DefaultTiers
+	}
+	tiers = append([]Tier(nil), tiers...)
+	sort.Slice(tiers, func(i, j int) bool {
+		return tiers[i].Resolution < tiers[j].Resolution
+	})
+
+	s := &series{
+		tiers: make([]tier, len(tiers)),
+	}
+	for i, ti := range tiers {
+		if ti.Resolution <= 0 {
+			ti.Resolution = time.Second
+		}
+		s.tiers[i].Tier = ti
+	}
+	return s
+}
+
+// Implements Series
+type series struct {
+	mu     sync.RWMutex
+	tiers  []tier
+	latest int64 // UTC of the latest sample
+}
+
+type tier struct {
+	Tier
+	buckets []Bucket // sorted by Start
+}
+
+func (s *series) Tiers() []Tier {
+	tiers := make([]Tier, len(s.tiers))
+	for i := range s.tiers {
+		tiers[i] = s.tiers[i].Tier
+	}
+	return tiers
+}
+
+func (s *series) Add(at time.Time, value float64) {
+	utc := at.UnixNano()
+	sample := Bucket{
+		Count: 1,
+		Sum:   value,
+		Min:   value,
+		Max:   value,
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.latest = max(s.latest, utc)
+	for i := range s.tiers {
+		t := &s.tiers[i]
+		if utc < s.latest-int64(t.Retention) {
+			continue
+		}
+		sample.Start = floor(utc, int64(t.Resolution))
+		t.add(sample)
+		t.prune(s.latest)
+	}
+}
+
+func (t *tier) add(sample Bucket) {
+	n := len(t.buckets)
+
+	// samples typically arrive in order
+	if n > 0 && t.buckets[n-1].Start == sample.Start {
+		t.buckets[n-1].Merge(sample)
+		return
+	}
+	if n == 0 || t.buckets[n-1].Start < sample.Start {
+		t.buckets = append(t.buckets, sample)
+		return
+	}
+
+	i := sort.Search(n, func(i int) bool {
+		return t.buckets[i].Start >= sample.Start
+	})
+	if t.buckets[i].Start == sample.Start {
+		t.buckets[i].Merge(sample)
+	} else {
+		t.buckets = append(t.buckets, Bucket{})
+		copy(t.buckets[i+1:], t.buckets[i:])
+		t.buckets[i] = sample
+	}
+}
+
+// Drops buckets that ended before the retention window.
+func (t *tier) prune(latest int64) {
+	cutoff := latest - int64(t.Retention)
+	drop := 0
+	for drop < len(t.buckets) && t.buckets[drop].Start+int64(t.Resolution) <= cutoff {
+		drop++
+	}
+	if drop > 0 {
+		t.buckets = append(t.buckets[:0], t.buckets[drop:]...)
+	}
+}
+
+func (s *series) Query(from, to time.Time, resolution time.Duration) ([]Bucket, time.Duration) {
+	if len(s.tiers) == 0 {
+		return nil, 0
+	}
+	fromUTC, toUTC := from.UnixNano(), to.UnixNano()
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	src := s.sourceTier(fromUTC, resolution)
+	step := max(int64(resolution), int64(src.Resolution))
+	if toUTC > fromUTC {
+		span := uint64(toUTC) - uint64(fromUTC) // can't overflow
+		step = max(step, int64(span/(MaxQueryBuckets-2)+1))
+	}
+	step = ceil(step, int64(src.Resolution))
+	start := floor(fromUTC, step)
+	if toUTC <= start {
+		return nil, time.Duration(step)
+	}
+
+	buckets := make([]Bucket, (toUTC-start+step-1)/step)
+	for i := range buckets {
+		buckets[i].Start = start + int64(i)*step
+	}
+
+	i := sort.Search(len(src.buckets), func(i int) bool {
+		return src.buckets[i].Start >= start
+	})
+	for ; i < len(src.buckets); i++ {
+		b := src.buckets[i]
+		if b.Start >= toUTC {
+			break
+		}
+		buckets[(b.Start-start)/step].Merge(b)
+	}
+	return buckets, time.Duration(step)
+}
+
+func floor(utc, step int64) int64 {
+	r := utc % step
+	if r < 0 {
+		r += step
+	}
+	return utc - r
+}
+
+func ceil(x, step int64) int64 {
+	return (x + step - 1) / step * step
+}
+
+// Returns the coarsest tier no coarser than resolution that retains samples back to fromUTC.
+// If no tier is fine enough, the finest retaining tier is returned; if no tier retains fromUTC, the longest retaining tier is returned.
+// Caller holds s.mu
+func (s *series) sourceTier(fromUTC int64, resolution time.Duration) *tier {
+	var src, longest *tier
+	for i := range s.tiers {
+		t := &s.tiers[i]
+		if longest == nil || t.Retention > longest.Retention {
+			longest = t
+		}
+		if fromUTC < s.latest-int64(t.Retention) {
+			continue
+		}
+		if src == nil || t.Resolution <= resolution {
+			src = t
+		}
+	}
+	if src == nil {
+		src = longest
+	}
+	return src
+}
@@ -0,0 +1,58 @@
+package timeseries_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/timeseries"
+)
+
+func TestSeries(t *testing.T) {
+	series := timeseries.NewSeries(
+		timeseries.Tier{Resolution: time.Second, Retention: time.Minute},
+		timeseries.Tier{Resolution: time.Minute, Retention: time.Hour},
+	)
+
+	t0 := time.Unix(1_699_999_200, 0) // hour aligned
+	for i := 0; i < 3600; i++ {
+		series.Add(t0.Add(time.Duration(i)*time.Second), float64(i))
+	}
+	now := t0.Add(3599 * time.Second)
+
+	// recent history at fine resolution
+	buckets, res := series.Query(now.Add(-10*time.Second), now.Add(time.Second), time.Second)
+	if res != time.Second || len(buckets) != 11 {
+		t.Fatalf("expected 11 1s buckets, got %d %v buckets", len(buckets), res)
+	}
+	if b := buckets[10]; b.Count != 1 || b.Mean() != 3599 {
+		t.Fatalf("unexpected latest bucket: %+v", b)
+	}
+
+	// fine history has been dropped, so older queries are downsampled
+	buckets, res = series.Query(t0, t0.Add(10*time.Minute), time.Second)
+	if res != time.Minute || len(buckets) != 10 {
+		t.Fatalf("expected 10 1m buckets, got %d %v buckets", len(buckets), res)
+	}
+	if b := buckets[1]; b.Count != 60 || b.Min != 60 || b.Max != 119 || b.Mean() != 89.5 {
+		t.Fatalf("unexpected downsampled bucket: %+v", b)
+	}
+
+	// coarser than any tier
+	buckets, res = series.Query(t0, t0.Add(time.Hour), 10*time.Minute)
+	if res != 10*time.Minute || len(buckets) != 6 || buckets[0].Count != 600 {
+		t.Fatalf("expected 6 10m buckets, got %d %v buckets", len(buckets), res)
+	}
+
+	// out of order sample
+	series.Add(now.Add(-time.Second), 5000)
+	buckets, _ = series.Query(now.Add(-time.Second), now, time.Second)
+	if b := buckets[0]; b.Count != 2 || b.Max != 5000 {
+		t.Fatalf("unexpected merged bucket: %+v", b)
+	}
+
+	// a wide range at a fine resolution is coarsened rather than allocating a bucket per second
+	buckets, res = series.Query(t0, t0.Add(2_000_000*time.Hour), time.Second)
+	if len(buckets) > timeseries.MaxQueryBuckets || len(buckets) < timeseries.MaxQueryBuckets/2 || res%time.Minute != 0 {
+		t.Fatalf("expected at most %d buckets, got %d %v buckets", timeseries.MaxQueryBuckets, len(buckets), res)
+	}
+}
@@ -0,0 +1,53 @@
+// Package vecindex is an approximate nearest neighbor (ANN) index of vector embeddings, used to offer "similar items".
+package vecindex
+
+import (
+	"errors"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+// Index is an in-memory approximate nearest neighbor index using cosine similarity -- concurrency safe.
+//
+// Vectors are bucketed by random hyperplane hashing (LSH) so that a query only scores candidates sharing a bucket.
+// Indexes holding fewer than Opts.ExactBelow vectors are instead scanned exhaustively.
+type Index interface {
+
+	// Inserts or replaces the vector for the given item.
+	Put(itemID tag.ID, vec []float32) error
+
+	// Removes the given item from this index.
+	Remove(itemID tag.ID)
+
+	// Returns the vector stored for the given item (or nil if not present).
+	Get(itemID tag.ID) []float32
+
+	// Returns up to k items most similar to the given vector, ranked by descending similarity.
+	Nearest(vec []float32, k int) ([]Hit, error)
+
+	// Returns up to k items most similar to the given item, excluding the item itself.
+	NearestTo(itemID tag.ID, k int) ([]Hit, error)
+
+	// Returns the number of items in this index.
+	Len() int
+}
+
+// Opts configures a new Index.
+type Opts struct {
+	Dims       int   // vector dimensions; required
+	Tables     int   // number of hash tables; more tables improves recall at the cost of memory (default 8)
+	Bits       int   // hyperplanes per table (max 64); more bits yields smaller buckets (default 12)
+	ExactBelow int   // if the index holds fewer vectors than this, queries are exhaustive (default 1000)
+	Seed       int64 // seed for hyperplane generation, allowing reproducible indexes
+}
+
+// Hit is an item similar to a query.
+type Hit struct {
+	ItemID     tag.ID
+	Similarity float32 // cosine similarity in [-1, 1]
+}
+
+var (
+	ErrDimsMismatch = errors.New("vector dimensions mismatch")
+	ErrItemNotFound = errors.New("item not found")
+)
@@ -0,0 +1,208 @@
+package vecindex
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+)
+
+// NewIndex returns a new empty Index.
+func NewIndex(opts Opts) Index {
+	if opts.Tables <= 0 {
+		opts.Tables = 8
+	}
+	if opts.Bits <= 0 {
+		opts.Bits = 12
+	}
+	opts.Bits = min(opts.Bits, 64)
+	if opts.ExactBelow <= 0 {
+		opts.ExactBelow = 1000
+	}
+
+	idx := &index{
+		opts:    opts,
+		vecs:    make(map[tag.ID][]float32),
+		planes:  make([][][]float32, opts.Tables),
+		buckets: make([]map[uint64][]tag.ID, opts.Tables),
+	}
+
+	rng := rand.New(rand.NewSource(opts.Seed))
+	for t := range idx.planes {
+		idx.planes[t] = make([][]float32, opts.Bits)
+		for b := range idx.planes[t] {
+			plane := make([]float32, opts.Dims)
+			for d := range plane {
+				plane[d] = float32(rng.NormFloat64())
+			}
+			idx.planes[t][b] = plane
+		}
+		idx.buckets[t] = make(map[uint64][]tag.ID)
+	}
+	return idx
+}
+
+// Implements Index
+type index struct {
+	mu      sync.RWMutex
+	opts    Opts
+	vecs    map[tag.ID][]float32  // normalized vectors
+	planes  [][][]float32         // table => bit => hyperplane
+	buckets []map[uint64][]tag.ID // table => hash => items
+}
+
+func (idx *index) Put(itemID tag.ID, vec []float32) error {
+	if len(vec) != idx.opts.Dims {
+		return ErrDimsMismatch
+	}
+	unit := normalize(vec)
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.remove(itemID)
+	idx.vecs[itemID] = unit
+	for t := range idx.planes {
+		hash := idx.hash(t, unit)
+		idx.buckets[t][hash] = append(idx.buckets[t][hash], itemID)
+	}
+	return nil
+}
+
+func (idx *index) Remove(itemID tag.ID) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.remove(itemID)
+}
+
+// caller holds idx.mu
+func (idx *index) remove(itemID tag.ID) {
+	unit, exists := idx.vecs[itemID]
+	if !exists {
+		return
+	}
+	for t := range idx.planes {
+		hash := idx.hash(t, unit)
+		bucket := idx.buckets[t][hash]
+		for i, id := range bucket {
+			if id == itemID {
+				bucket[i] = bucket[len(bucket)-1]
+				bucket = bucket[:len(bucket)-1]
+				break
+			}
+		}
+		if len(bucket) == 0 {
+			delete(idx.buckets[t], hash)
+		} else {
+			idx.buckets[t][hash] = bucket
+		}
+	}
+	delete(idx.vecs, itemID)
+}
+
+func (idx *index) Get(itemID tag.ID) []float32 {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return idx.vecs[itemID]
+}
+
+func (idx *index) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return len(idx.vecs)
+}
+
+func (idx *index) Nearest(vec []float32, k int) ([]Hit, error) {
+	if len(vec) != idx.opts.Dims {
+		return nil, ErrDimsMismatch
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return idx.nearest(normalize(vec), k, tag.ID{}), nil
+}
+
+func (idx *index) NearestTo(itemID tag.ID, k int) ([]Hit, error) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	unit, exists := idx.vecs[itemID]
+	if !exists {
+		return nil, ErrItemNotFound
+	}
+	return idx.nearest(unit, k, itemID), nil
+}
+
+// caller holds idx.mu
+func (idx *index) nearest(unit []float32, k int, exclude tag.ID) []Hit {
+	if k <= 0 {
+		return nil
+	}
+
+	var hits []Hit
+	score := func(itemID tag.ID) {
+		if itemID != exclude {
+			hits = append(hits, Hit{
+				ItemID:     itemID,
+				Similarity: dot(unit, idx.vecs[itemID]),
+			})
+		}
+	}
+
+	if len(idx.vecs) < idx.opts.ExactBelow {
+		for itemID := range idx.vecs {
+			score(itemID)
+		}
+	} else {
+		seen := make(map[tag.ID]struct{})
+		for t := range idx.planes {
+			for _, itemID := range idx.buckets[t][idx.hash(t, unit)] {
+				if _, dupe := seen[itemID]; !dupe {
+					seen[itemID] = struct{}{}
+					score(itemID)
+				}
+			}
+		}
+	}
+
+	sort.Slice(hits, func(i, j int) bool {
+		return hits[i].Similarity > hits[j].Similarity
+	})
+	if len(hits) > k {
+		hits = hits[:k]
+	}
+	return hits
+}
+
+// Returns the bucket hash of a vector for the given table -- one bit per hyperplane side.
+func (idx *index) hash(table int, unit []float32) uint64 {
+	hash := uint64(0)
+	for b, plane := range idx.planes[table] {
+		if dot(plane, unit) >= 0 {
+			hash |= 1 << b
+		}
+	}
+	return hash
+}
+
+func dot(a, b []float32) float32 {
+	sum := float32(0)
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum
+}
+
+func normalize(vec []float32) []float32 {
+	unit := make([]float32, len(vec))
+	mag := math.Sqrt(float64(dot(vec, vec)))
+	if mag == 0 {
+		return unit
+	}
+	for i, x := range vec {
+		unit[i] = float32(float64(x) / mag)
+	}
+	return unit
+}
@@ -0,0 +1,60 @@
+package vecindex_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/art-media-platform/amp-sdk-go/stdlib/tag"
+	"github.com/art-media-platform/amp-sdk-go/stdlib/vecindex"
+)
+
+func TestIndex(t *testing.T) {
+	const dims = 16
+	rng := rand.New(rand.NewSource(3773))
+	randVec := func() []float32 {
+		vec := make([]float32, dims)
+		for i := range vec {
+			vec[i] = float32(rng.NormFloat64())
+		}
+		return vec
+	}
+
+	for _, exactBelow := range []int{1 << 20, 1} {
+		idx := vecindex.NewIndex(vecindex.Opts{
+			Dims:       dims,
+			Tables:     16,
+			Bits:       4,
+			ExactBelow: exactBelow,
+		})
+
+		target := randVec()
+		near := make([]float32, dims)
+		for i := range near {
+			near[i] = target[i] + 0.01*float32(rng.NormFloat64())
+		}
+
+		targetID, nearID := tag.Now(), tag.Now()
+		idx.Put(targetID, target)
+		idx.Put(nearID, near)
+		for i := 0; i < 500; i++ {
+			idx.Put(tag.Now(), randVec())
+		}
+
+		hits, err := idx.NearestTo(targetID, 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(hits) == 0 || hits[0].ItemID != nearID {
+			t.Fatalf("expected nearest item to be found (exactBelow=%d), got %v", exactBelow, hits)
+		}
+
+		if err = idx.Put(tag.Now(), make([]float32, dims+1)); err != vecindex.ErrDimsMismatch {
+			t.Fatalf("expected ErrDimsMismatch, got %v", err)
+		}
+
+		idx.Remove(nearID)
+		if hits, _ = idx.NearestTo(targetID, 1); len(hits) > 0 && hits[0].ItemID == nearID {
+			t.Fatal("removed item still returned")
+		}
+	}
+}